@@ -14,6 +14,8 @@ type AuthConfiguration struct {
 	JwtRefreshLifespan    time.Duration
 	HmacTimestampAge      time.Duration
 	PasswordResetLifespan time.Duration
+	APIKeyRotationOverlap time.Duration
+	OpsAPIKey             string
 }
 
 // AuthConfig sets the authentication & authorization configurations
@@ -22,6 +24,7 @@ func AuthConfig() (config *AuthConfiguration) {
 	viper.SetDefault("JWT_REFRESH_LIFESPAN", 10080) // 7 days
 	viper.SetDefault("HMAC_TIMESTAMP_AGE", 5)
 	viper.SetDefault("PASSWORD_RESET_LIFESPAN", 5)
+	viper.SetDefault("API_KEY_ROTATION_OVERLAP", 24) // 24 hours
 
 	return &AuthConfiguration{
 		Secret:                viper.GetString("SECRET"),
@@ -29,6 +32,8 @@ func AuthConfig() (config *AuthConfiguration) {
 		JwtRefreshLifespan:    time.Duration(viper.GetInt("JWT_REFRESH_LIFESPAN")) * time.Minute,
 		HmacTimestampAge:      time.Duration(viper.GetInt("HMAC_TIMESTAMP_AGE")) * time.Minute,
 		PasswordResetLifespan: time.Duration(viper.GetInt("PASSWORD_RESET_LIFESPAN")) * time.Minute,
+		APIKeyRotationOverlap: time.Duration(viper.GetInt("API_KEY_ROTATION_OVERLAP")) * time.Hour,
+		OpsAPIKey:             viper.GetString("OPS_API_KEY"),
 	}
 }
 
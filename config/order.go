@@ -15,12 +15,18 @@ type OrderConfiguration struct {
 	OrderRefundTimeout               time.Duration
 	ReceiveAddressValidity           time.Duration
 	OrderRequestValidity             time.Duration
+	QuoteValidity                    time.Duration
+	UnderpaymentTopUpWindow          time.Duration
 	TronProApiKey                    string
 	EntryPointContractAddress        common.Address
 	BucketQueueRebuildInterval       int
 	RefundCancellationCount          int
 	PercentDeviationFromExternalRate decimal.Decimal
 	PercentDeviationFromMarketRate   decimal.Decimal
+	StaleRateGracePeriod             time.Duration
+	DisputeEvidenceWindow            time.Duration
+	DisputeResolutionWindow          time.Duration
+	PartialFulfillmentTimeout        time.Duration
 	BundlerUrlEthereum               string
 	PaymasterUrlEthereum             string
 	BundlerUrlPolygon                string
@@ -38,6 +44,8 @@ type OrderConfiguration struct {
 func OrderConfig() *OrderConfiguration {
 	viper.SetDefault("RECEIVE_ADDRESS_VALIDITY", 30)
 	viper.SetDefault("ORDER_REQUEST_VALIDITY", 30)
+	viper.SetDefault("QUOTE_VALIDITY", 120)
+	viper.SetDefault("UNDERPAYMENT_TOPUP_WINDOW", 30)
 	viper.SetDefault("ORDER_FULFILLMENT_VALIDITY", 1)
 	viper.SetDefault("ORDER_REFUND_TIMEOUT", 5)
 	viper.SetDefault("BUCKET_QUEUE_REBUILD_INTERVAL", 1)
@@ -45,6 +53,10 @@ func OrderConfig() *OrderConfiguration {
 	viper.SetDefault("NETWORK_FEE", 0.05)
 	viper.SetDefault("PERCENT_DEVIATION_FROM_EXTERNAL_RATE", 0.01)
 	viper.SetDefault("PERCENT_DEVIATION_FROM_MARKET_RATE", 0.1)
+	viper.SetDefault("STALE_RATE_GRACE_PERIOD", 60)
+	viper.SetDefault("DISPUTE_EVIDENCE_WINDOW", 48)
+	viper.SetDefault("DISPUTE_RESOLUTION_WINDOW", 120)
+	viper.SetDefault("PARTIAL_FULFILLMENT_TIMEOUT", 60)
 	viper.SetDefault("ACTIVE_AA_SERVICE", "stackup")
 
 	return &OrderConfiguration{
@@ -52,6 +64,8 @@ func OrderConfig() *OrderConfiguration {
 		OrderRefundTimeout:               time.Duration(viper.GetInt("ORDER_REFUND_TIMEOUT")) * time.Minute,
 		ReceiveAddressValidity:           time.Duration(viper.GetInt("RECEIVE_ADDRESS_VALIDITY")) * time.Minute,
 		OrderRequestValidity:             time.Duration(viper.GetInt("ORDER_REQUEST_VALIDITY")) * time.Second,
+		QuoteValidity:                    time.Duration(viper.GetInt("QUOTE_VALIDITY")) * time.Second,
+		UnderpaymentTopUpWindow:          time.Duration(viper.GetInt("UNDERPAYMENT_TOPUP_WINDOW")) * time.Minute,
 		TronProApiKey:                    viper.GetString("TRON_PRO_API_KEY"),
 		ActiveAAService:                  viper.GetString("ACTIVE_AA_SERVICE"),
 		BundlerUrlEthereum:               viper.GetString("BUNDLER_URL_ETHEREUM"),
@@ -69,6 +83,10 @@ func OrderConfig() *OrderConfiguration {
 		RefundCancellationCount:          viper.GetInt("REFUND_CANCELLATION_COUNT"),
 		PercentDeviationFromExternalRate: decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_EXTERNAL_RATE")),
 		PercentDeviationFromMarketRate:   decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_MARKET_RATE")),
+		StaleRateGracePeriod:             time.Duration(viper.GetInt("STALE_RATE_GRACE_PERIOD")) * time.Minute,
+		DisputeEvidenceWindow:            time.Duration(viper.GetInt("DISPUTE_EVIDENCE_WINDOW")) * time.Hour,
+		DisputeResolutionWindow:          time.Duration(viper.GetInt("DISPUTE_RESOLUTION_WINDOW")) * time.Hour,
+		PartialFulfillmentTimeout:        time.Duration(viper.GetInt("PARTIAL_FULFILLMENT_TIMEOUT")) * time.Minute,
 	}
 }
 
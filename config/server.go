@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -18,6 +19,7 @@ type ServerConfiguration struct {
 	HostDomain               string
 	RateLimitUnauthenticated int
 	RateLimitAuthenticated   int
+	IdempotencyKeyValidity   time.Duration
 }
 
 // ServerConfig sets the server configuration
@@ -31,18 +33,20 @@ func ServerConfig() *ServerConfiguration {
 	viper.SetDefault("SENTRY_DSN", "")
 	viper.SetDefault("RATE_LIMIT_UNAUTHENTICATED", 5)
 	viper.SetDefault("RATE_LIMIT_AUTHENTICATED", 50)
+	viper.SetDefault("IDEMPOTENCY_KEY_VALIDITY", 24)
 
 	return &ServerConfiguration{
-		Debug:        viper.GetBool("DEBUG"),
-		Host:         viper.GetString("SERVER_HOST"),
-		Port:         viper.GetString("SERVER_PORT"),
-		Timezone:     viper.GetString("SERVER_TIMEZONE"),
-		AllowedHosts: viper.GetString("ALLOWED_HOSTS"),
-		Environment:  viper.GetString("ENVIRONMENT"),
-		SentryDSN:    viper.GetString("SENTRY_DSN"),
-		HostDomain:   viper.GetString("HOST_DOMAIN"),
+		Debug:                    viper.GetBool("DEBUG"),
+		Host:                     viper.GetString("SERVER_HOST"),
+		Port:                     viper.GetString("SERVER_PORT"),
+		Timezone:                 viper.GetString("SERVER_TIMEZONE"),
+		AllowedHosts:             viper.GetString("ALLOWED_HOSTS"),
+		Environment:              viper.GetString("ENVIRONMENT"),
+		SentryDSN:                viper.GetString("SENTRY_DSN"),
+		HostDomain:               viper.GetString("HOST_DOMAIN"),
 		RateLimitUnauthenticated: viper.GetInt("RATE_LIMIT_UNAUTHENTICATED"),
-        RateLimitAuthenticated:   viper.GetInt("RATE_LIMIT_AUTHENTICATED"),
+		RateLimitAuthenticated:   viper.GetInt("RATE_LIMIT_AUTHENTICATED"),
+		IdempotencyKeyValidity:   time.Duration(viper.GetInt("IDEMPOTENCY_KEY_VALIDITY")) * time.Hour,
 	}
 }
 
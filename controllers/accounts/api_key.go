@@ -0,0 +1,215 @@
+package accounts
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// ListAPIKeys lists the API keys of the sender or provider profile without their secrets
+func (ctrl *ProfileController) ListAPIKeys(ctx *gin.Context) {
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	apiKeys, err := storage.Client.APIKey.
+		Query().
+		Where(apiKeyOfProfile(sender, provider)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch API keys", nil)
+		return
+	}
+
+	response := make([]types.APIKeyDetailsResponse, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		response[i] = apiKeyDetailsResponse(apiKey, "")
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "API keys fetched successfully", response)
+}
+
+// CreateAPIKey creates a named and scoped API key for the sender or provider profile
+func (ctrl *ProfileController) CreateAPIKey(ctx *gin.Context) {
+	var payload types.APIKeyPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.ExpiresAt != nil && payload.ExpiresAt.Before(time.Now()) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "ExpiresAt",
+			Message: "Expiry must be in the future",
+		})
+		return
+	}
+
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	if payload.Sandbox && provider != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Sandbox",
+			Message: "Sandbox keys are only available to senders",
+		})
+		return
+	}
+
+	apiKey, secretKey, err := ctrl.apiKeyService.CreateAPIKey(ctx, sender, provider, payload.Name, payload.Scopes, payload.ExpiresAt, payload.Sandbox)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create API key", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "API key created successfully", apiKeyDetailsResponse(apiKey, secretKey))
+}
+
+// RotateAPIKey replaces an API key of the sender or provider profile with a new secret.
+// The old key keeps working for the overlap window so clients can switch over.
+func (ctrl *ProfileController) RotateAPIKey(ctx *gin.Context) {
+	var payload types.RotateAPIKeyPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil && err != io.EOF {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	apiKey, ok := getProfileAPIKey(ctx)
+	if !ok {
+		return
+	}
+
+	overlap := authConf.APIKeyRotationOverlap
+	if payload.OverlapHours != nil {
+		overlap = time.Duration(*payload.OverlapHours) * time.Hour
+	}
+
+	newAPIKey, secretKey, err := ctrl.apiKeyService.RotateAPIKey(ctx, apiKey, overlap)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate API key", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "API key rotated successfully", apiKeyDetailsResponse(newAPIKey, secretKey))
+}
+
+// RevokeAPIKey revokes an API key of the sender or provider profile
+func (ctrl *ProfileController) RevokeAPIKey(ctx *gin.Context) {
+	apiKey, ok := getProfileAPIKey(ctx)
+	if !ok {
+		return
+	}
+
+	// The primary key signs webhooks and node requests, so it can only be replaced
+	if apiKey.IsPrimary {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "The primary API key cannot be revoked, rotate it instead", nil)
+		return
+	}
+
+	_, err := apiKey.Update().
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke API key", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "API key revoked successfully", nil)
+}
+
+// getProfileAPIKey fetches the usable API key in the route param from the profile on the route.
+// It writes the error response when the key can't be fetched.
+func getProfileAPIKey(ctx *gin.Context) (*ent.APIKey, bool) {
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+
+	apiKeyID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid API key ID", nil)
+		return nil, false
+	}
+
+	apiKey, err := storage.Client.APIKey.
+		Query().
+		Where(
+			apikey.IDEQ(apiKeyID),
+			apiKeyOfProfile(sender, provider),
+			apikey.RevokedAtIsNil(),
+			apikey.Or(
+				apikey.ExpiresAtIsNil(),
+				apikey.ExpiresAtGT(time.Now()),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "API key not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch API key", nil)
+		}
+		return nil, false
+	}
+
+	return apiKey, true
+}
+
+// apiKeyOfProfile is a predicate for the API keys of a sender or provider profile
+func apiKeyOfProfile(sender *ent.SenderProfile, provider *ent.ProviderProfile) predicate.APIKey {
+	if sender != nil {
+		return apikey.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))
+	}
+	return apikey.HasProviderProfileWith(providerprofile.IDEQ(provider.ID))
+}
+
+// apiKeyDetailsResponse converts an API key to its response type
+func apiKeyDetailsResponse(apiKey *ent.APIKey, secret string) types.APIKeyDetailsResponse {
+	response := types.APIKeyDetailsResponse{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		Scopes:    apiKey.Scopes,
+		IsPrimary: apiKey.IsPrimary,
+		IsSandbox: apiKey.IsSandbox,
+		Secret:    secret,
+		CreatedAt: apiKey.CreatedAt,
+	}
+	if !apiKey.ExpiresAt.IsZero() {
+		response.ExpiresAt = &apiKey.ExpiresAt
+	}
+	if !apiKey.LastUsedAt.IsZero() {
+		response.LastUsedAt = &apiKey.LastUsedAt
+	}
+	if !apiKey.RevokedAt.IsZero() {
+		response.RevokedAt = &apiKey.RevokedAt
+	}
+	return response
+}
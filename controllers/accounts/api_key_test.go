@@ -0,0 +1,216 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/routers/middleware"
+	"github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/paycrest/aggregator/utils/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeys(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:apikeys?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Setup test data
+	owner, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "keyowner@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(owner.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	primaryKey, _, err := services.NewAPIKeyService().GenerateAPIKey(context.Background(), nil, sender, nil)
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewProfileController()
+
+	v1 := router.Group("/v1/")
+	v1.GET("settings/sender/api-keys", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.ListAPIKeys)
+	v1.POST("settings/sender/api-keys", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.CreateAPIKey)
+	v1.POST("settings/sender/api-keys/:id/rotate", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.RotateAPIKey)
+	v1.DELETE("settings/sender/api-keys/:id", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.RevokeAPIKey)
+
+	ok := func(ctx *gin.Context) { u.APIResponse(ctx, http.StatusOK, "success", "OK", nil) }
+	v1.GET("sender/orders", middleware.APIKeyMiddleware, middleware.OnlySenderMiddleware, ok)
+	v1.POST("sender/orders", middleware.APIKeyMiddleware, middleware.OnlySenderMiddleware, ok)
+
+	accessToken, _ := token.GenerateAccessJWT(owner.ID.String(), "sender")
+	headers := map[string]string{
+		"Authorization": "Bearer " + accessToken,
+	}
+
+	var scopedKey types.APIKeyDetailsResponse
+
+	t.Run("CreateAPIKey", func(t *testing.T) {
+		t.Run("with an unknown scope", func(t *testing.T) {
+			payload := types.APIKeyPayload{
+				Name:   "Reporting",
+				Scopes: []string{"keys:manage"},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/api-keys", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with a read only scope", func(t *testing.T) {
+			payload := types.APIKeyPayload{
+				Name:   "Reporting",
+				Scopes: []string{"orders:read"},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/api-keys", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data types.APIKeyDetailsResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, response.Data.Secret)
+			assert.False(t, response.Data.IsPrimary)
+			scopedKey = response.Data
+		})
+
+		t.Run("with a settings scope", func(t *testing.T) {
+			payload := types.APIKeyPayload{
+				Name:   "Rates",
+				Scopes: []string{"settings:read"},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/api-keys", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+		})
+	})
+
+	t.Run("Scopes", func(t *testing.T) {
+		t.Run("scoped key can read orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/sender/orders", nil, map[string]string{"API-Key": scopedKey.ID.String()}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+		})
+
+		t.Run("scoped key cannot create orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", map[string]interface{}{}, map[string]string{"API-Key": scopedKey.ID.String()}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, res.Code)
+		})
+
+		t.Run("primary key can create orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", map[string]interface{}{}, map[string]string{"API-Key": primaryKey.ID.String()}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			apiKey, err := db.Client.APIKey.Get(context.Background(), primaryKey.ID)
+			assert.NoError(t, err)
+			assert.False(t, apiKey.LastUsedAt.IsZero())
+		})
+	})
+
+	t.Run("RotateAPIKey", func(t *testing.T) {
+		payload := types.RotateAPIKeyPayload{
+			OverlapHours: new(int),
+		}
+		*payload.OverlapHours = 1
+
+		res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/api-keys/"+primaryKey.ID.String()+"/rotate", payload, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var response struct {
+			Data types.APIKeyDetailsResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.True(t, response.Data.IsPrimary)
+		assert.NotEqual(t, primaryKey.ID, response.Data.ID)
+
+		// Both keys verify during the overlap window
+		oldKey, err := db.Client.APIKey.Get(context.Background(), primaryKey.ID)
+		assert.NoError(t, err)
+		assert.False(t, oldKey.IsPrimary)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), oldKey.ExpiresAt, time.Minute)
+
+		// A profile can only have one primary key
+		_, _, err = services.NewAPIKeyService().GenerateAPIKey(context.Background(), nil, sender, nil)
+		assert.Error(t, err)
+
+		for _, id := range []string{primaryKey.ID.String(), response.Data.ID.String()} {
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", map[string]interface{}{}, map[string]string{"API-Key": id}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+		}
+
+		// The old key stops verifying after the overlap window
+		_, err = oldKey.Update().SetExpiresAt(time.Now().Add(-time.Minute)).Save(context.Background())
+		assert.NoError(t, err)
+
+		res, err = test.PerformRequest(t, "POST", "/v1/sender/orders", map[string]interface{}{}, map[string]string{"API-Key": primaryKey.ID.String()}, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+		primaryKey = &ent.APIKey{ID: response.Data.ID}
+	})
+
+	t.Run("RevokeAPIKey", func(t *testing.T) {
+		t.Run("with the primary key", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "DELETE", "/v1/settings/sender/api-keys/"+primaryKey.ID.String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with a scoped key", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "DELETE", "/v1/settings/sender/api-keys/"+scopedKey.ID.String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			res, err = test.PerformRequest(t, "GET", "/v1/sender/orders", nil, map[string]string{"API-Key": scopedKey.ID.String()}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnauthorized, res.Code)
+		})
+	})
+
+	t.Run("ListAPIKeys", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", "/v1/settings/sender/api-keys", nil, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data []types.APIKeyDetailsResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 4)
+		for _, apiKey := range response.Data {
+			assert.Empty(t, apiKey.Secret)
+		}
+	})
+}
@@ -140,18 +140,18 @@ func TestAuth(t *testing.T) {
 				Where(userEnt.IDEQ(userUUID)).
 				WithProviderProfile(
 					func(q *ent.ProviderProfileQuery) {
-						q.WithAPIKey()
+						q.WithAPIKeys()
 					}).
 				WithSenderProfile(func(q *ent.SenderProfileQuery) {
-					q.WithAPIKey()
+					q.WithAPIKeys()
 				}).
 				Only(context.Background())
 
 			assert.NoError(t, err)
 
 			assert.NotNil(t, user)
-			assert.NotNil(t, user.Edges.SenderProfile.Edges.APIKey)
-			assert.NotNil(t, user.Edges.ProviderProfile.Edges.APIKey)
+			assert.Len(t, user.Edges.SenderProfile.Edges.APIKeys, 1)
+			assert.Len(t, user.Edges.ProviderProfile.Edges.APIKeys, 1)
 
 		})
 		t.Run("with only sender scope payload", func(t *testing.T) {
@@ -203,13 +203,13 @@ func TestAuth(t *testing.T) {
 				Where(userEnt.IDEQ(userUUID)).
 				WithProviderProfile().
 				WithSenderProfile(func(spq *ent.SenderProfileQuery) {
-					spq.WithAPIKey()
+					spq.WithAPIKeys()
 				}).
 				Only(context.Background())
 			assert.NoError(t, err)
 
 			assert.NotNil(t, user)
-			assert.NotNil(t, user.Edges.SenderProfile.Edges.APIKey)
+			assert.Len(t, user.Edges.SenderProfile.Edges.APIKeys, 1)
 			assert.Nil(t, user.Edges.ProviderProfile)
 		})
 		t.Run("with only provider scope payload", func(t *testing.T) {
@@ -264,14 +264,14 @@ func TestAuth(t *testing.T) {
 				Where(userEnt.IDEQ(userUUID)).
 				WithProviderProfile(
 					func(ppq *ent.ProviderProfileQuery) {
-						ppq.WithAPIKey()
+						ppq.WithAPIKeys()
 					}).
 				WithSenderProfile().
 				Only(context.Background())
 			assert.NoError(t, err)
 
 			assert.NotNil(t, user)
-			assert.NotNil(t, user.Edges.ProviderProfile.Edges.APIKey)
+			assert.Len(t, user.Edges.ProviderProfile.Edges.APIKeys, 1)
 			assert.Nil(t, user.Edges.SenderProfile)
 
 			// t.Run("test unsupported fiat", func(t *testing.T) {
@@ -334,14 +334,14 @@ func TestAuth(t *testing.T) {
 				Where(userEnt.IDEQ(userUUID)).
 				WithProviderProfile(
 					func(q *ent.ProviderProfileQuery) {
-						q.WithAPIKey()
+						q.WithAPIKeys()
 					}).
 				WithSenderProfile().
 				Only(context.Background())
 			assert.NoError(t, err)
 
 			assert.NotNil(t, user)
-			assert.NotNil(t, user.Edges.ProviderProfile.Edges.APIKey)
+			assert.Len(t, user.Edges.ProviderProfile.Edges.APIKeys, 1)
 			assert.Nil(t, user.Edges.SenderProfile)
 		})
 
@@ -3,17 +3,22 @@ package accounts
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
 	svc "github.com/paycrest/aggregator/services"
 	"github.com/paycrest/aggregator/storage"
@@ -77,6 +82,14 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 		update.SetDomainWhitelist(payload.DomainWhitelist)
 	}
 
+	if payload.UnderpaymentPolicy != "" {
+		update.SetUnderpaymentPolicy(payload.UnderpaymentPolicy)
+	}
+
+	if payload.OverpaymentPolicy != "" {
+		update.SetOverpaymentPolicy(payload.OverpaymentPolicy)
+	}
+
 	// save or update SenderOrderToken
 	tx, err := storage.Client.Tx(ctx)
 	if err != nil {
@@ -87,16 +100,27 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 	for _, tokenPayload := range payload.Tokens {
 
 		if len(tokenPayload.Addresses) == 0 {
+			_ = tx.Rollback()
 			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("No wallet address provided for %s token", tokenPayload.Symbol), nil)
 			return
 		}
 
+		if err := validateFeeTiers(tokenPayload.FeeTiers); err != nil {
+			_ = tx.Rollback()
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "FeeTiers",
+				Message: err.Error(),
+			})
+			return
+		}
+
 		// Check if token is supported
 		_, err := tx.Token.
 			Query().
 			Where(token.Symbol(tokenPayload.Symbol)).
 			First(ctx)
 		if err != nil {
+			_ = tx.Rollback()
 			u.APIResponse(ctx, http.StatusBadRequest, "error", "Token not supported", nil)
 			return
 		}
@@ -107,6 +131,7 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 			if strings.HasPrefix(address.Network, "tron") {
 				feeAddressIsValid := u.IsValidTronAddress(address.FeeAddress)
 				if address.FeeAddress != "" && !feeAddressIsValid {
+					_ = tx.Rollback()
 					u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
 						Field:   "FeeAddress",
 						Message: "Invalid Tron address",
@@ -117,6 +142,7 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 			} else {
 				feeAddressIsValid := u.IsValidEthereumAddress(address.FeeAddress)
 				if address.FeeAddress != "" && !feeAddressIsValid {
+					_ = tx.Rollback()
 					u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
 						Field:   "FeeAddress",
 						Message: "Invalid Ethereum address",
@@ -139,6 +165,7 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 				).
 				Only(ctx)
 			if err != nil {
+				_ = tx.Rollback()
 				u.APIResponse(
 					ctx,
 					http.StatusBadRequest,
@@ -150,6 +177,8 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 			networksToTokenId[key] = tokenId.ID
 		}
 
+		// Addresses on the same network share a sender order token
+		senderTokens := map[int]*ent.SenderOrderToken{}
 		for _, address := range tokenPayload.Addresses {
 			senderToken, err := tx.SenderOrderToken.
 				Query().
@@ -162,7 +191,7 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 				Only(ctx)
 			if err != nil {
 				if ent.IsNotFound(err) {
-					_, err := tx.SenderOrderToken.
+					senderToken, err = tx.SenderOrderToken.
 						Create().
 						SetSenderID(sender.ID).
 						SetTokenID(networksToTokenId[address.Network]).
@@ -171,26 +200,78 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 						SetFeeAddress(address.FeeAddress).
 						Save(ctx)
 					if err != nil {
+						logger.Errorf("error: %v", err)
+						_ = tx.Rollback()
 						u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
 						return
 					}
 				} else {
+					logger.Errorf("error: %v", err)
+					_ = tx.Rollback()
 					u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile err:", nil)
 					return
 				}
 
 			} else {
-				_, err := senderToken.
+				senderToken, err = senderToken.
 					Update().
 					SetRefundAddress(address.RefundAddress).
 					SetFeePercent(tokenPayload.FeePercent).
 					SetFeeAddress(address.FeeAddress).
 					Save(ctx)
 				if err != nil {
+					logger.Errorf("error: %v", err)
+					_ = tx.Rollback()
 					u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
 					return
 				}
 			}
+
+			senderTokens[senderToken.ID] = senderToken
+		}
+
+		// Fee tiers are replaced as a whole when provided
+		if tokenPayload.FeeTiers == nil {
+			continue
+		}
+
+		for _, senderToken := range senderTokens {
+			_, err := tx.SenderFeeTier.
+				Delete().
+				Where(senderfeetier.HasSenderOrderTokenWith(senderordertoken.IDEQ(senderToken.ID))).
+				Exec(ctx)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				_ = tx.Rollback()
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+				return
+			}
+
+			builders := make([]*ent.SenderFeeTierCreate, len(tokenPayload.FeeTiers))
+			for i, tier := range tokenPayload.FeeTiers {
+				builders[i] = tx.SenderFeeTier.
+					Create().
+					SetSenderOrderToken(senderToken).
+					SetName(tier.Name).
+					SetMinVolume(tier.MinVolume).
+					SetFeePercent(tier.FeePercent).
+					SetMinFee(tier.MinFee).
+					SetMaxFee(tier.MaxFee)
+				if !tier.StartsAt.IsZero() {
+					builders[i].SetStartsAt(tier.StartsAt)
+				}
+				if !tier.EndsAt.IsZero() {
+					builders[i].SetEndsAt(tier.EndsAt)
+				}
+			}
+
+			_, err = tx.SenderFeeTier.CreateBulk(builders...).Save(ctx)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				_ = tx.Rollback()
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+				return
+			}
 		}
 	}
 
@@ -247,6 +328,86 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 		update.SetIsAvailable(false)
 	}
 
+	update.SetAutoAdjustStaleRates(payload.AutoAdjustStaleRates)
+
+	// Operating hours switch the provider's availability on and off automatically
+	if payload.Timezone != "" {
+		if _, err := time.LoadLocation(payload.Timezone); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Timezone",
+				Message: "Invalid timezone",
+			})
+			return
+		}
+		update.SetTimezone(payload.Timezone)
+	}
+
+	if payload.OperatingHours != nil {
+		for _, hours := range payload.OperatingHours {
+			_, openErr := time.Parse("15:04", hours.Open)
+			_, closeErr := time.Parse("15:04", hours.Close)
+			if hours.Day < 0 || hours.Day > 6 || openErr != nil || closeErr != nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "OperatingHours",
+					Message: "Operating hours must have a day from 0 (Sunday) to 6 and open and close times in the format HH:MM",
+				})
+				return
+			}
+		}
+		update.SetOperatingHours(payload.OperatingHours)
+	}
+
+	if payload.Holidays != nil {
+		for _, holiday := range payload.Holidays {
+			if _, err := time.Parse("2006-01-02", holiday); err != nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Holidays",
+					Message: "Holidays must be dates in the format YYYY-MM-DD",
+				})
+				return
+			}
+		}
+		update.SetHolidays(payload.Holidays)
+	}
+
+	// Institutions the provider can and can't pay out to are used to route orders
+	if payload.SupportedInstitutions != nil || payload.UnsupportedInstitutions != nil {
+		for _, code := range append(payload.SupportedInstitutions, payload.UnsupportedInstitutions...) {
+			exists, err := storage.Client.Institution.
+				Query().
+				Where(institution.CodeEQ(code)).
+				Exist(ctx)
+			if err != nil {
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch institutions", nil)
+				return
+			}
+			if !exists {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Institutions",
+					Message: "Institution " + code + " is not supported",
+				})
+				return
+			}
+		}
+
+		for _, code := range payload.SupportedInstitutions {
+			if u.ContainsString(payload.UnsupportedInstitutions, code) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "UnsupportedInstitutions",
+					Message: "Institution " + code + " can't be both supported and unsupported",
+				})
+				return
+			}
+		}
+
+		if payload.SupportedInstitutions != nil {
+			update.SetSupportedInstitutions(payload.SupportedInstitutions)
+		}
+		if payload.UnsupportedInstitutions != nil {
+			update.SetUnsupportedInstitutions(payload.UnsupportedInstitutions)
+		}
+	}
+
 	if payload.Currency != "" {
 		currency, err := storage.Client.FiatCurrency.
 			Query().
@@ -349,6 +510,52 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			}
 		}
 
+		// Validate the rate slippage tolerance. Percentage tolerances are relative to the provider's rate
+		if tokenPayload.RateSlippageType != "" {
+			if providerordertoken.RateSlippageTypeValidator(tokenPayload.RateSlippageType) != nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "RateSlippageType",
+					Message: "Rate slippage type must be percentage or absolute",
+				})
+				return
+			}
+
+			if tokenPayload.RateSlippage.IsNegative() ||
+				(tokenPayload.RateSlippageType == providerordertoken.RateSlippageTypePercentage && tokenPayload.RateSlippage.GreaterThan(decimal.NewFromInt(100))) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "RateSlippage",
+					Message: "Rate slippage must be between 0 and 100 percent or a non-negative amount",
+				})
+				return
+			}
+		}
+
+		// Validate the rate bands. Bands are kept sorted and must fall within the order amount range without overlapping
+		sort.SliceStable(tokenPayload.RateBands, func(i, j int) bool {
+			return tokenPayload.RateBands[i].MinAmount.LessThan(tokenPayload.RateBands[j].MinAmount)
+		})
+		for i, band := range tokenPayload.RateBands {
+			if !band.MinAmount.LessThan(band.MaxAmount) ||
+				band.MinAmount.LessThan(tokenPayload.MinOrderAmount) ||
+				band.MaxAmount.GreaterThan(tokenPayload.MaxOrderAmount) ||
+				(i > 0 && band.MinAmount.LessThan(tokenPayload.RateBands[i-1].MaxAmount)) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "RateBands",
+					Message: "Rate bands must be within the min and max order amount and must not overlap",
+				})
+				return
+			}
+
+			// A band of a fixed rate token would otherwise quote a rate of 0
+			if tokenPayload.ConversionRateType == providerordertoken.ConversionRateTypeFixed && !band.FixedConversionRate.IsPositive() {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "RateBands",
+					Message: "Rate bands of a fixed rate token must have a fixed conversion rate",
+				})
+				return
+			}
+		}
+
 		// Ensure rate is within allowed deviation from the market rate
 		currency, err := storage.Client.FiatCurrency.
 			Query().
@@ -362,15 +569,20 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			return
 		}
 
-		var rate decimal.Decimal
-
 		if tokenPayload.ConversionRateType == providerordertoken.ConversionRateTypeFloating {
-			rate = currency.MarketRate.Add(tokenPayload.FloatingConversionRate)
+			floatingRates := []decimal.Decimal{tokenPayload.FloatingConversionRate}
+			for _, band := range tokenPayload.RateBands {
+				floatingRates = append(floatingRates, band.FloatingConversionRate)
+			}
 
-			percentDeviation := u.AbsPercentageDeviation(currency.MarketRate, rate)
-			if percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Rate is too far from market rate", nil)
-				return
+			for _, floatingRate := range floatingRates {
+				rate := currency.MarketRate.Add(floatingRate)
+
+				percentDeviation := u.AbsPercentageDeviation(currency.MarketRate, rate)
+				if percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
+					u.APIResponse(ctx, http.StatusBadRequest, "error", "Rate is too far from market rate", nil)
+					return
+				}
 			}
 		}
 
@@ -385,8 +597,13 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 
 		if err != nil {
 			if ent.IsNotFound(err) {
-				// Token doesn't exist, create it
-				_, err = storage.Client.ProviderOrderToken.
+				// Token doesn't exist, create it with an absolute slippage of 0.5 unless a tolerance is set
+				if tokenPayload.RateSlippageType == "" {
+					tokenPayload.RateSlippageType = providerordertoken.RateSlippageTypeAbsolute
+					tokenPayload.RateSlippage = decimal.NewFromFloat(0.5)
+				}
+
+				orderToken, err = storage.Client.ProviderOrderToken.
 					Create().
 					SetSymbol(tokenPayload.Symbol).
 					SetConversionRateType(tokenPayload.ConversionRateType).
@@ -394,6 +611,9 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 					SetFloatingConversionRate(tokenPayload.FloatingConversionRate).
 					SetMaxOrderAmount(tokenPayload.MaxOrderAmount).
 					SetMinOrderAmount(tokenPayload.MinOrderAmount).
+					SetRateSlippage(tokenPayload.RateSlippage).
+					SetRateSlippageType(tokenPayload.RateSlippageType).
+					SetRateBands(tokenPayload.RateBands).
 					SetAddresses(tokenPayload.Addresses).
 					SetProviderID(provider.ID).
 					Save(ctx)
@@ -407,34 +627,38 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			}
 		} else {
 			// Token exists, update it
-			_, err = orderToken.Update().
+			tokenUpdate := orderToken.Update().
 				SetConversionRateType(tokenPayload.ConversionRateType).
 				SetFixedConversionRate(tokenPayload.FixedConversionRate).
 				SetFloatingConversionRate(tokenPayload.FloatingConversionRate).
 				SetMaxOrderAmount(tokenPayload.MaxOrderAmount).
 				SetMinOrderAmount(tokenPayload.MinOrderAmount).
-				SetAddresses(tokenPayload.Addresses).
-				Save(ctx)
+				SetRateBands(tokenPayload.RateBands).
+				SetAddresses(tokenPayload.Addresses)
+
+			// Keep the current slippage tolerance unless a new one is set
+			if tokenPayload.RateSlippageType != "" {
+				tokenUpdate.
+					SetRateSlippage(tokenPayload.RateSlippage).
+					SetRateSlippageType(tokenPayload.RateSlippageType)
+			}
+
+			orderToken, err = tokenUpdate.Save(ctx)
 			if err != nil {
 				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to set token - "+tokenPayload.Symbol, nil)
 				return
 			}
 		}
 
-		rate, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, tokenPayload.Symbol)
-		if err != nil {
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to set token", nil)
-			return
-		}
-
-		// Add provider to buckets
+		// Add provider to buckets, pricing the order amount range with its rate bands
+		minFiatAmount, maxFiatAmount := svc.ProviderTokenFiatRange(orderToken, currency.MarketRate)
 		buckets, err := storage.Client.ProvisionBucket.
 			Query().
 			Where(
 				provisionbucket.Or(
-					provisionbucket.MinAmountLTE(tokenPayload.MinOrderAmount.Mul(rate)),
-					provisionbucket.MinAmountLTE(tokenPayload.MaxOrderAmount.Mul(rate)),
-					provisionbucket.MaxAmountGTE(tokenPayload.MaxOrderAmount.Mul(rate)),
+					provisionbucket.MinAmountLTE(minFiatAmount),
+					provisionbucket.MinAmountLTE(maxFiatAmount),
+					provisionbucket.MaxAmountGTE(maxFiatAmount),
 				),
 			).
 			All(ctx)
@@ -511,6 +735,9 @@ func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 				tq.WithNetwork()
 			},
 		).
+		WithFeeTiers(func(ftq *ent.SenderFeeTierQuery) {
+			ftq.Order(ent.Asc(senderfeetier.FieldMinVolume))
+		}).
 		All(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
@@ -526,21 +753,46 @@ func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 			FeePercent:    token.FeePercent,
 			FeeAddress:    token.FeeAddress,
 			Network:       token.Edges.Token.Edges.Network.Identifier,
+			FeeTiers:      make([]types.SenderFeeTierResponse, len(token.Edges.FeeTiers)),
+		}
+
+		for j, tier := range token.Edges.FeeTiers {
+			payload.FeeTiers[j] = types.SenderFeeTierResponse{
+				Name:       tier.Name,
+				MinVolume:  tier.MinVolume,
+				FeePercent: tier.FeePercent,
+				MinFee:     tier.MinFee,
+				MaxFee:     tier.MaxFee,
+			}
+			if !tier.StartsAt.IsZero() {
+				payload.FeeTiers[j].StartsAt = &tier.StartsAt
+			}
+			if !tier.EndsAt.IsZero() {
+				payload.FeeTiers[j].EndsAt = &tier.EndsAt
+			}
 		}
 
 		tokensPayload[i] = payload
 	}
 
 	response := &types.SenderProfileResponse{
-		ID:              sender.ID,
-		FirstName:       user.FirstName,
-		LastName:        user.LastName,
-		Email:           user.Email,
-		WebhookURL:      sender.WebhookURL,
-		DomainWhitelist: sender.DomainWhitelist,
-		Tokens:          tokensPayload,
-		APIKey:          *apiKey,
-		IsActive:        sender.IsActive,
+		ID:                 sender.ID,
+		FirstName:          user.FirstName,
+		LastName:           user.LastName,
+		Email:              user.Email,
+		WebhookURL:         sender.WebhookURL,
+		DomainWhitelist:    sender.DomainWhitelist,
+		Tokens:             tokensPayload,
+		APIKey:             *apiKey,
+		IsActive:           sender.IsActive,
+		UnderpaymentPolicy: sender.UnderpaymentPolicy,
+		OverpaymentPolicy:  sender.OverpaymentPolicy,
+		Role:               getProfileRole(ctx, "sender_role"),
+	}
+
+	// Only roles that manage API keys can see the secret
+	if !u.HasTeamPermission(response.Role, types.PermissionKeysManage) {
+		response.APIKey.Secret = ""
 	}
 
 	linkedProvider, err := storage.Client.ProviderProfile.
@@ -608,6 +860,9 @@ func (ctrl *ProfileController) GetProviderProfile(ctx *gin.Context) {
 			FloatingConversionRate: token.FloatingConversionRate,
 			MaxOrderAmount:         token.MaxOrderAmount,
 			MinOrderAmount:         token.MinOrderAmount,
+			RateSlippage:           token.RateSlippage,
+			RateSlippageType:       token.RateSlippageType,
+			RateBands:              token.RateBands,
 			Addresses: make([]struct {
 				Address string `json:"address"`
 				Network string `json:"network"`
@@ -635,26 +890,77 @@ func (ctrl *ProfileController) GetProviderProfile(ctx *gin.Context) {
 		return
 	}
 
+	// Only roles that manage API keys can see the secret
+	role := getProfileRole(ctx, "provider_role")
+	if !u.HasTeamPermission(role, types.PermissionKeysManage) {
+		apiKey.Secret = ""
+	}
+
 	u.APIResponse(ctx, http.StatusOK, "success", "Profile retrieved successfully", &types.ProviderProfileResponse{
-		ID:                   provider.ID,
-		FirstName:            user.FirstName,
-		LastName:             user.LastName,
-		Email:                user.Email,
-		TradingName:          provider.TradingName,
-		Currency:             currency.Code,
-		HostIdentifier:       provider.HostIdentifier,
-		IsAvailable:          provider.IsAvailable,
-		Tokens:               tokensPayload,
-		APIKey:               *apiKey,
-		IsActive:             provider.IsActive,
-		Address:              provider.Address,
-		MobileNumber:         provider.MobileNumber,
-		DateOfBirth:          provider.DateOfBirth,
-		BusinessName:         provider.BusinessName,
-		VisibilityMode:       provider.VisibilityMode,
-		IdentityDocumentType: provider.IdentityDocumentType,
-		IdentityDocument:     provider.IdentityDocument,
-		BusinessDocument:     provider.BusinessDocument,
-		IsKybVerified:        provider.IsKybVerified,
+		ID:                      provider.ID,
+		FirstName:               user.FirstName,
+		LastName:                user.LastName,
+		Email:                   user.Email,
+		TradingName:             provider.TradingName,
+		Currency:                currency.Code,
+		HostIdentifier:          provider.HostIdentifier,
+		IsAvailable:             provider.IsAvailable,
+		Timezone:                provider.Timezone,
+		OperatingHours:          provider.OperatingHours,
+		Holidays:                provider.Holidays,
+		SupportedInstitutions:   provider.SupportedInstitutions,
+		UnsupportedInstitutions: provider.UnsupportedInstitutions,
+		AutoAdjustStaleRates:    provider.AutoAdjustStaleRates,
+		Tokens:                  tokensPayload,
+		APIKey:                  *apiKey,
+		IsActive:                provider.IsActive,
+		Address:                 provider.Address,
+		MobileNumber:            provider.MobileNumber,
+		DateOfBirth:             provider.DateOfBirth,
+		BusinessName:            provider.BusinessName,
+		VisibilityMode:          provider.VisibilityMode,
+		IdentityDocumentType:    provider.IdentityDocumentType,
+		IdentityDocument:        provider.IdentityDocument,
+		BusinessDocument:        provider.BusinessDocument,
+		IsKybVerified:           provider.IsKybVerified,
+		Role:                    role,
 	})
 }
+
+// getProfileRole returns the role of the user on the profile in the request context.
+// Requests without a team role are made by the profile owner.
+func getProfileRole(ctx *gin.Context, key string) teammember.Role {
+	if role, ok := ctx.Get(key); ok {
+		return role.(teammember.Role)
+	}
+	return teammember.RoleOwner
+}
+
+// validateFeeTiers checks the fee tiers of a sender token payload
+func validateFeeTiers(tiers []types.SenderFeeTierPayload) error {
+	names := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if names[tier.Name] {
+			return fmt.Errorf("duplicate fee tier name %s", tier.Name)
+		}
+		names[tier.Name] = true
+
+		if tier.FeePercent.LessThan(decimal.Zero) || tier.FeePercent.GreaterThan(decimal.NewFromInt(100)) {
+			return fmt.Errorf("fee percent of %s must be between 0 and 100", tier.Name)
+		}
+
+		if tier.MinVolume.LessThan(decimal.Zero) || tier.MinFee.LessThan(decimal.Zero) || tier.MaxFee.LessThan(decimal.Zero) {
+			return fmt.Errorf("volume and fee caps of %s must not be negative", tier.Name)
+		}
+
+		if tier.MaxFee.GreaterThan(decimal.Zero) && tier.MaxFee.LessThan(tier.MinFee) {
+			return fmt.Errorf("max fee of %s must not be less than its min fee", tier.Name)
+		}
+
+		if !tier.StartsAt.IsZero() && !tier.EndsAt.IsZero() && !tier.EndsAt.After(tier.StartsAt) {
+			return fmt.Errorf("end date of %s must be after its start date", tier.Name)
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/ent/enttest"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	tokenDB "github.com/paycrest/aggregator/ent/token"
@@ -327,6 +328,111 @@ func TestProfile(t *testing.T) {
 			assert.True(t, senderProfile.IsActive)
 		})
 
+		t.Run("with fee tiers", func(t *testing.T) {
+			testUser, err := test.CreateTestUser(map[string]interface{}{
+				"scope": "sender",
+				"email": "johndoe4@test.com",
+			})
+			assert.NoError(t, err)
+
+			sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+				"user_id": testUser.ID,
+				"token":   testCtx.token.Symbol,
+			})
+			assert.NoError(t, err)
+
+			apiKeyService := services.NewAPIKeyService()
+			_, _, err = apiKeyService.GenerateAPIKey(
+				context.Background(),
+				nil,
+				sender,
+				nil,
+			)
+			assert.NoError(t, err)
+
+			accessToken, _ := token.GenerateAccessJWT(testUser.ID.String(), "sender")
+			headers := map[string]string{
+				"Authorization": "Bearer " + accessToken,
+			}
+
+			tokenPayload := types.SenderOrderTokenPayload{
+				Symbol:     testCtx.token.Symbol,
+				FeePercent: decimal.NewFromInt(1),
+				Addresses: []types.SenderOrderAddressPayload{{
+					Network:       testCtx.token.Edges.Network.Identifier,
+					FeeAddress:    "0xD4EB9067111F81b9bAabE06E2b8ebBaDADEd5DAf",
+					RefundAddress: "0xD4EB9067111F81b9bAabE06E2b8ebBaDADEd5DA0",
+				}},
+				FeeTiers: []types.SenderFeeTierPayload{
+					{
+						Name:       "standard",
+						FeePercent: decimal.NewFromInt(1),
+						MinFee:     decimal.NewFromFloat(0.5),
+					},
+					{
+						Name:       "volume",
+						MinVolume:  decimal.NewFromInt(10000),
+						FeePercent: decimal.NewFromFloat(0.5),
+						MaxFee:     decimal.NewFromInt(20),
+					},
+					{
+						Name:       "launch-promo",
+						FeePercent: decimal.Zero,
+						StartsAt:   time.Now(),
+						EndsAt:     time.Now().Add(7 * 24 * time.Hour),
+					},
+				},
+			}
+
+			res, err := test.PerformRequest(t, "PATCH", "/settings/sender", types.SenderProfilePayload{
+				Tokens: []types.SenderOrderTokenPayload{tokenPayload},
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			res, err = test.PerformRequest(t, "GET", "/settings/sender", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.SenderProfileResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data.Tokens, 1)
+
+			tiers := response.Data.Tokens[0].FeeTiers
+			assert.Len(t, tiers, 3)
+			assert.Equal(t, "volume", tiers[2].Name)
+			assert.True(t, tiers[2].MaxFee.Equal(decimal.NewFromInt(20)))
+
+			// Tiers are replaced on the next update, once per network
+			tokenPayload.FeeTiers = tokenPayload.FeeTiers[:1]
+			tokenPayload.Addresses = append(tokenPayload.Addresses, tokenPayload.Addresses[0])
+			res, err = test.PerformRequest(t, "PATCH", "/settings/sender", types.SenderProfilePayload{
+				Tokens: []types.SenderOrderTokenPayload{tokenPayload},
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			count, err := db.Client.SenderFeeTier.
+				Query().
+				Where(senderfeetier.HasSenderOrderTokenWith(
+					senderordertoken.HasSenderWith(senderprofile.HasUserWith(user.ID(testUser.ID))),
+				)).
+				Count(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			// Tiers with a max fee below their min fee are rejected
+			tokenPayload.FeeTiers[0].MaxFee = decimal.NewFromFloat(0.1)
+			res, err = test.PerformRequest(t, "PATCH", "/settings/sender", types.SenderProfilePayload{
+				Tokens: []types.SenderOrderTokenPayload{tokenPayload},
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
 	})
 
 	t.Run("UpdateProviderProfile", func(t *testing.T) {
@@ -405,6 +511,44 @@ func TestProfile(t *testing.T) {
 			assert.Equal(t, 1, providerProfile)
 		})
 
+		t.Run("with a fixed rate band without a rate", func(t *testing.T) {
+			accessToken, _ := token.GenerateAccessJWT(testCtx.user.ID.String(), "provider")
+			headers := map[string]string{
+				"Authorization": "Bearer " + accessToken,
+			}
+			payload := map[string]interface{}{
+				"tradingName":    testCtx.providerProfile.TradingName,
+				"hostIdentifier": testCtx.providerProfile.HostIdentifier,
+				"currency":       "KES",
+				"tokens": []map[string]interface{}{{
+					"symbol":                 testCtx.token.Symbol,
+					"conversionRateType":     "fixed",
+					"fixedConversionRate":    "130",
+					"floatingConversionRate": "0",
+					"minOrderAmount":         "1",
+					"maxOrderAmount":         "1000",
+					"rateBands": []map[string]interface{}{{
+						"minAmount":              "100",
+						"maxAmount":              "1000",
+						"floatingConversionRate": "1",
+					}},
+					"addresses": []map[string]interface{}{{
+						"address": "0xD4EB9067111F81b9bAabE06E2b8ebBaDADEd5DA0",
+						"network": testCtx.token.Edges.Network.Identifier,
+					}},
+				}},
+			}
+
+			res, err := test.PerformRequest(t, "PATCH", "/settings/provider", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "RateBands", response.Data.(map[string]interface{})["field"])
+		})
+
 		t.Run("with optional fields", func(t *testing.T) {
 			profileUpdateRequest := func(payload types.ProviderProfilePayload) *httptest.ResponseRecorder {
 				// Test partial update
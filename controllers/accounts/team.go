@@ -0,0 +1,404 @@
+package accounts
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
+	userEnt "github.com/paycrest/aggregator/ent/user"
+	svc "github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// TeamController is the controller type for the team member endpoints
+type TeamController struct {
+	emailService *svc.EmailService
+}
+
+// NewTeamController creates a new instance of TeamController with injected services
+func NewTeamController() *TeamController {
+	return &TeamController{
+		emailService: svc.NewEmailService(svc.SENDGRID_MAIL_PROVIDER),
+	}
+}
+
+// ListTeamMembers lists the owner and members of the sender or provider team
+func (ctrl *TeamController) ListTeamMembers(ctx *gin.Context) {
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	owner, err := getTeamOwner(ctx, sender, provider)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch team members", nil)
+		return
+	}
+
+	members, err := db.Client.TeamMember.
+		Query().
+		Where(teamMemberOfProfile(sender, provider)).
+		WithUser().
+		Order(ent.Asc(teammember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch team members", nil)
+		return
+	}
+
+	response := []types.TeamMemberResponse{{
+		ID:        owner.ID,
+		Email:     owner.Email,
+		FirstName: owner.FirstName,
+		LastName:  owner.LastName,
+		Role:      teammember.RoleOwner,
+		Status:    teammember.StatusActive,
+		CreatedAt: owner.CreatedAt,
+	}}
+	for _, member := range members {
+		response = append(response, teamMemberResponse(member))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team members fetched successfully", response)
+}
+
+// InviteTeamMember invites a user by email to join the sender or provider team with a role
+func (ctrl *TeamController) InviteTeamMember(ctx *gin.Context) {
+	var payload types.InviteTeamMemberPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	owner, err := getTeamOwner(ctx, sender, provider)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to invite team member", nil)
+		return
+	}
+
+	email := strings.ToLower(payload.Email)
+	if email == owner.Email {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "User is already a member of this team", nil)
+		return
+	}
+
+	exists, err := db.Client.TeamMember.
+		Query().
+		Where(
+			teamMemberOfProfile(sender, provider),
+			teammember.EmailEQ(email),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to invite team member", nil)
+		return
+	} else if exists {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "User is already a member of this team", nil)
+		return
+	}
+
+	memberCreate := db.Client.TeamMember.
+		Create().
+		SetEmail(email).
+		SetRole(payload.Role)
+	if sender != nil {
+		memberCreate.SetSenderProfile(sender)
+	} else {
+		memberCreate.SetProviderProfile(provider)
+	}
+
+	member, err := memberCreate.Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to invite team member", nil)
+		return
+	}
+
+	// Send the invitation email on behalf of the inviting user
+	inviterName := fmt.Sprintf("%s %s", owner.FirstName, owner.LastName)
+	if userID, err := uuid.Parse(ctx.GetString("user_id")); err == nil && userID != owner.ID {
+		if inviter, err := db.Client.User.Get(ctx, userID); err == nil {
+			inviterName = fmt.Sprintf("%s %s", inviter.FirstName, inviter.LastName)
+		}
+	}
+
+	if _, err := ctrl.emailService.SendTeamInvitationEmail(ctx, member.InviteToken, member.Email, inviterName, string(member.Role)); err != nil {
+		logger.Errorf("error: %v", err)
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Team member invited successfully", teamMemberResponse(member))
+}
+
+// UpdateTeamMember changes the role of a member of the sender or provider team
+func (ctrl *TeamController) UpdateTeamMember(ctx *gin.Context) {
+	var payload types.UpdateTeamMemberPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	member, ok := getTeamMember(ctx)
+	if !ok {
+		return
+	}
+
+	user := member.Edges.User
+	member, err := member.Update().
+		SetRole(payload.Role).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		return
+	}
+	member.Edges.User = user
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team member updated successfully", teamMemberResponse(member))
+}
+
+// RemoveTeamMember removes a member or pending invitation from the sender or provider team
+func (ctrl *TeamController) RemoveTeamMember(ctx *gin.Context) {
+	member, ok := getTeamMember(ctx)
+	if !ok {
+		return
+	}
+
+	if err := db.Client.TeamMember.DeleteOne(member).Exec(ctx); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team member removed successfully", nil)
+}
+
+// AcceptTeamInvite accepts a team invitation with the token sent to the invited email.
+// A new user is created for the invited email if it does not belong to any user yet.
+func (ctrl *TeamController) AcceptTeamInvite(ctx *gin.Context) {
+	var payload types.AcceptTeamInvitePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	member, err := db.Client.TeamMember.
+		Query().
+		Where(
+			teammember.InviteTokenEQ(payload.Token),
+			teammember.StatusEQ(teammember.StatusInvited),
+		).
+		WithSenderProfile().
+		WithProviderProfile().
+		Only(ctx)
+	if err != nil || member.InviteExpiryAt.Before(time.Now()) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid or expired invitation token", nil)
+		return
+	}
+
+	scope := "sender"
+	if member.Edges.ProviderProfile != nil {
+		scope = "provider"
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	user, err := tx.User.
+		Query().
+		Where(userEnt.EmailEQ(member.Email)).
+		WithSenderProfile().
+		WithProviderProfile().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	if user != nil {
+		// A user acts on the profile they own, so they can't also join another team of the same scope
+		if (scope == "sender" && user.Edges.SenderProfile != nil) || (scope == "provider" && user.Edges.ProviderProfile != nil) {
+			_ = tx.Rollback()
+			u.APIResponse(ctx, http.StatusBadRequest, "error",
+				fmt.Sprintf("User already has a %s profile", scope), nil)
+			return
+		}
+
+		scopes := strings.Split(user.Scope, " ")
+		if !u.ContainsString(scopes, scope) {
+			user, err = user.Update().
+				SetScope(strings.Join(append(scopes, scope), " ")).
+				Save(ctx)
+			if err != nil {
+				_ = tx.Rollback()
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+				return
+			}
+		}
+	} else {
+		// The invitation token proves ownership of the email, so the new user is verified
+		if payload.FirstName == "" || payload.LastName == "" || payload.Password == "" {
+			_ = tx.Rollback()
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "FirstName, LastName, Password",
+				Message: "This field is required for a new user",
+			})
+			return
+		}
+
+		user, err = tx.User.
+			Create().
+			SetFirstName(payload.FirstName).
+			SetLastName(payload.LastName).
+			SetEmail(member.Email).
+			SetPassword(payload.Password).
+			SetScope(scope).
+			SetIsEmailVerified(true).
+			SetHasEarlyAccess(true).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+			return
+		}
+	}
+
+	member, err = tx.TeamMember.
+		UpdateOneID(member.ID).
+		SetUser(user).
+		SetStatus(teammember.StatusActive).
+		ClearInviteToken().
+		ClearInviteExpiryAt().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	member.Edges.User = user
+	u.APIResponse(ctx, http.StatusOK, "success", "Invitation accepted successfully", teamMemberResponse(member))
+}
+
+// getRouteProfile returns the sender or provider profile whose settings are managed on the route
+func getRouteProfile(ctx *gin.Context) (*ent.SenderProfile, *ent.ProviderProfile, bool) {
+	if strings.Contains(ctx.FullPath(), "/provider") {
+		providerCtx, _ := ctx.Get("provider")
+		provider, ok := providerCtx.(*ent.ProviderProfile)
+		return nil, provider, ok && provider != nil
+	}
+
+	senderCtx, _ := ctx.Get("sender")
+	sender, ok := senderCtx.(*ent.SenderProfile)
+	return sender, nil, ok && sender != nil
+}
+
+// getTeamOwner returns the user who owns the sender or provider profile
+func getTeamOwner(ctx *gin.Context, sender *ent.SenderProfile, provider *ent.ProviderProfile) (*ent.User, error) {
+	if sender != nil {
+		return sender.QueryUser().Only(ctx)
+	}
+	return provider.QueryUser().Only(ctx)
+}
+
+// getTeamMember fetches the team member in the route param from the team on the route.
+// It writes the error response when the member can't be fetched.
+func getTeamMember(ctx *gin.Context) (*ent.TeamMember, bool) {
+	sender, provider, ok := getRouteProfile(ctx)
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+
+	memberID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid team member ID", nil)
+		return nil, false
+	}
+
+	member, err := db.Client.TeamMember.
+		Query().
+		Where(
+			teammember.IDEQ(memberID),
+			teamMemberOfProfile(sender, provider),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Team member not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch team member", nil)
+		}
+		return nil, false
+	}
+
+	return member, true
+}
+
+// teamMemberOfProfile is a predicate for the members of a sender or provider team
+func teamMemberOfProfile(sender *ent.SenderProfile, provider *ent.ProviderProfile) predicate.TeamMember {
+	if sender != nil {
+		return teammember.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))
+	}
+	return teammember.HasProviderProfileWith(providerprofile.IDEQ(provider.ID))
+}
+
+// teamMemberResponse converts a team member to its response type
+func teamMemberResponse(member *ent.TeamMember) types.TeamMemberResponse {
+	response := types.TeamMemberResponse{
+		ID:        member.ID,
+		Email:     member.Email,
+		Role:      member.Role,
+		Status:    member.Status,
+		CreatedAt: member.CreatedAt,
+	}
+	if member.Edges.User != nil {
+		response.FirstName = member.Edges.User.FirstName
+		response.LastName = member.Edges.User.LastName
+	}
+	return response
+}
@@ -0,0 +1,253 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/teammember"
+	"github.com/paycrest/aggregator/routers/middleware"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/paycrest/aggregator/utils/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeam(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:team?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Mock the invitation emails
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	httpmock.RegisterRegexpResponder("POST", regexp.MustCompile(`/v3/mail/send`),
+		func(r *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(202, nil)
+			resp.Header.Set("X-Message-Id", "thisisatestid")
+			return resp, nil
+		},
+	)
+
+	// Setup test data
+	owner, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "teamowner@test.com",
+	})
+	assert.NoError(t, err)
+
+	_, err = db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(owner.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewTeamController()
+
+	v1 := router.Group("/v1/")
+	v1.GET("settings/sender/team", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.ListTeamMembers)
+	v1.POST("settings/sender/team", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.InviteTeamMember)
+	v1.PATCH("settings/sender/team/:id", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.UpdateTeamMember)
+	v1.DELETE("settings/sender/team/:id", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ctrl.RemoveTeamMember)
+	v1.POST("auth/accept-invite", ctrl.AcceptTeamInvite)
+
+	ok := func(ctx *gin.Context) { u.APIResponse(ctx, http.StatusOK, "success", "OK", nil) }
+	v1.GET("sender/orders/export", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ok)
+	v1.POST("sender/orders", middleware.JWTMiddleware, middleware.OnlySenderMiddleware, ok)
+
+	ownerToken, _ := token.GenerateAccessJWT(owner.ID.String(), "sender")
+	ownerHeaders := map[string]string{
+		"Authorization": "Bearer " + ownerToken,
+	}
+
+	var memberID string
+
+	t.Run("InviteTeamMember", func(t *testing.T) {
+		t.Run("with a valid payload", func(t *testing.T) {
+			payload := types.InviteTeamMemberPayload{
+				Email: "Finance@test.com",
+				Role:  teammember.RoleFinance,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/team", payload, ownerHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data types.TeamMemberResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "finance@test.com", response.Data.Email)
+			assert.Equal(t, teammember.StatusInvited, response.Data.Status)
+			memberID = response.Data.ID.String()
+		})
+
+		t.Run("with an existing member", func(t *testing.T) {
+			payload := types.InviteTeamMemberPayload{
+				Email: "finance@test.com",
+				Role:  teammember.RoleAdmin,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/team", payload, ownerHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with the owner role", func(t *testing.T) {
+			payload := types.InviteTeamMemberPayload{
+				Email: "another@test.com",
+				Role:  teammember.RoleOwner,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/team", payload, ownerHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("AcceptTeamInvite", func(t *testing.T) {
+		member, err := db.Client.TeamMember.
+			Query().
+			Where(teammember.EmailEQ("finance@test.com")).
+			Only(context.Background())
+		assert.NoError(t, err)
+
+		t.Run("without the details of a new user", func(t *testing.T) {
+			payload := types.AcceptTeamInvitePayload{
+				Token: member.InviteToken,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/auth/accept-invite", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with a valid token", func(t *testing.T) {
+			payload := types.AcceptTeamInvitePayload{
+				Token:     member.InviteToken,
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Password:  "password",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/auth/accept-invite", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			member, err = db.Client.TeamMember.
+				Query().
+				Where(teammember.EmailEQ("finance@test.com")).
+				WithUser().
+				Only(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, teammember.StatusActive, member.Status)
+			assert.Equal(t, "sender", member.Edges.User.Scope)
+			assert.Empty(t, member.InviteToken)
+		})
+
+		t.Run("with a used token", func(t *testing.T) {
+			payload := types.AcceptTeamInvitePayload{
+				Token: "used-token",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/auth/accept-invite", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("Permissions", func(t *testing.T) {
+		member, err := db.Client.TeamMember.
+			Query().
+			Where(teammember.EmailEQ("finance@test.com")).
+			WithUser().
+			Only(context.Background())
+		assert.NoError(t, err)
+
+		memberToken, _ := token.GenerateAccessJWT(member.Edges.User.ID.String(), "sender")
+		memberHeaders := map[string]string{
+			"Authorization": "Bearer " + memberToken,
+		}
+
+		t.Run("finance can export orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/sender/orders/export", nil, memberHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+		})
+
+		t.Run("finance cannot create orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", map[string]interface{}{}, memberHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, res.Code)
+		})
+
+		t.Run("finance cannot invite team members", func(t *testing.T) {
+			payload := types.InviteTeamMemberPayload{
+				Email: "developer@test.com",
+				Role:  teammember.RoleDeveloper,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/settings/sender/team", payload, memberHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, res.Code)
+		})
+
+		t.Run("members are listed after the owner", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/settings/sender/team", nil, memberHeaders, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.TeamMemberResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 2)
+			assert.Equal(t, teammember.RoleOwner, response.Data[0].Role)
+			assert.Equal(t, "Jane", response.Data[1].FirstName)
+		})
+	})
+
+	t.Run("UpdateTeamMember", func(t *testing.T) {
+		payload := types.UpdateTeamMemberPayload{
+			Role: teammember.RoleDeveloper,
+		}
+
+		res, err := test.PerformRequest(t, "PATCH", "/v1/settings/sender/team/"+memberID, payload, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.TeamMemberResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, teammember.RoleDeveloper, response.Data.Role)
+	})
+
+	t.Run("RemoveTeamMember", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "DELETE", "/v1/settings/sender/team/"+memberID, nil, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		count, err := db.Client.TeamMember.Query().Count(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
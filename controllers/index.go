@@ -175,75 +175,19 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 			}
 		}
 
-		rateResponse, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, token.Symbol)
+		rateResponse, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, token.Symbol, tokenAmount)
 		if err != nil {
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider rate", nil)
 			return
 		}
 
 	} else {
-		// Get redis keys for provision buckets
-		keys, _, err := storage.RedisClient.Scan(ctx, uint64(0), "bucket_"+currency.Code+"_*_*", 100).Result()
+		// Get rate from the bucket queues
+		rateResponse, err = u.GetTokenRateFromQueue(token.Symbol, tokenAmount, currency.Code, currency.MarketRate)
 		if err != nil {
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch rates", nil)
 			return
 		}
-
-		highestMaxAmount := decimal.NewFromInt(0)
-
-		// Scan through the buckets to find a matching rate
-		for _, key := range keys {
-			bucketData := strings.Split(key, "_")
-			minAmount, _ := decimal.NewFromString(bucketData[2])
-			maxAmount, _ := decimal.NewFromString(bucketData[3])
-
-			for index := 0; ; index++ {
-				// Get the topmost provider in the priority queue of the bucket
-				providerData, err := storage.RedisClient.LIndex(ctx, key, int64(index)).Result()
-				if err != nil {
-					break
-				}
-				parts := strings.Split(providerData, ":")
-				if len(parts) != 5 {
-					logger.Errorf("GetTokenRate.InvalidProviderData: %v", providerData)
-					continue
-				}
-
-				// Skip entry if token doesn't match
-				if parts[1] != token.Symbol {
-					continue
-				}
-
-				// Skip entry if order amount is not within provider's min and max order amount
-				minOrderAmount, err := decimal.NewFromString(parts[3])
-				if err != nil {
-					continue
-				}
-
-				maxOrderAmount, err := decimal.NewFromString(parts[4])
-				if err != nil {
-					continue
-				}
-
-				if tokenAmount.LessThan(minOrderAmount) || tokenAmount.GreaterThan(maxOrderAmount) {
-					continue
-				}
-
-				// Get fiat equivalent of the token amount
-				rate, _ := decimal.NewFromString(parts[2])
-				fiatAmount := tokenAmount.Mul(rate)
-
-				// Check if fiat amount is within the bucket range and set the rate
-				if fiatAmount.GreaterThanOrEqual(minAmount) && fiatAmount.LessThanOrEqual(maxAmount) {
-					rateResponse = rate
-					break
-				} else if maxAmount.GreaterThan(highestMaxAmount) {
-					// Get the highest max amount
-					highestMaxAmount = maxAmount
-					rateResponse = rate
-				}
-			}
-		}
 	}
 
 	u.APIResponse(ctx, http.StatusOK, "success", "Rate fetched successfully", rateResponse)
@@ -285,49 +229,14 @@ func (ctrl *Controller) VerifyAccount(ctx *gin.Context) {
 		return
 	}
 
-	providers, err := storage.Client.ProviderProfile.
-		Query().
-		Where(
-			providerprofile.HasCurrencyWith(
-				fiatcurrency.CodeEQ(institution.Edges.FiatCurrency.Code),
-			),
-			providerprofile.HostIdentifierNotNil(),
-			providerprofile.IsActiveEQ(true),
-			providerprofile.IsAvailableEQ(true),
-		).
-		All(ctx)
+	accountName, err := u.VerifyAccountName(ctx, institution.Edges.FiatCurrency.Code, payload)
 	if err != nil {
 		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusBadRequest, "error",
-			"Failed to verify account", err.Error())
-		return
-	}
-
-	var res fastshot.Response
-	var data map[string]interface{}
-	for _, provider := range providers {
-		res, err = fastshot.NewClient(provider.HostIdentifier).
-			Config().SetTimeout(30 * time.Second).
-			Build().POST("/verify_account").
-			Body().AsJSON(payload).
-			Send()
-		if err != nil {
-			continue
-		}
-
-		data, err = u.ParseJSONResponse(res.RawResponse)
-		if err != nil {
-			continue
-		}
-	}
-
-	if err != nil {
-		logger.Errorf("error: %v %v", err, data)
 		u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "Failed to verify account", nil)
 		return
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "Account name was fetched successfully", data["data"].(string))
+	u.APIResponse(ctx, http.StatusOK, "success", "Account name was fetched successfully", accountName)
 }
 
 // GetLockPaymentOrderStatus controller fetches a payment order status by ID
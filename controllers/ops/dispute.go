@@ -0,0 +1,163 @@
+package ops
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// OpsController is the controller type for ops endpoints
+type OpsController struct {
+	disputeService *svc.DisputeService
+}
+
+// NewOpsController creates a new instance of OpsController
+func NewOpsController() *OpsController {
+	return &OpsController{
+		disputeService: svc.NewDisputeService(),
+	}
+}
+
+// GetDisputes controller fetches disputes, optionally filtered by status, with the most urgent first
+func (ctrl *OpsController) GetDisputes(ctx *gin.Context) {
+	query := storage.Client.Dispute.Query()
+
+	if status := ctx.Query("status"); status != "" {
+		if err := dispute.StatusValidator(dispute.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status filter", nil)
+			return
+		}
+		query = query.Where(dispute.StatusEQ(dispute.Status(status)))
+	}
+
+	// Get page and pageSize query params
+	page, offset, pageSize := u.Paginate(ctx)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	disputes, err := query.
+		WithLockPaymentOrder().
+		Limit(pageSize).
+		Offset(offset).
+		Order(ent.Asc(dispute.FieldResolutionDueAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	response := make([]types.DisputeResponse, len(disputes))
+	for i, d := range disputes {
+		response[i] = svc.DisputeResponse(d, d.Edges.LockPaymentOrder.ID)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", &types.DisputeList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Disputes:     response,
+	})
+}
+
+// RequestDisputeEvidence controller asks the provider for more evidence on a dispute
+func (ctrl *OpsController) RequestDisputeEvidence(ctx *gin.Context) {
+	var payload types.RequestDisputeEvidencePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	d, ok := getDispute(ctx)
+	if !ok {
+		return
+	}
+
+	d, err := ctrl.disputeService.RequestEvidence(ctx, d, payload.Note)
+	if err != nil {
+		if err == svc.ErrDisputeClosed {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to request evidence", err.Error())
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to request evidence", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Evidence requested successfully", svc.DisputeResponse(d, d.Edges.LockPaymentOrder.ID))
+}
+
+// ResolveDispute controller closes a dispute with the outcome of the review
+func (ctrl *OpsController) ResolveDispute(ctx *gin.Context) {
+	var payload types.ResolveDisputePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.Status == string(dispute.StatusRejected) && payload.ProviderAtFault {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "ProviderAtFault",
+			Message: "A rejected dispute cannot be held against the provider",
+		})
+		return
+	}
+
+	d, ok := getDispute(ctx)
+	if !ok {
+		return
+	}
+
+	d, err := ctrl.disputeService.ResolveDispute(ctx, d, dispute.Status(payload.Status), payload.ProviderAtFault, payload.Resolution)
+	if err != nil {
+		if err == svc.ErrDisputeClosed {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to resolve dispute", err.Error())
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resolve dispute", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute resolved successfully", svc.DisputeResponse(d, d.Edges.LockPaymentOrder.ID))
+}
+
+// getDispute fetches the dispute in the URL.
+// It responds with an error and returns false when the dispute can't be fetched
+func getDispute(ctx *gin.Context) (*ent.Dispute, bool) {
+	disputeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute ID", nil)
+		return nil, false
+	}
+
+	d, err := storage.Client.Dispute.Get(ctx, disputeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dispute not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dispute", nil)
+		}
+		return nil, false
+	}
+
+	return d, true
+}
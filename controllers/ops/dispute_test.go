@@ -0,0 +1,226 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisputes(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:ops_disputes?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
+	// Keep provider notification emails off the network
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	// Setup test data
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "provider",
+		"email": "ops.disputes@test.com",
+	})
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"currency_id": currency.ID,
+	})
+	assert.NoError(t, err)
+
+	createDispute := func(status dispute.Status, resolutionDueAt time.Time) *ent.Dispute {
+		lockOrder, err := db.Client.LockPaymentOrder.
+			Create().
+			SetGatewayID("0xdispute").
+			SetAmount(decimal.NewFromInt(100)).
+			SetRate(decimal.NewFromInt(1500)).
+			SetAmountFulfilled(decimal.NewFromInt(150000)).
+			SetOrderPercent(decimal.NewFromInt(100)).
+			SetBlockNumber(12345).
+			SetInstitution("ABNGNGLA").
+			SetAccountIdentifier("0123456789").
+			SetAccountName("John Doe").
+			SetStatus(lockpaymentorder.StatusSettled).
+			SetToken(token).
+			SetProvider(provider).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		d, err := db.Client.Dispute.
+			Create().
+			SetLockPaymentOrder(lockOrder).
+			SetReason("Recipient did not receive the funds").
+			SetStatus(status).
+			SetEvidenceDueAt(time.Now().Add(-time.Hour)).
+			SetResolutionDueAt(resolutionDueAt).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		return d
+	}
+
+	openDispute := createDispute(dispute.StatusOpen, time.Now().Add(24*time.Hour))
+	urgentDispute := createDispute(dispute.StatusOpen, time.Now().Add(time.Hour))
+	rejectedDispute := createDispute(dispute.StatusRejected, time.Now().Add(48*time.Hour))
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewOpsController()
+
+	v1 := router.Group("/v1/ops/")
+	v1.GET("disputes", ctrl.GetDisputes)
+	v1.POST("disputes/:id/request-evidence", ctrl.RequestDisputeEvidence)
+	v1.POST("disputes/:id/resolve", ctrl.ResolveDispute)
+
+	disputePath := func(d *ent.Dispute, action string) string {
+		return "/v1/ops/disputes/" + d.ID.String() + "/" + action
+	}
+
+	t.Run("GetDisputes", func(t *testing.T) {
+		t.Run("lists the most urgent disputes first", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/ops/disputes?status=open", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.DisputeList `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, response.Data.TotalRecords)
+			assert.Len(t, response.Data.Disputes, 2)
+			assert.Equal(t, urgentDispute.ID, response.Data.Disputes[0].ID)
+		})
+	})
+
+	t.Run("RequestDisputeEvidence", func(t *testing.T) {
+		t.Run("on a closed dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(rejectedDispute, "request-evidence"), map[string]interface{}{}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("restarts the evidence deadline", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(urgentDispute, "request-evidence"), map[string]interface{}{
+				"note": "Please share the PSP receipt",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			d, err := db.Client.Dispute.Get(context.Background(), urgentDispute.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, dispute.StatusAwaitingProviderEvidence, d.Status)
+			assert.True(t, d.EvidenceDueAt.After(time.Now()))
+		})
+	})
+
+	t.Run("ResolveDispute", func(t *testing.T) {
+		t.Run("without a resolution", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(openDispute, "resolve"), map[string]interface{}{
+				"status": "resolved",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("when a rejected dispute is held against the provider", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(openDispute, "resolve"), map[string]interface{}{
+				"status":          "rejected",
+				"providerAtFault": true,
+				"resolution":      "The PSP receipt shows the transfer",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with an invalid dispute ID", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/v1/ops/disputes/"+rejectedDispute.ID.String()[:8]+"/resolve", map[string]interface{}{
+				"status":     "resolved",
+				"resolution": "Refunded",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("closes the dispute against the provider", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(openDispute, "resolve"), map[string]interface{}{
+				"status":          "resolved",
+				"providerAtFault": true,
+				"resolution":      "The provider refunded the recipient",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, string(dispute.StatusResolved), response.Data.Status)
+			assert.NotNil(t, response.Data.ResolvedAt)
+
+			d, err := db.Client.Dispute.Get(context.Background(), openDispute.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, dispute.StatusResolved, d.Status)
+			assert.True(t, *d.ProviderAtFault)
+			assert.Equal(t, "The provider refunded the recipient", d.Resolution)
+		})
+
+		t.Run("on a closed dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputePath(openDispute, "resolve"), map[string]interface{}{
+				"status":     "rejected",
+				"resolution": "Reopened by mistake",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+}
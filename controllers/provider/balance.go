@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// GetBalances controller fetches the fiat balances reported by the provider
+func (ctrl *ProviderController) GetBalances(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	balances, err := storage.Client.ProviderFiatBalance.
+		Query().
+		Where(providerfiatbalance.HasProviderWith(providerprofile.IDEQ(provider.ID))).
+		WithCurrency().
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch balances", nil)
+		return
+	}
+
+	response := make([]types.ProviderBalanceResponse, 0, len(balances))
+	for _, balance := range balances {
+		res, err := ctrl.balanceResponse(ctx, provider.ID, balance)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch balances", nil)
+			return
+		}
+		response = append(response, res)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Balances fetched successfully", response)
+}
+
+// UpdateBalances controller records the fiat balances a provider has available to fulfil orders.
+// Providers whose balance can't cover an order are skipped when matching orders
+func (ctrl *ProviderController) UpdateBalances(ctx *gin.Context) {
+	var payload types.UpdateProviderBalancesPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	for _, balance := range payload.Balances {
+		if balance.Available.IsNegative() {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Available",
+				Message: "Available balance cannot be negative",
+			})
+			return
+		}
+	}
+
+	response := make([]types.ProviderBalanceResponse, 0, len(payload.Balances))
+	for _, balance := range payload.Balances {
+		updated, err := ctrl.balanceService.UpdateBalance(ctx, provider.ID, balance.Currency, balance.Available)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Currency",
+					Message: "Currency " + balance.Currency + " is not supported",
+				})
+			} else {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update balances", nil)
+			}
+			return
+		}
+
+		res, err := ctrl.balanceResponse(ctx, provider.ID, updated)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update balances", nil)
+			return
+		}
+		response = append(response, res)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Balances updated successfully", response)
+}
+
+// balanceResponse builds the response for a provider's fiat balance, including the amount reserved for order requests
+func (ctrl *ProviderController) balanceResponse(ctx *gin.Context, providerID string, balance *ent.ProviderFiatBalance) (types.ProviderBalanceResponse, error) {
+	available, _, err := ctrl.balanceService.GetAvailableBalance(ctx, providerID, balance.Edges.Currency.Code)
+	if err != nil {
+		return types.ProviderBalanceResponse{}, err
+	}
+
+	return types.ProviderBalanceResponse{
+		Currency:  balance.Edges.Currency.Code,
+		Balance:   balance.AvailableBalance,
+		Reserved:  balance.AvailableBalance.Sub(available),
+		Available: available,
+		UpdatedAt: balance.UpdatedAt,
+	}, nil
+}
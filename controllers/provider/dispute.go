@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// GetDisputes controller fetches the disputes opened on the provider's orders, optionally filtered by status
+func (ctrl *ProviderController) GetDisputes(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	query := storage.Client.Dispute.
+		Query().
+		Where(dispute.HasLockPaymentOrderWith(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID))))
+
+	if status := ctx.Query("status"); status != "" {
+		if err := dispute.StatusValidator(dispute.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status filter", nil)
+			return
+		}
+		query = query.Where(dispute.StatusEQ(dispute.Status(status)))
+	}
+
+	disputes, err := query.
+		WithLockPaymentOrder().
+		Order(ent.Desc(dispute.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	response := make([]types.DisputeResponse, len(disputes))
+	for i, d := range disputes {
+		response[i] = svc.DisputeResponse(d, d.Edges.LockPaymentOrder.ID)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", response)
+}
+
+// SubmitDisputeEvidence controller adds the provider's proof of fulfilment, such as PSP receipts and references, to a dispute
+func (ctrl *ProviderController) SubmitDisputeEvidence(ctx *gin.Context) {
+	var payload types.SubmitDisputeEvidencePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	for _, evidence := range payload.Evidence {
+		if evidence.Reference == "" && evidence.URL == "" {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Evidence",
+				Message: "Each evidence must have a reference or a URL",
+			})
+			return
+		}
+	}
+
+	disputeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute ID", nil)
+		return
+	}
+
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	d, err := storage.Client.Dispute.
+		Query().
+		Where(
+			dispute.IDEQ(disputeID),
+			dispute.HasLockPaymentOrderWith(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID))),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dispute not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to submit evidence", nil)
+		}
+		return
+	}
+
+	d, err = ctrl.disputeService.SubmitEvidence(ctx, d, payload.Evidence)
+	if err != nil {
+		if err == svc.ErrDisputeClosed {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to submit evidence", err.Error())
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to submit evidence", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Evidence submitted successfully", svc.DisputeResponse(d, d.Edges.LockPaymentOrder.ID))
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisputes(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:provider_disputes?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Setup test data
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	createProvider := func(email string) *ent.ProviderProfile {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"scope": "provider",
+			"email": email,
+		})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		return provider
+	}
+
+	createDispute := func(provider *ent.ProviderProfile, status dispute.Status) *ent.Dispute {
+		lockOrder, err := db.Client.LockPaymentOrder.
+			Create().
+			SetGatewayID("0xdispute").
+			SetAmount(decimal.NewFromInt(100)).
+			SetRate(decimal.NewFromInt(1500)).
+			SetAmountFulfilled(decimal.NewFromInt(150000)).
+			SetOrderPercent(decimal.NewFromInt(100)).
+			SetBlockNumber(12345).
+			SetInstitution("ABNGNGLA").
+			SetAccountIdentifier("0123456789").
+			SetAccountName("John Doe").
+			SetStatus(lockpaymentorder.StatusSettled).
+			SetToken(token).
+			SetProvider(provider).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		d, err := db.Client.Dispute.
+			Create().
+			SetLockPaymentOrder(lockOrder).
+			SetReason("Recipient did not receive the funds").
+			SetStatus(status).
+			SetEvidenceDueAt(time.Now().Add(time.Hour)).
+			SetResolutionDueAt(time.Now().Add(24 * time.Hour)).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		return d
+	}
+
+	provider := createProvider("provider.disputes@test.com")
+	otherProvider := createProvider("other.provider.disputes@test.com")
+
+	awaitingDispute := createDispute(provider, dispute.StatusAwaitingProviderEvidence)
+	resolvedDispute := createDispute(provider, dispute.StatusResolved)
+	otherDispute := createDispute(otherProvider, dispute.StatusAwaitingProviderEvidence)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewProviderController()
+
+	v1 := router.Group("/v1/provider/")
+	v1.Use(func(ctx *gin.Context) {
+		ctx.Set("provider", provider)
+		ctx.Next()
+	})
+	v1.GET("disputes", ctrl.GetDisputes)
+	v1.POST("disputes/:id/evidence", ctrl.SubmitDisputeEvidence)
+
+	evidencePath := func(d *ent.Dispute) string {
+		return "/v1/provider/disputes/" + d.ID.String() + "/evidence"
+	}
+
+	payload := map[string]interface{}{
+		"evidence": []map[string]interface{}{
+			{
+				"type":      "receipt",
+				"reference": "PSP-REF-123",
+				"note":      "Transfer completed",
+			},
+		},
+	}
+
+	t.Run("GetDisputes", func(t *testing.T) {
+		t.Run("fetches only the provider's disputes", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/provider/disputes", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 2)
+		})
+
+		t.Run("with a status filter", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/provider/disputes?status=resolved", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 1)
+			assert.Equal(t, resolvedDispute.ID, response.Data[0].ID)
+		})
+
+		t.Run("with an invalid status filter", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/v1/provider/disputes?status=unknown", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("SubmitDisputeEvidence", func(t *testing.T) {
+		t.Run("without evidence", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(awaitingDispute), map[string]interface{}{
+				"evidence": []map[string]interface{}{},
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("without a reference or URL", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(awaitingDispute), map[string]interface{}{
+				"evidence": []map[string]interface{}{
+					{"type": "receipt"},
+				},
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("on another provider's dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(otherDispute), payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+
+		t.Run("on a closed dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(resolvedDispute), payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("moves the dispute to review by ops", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(awaitingDispute), payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, string(dispute.StatusOpen), response.Data.Status)
+			assert.Len(t, response.Data.Evidence, 1)
+			assert.Equal(t, "PSP-REF-123", response.Data.Evidence[0].Reference)
+
+			d, err := db.Client.Dispute.Get(context.Background(), awaitingDispute.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, dispute.StatusOpen, d.Status)
+			assert.Len(t, d.Evidence, 1)
+		})
+
+		t.Run("appends further evidence", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", evidencePath(awaitingDispute), map[string]interface{}{
+				"evidence": []map[string]interface{}{
+					{
+						"type": "other",
+						"url":  "https://example.com/receipt.pdf",
+					},
+				},
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			d, err := db.Client.Dispute.Get(context.Background(), awaitingDispute.ID)
+			assert.NoError(t, err)
+			assert.Len(t, d.Evidence, 2)
+		})
+	})
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
+	svc "github.com/paycrest/aggregator/services"
 	orderService "github.com/paycrest/aggregator/services/order"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
@@ -29,11 +31,21 @@ import (
 var orderConf = config.OrderConfig()
 
 // ProviderController is a controller type for provider endpoints
-type ProviderController struct{}
+type ProviderController struct {
+	streamService     *svc.ProviderStreamService
+	balanceService    *svc.ProviderBalanceService
+	trustScoreService *svc.TrustScoreService
+	disputeService    *svc.DisputeService
+}
 
 // NewProviderController creates a new instance of ProviderController with injected services
 func NewProviderController() *ProviderController {
-	return &ProviderController{}
+	return &ProviderController{
+		streamService:     svc.NewProviderStreamService(),
+		balanceService:    svc.NewProviderBalanceService(),
+		trustScoreService: svc.NewTrustScoreService(),
+		disputeService:    svc.NewDisputeService(),
+	}
 }
 
 // GetLockPaymentOrders controller fetches all assigned orders
@@ -43,9 +55,9 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 
 	// Set ordering
 	ordering := ctx.Query("ordering")
-	order := ent.Desc(lockpaymentorder.FieldCreatedAt)
+	order := []lockpaymentorder.OrderOption{ent.Desc(lockpaymentorder.FieldCreatedAt), ent.Desc(lockpaymentorder.FieldID)}
 	if ordering == "asc" {
-		order = ent.Asc(lockpaymentorder.FieldCreatedAt)
+		order = []lockpaymentorder.OrderOption{ent.Asc(lockpaymentorder.FieldCreatedAt), ent.Asc(lockpaymentorder.FieldID)}
 	}
 
 	// Get provider profile from the context
@@ -56,29 +68,21 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 	}
 	provider := providerCtx.(*ent.ProviderProfile)
 
-	lockPaymentOrderQuery := storage.Client.LockPaymentOrder.Query()
-
-	// Filter by status
-	statusMap := map[string]lockpaymentorder.Status{
-		"pending":    lockpaymentorder.StatusPending,
-		"validated":  lockpaymentorder.StatusValidated,
-		"fulfilled":  lockpaymentorder.StatusFulfilled,
-		"cancelled":  lockpaymentorder.StatusCancelled,
-		"processing": lockpaymentorder.StatusProcessing,
-		"settled":    lockpaymentorder.StatusSettled,
+	// Get filter query params
+	filters, err := u.ParseOrderListFilters(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
 	}
 
-	statusQueryParam := ctx.Query("status")
+	lockPaymentOrderQuery := storage.Client.LockPaymentOrder.Query().Where(
+		lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+	)
 
-	if status, ok := statusMap[statusQueryParam]; ok {
-		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(
-			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
-			lockpaymentorder.StatusEQ(status),
-		)
-	} else {
-		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(
-			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
-		)
+	lockPaymentOrderQuery, err = filterLockPaymentOrders(lockPaymentOrderQuery, filters)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
 	}
 
 	count, err := lockPaymentOrderQuery.Count(ctx)
@@ -88,11 +92,37 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
-	// Fetch all orders assigned to the provider
+	// Continue after the cursor position when one is provided, otherwise fall back to offsets
+	if filters.Cursor != nil {
+		if ordering == "asc" {
+			lockPaymentOrderQuery = lockPaymentOrderQuery.Where(
+				lockpaymentorder.Or(
+					lockpaymentorder.CreatedAtGT(filters.Cursor.CreatedAt),
+					lockpaymentorder.And(
+						lockpaymentorder.CreatedAtEQ(filters.Cursor.CreatedAt),
+						lockpaymentorder.IDGT(filters.Cursor.ID),
+					),
+				),
+			)
+		} else {
+			lockPaymentOrderQuery = lockPaymentOrderQuery.Where(
+				lockpaymentorder.Or(
+					lockpaymentorder.CreatedAtLT(filters.Cursor.CreatedAt),
+					lockpaymentorder.And(
+						lockpaymentorder.CreatedAtEQ(filters.Cursor.CreatedAt),
+						lockpaymentorder.IDLT(filters.Cursor.ID),
+					),
+				),
+			)
+		}
+		offset = 0
+	}
+
+	// Fetch all orders assigned to the provider, with one extra to tell whether there is a next page
 	lockPaymentOrders, err := lockPaymentOrderQuery.
-		Limit(pageSize).
+		Limit(pageSize + 1).
 		Offset(offset).
-		Order(order).
+		Order(order...).
 		WithProvider().
 		WithToken(
 			func(query *ent.TokenQuery) {
@@ -106,6 +136,13 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
+	nextCursor := ""
+	if len(lockPaymentOrders) > pageSize {
+		lockPaymentOrders = lockPaymentOrders[:pageSize]
+		last := lockPaymentOrders[len(lockPaymentOrders)-1]
+		nextCursor = u.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
 	var orders []types.LockPaymentOrderResponse
 	for _, order := range lockPaymentOrders {
 		orders = append(orders, types.LockPaymentOrderResponse{
@@ -131,10 +168,141 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 		Page:         page,
 		PageSize:     pageSize,
 		TotalRecords: count,
+		NextCursor:   nextCursor,
 		Orders:       orders,
 	})
 }
 
+// ExportLockPaymentOrders controller streams the provider's assigned orders within a date range as CSV or NDJSON
+func (ctrl *ProviderController) ExportLockPaymentOrders(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	// Get filter query params
+	filters, err := u.ParseOrderListFilters(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
+
+	if filters.From.IsZero() || filters.To.IsZero() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query params: from and to dates are required", nil)
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "csv")
+	if format != "csv" && format != "ndjson" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query params: format must be csv or ndjson", nil)
+		return
+	}
+
+	lockPaymentOrderQuery, err := filterLockPaymentOrders(storage.Client.LockPaymentOrder.Query().Where(
+		lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+	), filters)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
+
+	writer, err := u.NewExportWriter(ctx, format,
+		fmt.Sprintf("orders_%s_%s", filters.From.Format("2006-01-02"), filters.To.Format("2006-01-02")),
+		[]string{
+			"id", "gateway_id", "created_at", "updated_at", "status", "token", "network",
+			"amount", "rate", "order_percent", "tx_hash",
+			"recipient_institution", "recipient_account_identifier", "recipient_account_name",
+			"psp_transaction_ids",
+		},
+	)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to export orders", nil)
+		return
+	}
+
+	// Stream the orders in chunks keyed on (created_at, id) so the whole result is never held in memory
+	const chunkSize = 500
+	var cursor *types.PaginationCursor
+	for {
+		query := lockPaymentOrderQuery.Clone()
+		if cursor != nil {
+			query = query.Where(
+				lockpaymentorder.Or(
+					lockpaymentorder.CreatedAtGT(cursor.CreatedAt),
+					lockpaymentorder.And(
+						lockpaymentorder.CreatedAtEQ(cursor.CreatedAt),
+						lockpaymentorder.IDGT(cursor.ID),
+					),
+				),
+			)
+		}
+
+		lockPaymentOrders, err := query.
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithFulfillments().
+			Order(ent.Asc(lockpaymentorder.FieldCreatedAt), ent.Asc(lockpaymentorder.FieldID)).
+			Limit(chunkSize).
+			All(ctx)
+		if err != nil {
+			logger.Errorf("ExportLockPaymentOrders: %v", err)
+			return
+		}
+
+		if len(lockPaymentOrders) == 0 {
+			break
+		}
+
+		for _, order := range lockPaymentOrders {
+			txIDs := []string{}
+			for _, fulfillment := range order.Edges.Fulfillments {
+				if fulfillment.TxID != "" {
+					txIDs = append(txIDs, fulfillment.TxID)
+				}
+			}
+
+			err := writer.WriteRow([]interface{}{
+				order.ID.String(),
+				order.GatewayID,
+				order.CreatedAt,
+				order.UpdatedAt,
+				order.Status,
+				order.Edges.Token.Symbol,
+				order.Edges.Token.Edges.Network.Identifier,
+				order.Amount,
+				order.Rate,
+				order.OrderPercent,
+				order.TxHash,
+				order.Institution,
+				order.AccountIdentifier,
+				order.AccountName,
+				txIDs,
+			})
+			if err != nil {
+				logger.Errorf("ExportLockPaymentOrders: %v", err)
+				return
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			logger.Errorf("ExportLockPaymentOrders: %v", err)
+			return
+		}
+
+		if len(lockPaymentOrders) < chunkSize {
+			break
+		}
+
+		last := lockPaymentOrders[len(lockPaymentOrders)-1]
+		cursor = &types.PaginationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
 // AcceptOrder controller accepts an order
 func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 	// Get provider profile from the context
@@ -239,6 +407,13 @@ func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 		return
 	}
 
+	// The provider is paying out the order, so its reserved fiat balance is spent
+	if err := ctrl.balanceService.DeductReservedBalance(ctx, provider.ID, orderID); err != nil {
+		logger.Errorf("error deducting reserved balance for order %s: %v", orderID, err)
+	}
+
+	ctrl.trustScoreService.RecordOrderEvent(ctx, provider.ID, svc.ProviderOrderAccepted)
+
 	u.APIResponse(ctx, http.StatusCreated, "success", "Order request accepted successfully", &types.AcceptOrderResponse{
 		ID:                orderID,
 		Amount:            order.Amount.Mul(order.Rate).RoundBank(0),
@@ -289,6 +464,12 @@ func (ctrl *ProviderController) DeclineOrder(ctx *gin.Context) {
 		return
 	}
 
+	// Release the fiat balance reserved for the order request
+	err = ctrl.balanceService.ReleaseBalance(ctx, provider.ID, orderID)
+	if err != nil {
+		logger.Errorf("error releasing reserved balance for order %s: %v", orderID, err)
+	}
+
 	// Push provider ID to order exclude list
 	orderKey := fmt.Sprintf("order_exclude_list_%s", orderID)
 	_, err = storage.RedisClient.RPush(ctx, orderKey, provider.ID).Result()
@@ -350,11 +531,49 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
+			if payload.Amount.IsNegative() {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Amount",
+					Message: "Amount cannot be negative",
+				})
+				return
+			}
+
+			order, err := storage.Client.LockPaymentOrder.Get(ctx, orderID)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+				return
+			}
+
+			// Fulfillments awaiting validation count against the order so they can't cover more than its amount
+			claimableAmount, err := u.ClaimableFulfillmentAmount(ctx, order)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+				return
+			}
+
+			// A fulfillment without an amount pays out the rest of the order
+			amount := payload.Amount
+			if amount.IsZero() {
+				amount = claimableAmount
+			}
+
+			if amount.IsZero() || amount.GreaterThan(claimableAmount) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Amount",
+					Message: fmt.Sprintf("Amount exceeds the unfulfilled amount of %s", claimableAmount),
+				})
+				return
+			}
+
 			_, err = storage.Client.LockOrderFulfillment.
 				Create().
 				SetOrderID(orderID).
 				SetTxID(payload.TxID).
 				SetPsp(payload.PSP).
+				SetAmount(amount).
 				Save(ctx)
 			if err != nil {
 				logger.Errorf("error: %v", err)
@@ -389,16 +608,65 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			return
 		}
 
-		_, err := fulfillment.Update().
+		// Validate the fulfillment, add it to the amount paid out and validate the order in one transaction
+		// so a failed write never leaves the fulfillment validated without the order being updated
+		tx, err := storage.Client.Tx(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+			return
+		}
+
+		// Only the update that validates the fulfillment adds it to the amount paid out, so a fulfillment
+		// validated by a repeated callback or the fulfillment sync is never counted twice
+		validated, err := tx.LockOrderFulfillment.
+			Update().
+			Where(
+				lockorderfulfillment.IDEQ(fulfillment.ID),
+				lockorderfulfillment.ValidationStatusNEQ(lockorderfulfillment.ValidationStatusSuccess),
+			).
 			SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess).
 			Save(ctx)
 		if err != nil {
+			_ = tx.Rollback()
 			logger.Errorf("error: %v", err)
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
 			return
 		}
 
-		transactionLog, err := storage.Client.TransactionLog.Create().
+		// A fulfillment validated before only moves the order to validated if it is still covered
+		var order *ent.LockPaymentOrder
+		if validated == 1 {
+			// Add the fulfillment to the amount paid out, the order is only validated once the amount is covered
+			order, err = tx.LockPaymentOrder.
+				UpdateOneID(fulfillment.Edges.Order.ID).
+				AddAmountFulfilled(fulfillment.Amount).
+				Save(ctx)
+		} else {
+			order, err = tx.LockPaymentOrder.Get(ctx, fulfillment.Edges.Order.ID)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+			return
+		}
+
+		if !u.IsLockOrderFulfilled(order) {
+			if err := tx.Commit(); err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+				return
+			}
+
+			u.APIResponse(ctx, http.StatusOK, "success", "Order partially fulfilled", &types.FulfillLockOrderResponse{
+				AmountFulfilled:   order.AmountFulfilled,
+				UnfulfilledAmount: u.UnfulfilledAmount(order),
+			})
+			return
+		}
+
+		transactionLog, err := tx.TransactionLog.Create().
 			SetStatus(transactionlog.StatusOrderValidated).
 			SetNetwork(fulfillment.Edges.Order.Edges.Token.Edges.Network.Identifier).
 			SetMetadata(map[string]interface{}{
@@ -407,16 +675,32 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			}).
 			Save(ctx)
 		if err != nil {
+			_ = tx.Rollback()
 			logger.Errorf("error: %v", err)
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
 			return
 		}
 
-		_, err = updateLockOrder.
+		_, err = tx.LockPaymentOrder.
+			Update().
+			Where(
+				lockpaymentorder.IDEQ(orderID),
+				lockpaymentorder.Or(
+					lockpaymentorder.StatusEQ(lockpaymentorder.StatusProcessing),
+					lockpaymentorder.StatusEQ(lockpaymentorder.StatusFulfilled),
+				),
+			).
 			SetStatus(lockpaymentorder.StatusValidated).
 			AddTransactions(transactionLog).
 			Save(ctx)
 		if err != nil {
+			_ = tx.Rollback()
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
 			logger.Errorf("error: %v", err)
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
 			return
@@ -533,6 +817,11 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 		return
 	}
 
+	if order.AmountFulfilled.IsPositive() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Partially fulfilled orders cannot be cancelled", nil)
+		return
+	}
+
 	// Get new cancellation count based on cancel reason
 	orderUpdate := storage.Client.LockPaymentOrder.UpdateOneID(orderID)
 	cancellationCount := order.CancellationCount
@@ -553,9 +842,9 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 				break
 			}
 
-			// Extract the id from the data (assuming format "providerID:token:rate:minAmount:maxAmount")
+			// Extract the id from the data (assuming format "providerID:token:rate:minAmount:maxAmount:slippage:rateBands")
 			parts := strings.Split(providerData, ":")
-			if len(parts) != 5 {
+			if len(parts) != 7 {
 				logger.Errorf("invalid provider data format: %s", providerData)
 				continue // Skip this entry due to invalid format
 			}
@@ -578,6 +867,12 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 			}
 		}
 
+		// The provider is out of float until it reports a new balance
+		err = ctrl.balanceService.ClearBalance(ctx, provider.ID, order.Edges.ProvisionBucket.Edges.Currency.Code)
+		if err != nil {
+			logger.Errorf("failed to clear fiat balance for provider %s: %v", provider.ID, err)
+		}
+
 		// // Update provider availability to off
 		// _, err = storage.Client.ProviderProfile.
 		// 	UpdateOneID(provider.ID).
@@ -602,6 +897,11 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 	order.Status = lockpaymentorder.StatusCancelled
 	order.CancellationCount = cancellationCount
 
+	// Invalid recipient details are not held against the provider's trust score
+	if payload.Reason != "Invalid recipient bank details" {
+		ctrl.trustScoreService.RecordOrderEvent(ctx, provider.ID, svc.ProviderOrderCancelled)
+	}
+
 	// Check if order cancellation count is equal or greater than RefundCancellationCount in config,
 	// and the order has not been refunded, then trigger refund
 	if order.CancellationCount >= orderConf.RefundCancellationCount && order.Status == lockpaymentorder.StatusCancelled {
@@ -686,18 +986,26 @@ func (ctrl *ProviderController) Stats(ctx *gin.Context) {
 	}
 	provider := providerCtx.(*ent.ProviderProfile)
 
+	statsQuery, err := u.ParseStatsQuery(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
+
 	// Fetch provider stats
 	query := storage.Client.LockPaymentOrder.
 		Query().
 		Where(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)), lockpaymentorder.StatusEQ(lockpaymentorder.StatusSettled))
 
 	var v []struct {
-		Sum decimal.Decimal
+		Sum        decimal.Decimal
+		FiatVolume decimal.Decimal `json:"fiat_volume"`
 	}
 
-	err := query.
+	err = query.
 		Aggregate(
 			ent.Sum(lockpaymentorder.FieldAmount),
+			u.StatsSettledSum("fiat_volume", string(lockpaymentorder.StatusSettled), lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate),
 		).
 		Scan(ctx, &v)
 	if err != nil {
@@ -706,18 +1014,7 @@ func (ctrl *ProviderController) Stats(ctx *gin.Context) {
 		return
 	}
 
-	settledOrders, err := query.
-		All(ctx)
-	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider stats", nil)
-		return
-	}
-
-	var totalFiatVolume decimal.Decimal
-	for _, order := range settledOrders {
-		totalFiatVolume = totalFiatVolume.Add(order.Amount.Mul(order.Rate).RoundBank(0))
-	}
+	totalFiatVolume := v[0].FiatVolume.RoundBank(0)
 
 	count, err := storage.Client.LockPaymentOrder.
 		Query().
@@ -729,11 +1026,153 @@ func (ctrl *ProviderController) Stats(ctx *gin.Context) {
 		return
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "Provider stats fetched successfully", &types.ProviderStatsResponse{
+	response := &types.ProviderStatsResponse{
 		TotalOrders:       count,
 		TotalFiatVolume:   totalFiatVolume,
 		TotalCryptoVolume: v[0].Sum,
-	})
+	}
+
+	// Time series are only computed when a bucket or group-by dimension is requested
+	if statsQuery.Bucket != "" || len(statsQuery.GroupBy) > 0 {
+		aggregator, err := aggregateLockPaymentOrderStats(ctx, provider, statsQuery)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider stats", nil)
+			return
+		}
+		response.Series = aggregator.Series()
+		response.SettlementTime = aggregator.SettlementTime()
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Provider stats fetched successfully", response)
+}
+
+// aggregateLockPaymentOrderStats aggregates the provider's lock orders created within the stats query range.
+// Order counts and the volumes of settled orders are grouped by day, token, institution and status in SQL.
+// Settlement times are read from the settled orders in chunks keyed on (created_at, id)
+func aggregateLockPaymentOrderStats(ctx *gin.Context, provider *ent.ProviderProfile, statsQuery *types.StatsQuery) (*u.StatsAggregator, error) {
+	currencies, err := u.GetInstitutionCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := u.GetTokensByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregator := u.NewStatsAggregator(statsQuery)
+
+	var groups []struct {
+		Day         string          `json:"day"`
+		TokenID     int             `json:"token_lock_payment_orders"`
+		Institution string          `json:"institution"`
+		Status      string          `json:"status"`
+		Orders      int             `json:"orders"`
+		Amount      decimal.Decimal `json:"amount"`
+		FiatAmount  decimal.Decimal `json:"fiat_amount"`
+	}
+	err = storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+			lockpaymentorder.CreatedAtGTE(statsQuery.From),
+			lockpaymentorder.CreatedAtLTE(statsQuery.To),
+		).
+		GroupBy(lockpaymentorder.FieldStatus, lockpaymentorder.FieldInstitution, lockpaymentorder.TokenColumn).
+		Aggregate(
+			u.StatsDay(lockpaymentorder.FieldCreatedAt),
+			ent.As(ent.Count(), "orders"),
+			u.StatsSettledSum("amount", string(lockpaymentorder.StatusSettled), lockpaymentorder.FieldAmount),
+			u.StatsSettledSum("fiat_amount", string(lockpaymentorder.StatusSettled), lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate lock orders: %w", err)
+	}
+
+	for _, group := range groups {
+		day, err := u.ParseStatsDay(group.Day)
+		if err != nil {
+			return nil, err
+		}
+
+		record := types.StatsRecord{
+			CreatedAt:  day,
+			Currency:   currencies[group.Institution],
+			Status:     group.Status,
+			Orders:     group.Orders,
+			Amount:     group.Amount,
+			FiatAmount: group.FiatAmount.RoundBank(0),
+		}
+		if token, ok := tokens[group.TokenID]; ok {
+			record.Token = token.Symbol
+			record.Network = token.Edges.Network.Identifier
+		}
+
+		aggregator.Add(record)
+	}
+
+	const chunkSize = 500
+	var cursor *types.PaginationCursor
+	for {
+		query := storage.Client.LockPaymentOrder.
+			Query().
+			Where(
+				lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				lockpaymentorder.StatusEQ(lockpaymentorder.StatusSettled),
+				lockpaymentorder.CreatedAtGTE(statsQuery.From),
+				lockpaymentorder.CreatedAtLTE(statsQuery.To),
+			)
+		if cursor != nil {
+			query = query.Where(
+				lockpaymentorder.Or(
+					lockpaymentorder.CreatedAtGT(cursor.CreatedAt),
+					lockpaymentorder.And(
+						lockpaymentorder.CreatedAtEQ(cursor.CreatedAt),
+						lockpaymentorder.IDGT(cursor.ID),
+					),
+				),
+			)
+		}
+
+		lockOrders, err := query.
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithTransactions(func(tq *ent.TransactionLogQuery) {
+				tq.Where(transactionlog.StatusIn(
+					transactionlog.StatusOrderCreated,
+					transactionlog.StatusOrderSettled,
+				))
+			}).
+			Order(ent.Asc(lockpaymentorder.FieldCreatedAt), ent.Asc(lockpaymentorder.FieldID)).
+			Limit(chunkSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch lock orders: %w", err)
+		}
+
+		for _, lockOrder := range lockOrders {
+			aggregator.Add(types.StatsRecord{
+				CreatedAt:      lockOrder.CreatedAt,
+				Token:          lockOrder.Edges.Token.Symbol,
+				Network:        lockOrder.Edges.Token.Edges.Network.Identifier,
+				Currency:       currencies[lockOrder.Institution],
+				Status:         string(lockOrder.Status),
+				SettlementTime: u.SettlementTime(lockOrder.CreatedAt, lockOrder.Edges.Transactions),
+			})
+		}
+
+		if len(lockOrders) < chunkSize {
+			break
+		}
+
+		last := lockOrders[len(lockOrders)-1]
+		cursor = &types.PaginationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	return aggregator, nil
 }
 
 // NodeInfo controller fetches the provision node info
@@ -748,7 +1187,6 @@ func (ctrl *ProviderController) NodeInfo(ctx *gin.Context) {
 	provider, err := storage.Client.ProviderProfile.
 		Query().
 		Where(providerprofile.IDEQ(providerCtx.(*ent.ProviderProfile).ID)).
-		WithAPIKey().
 		WithCurrency().
 		Only(ctx)
 	if err != nil {
@@ -856,3 +1294,94 @@ func (ctrl *ProviderController) GetLockPaymentOrderByID(ctx *gin.Context) {
 		Transactions:      transactions,
 	})
 }
+
+// filterLockPaymentOrders applies the order list filters to a lock payment order query
+func filterLockPaymentOrders(query *ent.LockPaymentOrderQuery, filters *types.OrderListFilters) (*ent.LockPaymentOrderQuery, error) {
+	// Filter by status
+	statusMap := map[string]lockpaymentorder.Status{
+		"pending":    lockpaymentorder.StatusPending,
+		"validated":  lockpaymentorder.StatusValidated,
+		"fulfilled":  lockpaymentorder.StatusFulfilled,
+		"cancelled":  lockpaymentorder.StatusCancelled,
+		"processing": lockpaymentorder.StatusProcessing,
+		"settled":    lockpaymentorder.StatusSettled,
+	}
+
+	if len(filters.Statuses) > 0 {
+		statuses := []lockpaymentorder.Status{}
+		for _, statusQueryParam := range filters.Statuses {
+			status, ok := statusMap[statusQueryParam]
+			if !ok {
+				return nil, fmt.Errorf("invalid status %s", statusQueryParam)
+			}
+			statuses = append(statuses, status)
+		}
+
+		query = query.Where(
+			lockpaymentorder.StatusIn(statuses...),
+		)
+	}
+
+	// Filter by token and network
+	if filters.Token != "" {
+		query = query.Where(
+			lockpaymentorder.HasTokenWith(token.SymbolEQ(filters.Token)),
+		)
+	}
+
+	if filters.Network != "" {
+		query = query.Where(
+			lockpaymentorder.HasTokenWith(
+				token.HasNetworkWith(network.IdentifierEQ(filters.Network)),
+			),
+		)
+	}
+
+	// Filter by gateway order ID, which is the reference providers know orders by
+	if filters.Reference != "" {
+		query = query.Where(
+			lockpaymentorder.GatewayIDEQ(filters.Reference),
+		)
+	}
+
+	// Filter by recipient
+	if filters.Institution != "" {
+		query = query.Where(
+			lockpaymentorder.InstitutionEQ(filters.Institution),
+		)
+	}
+
+	if filters.AccountIdentifier != "" {
+		query = query.Where(
+			lockpaymentorder.AccountIdentifierEQ(filters.AccountIdentifier),
+		)
+	}
+
+	// Filter by date range
+	if !filters.From.IsZero() {
+		query = query.Where(
+			lockpaymentorder.CreatedAtGTE(filters.From),
+		)
+	}
+
+	if !filters.To.IsZero() {
+		query = query.Where(
+			lockpaymentorder.CreatedAtLTE(filters.To),
+		)
+	}
+
+	// Filter by amount range
+	if filters.MinAmount.Valid {
+		query = query.Where(
+			lockpaymentorder.AmountGTE(filters.MinAmount.Decimal),
+		)
+	}
+
+	if filters.MaxAmount.Valid {
+		query = query.Where(
+			lockpaymentorder.AmountLTE(filters.MaxAmount.Decimal),
+		)
+	}
+
+	return query, nil
+}
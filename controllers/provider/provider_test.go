@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,6 +26,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/utils/test"
 	"github.com/paycrest/aggregator/utils/token"
 	"github.com/stretchr/testify/assert"
@@ -140,6 +144,7 @@ func TestProvider(t *testing.T) {
 	router.GET("/orders", ctrl.GetLockPaymentOrders)
 	router.GET("/stats", ctrl.Stats)
 	router.GET("/node-info", ctrl.NodeInfo)
+	router.GET("/orders/export", ctrl.ExportLockPaymentOrders)
 	router.GET("/orders/:id", ctrl.GetLockPaymentOrderByID)
 	router.POST("/orders/:id/accept", ctrl.AcceptOrder)
 	router.POST("/orders/:id/decline", ctrl.DeclineOrder)
@@ -312,6 +317,159 @@ func TestProvider(t *testing.T) {
 			assert.Greater(t, firstOrderTimestamp, lastOrderTimestamp)
 		})
 
+		t.Run("with cursor pagination", func(t *testing.T) {
+			fetchPage := func(cursor string) map[string]interface{} {
+				var payload = map[string]interface{}{
+					"pageSize":  "4",
+					"timestamp": time.Now().Unix(),
+				}
+				url := fmt.Sprintf("/orders?pageSize=4&timestamp=%v", payload["timestamp"])
+				if cursor != "" {
+					payload["cursor"] = cursor
+					url = fmt.Sprintf("%s&cursor=%s", url, cursor)
+				}
+
+				signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+				headers := map[string]string{
+					"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+					"Client-Type":   "backend",
+				}
+
+				res, err := test.PerformRequest(t, "GET", url, nil, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, res.Code)
+
+				var response types.Response
+				err = json.Unmarshal(res.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				data, ok := response.Data.(map[string]interface{})
+				assert.True(t, ok, "response.Data is of not type map[string]interface{}")
+				return data
+			}
+
+			firstPage := fetchPage("")
+			assert.Equal(t, 4, len(firstPage["orders"].([]interface{})))
+			assert.NotEmpty(t, firstPage["nextCursor"])
+
+			secondPage := fetchPage(firstPage["nextCursor"].(string))
+			assert.Equal(t, 4, len(secondPage["orders"].([]interface{})))
+
+			seen := map[string]bool{}
+			for _, order := range firstPage["orders"].([]interface{}) {
+				seen[order.(map[string]interface{})["id"].(string)] = true
+			}
+			for _, order := range secondPage["orders"].([]interface{}) {
+				assert.False(t, seen[order.(map[string]interface{})["id"].(string)], "pages should not overlap")
+			}
+		})
+
+		t.Run("with multiple statuses and amount range", func(t *testing.T) {
+			var payload = map[string]interface{}{
+				"status":    "pending,validated",
+				"minAmount": "100",
+				"maxAmount": "101",
+				"timestamp": time.Now().Unix(),
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+				"Client-Type":   "backend",
+			}
+
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/orders?status=%s&minAmount=%s&maxAmount=%s&timestamp=%v", payload["status"], payload["minAmount"], payload["maxAmount"], payload["timestamp"]), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is of not type map[string]interface{}")
+			assert.Greater(t, int(data["total"].(float64)), 0)
+			for _, order := range data["orders"].([]interface{}) {
+				assert.Contains(t, []string{"pending", "validated"}, order.(map[string]interface{})["status"])
+			}
+		})
+
+		t.Run("with an invalid date range", func(t *testing.T) {
+			var payload = map[string]interface{}{
+				"from":      "not-a-date",
+				"timestamp": time.Now().Unix(),
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+				"Client-Type":   "backend",
+			}
+
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/orders?from=%s&timestamp=%v", payload["from"], payload["timestamp"]), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+	})
+
+	t.Run("ExportLockPaymentOrders", func(t *testing.T) {
+		from := time.Now().Add(-24 * time.Hour).Format("2006-01-02")
+		to := time.Now().Add(24 * time.Hour).Format("2006-01-02")
+
+		exportOrders := func(query map[string]interface{}) *httptest.ResponseRecorder {
+			payload := map[string]interface{}{
+				"timestamp": time.Now().Unix(),
+			}
+			url := fmt.Sprintf("/orders/export?timestamp=%v", payload["timestamp"])
+			for key, value := range query {
+				payload[key] = value
+				url = fmt.Sprintf("%s&%s=%v", url, key, value)
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+				"Client-Type":   "backend",
+			}
+
+			res, err := test.PerformRequest(t, "GET", url, nil, headers, router)
+			assert.NoError(t, err)
+			return res
+		}
+
+		t.Run("should stream orders as CSV", func(t *testing.T) {
+			res := exportOrders(map[string]interface{}{"from": from, "to": to})
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+
+			records, err := csv.NewReader(res.Body).ReadAll()
+			assert.NoError(t, err)
+			assert.Greater(t, len(records), 1)
+			assert.Equal(t, "id", records[0][0])
+			assert.Equal(t, "psp_transaction_ids", records[0][len(records[0])-1])
+		})
+
+		t.Run("should stream orders as NDJSON", func(t *testing.T) {
+			res := exportOrders(map[string]interface{}{"from": from, "to": to, "format": "ndjson"})
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			lines := strings.Split(strings.TrimSpace(res.Body.String()), "\n")
+			assert.Greater(t, len(lines), 0)
+			for _, line := range lines {
+				var row map[string]interface{}
+				err := json.Unmarshal([]byte(line), &row)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, row["gateway_id"])
+			}
+		})
+
+		t.Run("should require a date range", func(t *testing.T) {
+			res := exportOrders(map[string]interface{}{"from": from})
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
 	})
 
 	t.Run("GetStats", func(t *testing.T) {
@@ -494,6 +652,96 @@ func TestProvider(t *testing.T) {
 			assert.NoError(t, err, "Failed to convert expectedTotalCryptoVolume to decimal")
 			assert.Equal(t, 0, totalCryptoVolume.Cmp(expectedTotalCryptoVolume))
 		})
+
+		t.Run("should return a time series grouped by status", func(t *testing.T) {
+			// Create a settled order that took two minutes to settle
+			settledOrder, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+				"status":     "settled",
+			})
+			assert.NoError(t, err)
+
+			createdLog, err := db.Client.TransactionLog.
+				Create().
+				SetStatus("order_created").
+				SetMetadata(map[string]interface{}{}).
+				SetCreatedAt(time.Now().Add(-2 * time.Minute)).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			settledLog, err := db.Client.TransactionLog.
+				Create().
+				SetStatus("order_settled").
+				SetMetadata(map[string]interface{}{}).
+				SetCreatedAt(time.Now()).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			_, err = settledOrder.Update().
+				AddTransactions(createdLog, settledLog).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			var payload = map[string]interface{}{
+				"timestamp": time.Now().Unix(),
+				"bucket":    "day",
+				"groupBy":   "status",
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+				"Client-Type":   "backend",
+			}
+
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/stats?timestamp=%v&bucket=day&groupBy=status", payload["timestamp"]), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.ProviderStatsResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			totalOrders := 0
+			var settled *types.StatsSeriesEntry
+			for i, entry := range response.Data.Series {
+				assert.Equal(t, time.Now().UTC().Format("2006-01-02"), entry.Period)
+				totalOrders += entry.TotalOrders
+				if entry.Status == "settled" {
+					settled = &response.Data.Series[i]
+				}
+			}
+			assert.Equal(t, response.Data.TotalOrders, totalOrders)
+
+			assert.NotNil(t, settled)
+			assert.Equal(t, 2, settled.TotalOrders)
+			assert.NotNil(t, settled.SettlementTime)
+			assert.Equal(t, 1, settled.SettlementTime.SettledOrders)
+			assert.InDelta(t, 120, settled.SettlementTime.MedianSeconds, 1)
+			assert.InDelta(t, 120, response.Data.SettlementTime.P95Seconds, 1)
+		})
+
+		t.Run("should reject an invalid bucket", func(t *testing.T) {
+			var payload = map[string]interface{}{
+				"timestamp": time.Now().Unix(),
+				"bucket":    "hour",
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+				"Client-Type":   "backend",
+			}
+
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/stats?timestamp=%v&bucket=hour", payload["timestamp"]), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
 	})
 
 	t.Run("NodeInfo", func(t *testing.T) {
@@ -1594,6 +1842,110 @@ func TestProvider(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, "Order fulfilled successfully", response.Message)
 		})
+
+		t.Run("when fulfillment covers part of the order", func(t *testing.T) {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+				"status":     "fulfilled",
+			})
+			assert.NoError(t, err)
+
+			partialAmount := order.Amount.Mul(order.Rate).Div(decimal.NewFromInt(2)).Truncate(2)
+
+			tx_id := "0x123" + fmt.Sprint(rand.Intn(1000000))
+			_, err = test.CreateTestLockOrderFulfillment(map[string]interface{}{
+				"tx_id":             tx_id,
+				"psp":               "psp-name",
+				"amount":            partialAmount.InexactFloat64(),
+				"validation_status": "pending",
+				"orderId":           order.ID,
+			})
+			assert.NoError(t, err)
+
+			// Test default params
+			var payload = map[string]interface{}{
+				"timestamp":        time.Now().Unix(),
+				"validationStatus": "success",
+				"txId":             tx_id,
+				"psp":              "psp-name",
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/orders/"+order.ID.String()+"/fulfill", payload, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Order partially fulfilled", response.Message)
+
+			// The order stays fulfilled with the validated amount recorded
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+			assert.True(t, order.AmountFulfilled.Equal(partialAmount))
+
+			// A repeated callback doesn't count the fulfillment twice
+			res, err = test.PerformRequest(t, "POST", "/orders/"+order.ID.String()+"/fulfill", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.True(t, order.AmountFulfilled.Equal(partialAmount))
+		})
+
+		t.Run("when pending fulfillments already claim the order", func(t *testing.T) {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+				"status":     "fulfilled",
+			})
+			assert.NoError(t, err)
+
+			_, err = test.CreateTestLockOrderFulfillment(map[string]interface{}{
+				"tx_id":             "0x123" + fmt.Sprint(rand.Intn(1000000)),
+				"psp":               "psp-name",
+				"validation_status": "pending",
+				"orderId":           order.ID,
+			})
+			assert.NoError(t, err)
+
+			// Test default params
+			var payload = map[string]interface{}{
+				"timestamp":        time.Now().Unix(),
+				"validationStatus": "pending",
+				"txId":             "0x456" + fmt.Sprint(rand.Intn(1000000)),
+				"psp":              "psp-name",
+				"amount":           1,
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/orders/"+order.ID.String()+"/fulfill", payload, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Failed to validate payload", response.Message)
+		})
 	})
 
 }
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// GetRateExclusions controller lists the provider's tokens that are currently excluded from the order queue and why
+func (ctrl *ProviderController) GetRateExclusions(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	exclusions, err := storage.Client.ProviderRateExclusion.
+		Query().
+		Where(
+			providerrateexclusion.HasOrderTokenWith(
+				providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+			),
+		).
+		WithOrderToken(func(potq *ent.ProviderOrderTokenQuery) {
+			potq.WithProvider(func(ppq *ent.ProviderProfileQuery) {
+				ppq.WithCurrency()
+			})
+		}).
+		Order(ent.Asc(providerrateexclusion.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch rate exclusions", nil)
+		return
+	}
+
+	response := make([]types.ProviderRateExclusionResponse, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		token := exclusion.Edges.OrderToken
+		response = append(response, svc.ExclusionResponse(exclusion, token, token.Edges.Provider.Edges.Currency.Code))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Rate exclusions fetched successfully", response)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/paycrest/aggregator/ent"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// streamUpgrader upgrades provider stream requests to WebSocket connections.
+// Provider nodes are not browsers, so the origin is not checked
+var streamUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// Stream controller opens a WebSocket stream that delivers order requests, tx_status queries and
+// cancellation notices to the provider's node. The node acks each message over the same connection.
+// Nodes that are not connected keep receiving messages by HTTP push to their host identifier
+func (ctrl *ProviderController) Stream(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	if !websocket.IsWebSocketUpgrade(ctx.Request) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Expected a WebSocket upgrade request", nil)
+		return
+	}
+
+	conn, err := streamUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	ctrl.streamService.ServeConn(ctx.Request.Context(), provider, conn)
+}
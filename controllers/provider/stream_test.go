@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	svc "github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:provider_stream?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
+	// Setup test data
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "provider",
+		"email": "provider.stream@test.com",
+	})
+	assert.NoError(t, err)
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"currency_id": currency.ID,
+	})
+	assert.NoError(t, err)
+
+	// Messages are signed with the primary API key
+	_, _, err = svc.NewAPIKeyService().GenerateAPIKey(context.Background(), nil, nil, provider)
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewProviderController()
+
+	v1 := router.Group("/v1/provider/")
+	v1.Use(func(ctx *gin.Context) {
+		ctx.Set("provider", provider)
+		ctx.Next()
+	})
+	v1.GET("stream", ctrl.Stream)
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	t.Run("without a WebSocket upgrade", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", "/v1/provider/stream", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("relays messages to the node and its acks to the sender", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/v1/provider/stream", nil)
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		type result struct {
+			data map[string]interface{}
+			err  error
+		}
+		results := make(chan result, 1)
+
+		// Wait for the connection to subscribe to the provider's messages
+		time.Sleep(500 * time.Millisecond)
+
+		go func() {
+			data, err := svc.NewProviderStreamService().Send(context.Background(), provider, svc.ProviderStreamTxStatus,
+				map[string]interface{}{"orderId": "order-1"}, 5*time.Second)
+			results <- result{data, err}
+		}()
+
+		var message types.ProviderStreamMessage
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		err = conn.ReadJSON(&message)
+		assert.NoError(t, err)
+		assert.Equal(t, svc.ProviderStreamTxStatus, message.Type)
+		assert.Equal(t, "order-1", message.Data["orderId"])
+		assert.NotEmpty(t, message.Signature)
+
+		err = conn.WriteJSON(types.ProviderStreamMessage{
+			ID:   message.ID,
+			Type: svc.ProviderStreamAck,
+			Data: map[string]interface{}{"status": "success"},
+		})
+		assert.NoError(t, err)
+
+		res := <-results
+		assert.NoError(t, res.err)
+		assert.Equal(t, "success", res.data["status"])
+	})
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrating"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// GetTrustScore controller fetches the provider's trust score and the breakdown of its components
+func (ctrl *ProviderController) GetTrustScore(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	rating, err := storage.Client.ProviderRating.
+		Query().
+		Where(providerrating.HasProviderProfileWith(providerprofile.IDEQ(provider.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Trust score has not been computed yet", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch trust score", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Trust score fetched successfully", &types.ProviderTrustScoreResponse{
+		TrustScore: rating.TrustScore,
+		Acceptance: types.TrustScoreComponent{
+			Value:  rating.AcceptanceRate,
+			Score:  rating.AcceptanceScore,
+			Weight: svc.TrustScoreWeights.Acceptance,
+		},
+		Cancellation: types.TrustScoreComponent{
+			Value:  rating.CancellationRate,
+			Score:  rating.CancellationScore,
+			Weight: svc.TrustScoreWeights.Cancellation,
+		},
+		Validation: types.TrustScoreComponent{
+			Value:  rating.ValidationFailureRate,
+			Score:  rating.ValidationScore,
+			Weight: svc.TrustScoreWeights.Validation,
+		},
+		FulfillmentTime: types.TrustScoreComponent{
+			Value:  rating.AverageFulfillmentTime,
+			Score:  rating.FulfillmentTimeScore,
+			Weight: svc.TrustScoreWeights.FulfillmentTime,
+		},
+		Disputes: types.TrustScoreComponent{
+			Value:  rating.DisputeRate,
+			Score:  rating.DisputeScore,
+			Weight: svc.TrustScoreWeights.Disputes,
+		},
+		UpdatedAt: rating.UpdatedAt,
+	})
+}
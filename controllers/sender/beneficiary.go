@@ -0,0 +1,344 @@
+package sender
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// CreateBeneficiary controller saves a verified recipient to the sender's address book
+func (ctrl *SenderController) CreateBeneficiary(ctx *gin.Context) {
+	var payload types.BeneficiaryPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	exists, err := storage.Client.Beneficiary.
+		Query().
+		Where(
+			beneficiary.InstitutionEQ(payload.Institution),
+			beneficiary.AccountIdentifierEQ(payload.AccountIdentifier),
+			beneficiary.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to save beneficiary", nil)
+		return
+	}
+
+	if exists {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "AccountIdentifier",
+			Message: "Beneficiary already exists",
+		})
+		return
+	}
+
+	verifiedAccountName, ok := verifyBeneficiaryAccount(ctx, payload)
+	if !ok {
+		return
+	}
+
+	create := storage.Client.Beneficiary.
+		Create().
+		SetInstitution(payload.Institution).
+		SetAccountIdentifier(payload.AccountIdentifier).
+		SetAccountName(payload.AccountName).
+		SetMemo(payload.Memo).
+		SetSenderProfile(sender)
+
+	if verifiedAccountName != "" {
+		create.
+			SetVerifiedAccountName(verifiedAccountName).
+			SetVerifiedAt(time.Now())
+	}
+
+	savedBeneficiary, err := create.Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to save beneficiary", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Beneficiary saved successfully", beneficiaryResponse(savedBeneficiary))
+}
+
+// GetBeneficiaries controller lists the beneficiaries in the sender's address book
+func (ctrl *SenderController) GetBeneficiaries(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	beneficiaries, err := storage.Client.Beneficiary.
+		Query().
+		Where(beneficiary.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))).
+		Order(ent.Asc(beneficiary.FieldAccountName)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch beneficiaries", nil)
+		return
+	}
+
+	response := make([]types.BeneficiaryResponse, len(beneficiaries))
+	for i, b := range beneficiaries {
+		response[i] = beneficiaryResponse(b)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Beneficiaries fetched successfully", response)
+}
+
+// GetBeneficiaryByID controller fetches a beneficiary from the sender's address book
+func (ctrl *SenderController) GetBeneficiaryByID(ctx *gin.Context) {
+	savedBeneficiary, ok := getSenderBeneficiary(ctx)
+	if !ok {
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Beneficiary fetched successfully", beneficiaryResponse(savedBeneficiary))
+}
+
+// UpdateBeneficiary controller updates a beneficiary in the sender's address book.
+// The account is verified again when its institution or account identifier changes.
+func (ctrl *SenderController) UpdateBeneficiary(ctx *gin.Context) {
+	var payload types.BeneficiaryPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	savedBeneficiary, ok := getSenderBeneficiary(ctx)
+	if !ok {
+		return
+	}
+
+	update := savedBeneficiary.Update().
+		SetAccountName(payload.AccountName).
+		SetMemo(payload.Memo)
+
+	if payload.Institution != savedBeneficiary.Institution || payload.AccountIdentifier != savedBeneficiary.AccountIdentifier {
+		exists, err := storage.Client.Beneficiary.
+			Query().
+			Where(
+				beneficiary.IDNEQ(savedBeneficiary.ID),
+				beneficiary.InstitutionEQ(payload.Institution),
+				beneficiary.AccountIdentifierEQ(payload.AccountIdentifier),
+				beneficiary.HasSenderProfileWith(senderprofile.IDEQ(savedBeneficiary.Edges.SenderProfile.ID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update beneficiary", nil)
+			return
+		}
+
+		if exists {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "AccountIdentifier",
+				Message: "Beneficiary already exists",
+			})
+			return
+		}
+
+		verifiedAccountName, ok := verifyBeneficiaryAccount(ctx, payload)
+		if !ok {
+			return
+		}
+
+		update.
+			SetInstitution(payload.Institution).
+			SetAccountIdentifier(payload.AccountIdentifier)
+
+		if verifiedAccountName != "" {
+			update.
+				SetVerifiedAccountName(verifiedAccountName).
+				SetVerifiedAt(time.Now())
+		} else {
+			update.
+				ClearVerifiedAccountName().
+				ClearVerifiedAt()
+		}
+	}
+
+	savedBeneficiary, err := update.Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update beneficiary", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Beneficiary updated successfully", beneficiaryResponse(savedBeneficiary))
+}
+
+// DeleteBeneficiary controller removes a beneficiary from the sender's address book
+func (ctrl *SenderController) DeleteBeneficiary(ctx *gin.Context) {
+	savedBeneficiary, ok := getSenderBeneficiary(ctx)
+	if !ok {
+		return
+	}
+
+	err := storage.Client.Beneficiary.DeleteOne(savedBeneficiary).Exec(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to delete beneficiary", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Beneficiary deleted successfully", nil)
+}
+
+// getSenderBeneficiary fetches the beneficiary in the route param from the sender's address book.
+// It writes the error response when the beneficiary can't be fetched.
+func getSenderBeneficiary(ctx *gin.Context) (*ent.Beneficiary, bool) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid beneficiary ID", nil)
+		return nil, false
+	}
+
+	savedBeneficiary, err := storage.Client.Beneficiary.
+		Query().
+		Where(
+			beneficiary.IDEQ(id),
+			beneficiary.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Beneficiary not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch beneficiary", nil)
+		}
+		return nil, false
+	}
+
+	return savedBeneficiary, true
+}
+
+// getBeneficiaryRecipient fetches a beneficiary from the sender's address book as an order recipient.
+// The verified account name is used when the account was verified.
+// It writes the error response when the beneficiary can't be fetched.
+func getBeneficiaryRecipient(ctx *gin.Context, sender *ent.SenderProfile, beneficiaryID string) (*types.PaymentOrderRecipient, bool) {
+	savedBeneficiary, err := storage.Client.Beneficiary.
+		Query().
+		Where(
+			beneficiary.IDEQ(uuid.MustParse(beneficiaryID)),
+			beneficiary.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "BeneficiaryID",
+				Message: "Beneficiary not found",
+			})
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch beneficiary", nil)
+		}
+		return nil, false
+	}
+
+	accountName := savedBeneficiary.AccountName
+	if savedBeneficiary.VerifiedAccountName != "" {
+		accountName = savedBeneficiary.VerifiedAccountName
+	}
+
+	return &types.PaymentOrderRecipient{
+		Institution:       savedBeneficiary.Institution,
+		AccountIdentifier: savedBeneficiary.AccountIdentifier,
+		AccountName:       accountName,
+		Memo:              savedBeneficiary.Memo,
+	}, true
+}
+
+// verifyBeneficiaryAccount fetches the name on a beneficiary's account from the provider nodes.
+// An empty name is returned for currencies whose accounts can't be verified yet.
+// It writes the error response when the account can't be verified.
+func verifyBeneficiaryAccount(ctx *gin.Context, payload types.BeneficiaryPayload) (string, bool) {
+	institution, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(payload.Institution)).
+		WithFiatCurrency().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Institution",
+			Message: "Institution is not supported",
+		})
+		return "", false
+	}
+
+	// TODO: Remove this after testing non-NGN institutions
+	if institution.Edges.FiatCurrency.Code != "NGN" {
+		return "", true
+	}
+
+	accountName, err := u.VerifyAccountName(ctx, institution.Edges.FiatCurrency.Code, types.VerifyAccountRequest{
+		Institution:       payload.Institution,
+		AccountIdentifier: payload.AccountIdentifier,
+	})
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "Failed to verify account", nil)
+		return "", false
+	}
+
+	return accountName, true
+}
+
+// beneficiaryResponse converts a beneficiary to its response type
+func beneficiaryResponse(b *ent.Beneficiary) types.BeneficiaryResponse {
+	response := types.BeneficiaryResponse{
+		ID:                  b.ID,
+		Institution:         b.Institution,
+		AccountIdentifier:   b.AccountIdentifier,
+		AccountName:         b.AccountName,
+		Memo:                b.Memo,
+		VerifiedAccountName: b.VerifiedAccountName,
+		CreatedAt:           b.CreatedAt,
+		UpdatedAt:           b.UpdatedAt,
+	}
+	if !b.VerifiedAt.IsZero() {
+		response.VerifiedAt = &b.VerifiedAt
+	}
+	return response
+}
@@ -0,0 +1,243 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBeneficiaries(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:beneficiaries?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Mock the provider node account verification
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	httpmock.RegisterResponder("POST", "https://node.example.com/verify_account",
+		func(r *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"status":  "success",
+				"message": "Account name was fetched successfully",
+				"data":    "JOHN DOE",
+			})
+		},
+	)
+
+	// Setup test data
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	providerUser, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "provider",
+		"email": "beneficiaryprovider@test.com",
+	})
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":         providerUser.ID,
+		"currency_id":     currency.ID,
+		"host_identifier": "https://node.example.com",
+	})
+	assert.NoError(t, err)
+
+	_, err = provider.Update().
+		SetIsActive(true).
+		SetIsAvailable(true).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	senderUser, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "beneficiarysender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(senderUser.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewSenderController()
+
+	v1 := router.Group("/v1/sender/")
+	v1.Use(func(ctx *gin.Context) {
+		ctx.Set("sender", sender)
+		ctx.Next()
+	})
+	v1.POST("beneficiaries", ctrl.CreateBeneficiary)
+	v1.GET("beneficiaries", ctrl.GetBeneficiaries)
+	v1.GET("beneficiaries/:id", ctrl.GetBeneficiaryByID)
+	v1.PUT("beneficiaries/:id", ctrl.UpdateBeneficiary)
+	v1.DELETE("beneficiaries/:id", ctrl.DeleteBeneficiary)
+	v1.POST("orders", ctrl.InitiatePaymentOrder)
+
+	var beneficiaryID string
+
+	t.Run("CreateBeneficiary", func(t *testing.T) {
+		t.Run("with a valid payload", func(t *testing.T) {
+			payload := types.BeneficiaryPayload{
+				Institution:       "ABNGNGLA",
+				AccountIdentifier: "0123456789",
+				AccountName:       "John Doe",
+				Memo:              "Rent",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/beneficiaries", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data types.BeneficiaryResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "JOHN DOE", response.Data.VerifiedAccountName)
+			assert.NotNil(t, response.Data.VerifiedAt)
+			assert.Equal(t, 1, httpmock.GetTotalCallCount())
+			beneficiaryID = response.Data.ID.String()
+		})
+
+		t.Run("with an existing account", func(t *testing.T) {
+			payload := types.BeneficiaryPayload{
+				Institution:       "ABNGNGLA",
+				AccountIdentifier: "0123456789",
+				AccountName:       "Johnny Doe",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/beneficiaries", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with an unsupported institution", func(t *testing.T) {
+			payload := types.BeneficiaryPayload{
+				Institution:       "UNKNOWN",
+				AccountIdentifier: "0123456789",
+				AccountName:       "John Doe",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/beneficiaries", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("GetBeneficiaries", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", "/v1/sender/beneficiaries", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data []types.BeneficiaryResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+	})
+
+	t.Run("UpdateBeneficiary", func(t *testing.T) {
+		t.Run("keeps the verification of an unchanged account", func(t *testing.T) {
+			payload := types.BeneficiaryPayload{
+				Institution:       "ABNGNGLA",
+				AccountIdentifier: "0123456789",
+				AccountName:       "Johnny",
+				Memo:              "School fees",
+			}
+
+			res, err := test.PerformRequest(t, "PUT", "/v1/sender/beneficiaries/"+beneficiaryID, payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.BeneficiaryResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Johnny", response.Data.AccountName)
+			assert.Equal(t, "JOHN DOE", response.Data.VerifiedAccountName)
+			assert.Equal(t, 1, httpmock.GetTotalCallCount())
+		})
+
+		t.Run("verifies a changed account", func(t *testing.T) {
+			payload := types.BeneficiaryPayload{
+				Institution:       "ABNGNGLA",
+				AccountIdentifier: "9876543210",
+				AccountName:       "Johnny",
+				Memo:              "School fees",
+			}
+
+			res, err := test.PerformRequest(t, "PUT", "/v1/sender/beneficiaries/"+beneficiaryID, payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Equal(t, 2, httpmock.GetTotalCallCount())
+		})
+	})
+
+	t.Run("InitiatePaymentOrder", func(t *testing.T) {
+		t.Run("with both a beneficiary and a recipient", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":        "100",
+				"token":         "USDT",
+				"network":       "localhost",
+				"beneficiaryId": beneficiaryID,
+				"recipient": map[string]interface{}{
+					"institution":       "ABNGNGLA",
+					"accountIdentifier": "0123456789",
+					"accountName":       "John Doe",
+					"memo":              "Rent",
+				},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with an unknown beneficiary", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":        "100",
+				"token":         "USDT",
+				"network":       "localhost",
+				"beneficiaryId": "1f3c6b5e-8a4d-4b8e-9c1a-2d3e4f5a6b7c",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/orders", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "BeneficiaryID", response.Data.(map[string]interface{})["field"])
+		})
+	})
+
+	t.Run("DeleteBeneficiary", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "DELETE", "/v1/sender/beneficiaries/"+beneficiaryID, nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		res, err = test.PerformRequest(t, "GET", "/v1/sender/beneficiaries/"+beneficiaryID, nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
@@ -0,0 +1,119 @@
+package sender
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// OpenDispute controller opens a dispute on a payment order whose recipient claims they were not paid
+func (ctrl *SenderController) OpenDispute(ctx *gin.Context) {
+	var payload types.OpenDisputePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	paymentOrder, ok := getSenderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if paymentOrder.IsSandbox {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Disputes are not available in sandbox mode", nil)
+		return
+	}
+
+	disputes, err := ctrl.disputeService.OpenDispute(ctx, paymentOrder, payload.AccountIdentifier, payload.Reason)
+	if err != nil {
+		switch err {
+		case svc.ErrOrderNotDisputable, svc.ErrDisputeAlreadyOpen:
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to open dispute", err.Error())
+		default:
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		}
+		return
+	}
+
+	response := make([]types.DisputeResponse, len(disputes))
+	for i, d := range disputes {
+		response[i] = svc.DisputeResponse(d, paymentOrder.ID)
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Dispute opened successfully", response)
+}
+
+// GetPaymentOrderDisputes controller fetches the disputes opened on a payment order
+func (ctrl *SenderController) GetPaymentOrderDisputes(ctx *gin.Context) {
+	paymentOrder, ok := getSenderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	disputes, err := paymentOrder.
+		QueryDisputes().
+		WithLockPaymentOrder().
+		Order(ent.Desc(dispute.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	response := make([]types.DisputeResponse, len(disputes))
+	for i, d := range disputes {
+		response[i] = svc.DisputeResponse(d, paymentOrder.ID)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", response)
+}
+
+// getSenderPaymentOrder fetches the payment order in the URL that belongs to the sender in the context.
+// It responds with an error and returns false when the payment order can't be fetched
+func getSenderPaymentOrder(ctx *gin.Context) (*ent.PaymentOrder, bool) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return nil, false
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			senderPaymentOrder(ctx, sender),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		}
+		return nil, false
+	}
+
+	return paymentOrder, true
+}
@@ -0,0 +1,266 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	svc "github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisputes(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:disputes?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Capture the webhook events sent to the sender
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	events := []string{}
+	httpmock.RegisterResponder("POST", "https://example.com/hook",
+		func(r *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(r.Body)
+			var payload struct {
+				Event string `json:"event"`
+			}
+			_ = json.Unmarshal(body, &payload)
+			events = append(events, payload.Event)
+			return httpmock.NewBytesResponse(200, nil), nil
+		},
+	)
+
+	// Setup test data
+	_, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "disputes@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(user.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Webhooks are signed with the primary API key
+	_, _, err = svc.NewAPIKeyService().GenerateAPIKey(context.Background(), nil, sender, nil)
+	assert.NoError(t, err)
+
+	paymentOrder, err := db.Client.PaymentOrder.
+		Create().
+		SetSenderProfile(sender).
+		SetAmount(decimal.NewFromInt(100)).
+		SetAmountPaid(decimal.NewFromInt(100)).
+		SetAmountReturned(decimal.Zero).
+		SetPercentSettled(decimal.NewFromInt(100)).
+		SetNetworkFee(network.Fee).
+		SetProtocolFee(decimal.Zero).
+		SetSenderFee(decimal.Zero).
+		SetToken(token).
+		SetRate(decimal.NewFromInt(1500)).
+		SetReceiveAddressText("0x1234567890123456789012345678901234567890").
+		SetFeePercent(decimal.Zero).
+		SetReturnAddress("0x0987654321098765432109876543210987654321").
+		SetGatewayID("0xdispute").
+		SetStatus(paymentorder.StatusSettled).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	lockOrder, err := db.Client.LockPaymentOrder.
+		Create().
+		SetGatewayID("0xdispute").
+		SetAmount(decimal.NewFromInt(100)).
+		SetRate(decimal.NewFromInt(1500)).
+		SetAmountFulfilled(decimal.Zero).
+		SetOrderPercent(decimal.NewFromInt(100)).
+		SetBlockNumber(12345).
+		SetInstitution("ABNGNGLA").
+		SetAccountIdentifier("0123456789").
+		SetAccountName("John Doe").
+		SetStatus(lockpaymentorder.StatusProcessing).
+		SetToken(token).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// A split recipient paid out by another lock order with the same gateway ID
+	splitOrder, err := db.Client.LockPaymentOrder.
+		Create().
+		SetGatewayID("0xdispute").
+		SetAmount(decimal.NewFromInt(40)).
+		SetRate(decimal.NewFromInt(1500)).
+		SetAmountFulfilled(decimal.Zero).
+		SetOrderPercent(decimal.NewFromInt(40)).
+		SetBlockNumber(12345).
+		SetInstitution("ABNGNGLA").
+		SetAccountIdentifier("9876543210").
+		SetAccountName("Jane Doe").
+		SetStatus(lockpaymentorder.StatusProcessing).
+		SetToken(token).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewSenderController()
+
+	v1 := router.Group("/v1/sender/")
+	v1.Use(func(ctx *gin.Context) {
+		ctx.Set("sender", sender)
+		ctx.Next()
+	})
+	v1.POST("orders/:id/disputes", ctrl.OpenDispute)
+	v1.GET("orders/:id/disputes", ctrl.GetPaymentOrderDisputes)
+
+	disputesPath := "/v1/sender/orders/" + paymentOrder.ID.String() + "/disputes"
+	payload := map[string]interface{}{
+		"reason": "Recipient did not receive the funds",
+	}
+
+	t.Run("OpenDispute", func(t *testing.T) {
+		t.Run("without a reason", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputesPath, map[string]interface{}{}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("before the order is paid out", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputesPath, payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("on a validated order of a recipient", func(t *testing.T) {
+			_, err := lockOrder.Update().
+				SetStatus(lockpaymentorder.StatusValidated).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			res, err := test.PerformRequest(t, "POST", disputesPath, map[string]interface{}{
+				"reason":            payload["reason"],
+				"accountIdentifier": lockOrder.AccountIdentifier,
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data []types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 1)
+			assert.Equal(t, paymentOrder.ID, response.Data[0].OrderID)
+			assert.Equal(t, lockOrder.AccountIdentifier, response.Data[0].AccountIdentifier)
+			assert.Equal(t, string(dispute.StatusAwaitingProviderEvidence), response.Data[0].Status)
+			assert.NotNil(t, response.Data[0].EvidenceDueAt)
+			assert.True(t, response.Data[0].ResolutionDueAt.After(*response.Data[0].EvidenceDueAt))
+			assert.Equal(t, []string{svc.DisputeEventOpened}, events)
+		})
+
+		t.Run("with an unknown recipient", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputesPath, map[string]interface{}{
+				"reason":            payload["reason"],
+				"accountIdentifier": "0000000000",
+			}, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("on the remaining paid out split recipients", func(t *testing.T) {
+			_, err := splitOrder.Update().
+				SetStatus(lockpaymentorder.StatusSettled).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			res, err := test.PerformRequest(t, "POST", disputesPath, payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data []types.DisputeResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 1)
+			assert.Equal(t, splitOrder.AccountIdentifier, response.Data[0].AccountIdentifier)
+			assert.Equal(t, []string{svc.DisputeEventOpened, svc.DisputeEventOpened}, events)
+		})
+
+		t.Run("with an open dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", disputesPath, payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with an open dispute on the same lock order", func(t *testing.T) {
+			// The unique index rejects a second unresolved dispute even if the existence check is raced
+			_, err := db.Client.Dispute.
+				Create().
+				SetLockPaymentOrder(lockOrder).
+				SetPaymentOrder(paymentOrder).
+				SetReason("Duplicate").
+				SetStatus(dispute.StatusOpen).
+				SetEvidenceDueAt(time.Now()).
+				SetResolutionDueAt(time.Now()).
+				Save(context.Background())
+			assert.True(t, ent.IsConstraintError(err))
+		})
+	})
+
+	t.Run("GetPaymentOrderDisputes", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", disputesPath, nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data []types.DisputeResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 2)
+		assert.Equal(t, payload["reason"], response.Data[0].Reason)
+	})
+}
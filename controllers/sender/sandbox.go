@@ -0,0 +1,252 @@
+package sender
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	svc "github.com/paycrest/aggregator/services"
+	orderService "github.com/paycrest/aggregator/services/order"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// SimulateSandboxDeposit controller simulates a token transfer to the receive address of a sandbox order.
+// The deposit goes through the same indexing as production, so wrong-amount policies and webhooks apply.
+func (ctrl *SenderController) SimulateSandboxDeposit(ctx *gin.Context) {
+	var payload types.SandboxDepositPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil && err != io.EOF {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	paymentOrder, ok := getSandboxPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	receiveAddress := paymentOrder.Edges.ReceiveAddress
+	if paymentOrder.Status != paymentorder.StatusInitiated || receiveAddress == nil || receiveAddress.Status != receiveaddress.StatusUnused {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Payment order is not awaiting a deposit", nil)
+		return
+	}
+
+	// Pay the outstanding order amount with fees unless an amount is provided
+	token := paymentOrder.Edges.Token
+	amount := payload.Amount
+	if amount.IsZero() {
+		fees := paymentOrder.NetworkFee.Add(paymentOrder.SenderFee).Add(paymentOrder.ProtocolFee)
+		amount = paymentOrder.Amount.Add(fees).Round(int32(token.Decimals)).Sub(paymentOrder.AmountPaid)
+	}
+	if !amount.IsPositive() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Amount",
+			Message: "Amount must be greater than zero",
+		})
+		return
+	}
+
+	fromAddress := payload.FromAddress
+	if fromAddress == "" {
+		fromAddress = paymentOrder.ReturnAddress
+	}
+	if fromAddress == "" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "FromAddress",
+			Message: "From address is required when the order has no return address",
+		})
+		return
+	}
+
+	txHash, err := orderService.RandomTxHash()
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate deposit", nil)
+		return
+	}
+
+	indexerService := svc.NewIndexerService(orderService.NewOrderSandbox())
+	_, err = indexerService.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, paymentOrder, &types.TokenTransferEvent{
+		BlockNumber: uint64(paymentOrder.BlockNumber + 1),
+		TxHash:      txHash,
+		From:        fromAddress,
+		To:          receiveAddress.Address,
+		Value:       u.ToSubunit(amount, token.Decimals),
+	})
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate deposit", nil)
+		return
+	}
+
+	sandboxOrderResponse(ctx, paymentOrder.ID, "Deposit simulated successfully")
+}
+
+// SimulateSandboxSettlement controller simulates a provider settling a pending sandbox order.
+// A partial percent settles the order in splits like a provider with limited liquidity.
+func (ctrl *SenderController) SimulateSandboxSettlement(ctx *gin.Context) {
+	var payload types.SandboxSettlePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil && err != io.EOF {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	percent := payload.Percent
+	if percent.IsZero() {
+		percent = decimal.NewFromInt(100)
+	}
+	if !percent.IsPositive() || percent.GreaterThan(decimal.NewFromInt(100)) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Percent",
+			Message: "Percent must be greater than 0 and at most 100",
+		})
+		return
+	}
+
+	paymentOrder, ok := getSandboxPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if paymentOrder.Status != paymentorder.StatusPending {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only pending orders can be settled", nil)
+		return
+	}
+
+	txHash, err := orderService.RandomTxHash()
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate settlement", nil)
+		return
+	}
+
+	// Settle percent is in BPS where 100000 is 100%
+	indexerService := svc.NewIndexerService(orderService.NewOrderSandbox())
+	err = indexerService.UpdateOrderStatusSettled(ctx, paymentOrder.Edges.Token.Edges.Network, &types.OrderSettledEvent{
+		BlockNumber:   uint64(paymentOrder.BlockNumber + 1),
+		TxHash:        txHash,
+		OrderId:       common.HexToHash(paymentOrder.GatewayID),
+		SettlePercent: percent.Mul(decimal.NewFromInt(1000)).BigInt(),
+	})
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate settlement", nil)
+		return
+	}
+
+	sandboxOrderResponse(ctx, paymentOrder.ID, "Settlement simulated successfully")
+}
+
+// SimulateSandboxRefund controller simulates a pending sandbox order being refunded when no provider can fulfil it
+func (ctrl *SenderController) SimulateSandboxRefund(ctx *gin.Context) {
+	paymentOrder, ok := getSandboxPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if paymentOrder.Status != paymentorder.StatusPending || !paymentOrder.PercentSettled.IsZero() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only pending orders that are not partially settled can be refunded", nil)
+		return
+	}
+
+	txHash, err := orderService.RandomTxHash()
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate refund", nil)
+		return
+	}
+
+	indexerService := svc.NewIndexerService(orderService.NewOrderSandbox())
+	err = indexerService.UpdateOrderStatusRefunded(ctx, paymentOrder.Edges.Token.Edges.Network, &types.OrderRefundedEvent{
+		BlockNumber: uint64(paymentOrder.BlockNumber + 1),
+		TxHash:      txHash,
+		Fee:         u.ToSubunit(paymentOrder.NetworkFee, paymentOrder.Edges.Token.Decimals),
+		OrderId:     common.HexToHash(paymentOrder.GatewayID),
+	})
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to simulate refund", nil)
+		return
+	}
+
+	sandboxOrderResponse(ctx, paymentOrder.ID, "Refund simulated successfully")
+}
+
+// getSandboxPaymentOrder fetches the sandbox payment order in the route param for the sender in the context.
+// It writes the error response when the order can't be fetched.
+func getSandboxPaymentOrder(ctx *gin.Context) (*ent.PaymentOrder, bool) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	if !ctx.GetBool("sandbox") {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Sandbox endpoints require a sandbox API key", nil)
+		return nil, false
+	}
+
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return nil, false
+	}
+
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			senderPaymentOrder(ctx, sender),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithRecipient().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		}
+		return nil, false
+	}
+
+	return paymentOrder, true
+}
+
+// sandboxOrderResponse refetches a sandbox order after a simulated event and writes it to the response
+func sandboxOrderResponse(ctx *gin.Context, orderID uuid.UUID, message string) {
+	paymentOrder, err := storage.Client.PaymentOrder.Get(ctx, orderID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, &types.SandboxOrderResponse{
+		ID:             paymentOrder.ID,
+		AmountPaid:     paymentOrder.AmountPaid,
+		AmountReturned: paymentOrder.AmountReturned,
+		PercentSettled: paymentOrder.PercentSettled,
+		TxHash:         paymentOrder.TxHash,
+		GatewayID:      paymentOrder.GatewayID,
+		Status:         paymentOrder.Status,
+	})
+}
@@ -0,0 +1,269 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	svc "github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandbox(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:sandbox?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Capture the webhook events sent to the sender
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	events := []string{}
+	httpmock.RegisterResponder("POST", "https://example.com/hook",
+		func(r *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(r.Body)
+			var payload struct {
+				Event string `json:"event"`
+			}
+			_ = json.Unmarshal(body, &payload)
+			events = append(events, payload.Event)
+			return httpmock.NewBytesResponse(200, nil), nil
+		},
+	)
+
+	// Setup test data
+	_, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "sandbox@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(user.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Webhooks are signed with the primary API key
+	_, _, err = svc.NewAPIKeyService().GenerateAPIKey(context.Background(), nil, sender, nil)
+	assert.NoError(t, err)
+
+	address, salt, err := svc.NewReceiveAddressService().CreateSandboxAddress(context.Background(), network.Identifier)
+	assert.NoError(t, err)
+
+	receiveAddress, err := db.Client.ReceiveAddress.
+		Create().
+		SetAddress(address).
+		SetSalt(salt).
+		SetStatus(receiveaddress.StatusUnused).
+		SetValidUntil(time.Now().Add(time.Hour)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	paymentOrder, err := db.Client.PaymentOrder.
+		Create().
+		SetSenderProfile(sender).
+		SetAmount(decimal.NewFromInt(100)).
+		SetAmountPaid(decimal.Zero).
+		SetAmountReturned(decimal.Zero).
+		SetPercentSettled(decimal.Zero).
+		SetNetworkFee(network.Fee).
+		SetProtocolFee(decimal.Zero).
+		SetSenderFee(decimal.Zero).
+		SetToken(token).
+		SetRate(decimal.NewFromInt(1500)).
+		SetReceiveAddress(receiveAddress).
+		SetReceiveAddressText(receiveAddress.Address).
+		SetFeePercent(decimal.Zero).
+		SetReturnAddress("0x0987654321098765432109876543210987654321").
+		SetIsSandbox(true).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	_, err = db.Client.PaymentOrderRecipient.
+		Create().
+		SetInstitution("ABNGNGLA").
+		SetAccountIdentifier("0123456789").
+		SetAccountName("John Doe").
+		SetMemo("Sandbox").
+		SetPaymentOrder(paymentOrder).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewSenderController()
+
+	sandbox := router.Group("/v1/sender/")
+	sandbox.Use(func(ctx *gin.Context) {
+		ctx.Set("sender", sender)
+		ctx.Set("sandbox", true)
+		ctx.Next()
+	})
+	sandbox.POST("sandbox/orders/:id/deposit", ctrl.SimulateSandboxDeposit)
+	sandbox.POST("sandbox/orders/:id/settle", ctrl.SimulateSandboxSettlement)
+	sandbox.POST("sandbox/orders/:id/refund", ctrl.SimulateSandboxRefund)
+	sandbox.POST("orders/:id/cancel", ctrl.CancelPaymentOrder)
+
+	live := router.Group("/v1/live/")
+	live.Use(func(ctx *gin.Context) {
+		ctx.Set("sender", sender)
+		ctx.Next()
+	})
+	live.POST("sandbox/orders/:id/deposit", ctrl.SimulateSandboxDeposit)
+	live.GET("orders/:id", ctrl.GetPaymentOrderByID)
+
+	orderPath := "/v1/sender/sandbox/orders/" + paymentOrder.ID.String()
+
+	t.Run("requires a sandbox API key", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", "/v1/live/sandbox/orders/"+paymentOrder.ID.String()+"/deposit", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+
+		// Sandbox orders are hidden from live requests
+		res, err = test.PerformRequest(t, "GET", "/v1/live/orders/"+paymentOrder.ID.String(), nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("SimulateSandboxRefund before a deposit", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", orderPath+"/refund", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("SimulateSandboxDeposit", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", orderPath+"/deposit", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.SandboxOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusPending, response.Data.Status)
+		assert.True(t, response.Data.AmountPaid.Equal(decimal.NewFromFloat(100.1)))
+		assert.NotEmpty(t, response.Data.GatewayID)
+		assert.Equal(t, []string{"payment_order.pending"}, events)
+
+		// The receive address can only be funded once
+		res, err = test.PerformRequest(t, "POST", orderPath+"/deposit", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("SimulateSandboxSettlement", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", orderPath+"/settle", map[string]interface{}{
+			"percent": "40",
+		}, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.SandboxOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusPending, response.Data.Status)
+		assert.True(t, response.Data.PercentSettled.Equal(decimal.NewFromInt(40)))
+
+		// Partially settled orders can't be refunded
+		res, err = test.PerformRequest(t, "POST", orderPath+"/refund", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		res, err = test.PerformRequest(t, "POST", orderPath+"/settle", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusSettled, response.Data.Status)
+		assert.True(t, response.Data.PercentSettled.Equal(decimal.NewFromInt(100)))
+		assert.Equal(t, []string{"payment_order.pending", "payment_order.pending", "payment_order.settled"}, events)
+	})
+	t.Run("CancelPaymentOrder refunds the partial deposit without a transaction", func(t *testing.T) {
+		address, salt, err := svc.NewReceiveAddressService().CreateSandboxAddress(context.Background(), network.Identifier)
+		assert.NoError(t, err)
+
+		receiveAddress, err := db.Client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetSalt(salt).
+			SetStatus(receiveaddress.StatusUnused).
+			SetValidUntil(time.Now().Add(time.Hour)).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		underpaidOrder, err := db.Client.PaymentOrder.
+			Create().
+			SetSenderProfile(sender).
+			SetAmount(decimal.NewFromInt(100)).
+			SetAmountPaid(decimal.NewFromInt(50)).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(network.Fee).
+			SetProtocolFee(decimal.Zero).
+			SetSenderFee(decimal.Zero).
+			SetToken(token).
+			SetRate(decimal.NewFromInt(1500)).
+			SetReceiveAddress(receiveAddress).
+			SetReceiveAddressText(receiveAddress.Address).
+			SetFeePercent(decimal.Zero).
+			SetReturnAddress("0x0987654321098765432109876543210987654321").
+			SetIsSandbox(true).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		res, err := test.PerformRequest(t, "POST", "/v1/sender/orders/"+underpaidOrder.ID.String()+"/cancel", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		// The sandbox order service returns the deposit less the network fee
+		assert.Eventually(t, func() bool {
+			order, err := db.Client.PaymentOrder.Get(context.Background(), underpaidOrder.ID)
+			return err == nil && order.AmountReturned.Equal(decimal.NewFromFloat(49.9))
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
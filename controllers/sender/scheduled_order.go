@@ -0,0 +1,466 @@
+package sender
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/network"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	tokenEnt "github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// CreateScheduledOrder controller schedules a one-off or recurring payment order
+func (ctrl *SenderController) CreateScheduledOrder(ctx *gin.Context) {
+	var payload types.ScheduledOrderPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	if ctx.GetBool("sandbox") {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Scheduled orders are not available in sandbox mode", nil)
+		return
+	}
+
+	if payload.BeneficiaryID != "" {
+		payload.Recipient, ok = getBeneficiaryRecipient(ctx, sender, payload.BeneficiaryID)
+		if !ok {
+			return
+		}
+	}
+
+	if !payload.Amount.IsPositive() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Amount",
+			Message: "Amount must be greater than zero",
+		})
+		return
+	}
+
+	if payload.Rate.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Rate",
+			Message: "Rate cannot be negative",
+		})
+		return
+	}
+
+	if payload.Recipient.ProviderID != "" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Recipient",
+			Message: "Scheduled orders to a specific provider are not supported",
+		})
+		return
+	}
+
+	// Get token from DB
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenEnt.SymbolEQ(payload.Token),
+			tokenEnt.HasNetworkWith(network.IdentifierEQ(payload.Network)),
+			tokenEnt.IsEnabledEQ(true),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is not supported",
+		})
+		return
+	}
+
+	tokenConfigured, err := storage.Client.SenderOrderToken.
+		Query().
+		Where(
+			senderordertoken.HasTokenWith(tokenEnt.IDEQ(token.ID)),
+			senderordertoken.HasSenderWith(senderprofile.IDEQ(sender.ID)),
+			senderordertoken.FeeAddressNEQ(""),
+			senderordertoken.RefundAddressNEQ(""),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to schedule payment order", nil)
+		return
+	}
+
+	if !tokenConfigured {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is not configured",
+		})
+		return
+	}
+
+	institutionExists, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(payload.Recipient.Institution)).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to schedule payment order", nil)
+		return
+	}
+
+	if !institutionExists {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Recipient",
+			Message: "Invalid institution code provided",
+		})
+		return
+	}
+
+	// Validate the schedule
+	startAt := time.Now()
+	if payload.StartAt != nil && payload.StartAt.After(startAt) {
+		startAt = *payload.StartAt
+	}
+
+	var endAt time.Time
+	if payload.EndAt != nil {
+		if !payload.EndAt.After(startAt) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "EndAt",
+				Message: "End time must be after the start time",
+			})
+			return
+		}
+		endAt = *payload.EndAt
+	}
+
+	nextRunAt, err := u.NextScheduledRun(payload.CronExpression, startAt, endAt, time.Time{})
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "CronExpression",
+			Message: "Invalid cron expression",
+		})
+		return
+	}
+
+	if nextRunAt.IsZero() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "EndAt",
+			Message: "No runs are scheduled before the end time",
+		})
+		return
+	}
+
+	create := storage.Client.ScheduledOrder.
+		Create().
+		SetSenderProfile(sender).
+		SetToken(token).
+		SetAmount(payload.Amount).
+		SetRate(payload.Rate).
+		SetInstitution(payload.Recipient.Institution).
+		SetAccountIdentifier(payload.Recipient.AccountIdentifier).
+		SetAccountName(payload.Recipient.AccountName).
+		SetMemo(payload.Recipient.Memo).
+		SetCronExpression(payload.CronExpression).
+		SetStartAt(startAt).
+		SetNextRunAt(nextRunAt)
+
+	if !endAt.IsZero() {
+		create.SetEndAt(endAt)
+	}
+
+	scheduledOrder, err := create.Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to schedule payment order", nil)
+		return
+	}
+	scheduledOrder.Edges.Token = token
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Payment order scheduled successfully", scheduledOrderResponse(scheduledOrder))
+}
+
+// GetScheduledOrders controller lists the scheduled orders of the sender
+func (ctrl *SenderController) GetScheduledOrders(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	scheduledOrders, err := storage.Client.ScheduledOrder.
+		Query().
+		Where(scheduledorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Order(ent.Desc(scheduledorder.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch scheduled orders", nil)
+		return
+	}
+
+	response := make([]types.ScheduledOrderResponse, len(scheduledOrders))
+	for i, scheduledOrder := range scheduledOrders {
+		response[i] = scheduledOrderResponse(scheduledOrder)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled orders fetched successfully", response)
+}
+
+// GetScheduledOrderByID controller fetches a scheduled order of the sender
+func (ctrl *SenderController) GetScheduledOrderByID(ctx *gin.Context) {
+	scheduledOrder, ok := getSenderScheduledOrder(ctx)
+	if !ok {
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled order fetched successfully", scheduledOrderResponse(scheduledOrder))
+}
+
+// GetScheduledOrderRuns controller fetches the run history of a scheduled order, latest first
+func (ctrl *SenderController) GetScheduledOrderRuns(ctx *gin.Context) {
+	scheduledOrder, ok := getSenderScheduledOrder(ctx)
+	if !ok {
+		return
+	}
+
+	// Get page and pageSize query params
+	page, offset, pageSize := u.Paginate(ctx)
+
+	runQuery := scheduledOrder.QueryRuns()
+
+	count, err := runQuery.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch scheduled order runs", nil)
+		return
+	}
+
+	runs, err := runQuery.
+		WithPaymentOrder().
+		Limit(pageSize).
+		Offset(offset).
+		Order(ent.Desc(scheduledorderrun.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch scheduled order runs", nil)
+		return
+	}
+
+	response := make([]types.ScheduledOrderRunResponse, len(runs))
+	for i, run := range runs {
+		response[i] = types.ScheduledOrderRunResponse{
+			ID:           run.ID,
+			ScheduledFor: run.ScheduledFor,
+			Status:       run.Status,
+			Error:        run.Error,
+			CreatedAt:    run.CreatedAt,
+		}
+		if run.Edges.PaymentOrder != nil {
+			response[i].PaymentOrderID = &run.Edges.PaymentOrder.ID
+		}
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled order runs fetched successfully", &types.ScheduledOrderRunList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Runs:         response,
+	})
+}
+
+// PauseScheduledOrder controller stops a scheduled order from running until it is resumed
+func (ctrl *SenderController) PauseScheduledOrder(ctx *gin.Context) {
+	scheduledOrder, ok := getSenderScheduledOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if scheduledOrder.Status != scheduledorder.StatusActive {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only active scheduled orders can be paused", nil)
+		return
+	}
+
+	updatedOrder, err := scheduledOrder.Update().
+		SetStatus(scheduledorder.StatusPaused).
+		ClearNextRunAt().
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to pause scheduled order", nil)
+		return
+	}
+	updatedOrder.Edges.Token = scheduledOrder.Edges.Token
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled order paused successfully", scheduledOrderResponse(updatedOrder))
+}
+
+// ResumeScheduledOrder controller resumes a paused scheduled order.
+// Runs missed while the order was paused are skipped.
+func (ctrl *SenderController) ResumeScheduledOrder(ctx *gin.Context) {
+	scheduledOrder, ok := getSenderScheduledOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if scheduledOrder.Status != scheduledorder.StatusPaused {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only paused scheduled orders can be resumed", nil)
+		return
+	}
+
+	// A one-off order that has not run yet runs as soon as it is resumed
+	lastRunAt := time.Now()
+	if scheduledOrder.CronExpression == "" {
+		lastRunAt = scheduledOrder.LastRunAt
+	}
+
+	nextRunAt, err := u.NextScheduledRun(scheduledOrder.CronExpression, scheduledOrder.StartAt, scheduledOrder.EndAt, lastRunAt)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resume scheduled order", nil)
+		return
+	}
+
+	update := scheduledOrder.Update()
+	if nextRunAt.IsZero() {
+		update.SetStatus(scheduledorder.StatusCompleted)
+	} else {
+		update.
+			SetStatus(scheduledorder.StatusActive).
+			SetNextRunAt(nextRunAt)
+	}
+
+	updatedOrder, err := update.Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resume scheduled order", nil)
+		return
+	}
+	updatedOrder.Edges.Token = scheduledOrder.Edges.Token
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled order resumed successfully", scheduledOrderResponse(updatedOrder))
+}
+
+// CancelScheduledOrder controller stops a scheduled order for good. Its run history is kept.
+func (ctrl *SenderController) CancelScheduledOrder(ctx *gin.Context) {
+	scheduledOrder, ok := getSenderScheduledOrder(ctx)
+	if !ok {
+		return
+	}
+
+	if scheduledOrder.Status == scheduledorder.StatusCompleted || scheduledOrder.Status == scheduledorder.StatusCancelled {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Scheduled order has already ended", nil)
+		return
+	}
+
+	_, err := scheduledOrder.Update().
+		SetStatus(scheduledorder.StatusCancelled).
+		ClearNextRunAt().
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel scheduled order", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Scheduled order cancelled successfully", nil)
+}
+
+// getSenderScheduledOrder fetches the scheduled order in the route param from the sender's scheduled orders.
+// It writes the error response when the scheduled order can't be fetched.
+func getSenderScheduledOrder(ctx *gin.Context) (*ent.ScheduledOrder, bool) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid scheduled order ID", nil)
+		return nil, false
+	}
+
+	scheduledOrder, err := storage.Client.ScheduledOrder.
+		Query().
+		Where(
+			scheduledorder.IDEQ(id),
+			scheduledorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Scheduled order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch scheduled order", nil)
+		}
+		return nil, false
+	}
+
+	return scheduledOrder, true
+}
+
+// scheduledOrderResponse converts a scheduled order with its token and network to its response type
+func scheduledOrderResponse(scheduledOrder *ent.ScheduledOrder) types.ScheduledOrderResponse {
+	response := types.ScheduledOrderResponse{
+		ID:     scheduledOrder.ID,
+		Amount: scheduledOrder.Amount,
+		Rate:   scheduledOrder.Rate,
+		Recipient: types.PaymentOrderRecipient{
+			Institution:       scheduledOrder.Institution,
+			AccountIdentifier: scheduledOrder.AccountIdentifier,
+			AccountName:       scheduledOrder.AccountName,
+			Memo:              scheduledOrder.Memo,
+		},
+		CronExpression: scheduledOrder.CronExpression,
+		StartAt:        scheduledOrder.StartAt,
+		Status:         scheduledOrder.Status,
+		CreatedAt:      scheduledOrder.CreatedAt,
+		UpdatedAt:      scheduledOrder.UpdatedAt,
+	}
+	if token := scheduledOrder.Edges.Token; token != nil {
+		response.Token = token.Symbol
+		if token.Edges.Network != nil {
+			response.Network = token.Edges.Network.Identifier
+		}
+	}
+	if !scheduledOrder.EndAt.IsZero() {
+		response.EndAt = &scheduledOrder.EndAt
+	}
+	if !scheduledOrder.NextRunAt.IsZero() {
+		response.NextRunAt = &scheduledOrder.NextRunAt
+	}
+	if !scheduledOrder.LastRunAt.IsZero() {
+		response.LastRunAt = &scheduledOrder.LastRunAt
+	}
+	return response
+}
@@ -0,0 +1,240 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledOrders(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:scheduledorders?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Setup test data
+	_, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "scheduler@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := db.Client.SenderProfile.
+		Create().
+		SetWebhookURL("https://example.com/hook").
+		SetDomainWhitelist([]string{"example.com"}).
+		SetUserID(user.ID).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	_, err = db.Client.SenderOrderToken.
+		Create().
+		SetSender(sender).
+		SetToken(token).
+		SetFeePercent(decimal.NewFromInt(1)).
+		SetFeeAddress("0x1234567890123456789012345678901234567890").
+		SetRefundAddress("0x0987654321098765432109876543210987654321").
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewSenderController()
+
+	v1 := router.Group("/v1/sender/")
+	v1.Use(func(ctx *gin.Context) {
+		ctx.Set("sender", sender)
+		ctx.Next()
+	})
+	v1.POST("scheduled-orders", ctrl.CreateScheduledOrder)
+	v1.GET("scheduled-orders", ctrl.GetScheduledOrders)
+	v1.GET("scheduled-orders/:id/runs", ctrl.GetScheduledOrderRuns)
+	v1.POST("scheduled-orders/:id/pause", ctrl.PauseScheduledOrder)
+	v1.POST("scheduled-orders/:id/resume", ctrl.ResumeScheduledOrder)
+	v1.DELETE("scheduled-orders/:id", ctrl.CancelScheduledOrder)
+
+	recipient := map[string]interface{}{
+		"institution":       "ABNGNGLA",
+		"accountIdentifier": "0123456789",
+		"accountName":       "John Doe",
+		"memo":              "Salary",
+	}
+
+	var scheduledOrderID string
+
+	t.Run("CreateScheduledOrder", func(t *testing.T) {
+		t.Run("with an invalid cron expression", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":         "100",
+				"token":          "USDT",
+				"network":        "localhost",
+				"recipient":      recipient,
+				"cronExpression": "every month",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with an end time before the start time", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":         "100",
+				"token":          "USDT",
+				"network":        "localhost",
+				"recipient":      recipient,
+				"cronExpression": "0 9 1 * *",
+				"startAt":        time.Now().Add(48 * time.Hour),
+				"endAt":          time.Now().Add(24 * time.Hour),
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("with a monthly schedule", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":         "100",
+				"token":          "USDT",
+				"network":        "localhost",
+				"recipient":      recipient,
+				"cronExpression": "0 9 1 * *",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders", payload, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data types.ScheduledOrderResponse `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, scheduledorder.StatusActive, response.Data.Status)
+			assert.Equal(t, "localhost", response.Data.Network)
+			assert.NotNil(t, response.Data.NextRunAt)
+			assert.Equal(t, 1, response.Data.NextRunAt.Day())
+			assert.Equal(t, 9, response.Data.NextRunAt.UTC().Hour())
+			scheduledOrderID = response.Data.ID.String()
+		})
+	})
+
+	t.Run("PauseScheduledOrder", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders/"+scheduledOrderID+"/pause", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.ScheduledOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledorder.StatusPaused, response.Data.Status)
+		assert.Nil(t, response.Data.NextRunAt)
+
+		res, err = test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders/"+scheduledOrderID+"/pause", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("ResumeScheduledOrder", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders/"+scheduledOrderID+"/resume", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.ScheduledOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledorder.StatusActive, response.Data.Status)
+		assert.NotNil(t, response.Data.NextRunAt)
+	})
+
+	t.Run("GetScheduledOrderRuns", func(t *testing.T) {
+		scheduledOrder, err := db.Client.ScheduledOrder.Query().Only(context.Background())
+		assert.NoError(t, err)
+
+		_, err = db.Client.ScheduledOrderRun.
+			Create().
+			SetScheduledOrder(scheduledOrder).
+			SetScheduledFor(time.Now()).
+			SetStatus(scheduledorderrun.StatusFailed).
+			SetError("fee address or refund address is not configured").
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		res, err := test.PerformRequest(t, "GET", "/v1/sender/scheduled-orders/"+scheduledOrderID+"/runs", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.ScheduledOrderRunList `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.Data.TotalRecords)
+		assert.Equal(t, scheduledorderrun.StatusFailed, response.Data.Runs[0].Status)
+		assert.Nil(t, response.Data.Runs[0].PaymentOrderID)
+	})
+
+	t.Run("CancelScheduledOrder", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "DELETE", "/v1/sender/scheduled-orders/"+scheduledOrderID, nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		res, err = test.PerformRequest(t, "POST", "/v1/sender/scheduled-orders/"+scheduledOrderID+"/resume", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		res, err = test.PerformRequest(t, "GET", "/v1/sender/scheduled-orders", nil, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data []types.ScheduledOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, scheduledorder.StatusCancelled, response.Data[0].Status)
+	})
+}
@@ -1,29 +1,40 @@
 package sender
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/storage"
 
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
-	providerprofile "github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	tokenEnt "github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	svc "github.com/paycrest/aggregator/services"
+	orderService "github.com/paycrest/aggregator/services/order"
 	"github.com/paycrest/aggregator/types"
 	u "github.com/paycrest/aggregator/utils"
 	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 
 	"github.com/gin-gonic/gin"
@@ -31,14 +42,16 @@ import (
 
 // SenderController is a controller type for sender endpoints
 type SenderController struct {
-	receiveAddressService *svc.ReceiveAddressService
+	disputeService      *svc.DisputeService
+	paymentOrderService *svc.PaymentOrderService
 }
 
 // NewSenderController creates a new instance of SenderController
 func NewSenderController() *SenderController {
 
 	return &SenderController{
-		receiveAddressService: svc.NewReceiveAddressService(),
+		disputeService:      svc.NewDisputeService(),
+		paymentOrderService: svc.NewPaymentOrderService(),
 	}
 }
 
@@ -69,6 +82,99 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		return
 	}
 
+	// Pay a saved beneficiary if provided in place of the recipient
+	if payload.BeneficiaryID != "" {
+		payload.Recipient, ok = getBeneficiaryRecipient(ctx, sender, payload.BeneficiaryID)
+		if !ok {
+			return
+		}
+	}
+
+	// Bind the order to a rate quote if provided
+	quoteKey := ""
+	if payload.QuoteID != "" {
+		quoteKey = fmt.Sprintf("quote_%s", payload.QuoteID)
+		quoteData, err := storage.RedisClient.Get(ctx, quoteKey).Bytes()
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "QuoteID",
+				Message: "Quote not found or has expired",
+			})
+			return
+		}
+
+		var quote types.RateQuote
+		if err := json.Unmarshal(quoteData, &quote); err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			return
+		}
+
+		if quote.SenderID != sender.ID || time.Now().After(quote.ExpiresAt) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "QuoteID",
+				Message: "Quote not found or has expired",
+			})
+			return
+		}
+
+		if !strings.EqualFold(quote.Token, payload.Token) || quote.Network != payload.Network || !quote.Amount.Equal(payload.Amount) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "QuoteID",
+				Message: "Quote does not match the order amount, token or network",
+			})
+			return
+		}
+
+		currencyMatches, err := storage.Client.Institution.
+			Query().
+			Where(
+				institution.CodeEQ(payload.Recipient.Institution),
+				institution.HasFiatCurrencyWith(fiatcurrency.CodeEQ(quote.Currency)),
+			).
+			Exist(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			return
+		}
+
+		if !currencyMatches {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "QuoteID",
+				Message: "Quote currency does not match the recipient institution",
+			})
+			return
+		}
+
+		// The order is matched to providers at the quoted rate, so the quote is only honoured
+		// while the quoting provider still offers that rate within its slippage
+		if quote.ProviderID != "" {
+			rate, slippage, found, err := u.GetProviderRateFromQueue(quote.ProviderID, quote.Token, quote.Amount, quote.Currency)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+				return
+			}
+
+			if !found || rate.Sub(quote.Rate).Abs().GreaterThan(slippage) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "QuoteID",
+					Message: "Quoted rate is no longer available, please request a new quote",
+				})
+				return
+			}
+		}
+
+		payload.Rate = quote.Rate
+	} else if payload.Rate.IsZero() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Rate",
+			Message: "This field is required",
+		})
+		return
+	}
+
 	// Get token from DB
 	token, err := storage.Client.Token.
 		Query().
@@ -88,30 +194,9 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 	}
 
 	// Handle sender profile overrides
-	senderOrderToken, err := storage.Client.SenderOrderToken.
-		Query().
-		Where(
-			senderordertoken.HasTokenWith(
-				tokenEnt.IDEQ(token.ID),
-			),
-			senderordertoken.HasSenderWith(
-				senderprofile.IDEQ(sender.ID),
-			),
-		).
-		Only(ctx)
+	senderOrderToken, err := ctrl.paymentOrderService.GetSenderOrderToken(ctx, sender, token)
 	if err != nil {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
-			Field:   "Token",
-			Message: "Provided token is not configured",
-		})
-		return
-	}
-
-	if senderOrderToken.FeeAddress == "" || senderOrderToken.RefundAddress == "" {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
-			Field:   "Token",
-			Message: "Fee address or refund address is not configured",
-		})
+		orderValidationResponse(ctx, err)
 		return
 	}
 
@@ -158,6 +243,20 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		feeAddress = payload.FeeAddress
 	}
 
+	// Fee tiers only apply when the partner fee is not overridden
+	var feeTier *ent.SenderFeeTier
+	if payload.FeeAddress == "" {
+		feeTier, err = u.GetSenderFeeTier(ctx, sender, senderOrderToken, token.Symbol)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			return
+		}
+		if feeTier != nil {
+			feePercent = feeTier.FeePercent
+		}
+	}
+
 	if payload.ReturnAddress != "" {
 		if !strings.HasPrefix(payload.Network, "tron") {
 			if !u.IsValidEthereumAddress(payload.ReturnAddress) {
@@ -209,125 +308,86 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		}
 	}
 
-	// Validate if institution exists
-	institutionExists, err := storage.Client.Institution.
-		Query().
-		Where(
-			institution.CodeEQ(payload.Recipient.Institution),
-		).
-		Exist(ctx)
-	if err != nil {
-		logger.Errorf("error validating institution: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to validate institution", nil)
-		return
-	}
-	if !institutionExists {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
-			Field:   "Recipient",
-			Message: "Invalid institution code provided",
-		})
+	// Validate the rate and recipient
+	if err := ctrl.paymentOrderService.ValidateOrder(ctx, token, payload.Amount, payload.Rate, *payload.Recipient); err != nil {
+		orderValidationResponse(ctx, err)
 		return
 	}
 
-	isPrivate := false
-	isTokenNetworkPresent := false
-	maxOrderAmount := decimal.NewFromInt(0)
-	minOrderAmount := decimal.NewFromInt(0)
+	// Validate split recipients
+	var splitAmounts []decimal.Decimal
+	if len(payload.Splits) > 0 {
+		if payload.Recipient.ProviderID != "" {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Splits",
+				Message: "Splits are not supported for orders to a specific provider",
+			})
+			return
+		}
 
-	if payload.Recipient.ProviderID != "" {
-		providerProfile, err := storage.Client.ProviderProfile.
-			Query().
-			Where(
-				providerprofile.IDEQ(payload.Recipient.ProviderID),
-			).
-			WithOrderTokens().
-			Only(ctx)
+		splitAmounts, err = u.SplitOrderAmount(payload.Amount, payload.Rate, payload.Splits)
 		if err != nil {
-			if ent.IsNotFound(err) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Provider not found", nil)
-				return
-			} else {
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider profile", nil)
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Splits",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		institutionCodes := []string{payload.Recipient.Institution}
+		accounts := map[string]bool{
+			payload.Recipient.Institution + ":" + payload.Recipient.AccountIdentifier: true,
+		}
+		for _, split := range payload.Splits {
+			account := split.Institution + ":" + split.AccountIdentifier
+			if accounts[account] {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "Splits",
+					Message: "Each account can only be paid once per order",
+				})
 				return
 			}
-		}
+			accounts[account] = true
 
-	out:
-		for _, orderToken := range providerProfile.Edges.OrderTokens {
-			for _, address := range orderToken.Addresses {
-				if address.Network == token.Edges.Network.Identifier {
-					isTokenNetworkPresent = true
-					break out
-				}
+			if !u.ContainsString(institutionCodes, split.Institution) {
+				institutionCodes = append(institutionCodes, split.Institution)
 			}
 		}
 
-		if !isTokenNetworkPresent {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "The selected network is not supported by the specified provider", nil)
+		// All recipients are paid out in the currency of the main recipient
+		institutions, err := storage.Client.Institution.
+			Query().
+			Where(institution.CodeIn(institutionCodes...)).
+			WithFiatCurrency().
+			All(ctx)
+		if err != nil {
+			logger.Errorf("error validating institution: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to validate institution", nil)
 			return
 		}
 
-		maxOrderAmount = providerProfile.Edges.OrderTokens[0].MaxOrderAmount
-		minOrderAmount = providerProfile.Edges.OrderTokens[0].MinOrderAmount
-
-		if providerProfile.VisibilityMode == providerprofile.VisibilityModePrivate {
-			isPrivate = true
+		currencies := make(map[string]bool)
+		for _, inst := range institutions {
+			if inst.Edges.FiatCurrency != nil {
+				currencies[inst.Edges.FiatCurrency.Code] = true
+			}
 		}
-	}
 
-	// Validate amount for private orders
-	if isPrivate {
-		if payload.Amount.LessThan(minOrderAmount) {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "The amount is below the minimum order amount for the specified provider", nil)
-			return
-		} else if payload.Amount.GreaterThan(maxOrderAmount) {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "The amount is beyond the maximum order amount for the specified provider", nil)
+		if len(institutions) != len(institutionCodes) || len(currencies) != 1 {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Splits",
+				Message: "Split institutions must be valid and in the same currency as the recipient",
+			})
 			return
 		}
 	}
 
 	// Generate receive address
-	var receiveAddress *ent.ReceiveAddress
-	if strings.HasPrefix(payload.Network, "tron") {
-		address, salt, err := ctrl.receiveAddressService.CreateTronAddress(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
-
-		receiveAddress, err = storage.Client.ReceiveAddress.
-			Create().
-			SetAddress(address).
-			SetSalt(salt).
-			SetStatus(receiveaddress.StatusUnused).
-			SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
-			Save(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
-	} else {
-		address, salt, err := ctrl.receiveAddressService.CreateSmartAddress(ctx, nil, nil)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
-
-		receiveAddress, err = storage.Client.ReceiveAddress.
-			Create().
-			SetAddress(address).
-			SetSalt(salt).
-			SetStatus(receiveaddress.StatusUnused).
-			SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
-			Save(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
+	receiveAddress, err := ctrl.paymentOrderService.CreateReceiveAddress(ctx, storage.Client, payload.Network, ctx.GetBool("sandbox"))
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+		return
 	}
 
 	// Prevent receive address expiry for private orders
@@ -343,48 +403,35 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		return
 	}
 
-	senderFee := feePercent.Mul(payload.Amount).Div(decimal.NewFromInt(100)).Round(4)
-	protocolFee := decimal.NewFromFloat(0)
+	// Wrong-amount deposits follow the sender's policy unless the order overrides it
+	underpaymentPolicy := paymentorder.UnderpaymentPolicy(sender.UnderpaymentPolicy)
+	if payload.UnderpaymentPolicy != "" {
+		underpaymentPolicy = payload.UnderpaymentPolicy
+	}
 
-	// Create transaction Log
-	transactionLog, err := tx.TransactionLog.
-		Create().
-		SetStatus(transactionlog.StatusOrderInitiated).
-		SetMetadata(
-			map[string]interface{}{
-				"ReceiveAddress": receiveAddress.Address,
-				"SenderID":       sender.ID.String(),
-			},
-		).SetNetwork(token.Edges.Network.Identifier).
-		Save(ctx)
-	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		_ = tx.Rollback()
-		return
+	overpaymentPolicy := paymentorder.OverpaymentPolicy(sender.OverpaymentPolicy)
+	if payload.OverpaymentPolicy != "" {
+		overpaymentPolicy = payload.OverpaymentPolicy
 	}
 
-	// Create payment order
-	paymentOrder, err := tx.PaymentOrder.
-		Create().
-		SetSenderProfile(sender).
-		SetAmount(payload.Amount).
-		SetAmountPaid(decimal.NewFromInt(0)).
-		SetAmountReturned(decimal.NewFromInt(0)).
-		SetPercentSettled(decimal.NewFromInt(0)).
-		SetNetworkFee(token.Edges.Network.Fee).
-		SetProtocolFee(protocolFee).
-		SetSenderFee(senderFee).
-		SetToken(token).
-		SetRate(payload.Rate).
-		SetReceiveAddress(receiveAddress).
-		SetReceiveAddressText(receiveAddress.Address).
-		SetFeePercent(feePercent).
-		SetFeeAddress(feeAddress).
-		SetReturnAddress(returnAddress).
-		SetReference(payload.Reference).
-		AddTransactions(transactionLog).
-		Save(ctx)
+	// Create payment order, its recipient and splits
+	paymentOrder, err := ctrl.paymentOrderService.CreatePaymentOrder(ctx, tx, svc.PaymentOrderParams{
+		Sender:             sender,
+		Token:              token,
+		Amount:             payload.Amount,
+		Rate:               payload.Rate,
+		Recipient:          *payload.Recipient,
+		Splits:             payload.Splits,
+		SplitAmounts:       splitAmounts,
+		FeePercent:         feePercent,
+		FeeAddress:         feeAddress,
+		FeeTier:            feeTier,
+		ReturnAddress:      returnAddress,
+		Reference:          payload.Reference,
+		UnderpaymentPolicy: underpaymentPolicy,
+		OverpaymentPolicy:  overpaymentPolicy,
+		IsSandbox:          ctx.GetBool("sandbox"),
+	}, receiveAddress)
 	if err != nil {
 		logger.Errorf("error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
@@ -392,21 +439,21 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		return
 	}
 
-	// Create payment order recipient
-	_, err = tx.PaymentOrderRecipient.
-		Create().
-		SetInstitution(payload.Recipient.Institution).
-		SetAccountIdentifier(payload.Recipient.AccountIdentifier).
-		SetAccountName(payload.Recipient.AccountName).
-		SetProviderID(payload.Recipient.ProviderID).
-		SetMemo(payload.Recipient.Memo).
-		SetPaymentOrder(paymentOrder).
-		Save(ctx)
-	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		_ = tx.Rollback()
-		return
+	// Quotes can only be used once, so claim the quote atomically before committing the order
+	if quoteKey != "" {
+		if err := storage.RedisClient.GetDel(ctx, quoteKey).Err(); err != nil {
+			_ = tx.Rollback()
+			if err == redis.Nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "QuoteID",
+					Message: "Quote not found or has expired",
+				})
+			} else {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			}
+			return
+		}
 	}
 
 	// Commit the transaction
@@ -424,22 +471,22 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 			Network:        token.Edges.Network.Identifier,
 			ReceiveAddress: receiveAddress.Address,
 			ValidUntil:     receiveAddress.ValidUntil,
-			SenderFee:      senderFee,
-			TransactionFee: protocolFee.Add(token.Edges.Network.Fee),
+			SenderFee:      paymentOrder.SenderFee,
+			FeeTier:        paymentOrder.FeeTier,
+			TransactionFee: paymentOrder.ProtocolFee.Add(paymentOrder.NetworkFee),
 			Reference:      paymentOrder.Reference,
 		})
 }
 
-// GetPaymentOrderByID controller fetches a payment order by ID
-func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
-	// Get order ID from the URL
-	orderID := ctx.Param("id")
-	isUUID := true
+// CreateRateQuote controller issues a rate quote that a payment order can bind to until it expires
+func (ctrl *SenderController) CreateRateQuote(ctx *gin.Context) {
+	var payload types.NewRateQuotePayload
 
-	// Convert order ID to UUID
-	id, err := uuid.Parse(orderID)
-	if err != nil {
-		isUUID = false
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
 	}
 
 	// Get sender profile from the context
@@ -450,65 +497,213 @@ func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
 	}
 	sender := senderCtx.(*ent.SenderProfile)
 
-	// Fetch payment order from the database
-	paymentOrderQuery := storage.Client.PaymentOrder.Query()
-
-	if isUUID {
-		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.IDEQ(id))
-	} else {
-		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.ReferenceEQ(orderID))
-	}
-
-	paymentOrder, err := paymentOrderQuery.
-		Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))).
-		WithRecipient().
-		WithToken(func(tq *ent.TokenQuery) {
-			tq.WithNetwork()
-		}).
-		WithTransactions().
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			u.APIResponse(ctx, http.StatusNotFound, "error",
-				"Payment order not found", nil)
-		} else {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error",
-				"Failed to fetch payment order", nil)
-		}
-		return
-	}
-
-	var transactions []types.TransactionLog
-	for _, transaction := range paymentOrder.Edges.Transactions {
-		transactions = append(transactions, types.TransactionLog{
-			ID:        transaction.ID,
-			GatewayId: transaction.GatewayID,
-			Status:    transaction.Status,
-			TxHash:    transaction.TxHash,
-			CreatedAt: transaction.CreatedAt,
+	if !payload.Amount.IsPositive() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Amount",
+			Message: "Amount must be greater than zero",
 		})
-
+		return
 	}
 
-	institution, err := storage.Client.Institution.
+	// Get token from DB
+	token, err := storage.Client.Token.
 		Query().
-		Where(institution.CodeEQ(paymentOrder.Edges.Recipient.Institution)).
-		WithFiatCurrency().
+		Where(
+			tokenEnt.SymbolEQ(payload.Token),
+			tokenEnt.HasNetworkWith(network.IdentifierEQ(payload.Network)),
+			tokenEnt.IsEnabledEQ(true),
+		).
+		WithNetwork().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is not supported",
+		})
 		return
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "The order has been successfully retrieved", &types.PaymentOrderResponse{
-		ID:             paymentOrder.ID,
-		Amount:         paymentOrder.Amount,
+	senderOrderToken, err := storage.Client.SenderOrderToken.
+		Query().
+		Where(
+			senderordertoken.HasTokenWith(
+				tokenEnt.IDEQ(token.ID),
+			),
+			senderordertoken.HasSenderWith(
+				senderprofile.IDEQ(sender.ID),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is not configured",
+		})
+		return
+	}
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(
+			fiatcurrency.IsEnabledEQ(true),
+			fiatcurrency.CodeEQ(strings.ToUpper(payload.Currency)),
+		).
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Currency",
+			Message: "Fiat currency is not supported",
+		})
+		return
+	}
+
+	// Get rate from the bucket queues
+	rate, providerID, err := u.GetTokenRateQuoteFromQueue(token.Symbol, payload.Amount, currency.Code, currency.MarketRate)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch rate", nil)
+		return
+	}
+
+	feeTier, err := u.GetSenderFeeTier(ctx, sender, senderOrderToken, token.Symbol)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create rate quote", nil)
+		return
+	}
+
+	feePercent := senderOrderToken.FeePercent
+	var feeTierName string
+	if feeTier != nil {
+		feePercent = feeTier.FeePercent
+		feeTierName = feeTier.Name
+	}
+
+	senderFee := u.SenderFee(payload.Amount, feePercent, feeTier)
+	protocolFee := decimal.NewFromFloat(0)
+
+	quote := types.RateQuote{
+		ID:          uuid.New(),
+		SenderID:    sender.ID,
+		Amount:      payload.Amount,
+		Token:       token.Symbol,
+		Network:     token.Edges.Network.Identifier,
+		Currency:    currency.Code,
+		Rate:        rate,
+		ProviderID:  providerID,
+		FiatAmount:  payload.Amount.Mul(rate).Round(2),
+		SenderFee:   senderFee,
+		FeeTier:     feeTierName,
+		NetworkFee:  token.Edges.Network.Fee,
+		ProtocolFee: protocolFee,
+		TotalAmount: payload.Amount.Add(senderFee).Add(token.Edges.Network.Fee).Add(protocolFee),
+		ExpiresAt:   time.Now().Add(orderConf.QuoteValidity),
+	}
+
+	quoteData, err := json.Marshal(quote)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create rate quote", nil)
+		return
+	}
+
+	err = storage.RedisClient.Set(ctx, fmt.Sprintf("quote_%s", quote.ID), quoteData, orderConf.QuoteValidity).Err()
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create rate quote", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Rate quote created successfully", quote)
+}
+
+// GetPaymentOrderByID controller fetches a payment order by ID
+func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
+	// Get order ID from the URL
+	orderID := ctx.Param("id")
+	isUUID := true
+
+	// Convert order ID to UUID
+	id, err := uuid.Parse(orderID)
+	if err != nil {
+		isUUID = false
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	// Fetch payment order from the database
+	paymentOrderQuery := storage.Client.PaymentOrder.Query()
+
+	if isUUID {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.IDEQ(id))
+	} else {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.ReferenceEQ(orderID))
+	}
+
+	paymentOrder, err := paymentOrderQuery.
+		Where(senderPaymentOrder(ctx, sender)).
+		WithRecipient().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithTransactions().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error",
+				"Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error",
+				"Failed to fetch payment order", nil)
+		}
+		return
+	}
+
+	var transactions []types.TransactionLog
+	for _, transaction := range paymentOrder.Edges.Transactions {
+		transactions = append(transactions, types.TransactionLog{
+			ID:        transaction.ID,
+			GatewayId: transaction.GatewayID,
+			Status:    transaction.Status,
+			TxHash:    transaction.TxHash,
+			CreatedAt: transaction.CreatedAt,
+		})
+
+	}
+
+	institution, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(paymentOrder.Edges.Recipient.Institution)).
+		WithFiatCurrency().
+		Only(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		return
+	}
+
+	recipients, err := u.GetPaymentOrderRecipientSettlements(ctx, paymentOrder, paymentOrder.Edges.Recipient)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "The order has been successfully retrieved", &types.PaymentOrderResponse{
+		ID:             paymentOrder.ID,
+		Amount:         paymentOrder.Amount,
 		AmountPaid:     paymentOrder.AmountPaid,
 		AmountReturned: paymentOrder.AmountReturned,
 		Token:          paymentOrder.Edges.Token.Symbol,
 		SenderFee:      paymentOrder.SenderFee,
+		FeeTier:        paymentOrder.FeeTier,
 		TransactionFee: paymentOrder.NetworkFee.Add(paymentOrder.ProtocolFee),
 		Rate:           paymentOrder.Rate,
 		Network:        paymentOrder.Edges.Token.Edges.Network.Identifier,
@@ -520,17 +715,20 @@ func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
 			ProviderID:        paymentOrder.Edges.Recipient.ProviderID,
 			Memo:              paymentOrder.Edges.Recipient.Memo,
 		},
-		Transactions:   transactions,
-		FromAddress:    paymentOrder.FromAddress,
-		ReturnAddress:  paymentOrder.ReturnAddress,
-		ReceiveAddress: paymentOrder.ReceiveAddressText,
-		FeeAddress:     paymentOrder.FeeAddress,
-		Reference:      paymentOrder.Reference,
-		GatewayID:      paymentOrder.GatewayID,
-		CreatedAt:      paymentOrder.CreatedAt,
-		UpdatedAt:      paymentOrder.UpdatedAt,
-		TxHash:         paymentOrder.TxHash,
-		Status:         paymentOrder.Status,
+		Recipients:         recipients,
+		Transactions:       transactions,
+		FromAddress:        paymentOrder.FromAddress,
+		ReturnAddress:      paymentOrder.ReturnAddress,
+		ReceiveAddress:     paymentOrder.ReceiveAddressText,
+		FeeAddress:         paymentOrder.FeeAddress,
+		Reference:          paymentOrder.Reference,
+		GatewayID:          paymentOrder.GatewayID,
+		CreatedAt:          paymentOrder.CreatedAt,
+		UpdatedAt:          paymentOrder.UpdatedAt,
+		TxHash:             paymentOrder.TxHash,
+		Status:             paymentOrder.Status,
+		UnderpaymentPolicy: paymentOrder.UnderpaymentPolicy,
+		OverpaymentPolicy:  paymentOrder.OverpaymentPolicy,
 	})
 }
 
@@ -546,107 +744,81 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 
 	// Get ordering query param
 	ordering := ctx.Query("ordering")
-	order := ent.Desc(paymentorder.FieldCreatedAt)
+	order := []paymentorder.OrderOption{ent.Desc(paymentorder.FieldCreatedAt), ent.Desc(paymentorder.FieldID)}
 	if ordering == "asc" {
-		order = ent.Asc(paymentorder.FieldCreatedAt)
+		order = []paymentorder.OrderOption{ent.Asc(paymentorder.FieldCreatedAt), ent.Asc(paymentorder.FieldID)}
 	}
 
 	// Get page and pageSize query params
 	page, offset, pageSize := u.Paginate(ctx)
 
+	// Get filter query params
+	filters, err := u.ParseOrderListFilters(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
+
 	paymentOrderQuery := storage.Client.PaymentOrder.Query()
 
 	// Filter by sender
 	paymentOrderQuery = paymentOrderQuery.Where(
-		paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		senderPaymentOrder(ctx, sender),
 	)
 
-	// Filter by status
-	statusQueryParam := ctx.Query("status")
-	statusMap := map[string]paymentorder.Status{
-		"initiated": paymentorder.StatusInitiated,
-		"pending":   paymentorder.StatusPending,
-		"expired":   paymentorder.StatusExpired,
-		"settled":   paymentorder.StatusSettled,
-		"refunded":  paymentorder.StatusRefunded,
+	paymentOrderQuery, err = filterPaymentOrders(ctx, paymentOrderQuery, filters)
+	if err != nil {
+		if errors.Is(err, errInvalidStatus) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		}
+		return
 	}
 
-	if status, ok := statusMap[statusQueryParam]; ok {
-		paymentOrderQuery = paymentOrderQuery.Where(
-			paymentorder.StatusEQ(status),
-		)
+	count, err := paymentOrderQuery.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		return
 	}
 
-	// Filter by token
-	tokenQueryParam := ctx.Query("token")
-
-	if tokenQueryParam != "" {
-		tokenExists, err := storage.Client.Token.
-			Query().
-			Where(
-				tokenEnt.SymbolEQ(tokenQueryParam),
-			).
-			Exist(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error",
-				"Failed to fetch payment orders", nil)
-			return
-		}
-
-		if tokenExists {
+	// Continue after the cursor position when one is provided, otherwise fall back to offsets
+	if filters.Cursor != nil {
+		if ordering == "asc" {
 			paymentOrderQuery = paymentOrderQuery.Where(
-				paymentorder.HasTokenWith(
-					tokenEnt.SymbolEQ(tokenQueryParam),
+				paymentorder.Or(
+					paymentorder.CreatedAtGT(filters.Cursor.CreatedAt),
+					paymentorder.And(
+						paymentorder.CreatedAtEQ(filters.Cursor.CreatedAt),
+						paymentorder.IDGT(filters.Cursor.ID),
+					),
 				),
 			)
-		}
-	}
-
-	// Filter by network
-	networkQueryParam := ctx.Query("network")
-
-	if networkQueryParam != "" {
-		networkExists, err := storage.Client.Network.
-			Query().
-			Where(
-				network.IdentifierEQ(networkQueryParam),
-			).
-			Exist(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error",
-				"Failed to fetch payment orders", nil)
-			return
-		}
-
-		if networkExists {
+		} else {
 			paymentOrderQuery = paymentOrderQuery.Where(
-				paymentorder.HasTokenWith(
-					tokenEnt.HasNetworkWith(
-						network.IdentifierEQ(networkQueryParam),
+				paymentorder.Or(
+					paymentorder.CreatedAtLT(filters.Cursor.CreatedAt),
+					paymentorder.And(
+						paymentorder.CreatedAtEQ(filters.Cursor.CreatedAt),
+						paymentorder.IDLT(filters.Cursor.ID),
 					),
 				),
 			)
 		}
+		offset = 0
 	}
 
-	count, err := paymentOrderQuery.Count(ctx)
-	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
-		return
-	}
-
-	// Fetch payment orders
+	// Fetch payment orders, with one extra to tell whether there is a next page
 	paymentOrders, err := paymentOrderQuery.
 		WithRecipient().
 		WithToken(func(tq *ent.TokenQuery) {
 			tq.WithNetwork()
 		}).
-		Limit(pageSize).
+		Limit(pageSize + 1).
 		Offset(offset).
-		Order(order).
+		Order(order...).
 		All(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
@@ -655,6 +827,13 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
+	nextCursor := ""
+	if len(paymentOrders) > pageSize {
+		paymentOrders = paymentOrders[:pageSize]
+		last := paymentOrders[len(paymentOrders)-1]
+		nextCursor = u.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
 	var orders []types.PaymentOrderResponse
 
 	for _, paymentOrder := range paymentOrders {
@@ -676,6 +855,7 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 			AmountReturned: paymentOrder.AmountReturned,
 			Token:          paymentOrder.Edges.Token.Symbol,
 			SenderFee:      paymentOrder.SenderFee,
+			FeeTier:        paymentOrder.FeeTier,
 			TransactionFee: paymentOrder.NetworkFee.Add(paymentOrder.ProtocolFee),
 			Rate:           paymentOrder.Rate,
 			Network:        paymentOrder.Edges.Token.Edges.Network.Identifier,
@@ -687,16 +867,18 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 				ProviderID:        paymentOrder.Edges.Recipient.ProviderID,
 				Memo:              paymentOrder.Edges.Recipient.Memo,
 			},
-			FromAddress:    paymentOrder.FromAddress,
-			ReturnAddress:  paymentOrder.ReturnAddress,
-			ReceiveAddress: paymentOrder.ReceiveAddressText,
-			FeeAddress:     paymentOrder.FeeAddress,
-			Reference:      paymentOrder.Reference,
-			GatewayID:      paymentOrder.GatewayID,
-			CreatedAt:      paymentOrder.CreatedAt,
-			UpdatedAt:      paymentOrder.UpdatedAt,
-			TxHash:         paymentOrder.TxHash,
-			Status:         paymentOrder.Status,
+			FromAddress:        paymentOrder.FromAddress,
+			ReturnAddress:      paymentOrder.ReturnAddress,
+			ReceiveAddress:     paymentOrder.ReceiveAddressText,
+			FeeAddress:         paymentOrder.FeeAddress,
+			Reference:          paymentOrder.Reference,
+			GatewayID:          paymentOrder.GatewayID,
+			CreatedAt:          paymentOrder.CreatedAt,
+			UpdatedAt:          paymentOrder.UpdatedAt,
+			TxHash:             paymentOrder.TxHash,
+			Status:             paymentOrder.Status,
+			UnderpaymentPolicy: paymentOrder.UnderpaymentPolicy,
+			OverpaymentPolicy:  paymentOrder.OverpaymentPolicy,
 		})
 	}
 
@@ -704,12 +886,13 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 		Page:         page,
 		PageSize:     pageSize,
 		TotalRecords: count,
+		NextCursor:   nextCursor,
 		Orders:       orders,
 	})
 }
 
-// Stats controller fetches sender stats
-func (ctrl *SenderController) Stats(ctx *gin.Context) {
+// ExportPaymentOrders controller streams the sender's payment orders within a date range as CSV or NDJSON
+func (ctrl *SenderController) ExportPaymentOrders(ctx *gin.Context) {
 	// Get sender profile from the context
 	senderCtx, ok := ctx.Get("sender")
 	if !ok {
@@ -718,45 +901,1074 @@ func (ctrl *SenderController) Stats(ctx *gin.Context) {
 	}
 	sender := senderCtx.(*ent.SenderProfile)
 
-	// Aggregate sender stats from db
+	// Get filter query params
+	filters, err := u.ParseOrderListFilters(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
 
-	var w []struct {
-		Sum               decimal.Decimal
-		SumFieldSenderFee decimal.Decimal
+	if filters.From.IsZero() || filters.To.IsZero() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query params: from and to dates are required", nil)
+		return
 	}
-	err := storage.Client.PaymentOrder.
-		Query().
-		Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)), paymentorder.StatusEQ(paymentorder.StatusSettled)).
-		Aggregate(
-			ent.Sum(paymentorder.FieldAmount),
-			ent.As(ent.Sum(paymentorder.FieldSenderFee), "SumFieldSenderFee"),
-		).
-		Scan(ctx, &w)
-	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender stats", nil)
+
+	format := ctx.DefaultQuery("format", "csv")
+	if format != "csv" && format != "ndjson" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query params: format must be csv or ndjson", nil)
 		return
 	}
 
-	var v []struct {
-		Count int
+	paymentOrderQuery, err := filterPaymentOrders(ctx, storage.Client.PaymentOrder.Query().Where(
+		senderPaymentOrder(ctx, sender),
+	), filters)
+	if err != nil {
+		if errors.Is(err, errInvalidStatus) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to export payment orders", nil)
+		}
+		return
 	}
-	err = storage.Client.PaymentOrder.
-		Query().
-		Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))).
-		Aggregate(
-			ent.Count(),
-		).
-		Scan(ctx, &v)
+
+	writer, err := u.NewExportWriter(ctx, format,
+		fmt.Sprintf("orders_%s_%s", filters.From.Format("2006-01-02"), filters.To.Format("2006-01-02")),
+		[]string{
+			"id", "reference", "created_at", "updated_at", "status", "token", "network",
+			"amount", "amount_paid", "amount_returned", "sender_fee", "network_fee", "protocol_fee", "rate",
+			"tx_hash", "gateway_id", "recipient_institution", "recipient_account_identifier", "recipient_account_name",
+			"psp_transaction_ids",
+		},
+	)
 	if err != nil {
 		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender stats", nil)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to export payment orders", nil)
 		return
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "Sender stats retrieved successfully", types.SenderStatsResponse{
-		TotalOrders:      v[0].Count,
-		TotalOrderVolume: w[0].Sum,
-		TotalFeeEarnings: w[0].SumFieldSenderFee,
-	})
+	// Stream the orders in chunks keyed on (created_at, id) so the whole result is never held in memory
+	const chunkSize = 500
+	var cursor *types.PaginationCursor
+	for {
+		query := paymentOrderQuery.Clone()
+		if cursor != nil {
+			query = query.Where(
+				paymentorder.Or(
+					paymentorder.CreatedAtGT(cursor.CreatedAt),
+					paymentorder.And(
+						paymentorder.CreatedAtEQ(cursor.CreatedAt),
+						paymentorder.IDGT(cursor.ID),
+					),
+				),
+			)
+		}
+
+		paymentOrders, err := query.
+			WithRecipient().
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			Order(ent.Asc(paymentorder.FieldCreatedAt), ent.Asc(paymentorder.FieldID)).
+			Limit(chunkSize).
+			All(ctx)
+		if err != nil {
+			logger.Errorf("ExportPaymentOrders: %v", err)
+			return
+		}
+
+		if len(paymentOrders) == 0 {
+			break
+		}
+
+		// Fetch the PSP transaction IDs of the fulfilled lock orders
+		gatewayIDs := []string{}
+		for _, paymentOrder := range paymentOrders {
+			if paymentOrder.GatewayID != "" {
+				gatewayIDs = append(gatewayIDs, paymentOrder.GatewayID)
+			}
+		}
+
+		pspTxIDs := map[string][]string{}
+		if len(gatewayIDs) > 0 {
+			lockOrders, err := storage.Client.LockPaymentOrder.
+				Query().
+				Where(lockpaymentorder.GatewayIDIn(gatewayIDs...)).
+				WithFulfillments().
+				All(ctx)
+			if err != nil {
+				logger.Errorf("ExportPaymentOrders: %v", err)
+				return
+			}
+
+			for _, lockOrder := range lockOrders {
+				for _, fulfillment := range lockOrder.Edges.Fulfillments {
+					if fulfillment.TxID != "" {
+						pspTxIDs[lockOrder.GatewayID] = append(pspTxIDs[lockOrder.GatewayID], fulfillment.TxID)
+					}
+				}
+			}
+		}
+
+		for _, paymentOrder := range paymentOrders {
+			var institution, accountIdentifier, accountName string
+			if paymentOrder.Edges.Recipient != nil {
+				institution = paymentOrder.Edges.Recipient.Institution
+				accountIdentifier = paymentOrder.Edges.Recipient.AccountIdentifier
+				accountName = paymentOrder.Edges.Recipient.AccountName
+			}
+
+			txIDs := pspTxIDs[paymentOrder.GatewayID]
+			if txIDs == nil {
+				txIDs = []string{}
+			}
+
+			err := writer.WriteRow([]interface{}{
+				paymentOrder.ID.String(),
+				paymentOrder.Reference,
+				paymentOrder.CreatedAt,
+				paymentOrder.UpdatedAt,
+				paymentOrder.Status,
+				paymentOrder.Edges.Token.Symbol,
+				paymentOrder.Edges.Token.Edges.Network.Identifier,
+				paymentOrder.Amount,
+				paymentOrder.AmountPaid,
+				paymentOrder.AmountReturned,
+				paymentOrder.SenderFee,
+				paymentOrder.NetworkFee,
+				paymentOrder.ProtocolFee,
+				paymentOrder.Rate,
+				paymentOrder.TxHash,
+				paymentOrder.GatewayID,
+				institution,
+				accountIdentifier,
+				accountName,
+				txIDs,
+			})
+			if err != nil {
+				logger.Errorf("ExportPaymentOrders: %v", err)
+				return
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			logger.Errorf("ExportPaymentOrders: %v", err)
+			return
+		}
+
+		if len(paymentOrders) < chunkSize {
+			break
+		}
+
+		last := paymentOrders[len(paymentOrders)-1]
+		cursor = &types.PaginationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+// CancelPaymentOrder controller cancels a payment order that has not been funded on-chain
+func (ctrl *SenderController) CancelPaymentOrder(ctx *gin.Context) {
+	// Get order ID from the URL
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	// Fetch payment order from the database
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			senderPaymentOrder(ctx, sender),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithBatch().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel payment order", nil)
+		}
+		return
+	}
+
+	if paymentOrder.Edges.Batch != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Orders in a batch cannot be cancelled individually", nil)
+		return
+	}
+
+	if paymentOrder.Status != paymentorder.StatusInitiated {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only initiated orders can be cancelled", nil)
+		return
+	}
+
+	// Expire payment order and receive address in a transaction
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel payment order", nil)
+		return
+	}
+
+	// Guard against the indexer picking up a deposit concurrently
+	updated, err := tx.PaymentOrder.
+		Update().
+		Where(
+			paymentorder.IDEQ(paymentOrder.ID),
+			paymentorder.StatusEQ(paymentorder.StatusInitiated),
+		).
+		SetStatus(paymentorder.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel payment order", nil)
+		_ = tx.Rollback()
+		return
+	}
+
+	if updated == 0 {
+		u.APIResponse(ctx, http.StatusConflict, "error", "Payment order can no longer be cancelled", nil)
+		_ = tx.Rollback()
+		return
+	}
+
+	if paymentOrder.Edges.ReceiveAddress != nil {
+		_, err = tx.ReceiveAddress.
+			UpdateOneID(paymentOrder.Edges.ReceiveAddress.ID).
+			SetStatus(receiveaddress.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel payment order", nil)
+			_ = tx.Rollback()
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to cancel payment order", nil)
+		return
+	}
+
+	// Refund any partial deposit to the return address. The request context is done once the response is sent,
+	// and refunds that fail here are retried for expired orders with an unreturned balance
+	if paymentOrder.AmountPaid.GreaterThan(decimal.Zero) {
+		go func() {
+			var service types.OrderService
+			if paymentOrder.IsSandbox {
+				service = orderService.NewOrderSandbox()
+			} else if strings.HasPrefix(paymentOrder.Edges.Token.Edges.Network.Identifier, "tron") {
+				service = orderService.NewOrderTron()
+			} else {
+				service = orderService.NewOrderEVM()
+			}
+			err := service.RevertOrder(context.Background(), nil, paymentOrder.ID)
+			if err != nil {
+				logger.Errorf("CancelPaymentOrder.RevertOrder(%v): %v", paymentOrder.ID, err)
+			}
+		}()
+	}
+
+	// Send webhook notification to sender
+	paymentOrder.Status = paymentorder.StatusExpired
+	paymentOrder.Edges.SenderProfile = sender
+	err = u.SendPaymentOrderWebhook(ctx, paymentOrder)
+	if err != nil {
+		logger.Errorf("CancelPaymentOrder.webhook: %v", err)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Payment order cancelled successfully", &types.CancelPaymentOrderResponse{
+		ID:         paymentOrder.ID,
+		Status:     paymentorder.StatusExpired,
+		AmountPaid: paymentOrder.AmountPaid,
+	})
+}
+
+// CreatePaymentOrderBatch controller creates a batch of payment orders funded by a single deposit
+func (ctrl *SenderController) CreatePaymentOrderBatch(ctx *gin.Context) {
+	var payload types.NewPaymentOrderBatchPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	if ctx.GetBool("sandbox") {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Payment order batches are not available in sandbox mode", nil)
+		return
+	}
+
+	if !sender.IsActive && !serverConf.Debug {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Your account is not active", nil)
+		return
+	}
+
+	// Get token from DB
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenEnt.SymbolEQ(payload.Token),
+			tokenEnt.HasNetworkWith(network.IdentifierEQ(payload.Network)),
+			tokenEnt.IsEnabledEQ(true),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is not supported",
+		})
+		return
+	}
+
+	// Handle sender profile overrides
+	senderOrderToken, err := ctrl.paymentOrderService.GetSenderOrderToken(ctx, sender, token)
+	if err != nil {
+		batchOrderValidationResponse(ctx, "", err)
+		return
+	}
+
+	returnAddress := senderOrderToken.RefundAddress
+	if payload.ReturnAddress != "" {
+		if !strings.HasPrefix(payload.Network, "tron") {
+			if !u.IsValidEthereumAddress(payload.ReturnAddress) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "ReturnAddress",
+					Message: "Invalid Ethereum address",
+				})
+				return
+			}
+		} else {
+			if !u.IsValidTronAddress(payload.ReturnAddress) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "ReturnAddress",
+					Message: "Invalid Tron address",
+				})
+				return
+			}
+		}
+		returnAddress = payload.ReturnAddress
+	}
+
+	referenceRegex := regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`)
+
+	if payload.Reference != "" {
+		if !referenceRegex.MatchString(payload.Reference) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Reference",
+				Message: "Reference must be alphanumeric",
+			})
+			return
+		}
+
+		referenceExists, err := storage.Client.PaymentOrderBatch.
+			Query().
+			Where(paymentorderbatch.ReferenceEQ(payload.Reference)).
+			Exist(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+			return
+		}
+
+		if referenceExists {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Reference",
+				Message: "Reference already exists",
+			})
+			return
+		}
+	}
+
+	// Collect references so they can be validated in bulk
+	references := []string{}
+	seenReferences := map[string]bool{}
+	for i, order := range payload.Orders {
+		if order.Amount.LessThanOrEqual(decimal.Zero) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   fmt.Sprintf("Orders[%d].Amount", i),
+				Message: "Amount must be greater than zero",
+			})
+			return
+		}
+
+		if order.Reference != "" {
+			if !referenceRegex.MatchString(order.Reference) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   fmt.Sprintf("Orders[%d].Reference", i),
+					Message: "Reference must be alphanumeric",
+				})
+				return
+			}
+
+			if seenReferences[order.Reference] {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   fmt.Sprintf("Orders[%d].Reference", i),
+					Message: "Reference is duplicated in the batch",
+				})
+				return
+			}
+			seenReferences[order.Reference] = true
+			references = append(references, order.Reference)
+		}
+	}
+
+	if len(references) > 0 {
+		existingOrders, err := storage.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.ReferenceIn(references...)).
+			Select(paymentorder.FieldReference).
+			Strings(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+			return
+		}
+
+		if len(existingOrders) > 0 {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Orders",
+				Message: fmt.Sprintf("Reference %s already exists", existingOrders[0]),
+			})
+			return
+		}
+	}
+
+	// Validate the rate and recipient of each order
+	for i, order := range payload.Orders {
+		err := ctrl.paymentOrderService.ValidateOrder(ctx, token, order.Amount, order.Rate, order.Recipient)
+		if err != nil {
+			batchOrderValidationResponse(ctx, fmt.Sprintf("Orders[%d]", i), err)
+			return
+		}
+	}
+
+	feeTier, err := u.GetSenderFeeTier(ctx, sender, senderOrderToken, token.Symbol)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		return
+	}
+
+	feePercent := senderOrderToken.FeePercent
+	if feeTier != nil {
+		feePercent = feeTier.FeePercent
+	}
+
+	// Create the batch, its receive address and all orders in a single transaction
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		return
+	}
+
+	receiveAddress, err := ctrl.paymentOrderService.CreateReceiveAddress(ctx, tx.Client(), payload.Network, ctx.GetBool("sandbox"))
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		_ = tx.Rollback()
+		return
+	}
+
+	totalAmount := decimal.Zero
+	totalSenderFee := decimal.Zero
+	protocolFee := decimal.NewFromFloat(0)
+	for _, order := range payload.Orders {
+		senderFee := u.SenderFee(order.Amount, feePercent, feeTier)
+		totalSenderFee = totalSenderFee.Add(senderFee)
+		totalAmount = totalAmount.Add(order.Amount).Add(senderFee).Add(protocolFee).Add(token.Edges.Network.Fee)
+	}
+
+	batch, err := tx.PaymentOrderBatch.
+		Create().
+		SetSenderProfile(sender).
+		SetToken(token).
+		SetAmount(totalAmount).
+		SetAmountPaid(decimal.NewFromInt(0)).
+		SetAmountReturned(decimal.NewFromInt(0)).
+		SetReceiveAddress(receiveAddress).
+		SetReceiveAddressText(receiveAddress.Address).
+		SetReturnAddress(returnAddress).
+		SetReference(payload.Reference).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		_ = tx.Rollback()
+		return
+	}
+
+	orders := make([]types.PaymentOrderBatchItemResponse, 0, len(payload.Orders))
+	for _, order := range payload.Orders {
+		paymentOrder, err := ctrl.paymentOrderService.CreatePaymentOrder(ctx, tx, svc.PaymentOrderParams{
+			Sender:             sender,
+			Token:              token,
+			Amount:             order.Amount,
+			Rate:               order.Rate,
+			Recipient:          order.Recipient,
+			FeePercent:         feePercent,
+			FeeAddress:         senderOrderToken.FeeAddress,
+			FeeTier:            feeTier,
+			ReturnAddress:      returnAddress,
+			Reference:          order.Reference,
+			UnderpaymentPolicy: paymentorder.UnderpaymentPolicy(sender.UnderpaymentPolicy),
+			OverpaymentPolicy:  paymentorder.OverpaymentPolicy(sender.OverpaymentPolicy),
+			IsSandbox:          ctx.GetBool("sandbox"),
+			Batch:              batch,
+			Metadata: map[string]interface{}{
+				"BatchID": batch.ID.String(),
+			},
+		}, receiveAddress)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+			_ = tx.Rollback()
+			return
+		}
+
+		orders = append(orders, types.PaymentOrderBatchItemResponse{
+			ID:        paymentOrder.ID,
+			Amount:    paymentOrder.Amount,
+			SenderFee: paymentOrder.SenderFee,
+			FeeTier:   paymentOrder.FeeTier,
+			Reference: paymentOrder.Reference,
+			Status:    paymentOrder.Status,
+		})
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Payment order batch initiated successfully",
+		&types.PaymentOrderBatchResponse{
+			ID:             batch.ID,
+			Amount:         batch.Amount,
+			AmountPaid:     batch.AmountPaid,
+			AmountReturned: batch.AmountReturned,
+			Token:          payload.Token,
+			Network:        token.Edges.Network.Identifier,
+			ReceiveAddress: receiveAddress.Address,
+			ValidUntil:     receiveAddress.ValidUntil,
+			SenderFee:      totalSenderFee,
+			TransactionFee: protocolFee.Add(token.Edges.Network.Fee).Mul(decimal.NewFromInt(int64(len(orders)))),
+			Reference:      batch.Reference,
+			Status:         batch.Status,
+			TotalOrders:    len(orders),
+			Progress:       map[paymentorder.Status]int{paymentorder.StatusInitiated: len(orders)},
+			Orders:         orders,
+			CreatedAt:      batch.CreatedAt,
+			UpdatedAt:      batch.UpdatedAt,
+		})
+}
+
+// GetPaymentOrderBatch controller fetches a batch payment order along with the progress of its orders
+func (ctrl *SenderController) GetPaymentOrderBatch(ctx *gin.Context) {
+	// Get batch ID from the URL
+	batchID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid batch ID", nil)
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	batch, err := storage.Client.PaymentOrderBatch.
+		Query().
+		Where(
+			paymentorderbatch.IDEQ(batchID),
+			paymentorderbatch.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithPaymentOrders(func(poq *ent.PaymentOrderQuery) {
+			poq.Order(ent.Asc(paymentorder.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order batch not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order batch", nil)
+		}
+		return
+	}
+
+	senderFee := decimal.Zero
+	transactionFee := decimal.Zero
+	progress := map[paymentorder.Status]int{}
+	orders := make([]types.PaymentOrderBatchItemResponse, 0, len(batch.Edges.PaymentOrders))
+	for _, order := range batch.Edges.PaymentOrders {
+		senderFee = senderFee.Add(order.SenderFee)
+		transactionFee = transactionFee.Add(order.NetworkFee).Add(order.ProtocolFee)
+		progress[order.Status]++
+		orders = append(orders, types.PaymentOrderBatchItemResponse{
+			ID:        order.ID,
+			Amount:    order.Amount,
+			SenderFee: order.SenderFee,
+			FeeTier:   order.FeeTier,
+			Reference: order.Reference,
+			Status:    order.Status,
+			TxHash:    order.TxHash,
+		})
+	}
+
+	var validUntil time.Time
+	if batch.Edges.ReceiveAddress != nil {
+		validUntil = batch.Edges.ReceiveAddress.ValidUntil
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "The batch has been successfully retrieved", &types.PaymentOrderBatchResponse{
+		ID:             batch.ID,
+		Amount:         batch.Amount,
+		AmountPaid:     batch.AmountPaid,
+		AmountReturned: batch.AmountReturned,
+		Token:          batch.Edges.Token.Symbol,
+		Network:        batch.Edges.Token.Edges.Network.Identifier,
+		ReceiveAddress: batch.ReceiveAddressText,
+		ValidUntil:     validUntil,
+		SenderFee:      senderFee,
+		TransactionFee: transactionFee,
+		Reference:      batch.Reference,
+		Status:         batch.Status,
+		TotalOrders:    len(orders),
+		Progress:       progress,
+		Orders:         orders,
+		CreatedAt:      batch.CreatedAt,
+		UpdatedAt:      batch.UpdatedAt,
+	})
+}
+
+// Stats controller fetches sender stats
+func (ctrl *SenderController) Stats(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	statsQuery, err := u.ParseStatsQuery(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Invalid query params: %v", err), nil)
+		return
+	}
+
+	// Aggregate sender stats from db
+
+	var w []struct {
+		Sum               decimal.Decimal
+		SumFieldSenderFee decimal.Decimal
+	}
+	err = storage.Client.PaymentOrder.
+		Query().
+		Where(senderPaymentOrder(ctx, sender), paymentorder.StatusEQ(paymentorder.StatusSettled)).
+		Aggregate(
+			ent.Sum(paymentorder.FieldAmount),
+			ent.As(ent.Sum(paymentorder.FieldSenderFee), "SumFieldSenderFee"),
+		).
+		Scan(ctx, &w)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender stats", nil)
+		return
+	}
+
+	var v []struct {
+		Count int
+	}
+	err = storage.Client.PaymentOrder.
+		Query().
+		Where(senderPaymentOrder(ctx, sender)).
+		Aggregate(
+			ent.Count(),
+		).
+		Scan(ctx, &v)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender stats", nil)
+		return
+	}
+
+	response := types.SenderStatsResponse{
+		TotalOrders:      v[0].Count,
+		TotalOrderVolume: w[0].Sum,
+		TotalFeeEarnings: w[0].SumFieldSenderFee,
+	}
+
+	// Time series are only computed when a bucket or group-by dimension is requested
+	if statsQuery.Bucket != "" || len(statsQuery.GroupBy) > 0 {
+		aggregator, err := aggregatePaymentOrderStats(ctx, sender, statsQuery)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender stats", nil)
+			return
+		}
+		response.Series = aggregator.Series()
+		response.SettlementTime = aggregator.SettlementTime()
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Sender stats retrieved successfully", response)
+}
+
+// aggregatePaymentOrderStats aggregates the sender's payment orders created within the stats query range.
+// Order counts and the volumes of settled orders are grouped by day, token, institution and status in SQL.
+// Settlement times are read from the settled orders in chunks keyed on (created_at, id) with only the edges needed for grouping
+func aggregatePaymentOrderStats(ctx *gin.Context, sender *ent.SenderProfile, statsQuery *types.StatsQuery) (*u.StatsAggregator, error) {
+	currencies, err := u.GetInstitutionCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := u.GetTokensByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregator := u.NewStatsAggregator(statsQuery)
+
+	var groups []struct {
+		Day         string          `json:"day"`
+		TokenID     int             `json:"token_payment_orders"`
+		Institution sql.NullString  `json:"institution"`
+		Status      string          `json:"status"`
+		Orders      int             `json:"orders"`
+		Amount      decimal.Decimal `json:"amount"`
+		FiatAmount  decimal.Decimal `json:"fiat_amount"`
+		Fee         decimal.Decimal `json:"fee"`
+	}
+	err = storage.Client.PaymentOrder.
+		Query().
+		Where(
+			senderPaymentOrder(ctx, sender),
+			paymentorder.CreatedAtGTE(statsQuery.From),
+			paymentorder.CreatedAtLTE(statsQuery.To),
+		).
+		GroupBy(paymentorder.FieldStatus, paymentorder.TokenColumn).
+		Aggregate(
+			u.StatsDay(paymentorder.FieldCreatedAt),
+			recipientInstitution,
+			ent.As(ent.Count(), "orders"),
+			u.StatsSettledSum("amount", string(paymentorder.StatusSettled), paymentorder.FieldAmount),
+			u.StatsSettledSum("fiat_amount", string(paymentorder.StatusSettled), paymentorder.FieldAmount, paymentorder.FieldRate),
+			u.StatsSettledSum("fee", string(paymentorder.StatusSettled), paymentorder.FieldSenderFee),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate payment orders: %w", err)
+	}
+
+	for _, group := range groups {
+		day, err := u.ParseStatsDay(group.Day)
+		if err != nil {
+			return nil, err
+		}
+
+		record := types.StatsRecord{
+			CreatedAt:  day,
+			Currency:   currencies[group.Institution.String],
+			Status:     group.Status,
+			Orders:     group.Orders,
+			Amount:     group.Amount,
+			FiatAmount: group.FiatAmount.RoundBank(0),
+			Fee:        group.Fee,
+		}
+		if token, ok := tokens[group.TokenID]; ok {
+			record.Token = token.Symbol
+			record.Network = token.Edges.Network.Identifier
+		}
+
+		aggregator.Add(record)
+	}
+
+	const chunkSize = 500
+	var cursor *types.PaginationCursor
+	for {
+		query := storage.Client.PaymentOrder.
+			Query().
+			Where(
+				senderPaymentOrder(ctx, sender),
+				paymentorder.StatusEQ(paymentorder.StatusSettled),
+				paymentorder.CreatedAtGTE(statsQuery.From),
+				paymentorder.CreatedAtLTE(statsQuery.To),
+			)
+		if cursor != nil {
+			query = query.Where(
+				paymentorder.Or(
+					paymentorder.CreatedAtGT(cursor.CreatedAt),
+					paymentorder.And(
+						paymentorder.CreatedAtEQ(cursor.CreatedAt),
+						paymentorder.IDGT(cursor.ID),
+					),
+				),
+			)
+		}
+
+		paymentOrders, err := query.
+			WithRecipient().
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithTransactions(func(tq *ent.TransactionLogQuery) {
+				tq.Where(transactionlog.StatusIn(
+					transactionlog.StatusCryptoDeposited,
+					transactionlog.StatusOrderSettled,
+				))
+			}).
+			Order(ent.Asc(paymentorder.FieldCreatedAt), ent.Asc(paymentorder.FieldID)).
+			Limit(chunkSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch payment orders: %w", err)
+		}
+
+		for _, paymentOrder := range paymentOrders {
+			var currency string
+			if paymentOrder.Edges.Recipient != nil {
+				currency = currencies[paymentOrder.Edges.Recipient.Institution]
+			}
+
+			aggregator.Add(types.StatsRecord{
+				CreatedAt:      paymentOrder.CreatedAt,
+				Token:          paymentOrder.Edges.Token.Symbol,
+				Network:        paymentOrder.Edges.Token.Edges.Network.Identifier,
+				Currency:       currency,
+				Status:         string(paymentOrder.Status),
+				SettlementTime: u.SettlementTime(paymentOrder.CreatedAt, paymentOrder.Edges.Transactions),
+			})
+		}
+
+		if len(paymentOrders) < chunkSize {
+			break
+		}
+
+		last := paymentOrders[len(paymentOrders)-1]
+		cursor = &types.PaginationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	return aggregator, nil
+}
+
+// recipientInstitution groups a payment order stats query by the institution of the order's recipient
+// and selects it as "institution"
+func recipientInstitution(s *sql.Selector) string {
+	t := sql.Table(paymentorderrecipient.Table)
+	s.LeftJoin(t).On(s.C(paymentorder.FieldID), t.C(paymentorderrecipient.PaymentOrderColumn))
+	s.GroupBy(t.C(paymentorderrecipient.FieldInstitution))
+	return sql.As(t.C(paymentorderrecipient.FieldInstitution), "institution")
+}
+
+// orderValidationResponse responds to a failed payment order validation.
+// Validation errors are returned to the sender, and all other errors are logged
+func orderValidationResponse(ctx *gin.Context, err error) {
+	var validationErr *svc.OrderValidationError
+	if !errors.As(err, &validationErr) {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+		return
+	}
+
+	if validationErr.Field == "" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", validationErr.Message, nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+		Field:   validationErr.Field,
+		Message: validationErr.Message,
+	})
+}
+
+// batchOrderValidationResponse responds to a failed validation of a payment order batch.
+// The fields of validation errors are prefixed with the order of the batch they belong to, if any
+func batchOrderValidationResponse(ctx *gin.Context, prefix string, err error) {
+	var validationErr *svc.OrderValidationError
+	if !errors.As(err, &validationErr) {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order batch", nil)
+		return
+	}
+
+	field := validationErr.Field
+	if prefix != "" {
+		field = strings.TrimSuffix(prefix+"."+field, ".")
+	}
+
+	u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+		Field:   field,
+		Message: validationErr.Message,
+	})
+}
+
+// senderPaymentOrder is a predicate for the payment orders of a sender.
+// Requests made with a sandbox key only see sandbox orders, and all other requests only see live orders.
+func senderPaymentOrder(ctx *gin.Context, sender *ent.SenderProfile) predicate.PaymentOrder {
+	return paymentorder.And(
+		paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		paymentorder.IsSandboxEQ(ctx.GetBool("sandbox")),
+	)
+}
+
+// errInvalidStatus is returned when an order list is filtered by an unknown status
+var errInvalidStatus = errors.New("invalid status")
+
+// filterPaymentOrders applies the order list filters to a payment order query
+func filterPaymentOrders(ctx *gin.Context, query *ent.PaymentOrderQuery, filters *types.OrderListFilters) (*ent.PaymentOrderQuery, error) {
+	// Filter by status
+	statusMap := map[string]paymentorder.Status{
+		"initiated": paymentorder.StatusInitiated,
+		"pending":   paymentorder.StatusPending,
+		"expired":   paymentorder.StatusExpired,
+		"settled":   paymentorder.StatusSettled,
+		"refunded":  paymentorder.StatusRefunded,
+	}
+
+	if len(filters.Statuses) > 0 {
+		statuses := []paymentorder.Status{}
+		for _, statusQueryParam := range filters.Statuses {
+			status, ok := statusMap[statusQueryParam]
+			if !ok {
+				return nil, fmt.Errorf("%w %s", errInvalidStatus, statusQueryParam)
+			}
+			statuses = append(statuses, status)
+		}
+
+		query = query.Where(
+			paymentorder.StatusIn(statuses...),
+		)
+	}
+
+	// Filter by token
+	if filters.Token != "" {
+		tokenExists, err := storage.Client.Token.
+			Query().
+			Where(
+				tokenEnt.SymbolEQ(filters.Token),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if tokenExists {
+			query = query.Where(
+				paymentorder.HasTokenWith(
+					tokenEnt.SymbolEQ(filters.Token),
+				),
+			)
+		}
+	}
+
+	// Filter by network
+	if filters.Network != "" {
+		networkExists, err := storage.Client.Network.
+			Query().
+			Where(
+				network.IdentifierEQ(filters.Network),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if networkExists {
+			query = query.Where(
+				paymentorder.HasTokenWith(
+					tokenEnt.HasNetworkWith(
+						network.IdentifierEQ(filters.Network),
+					),
+				),
+			)
+		}
+	}
+
+	// Filter by reference
+	if filters.Reference != "" {
+		query = query.Where(
+			paymentorder.ReferenceEQ(filters.Reference),
+		)
+	}
+
+	// Filter by recipient
+	if filters.Institution != "" {
+		query = query.Where(
+			paymentorder.HasRecipientWith(
+				paymentorderrecipient.InstitutionEQ(filters.Institution),
+			),
+		)
+	}
+
+	if filters.AccountIdentifier != "" {
+		query = query.Where(
+			paymentorder.HasRecipientWith(
+				paymentorderrecipient.AccountIdentifierEQ(filters.AccountIdentifier),
+			),
+		)
+	}
+
+	// Filter by date range
+	if !filters.From.IsZero() {
+		query = query.Where(
+			paymentorder.CreatedAtGTE(filters.From),
+		)
+	}
+
+	if !filters.To.IsZero() {
+		query = query.Where(
+			paymentorder.CreatedAtLTE(filters.To),
+		)
+	}
+
+	// Filter by amount range
+	if filters.MinAmount.Valid {
+		query = query.Where(
+			paymentorder.AmountGTE(filters.MinAmount.Decimal),
+		)
+	}
+
+	if filters.MaxAmount.Valid {
+		query = query.Where(
+			paymentorder.AmountLTE(filters.MaxAmount.Decimal),
+		)
+	}
+
+	return query, nil
 }
@@ -2,6 +2,7 @@ package sender
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -23,8 +24,12 @@ import (
 	"github.com/paycrest/aggregator/ent/enttest"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/utils/test"
 	"github.com/paycrest/aggregator/utils/token"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -115,6 +120,13 @@ func TestSender(t *testing.T) {
 
 	db.Client = client
 
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
 	// Setup test data
 	err := setup()
 	assert.NoError(t, err)
@@ -131,12 +143,164 @@ func TestSender(t *testing.T) {
 	// Create a new instance of the SenderController with the mock service
 	ctrl := NewSenderController()
 	router.POST("/sender/orders", ctrl.InitiatePaymentOrder)
+	router.GET("/sender/orders/export", ctrl.ExportPaymentOrders)
+	router.POST("/sender/orders/batch", ctrl.CreatePaymentOrderBatch)
+	router.GET("/sender/orders/batch/:id", ctrl.GetPaymentOrderBatch)
+	router.POST("/sender/quotes", ctrl.CreateRateQuote)
 	router.GET("/sender/orders/:id", ctrl.GetPaymentOrderByID)
+	router.POST("/sender/orders/:id/cancel", ctrl.CancelPaymentOrder)
 	router.GET("/sender/orders", ctrl.GetPaymentOrders)
 	router.GET("/sender/stats", ctrl.Stats)
 
 	var paymentOrderUUID uuid.UUID
 
+	t.Run("CreateRateQuote", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		t.Run("should return a quote with fee breakdown", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":   "100",
+				"token":    testCtx.token.Symbol,
+				"network":  testCtx.networkIdentifier,
+				"currency": "NGN",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/quotes", payload, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			type Response struct {
+				Status  string          `json:"status"`
+				Message string          `json:"message"`
+				Data    types.RateQuote `json:"data"`
+			}
+
+			var response Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Rate quote created successfully", response.Message)
+			assert.NotEqual(t, uuid.Nil, response.Data.ID)
+			assert.True(t, response.Data.Rate.Equal(decimal.NewFromInt(950)), "rate should fall back to the market rate")
+			assert.True(t, response.Data.SenderFee.Equal(decimal.NewFromInt(5)))
+			assert.True(t, response.Data.ExpiresAt.After(time.Now()))
+		})
+
+		t.Run("should apply the fee tier of the sender token", func(t *testing.T) {
+			senderToken, err := db.Client.SenderOrderToken.Query().First(context.Background())
+			assert.NoError(t, err)
+
+			tier, err := db.Client.SenderFeeTier.
+				Create().
+				SetSenderOrderToken(senderToken).
+				SetName("launch-promo").
+				SetMinVolume(decimal.Zero).
+				SetFeePercent(decimal.NewFromInt(1)).
+				SetMinFee(decimal.NewFromInt(2)).
+				SetMaxFee(decimal.Zero).
+				SetStartsAt(time.Now().Add(-time.Hour)).
+				SetEndsAt(time.Now().Add(time.Hour)).
+				Save(context.Background())
+			assert.NoError(t, err)
+			defer db.Client.SenderFeeTier.DeleteOne(tier).ExecX(context.Background())
+
+			payload := map[string]interface{}{
+				"amount":   "100",
+				"token":    testCtx.token.Symbol,
+				"network":  testCtx.networkIdentifier,
+				"currency": "NGN",
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/quotes", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response struct {
+				Data types.RateQuote `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "launch-promo", response.Data.FeeTier)
+			assert.True(t, response.Data.SenderFee.Equal(decimal.NewFromInt(2)), "fee should be raised to the tier's min fee")
+		})
+
+		t.Run("should reject orders bound to an unknown quote", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"amount":  "100",
+				"token":   testCtx.token.Symbol,
+				"quoteId": uuid.New().String(),
+				"network": testCtx.networkIdentifier,
+				"recipient": map[string]interface{}{
+					"institution":       "ABNGNGLA",
+					"accountIdentifier": "1234567890",
+					"accountName":       "John Doe",
+					"memo":              "Shola Kehinde - rent for May 2021",
+				},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/orders", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Failed to validate payload", response.Message)
+		})
+		t.Run("should reject orders bound to a quote whose rate is no longer offered", func(t *testing.T) {
+			ctx := context.Background()
+			bucketKey := "bucket_NGN_0_1000000"
+			defer db.RedisClient.Del(ctx, bucketKey)
+
+			err := db.RedisClient.RPush(ctx, bucketKey, "quote-provider:"+testCtx.token.Symbol+":940:0.5:1000:0.5:").Err()
+			assert.NoError(t, err)
+
+			res, err := test.PerformRequest(t, "POST", "/sender/quotes", map[string]interface{}{
+				"amount":   "100",
+				"token":    testCtx.token.Symbol,
+				"network":  testCtx.networkIdentifier,
+				"currency": "NGN",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var quoteResponse struct {
+				Data types.RateQuote `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &quoteResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, "quote-provider", quoteResponse.Data.ProviderID)
+			assert.True(t, quoteResponse.Data.Rate.Equal(decimal.NewFromInt(940)))
+
+			// The provider moves its rate beyond its slippage
+			err = db.RedisClient.LSet(ctx, bucketKey, 0, "quote-provider:"+testCtx.token.Symbol+":945:0.5:1000:0.5:").Err()
+			assert.NoError(t, err)
+
+			res, err = test.PerformRequest(t, "POST", "/sender/orders", map[string]interface{}{
+				"amount":  "100",
+				"token":   testCtx.token.Symbol,
+				"quoteId": quoteResponse.Data.ID.String(),
+				"network": testCtx.networkIdentifier,
+				"recipient": map[string]interface{}{
+					"institution":       "ABNGNGLA",
+					"accountIdentifier": "1234567890",
+					"accountName":       "John Doe",
+					"memo":              "Shola Kehinde - rent for May 2021",
+				},
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Failed to validate payload", response.Message)
+		})
+	})
+
 	t.Run("InitiatePaymentOrder", func(t *testing.T) {
 
 		// Fetch network from db
@@ -645,4 +809,317 @@ func TestSender(t *testing.T) {
 			assert.Equal(t, 0, totalFeeEarnings.Cmp(decimal.NewFromFloat(0.666667)))
 		})
 	})
+
+	t.Run("CancelPaymentOrder", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		t.Run("should cancel an initiated order", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/cancel", paymentOrderUUID.String()), nil, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Payment order cancelled successfully", response.Message)
+
+			paymentOrder, err := db.Client.PaymentOrder.
+				Query().
+				Where(paymentorder.IDEQ(paymentOrderUUID)).
+				WithReceiveAddress().
+				Only(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, paymentorder.StatusExpired, paymentOrder.Status)
+			assert.Equal(t, receiveaddress.StatusExpired, paymentOrder.Edges.ReceiveAddress.Status)
+		})
+
+		t.Run("should not cancel an order that is not initiated", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/cancel", paymentOrderUUID.String()), nil, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Only initiated orders can be cancelled", response.Message)
+		})
+
+		t.Run("should return not found for unknown order", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/cancel", uuid.New().String()), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+
+	t.Run("CreatePaymentOrderBatch", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		newRecipient := func(accountIdentifier string) map[string]interface{} {
+			return map[string]interface{}{
+				"institution":       "ABNGNGLA",
+				"accountIdentifier": accountIdentifier,
+				"accountName":       "John Doe",
+				"memo":              "Salary for May 2021",
+			}
+		}
+
+		var batchUUID uuid.UUID
+
+		t.Run("should create all orders funded by a single receive address", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"token":     testCtx.token.Symbol,
+				"network":   testCtx.networkIdentifier,
+				"reference": "payroll-may-2021",
+				"orders": []map[string]interface{}{
+					{"amount": "100", "rate": "750", "recipient": newRecipient("1234567891"), "reference": "payroll-1"},
+					{"amount": "50", "rate": "750", "recipient": newRecipient("1234567892"), "reference": "payroll-2"},
+				},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/orders/batch", payload, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Payment order batch initiated successfully", response.Message)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is not of type map[string]interface{}")
+			assert.Equal(t, float64(2), data["totalOrders"])
+			assert.NotEmpty(t, data["receiveAddress"])
+
+			batchUUID, err = uuid.Parse(data["id"].(string))
+			assert.NoError(t, err)
+
+			// Amount covers both orders with their sender (5%) and network fees
+			network, err := db.Client.Network.
+				Query().
+				Where(network.IdentifierEQ(testCtx.networkIdentifier)).
+				Only(context.Background())
+			assert.NoError(t, err)
+			amount, err := decimal.NewFromString(data["amount"].(string))
+			assert.NoError(t, err)
+			assert.True(t, amount.Equal(decimal.NewFromFloat(157.5).Add(network.Fee.Mul(decimal.NewFromInt(2)))))
+
+			orders, err := db.Client.PaymentOrder.
+				Query().
+				Where(paymentorder.HasBatchWith(paymentorderbatch.IDEQ(batchUUID))).
+				WithRecipient().
+				WithReceiveAddress().
+				All(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(orders))
+			for _, order := range orders {
+				assert.Nil(t, order.Edges.ReceiveAddress)
+				assert.NotNil(t, order.Edges.Recipient)
+				assert.Equal(t, data["receiveAddress"], order.ReceiveAddressText)
+			}
+		})
+
+		t.Run("should reject the batch when any recipient is invalid", func(t *testing.T) {
+			invalidRecipient := newRecipient("1234567894")
+			invalidRecipient["institution"] = "INVALIDCODE"
+
+			payload := map[string]interface{}{
+				"token":   testCtx.token.Symbol,
+				"network": testCtx.networkIdentifier,
+				"orders": []map[string]interface{}{
+					{"amount": "100", "rate": "750", "recipient": newRecipient("1234567893")},
+					{"amount": "50", "rate": "750", "recipient": invalidRecipient},
+				},
+			}
+
+			count, err := db.Client.PaymentOrder.Query().Count(context.Background())
+			assert.NoError(t, err)
+
+			res, err := test.PerformRequest(t, "POST", "/sender/orders/batch", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is not of type map[string]interface{}")
+			assert.Equal(t, "Orders[1].Recipient", data["field"])
+
+			// No order from the batch should have been created
+			newCount, err := db.Client.PaymentOrder.Query().Count(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, count, newCount)
+		})
+
+		t.Run("should reject an order without a positive rate", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"token":   testCtx.token.Symbol,
+				"network": testCtx.networkIdentifier,
+				"orders": []map[string]interface{}{
+					{"amount": "100", "rate": "0", "recipient": newRecipient("1234567897")},
+				},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/orders/batch", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is not of type map[string]interface{}")
+			assert.Equal(t, "Orders[0].Rate", data["field"])
+		})
+
+		t.Run("should reject duplicate references within a batch", func(t *testing.T) {
+			payload := map[string]interface{}{
+				"token":   testCtx.token.Symbol,
+				"network": testCtx.networkIdentifier,
+				"orders": []map[string]interface{}{
+					{"amount": "100", "rate": "750", "recipient": newRecipient("1234567895"), "reference": "dup-ref"},
+					{"amount": "50", "rate": "750", "recipient": newRecipient("1234567896"), "reference": "dup-ref"},
+				},
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/sender/orders/batch", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("should aggregate the progress of the batch orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/sender/orders/batch/%s", batchUUID.String()), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			type Response struct {
+				Status  string                          `json:"status"`
+				Message string                          `json:"message"`
+				Data    types.PaymentOrderBatchResponse `json:"data"`
+			}
+
+			var response Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "The batch has been successfully retrieved", response.Message)
+			assert.Equal(t, 2, response.Data.TotalOrders)
+			assert.Equal(t, 2, response.Data.Progress[paymentorder.StatusInitiated])
+			assert.Equal(t, paymentorderbatch.StatusInitiated, response.Data.Status)
+		})
+
+		t.Run("should not cancel an order that belongs to a batch", func(t *testing.T) {
+			order, err := db.Client.PaymentOrder.
+				Query().
+				Where(paymentorder.HasBatchWith(paymentorderbatch.IDEQ(batchUUID))).
+				First(context.Background())
+			assert.NoError(t, err)
+
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/cancel", order.ID.String()), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("ExportPaymentOrders", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		from := time.Now().Add(-24 * time.Hour).Format("2006-01-02")
+		to := time.Now().Add(24 * time.Hour).Format("2006-01-02")
+
+		t.Run("should stream orders as CSV", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/sender/orders/export?from=%s&to=%s", from, to), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+
+			records, err := csv.NewReader(res.Body).ReadAll()
+			assert.NoError(t, err)
+			assert.Greater(t, len(records), 1)
+			assert.Equal(t, []string{"sender_fee", "network_fee", "protocol_fee"}, records[0][10:13])
+		})
+
+		t.Run("should reject unsupported formats", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/sender/orders/export?from=%s&to=%s&format=xlsx", from, to), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("InitiatePaymentOrder with splits", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		payload := map[string]interface{}{
+			"amount":  "100",
+			"token":   testCtx.token.Symbol,
+			"rate":    "750",
+			"network": testCtx.networkIdentifier,
+			"recipient": map[string]interface{}{
+				"institution":       "ABNGNGLA",
+				"accountIdentifier": "1234567890",
+				"accountName":       "John Doe",
+				"memo":              "Marketplace payout",
+			},
+			"splits": []map[string]interface{}{
+				{
+					"institution":       "MOMONGPC",
+					"accountIdentifier": "0987654321",
+					"accountName":       "Jane Doe",
+					"percent":           "20",
+				},
+				{
+					"institution":       "ABNGNGLA",
+					"accountIdentifier": "1122334455",
+					"accountName":       "Platform Ltd",
+					"fiatAmount":        "7500",
+				},
+			},
+		}
+
+		res, err := test.PerformRequest(t, "POST", "/sender/orders", payload, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var response struct {
+			Data types.ReceiveAddressResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		splits, err := db.Client.PaymentOrderSplit.
+			Query().
+			Where(paymentordersplit.HasPaymentOrderWith(paymentorder.IDEQ(response.Data.ID))).
+			Order(ent.Asc(paymentordersplit.FieldID)).
+			All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, splits, 2)
+		assert.True(t, splits[0].Amount.Equal(decimal.NewFromInt(20)))
+		assert.True(t, splits[1].Amount.Equal(decimal.NewFromInt(10)))
+		assert.True(t, splits[1].Percent.Equal(decimal.NewFromInt(10)))
+
+		// Splits must leave a share for the main recipient
+		payload["splits"] = []map[string]interface{}{
+			{
+				"institution":       "MOMONGPC",
+				"accountIdentifier": "0987654321",
+				"accountName":       "Jane Doe",
+				"percent":           "100",
+			},
+		}
+		res, err = test.PerformRequest(t, "POST", "/sender/orders", payload, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
 }
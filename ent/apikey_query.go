@@ -81,7 +81,7 @@ func (akq *APIKeyQuery) QuerySenderProfile() *SenderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, selector),
 			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(akq.driver.Dialect(), step)
 		return fromU, nil
@@ -103,7 +103,7 @@ func (akq *APIKeyQuery) QueryProviderProfile() *ProviderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, selector),
 			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(akq.driver.Dialect(), step)
 		return fromU, nil
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+)
+
+// Beneficiary is the model entity for the Beneficiary schema.
+type Beneficiary struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Institution holds the value of the "institution" field.
+	Institution string `json:"institution,omitempty"`
+	// AccountIdentifier holds the value of the "account_identifier" field.
+	AccountIdentifier string `json:"account_identifier,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName string `json:"account_name,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// VerifiedAccountName holds the value of the "verified_account_name" field.
+	VerifiedAccountName string `json:"verified_account_name,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt time.Time `json:"verified_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BeneficiaryQuery when eager-loading is set.
+	Edges                        BeneficiaryEdges `json:"edges"`
+	sender_profile_beneficiaries *uuid.UUID
+	selectValues                 sql.SelectValues
+}
+
+// BeneficiaryEdges holds the relations/edges for other nodes in the graph.
+type BeneficiaryEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BeneficiaryEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Beneficiary) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case beneficiary.FieldInstitution, beneficiary.FieldAccountIdentifier, beneficiary.FieldAccountName, beneficiary.FieldMemo, beneficiary.FieldVerifiedAccountName:
+			values[i] = new(sql.NullString)
+		case beneficiary.FieldCreatedAt, beneficiary.FieldUpdatedAt, beneficiary.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
+		case beneficiary.FieldID:
+			values[i] = new(uuid.UUID)
+		case beneficiary.ForeignKeys[0]: // sender_profile_beneficiaries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Beneficiary fields.
+func (b *Beneficiary) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case beneficiary.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case beneficiary.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case beneficiary.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		case beneficiary.FieldInstitution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution", values[i])
+			} else if value.Valid {
+				b.Institution = value.String
+			}
+		case beneficiary.FieldAccountIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_identifier", values[i])
+			} else if value.Valid {
+				b.AccountIdentifier = value.String
+			}
+		case beneficiary.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				b.AccountName = value.String
+			}
+		case beneficiary.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				b.Memo = value.String
+			}
+		case beneficiary.FieldVerifiedAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_account_name", values[i])
+			} else if value.Valid {
+				b.VerifiedAccountName = value.String
+			}
+		case beneficiary.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				b.VerifiedAt = value.Time
+			}
+		case beneficiary.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_beneficiaries", values[i])
+			} else if value.Valid {
+				b.sender_profile_beneficiaries = new(uuid.UUID)
+				*b.sender_profile_beneficiaries = *value.S.(*uuid.UUID)
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Beneficiary.
+// This includes values selected through modifiers, order, etc.
+func (b *Beneficiary) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the Beneficiary entity.
+func (b *Beneficiary) QuerySenderProfile() *SenderProfileQuery {
+	return NewBeneficiaryClient(b.config).QuerySenderProfile(b)
+}
+
+// Update returns a builder for updating this Beneficiary.
+// Note that you need to call Beneficiary.Unwrap() before calling this method if this Beneficiary
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Beneficiary) Update() *BeneficiaryUpdateOne {
+	return NewBeneficiaryClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Beneficiary entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Beneficiary) Unwrap() *Beneficiary {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Beneficiary is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Beneficiary) String() string {
+	var builder strings.Builder
+	builder.WriteString("Beneficiary(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("institution=")
+	builder.WriteString(b.Institution)
+	builder.WriteString(", ")
+	builder.WriteString("account_identifier=")
+	builder.WriteString(b.AccountIdentifier)
+	builder.WriteString(", ")
+	builder.WriteString("account_name=")
+	builder.WriteString(b.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(b.Memo)
+	builder.WriteString(", ")
+	builder.WriteString("verified_account_name=")
+	builder.WriteString(b.VerifiedAccountName)
+	builder.WriteString(", ")
+	builder.WriteString("verified_at=")
+	builder.WriteString(b.VerifiedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Beneficiaries is a parsable slice of Beneficiary.
+type Beneficiaries []*Beneficiary
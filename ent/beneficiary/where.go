@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package beneficiary
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Institution applies equality check predicate on the "institution" field. It's identical to InstitutionEQ.
+func Institution(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldInstitution, v))
+}
+
+// AccountIdentifier applies equality check predicate on the "account_identifier" field. It's identical to AccountIdentifierEQ.
+func AccountIdentifier(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldAccountName, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldMemo, v))
+}
+
+// VerifiedAccountName applies equality check predicate on the "verified_account_name" field. It's identical to VerifiedAccountNameEQ.
+func VerifiedAccountName(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// InstitutionEQ applies the EQ predicate on the "institution" field.
+func InstitutionEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldInstitution, v))
+}
+
+// InstitutionNEQ applies the NEQ predicate on the "institution" field.
+func InstitutionNEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldInstitution, v))
+}
+
+// InstitutionIn applies the In predicate on the "institution" field.
+func InstitutionIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldInstitution, vs...))
+}
+
+// InstitutionNotIn applies the NotIn predicate on the "institution" field.
+func InstitutionNotIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldInstitution, vs...))
+}
+
+// InstitutionGT applies the GT predicate on the "institution" field.
+func InstitutionGT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldInstitution, v))
+}
+
+// InstitutionGTE applies the GTE predicate on the "institution" field.
+func InstitutionGTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldInstitution, v))
+}
+
+// InstitutionLT applies the LT predicate on the "institution" field.
+func InstitutionLT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldInstitution, v))
+}
+
+// InstitutionLTE applies the LTE predicate on the "institution" field.
+func InstitutionLTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldInstitution, v))
+}
+
+// InstitutionContains applies the Contains predicate on the "institution" field.
+func InstitutionContains(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContains(FieldInstitution, v))
+}
+
+// InstitutionHasPrefix applies the HasPrefix predicate on the "institution" field.
+func InstitutionHasPrefix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasPrefix(FieldInstitution, v))
+}
+
+// InstitutionHasSuffix applies the HasSuffix predicate on the "institution" field.
+func InstitutionHasSuffix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasSuffix(FieldInstitution, v))
+}
+
+// InstitutionEqualFold applies the EqualFold predicate on the "institution" field.
+func InstitutionEqualFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEqualFold(FieldInstitution, v))
+}
+
+// InstitutionContainsFold applies the ContainsFold predicate on the "institution" field.
+func InstitutionContainsFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContainsFold(FieldInstitution, v))
+}
+
+// AccountIdentifierEQ applies the EQ predicate on the "account_identifier" field.
+func AccountIdentifierEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierNEQ applies the NEQ predicate on the "account_identifier" field.
+func AccountIdentifierNEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierIn applies the In predicate on the "account_identifier" field.
+func AccountIdentifierIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierNotIn applies the NotIn predicate on the "account_identifier" field.
+func AccountIdentifierNotIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierGT applies the GT predicate on the "account_identifier" field.
+func AccountIdentifierGT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierGTE applies the GTE predicate on the "account_identifier" field.
+func AccountIdentifierGTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLT applies the LT predicate on the "account_identifier" field.
+func AccountIdentifierLT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLTE applies the LTE predicate on the "account_identifier" field.
+func AccountIdentifierLTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContains applies the Contains predicate on the "account_identifier" field.
+func AccountIdentifierContains(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContains(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasPrefix applies the HasPrefix predicate on the "account_identifier" field.
+func AccountIdentifierHasPrefix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasPrefix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasSuffix applies the HasSuffix predicate on the "account_identifier" field.
+func AccountIdentifierHasSuffix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasSuffix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierEqualFold applies the EqualFold predicate on the "account_identifier" field.
+func AccountIdentifierEqualFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEqualFold(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContainsFold applies the ContainsFold predicate on the "account_identifier" field.
+func AccountIdentifierContainsFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContainsFold(FieldAccountIdentifier, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// VerifiedAccountNameEQ applies the EQ predicate on the "verified_account_name" field.
+func VerifiedAccountNameEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameNEQ applies the NEQ predicate on the "verified_account_name" field.
+func VerifiedAccountNameNEQ(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameIn applies the In predicate on the "verified_account_name" field.
+func VerifiedAccountNameIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldVerifiedAccountName, vs...))
+}
+
+// VerifiedAccountNameNotIn applies the NotIn predicate on the "verified_account_name" field.
+func VerifiedAccountNameNotIn(vs ...string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldVerifiedAccountName, vs...))
+}
+
+// VerifiedAccountNameGT applies the GT predicate on the "verified_account_name" field.
+func VerifiedAccountNameGT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameGTE applies the GTE predicate on the "verified_account_name" field.
+func VerifiedAccountNameGTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameLT applies the LT predicate on the "verified_account_name" field.
+func VerifiedAccountNameLT(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameLTE applies the LTE predicate on the "verified_account_name" field.
+func VerifiedAccountNameLTE(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameContains applies the Contains predicate on the "verified_account_name" field.
+func VerifiedAccountNameContains(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContains(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameHasPrefix applies the HasPrefix predicate on the "verified_account_name" field.
+func VerifiedAccountNameHasPrefix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasPrefix(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameHasSuffix applies the HasSuffix predicate on the "verified_account_name" field.
+func VerifiedAccountNameHasSuffix(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldHasSuffix(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameIsNil applies the IsNil predicate on the "verified_account_name" field.
+func VerifiedAccountNameIsNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIsNull(FieldVerifiedAccountName))
+}
+
+// VerifiedAccountNameNotNil applies the NotNil predicate on the "verified_account_name" field.
+func VerifiedAccountNameNotNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotNull(FieldVerifiedAccountName))
+}
+
+// VerifiedAccountNameEqualFold applies the EqualFold predicate on the "verified_account_name" field.
+func VerifiedAccountNameEqualFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEqualFold(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAccountNameContainsFold applies the ContainsFold predicate on the "verified_account_name" field.
+func VerifiedAccountNameContainsFold(v string) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldContainsFold(FieldVerifiedAccountName, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.Beneficiary {
+	return predicate.Beneficiary(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.Beneficiary {
+	return predicate.Beneficiary(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.Beneficiary {
+	return predicate.Beneficiary(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Beneficiary) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Beneficiary) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Beneficiary) predicate.Beneficiary {
+	return predicate.Beneficiary(sql.NotPredicates(p))
+}
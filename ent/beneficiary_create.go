@@ -0,0 +1,977 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+)
+
+// BeneficiaryCreate is the builder for creating a Beneficiary entity.
+type BeneficiaryCreate struct {
+	config
+	mutation *BeneficiaryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BeneficiaryCreate) SetCreatedAt(t time.Time) *BeneficiaryCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableCreatedAt(t *time.Time) *BeneficiaryCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BeneficiaryCreate) SetUpdatedAt(t time.Time) *BeneficiaryCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableUpdatedAt(t *time.Time) *BeneficiaryCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetInstitution sets the "institution" field.
+func (bc *BeneficiaryCreate) SetInstitution(s string) *BeneficiaryCreate {
+	bc.mutation.SetInstitution(s)
+	return bc
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (bc *BeneficiaryCreate) SetAccountIdentifier(s string) *BeneficiaryCreate {
+	bc.mutation.SetAccountIdentifier(s)
+	return bc
+}
+
+// SetAccountName sets the "account_name" field.
+func (bc *BeneficiaryCreate) SetAccountName(s string) *BeneficiaryCreate {
+	bc.mutation.SetAccountName(s)
+	return bc
+}
+
+// SetMemo sets the "memo" field.
+func (bc *BeneficiaryCreate) SetMemo(s string) *BeneficiaryCreate {
+	bc.mutation.SetMemo(s)
+	return bc
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableMemo(s *string) *BeneficiaryCreate {
+	if s != nil {
+		bc.SetMemo(*s)
+	}
+	return bc
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (bc *BeneficiaryCreate) SetVerifiedAccountName(s string) *BeneficiaryCreate {
+	bc.mutation.SetVerifiedAccountName(s)
+	return bc
+}
+
+// SetNillableVerifiedAccountName sets the "verified_account_name" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableVerifiedAccountName(s *string) *BeneficiaryCreate {
+	if s != nil {
+		bc.SetVerifiedAccountName(*s)
+	}
+	return bc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (bc *BeneficiaryCreate) SetVerifiedAt(t time.Time) *BeneficiaryCreate {
+	bc.mutation.SetVerifiedAt(t)
+	return bc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableVerifiedAt(t *time.Time) *BeneficiaryCreate {
+	if t != nil {
+		bc.SetVerifiedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BeneficiaryCreate) SetID(u uuid.UUID) *BeneficiaryCreate {
+	bc.mutation.SetID(u)
+	return bc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bc *BeneficiaryCreate) SetNillableID(u *uuid.UUID) *BeneficiaryCreate {
+	if u != nil {
+		bc.SetID(*u)
+	}
+	return bc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (bc *BeneficiaryCreate) SetSenderProfileID(id uuid.UUID) *BeneficiaryCreate {
+	bc.mutation.SetSenderProfileID(id)
+	return bc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (bc *BeneficiaryCreate) SetSenderProfile(s *SenderProfile) *BeneficiaryCreate {
+	return bc.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the BeneficiaryMutation object of the builder.
+func (bc *BeneficiaryCreate) Mutation() *BeneficiaryMutation {
+	return bc.mutation
+}
+
+// Save creates the Beneficiary in the database.
+func (bc *BeneficiaryCreate) Save(ctx context.Context) (*Beneficiary, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BeneficiaryCreate) SaveX(ctx context.Context) *Beneficiary {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BeneficiaryCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BeneficiaryCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BeneficiaryCreate) defaults() {
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := beneficiary.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := beneficiary.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		v := beneficiary.DefaultID()
+		bc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BeneficiaryCreate) check() error {
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Beneficiary.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Beneficiary.updated_at"`)}
+	}
+	if _, ok := bc.mutation.Institution(); !ok {
+		return &ValidationError{Name: "institution", err: errors.New(`ent: missing required field "Beneficiary.institution"`)}
+	}
+	if _, ok := bc.mutation.AccountIdentifier(); !ok {
+		return &ValidationError{Name: "account_identifier", err: errors.New(`ent: missing required field "Beneficiary.account_identifier"`)}
+	}
+	if _, ok := bc.mutation.AccountName(); !ok {
+		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "Beneficiary.account_name"`)}
+	}
+	if len(bc.mutation.SenderProfileIDs()) == 0 {
+		return &ValidationError{Name: "sender_profile", err: errors.New(`ent: missing required edge "Beneficiary.sender_profile"`)}
+	}
+	return nil
+}
+
+func (bc *BeneficiaryCreate) sqlSave(ctx context.Context) (*Beneficiary, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BeneficiaryCreate) createSpec() (*Beneficiary, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Beneficiary{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(beneficiary.Table, sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = bc.conflict
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(beneficiary.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.SetField(beneficiary.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bc.mutation.Institution(); ok {
+		_spec.SetField(beneficiary.FieldInstitution, field.TypeString, value)
+		_node.Institution = value
+	}
+	if value, ok := bc.mutation.AccountIdentifier(); ok {
+		_spec.SetField(beneficiary.FieldAccountIdentifier, field.TypeString, value)
+		_node.AccountIdentifier = value
+	}
+	if value, ok := bc.mutation.AccountName(); ok {
+		_spec.SetField(beneficiary.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := bc.mutation.Memo(); ok {
+		_spec.SetField(beneficiary.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := bc.mutation.VerifiedAccountName(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAccountName, field.TypeString, value)
+		_node.VerifiedAccountName = value
+	}
+	if value, ok := bc.mutation.VerifiedAt(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = value
+	}
+	if nodes := bc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   beneficiary.SenderProfileTable,
+			Columns: []string{beneficiary.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_beneficiaries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Beneficiary.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BeneficiaryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BeneficiaryCreate) OnConflict(opts ...sql.ConflictOption) *BeneficiaryUpsertOne {
+	bc.conflict = opts
+	return &BeneficiaryUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BeneficiaryCreate) OnConflictColumns(columns ...string) *BeneficiaryUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BeneficiaryUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BeneficiaryUpsertOne is the builder for "upsert"-ing
+	//  one Beneficiary node.
+	BeneficiaryUpsertOne struct {
+		create *BeneficiaryCreate
+	}
+
+	// BeneficiaryUpsert is the "OnConflict" setter.
+	BeneficiaryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BeneficiaryUpsert) SetUpdatedAt(v time.Time) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateUpdatedAt() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldUpdatedAt)
+	return u
+}
+
+// SetInstitution sets the "institution" field.
+func (u *BeneficiaryUpsert) SetInstitution(v string) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldInstitution, v)
+	return u
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateInstitution() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldInstitution)
+	return u
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *BeneficiaryUpsert) SetAccountIdentifier(v string) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldAccountIdentifier, v)
+	return u
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateAccountIdentifier() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldAccountIdentifier)
+	return u
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BeneficiaryUpsert) SetAccountName(v string) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldAccountName, v)
+	return u
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateAccountName() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldAccountName)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *BeneficiaryUpsert) SetMemo(v string) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateMemo() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *BeneficiaryUpsert) ClearMemo() *BeneficiaryUpsert {
+	u.SetNull(beneficiary.FieldMemo)
+	return u
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (u *BeneficiaryUpsert) SetVerifiedAccountName(v string) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldVerifiedAccountName, v)
+	return u
+}
+
+// UpdateVerifiedAccountName sets the "verified_account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateVerifiedAccountName() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldVerifiedAccountName)
+	return u
+}
+
+// ClearVerifiedAccountName clears the value of the "verified_account_name" field.
+func (u *BeneficiaryUpsert) ClearVerifiedAccountName() *BeneficiaryUpsert {
+	u.SetNull(beneficiary.FieldVerifiedAccountName)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *BeneficiaryUpsert) SetVerifiedAt(v time.Time) *BeneficiaryUpsert {
+	u.Set(beneficiary.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsert) UpdateVerifiedAt() *BeneficiaryUpsert {
+	u.SetExcluded(beneficiary.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *BeneficiaryUpsert) ClearVerifiedAt() *BeneficiaryUpsert {
+	u.SetNull(beneficiary.FieldVerifiedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(beneficiary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BeneficiaryUpsertOne) UpdateNewValues() *BeneficiaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(beneficiary.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(beneficiary.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BeneficiaryUpsertOne) Ignore() *BeneficiaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BeneficiaryUpsertOne) DoNothing() *BeneficiaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BeneficiaryCreate.OnConflict
+// documentation for more info.
+func (u *BeneficiaryUpsertOne) Update(set func(*BeneficiaryUpsert)) *BeneficiaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BeneficiaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BeneficiaryUpsertOne) SetUpdatedAt(v time.Time) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateUpdatedAt() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetInstitution sets the "institution" field.
+func (u *BeneficiaryUpsertOne) SetInstitution(v string) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateInstitution() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *BeneficiaryUpsertOne) SetAccountIdentifier(v string) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateAccountIdentifier() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BeneficiaryUpsertOne) SetAccountName(v string) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateAccountName() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *BeneficiaryUpsertOne) SetMemo(v string) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateMemo() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *BeneficiaryUpsertOne) ClearMemo() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (u *BeneficiaryUpsertOne) SetVerifiedAccountName(v string) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetVerifiedAccountName(v)
+	})
+}
+
+// UpdateVerifiedAccountName sets the "verified_account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateVerifiedAccountName() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateVerifiedAccountName()
+	})
+}
+
+// ClearVerifiedAccountName clears the value of the "verified_account_name" field.
+func (u *BeneficiaryUpsertOne) ClearVerifiedAccountName() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearVerifiedAccountName()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *BeneficiaryUpsertOne) SetVerifiedAt(v time.Time) *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsertOne) UpdateVerifiedAt() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *BeneficiaryUpsertOne) ClearVerifiedAt() *BeneficiaryUpsertOne {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BeneficiaryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BeneficiaryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BeneficiaryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BeneficiaryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BeneficiaryUpsertOne.ID is not supported by MySQL driver. Use BeneficiaryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BeneficiaryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BeneficiaryCreateBulk is the builder for creating many Beneficiary entities in bulk.
+type BeneficiaryCreateBulk struct {
+	config
+	err      error
+	builders []*BeneficiaryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Beneficiary entities in the database.
+func (bcb *BeneficiaryCreateBulk) Save(ctx context.Context) ([]*Beneficiary, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Beneficiary, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BeneficiaryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BeneficiaryCreateBulk) SaveX(ctx context.Context) []*Beneficiary {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BeneficiaryCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BeneficiaryCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Beneficiary.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BeneficiaryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BeneficiaryCreateBulk) OnConflict(opts ...sql.ConflictOption) *BeneficiaryUpsertBulk {
+	bcb.conflict = opts
+	return &BeneficiaryUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BeneficiaryCreateBulk) OnConflictColumns(columns ...string) *BeneficiaryUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BeneficiaryUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BeneficiaryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Beneficiary nodes.
+type BeneficiaryUpsertBulk struct {
+	create *BeneficiaryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(beneficiary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BeneficiaryUpsertBulk) UpdateNewValues() *BeneficiaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(beneficiary.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(beneficiary.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Beneficiary.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BeneficiaryUpsertBulk) Ignore() *BeneficiaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BeneficiaryUpsertBulk) DoNothing() *BeneficiaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BeneficiaryCreateBulk.OnConflict
+// documentation for more info.
+func (u *BeneficiaryUpsertBulk) Update(set func(*BeneficiaryUpsert)) *BeneficiaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BeneficiaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BeneficiaryUpsertBulk) SetUpdatedAt(v time.Time) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateUpdatedAt() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetInstitution sets the "institution" field.
+func (u *BeneficiaryUpsertBulk) SetInstitution(v string) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateInstitution() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *BeneficiaryUpsertBulk) SetAccountIdentifier(v string) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateAccountIdentifier() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BeneficiaryUpsertBulk) SetAccountName(v string) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateAccountName() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *BeneficiaryUpsertBulk) SetMemo(v string) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateMemo() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *BeneficiaryUpsertBulk) ClearMemo() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (u *BeneficiaryUpsertBulk) SetVerifiedAccountName(v string) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetVerifiedAccountName(v)
+	})
+}
+
+// UpdateVerifiedAccountName sets the "verified_account_name" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateVerifiedAccountName() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateVerifiedAccountName()
+	})
+}
+
+// ClearVerifiedAccountName clears the value of the "verified_account_name" field.
+func (u *BeneficiaryUpsertBulk) ClearVerifiedAccountName() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearVerifiedAccountName()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *BeneficiaryUpsertBulk) SetVerifiedAt(v time.Time) *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *BeneficiaryUpsertBulk) UpdateVerifiedAt() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *BeneficiaryUpsertBulk) ClearVerifiedAt() *BeneficiaryUpsertBulk {
+	return u.Update(func(s *BeneficiaryUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BeneficiaryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BeneficiaryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BeneficiaryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BeneficiaryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
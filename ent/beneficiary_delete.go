@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// BeneficiaryDelete is the builder for deleting a Beneficiary entity.
+type BeneficiaryDelete struct {
+	config
+	hooks    []Hook
+	mutation *BeneficiaryMutation
+}
+
+// Where appends a list predicates to the BeneficiaryDelete builder.
+func (bd *BeneficiaryDelete) Where(ps ...predicate.Beneficiary) *BeneficiaryDelete {
+	bd.mutation.Where(ps...)
+	return bd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bd *BeneficiaryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bd.sqlExec, bd.mutation, bd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bd *BeneficiaryDelete) ExecX(ctx context.Context) int {
+	n, err := bd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bd *BeneficiaryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(beneficiary.Table, sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID))
+	if ps := bd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bd.mutation.done = true
+	return affected, err
+}
+
+// BeneficiaryDeleteOne is the builder for deleting a single Beneficiary entity.
+type BeneficiaryDeleteOne struct {
+	bd *BeneficiaryDelete
+}
+
+// Where appends a list predicates to the BeneficiaryDelete builder.
+func (bdo *BeneficiaryDeleteOne) Where(ps ...predicate.Beneficiary) *BeneficiaryDeleteOne {
+	bdo.bd.mutation.Where(ps...)
+	return bdo
+}
+
+// Exec executes the deletion query.
+func (bdo *BeneficiaryDeleteOne) Exec(ctx context.Context) error {
+	n, err := bdo.bd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{beneficiary.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdo *BeneficiaryDeleteOne) ExecX(ctx context.Context) {
+	if err := bdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// BeneficiaryUpdate is the builder for updating Beneficiary entities.
+type BeneficiaryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BeneficiaryMutation
+}
+
+// Where appends a list predicates to the BeneficiaryUpdate builder.
+func (bu *BeneficiaryUpdate) Where(ps ...predicate.Beneficiary) *BeneficiaryUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BeneficiaryUpdate) SetUpdatedAt(t time.Time) *BeneficiaryUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// SetInstitution sets the "institution" field.
+func (bu *BeneficiaryUpdate) SetInstitution(s string) *BeneficiaryUpdate {
+	bu.mutation.SetInstitution(s)
+	return bu
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableInstitution(s *string) *BeneficiaryUpdate {
+	if s != nil {
+		bu.SetInstitution(*s)
+	}
+	return bu
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (bu *BeneficiaryUpdate) SetAccountIdentifier(s string) *BeneficiaryUpdate {
+	bu.mutation.SetAccountIdentifier(s)
+	return bu
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableAccountIdentifier(s *string) *BeneficiaryUpdate {
+	if s != nil {
+		bu.SetAccountIdentifier(*s)
+	}
+	return bu
+}
+
+// SetAccountName sets the "account_name" field.
+func (bu *BeneficiaryUpdate) SetAccountName(s string) *BeneficiaryUpdate {
+	bu.mutation.SetAccountName(s)
+	return bu
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableAccountName(s *string) *BeneficiaryUpdate {
+	if s != nil {
+		bu.SetAccountName(*s)
+	}
+	return bu
+}
+
+// SetMemo sets the "memo" field.
+func (bu *BeneficiaryUpdate) SetMemo(s string) *BeneficiaryUpdate {
+	bu.mutation.SetMemo(s)
+	return bu
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableMemo(s *string) *BeneficiaryUpdate {
+	if s != nil {
+		bu.SetMemo(*s)
+	}
+	return bu
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (bu *BeneficiaryUpdate) ClearMemo() *BeneficiaryUpdate {
+	bu.mutation.ClearMemo()
+	return bu
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (bu *BeneficiaryUpdate) SetVerifiedAccountName(s string) *BeneficiaryUpdate {
+	bu.mutation.SetVerifiedAccountName(s)
+	return bu
+}
+
+// SetNillableVerifiedAccountName sets the "verified_account_name" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableVerifiedAccountName(s *string) *BeneficiaryUpdate {
+	if s != nil {
+		bu.SetVerifiedAccountName(*s)
+	}
+	return bu
+}
+
+// ClearVerifiedAccountName clears the value of the "verified_account_name" field.
+func (bu *BeneficiaryUpdate) ClearVerifiedAccountName() *BeneficiaryUpdate {
+	bu.mutation.ClearVerifiedAccountName()
+	return bu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (bu *BeneficiaryUpdate) SetVerifiedAt(t time.Time) *BeneficiaryUpdate {
+	bu.mutation.SetVerifiedAt(t)
+	return bu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (bu *BeneficiaryUpdate) SetNillableVerifiedAt(t *time.Time) *BeneficiaryUpdate {
+	if t != nil {
+		bu.SetVerifiedAt(*t)
+	}
+	return bu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (bu *BeneficiaryUpdate) ClearVerifiedAt() *BeneficiaryUpdate {
+	bu.mutation.ClearVerifiedAt()
+	return bu
+}
+
+// Mutation returns the BeneficiaryMutation object of the builder.
+func (bu *BeneficiaryUpdate) Mutation() *BeneficiaryMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BeneficiaryUpdate) Save(ctx context.Context) (int, error) {
+	bu.defaults()
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BeneficiaryUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BeneficiaryUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BeneficiaryUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BeneficiaryUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := beneficiary.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BeneficiaryUpdate) check() error {
+	if bu.mutation.SenderProfileCleared() && len(bu.mutation.SenderProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Beneficiary.sender_profile"`)
+	}
+	return nil
+}
+
+func (bu *BeneficiaryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(beneficiary.Table, beneficiary.Columns, sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.SetField(beneficiary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.Institution(); ok {
+		_spec.SetField(beneficiary.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.AccountIdentifier(); ok {
+		_spec.SetField(beneficiary.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.AccountName(); ok {
+		_spec.SetField(beneficiary.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Memo(); ok {
+		_spec.SetField(beneficiary.FieldMemo, field.TypeString, value)
+	}
+	if bu.mutation.MemoCleared() {
+		_spec.ClearField(beneficiary.FieldMemo, field.TypeString)
+	}
+	if value, ok := bu.mutation.VerifiedAccountName(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAccountName, field.TypeString, value)
+	}
+	if bu.mutation.VerifiedAccountNameCleared() {
+		_spec.ClearField(beneficiary.FieldVerifiedAccountName, field.TypeString)
+	}
+	if value, ok := bu.mutation.VerifiedAt(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if bu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(beneficiary.FieldVerifiedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{beneficiary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BeneficiaryUpdateOne is the builder for updating a single Beneficiary entity.
+type BeneficiaryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BeneficiaryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BeneficiaryUpdateOne) SetUpdatedAt(t time.Time) *BeneficiaryUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetInstitution sets the "institution" field.
+func (buo *BeneficiaryUpdateOne) SetInstitution(s string) *BeneficiaryUpdateOne {
+	buo.mutation.SetInstitution(s)
+	return buo
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableInstitution(s *string) *BeneficiaryUpdateOne {
+	if s != nil {
+		buo.SetInstitution(*s)
+	}
+	return buo
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (buo *BeneficiaryUpdateOne) SetAccountIdentifier(s string) *BeneficiaryUpdateOne {
+	buo.mutation.SetAccountIdentifier(s)
+	return buo
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableAccountIdentifier(s *string) *BeneficiaryUpdateOne {
+	if s != nil {
+		buo.SetAccountIdentifier(*s)
+	}
+	return buo
+}
+
+// SetAccountName sets the "account_name" field.
+func (buo *BeneficiaryUpdateOne) SetAccountName(s string) *BeneficiaryUpdateOne {
+	buo.mutation.SetAccountName(s)
+	return buo
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableAccountName(s *string) *BeneficiaryUpdateOne {
+	if s != nil {
+		buo.SetAccountName(*s)
+	}
+	return buo
+}
+
+// SetMemo sets the "memo" field.
+func (buo *BeneficiaryUpdateOne) SetMemo(s string) *BeneficiaryUpdateOne {
+	buo.mutation.SetMemo(s)
+	return buo
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableMemo(s *string) *BeneficiaryUpdateOne {
+	if s != nil {
+		buo.SetMemo(*s)
+	}
+	return buo
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (buo *BeneficiaryUpdateOne) ClearMemo() *BeneficiaryUpdateOne {
+	buo.mutation.ClearMemo()
+	return buo
+}
+
+// SetVerifiedAccountName sets the "verified_account_name" field.
+func (buo *BeneficiaryUpdateOne) SetVerifiedAccountName(s string) *BeneficiaryUpdateOne {
+	buo.mutation.SetVerifiedAccountName(s)
+	return buo
+}
+
+// SetNillableVerifiedAccountName sets the "verified_account_name" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableVerifiedAccountName(s *string) *BeneficiaryUpdateOne {
+	if s != nil {
+		buo.SetVerifiedAccountName(*s)
+	}
+	return buo
+}
+
+// ClearVerifiedAccountName clears the value of the "verified_account_name" field.
+func (buo *BeneficiaryUpdateOne) ClearVerifiedAccountName() *BeneficiaryUpdateOne {
+	buo.mutation.ClearVerifiedAccountName()
+	return buo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (buo *BeneficiaryUpdateOne) SetVerifiedAt(t time.Time) *BeneficiaryUpdateOne {
+	buo.mutation.SetVerifiedAt(t)
+	return buo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (buo *BeneficiaryUpdateOne) SetNillableVerifiedAt(t *time.Time) *BeneficiaryUpdateOne {
+	if t != nil {
+		buo.SetVerifiedAt(*t)
+	}
+	return buo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (buo *BeneficiaryUpdateOne) ClearVerifiedAt() *BeneficiaryUpdateOne {
+	buo.mutation.ClearVerifiedAt()
+	return buo
+}
+
+// Mutation returns the BeneficiaryMutation object of the builder.
+func (buo *BeneficiaryUpdateOne) Mutation() *BeneficiaryMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BeneficiaryUpdate builder.
+func (buo *BeneficiaryUpdateOne) Where(ps ...predicate.Beneficiary) *BeneficiaryUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BeneficiaryUpdateOne) Select(field string, fields ...string) *BeneficiaryUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Beneficiary entity.
+func (buo *BeneficiaryUpdateOne) Save(ctx context.Context) (*Beneficiary, error) {
+	buo.defaults()
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BeneficiaryUpdateOne) SaveX(ctx context.Context) *Beneficiary {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BeneficiaryUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BeneficiaryUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BeneficiaryUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := beneficiary.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BeneficiaryUpdateOne) check() error {
+	if buo.mutation.SenderProfileCleared() && len(buo.mutation.SenderProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Beneficiary.sender_profile"`)
+	}
+	return nil
+}
+
+func (buo *BeneficiaryUpdateOne) sqlSave(ctx context.Context) (_node *Beneficiary, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(beneficiary.Table, beneficiary.Columns, sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Beneficiary.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, beneficiary.FieldID)
+		for _, f := range fields {
+			if !beneficiary.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != beneficiary.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.SetField(beneficiary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.Institution(); ok {
+		_spec.SetField(beneficiary.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.AccountIdentifier(); ok {
+		_spec.SetField(beneficiary.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.AccountName(); ok {
+		_spec.SetField(beneficiary.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Memo(); ok {
+		_spec.SetField(beneficiary.FieldMemo, field.TypeString, value)
+	}
+	if buo.mutation.MemoCleared() {
+		_spec.ClearField(beneficiary.FieldMemo, field.TypeString)
+	}
+	if value, ok := buo.mutation.VerifiedAccountName(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAccountName, field.TypeString, value)
+	}
+	if buo.mutation.VerifiedAccountNameCleared() {
+		_spec.ClearField(beneficiary.FieldVerifiedAccountName, field.TypeString)
+	}
+	if value, ok := buo.mutation.VerifiedAt(); ok {
+		_spec.SetField(beneficiary.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if buo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(beneficiary.FieldVerifiedAt, field.TypeTime)
+	}
+	_node = &Beneficiary{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{beneficiary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
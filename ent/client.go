@@ -17,6 +17,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -25,14 +27,22 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
@@ -47,6 +57,10 @@ type Client struct {
 	Schema *migrate.Schema
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// Beneficiary is the client for interacting with the Beneficiary builders.
+	Beneficiary *BeneficiaryClient
+	// Dispute is the client for interacting with the Dispute builders.
+	Dispute *DisputeClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -63,22 +77,38 @@ type Client struct {
 	Network *NetworkClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
+	// PaymentOrderBatch is the client for interacting with the PaymentOrderBatch builders.
+	PaymentOrderBatch *PaymentOrderBatchClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
+	// PaymentOrderSplit is the client for interacting with the PaymentOrderSplit builders.
+	PaymentOrderSplit *PaymentOrderSplitClient
+	// ProviderFiatBalance is the client for interacting with the ProviderFiatBalance builders.
+	ProviderFiatBalance *ProviderFiatBalanceClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
+	// ProviderRateExclusion is the client for interacting with the ProviderRateExclusion builders.
+	ProviderRateExclusion *ProviderRateExclusionClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
+	// ScheduledOrder is the client for interacting with the ScheduledOrder builders.
+	ScheduledOrder *ScheduledOrderClient
+	// ScheduledOrderRun is the client for interacting with the ScheduledOrderRun builders.
+	ScheduledOrderRun *ScheduledOrderRunClient
+	// SenderFeeTier is the client for interacting with the SenderFeeTier builders.
+	SenderFeeTier *SenderFeeTierClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
@@ -101,6 +131,8 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIKey = NewAPIKeyClient(c.config)
+	c.Beneficiary = NewBeneficiaryClient(c.config)
+	c.Dispute = NewDisputeClient(c.config)
 	c.FiatCurrency = NewFiatCurrencyClient(c.config)
 	c.IdentityVerificationRequest = NewIdentityVerificationRequestClient(c.config)
 	c.Institution = NewInstitutionClient(c.config)
@@ -109,14 +141,22 @@ func (c *Client) init() {
 	c.LockPaymentOrder = NewLockPaymentOrderClient(c.config)
 	c.Network = NewNetworkClient(c.config)
 	c.PaymentOrder = NewPaymentOrderClient(c.config)
+	c.PaymentOrderBatch = NewPaymentOrderBatchClient(c.config)
 	c.PaymentOrderRecipient = NewPaymentOrderRecipientClient(c.config)
+	c.PaymentOrderSplit = NewPaymentOrderSplitClient(c.config)
+	c.ProviderFiatBalance = NewProviderFiatBalanceClient(c.config)
 	c.ProviderOrderToken = NewProviderOrderTokenClient(c.config)
 	c.ProviderProfile = NewProviderProfileClient(c.config)
+	c.ProviderRateExclusion = NewProviderRateExclusionClient(c.config)
 	c.ProviderRating = NewProviderRatingClient(c.config)
 	c.ProvisionBucket = NewProvisionBucketClient(c.config)
 	c.ReceiveAddress = NewReceiveAddressClient(c.config)
+	c.ScheduledOrder = NewScheduledOrderClient(c.config)
+	c.ScheduledOrderRun = NewScheduledOrderRunClient(c.config)
+	c.SenderFeeTier = NewSenderFeeTierClient(c.config)
 	c.SenderOrderToken = NewSenderOrderTokenClient(c.config)
 	c.SenderProfile = NewSenderProfileClient(c.config)
+	c.TeamMember = NewTeamMemberClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.TransactionLog = NewTransactionLogClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -215,6 +255,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		Beneficiary:                 NewBeneficiaryClient(cfg),
+		Dispute:                     NewDisputeClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
@@ -223,14 +265,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
+		PaymentOrderBatch:           NewPaymentOrderBatchClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
+		PaymentOrderSplit:           NewPaymentOrderSplitClient(cfg),
+		ProviderFiatBalance:         NewProviderFiatBalanceClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
+		ProviderRateExclusion:       NewProviderRateExclusionClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
+		ScheduledOrder:              NewScheduledOrderClient(cfg),
+		ScheduledOrderRun:           NewScheduledOrderRunClient(cfg),
+		SenderFeeTier:               NewSenderFeeTierClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		TeamMember:                  NewTeamMemberClient(cfg),
 		Token:                       NewTokenClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
 		User:                        NewUserClient(cfg),
@@ -256,6 +306,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		Beneficiary:                 NewBeneficiaryClient(cfg),
+		Dispute:                     NewDisputeClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
@@ -264,14 +316,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
+		PaymentOrderBatch:           NewPaymentOrderBatchClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
+		PaymentOrderSplit:           NewPaymentOrderSplitClient(cfg),
+		ProviderFiatBalance:         NewProviderFiatBalanceClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
+		ProviderRateExclusion:       NewProviderRateExclusionClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
+		ScheduledOrder:              NewScheduledOrderClient(cfg),
+		ScheduledOrderRun:           NewScheduledOrderRunClient(cfg),
+		SenderFeeTier:               NewSenderFeeTierClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		TeamMember:                  NewTeamMemberClient(cfg),
 		Token:                       NewTokenClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
 		User:                        NewUserClient(cfg),
@@ -306,11 +366,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIKey, c.FiatCurrency, c.IdentityVerificationRequest, c.Institution,
-		c.LinkedAddress, c.LockOrderFulfillment, c.LockPaymentOrder, c.Network,
-		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
+		c.APIKey, c.Beneficiary, c.Dispute, c.FiatCurrency,
+		c.IdentityVerificationRequest, c.Institution, c.LinkedAddress,
+		c.LockOrderFulfillment, c.LockPaymentOrder, c.Network, c.PaymentOrder,
+		c.PaymentOrderBatch, c.PaymentOrderRecipient, c.PaymentOrderSplit,
+		c.ProviderFiatBalance, c.ProviderOrderToken, c.ProviderProfile,
+		c.ProviderRateExclusion, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
+		c.ScheduledOrder, c.ScheduledOrderRun, c.SenderFeeTier, c.SenderOrderToken,
+		c.SenderProfile, c.TeamMember, c.Token, c.TransactionLog, c.User,
 		c.VerificationToken, c.WebhookRetryAttempt,
 	} {
 		n.Use(hooks...)
@@ -321,11 +384,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIKey, c.FiatCurrency, c.IdentityVerificationRequest, c.Institution,
-		c.LinkedAddress, c.LockOrderFulfillment, c.LockPaymentOrder, c.Network,
-		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
+		c.APIKey, c.Beneficiary, c.Dispute, c.FiatCurrency,
+		c.IdentityVerificationRequest, c.Institution, c.LinkedAddress,
+		c.LockOrderFulfillment, c.LockPaymentOrder, c.Network, c.PaymentOrder,
+		c.PaymentOrderBatch, c.PaymentOrderRecipient, c.PaymentOrderSplit,
+		c.ProviderFiatBalance, c.ProviderOrderToken, c.ProviderProfile,
+		c.ProviderRateExclusion, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
+		c.ScheduledOrder, c.ScheduledOrderRun, c.SenderFeeTier, c.SenderOrderToken,
+		c.SenderProfile, c.TeamMember, c.Token, c.TransactionLog, c.User,
 		c.VerificationToken, c.WebhookRetryAttempt,
 	} {
 		n.Intercept(interceptors...)
@@ -337,6 +403,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *APIKeyMutation:
 		return c.APIKey.mutate(ctx, m)
+	case *BeneficiaryMutation:
+		return c.Beneficiary.mutate(ctx, m)
+	case *DisputeMutation:
+		return c.Dispute.mutate(ctx, m)
 	case *FiatCurrencyMutation:
 		return c.FiatCurrency.mutate(ctx, m)
 	case *IdentityVerificationRequestMutation:
@@ -353,22 +423,38 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Network.mutate(ctx, m)
 	case *PaymentOrderMutation:
 		return c.PaymentOrder.mutate(ctx, m)
+	case *PaymentOrderBatchMutation:
+		return c.PaymentOrderBatch.mutate(ctx, m)
 	case *PaymentOrderRecipientMutation:
 		return c.PaymentOrderRecipient.mutate(ctx, m)
+	case *PaymentOrderSplitMutation:
+		return c.PaymentOrderSplit.mutate(ctx, m)
+	case *ProviderFiatBalanceMutation:
+		return c.ProviderFiatBalance.mutate(ctx, m)
 	case *ProviderOrderTokenMutation:
 		return c.ProviderOrderToken.mutate(ctx, m)
 	case *ProviderProfileMutation:
 		return c.ProviderProfile.mutate(ctx, m)
+	case *ProviderRateExclusionMutation:
+		return c.ProviderRateExclusion.mutate(ctx, m)
 	case *ProviderRatingMutation:
 		return c.ProviderRating.mutate(ctx, m)
 	case *ProvisionBucketMutation:
 		return c.ProvisionBucket.mutate(ctx, m)
 	case *ReceiveAddressMutation:
 		return c.ReceiveAddress.mutate(ctx, m)
+	case *ScheduledOrderMutation:
+		return c.ScheduledOrder.mutate(ctx, m)
+	case *ScheduledOrderRunMutation:
+		return c.ScheduledOrderRun.mutate(ctx, m)
+	case *SenderFeeTierMutation:
+		return c.SenderFeeTier.mutate(ctx, m)
 	case *SenderOrderTokenMutation:
 		return c.SenderOrderToken.mutate(ctx, m)
 	case *SenderProfileMutation:
 		return c.SenderProfile.mutate(ctx, m)
+	case *TeamMemberMutation:
+		return c.TeamMember.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
 	case *TransactionLogMutation:
@@ -500,7 +586,7 @@ func (c *APIKeyClient) QuerySenderProfile(ak *APIKey) *SenderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, id),
 			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
 		return fromV, nil
@@ -516,7 +602,7 @@ func (c *APIKeyClient) QueryProviderProfile(ak *APIKey) *ProviderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, id),
 			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
 		return fromV, nil
@@ -565,6 +651,320 @@ func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, er
 	}
 }
 
+// BeneficiaryClient is a client for the Beneficiary schema.
+type BeneficiaryClient struct {
+	config
+}
+
+// NewBeneficiaryClient returns a client for the Beneficiary from the given config.
+func NewBeneficiaryClient(c config) *BeneficiaryClient {
+	return &BeneficiaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `beneficiary.Hooks(f(g(h())))`.
+func (c *BeneficiaryClient) Use(hooks ...Hook) {
+	c.hooks.Beneficiary = append(c.hooks.Beneficiary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `beneficiary.Intercept(f(g(h())))`.
+func (c *BeneficiaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Beneficiary = append(c.inters.Beneficiary, interceptors...)
+}
+
+// Create returns a builder for creating a Beneficiary entity.
+func (c *BeneficiaryClient) Create() *BeneficiaryCreate {
+	mutation := newBeneficiaryMutation(c.config, OpCreate)
+	return &BeneficiaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Beneficiary entities.
+func (c *BeneficiaryClient) CreateBulk(builders ...*BeneficiaryCreate) *BeneficiaryCreateBulk {
+	return &BeneficiaryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BeneficiaryClient) MapCreateBulk(slice any, setFunc func(*BeneficiaryCreate, int)) *BeneficiaryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BeneficiaryCreateBulk{err: fmt.Errorf("calling to BeneficiaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BeneficiaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BeneficiaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Beneficiary.
+func (c *BeneficiaryClient) Update() *BeneficiaryUpdate {
+	mutation := newBeneficiaryMutation(c.config, OpUpdate)
+	return &BeneficiaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BeneficiaryClient) UpdateOne(b *Beneficiary) *BeneficiaryUpdateOne {
+	mutation := newBeneficiaryMutation(c.config, OpUpdateOne, withBeneficiary(b))
+	return &BeneficiaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BeneficiaryClient) UpdateOneID(id uuid.UUID) *BeneficiaryUpdateOne {
+	mutation := newBeneficiaryMutation(c.config, OpUpdateOne, withBeneficiaryID(id))
+	return &BeneficiaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Beneficiary.
+func (c *BeneficiaryClient) Delete() *BeneficiaryDelete {
+	mutation := newBeneficiaryMutation(c.config, OpDelete)
+	return &BeneficiaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BeneficiaryClient) DeleteOne(b *Beneficiary) *BeneficiaryDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BeneficiaryClient) DeleteOneID(id uuid.UUID) *BeneficiaryDeleteOne {
+	builder := c.Delete().Where(beneficiary.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BeneficiaryDeleteOne{builder}
+}
+
+// Query returns a query builder for Beneficiary.
+func (c *BeneficiaryClient) Query() *BeneficiaryQuery {
+	return &BeneficiaryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBeneficiary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Beneficiary entity by its id.
+func (c *BeneficiaryClient) Get(ctx context.Context, id uuid.UUID) (*Beneficiary, error) {
+	return c.Query().Where(beneficiary.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BeneficiaryClient) GetX(ctx context.Context, id uuid.UUID) *Beneficiary {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a Beneficiary.
+func (c *BeneficiaryClient) QuerySenderProfile(b *Beneficiary) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(beneficiary.Table, beneficiary.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, beneficiary.SenderProfileTable, beneficiary.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BeneficiaryClient) Hooks() []Hook {
+	return c.hooks.Beneficiary
+}
+
+// Interceptors returns the client interceptors.
+func (c *BeneficiaryClient) Interceptors() []Interceptor {
+	return c.inters.Beneficiary
+}
+
+func (c *BeneficiaryClient) mutate(ctx context.Context, m *BeneficiaryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BeneficiaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BeneficiaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BeneficiaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BeneficiaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Beneficiary mutation op: %q", m.Op())
+	}
+}
+
+// DisputeClient is a client for the Dispute schema.
+type DisputeClient struct {
+	config
+}
+
+// NewDisputeClient returns a client for the Dispute from the given config.
+func NewDisputeClient(c config) *DisputeClient {
+	return &DisputeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dispute.Hooks(f(g(h())))`.
+func (c *DisputeClient) Use(hooks ...Hook) {
+	c.hooks.Dispute = append(c.hooks.Dispute, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dispute.Intercept(f(g(h())))`.
+func (c *DisputeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Dispute = append(c.inters.Dispute, interceptors...)
+}
+
+// Create returns a builder for creating a Dispute entity.
+func (c *DisputeClient) Create() *DisputeCreate {
+	mutation := newDisputeMutation(c.config, OpCreate)
+	return &DisputeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Dispute entities.
+func (c *DisputeClient) CreateBulk(builders ...*DisputeCreate) *DisputeCreateBulk {
+	return &DisputeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DisputeClient) MapCreateBulk(slice any, setFunc func(*DisputeCreate, int)) *DisputeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DisputeCreateBulk{err: fmt.Errorf("calling to DisputeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DisputeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DisputeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Dispute.
+func (c *DisputeClient) Update() *DisputeUpdate {
+	mutation := newDisputeMutation(c.config, OpUpdate)
+	return &DisputeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DisputeClient) UpdateOne(d *Dispute) *DisputeUpdateOne {
+	mutation := newDisputeMutation(c.config, OpUpdateOne, withDispute(d))
+	return &DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DisputeClient) UpdateOneID(id uuid.UUID) *DisputeUpdateOne {
+	mutation := newDisputeMutation(c.config, OpUpdateOne, withDisputeID(id))
+	return &DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Dispute.
+func (c *DisputeClient) Delete() *DisputeDelete {
+	mutation := newDisputeMutation(c.config, OpDelete)
+	return &DisputeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DisputeClient) DeleteOne(d *Dispute) *DisputeDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DisputeClient) DeleteOneID(id uuid.UUID) *DisputeDeleteOne {
+	builder := c.Delete().Where(dispute.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DisputeDeleteOne{builder}
+}
+
+// Query returns a query builder for Dispute.
+func (c *DisputeClient) Query() *DisputeQuery {
+	return &DisputeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDispute},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Dispute entity by its id.
+func (c *DisputeClient) Get(ctx context.Context, id uuid.UUID) (*Dispute, error) {
+	return c.Query().Where(dispute.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DisputeClient) GetX(ctx context.Context, id uuid.UUID) *Dispute {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLockPaymentOrder queries the lock_payment_order edge of a Dispute.
+func (c *DisputeClient) QueryLockPaymentOrder(d *Dispute) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dispute.LockPaymentOrderTable, dispute.LockPaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder queries the payment_order edge of a Dispute.
+func (c *DisputeClient) QueryPaymentOrder(d *Dispute) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dispute.PaymentOrderTable, dispute.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DisputeClient) Hooks() []Hook {
+	return c.hooks.Dispute
+}
+
+// Interceptors returns the client interceptors.
+func (c *DisputeClient) Interceptors() []Interceptor {
+	return c.inters.Dispute
+}
+
+func (c *DisputeClient) mutate(ctx context.Context, m *DisputeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DisputeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DisputeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DisputeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Dispute mutation op: %q", m.Op())
+	}
+}
+
 // FiatCurrencyClient is a client for the FiatCurrency schema.
 type FiatCurrencyClient struct {
 	config
@@ -721,6 +1121,22 @@ func (c *FiatCurrencyClient) QueryInstitutions(fc *FiatCurrency) *InstitutionQue
 	return query
 }
 
+// QueryProviderBalances queries the provider_balances edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProviderBalances(fc *FiatCurrency) *ProviderFiatBalanceQuery {
+	query := (&ProviderFiatBalanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(providerfiatbalance.Table, providerfiatbalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderBalancesTable, fiatcurrency.ProviderBalancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FiatCurrencyClient) Hooks() []Hook {
 	return c.hooks.FiatCurrency
@@ -1514,6 +1930,22 @@ func (c *LockPaymentOrderClient) QueryTransactions(lpo *LockPaymentOrder) *Trans
 	return query
 }
 
+// QueryDisputes queries the disputes edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryDisputes(lpo *LockPaymentOrder) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.DisputesTable, lockpaymentorder.DisputesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *LockPaymentOrderClient) Hooks() []Hook {
 	return c.hooks.LockPaymentOrder
@@ -1844,6 +2276,22 @@ func (c *PaymentOrderClient) QueryLinkedAddress(po *PaymentOrder) *LinkedAddress
 	return query
 }
 
+// QueryBatch queries the batch edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryBatch(po *PaymentOrder) *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.BatchTable, paymentorder.BatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryReceiveAddress queries the receive_address edge of a PaymentOrder.
 func (c *PaymentOrderClient) QueryReceiveAddress(po *PaymentOrder) *ReceiveAddressQuery {
 	query := (&ReceiveAddressClient{config: c.config}).Query()
@@ -1876,6 +2324,22 @@ func (c *PaymentOrderClient) QueryRecipient(po *PaymentOrder) *PaymentOrderRecip
 	return query
 }
 
+// QuerySplits queries the splits edge of a PaymentOrder.
+func (c *PaymentOrderClient) QuerySplits(po *PaymentOrder) *PaymentOrderSplitQuery {
+	query := (&PaymentOrderSplitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentordersplit.Table, paymentordersplit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.SplitsTable, paymentorder.SplitsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTransactions queries the transactions edge of a PaymentOrder.
 func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLogQuery {
 	query := (&TransactionLogClient{config: c.config}).Query()
@@ -1892,6 +2356,22 @@ func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLog
 	return query
 }
 
+// QueryDisputes queries the disputes edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryDisputes(po *PaymentOrder) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.DisputesTable, paymentorder.DisputesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PaymentOrderClient) Hooks() []Hook {
 	return c.hooks.PaymentOrder
@@ -1917,55 +2397,252 @@ func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation
 	}
 }
 
-// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
-type PaymentOrderRecipientClient struct {
+// PaymentOrderBatchClient is a client for the PaymentOrderBatch schema.
+type PaymentOrderBatchClient struct {
 	config
 }
 
-// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
-func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
-	return &PaymentOrderRecipientClient{config: c}
+// NewPaymentOrderBatchClient returns a client for the PaymentOrderBatch from the given config.
+func NewPaymentOrderBatchClient(c config) *PaymentOrderBatchClient {
+	return &PaymentOrderBatchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+// A call to `Use(f, g, h)` equals to `paymentorderbatch.Hooks(f(g(h())))`.
+func (c *PaymentOrderBatchClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrderBatch = append(c.hooks.PaymentOrderBatch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `paymentorderbatch.Intercept(f(g(h())))`.
+func (c *PaymentOrderBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrderBatch = append(c.inters.PaymentOrderBatch, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrderRecipient entity.
-func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
-	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PaymentOrderBatch entity.
+func (c *PaymentOrderBatchClient) Create() *PaymentOrderBatchCreate {
+	mutation := newPaymentOrderBatchMutation(c.config, OpCreate)
+	return &PaymentOrderBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
-func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PaymentOrderBatch entities.
+func (c *PaymentOrderBatchClient) CreateBulk(builders ...*PaymentOrderBatchCreate) *PaymentOrderBatchCreateBulk {
+	return &PaymentOrderBatchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+func (c *PaymentOrderBatchClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderBatchCreate, int)) *PaymentOrderBatchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentOrderBatchCreateBulk{err: fmt.Errorf("calling to PaymentOrderBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	builders := make([]*PaymentOrderBatchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+	return &PaymentOrderBatchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrderRecipient.
+// Update returns an update builder for PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) Update() *PaymentOrderBatchUpdate {
+	mutation := newPaymentOrderBatchMutation(c.config, OpUpdate)
+	return &PaymentOrderBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentOrderBatchClient) UpdateOne(pob *PaymentOrderBatch) *PaymentOrderBatchUpdateOne {
+	mutation := newPaymentOrderBatchMutation(c.config, OpUpdateOne, withPaymentOrderBatch(pob))
+	return &PaymentOrderBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentOrderBatchClient) UpdateOneID(id uuid.UUID) *PaymentOrderBatchUpdateOne {
+	mutation := newPaymentOrderBatchMutation(c.config, OpUpdateOne, withPaymentOrderBatchID(id))
+	return &PaymentOrderBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) Delete() *PaymentOrderBatchDelete {
+	mutation := newPaymentOrderBatchMutation(c.config, OpDelete)
+	return &PaymentOrderBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentOrderBatchClient) DeleteOne(pob *PaymentOrderBatch) *PaymentOrderBatchDeleteOne {
+	return c.DeleteOneID(pob.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentOrderBatchClient) DeleteOneID(id uuid.UUID) *PaymentOrderBatchDeleteOne {
+	builder := c.Delete().Where(paymentorderbatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentOrderBatchDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) Query() *PaymentOrderBatchQuery {
+	return &PaymentOrderBatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentOrderBatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentOrderBatch entity by its id.
+func (c *PaymentOrderBatchClient) Get(ctx context.Context, id uuid.UUID) (*PaymentOrderBatch, error) {
+	return c.Query().Where(paymentorderbatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentOrderBatchClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrderBatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) QuerySenderProfile(pob *PaymentOrderBatch) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pob.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorderbatch.SenderProfileTable, paymentorderbatch.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pob.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) QueryToken(pob *PaymentOrderBatch) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pob.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorderbatch.TokenTable, paymentorderbatch.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(pob.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceiveAddress queries the receive_address edge of a PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) QueryReceiveAddress(pob *PaymentOrderBatch) *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pob.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, id),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorderbatch.ReceiveAddressTable, paymentorderbatch.ReceiveAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(pob.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentOrders queries the payment_orders edge of a PaymentOrderBatch.
+func (c *PaymentOrderBatchClient) QueryPaymentOrders(pob *PaymentOrderBatch) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pob.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorderbatch.PaymentOrdersTable, paymentorderbatch.PaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pob.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentOrderBatchClient) Hooks() []Hook {
+	return c.hooks.PaymentOrderBatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderBatchClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrderBatch
+}
+
+func (c *PaymentOrderBatchClient) mutate(ctx context.Context, m *PaymentOrderBatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentOrderBatch mutation op: %q", m.Op())
+	}
+}
+
+// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
+type PaymentOrderRecipientClient struct {
+	config
+}
+
+// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
+func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
+	return &PaymentOrderRecipientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentOrderRecipient entity.
+func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
+	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
+func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentOrderRecipient.
 func (c *PaymentOrderRecipientClient) Update() *PaymentOrderRecipientUpdate {
 	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdate)
 	return &PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
@@ -2066,107 +2743,107 @@ func (c *PaymentOrderRecipientClient) mutate(ctx context.Context, m *PaymentOrde
 	}
 }
 
-// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
-type ProviderOrderTokenClient struct {
+// PaymentOrderSplitClient is a client for the PaymentOrderSplit schema.
+type PaymentOrderSplitClient struct {
 	config
 }
 
-// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
-func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
-	return &ProviderOrderTokenClient{config: c}
+// NewPaymentOrderSplitClient returns a client for the PaymentOrderSplit from the given config.
+func NewPaymentOrderSplitClient(c config) *PaymentOrderSplitClient {
+	return &PaymentOrderSplitClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
-	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+// A call to `Use(f, g, h)` equals to `paymentordersplit.Hooks(f(g(h())))`.
+func (c *PaymentOrderSplitClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrderSplit = append(c.hooks.PaymentOrderSplit, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `paymentordersplit.Intercept(f(g(h())))`.
+func (c *PaymentOrderSplitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrderSplit = append(c.inters.PaymentOrderSplit, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderOrderToken entity.
-func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
-	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
-	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PaymentOrderSplit entity.
+func (c *PaymentOrderSplitClient) Create() *PaymentOrderSplitCreate {
+	mutation := newPaymentOrderSplitMutation(c.config, OpCreate)
+	return &PaymentOrderSplitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
-func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PaymentOrderSplit entities.
+func (c *PaymentOrderSplitClient) CreateBulk(builders ...*PaymentOrderSplitCreate) *PaymentOrderSplitCreateBulk {
+	return &PaymentOrderSplitCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+func (c *PaymentOrderSplitClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderSplitCreate, int)) *PaymentOrderSplitCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentOrderSplitCreateBulk{err: fmt.Errorf("calling to PaymentOrderSplitClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	builders := make([]*PaymentOrderSplitCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+	return &PaymentOrderSplitCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
-	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PaymentOrderSplit.
+func (c *PaymentOrderSplitClient) Update() *PaymentOrderSplitUpdate {
+	mutation := newPaymentOrderSplitMutation(c.config, OpUpdate)
+	return &PaymentOrderSplitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentOrderSplitClient) UpdateOne(pos *PaymentOrderSplit) *PaymentOrderSplitUpdateOne {
+	mutation := newPaymentOrderSplitMutation(c.config, OpUpdateOne, withPaymentOrderSplit(pos))
+	return &PaymentOrderSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentOrderSplitClient) UpdateOneID(id int) *PaymentOrderSplitUpdateOne {
+	mutation := newPaymentOrderSplitMutation(c.config, OpUpdateOne, withPaymentOrderSplitID(id))
+	return &PaymentOrderSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
-	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
-	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PaymentOrderSplit.
+func (c *PaymentOrderSplitClient) Delete() *PaymentOrderSplitDelete {
+	mutation := newPaymentOrderSplitMutation(c.config, OpDelete)
+	return &PaymentOrderSplitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
-	return c.DeleteOneID(pot.ID)
+func (c *PaymentOrderSplitClient) DeleteOne(pos *PaymentOrderSplit) *PaymentOrderSplitDeleteOne {
+	return c.DeleteOneID(pos.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
-	builder := c.Delete().Where(providerordertoken.ID(id))
+func (c *PaymentOrderSplitClient) DeleteOneID(id int) *PaymentOrderSplitDeleteOne {
+	builder := c.Delete().Where(paymentordersplit.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderOrderTokenDeleteOne{builder}
+	return &PaymentOrderSplitDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
-	return &ProviderOrderTokenQuery{
+// Query returns a query builder for PaymentOrderSplit.
+func (c *PaymentOrderSplitClient) Query() *PaymentOrderSplitQuery {
+	return &PaymentOrderSplitQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		ctx:    &QueryContext{Type: TypePaymentOrderSplit},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderOrderToken entity by its id.
-func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
-	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+// Get returns a PaymentOrderSplit entity by its id.
+func (c *PaymentOrderSplitClient) Get(ctx context.Context, id int) (*PaymentOrderSplit, error) {
+	return c.Query().Where(paymentordersplit.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+func (c *PaymentOrderSplitClient) GetX(ctx context.Context, id int) *PaymentOrderSplit {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2174,148 +2851,148 @@ func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOr
 	return obj
 }
 
-// QueryProvider queries the provider edge of a ProviderOrderToken.
-func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a PaymentOrderSplit.
+func (c *PaymentOrderSplitClient) QueryPaymentOrder(pos *PaymentOrderSplit) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pot.ID
+		id := pos.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
+			sqlgraph.From(paymentordersplit.Table, paymentordersplit.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentordersplit.PaymentOrderTable, paymentordersplit.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pos.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderOrderTokenClient) Hooks() []Hook {
-	return c.hooks.ProviderOrderToken
+func (c *PaymentOrderSplitClient) Hooks() []Hook {
+	return c.hooks.PaymentOrderSplit
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
-	return c.inters.ProviderOrderToken
+func (c *PaymentOrderSplitClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrderSplit
 }
 
-func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+func (c *PaymentOrderSplitClient) mutate(ctx context.Context, m *PaymentOrderSplitMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentOrderSplitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentOrderSplitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentOrderSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PaymentOrderSplitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PaymentOrderSplit mutation op: %q", m.Op())
 	}
 }
 
-// ProviderProfileClient is a client for the ProviderProfile schema.
-type ProviderProfileClient struct {
+// ProviderFiatBalanceClient is a client for the ProviderFiatBalance schema.
+type ProviderFiatBalanceClient struct {
 	config
 }
 
-// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
-func NewProviderProfileClient(c config) *ProviderProfileClient {
-	return &ProviderProfileClient{config: c}
+// NewProviderFiatBalanceClient returns a client for the ProviderFiatBalance from the given config.
+func NewProviderFiatBalanceClient(c config) *ProviderFiatBalanceClient {
+	return &ProviderFiatBalanceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
-func (c *ProviderProfileClient) Use(hooks ...Hook) {
-	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `providerfiatbalance.Hooks(f(g(h())))`.
+func (c *ProviderFiatBalanceClient) Use(hooks ...Hook) {
+	c.hooks.ProviderFiatBalance = append(c.hooks.ProviderFiatBalance, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
-func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `providerfiatbalance.Intercept(f(g(h())))`.
+func (c *ProviderFiatBalanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderFiatBalance = append(c.inters.ProviderFiatBalance, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderProfile entity.
-func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
-	mutation := newProviderProfileMutation(c.config, OpCreate)
-	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProviderFiatBalance entity.
+func (c *ProviderFiatBalanceClient) Create() *ProviderFiatBalanceCreate {
+	mutation := newProviderFiatBalanceMutation(c.config, OpCreate)
+	return &ProviderFiatBalanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
-func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProviderFiatBalance entities.
+func (c *ProviderFiatBalanceClient) CreateBulk(builders ...*ProviderFiatBalanceCreate) *ProviderFiatBalanceCreateBulk {
+	return &ProviderFiatBalanceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+func (c *ProviderFiatBalanceClient) MapCreateBulk(slice any, setFunc func(*ProviderFiatBalanceCreate, int)) *ProviderFiatBalanceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProviderFiatBalanceCreateBulk{err: fmt.Errorf("calling to ProviderFiatBalanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderProfileCreate, rv.Len())
+	builders := make([]*ProviderFiatBalanceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+	return &ProviderFiatBalanceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderProfile.
-func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
-	mutation := newProviderProfileMutation(c.config, OpUpdate)
-	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProviderFiatBalance.
+func (c *ProviderFiatBalanceClient) Update() *ProviderFiatBalanceUpdate {
+	mutation := newProviderFiatBalanceMutation(c.config, OpUpdate)
+	return &ProviderFiatBalanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProviderFiatBalanceClient) UpdateOne(pfb *ProviderFiatBalance) *ProviderFiatBalanceUpdateOne {
+	mutation := newProviderFiatBalanceMutation(c.config, OpUpdateOne, withProviderFiatBalance(pfb))
+	return &ProviderFiatBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProviderFiatBalanceClient) UpdateOneID(id uuid.UUID) *ProviderFiatBalanceUpdateOne {
+	mutation := newProviderFiatBalanceMutation(c.config, OpUpdateOne, withProviderFiatBalanceID(id))
+	return &ProviderFiatBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderProfile.
-func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
-	mutation := newProviderProfileMutation(c.config, OpDelete)
-	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProviderFiatBalance.
+func (c *ProviderFiatBalanceClient) Delete() *ProviderFiatBalanceDelete {
+	mutation := newProviderFiatBalanceMutation(c.config, OpDelete)
+	return &ProviderFiatBalanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
-	return c.DeleteOneID(pp.ID)
+func (c *ProviderFiatBalanceClient) DeleteOne(pfb *ProviderFiatBalance) *ProviderFiatBalanceDeleteOne {
+	return c.DeleteOneID(pfb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
-	builder := c.Delete().Where(providerprofile.ID(id))
+func (c *ProviderFiatBalanceClient) DeleteOneID(id uuid.UUID) *ProviderFiatBalanceDeleteOne {
+	builder := c.Delete().Where(providerfiatbalance.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderProfileDeleteOne{builder}
+	return &ProviderFiatBalanceDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderProfile.
-func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
-	return &ProviderProfileQuery{
+// Query returns a query builder for ProviderFiatBalance.
+func (c *ProviderFiatBalanceClient) Query() *ProviderFiatBalanceQuery {
+	return &ProviderFiatBalanceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderProfile},
+		ctx:    &QueryContext{Type: TypeProviderFiatBalance},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderProfile entity by its id.
-func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
-	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
+// Get returns a ProviderFiatBalance entity by its id.
+func (c *ProviderFiatBalanceClient) Get(ctx context.Context, id uuid.UUID) (*ProviderFiatBalance, error) {
+	return c.Query().Where(providerfiatbalance.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
+func (c *ProviderFiatBalanceClient) GetX(ctx context.Context, id uuid.UUID) *ProviderFiatBalance {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2323,244 +3000,1250 @@ func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderPr
 	return obj
 }
 
-// QueryUser queries the user edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryProvider queries the provider edge of a ProviderFiatBalance.
+func (c *ProviderFiatBalanceClient) QueryProvider(pfb *ProviderFiatBalance) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := pfb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
+			sqlgraph.From(providerfiatbalance.Table, providerfiatbalance.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerfiatbalance.ProviderTable, providerfiatbalance.ProviderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pfb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAPIKey queries the api_key edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAPIKey(pp *ProviderProfile) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
+// QueryCurrency queries the currency edge of a ProviderFiatBalance.
+func (c *ProviderFiatBalanceClient) QueryCurrency(pfb *ProviderFiatBalance) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := pfb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
+			sqlgraph.From(providerfiatbalance.Table, providerfiatbalance.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerfiatbalance.CurrencyTable, providerfiatbalance.CurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pfb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCurrency queries the currency edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryCurrency(pp *ProviderProfile) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *ProviderFiatBalanceClient) Hooks() []Hook {
+	return c.hooks.ProviderFiatBalance
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderFiatBalanceClient) Interceptors() []Interceptor {
+	return c.inters.ProviderFiatBalance
+}
+
+func (c *ProviderFiatBalanceClient) mutate(ctx context.Context, m *ProviderFiatBalanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderFiatBalanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderFiatBalanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderFiatBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderFiatBalanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderFiatBalance mutation op: %q", m.Op())
+	}
+}
+
+// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
+type ProviderOrderTokenClient struct {
+	config
+}
+
+// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
+func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
+	return &ProviderOrderTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
+	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderOrderToken entity.
+func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
+	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
+	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
+func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
+	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
+	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
+	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
+	return c.DeleteOneID(pot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
+	builder := c.Delete().Where(providerordertoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderOrderTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
+	return &ProviderOrderTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderOrderToken entity by its id.
+func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
+	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := pot.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerprofile.CurrencyTable, providerprofile.CurrencyColumn),
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
+// QueryRateExclusion queries the rate_exclusion edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryRateExclusion(pot *ProviderOrderToken) *ProviderRateExclusionQuery {
+	query := (&ProviderRateExclusionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := pot.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(providerrateexclusion.Table, providerrateexclusion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerordertoken.RateExclusionTable, providerordertoken.RateExclusionColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
-	query := (&ProviderOrderTokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ProviderOrderTokenClient) Hooks() []Hook {
+	return c.hooks.ProviderOrderToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
+	return c.inters.ProviderOrderToken
+}
+
+func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+	}
+}
+
+// ProviderProfileClient is a client for the ProviderProfile schema.
+type ProviderProfileClient struct {
+	config
+}
+
+// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
+func NewProviderProfileClient(c config) *ProviderProfileClient {
+	return &ProviderProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
+func (c *ProviderProfileClient) Use(hooks ...Hook) {
+	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
+func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderProfile entity.
+func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
+	mutation := newProviderProfileMutation(c.config, OpCreate)
+	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
+func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderProfile.
+func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
+	mutation := newProviderProfileMutation(c.config, OpUpdate)
+	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderProfile.
+func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
+	mutation := newProviderProfileMutation(c.config, OpDelete)
+	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
+	builder := c.Delete().Where(providerprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderProfile.
+func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
+	return &ProviderProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderProfile entity by its id.
+func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
+	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAPIKeys(pp *ProviderProfile) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.APIKeysTable, providerprofile.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrency queries the currency edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryCurrency(pp *ProviderProfile) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerprofile.CurrencyTable, providerprofile.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
+	query := (&ProviderRatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerrating.Table, providerrating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamMembers queries the team_members edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryTeamMembers(pp *ProviderProfile) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamMembersTable, providerprofile.TeamMembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiatBalances queries the fiat_balances edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryFiatBalances(pp *ProviderProfile) *ProviderFiatBalanceQuery {
+	query := (&ProviderFiatBalanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerfiatbalance.Table, providerfiatbalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.FiatBalancesTable, providerprofile.FiatBalancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderProfileClient) Hooks() []Hook {
+	return c.hooks.ProviderProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderProfileClient) Interceptors() []Interceptor {
+	return c.inters.ProviderProfile
+}
+
+func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+	}
+}
+
+// ProviderRateExclusionClient is a client for the ProviderRateExclusion schema.
+type ProviderRateExclusionClient struct {
+	config
+}
+
+// NewProviderRateExclusionClient returns a client for the ProviderRateExclusion from the given config.
+func NewProviderRateExclusionClient(c config) *ProviderRateExclusionClient {
+	return &ProviderRateExclusionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerrateexclusion.Hooks(f(g(h())))`.
+func (c *ProviderRateExclusionClient) Use(hooks ...Hook) {
+	c.hooks.ProviderRateExclusion = append(c.hooks.ProviderRateExclusion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerrateexclusion.Intercept(f(g(h())))`.
+func (c *ProviderRateExclusionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderRateExclusion = append(c.inters.ProviderRateExclusion, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderRateExclusion entity.
+func (c *ProviderRateExclusionClient) Create() *ProviderRateExclusionCreate {
+	mutation := newProviderRateExclusionMutation(c.config, OpCreate)
+	return &ProviderRateExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderRateExclusion entities.
+func (c *ProviderRateExclusionClient) CreateBulk(builders ...*ProviderRateExclusionCreate) *ProviderRateExclusionCreateBulk {
+	return &ProviderRateExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderRateExclusionClient) MapCreateBulk(slice any, setFunc func(*ProviderRateExclusionCreate, int)) *ProviderRateExclusionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderRateExclusionCreateBulk{err: fmt.Errorf("calling to ProviderRateExclusionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderRateExclusionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderRateExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderRateExclusion.
+func (c *ProviderRateExclusionClient) Update() *ProviderRateExclusionUpdate {
+	mutation := newProviderRateExclusionMutation(c.config, OpUpdate)
+	return &ProviderRateExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderRateExclusionClient) UpdateOne(pre *ProviderRateExclusion) *ProviderRateExclusionUpdateOne {
+	mutation := newProviderRateExclusionMutation(c.config, OpUpdateOne, withProviderRateExclusion(pre))
+	return &ProviderRateExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderRateExclusionClient) UpdateOneID(id uuid.UUID) *ProviderRateExclusionUpdateOne {
+	mutation := newProviderRateExclusionMutation(c.config, OpUpdateOne, withProviderRateExclusionID(id))
+	return &ProviderRateExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderRateExclusion.
+func (c *ProviderRateExclusionClient) Delete() *ProviderRateExclusionDelete {
+	mutation := newProviderRateExclusionMutation(c.config, OpDelete)
+	return &ProviderRateExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderRateExclusionClient) DeleteOne(pre *ProviderRateExclusion) *ProviderRateExclusionDeleteOne {
+	return c.DeleteOneID(pre.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderRateExclusionClient) DeleteOneID(id uuid.UUID) *ProviderRateExclusionDeleteOne {
+	builder := c.Delete().Where(providerrateexclusion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderRateExclusionDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderRateExclusion.
+func (c *ProviderRateExclusionClient) Query() *ProviderRateExclusionQuery {
+	return &ProviderRateExclusionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderRateExclusion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderRateExclusion entity by its id.
+func (c *ProviderRateExclusionClient) Get(ctx context.Context, id uuid.UUID) (*ProviderRateExclusion, error) {
+	return c.Query().Where(providerrateexclusion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderRateExclusionClient) GetX(ctx context.Context, id uuid.UUID) *ProviderRateExclusion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrderToken queries the order_token edge of a ProviderRateExclusion.
+func (c *ProviderRateExclusionClient) QueryOrderToken(pre *ProviderRateExclusion) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pre.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerrateexclusion.Table, providerrateexclusion.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerrateexclusion.OrderTokenTable, providerrateexclusion.OrderTokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(pre.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderRateExclusionClient) Hooks() []Hook {
+	return c.hooks.ProviderRateExclusion
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderRateExclusionClient) Interceptors() []Interceptor {
+	return c.inters.ProviderRateExclusion
+}
+
+func (c *ProviderRateExclusionClient) mutate(ctx context.Context, m *ProviderRateExclusionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderRateExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderRateExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderRateExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderRateExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderRateExclusion mutation op: %q", m.Op())
+	}
+}
+
+// ProviderRatingClient is a client for the ProviderRating schema.
+type ProviderRatingClient struct {
+	config
+}
+
+// NewProviderRatingClient returns a client for the ProviderRating from the given config.
+func NewProviderRatingClient(c config) *ProviderRatingClient {
+	return &ProviderRatingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
+func (c *ProviderRatingClient) Use(hooks ...Hook) {
+	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
+func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderRating entity.
+func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
+	mutation := newProviderRatingMutation(c.config, OpCreate)
+	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
+func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderRatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderRating.
+func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
+	mutation := newProviderRatingMutation(c.config, OpUpdate)
+	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderRating.
+func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
+	mutation := newProviderRatingMutation(c.config, OpDelete)
+	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
+	builder := c.Delete().Where(providerrating.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderRatingDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderRating.
+func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
+	return &ProviderRatingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderRating},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderRating entity by its id.
+func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
+	return c.Query().Where(providerrating.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
+func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderRatingClient) Hooks() []Hook {
+	return c.hooks.ProviderRating
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderRatingClient) Interceptors() []Interceptor {
+	return c.inters.ProviderRating
+}
+
+func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
+	}
+}
+
+// ProvisionBucketClient is a client for the ProvisionBucket schema.
+type ProvisionBucketClient struct {
+	config
+}
+
+// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
+func NewProvisionBucketClient(c config) *ProvisionBucketClient {
+	return &ProvisionBucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
+func (c *ProvisionBucketClient) Use(hooks ...Hook) {
+	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
+func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
+}
+
+// Create returns a builder for creating a ProvisionBucket entity.
+func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
+	mutation := newProvisionBucketMutation(c.config, OpCreate)
+	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
+func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProvisionBucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
+	mutation := newProvisionBucketMutation(c.config, OpUpdate)
+	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
+	mutation := newProvisionBucketMutation(c.config, OpDelete)
+	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
+	return c.DeleteOneID(pb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
+	builder := c.Delete().Where(provisionbucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProvisionBucketDeleteOne{builder}
+}
+
+// Query returns a query builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
+	return &ProvisionBucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProvisionBucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProvisionBucket entity by its id.
+func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
+	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCurrency queries the currency edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProvisionBucketClient) Hooks() []Hook {
+	return c.hooks.ProvisionBucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProvisionBucketClient) Interceptors() []Interceptor {
+	return c.inters.ProvisionBucket
+}
+
+func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
+	}
+}
+
+// ReceiveAddressClient is a client for the ReceiveAddress schema.
+type ReceiveAddressClient struct {
+	config
+}
+
+// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
+func NewReceiveAddressClient(c config) *ReceiveAddressClient {
+	return &ReceiveAddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
+func (c *ReceiveAddressClient) Use(hooks ...Hook) {
+	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
+func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
+}
+
+// Create returns a builder for creating a ReceiveAddress entity.
+func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
+	mutation := newReceiveAddressMutation(c.config, OpCreate)
+	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
+func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiveAddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
+	mutation := newReceiveAddressMutation(c.config, OpUpdate)
+	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
+	mutation := newReceiveAddressMutation(c.config, OpDelete)
+	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
+	builder := c.Delete().Where(receiveaddress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiveAddressDeleteOne{builder}
+}
+
+// Query returns a query builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
+	return &ReceiveAddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceiveAddress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReceiveAddress entity by its id.
+func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
+	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
-	query := (&ProviderRatingClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
+func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := ra.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerrating.Table, providerrating.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryPaymentOrderBatch queries the payment_order_batch edge of a ReceiveAddress.
+func (c *ReceiveAddressClient) QueryPaymentOrderBatch(ra *ReceiveAddress) *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := ra.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderBatchTable, receiveaddress.PaymentOrderBatchColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderProfileClient) Hooks() []Hook {
-	return c.hooks.ProviderProfile
+func (c *ReceiveAddressClient) Hooks() []Hook {
+	return c.hooks.ReceiveAddress
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderProfileClient) Interceptors() []Interceptor {
-	return c.inters.ProviderProfile
+func (c *ReceiveAddressClient) Interceptors() []Interceptor {
+	return c.inters.ReceiveAddress
 }
 
-func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
 	}
 }
 
-// ProviderRatingClient is a client for the ProviderRating schema.
-type ProviderRatingClient struct {
+// ScheduledOrderClient is a client for the ScheduledOrder schema.
+type ScheduledOrderClient struct {
 	config
 }
 
-// NewProviderRatingClient returns a client for the ProviderRating from the given config.
-func NewProviderRatingClient(c config) *ProviderRatingClient {
-	return &ProviderRatingClient{config: c}
+// NewScheduledOrderClient returns a client for the ScheduledOrder from the given config.
+func NewScheduledOrderClient(c config) *ScheduledOrderClient {
+	return &ScheduledOrderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
-func (c *ProviderRatingClient) Use(hooks ...Hook) {
-	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledorder.Hooks(f(g(h())))`.
+func (c *ScheduledOrderClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledOrder = append(c.hooks.ScheduledOrder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
-func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledorder.Intercept(f(g(h())))`.
+func (c *ScheduledOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledOrder = append(c.inters.ScheduledOrder, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderRating entity.
-func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
-	mutation := newProviderRatingMutation(c.config, OpCreate)
-	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledOrder entity.
+func (c *ScheduledOrderClient) Create() *ScheduledOrderCreate {
+	mutation := newScheduledOrderMutation(c.config, OpCreate)
+	return &ScheduledOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
-func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledOrder entities.
+func (c *ScheduledOrderClient) CreateBulk(builders ...*ScheduledOrderCreate) *ScheduledOrderCreateBulk {
+	return &ScheduledOrderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
+func (c *ScheduledOrderClient) MapCreateBulk(slice any, setFunc func(*ScheduledOrderCreate, int)) *ScheduledOrderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledOrderCreateBulk{err: fmt.Errorf("calling to ScheduledOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderRatingCreate, rv.Len())
+	builders := make([]*ScheduledOrderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+	return &ScheduledOrderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderRating.
-func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
-	mutation := newProviderRatingMutation(c.config, OpUpdate)
-	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledOrder.
+func (c *ScheduledOrderClient) Update() *ScheduledOrderUpdate {
+	mutation := newScheduledOrderMutation(c.config, OpUpdate)
+	return &ScheduledOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledOrderClient) UpdateOne(so *ScheduledOrder) *ScheduledOrderUpdateOne {
+	mutation := newScheduledOrderMutation(c.config, OpUpdateOne, withScheduledOrder(so))
+	return &ScheduledOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledOrderClient) UpdateOneID(id uuid.UUID) *ScheduledOrderUpdateOne {
+	mutation := newScheduledOrderMutation(c.config, OpUpdateOne, withScheduledOrderID(id))
+	return &ScheduledOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderRating.
-func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
-	mutation := newProviderRatingMutation(c.config, OpDelete)
-	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledOrder.
+func (c *ScheduledOrderClient) Delete() *ScheduledOrderDelete {
+	mutation := newScheduledOrderMutation(c.config, OpDelete)
+	return &ScheduledOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *ScheduledOrderClient) DeleteOne(so *ScheduledOrder) *ScheduledOrderDeleteOne {
+	return c.DeleteOneID(so.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
-	builder := c.Delete().Where(providerrating.ID(id))
+func (c *ScheduledOrderClient) DeleteOneID(id uuid.UUID) *ScheduledOrderDeleteOne {
+	builder := c.Delete().Where(scheduledorder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderRatingDeleteOne{builder}
+	return &ScheduledOrderDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderRating.
-func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
-	return &ProviderRatingQuery{
+// Query returns a query builder for ScheduledOrder.
+func (c *ScheduledOrderClient) Query() *ScheduledOrderQuery {
+	return &ScheduledOrderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderRating},
+		ctx:    &QueryContext{Type: TypeScheduledOrder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderRating entity by its id.
-func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
-	return c.Query().Where(providerrating.ID(id)).Only(ctx)
+// Get returns a ScheduledOrder entity by its id.
+func (c *ScheduledOrderClient) Get(ctx context.Context, id uuid.UUID) (*ScheduledOrder, error) {
+	return c.Query().Where(scheduledorder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
+func (c *ScheduledOrderClient) GetX(ctx context.Context, id uuid.UUID) *ScheduledOrder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2568,148 +4251,180 @@ func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating
 	return obj
 }
 
-// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
-func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QuerySenderProfile queries the sender_profile edge of a ScheduledOrder.
+func (c *ScheduledOrderClient) QuerySenderProfile(so *ScheduledOrder) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := so.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorder.SenderProfileTable, scheduledorder.SenderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(so.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a ScheduledOrder.
+func (c *ScheduledOrderClient) QueryToken(so *ScheduledOrder) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := so.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorder.TokenTable, scheduledorder.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(so.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a ScheduledOrder.
+func (c *ScheduledOrderClient) QueryRuns(so *ScheduledOrder) *ScheduledOrderRunQuery {
+	query := (&ScheduledOrderRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := so.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, id),
+			sqlgraph.To(scheduledorderrun.Table, scheduledorderrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scheduledorder.RunsTable, scheduledorder.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(so.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderRatingClient) Hooks() []Hook {
-	return c.hooks.ProviderRating
+func (c *ScheduledOrderClient) Hooks() []Hook {
+	return c.hooks.ScheduledOrder
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderRatingClient) Interceptors() []Interceptor {
-	return c.inters.ProviderRating
+func (c *ScheduledOrderClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledOrder
 }
 
-func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
+func (c *ScheduledOrderClient) mutate(ctx context.Context, m *ScheduledOrderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledOrder mutation op: %q", m.Op())
 	}
 }
 
-// ProvisionBucketClient is a client for the ProvisionBucket schema.
-type ProvisionBucketClient struct {
+// ScheduledOrderRunClient is a client for the ScheduledOrderRun schema.
+type ScheduledOrderRunClient struct {
 	config
 }
 
-// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
-func NewProvisionBucketClient(c config) *ProvisionBucketClient {
-	return &ProvisionBucketClient{config: c}
+// NewScheduledOrderRunClient returns a client for the ScheduledOrderRun from the given config.
+func NewScheduledOrderRunClient(c config) *ScheduledOrderRunClient {
+	return &ScheduledOrderRunClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
-func (c *ProvisionBucketClient) Use(hooks ...Hook) {
-	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledorderrun.Hooks(f(g(h())))`.
+func (c *ScheduledOrderRunClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledOrderRun = append(c.hooks.ScheduledOrderRun, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
-func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledorderrun.Intercept(f(g(h())))`.
+func (c *ScheduledOrderRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledOrderRun = append(c.inters.ScheduledOrderRun, interceptors...)
 }
 
-// Create returns a builder for creating a ProvisionBucket entity.
-func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
-	mutation := newProvisionBucketMutation(c.config, OpCreate)
-	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledOrderRun entity.
+func (c *ScheduledOrderRunClient) Create() *ScheduledOrderRunCreate {
+	mutation := newScheduledOrderRunMutation(c.config, OpCreate)
+	return &ScheduledOrderRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
-func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledOrderRun entities.
+func (c *ScheduledOrderRunClient) CreateBulk(builders ...*ScheduledOrderRunCreate) *ScheduledOrderRunCreateBulk {
+	return &ScheduledOrderRunCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
+func (c *ScheduledOrderRunClient) MapCreateBulk(slice any, setFunc func(*ScheduledOrderRunCreate, int)) *ScheduledOrderRunCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledOrderRunCreateBulk{err: fmt.Errorf("calling to ScheduledOrderRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProvisionBucketCreate, rv.Len())
+	builders := make([]*ScheduledOrderRunCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+	return &ScheduledOrderRunCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
-	mutation := newProvisionBucketMutation(c.config, OpUpdate)
-	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledOrderRun.
+func (c *ScheduledOrderRunClient) Update() *ScheduledOrderRunUpdate {
+	mutation := newScheduledOrderRunMutation(c.config, OpUpdate)
+	return &ScheduledOrderRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledOrderRunClient) UpdateOne(sor *ScheduledOrderRun) *ScheduledOrderRunUpdateOne {
+	mutation := newScheduledOrderRunMutation(c.config, OpUpdateOne, withScheduledOrderRun(sor))
+	return &ScheduledOrderRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledOrderRunClient) UpdateOneID(id uuid.UUID) *ScheduledOrderRunUpdateOne {
+	mutation := newScheduledOrderRunMutation(c.config, OpUpdateOne, withScheduledOrderRunID(id))
+	return &ScheduledOrderRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
-	mutation := newProvisionBucketMutation(c.config, OpDelete)
-	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledOrderRun.
+func (c *ScheduledOrderRunClient) Delete() *ScheduledOrderRunDelete {
+	mutation := newScheduledOrderRunMutation(c.config, OpDelete)
+	return &ScheduledOrderRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
-	return c.DeleteOneID(pb.ID)
+func (c *ScheduledOrderRunClient) DeleteOne(sor *ScheduledOrderRun) *ScheduledOrderRunDeleteOne {
+	return c.DeleteOneID(sor.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
-	builder := c.Delete().Where(provisionbucket.ID(id))
+func (c *ScheduledOrderRunClient) DeleteOneID(id uuid.UUID) *ScheduledOrderRunDeleteOne {
+	builder := c.Delete().Where(scheduledorderrun.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProvisionBucketDeleteOne{builder}
+	return &ScheduledOrderRunDeleteOne{builder}
 }
 
-// Query returns a query builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
-	return &ProvisionBucketQuery{
+// Query returns a query builder for ScheduledOrderRun.
+func (c *ScheduledOrderRunClient) Query() *ScheduledOrderRunQuery {
+	return &ScheduledOrderRunQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProvisionBucket},
+		ctx:    &QueryContext{Type: TypeScheduledOrderRun},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProvisionBucket entity by its id.
-func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
-	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
+// Get returns a ScheduledOrderRun entity by its id.
+func (c *ScheduledOrderRunClient) Get(ctx context.Context, id uuid.UUID) (*ScheduledOrderRun, error) {
+	return c.Query().Where(scheduledorderrun.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
+func (c *ScheduledOrderRunClient) GetX(ctx context.Context, id uuid.UUID) *ScheduledOrderRun {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2717,180 +4432,164 @@ func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBuck
 	return obj
 }
 
-// QueryCurrency queries the currency edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
-		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryScheduledOrder queries the scheduled_order edge of a ScheduledOrderRun.
+func (c *ScheduledOrderRunClient) QueryScheduledOrder(sor *ScheduledOrderRun) *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := sor.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
+			sqlgraph.From(scheduledorderrun.Table, scheduledorderrun.FieldID, id),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorderrun.ScheduledOrderTable, scheduledorderrun.ScheduledOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sor.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a ScheduledOrderRun.
+func (c *ScheduledOrderRunClient) QueryPaymentOrder(sor *ScheduledOrderRun) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := sor.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+			sqlgraph.From(scheduledorderrun.Table, scheduledorderrun.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledorderrun.PaymentOrderTable, scheduledorderrun.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sor.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProvisionBucketClient) Hooks() []Hook {
-	return c.hooks.ProvisionBucket
+func (c *ScheduledOrderRunClient) Hooks() []Hook {
+	return c.hooks.ScheduledOrderRun
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProvisionBucketClient) Interceptors() []Interceptor {
-	return c.inters.ProvisionBucket
+func (c *ScheduledOrderRunClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledOrderRun
 }
 
-func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
+func (c *ScheduledOrderRunClient) mutate(ctx context.Context, m *ScheduledOrderRunMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledOrderRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledOrderRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledOrderRun mutation op: %q", m.Op())
 	}
 }
 
-// ReceiveAddressClient is a client for the ReceiveAddress schema.
-type ReceiveAddressClient struct {
+// SenderFeeTierClient is a client for the SenderFeeTier schema.
+type SenderFeeTierClient struct {
 	config
 }
 
-// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
-func NewReceiveAddressClient(c config) *ReceiveAddressClient {
-	return &ReceiveAddressClient{config: c}
+// NewSenderFeeTierClient returns a client for the SenderFeeTier from the given config.
+func NewSenderFeeTierClient(c config) *SenderFeeTierClient {
+	return &SenderFeeTierClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
-func (c *ReceiveAddressClient) Use(hooks ...Hook) {
-	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `senderfeetier.Hooks(f(g(h())))`.
+func (c *SenderFeeTierClient) Use(hooks ...Hook) {
+	c.hooks.SenderFeeTier = append(c.hooks.SenderFeeTier, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
-func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `senderfeetier.Intercept(f(g(h())))`.
+func (c *SenderFeeTierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SenderFeeTier = append(c.inters.SenderFeeTier, interceptors...)
 }
 
-// Create returns a builder for creating a ReceiveAddress entity.
-func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
-	mutation := newReceiveAddressMutation(c.config, OpCreate)
-	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SenderFeeTier entity.
+func (c *SenderFeeTierClient) Create() *SenderFeeTierCreate {
+	mutation := newSenderFeeTierMutation(c.config, OpCreate)
+	return &SenderFeeTierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
-func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SenderFeeTier entities.
+func (c *SenderFeeTierClient) CreateBulk(builders ...*SenderFeeTierCreate) *SenderFeeTierCreateBulk {
+	return &SenderFeeTierCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
+func (c *SenderFeeTierClient) MapCreateBulk(slice any, setFunc func(*SenderFeeTierCreate, int)) *SenderFeeTierCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SenderFeeTierCreateBulk{err: fmt.Errorf("calling to SenderFeeTierClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReceiveAddressCreate, rv.Len())
+	builders := make([]*SenderFeeTierCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+	return &SenderFeeTierCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
-	mutation := newReceiveAddressMutation(c.config, OpUpdate)
-	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SenderFeeTier.
+func (c *SenderFeeTierClient) Update() *SenderFeeTierUpdate {
+	mutation := newSenderFeeTierMutation(c.config, OpUpdate)
+	return &SenderFeeTierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderFeeTierClient) UpdateOne(sft *SenderFeeTier) *SenderFeeTierUpdateOne {
+	mutation := newSenderFeeTierMutation(c.config, OpUpdateOne, withSenderFeeTier(sft))
+	return &SenderFeeTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderFeeTierClient) UpdateOneID(id int) *SenderFeeTierUpdateOne {
+	mutation := newSenderFeeTierMutation(c.config, OpUpdateOne, withSenderFeeTierID(id))
+	return &SenderFeeTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
-	mutation := newReceiveAddressMutation(c.config, OpDelete)
-	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SenderFeeTier.
+func (c *SenderFeeTierClient) Delete() *SenderFeeTierDelete {
+	mutation := newSenderFeeTierMutation(c.config, OpDelete)
+	return &SenderFeeTierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
-	return c.DeleteOneID(ra.ID)
+func (c *SenderFeeTierClient) DeleteOne(sft *SenderFeeTier) *SenderFeeTierDeleteOne {
+	return c.DeleteOneID(sft.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
-	builder := c.Delete().Where(receiveaddress.ID(id))
+func (c *SenderFeeTierClient) DeleteOneID(id int) *SenderFeeTierDeleteOne {
+	builder := c.Delete().Where(senderfeetier.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReceiveAddressDeleteOne{builder}
+	return &SenderFeeTierDeleteOne{builder}
 }
 
-// Query returns a query builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
-	return &ReceiveAddressQuery{
+// Query returns a query builder for SenderFeeTier.
+func (c *SenderFeeTierClient) Query() *SenderFeeTierQuery {
+	return &SenderFeeTierQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReceiveAddress},
+		ctx:    &QueryContext{Type: TypeSenderFeeTier},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ReceiveAddress entity by its id.
-func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
-	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
+// Get returns a SenderFeeTier entity by its id.
+func (c *SenderFeeTierClient) Get(ctx context.Context, id int) (*SenderFeeTier, error) {
+	return c.Query().Where(senderfeetier.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
+func (c *SenderFeeTierClient) GetX(ctx context.Context, id int) *SenderFeeTier {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2898,44 +4597,44 @@ func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
-func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QuerySenderOrderToken queries the sender_order_token edge of a SenderFeeTier.
+func (c *SenderFeeTierClient) QuerySenderOrderToken(sft *SenderFeeTier) *SenderOrderTokenQuery {
+	query := (&SenderOrderTokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ra.ID
+		id := sft.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
+			sqlgraph.From(senderfeetier.Table, senderfeetier.FieldID, id),
+			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, senderfeetier.SenderOrderTokenTable, senderfeetier.SenderOrderTokenColumn),
 		)
-		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sft.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ReceiveAddressClient) Hooks() []Hook {
-	return c.hooks.ReceiveAddress
+func (c *SenderFeeTierClient) Hooks() []Hook {
+	return c.hooks.SenderFeeTier
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReceiveAddressClient) Interceptors() []Interceptor {
-	return c.inters.ReceiveAddress
+func (c *SenderFeeTierClient) Interceptors() []Interceptor {
+	return c.inters.SenderFeeTier
 }
 
-func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
+func (c *SenderFeeTierClient) mutate(ctx context.Context, m *SenderFeeTierMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderFeeTierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderFeeTierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderFeeTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SenderFeeTierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SenderFeeTier mutation op: %q", m.Op())
 	}
 }
 
@@ -3079,6 +4778,22 @@ func (c *SenderOrderTokenClient) QueryToken(sot *SenderOrderToken) *TokenQuery {
 	return query
 }
 
+// QueryFeeTiers queries the fee_tiers edge of a SenderOrderToken.
+func (c *SenderOrderTokenClient) QueryFeeTiers(sot *SenderOrderToken) *SenderFeeTierQuery {
+	query := (&SenderFeeTierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, id),
+			sqlgraph.To(senderfeetier.Table, senderfeetier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderordertoken.FeeTiersTable, senderordertoken.FeeTiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(sot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SenderOrderTokenClient) Hooks() []Hook {
 	return c.hooks.SenderOrderToken
@@ -3228,15 +4943,15 @@ func (c *SenderProfileClient) QueryUser(sp *SenderProfile) *UserQuery {
 	return query
 }
 
-// QueryAPIKey queries the api_key edge of a SenderProfile.
-func (c *SenderProfileClient) QueryAPIKey(sp *SenderProfile) *APIKeyQuery {
+// QueryAPIKeys queries the api_keys edge of a SenderProfile.
+func (c *SenderProfileClient) QueryAPIKeys(sp *SenderProfile) *APIKeyQuery {
 	query := (&APIKeyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := sp.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.APIKeysTable, senderprofile.APIKeysColumn),
 		)
 		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
@@ -3292,6 +5007,70 @@ func (c *SenderProfileClient) QueryLinkedAddress(sp *SenderProfile) *LinkedAddre
 	return query
 }
 
+// QueryPaymentOrderBatches queries the payment_order_batches edge of a SenderProfile.
+func (c *SenderProfileClient) QueryPaymentOrderBatches(sp *SenderProfile) *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrderBatchesTable, senderprofile.PaymentOrderBatchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamMembers queries the team_members edge of a SenderProfile.
+func (c *SenderProfileClient) QueryTeamMembers(sp *SenderProfile) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamMembersTable, senderprofile.TeamMembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBeneficiaries queries the beneficiaries edge of a SenderProfile.
+func (c *SenderProfileClient) QueryBeneficiaries(sp *SenderProfile) *BeneficiaryQuery {
+	query := (&BeneficiaryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(beneficiary.Table, beneficiary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.BeneficiariesTable, senderprofile.BeneficiariesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledOrders queries the scheduled_orders edge of a SenderProfile.
+func (c *SenderProfileClient) QueryScheduledOrders(sp *SenderProfile) *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.ScheduledOrdersTable, senderprofile.ScheduledOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SenderProfileClient) Hooks() []Hook {
 	return c.hooks.SenderProfile
@@ -3317,6 +5096,188 @@ func (c *SenderProfileClient) mutate(ctx context.Context, m *SenderProfileMutati
 	}
 }
 
+// TeamMemberClient is a client for the TeamMember schema.
+type TeamMemberClient struct {
+	config
+}
+
+// NewTeamMemberClient returns a client for the TeamMember from the given config.
+func NewTeamMemberClient(c config) *TeamMemberClient {
+	return &TeamMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teammember.Hooks(f(g(h())))`.
+func (c *TeamMemberClient) Use(hooks ...Hook) {
+	c.hooks.TeamMember = append(c.hooks.TeamMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teammember.Intercept(f(g(h())))`.
+func (c *TeamMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamMember = append(c.inters.TeamMember, interceptors...)
+}
+
+// Create returns a builder for creating a TeamMember entity.
+func (c *TeamMemberClient) Create() *TeamMemberCreate {
+	mutation := newTeamMemberMutation(c.config, OpCreate)
+	return &TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamMember entities.
+func (c *TeamMemberClient) CreateBulk(builders ...*TeamMemberCreate) *TeamMemberCreateBulk {
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamMemberClient) MapCreateBulk(slice any, setFunc func(*TeamMemberCreate, int)) *TeamMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamMemberCreateBulk{err: fmt.Errorf("calling to TeamMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamMember.
+func (c *TeamMemberClient) Update() *TeamMemberUpdate {
+	mutation := newTeamMemberMutation(c.config, OpUpdate)
+	return &TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamMemberClient) UpdateOne(tm *TeamMember) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMember(tm))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamMemberClient) UpdateOneID(id uuid.UUID) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMemberID(id))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamMember.
+func (c *TeamMemberClient) Delete() *TeamMemberDelete {
+	mutation := newTeamMemberMutation(c.config, OpDelete)
+	return &TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamMemberClient) DeleteOne(tm *TeamMember) *TeamMemberDeleteOne {
+	return c.DeleteOneID(tm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamMemberClient) DeleteOneID(id uuid.UUID) *TeamMemberDeleteOne {
+	builder := c.Delete().Where(teammember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamMember.
+func (c *TeamMemberClient) Query() *TeamMemberQuery {
+	return &TeamMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamMember entity by its id.
+func (c *TeamMemberClient) Get(ctx context.Context, id uuid.UUID) (*TeamMember, error) {
+	return c.Query().Where(teammember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamMemberClient) GetX(ctx context.Context, id uuid.UUID) *TeamMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TeamMember.
+func (c *TeamMemberClient) QueryUser(tm *TeamMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.UserTable, teammember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySenderProfile queries the sender_profile edge of a TeamMember.
+func (c *TeamMemberClient) QuerySenderProfile(tm *TeamMember) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.SenderProfileTable, teammember.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderProfile queries the provider_profile edge of a TeamMember.
+func (c *TeamMemberClient) QueryProviderProfile(tm *TeamMember) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.ProviderProfileTable, teammember.ProviderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamMemberClient) Hooks() []Hook {
+	hooks := c.hooks.TeamMember
+	return append(hooks[:len(hooks):len(hooks)], teammember.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamMemberClient) Interceptors() []Interceptor {
+	return c.inters.TeamMember
+}
+
+func (c *TeamMemberClient) mutate(ctx context.Context, m *TeamMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TeamMember mutation op: %q", m.Op())
+	}
+}
+
 // TokenClient is a client for the Token schema.
 type TokenClient struct {
 	config
@@ -3489,6 +5450,38 @@ func (c *TokenClient) QuerySenderSettings(t *Token) *SenderOrderTokenQuery {
 	return query
 }
 
+// QueryPaymentOrderBatches queries the payment_order_batches edge of a Token.
+func (c *TokenClient) QueryPaymentOrderBatches(t *Token) *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, id),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.PaymentOrderBatchesTable, token.PaymentOrderBatchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledOrders queries the scheduled_orders edge of a Token.
+func (c *TokenClient) QueryScheduledOrders(t *Token) *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, id),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.ScheduledOrdersTable, token.ScheduledOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TokenClient) Hooks() []Hook {
 	return c.hooks.Token
@@ -3803,6 +5796,22 @@ func (c *UserClient) QueryVerificationToken(u *User) *VerificationTokenQuery {
 	return query
 }
 
+// QueryTeamMemberships queries the team_memberships edge of a User.
+func (c *UserClient) QueryTeamMemberships(u *User) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TeamMembershipsTable, user.TeamMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -4115,17 +6124,23 @@ func (c *WebhookRetryAttemptClient) mutate(ctx context.Context, m *WebhookRetryA
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, FiatCurrency, IdentityVerificationRequest, Institution, LinkedAddress,
-		LockOrderFulfillment, LockPaymentOrder, Network, PaymentOrder,
-		PaymentOrderRecipient, ProviderOrderToken, ProviderProfile, ProviderRating,
-		ProvisionBucket, ReceiveAddress, SenderOrderToken, SenderProfile, Token,
-		TransactionLog, User, VerificationToken, WebhookRetryAttempt []ent.Hook
+		APIKey, Beneficiary, Dispute, FiatCurrency, IdentityVerificationRequest,
+		Institution, LinkedAddress, LockOrderFulfillment, LockPaymentOrder, Network,
+		PaymentOrder, PaymentOrderBatch, PaymentOrderRecipient, PaymentOrderSplit,
+		ProviderFiatBalance, ProviderOrderToken, ProviderProfile,
+		ProviderRateExclusion, ProviderRating, ProvisionBucket, ReceiveAddress,
+		ScheduledOrder, ScheduledOrderRun, SenderFeeTier, SenderOrderToken,
+		SenderProfile, TeamMember, Token, TransactionLog, User, VerificationToken,
+		WebhookRetryAttempt []ent.Hook
 	}
 	inters struct {
-		APIKey, FiatCurrency, IdentityVerificationRequest, Institution, LinkedAddress,
-		LockOrderFulfillment, LockPaymentOrder, Network, PaymentOrder,
-		PaymentOrderRecipient, ProviderOrderToken, ProviderProfile, ProviderRating,
-		ProvisionBucket, ReceiveAddress, SenderOrderToken, SenderProfile, Token,
-		TransactionLog, User, VerificationToken, WebhookRetryAttempt []ent.Interceptor
+		APIKey, Beneficiary, Dispute, FiatCurrency, IdentityVerificationRequest,
+		Institution, LinkedAddress, LockOrderFulfillment, LockPaymentOrder, Network,
+		PaymentOrder, PaymentOrderBatch, PaymentOrderRecipient, PaymentOrderSplit,
+		ProviderFiatBalance, ProviderOrderToken, ProviderProfile,
+		ProviderRateExclusion, ProviderRating, ProvisionBucket, ReceiveAddress,
+		ScheduledOrder, ScheduledOrderRun, SenderFeeTier, SenderOrderToken,
+		SenderProfile, TeamMember, Token, TransactionLog, User, VerificationToken,
+		WebhookRetryAttempt []ent.Interceptor
 	}
 )
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+)
+
+// Dispute is the model entity for the Dispute schema.
+type Dispute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status dispute.Status `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Evidence holds the value of the "evidence" field.
+	Evidence []struct {
+		Type        string    "json:\"type\""
+		Reference   string    "json:\"reference\""
+		URL         string    "json:\"url\""
+		Note        string    "json:\"note\""
+		SubmittedAt time.Time "json:\"submittedAt\""
+	} `json:"evidence,omitempty"`
+	// ProviderAtFault holds the value of the "provider_at_fault" field.
+	ProviderAtFault *bool `json:"provider_at_fault,omitempty"`
+	// Resolution holds the value of the "resolution" field.
+	Resolution string `json:"resolution,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// EvidenceDueAt holds the value of the "evidence_due_at" field.
+	EvidenceDueAt *time.Time `json:"evidence_due_at,omitempty"`
+	// ResolutionDueAt holds the value of the "resolution_due_at" field.
+	ResolutionDueAt time.Time `json:"resolution_due_at,omitempty"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DisputeQuery when eager-loading is set.
+	Edges                       DisputeEdges `json:"edges"`
+	lock_payment_order_disputes *uuid.UUID
+	payment_order_disputes      *uuid.UUID
+	selectValues                sql.SelectValues
+}
+
+// DisputeEdges holds the relations/edges for other nodes in the graph.
+type DisputeEdges struct {
+	// LockPaymentOrder holds the value of the lock_payment_order edge.
+	LockPaymentOrder *LockPaymentOrder `json:"lock_payment_order,omitempty"`
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LockPaymentOrderOrErr returns the LockPaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEdges) LockPaymentOrderOrErr() (*LockPaymentOrder, error) {
+	if e.LockPaymentOrder != nil {
+		return e.LockPaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock_payment_order"}
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Dispute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dispute.FieldEvidence:
+			values[i] = new([]byte)
+		case dispute.FieldProviderAtFault:
+			values[i] = new(sql.NullBool)
+		case dispute.FieldStatus, dispute.FieldReason, dispute.FieldResolution:
+			values[i] = new(sql.NullString)
+		case dispute.FieldCreatedAt, dispute.FieldUpdatedAt, dispute.FieldResolvedAt, dispute.FieldEvidenceDueAt, dispute.FieldResolutionDueAt, dispute.FieldEscalatedAt:
+			values[i] = new(sql.NullTime)
+		case dispute.FieldID:
+			values[i] = new(uuid.UUID)
+		case dispute.ForeignKeys[0]: // lock_payment_order_disputes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case dispute.ForeignKeys[1]: // payment_order_disputes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Dispute fields.
+func (d *Dispute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dispute.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case dispute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case dispute.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case dispute.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				d.Status = dispute.Status(value.String)
+			}
+		case dispute.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				d.Reason = value.String
+			}
+		case dispute.FieldEvidence:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field evidence", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Evidence); err != nil {
+					return fmt.Errorf("unmarshal field evidence: %w", err)
+				}
+			}
+		case dispute.FieldProviderAtFault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_at_fault", values[i])
+			} else if value.Valid {
+				d.ProviderAtFault = new(bool)
+				*d.ProviderAtFault = value.Bool
+			}
+		case dispute.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				d.Resolution = value.String
+			}
+		case dispute.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				d.ResolvedAt = new(time.Time)
+				*d.ResolvedAt = value.Time
+			}
+		case dispute.FieldEvidenceDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field evidence_due_at", values[i])
+			} else if value.Valid {
+				d.EvidenceDueAt = new(time.Time)
+				*d.EvidenceDueAt = value.Time
+			}
+		case dispute.FieldResolutionDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_due_at", values[i])
+			} else if value.Valid {
+				d.ResolutionDueAt = value.Time
+			}
+		case dispute.FieldEscalatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field escalated_at", values[i])
+			} else if value.Valid {
+				d.EscalatedAt = new(time.Time)
+				*d.EscalatedAt = value.Time
+			}
+		case dispute.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_disputes", values[i])
+			} else if value.Valid {
+				d.lock_payment_order_disputes = new(uuid.UUID)
+				*d.lock_payment_order_disputes = *value.S.(*uuid.UUID)
+			}
+		case dispute.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_disputes", values[i])
+			} else if value.Valid {
+				d.payment_order_disputes = new(uuid.UUID)
+				*d.payment_order_disputes = *value.S.(*uuid.UUID)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Dispute.
+// This includes values selected through modifiers, order, etc.
+func (d *Dispute) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryLockPaymentOrder queries the "lock_payment_order" edge of the Dispute entity.
+func (d *Dispute) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	return NewDisputeClient(d.config).QueryLockPaymentOrder(d)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the Dispute entity.
+func (d *Dispute) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewDisputeClient(d.config).QueryPaymentOrder(d)
+}
+
+// Update returns a builder for updating this Dispute.
+// Note that you need to call Dispute.Unwrap() before calling this method if this Dispute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Dispute) Update() *DisputeUpdateOne {
+	return NewDisputeClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Dispute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Dispute) Unwrap() *Dispute {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Dispute is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Dispute) String() string {
+	var builder strings.Builder
+	builder.WriteString("Dispute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", d.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(d.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("evidence=")
+	builder.WriteString(fmt.Sprintf("%v", d.Evidence))
+	builder.WriteString(", ")
+	if v := d.ProviderAtFault; v != nil {
+		builder.WriteString("provider_at_fault=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(d.Resolution)
+	builder.WriteString(", ")
+	if v := d.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.EvidenceDueAt; v != nil {
+		builder.WriteString("evidence_due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution_due_at=")
+	builder.WriteString(d.ResolutionDueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := d.EscalatedAt; v != nil {
+		builder.WriteString("escalated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Disputes is a parsable slice of Dispute.
+type Disputes []*Dispute
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dispute
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the dispute type in the database.
+	Label = "dispute"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldEvidence holds the string denoting the evidence field in the database.
+	FieldEvidence = "evidence"
+	// FieldProviderAtFault holds the string denoting the provider_at_fault field in the database.
+	FieldProviderAtFault = "provider_at_fault"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldEvidenceDueAt holds the string denoting the evidence_due_at field in the database.
+	FieldEvidenceDueAt = "evidence_due_at"
+	// FieldResolutionDueAt holds the string denoting the resolution_due_at field in the database.
+	FieldResolutionDueAt = "resolution_due_at"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
+	// EdgeLockPaymentOrder holds the string denoting the lock_payment_order edge name in mutations.
+	EdgeLockPaymentOrder = "lock_payment_order"
+	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
+	EdgePaymentOrder = "payment_order"
+	// Table holds the table name of the dispute in the database.
+	Table = "disputes"
+	// LockPaymentOrderTable is the table that holds the lock_payment_order relation/edge.
+	LockPaymentOrderTable = "disputes"
+	// LockPaymentOrderInverseTable is the table name for the LockPaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "lockpaymentorder" package.
+	LockPaymentOrderInverseTable = "lock_payment_orders"
+	// LockPaymentOrderColumn is the table column denoting the lock_payment_order relation/edge.
+	LockPaymentOrderColumn = "lock_payment_order_disputes"
+	// PaymentOrderTable is the table that holds the payment_order relation/edge.
+	PaymentOrderTable = "disputes"
+	// PaymentOrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrderInverseTable = "payment_orders"
+	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
+	PaymentOrderColumn = "payment_order_disputes"
+)
+
+// Columns holds all SQL columns for dispute fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldReason,
+	FieldEvidence,
+	FieldProviderAtFault,
+	FieldResolution,
+	FieldResolvedAt,
+	FieldEvidenceDueAt,
+	FieldResolutionDueAt,
+	FieldEscalatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "disputes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"lock_payment_order_disputes",
+	"payment_order_disputes",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusAwaitingProviderEvidence is the default value of the Status enum.
+const DefaultStatus = StatusAwaitingProviderEvidence
+
+// Status values.
+const (
+	StatusOpen                     Status = "open"
+	StatusAwaitingProviderEvidence Status = "awaiting_provider_evidence"
+	StatusResolved                 Status = "resolved"
+	StatusRejected                 Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusAwaitingProviderEvidence, StatusResolved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("dispute: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Dispute queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByProviderAtFault orders the results by the provider_at_fault field.
+func ByProviderAtFault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderAtFault, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByEvidenceDueAt orders the results by the evidence_due_at field.
+func ByEvidenceDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvidenceDueAt, opts...).ToFunc()
+}
+
+// ByResolutionDueAt orders the results by the resolution_due_at field.
+func ByResolutionDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionDueAt, opts...).ToFunc()
+}
+
+// ByEscalatedAt orders the results by the escalated_at field.
+func ByEscalatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalatedAt, opts...).ToFunc()
+}
+
+// ByLockPaymentOrderField orders the results by lock_payment_order field.
+func ByLockPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLockPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentOrderField orders the results by payment_order field.
+func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLockPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LockPaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+	)
+}
+func newPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+	)
+}
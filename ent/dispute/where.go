@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dispute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldReason, v))
+}
+
+// ProviderAtFault applies equality check predicate on the "provider_at_fault" field. It's identical to ProviderAtFaultEQ.
+func ProviderAtFault(v bool) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldProviderAtFault, v))
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// EvidenceDueAt applies equality check predicate on the "evidence_due_at" field. It's identical to EvidenceDueAtEQ.
+func EvidenceDueAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldEvidenceDueAt, v))
+}
+
+// ResolutionDueAt applies equality check predicate on the "resolution_due_at" field. It's identical to ResolutionDueAtEQ.
+func ResolutionDueAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionDueAt, v))
+}
+
+// EscalatedAt applies equality check predicate on the "escalated_at" field. It's identical to EscalatedAtEQ.
+func EscalatedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContainsFold(FieldReason, v))
+}
+
+// EvidenceIsNil applies the IsNil predicate on the "evidence" field.
+func EvidenceIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldEvidence))
+}
+
+// EvidenceNotNil applies the NotNil predicate on the "evidence" field.
+func EvidenceNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldEvidence))
+}
+
+// ProviderAtFaultEQ applies the EQ predicate on the "provider_at_fault" field.
+func ProviderAtFaultEQ(v bool) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldProviderAtFault, v))
+}
+
+// ProviderAtFaultNEQ applies the NEQ predicate on the "provider_at_fault" field.
+func ProviderAtFaultNEQ(v bool) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldProviderAtFault, v))
+}
+
+// ProviderAtFaultIsNil applies the IsNil predicate on the "provider_at_fault" field.
+func ProviderAtFaultIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldProviderAtFault))
+}
+
+// ProviderAtFaultNotNil applies the NotNil predicate on the "provider_at_fault" field.
+func ProviderAtFaultNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldProviderAtFault))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolution, v))
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolution, v))
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolution, v))
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolution, v))
+}
+
+// ResolutionContains applies the Contains predicate on the "resolution" field.
+func ResolutionContains(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContains(FieldResolution, v))
+}
+
+// ResolutionHasPrefix applies the HasPrefix predicate on the "resolution" field.
+func ResolutionHasPrefix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasPrefix(FieldResolution, v))
+}
+
+// ResolutionHasSuffix applies the HasSuffix predicate on the "resolution" field.
+func ResolutionHasSuffix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasSuffix(FieldResolution, v))
+}
+
+// ResolutionIsNil applies the IsNil predicate on the "resolution" field.
+func ResolutionIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldResolution))
+}
+
+// ResolutionNotNil applies the NotNil predicate on the "resolution" field.
+func ResolutionNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldResolution))
+}
+
+// ResolutionEqualFold applies the EqualFold predicate on the "resolution" field.
+func ResolutionEqualFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEqualFold(FieldResolution, v))
+}
+
+// ResolutionContainsFold applies the ContainsFold predicate on the "resolution" field.
+func ResolutionContainsFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContainsFold(FieldResolution, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// EvidenceDueAtEQ applies the EQ predicate on the "evidence_due_at" field.
+func EvidenceDueAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtNEQ applies the NEQ predicate on the "evidence_due_at" field.
+func EvidenceDueAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtIn applies the In predicate on the "evidence_due_at" field.
+func EvidenceDueAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldEvidenceDueAt, vs...))
+}
+
+// EvidenceDueAtNotIn applies the NotIn predicate on the "evidence_due_at" field.
+func EvidenceDueAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldEvidenceDueAt, vs...))
+}
+
+// EvidenceDueAtGT applies the GT predicate on the "evidence_due_at" field.
+func EvidenceDueAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtGTE applies the GTE predicate on the "evidence_due_at" field.
+func EvidenceDueAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtLT applies the LT predicate on the "evidence_due_at" field.
+func EvidenceDueAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtLTE applies the LTE predicate on the "evidence_due_at" field.
+func EvidenceDueAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldEvidenceDueAt, v))
+}
+
+// EvidenceDueAtIsNil applies the IsNil predicate on the "evidence_due_at" field.
+func EvidenceDueAtIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldEvidenceDueAt))
+}
+
+// EvidenceDueAtNotNil applies the NotNil predicate on the "evidence_due_at" field.
+func EvidenceDueAtNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldEvidenceDueAt))
+}
+
+// ResolutionDueAtEQ applies the EQ predicate on the "resolution_due_at" field.
+func ResolutionDueAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionDueAt, v))
+}
+
+// ResolutionDueAtNEQ applies the NEQ predicate on the "resolution_due_at" field.
+func ResolutionDueAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolutionDueAt, v))
+}
+
+// ResolutionDueAtIn applies the In predicate on the "resolution_due_at" field.
+func ResolutionDueAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolutionDueAt, vs...))
+}
+
+// ResolutionDueAtNotIn applies the NotIn predicate on the "resolution_due_at" field.
+func ResolutionDueAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolutionDueAt, vs...))
+}
+
+// ResolutionDueAtGT applies the GT predicate on the "resolution_due_at" field.
+func ResolutionDueAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolutionDueAt, v))
+}
+
+// ResolutionDueAtGTE applies the GTE predicate on the "resolution_due_at" field.
+func ResolutionDueAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolutionDueAt, v))
+}
+
+// ResolutionDueAtLT applies the LT predicate on the "resolution_due_at" field.
+func ResolutionDueAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolutionDueAt, v))
+}
+
+// ResolutionDueAtLTE applies the LTE predicate on the "resolution_due_at" field.
+func ResolutionDueAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolutionDueAt, v))
+}
+
+// EscalatedAtEQ applies the EQ predicate on the "escalated_at" field.
+func EscalatedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtNEQ applies the NEQ predicate on the "escalated_at" field.
+func EscalatedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIn applies the In predicate on the "escalated_at" field.
+func EscalatedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtNotIn applies the NotIn predicate on the "escalated_at" field.
+func EscalatedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtGT applies the GT predicate on the "escalated_at" field.
+func EscalatedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtGTE applies the GTE predicate on the "escalated_at" field.
+func EscalatedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLT applies the LT predicate on the "escalated_at" field.
+func EscalatedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLTE applies the LTE predicate on the "escalated_at" field.
+func EscalatedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIsNil applies the IsNil predicate on the "escalated_at" field.
+func EscalatedAtIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldEscalatedAt))
+}
+
+// EscalatedAtNotNil applies the NotNil predicate on the "escalated_at" field.
+func EscalatedAtNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldEscalatedAt))
+}
+
+// HasLockPaymentOrder applies the HasEdge predicate on the "lock_payment_order" edge.
+func HasLockPaymentOrder() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockPaymentOrderWith applies the HasEdge predicate on the "lock_payment_order" edge with a given conditions (other predicates).
+func HasLockPaymentOrderWith(preds ...predicate.LockPaymentOrder) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newLockPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.NotPredicates(p))
+}
@@ -0,0 +1,1281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+)
+
+// DisputeCreate is the builder for creating a Dispute entity.
+type DisputeCreate struct {
+	config
+	mutation *DisputeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DisputeCreate) SetCreatedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableCreatedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DisputeCreate) SetUpdatedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableUpdatedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DisputeCreate) SetStatus(d dispute.Status) *DisputeCreate {
+	dc.mutation.SetStatus(d)
+	return dc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableStatus(d *dispute.Status) *DisputeCreate {
+	if d != nil {
+		dc.SetStatus(*d)
+	}
+	return dc
+}
+
+// SetReason sets the "reason" field.
+func (dc *DisputeCreate) SetReason(s string) *DisputeCreate {
+	dc.mutation.SetReason(s)
+	return dc
+}
+
+// SetEvidence sets the "evidence" field.
+func (dc *DisputeCreate) SetEvidence(saa []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeCreate {
+	dc.mutation.SetEvidence(saa)
+	return dc
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (dc *DisputeCreate) SetProviderAtFault(b bool) *DisputeCreate {
+	dc.mutation.SetProviderAtFault(b)
+	return dc
+}
+
+// SetNillableProviderAtFault sets the "provider_at_fault" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableProviderAtFault(b *bool) *DisputeCreate {
+	if b != nil {
+		dc.SetProviderAtFault(*b)
+	}
+	return dc
+}
+
+// SetResolution sets the "resolution" field.
+func (dc *DisputeCreate) SetResolution(s string) *DisputeCreate {
+	dc.mutation.SetResolution(s)
+	return dc
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableResolution(s *string) *DisputeCreate {
+	if s != nil {
+		dc.SetResolution(*s)
+	}
+	return dc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dc *DisputeCreate) SetResolvedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetResolvedAt(t)
+	return dc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableResolvedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetResolvedAt(*t)
+	}
+	return dc
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (dc *DisputeCreate) SetEvidenceDueAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetEvidenceDueAt(t)
+	return dc
+}
+
+// SetNillableEvidenceDueAt sets the "evidence_due_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableEvidenceDueAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetEvidenceDueAt(*t)
+	}
+	return dc
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (dc *DisputeCreate) SetResolutionDueAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetResolutionDueAt(t)
+	return dc
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (dc *DisputeCreate) SetEscalatedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetEscalatedAt(t)
+	return dc
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableEscalatedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetEscalatedAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DisputeCreate) SetID(u uuid.UUID) *DisputeCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableID(u *uuid.UUID) *DisputeCreate {
+	if u != nil {
+		dc.SetID(*u)
+	}
+	return dc
+}
+
+// SetLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID.
+func (dc *DisputeCreate) SetLockPaymentOrderID(id uuid.UUID) *DisputeCreate {
+	dc.mutation.SetLockPaymentOrderID(id)
+	return dc
+}
+
+// SetLockPaymentOrder sets the "lock_payment_order" edge to the LockPaymentOrder entity.
+func (dc *DisputeCreate) SetLockPaymentOrder(l *LockPaymentOrder) *DisputeCreate {
+	return dc.SetLockPaymentOrderID(l.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (dc *DisputeCreate) SetPaymentOrderID(id uuid.UUID) *DisputeCreate {
+	dc.mutation.SetPaymentOrderID(id)
+	return dc
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (dc *DisputeCreate) SetNillablePaymentOrderID(id *uuid.UUID) *DisputeCreate {
+	if id != nil {
+		dc = dc.SetPaymentOrderID(*id)
+	}
+	return dc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (dc *DisputeCreate) SetPaymentOrder(p *PaymentOrder) *DisputeCreate {
+	return dc.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (dc *DisputeCreate) Mutation() *DisputeMutation {
+	return dc.mutation
+}
+
+// Save creates the Dispute in the database.
+func (dc *DisputeCreate) Save(ctx context.Context) (*Dispute, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DisputeCreate) SaveX(ctx context.Context) *Dispute {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DisputeCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DisputeCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DisputeCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := dispute.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := dispute.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		v := dispute.DefaultStatus
+		dc.mutation.SetStatus(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := dispute.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DisputeCreate) check() error {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Dispute.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Dispute.updated_at"`)}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Dispute.status"`)}
+	}
+	if v, ok := dc.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Dispute.reason"`)}
+	}
+	if _, ok := dc.mutation.ResolutionDueAt(); !ok {
+		return &ValidationError{Name: "resolution_due_at", err: errors.New(`ent: missing required field "Dispute.resolution_due_at"`)}
+	}
+	if len(dc.mutation.LockPaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "lock_payment_order", err: errors.New(`ent: missing required edge "Dispute.lock_payment_order"`)}
+	}
+	return nil
+}
+
+func (dc *DisputeCreate) sqlSave(ctx context.Context) (*Dispute, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DisputeCreate) createSpec() (*Dispute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Dispute{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(dispute.Table, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dc.conflict
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(dispute.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := dc.mutation.Evidence(); ok {
+		_spec.SetField(dispute.FieldEvidence, field.TypeJSON, value)
+		_node.Evidence = value
+	}
+	if value, ok := dc.mutation.ProviderAtFault(); ok {
+		_spec.SetField(dispute.FieldProviderAtFault, field.TypeBool, value)
+		_node.ProviderAtFault = &value
+	}
+	if value, ok := dc.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeString, value)
+		_node.Resolution = value
+	}
+	if value, ok := dc.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := dc.mutation.EvidenceDueAt(); ok {
+		_spec.SetField(dispute.FieldEvidenceDueAt, field.TypeTime, value)
+		_node.EvidenceDueAt = &value
+	}
+	if value, ok := dc.mutation.ResolutionDueAt(); ok {
+		_spec.SetField(dispute.FieldResolutionDueAt, field.TypeTime, value)
+		_node.ResolutionDueAt = value
+	}
+	if value, ok := dc.mutation.EscalatedAt(); ok {
+		_spec.SetField(dispute.FieldEscalatedAt, field.TypeTime, value)
+		_node.EscalatedAt = &value
+	}
+	if nodes := dc.mutation.LockPaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dispute.LockPaymentOrderTable,
+			Columns: []string{dispute.LockPaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_disputes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dispute.PaymentOrderTable,
+			Columns: []string{dispute.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_disputes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dispute.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DisputeCreate) OnConflict(opts ...sql.ConflictOption) *DisputeUpsertOne {
+	dc.conflict = opts
+	return &DisputeUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DisputeCreate) OnConflictColumns(columns ...string) *DisputeUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DisputeUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DisputeUpsertOne is the builder for "upsert"-ing
+	//  one Dispute node.
+	DisputeUpsertOne struct {
+		create *DisputeCreate
+	}
+
+	// DisputeUpsert is the "OnConflict" setter.
+	DisputeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsert) SetUpdatedAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateUpdatedAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsert) SetStatus(v dispute.Status) *DisputeUpsert {
+	u.Set(dispute.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateStatus() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldStatus)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsert) SetReason(v string) *DisputeUpsert {
+	u.Set(dispute.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateReason() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldReason)
+	return u
+}
+
+// SetEvidence sets the "evidence" field.
+func (u *DisputeUpsert) SetEvidence(v []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpsert {
+	u.Set(dispute.FieldEvidence, v)
+	return u
+}
+
+// UpdateEvidence sets the "evidence" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateEvidence() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldEvidence)
+	return u
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (u *DisputeUpsert) ClearEvidence() *DisputeUpsert {
+	u.SetNull(dispute.FieldEvidence)
+	return u
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (u *DisputeUpsert) SetProviderAtFault(v bool) *DisputeUpsert {
+	u.Set(dispute.FieldProviderAtFault, v)
+	return u
+}
+
+// UpdateProviderAtFault sets the "provider_at_fault" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateProviderAtFault() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldProviderAtFault)
+	return u
+}
+
+// ClearProviderAtFault clears the value of the "provider_at_fault" field.
+func (u *DisputeUpsert) ClearProviderAtFault() *DisputeUpsert {
+	u.SetNull(dispute.FieldProviderAtFault)
+	return u
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsert) SetResolution(v string) *DisputeUpsert {
+	u.Set(dispute.FieldResolution, v)
+	return u
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolution() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolution)
+	return u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsert) ClearResolution() *DisputeUpsert {
+	u.SetNull(dispute.FieldResolution)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsert) SetResolvedAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolvedAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsert) ClearResolvedAt() *DisputeUpsert {
+	u.SetNull(dispute.FieldResolvedAt)
+	return u
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (u *DisputeUpsert) SetEvidenceDueAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldEvidenceDueAt, v)
+	return u
+}
+
+// UpdateEvidenceDueAt sets the "evidence_due_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateEvidenceDueAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldEvidenceDueAt)
+	return u
+}
+
+// ClearEvidenceDueAt clears the value of the "evidence_due_at" field.
+func (u *DisputeUpsert) ClearEvidenceDueAt() *DisputeUpsert {
+	u.SetNull(dispute.FieldEvidenceDueAt)
+	return u
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (u *DisputeUpsert) SetResolutionDueAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldResolutionDueAt, v)
+	return u
+}
+
+// UpdateResolutionDueAt sets the "resolution_due_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolutionDueAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolutionDueAt)
+	return u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *DisputeUpsert) SetEscalatedAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldEscalatedAt, v)
+	return u
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateEscalatedAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldEscalatedAt)
+	return u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *DisputeUpsert) ClearEscalatedAt() *DisputeUpsert {
+	u.SetNull(dispute.FieldEscalatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dispute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeUpsertOne) UpdateNewValues() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dispute.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dispute.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DisputeUpsertOne) Ignore() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeUpsertOne) DoNothing() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeCreate.OnConflict
+// documentation for more info.
+func (u *DisputeUpsertOne) Update(set func(*DisputeUpsert)) *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsertOne) SetUpdatedAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateUpdatedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsertOne) SetStatus(v dispute.Status) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateStatus() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsertOne) SetReason(v string) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateReason() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetEvidence sets the "evidence" field.
+func (u *DisputeUpsertOne) SetEvidence(v []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEvidence(v)
+	})
+}
+
+// UpdateEvidence sets the "evidence" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateEvidence() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEvidence()
+	})
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (u *DisputeUpsertOne) ClearEvidence() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEvidence()
+	})
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (u *DisputeUpsertOne) SetProviderAtFault(v bool) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetProviderAtFault(v)
+	})
+}
+
+// UpdateProviderAtFault sets the "provider_at_fault" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateProviderAtFault() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateProviderAtFault()
+	})
+}
+
+// ClearProviderAtFault clears the value of the "provider_at_fault" field.
+func (u *DisputeUpsertOne) ClearProviderAtFault() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearProviderAtFault()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsertOne) SetResolution(v string) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolution() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsertOne) ClearResolution() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsertOne) SetResolvedAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolvedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsertOne) ClearResolvedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (u *DisputeUpsertOne) SetEvidenceDueAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEvidenceDueAt(v)
+	})
+}
+
+// UpdateEvidenceDueAt sets the "evidence_due_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateEvidenceDueAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEvidenceDueAt()
+	})
+}
+
+// ClearEvidenceDueAt clears the value of the "evidence_due_at" field.
+func (u *DisputeUpsertOne) ClearEvidenceDueAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEvidenceDueAt()
+	})
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (u *DisputeUpsertOne) SetResolutionDueAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionDueAt(v)
+	})
+}
+
+// UpdateResolutionDueAt sets the "resolution_due_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolutionDueAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionDueAt()
+	})
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *DisputeUpsertOne) SetEscalatedAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateEscalatedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *DisputeUpsertOne) ClearEscalatedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DisputeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DisputeUpsertOne.ID is not supported by MySQL driver. Use DisputeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DisputeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DisputeCreateBulk is the builder for creating many Dispute entities in bulk.
+type DisputeCreateBulk struct {
+	config
+	err      error
+	builders []*DisputeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Dispute entities in the database.
+func (dcb *DisputeCreateBulk) Save(ctx context.Context) ([]*Dispute, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Dispute, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DisputeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DisputeCreateBulk) SaveX(ctx context.Context) []*Dispute {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DisputeCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DisputeCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dispute.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DisputeCreateBulk) OnConflict(opts ...sql.ConflictOption) *DisputeUpsertBulk {
+	dcb.conflict = opts
+	return &DisputeUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DisputeCreateBulk) OnConflictColumns(columns ...string) *DisputeUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DisputeUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DisputeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Dispute nodes.
+type DisputeUpsertBulk struct {
+	create *DisputeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dispute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeUpsertBulk) UpdateNewValues() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dispute.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dispute.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DisputeUpsertBulk) Ignore() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeUpsertBulk) DoNothing() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeCreateBulk.OnConflict
+// documentation for more info.
+func (u *DisputeUpsertBulk) Update(set func(*DisputeUpsert)) *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsertBulk) SetUpdatedAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateUpdatedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsertBulk) SetStatus(v dispute.Status) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateStatus() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsertBulk) SetReason(v string) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateReason() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetEvidence sets the "evidence" field.
+func (u *DisputeUpsertBulk) SetEvidence(v []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEvidence(v)
+	})
+}
+
+// UpdateEvidence sets the "evidence" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateEvidence() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEvidence()
+	})
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (u *DisputeUpsertBulk) ClearEvidence() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEvidence()
+	})
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (u *DisputeUpsertBulk) SetProviderAtFault(v bool) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetProviderAtFault(v)
+	})
+}
+
+// UpdateProviderAtFault sets the "provider_at_fault" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateProviderAtFault() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateProviderAtFault()
+	})
+}
+
+// ClearProviderAtFault clears the value of the "provider_at_fault" field.
+func (u *DisputeUpsertBulk) ClearProviderAtFault() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearProviderAtFault()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsertBulk) SetResolution(v string) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolution() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsertBulk) ClearResolution() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsertBulk) SetResolvedAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolvedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsertBulk) ClearResolvedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (u *DisputeUpsertBulk) SetEvidenceDueAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEvidenceDueAt(v)
+	})
+}
+
+// UpdateEvidenceDueAt sets the "evidence_due_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateEvidenceDueAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEvidenceDueAt()
+	})
+}
+
+// ClearEvidenceDueAt clears the value of the "evidence_due_at" field.
+func (u *DisputeUpsertBulk) ClearEvidenceDueAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEvidenceDueAt()
+	})
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (u *DisputeUpsertBulk) SetResolutionDueAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionDueAt(v)
+	})
+}
+
+// UpdateResolutionDueAt sets the "resolution_due_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolutionDueAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionDueAt()
+	})
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *DisputeUpsertBulk) SetEscalatedAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateEscalatedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *DisputeUpsertBulk) ClearEscalatedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DisputeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DisputeUpdate is the builder for updating Dispute entities.
+type DisputeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DisputeMutation
+}
+
+// Where appends a list predicates to the DisputeUpdate builder.
+func (du *DisputeUpdate) Where(ps ...predicate.Dispute) *DisputeUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DisputeUpdate) SetUpdatedAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DisputeUpdate) SetStatus(d dispute.Status) *DisputeUpdate {
+	du.mutation.SetStatus(d)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableStatus(d *dispute.Status) *DisputeUpdate {
+	if d != nil {
+		du.SetStatus(*d)
+	}
+	return du
+}
+
+// SetReason sets the "reason" field.
+func (du *DisputeUpdate) SetReason(s string) *DisputeUpdate {
+	du.mutation.SetReason(s)
+	return du
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableReason(s *string) *DisputeUpdate {
+	if s != nil {
+		du.SetReason(*s)
+	}
+	return du
+}
+
+// SetEvidence sets the "evidence" field.
+func (du *DisputeUpdate) SetEvidence(saa []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpdate {
+	du.mutation.SetEvidence(saa)
+	return du
+}
+
+// AppendEvidence appends saa to the "evidence" field.
+func (du *DisputeUpdate) AppendEvidence(saa []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpdate {
+	du.mutation.AppendEvidence(saa)
+	return du
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (du *DisputeUpdate) ClearEvidence() *DisputeUpdate {
+	du.mutation.ClearEvidence()
+	return du
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (du *DisputeUpdate) SetProviderAtFault(b bool) *DisputeUpdate {
+	du.mutation.SetProviderAtFault(b)
+	return du
+}
+
+// SetNillableProviderAtFault sets the "provider_at_fault" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableProviderAtFault(b *bool) *DisputeUpdate {
+	if b != nil {
+		du.SetProviderAtFault(*b)
+	}
+	return du
+}
+
+// ClearProviderAtFault clears the value of the "provider_at_fault" field.
+func (du *DisputeUpdate) ClearProviderAtFault() *DisputeUpdate {
+	du.mutation.ClearProviderAtFault()
+	return du
+}
+
+// SetResolution sets the "resolution" field.
+func (du *DisputeUpdate) SetResolution(s string) *DisputeUpdate {
+	du.mutation.SetResolution(s)
+	return du
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolution(s *string) *DisputeUpdate {
+	if s != nil {
+		du.SetResolution(*s)
+	}
+	return du
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (du *DisputeUpdate) ClearResolution() *DisputeUpdate {
+	du.mutation.ClearResolution()
+	return du
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (du *DisputeUpdate) SetResolvedAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetResolvedAt(t)
+	return du
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolvedAt(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetResolvedAt(*t)
+	}
+	return du
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (du *DisputeUpdate) ClearResolvedAt() *DisputeUpdate {
+	du.mutation.ClearResolvedAt()
+	return du
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (du *DisputeUpdate) SetEvidenceDueAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetEvidenceDueAt(t)
+	return du
+}
+
+// SetNillableEvidenceDueAt sets the "evidence_due_at" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableEvidenceDueAt(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetEvidenceDueAt(*t)
+	}
+	return du
+}
+
+// ClearEvidenceDueAt clears the value of the "evidence_due_at" field.
+func (du *DisputeUpdate) ClearEvidenceDueAt() *DisputeUpdate {
+	du.mutation.ClearEvidenceDueAt()
+	return du
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (du *DisputeUpdate) SetResolutionDueAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetResolutionDueAt(t)
+	return du
+}
+
+// SetNillableResolutionDueAt sets the "resolution_due_at" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolutionDueAt(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetResolutionDueAt(*t)
+	}
+	return du
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (du *DisputeUpdate) SetEscalatedAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetEscalatedAt(t)
+	return du
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableEscalatedAt(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetEscalatedAt(*t)
+	}
+	return du
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (du *DisputeUpdate) ClearEscalatedAt() *DisputeUpdate {
+	du.mutation.ClearEscalatedAt()
+	return du
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (du *DisputeUpdate) Mutation() *DisputeMutation {
+	return du.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DisputeUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DisputeUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DisputeUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DisputeUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DisputeUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := dispute.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DisputeUpdate) check() error {
+	if v, ok := du.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if du.mutation.LockPaymentOrderCleared() && len(du.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Dispute.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (du *DisputeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dispute.Table, dispute.Columns, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Evidence(); ok {
+		_spec.SetField(dispute.FieldEvidence, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedEvidence(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dispute.FieldEvidence, value)
+		})
+	}
+	if du.mutation.EvidenceCleared() {
+		_spec.ClearField(dispute.FieldEvidence, field.TypeJSON)
+	}
+	if value, ok := du.mutation.ProviderAtFault(); ok {
+		_spec.SetField(dispute.FieldProviderAtFault, field.TypeBool, value)
+	}
+	if du.mutation.ProviderAtFaultCleared() {
+		_spec.ClearField(dispute.FieldProviderAtFault, field.TypeBool)
+	}
+	if value, ok := du.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeString, value)
+	}
+	if du.mutation.ResolutionCleared() {
+		_spec.ClearField(dispute.FieldResolution, field.TypeString)
+	}
+	if value, ok := du.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+	}
+	if du.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dispute.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.EvidenceDueAt(); ok {
+		_spec.SetField(dispute.FieldEvidenceDueAt, field.TypeTime, value)
+	}
+	if du.mutation.EvidenceDueAtCleared() {
+		_spec.ClearField(dispute.FieldEvidenceDueAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.ResolutionDueAt(); ok {
+		_spec.SetField(dispute.FieldResolutionDueAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.EscalatedAt(); ok {
+		_spec.SetField(dispute.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if du.mutation.EscalatedAtCleared() {
+		_spec.ClearField(dispute.FieldEscalatedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dispute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DisputeUpdateOne is the builder for updating a single Dispute entity.
+type DisputeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DisputeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DisputeUpdateOne) SetUpdatedAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DisputeUpdateOne) SetStatus(d dispute.Status) *DisputeUpdateOne {
+	duo.mutation.SetStatus(d)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableStatus(d *dispute.Status) *DisputeUpdateOne {
+	if d != nil {
+		duo.SetStatus(*d)
+	}
+	return duo
+}
+
+// SetReason sets the "reason" field.
+func (duo *DisputeUpdateOne) SetReason(s string) *DisputeUpdateOne {
+	duo.mutation.SetReason(s)
+	return duo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableReason(s *string) *DisputeUpdateOne {
+	if s != nil {
+		duo.SetReason(*s)
+	}
+	return duo
+}
+
+// SetEvidence sets the "evidence" field.
+func (duo *DisputeUpdateOne) SetEvidence(saa []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpdateOne {
+	duo.mutation.SetEvidence(saa)
+	return duo
+}
+
+// AppendEvidence appends saa to the "evidence" field.
+func (duo *DisputeUpdateOne) AppendEvidence(saa []struct {
+	Type        string    "json:\"type\""
+	Reference   string    "json:\"reference\""
+	URL         string    "json:\"url\""
+	Note        string    "json:\"note\""
+	SubmittedAt time.Time "json:\"submittedAt\""
+}) *DisputeUpdateOne {
+	duo.mutation.AppendEvidence(saa)
+	return duo
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (duo *DisputeUpdateOne) ClearEvidence() *DisputeUpdateOne {
+	duo.mutation.ClearEvidence()
+	return duo
+}
+
+// SetProviderAtFault sets the "provider_at_fault" field.
+func (duo *DisputeUpdateOne) SetProviderAtFault(b bool) *DisputeUpdateOne {
+	duo.mutation.SetProviderAtFault(b)
+	return duo
+}
+
+// SetNillableProviderAtFault sets the "provider_at_fault" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableProviderAtFault(b *bool) *DisputeUpdateOne {
+	if b != nil {
+		duo.SetProviderAtFault(*b)
+	}
+	return duo
+}
+
+// ClearProviderAtFault clears the value of the "provider_at_fault" field.
+func (duo *DisputeUpdateOne) ClearProviderAtFault() *DisputeUpdateOne {
+	duo.mutation.ClearProviderAtFault()
+	return duo
+}
+
+// SetResolution sets the "resolution" field.
+func (duo *DisputeUpdateOne) SetResolution(s string) *DisputeUpdateOne {
+	duo.mutation.SetResolution(s)
+	return duo
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolution(s *string) *DisputeUpdateOne {
+	if s != nil {
+		duo.SetResolution(*s)
+	}
+	return duo
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (duo *DisputeUpdateOne) ClearResolution() *DisputeUpdateOne {
+	duo.mutation.ClearResolution()
+	return duo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (duo *DisputeUpdateOne) SetResolvedAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetResolvedAt(t)
+	return duo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolvedAt(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetResolvedAt(*t)
+	}
+	return duo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (duo *DisputeUpdateOne) ClearResolvedAt() *DisputeUpdateOne {
+	duo.mutation.ClearResolvedAt()
+	return duo
+}
+
+// SetEvidenceDueAt sets the "evidence_due_at" field.
+func (duo *DisputeUpdateOne) SetEvidenceDueAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetEvidenceDueAt(t)
+	return duo
+}
+
+// SetNillableEvidenceDueAt sets the "evidence_due_at" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableEvidenceDueAt(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetEvidenceDueAt(*t)
+	}
+	return duo
+}
+
+// ClearEvidenceDueAt clears the value of the "evidence_due_at" field.
+func (duo *DisputeUpdateOne) ClearEvidenceDueAt() *DisputeUpdateOne {
+	duo.mutation.ClearEvidenceDueAt()
+	return duo
+}
+
+// SetResolutionDueAt sets the "resolution_due_at" field.
+func (duo *DisputeUpdateOne) SetResolutionDueAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetResolutionDueAt(t)
+	return duo
+}
+
+// SetNillableResolutionDueAt sets the "resolution_due_at" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolutionDueAt(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetResolutionDueAt(*t)
+	}
+	return duo
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (duo *DisputeUpdateOne) SetEscalatedAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetEscalatedAt(t)
+	return duo
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableEscalatedAt(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetEscalatedAt(*t)
+	}
+	return duo
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (duo *DisputeUpdateOne) ClearEscalatedAt() *DisputeUpdateOne {
+	duo.mutation.ClearEscalatedAt()
+	return duo
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (duo *DisputeUpdateOne) Mutation() *DisputeMutation {
+	return duo.mutation
+}
+
+// Where appends a list predicates to the DisputeUpdate builder.
+func (duo *DisputeUpdateOne) Where(ps ...predicate.Dispute) *DisputeUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DisputeUpdateOne) Select(field string, fields ...string) *DisputeUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Dispute entity.
+func (duo *DisputeUpdateOne) Save(ctx context.Context) (*Dispute, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DisputeUpdateOne) SaveX(ctx context.Context) *Dispute {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DisputeUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DisputeUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DisputeUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := dispute.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DisputeUpdateOne) check() error {
+	if v, ok := duo.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if duo.mutation.LockPaymentOrderCleared() && len(duo.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Dispute.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (duo *DisputeUpdateOne) sqlSave(ctx context.Context) (_node *Dispute, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dispute.Table, dispute.Columns, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Dispute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dispute.FieldID)
+		for _, f := range fields {
+			if !dispute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dispute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Evidence(); ok {
+		_spec.SetField(dispute.FieldEvidence, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedEvidence(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dispute.FieldEvidence, value)
+		})
+	}
+	if duo.mutation.EvidenceCleared() {
+		_spec.ClearField(dispute.FieldEvidence, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.ProviderAtFault(); ok {
+		_spec.SetField(dispute.FieldProviderAtFault, field.TypeBool, value)
+	}
+	if duo.mutation.ProviderAtFaultCleared() {
+		_spec.ClearField(dispute.FieldProviderAtFault, field.TypeBool)
+	}
+	if value, ok := duo.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeString, value)
+	}
+	if duo.mutation.ResolutionCleared() {
+		_spec.ClearField(dispute.FieldResolution, field.TypeString)
+	}
+	if value, ok := duo.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dispute.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.EvidenceDueAt(); ok {
+		_spec.SetField(dispute.FieldEvidenceDueAt, field.TypeTime, value)
+	}
+	if duo.mutation.EvidenceDueAtCleared() {
+		_spec.ClearField(dispute.FieldEvidenceDueAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.ResolutionDueAt(); ok {
+		_spec.SetField(dispute.FieldResolutionDueAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.EscalatedAt(); ok {
+		_spec.SetField(dispute.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.EscalatedAtCleared() {
+		_spec.ClearField(dispute.FieldEscalatedAt, field.TypeTime)
+	}
+	_node = &Dispute{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dispute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
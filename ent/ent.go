@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -21,14 +23,22 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
@@ -95,6 +105,8 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apikey.Table:                      apikey.ValidColumn,
+			beneficiary.Table:                 beneficiary.ValidColumn,
+			dispute.Table:                     dispute.ValidColumn,
 			fiatcurrency.Table:                fiatcurrency.ValidColumn,
 			identityverificationrequest.Table: identityverificationrequest.ValidColumn,
 			institution.Table:                 institution.ValidColumn,
@@ -103,14 +115,22 @@ func checkColumn(table, column string) error {
 			lockpaymentorder.Table:            lockpaymentorder.ValidColumn,
 			network.Table:                     network.ValidColumn,
 			paymentorder.Table:                paymentorder.ValidColumn,
+			paymentorderbatch.Table:           paymentorderbatch.ValidColumn,
 			paymentorderrecipient.Table:       paymentorderrecipient.ValidColumn,
+			paymentordersplit.Table:           paymentordersplit.ValidColumn,
+			providerfiatbalance.Table:         providerfiatbalance.ValidColumn,
 			providerordertoken.Table:          providerordertoken.ValidColumn,
 			providerprofile.Table:             providerprofile.ValidColumn,
+			providerrateexclusion.Table:       providerrateexclusion.ValidColumn,
 			providerrating.Table:              providerrating.ValidColumn,
 			provisionbucket.Table:             provisionbucket.ValidColumn,
 			receiveaddress.Table:              receiveaddress.ValidColumn,
+			scheduledorder.Table:              scheduledorder.ValidColumn,
+			scheduledorderrun.Table:           scheduledorderrun.ValidColumn,
+			senderfeetier.Table:               senderfeetier.ValidColumn,
 			senderordertoken.Table:            senderordertoken.ValidColumn,
 			senderprofile.Table:               senderprofile.ValidColumn,
+			teammember.Table:                  teammember.ValidColumn,
 			token.Table:                       token.ValidColumn,
 			transactionlog.Table:              transactionlog.ValidColumn,
 			user.Table:                        user.ValidColumn,
@@ -51,9 +51,11 @@ type FiatCurrencyEdges struct {
 	ProvisionBuckets []*ProvisionBucket `json:"provision_buckets,omitempty"`
 	// Institutions holds the value of the institutions edge.
 	Institutions []*Institution `json:"institutions,omitempty"`
+	// ProviderBalances holds the value of the provider_balances edge.
+	ProviderBalances []*ProviderFiatBalance `json:"provider_balances,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ProvidersOrErr returns the Providers value or an error if the edge
@@ -83,6 +85,15 @@ func (e FiatCurrencyEdges) InstitutionsOrErr() ([]*Institution, error) {
 	return nil, &NotLoadedError{edge: "institutions"}
 }
 
+// ProviderBalancesOrErr returns the ProviderBalances value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) ProviderBalancesOrErr() ([]*ProviderFiatBalance, error) {
+	if e.loadedTypes[3] {
+		return e.ProviderBalances, nil
+	}
+	return nil, &NotLoadedError{edge: "provider_balances"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*FiatCurrency) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -203,6 +214,11 @@ func (fc *FiatCurrency) QueryInstitutions() *InstitutionQuery {
 	return NewFiatCurrencyClient(fc.config).QueryInstitutions(fc)
 }
 
+// QueryProviderBalances queries the "provider_balances" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryProviderBalances() *ProviderFiatBalanceQuery {
+	return NewFiatCurrencyClient(fc.config).QueryProviderBalances(fc)
+}
+
 // Update returns a builder for updating this FiatCurrency.
 // Note that you need to call FiatCurrency.Unwrap() before calling this method if this FiatCurrency
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -39,6 +39,8 @@ const (
 	EdgeProvisionBuckets = "provision_buckets"
 	// EdgeInstitutions holds the string denoting the institutions edge name in mutations.
 	EdgeInstitutions = "institutions"
+	// EdgeProviderBalances holds the string denoting the provider_balances edge name in mutations.
+	EdgeProviderBalances = "provider_balances"
 	// Table holds the table name of the fiatcurrency in the database.
 	Table = "fiat_currencies"
 	// ProvidersTable is the table that holds the providers relation/edge.
@@ -62,6 +64,13 @@ const (
 	InstitutionsInverseTable = "institutions"
 	// InstitutionsColumn is the table column denoting the institutions relation/edge.
 	InstitutionsColumn = "fiat_currency_institutions"
+	// ProviderBalancesTable is the table that holds the provider_balances relation/edge.
+	ProviderBalancesTable = "provider_fiat_balances"
+	// ProviderBalancesInverseTable is the table name for the ProviderFiatBalance entity.
+	// It exists in this package in order to avoid circular dependency with the "providerfiatbalance" package.
+	ProviderBalancesInverseTable = "provider_fiat_balances"
+	// ProviderBalancesColumn is the table column denoting the provider_balances relation/edge.
+	ProviderBalancesColumn = "fiat_currency_provider_balances"
 )
 
 // Columns holds all SQL columns for fiatcurrency fields.
@@ -197,6 +206,20 @@ func ByInstitutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInstitutionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByProviderBalancesCount orders the results by provider_balances count.
+func ByProviderBalancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProviderBalancesStep(), opts...)
+	}
+}
+
+// ByProviderBalances orders the results by provider_balances terms.
+func ByProviderBalances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderBalancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProvidersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -218,3 +241,10 @@ func newInstitutionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InstitutionsTable, InstitutionsColumn),
 	)
 }
+func newProviderBalancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderBalancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProviderBalancesTable, ProviderBalancesColumn),
+	)
+}
@@ -601,6 +601,29 @@ func HasInstitutionsWith(preds ...predicate.Institution) predicate.FiatCurrency
 	})
 }
 
+// HasProviderBalances applies the HasEdge predicate on the "provider_balances" edge.
+func HasProviderBalances() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProviderBalancesTable, ProviderBalancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderBalancesWith applies the HasEdge predicate on the "provider_balances" edge with a given conditions (other predicates).
+func HasProviderBalancesWith(preds ...predicate.ProviderFiatBalance) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newProviderBalancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FiatCurrency) predicate.FiatCurrency {
 	return predicate.FiatCurrency(sql.AndPredicates(predicates...))
@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/shopspring/decimal"
@@ -173,6 +174,21 @@ func (fcc *FiatCurrencyCreate) AddInstitutions(i ...*Institution) *FiatCurrencyC
 	return fcc.AddInstitutionIDs(ids...)
 }
 
+// AddProviderBalanceIDs adds the "provider_balances" edge to the ProviderFiatBalance entity by IDs.
+func (fcc *FiatCurrencyCreate) AddProviderBalanceIDs(ids ...uuid.UUID) *FiatCurrencyCreate {
+	fcc.mutation.AddProviderBalanceIDs(ids...)
+	return fcc
+}
+
+// AddProviderBalances adds the "provider_balances" edges to the ProviderFiatBalance entity.
+func (fcc *FiatCurrencyCreate) AddProviderBalances(p ...*ProviderFiatBalance) *FiatCurrencyCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcc.AddProviderBalanceIDs(ids...)
+}
+
 // Mutation returns the FiatCurrencyMutation object of the builder.
 func (fcc *FiatCurrencyCreate) Mutation() *FiatCurrencyMutation {
 	return fcc.mutation
@@ -379,6 +395,22 @@ func (fcc *FiatCurrencyCreate) createSpec() (*FiatCurrency, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fcc.mutation.ProviderBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
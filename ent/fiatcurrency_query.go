@@ -16,6 +16,7 @@ import (
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 )
@@ -30,6 +31,7 @@ type FiatCurrencyQuery struct {
 	withProviders        *ProviderProfileQuery
 	withProvisionBuckets *ProvisionBucketQuery
 	withInstitutions     *InstitutionQuery
+	withProviderBalances *ProviderFiatBalanceQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +134,28 @@ func (fcq *FiatCurrencyQuery) QueryInstitutions() *InstitutionQuery {
 	return query
 }
 
+// QueryProviderBalances chains the current query on the "provider_balances" edge.
+func (fcq *FiatCurrencyQuery) QueryProviderBalances() *ProviderFiatBalanceQuery {
+	query := (&ProviderFiatBalanceClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(providerfiatbalance.Table, providerfiatbalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderBalancesTable, fiatcurrency.ProviderBalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first FiatCurrency entity from the query.
 // Returns a *NotFoundError when no FiatCurrency was found.
 func (fcq *FiatCurrencyQuery) First(ctx context.Context) (*FiatCurrency, error) {
@@ -327,6 +351,7 @@ func (fcq *FiatCurrencyQuery) Clone() *FiatCurrencyQuery {
 		withProviders:        fcq.withProviders.Clone(),
 		withProvisionBuckets: fcq.withProvisionBuckets.Clone(),
 		withInstitutions:     fcq.withInstitutions.Clone(),
+		withProviderBalances: fcq.withProviderBalances.Clone(),
 		// clone intermediate query.
 		sql:  fcq.sql.Clone(),
 		path: fcq.path,
@@ -366,6 +391,17 @@ func (fcq *FiatCurrencyQuery) WithInstitutions(opts ...func(*InstitutionQuery))
 	return fcq
 }
 
+// WithProviderBalances tells the query-builder to eager-load the nodes that are connected to
+// the "provider_balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithProviderBalances(opts ...func(*ProviderFiatBalanceQuery)) *FiatCurrencyQuery {
+	query := (&ProviderFiatBalanceClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withProviderBalances = query
+	return fcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +480,11 @@ func (fcq *FiatCurrencyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*FiatCurrency{}
 		_spec       = fcq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			fcq.withProviders != nil,
 			fcq.withProvisionBuckets != nil,
 			fcq.withInstitutions != nil,
+			fcq.withProviderBalances != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -491,6 +528,15 @@ func (fcq *FiatCurrencyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := fcq.withProviderBalances; query != nil {
+		if err := fcq.loadProviderBalances(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.ProviderBalances = []*ProviderFiatBalance{} },
+			func(n *FiatCurrency, e *ProviderFiatBalance) {
+				n.Edges.ProviderBalances = append(n.Edges.ProviderBalances, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -587,6 +633,37 @@ func (fcq *FiatCurrencyQuery) loadInstitutions(ctx context.Context, query *Insti
 	}
 	return nil
 }
+func (fcq *FiatCurrencyQuery) loadProviderBalances(ctx context.Context, query *ProviderFiatBalanceQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *ProviderFiatBalance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.ProviderBalancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_provider_balances
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_provider_balances" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_provider_balances" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (fcq *FiatCurrencyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fcq.querySpec()
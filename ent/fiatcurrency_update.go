@@ -11,9 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/shopspring/decimal"
@@ -195,6 +197,21 @@ func (fcu *FiatCurrencyUpdate) AddInstitutions(i ...*Institution) *FiatCurrencyU
 	return fcu.AddInstitutionIDs(ids...)
 }
 
+// AddProviderBalanceIDs adds the "provider_balances" edge to the ProviderFiatBalance entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddProviderBalanceIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.AddProviderBalanceIDs(ids...)
+	return fcu
+}
+
+// AddProviderBalances adds the "provider_balances" edges to the ProviderFiatBalance entity.
+func (fcu *FiatCurrencyUpdate) AddProviderBalances(p ...*ProviderFiatBalance) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.AddProviderBalanceIDs(ids...)
+}
+
 // Mutation returns the FiatCurrencyMutation object of the builder.
 func (fcu *FiatCurrencyUpdate) Mutation() *FiatCurrencyMutation {
 	return fcu.mutation
@@ -263,6 +280,27 @@ func (fcu *FiatCurrencyUpdate) RemoveInstitutions(i ...*Institution) *FiatCurren
 	return fcu.RemoveInstitutionIDs(ids...)
 }
 
+// ClearProviderBalances clears all "provider_balances" edges to the ProviderFiatBalance entity.
+func (fcu *FiatCurrencyUpdate) ClearProviderBalances() *FiatCurrencyUpdate {
+	fcu.mutation.ClearProviderBalances()
+	return fcu
+}
+
+// RemoveProviderBalanceIDs removes the "provider_balances" edge to ProviderFiatBalance entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemoveProviderBalanceIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.RemoveProviderBalanceIDs(ids...)
+	return fcu
+}
+
+// RemoveProviderBalances removes "provider_balances" edges to ProviderFiatBalance entities.
+func (fcu *FiatCurrencyUpdate) RemoveProviderBalances(p ...*ProviderFiatBalance) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.RemoveProviderBalanceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fcu *FiatCurrencyUpdate) Save(ctx context.Context) (int, error) {
 	fcu.defaults()
@@ -473,6 +511,51 @@ func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fcu.mutation.ProviderBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RemovedProviderBalancesIDs(); len(nodes) > 0 && !fcu.mutation.ProviderBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.ProviderBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fiatcurrency.Label}
@@ -656,6 +739,21 @@ func (fcuo *FiatCurrencyUpdateOne) AddInstitutions(i ...*Institution) *FiatCurre
 	return fcuo.AddInstitutionIDs(ids...)
 }
 
+// AddProviderBalanceIDs adds the "provider_balances" edge to the ProviderFiatBalance entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddProviderBalanceIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddProviderBalanceIDs(ids...)
+	return fcuo
+}
+
+// AddProviderBalances adds the "provider_balances" edges to the ProviderFiatBalance entity.
+func (fcuo *FiatCurrencyUpdateOne) AddProviderBalances(p ...*ProviderFiatBalance) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.AddProviderBalanceIDs(ids...)
+}
+
 // Mutation returns the FiatCurrencyMutation object of the builder.
 func (fcuo *FiatCurrencyUpdateOne) Mutation() *FiatCurrencyMutation {
 	return fcuo.mutation
@@ -724,6 +822,27 @@ func (fcuo *FiatCurrencyUpdateOne) RemoveInstitutions(i ...*Institution) *FiatCu
 	return fcuo.RemoveInstitutionIDs(ids...)
 }
 
+// ClearProviderBalances clears all "provider_balances" edges to the ProviderFiatBalance entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearProviderBalances() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearProviderBalances()
+	return fcuo
+}
+
+// RemoveProviderBalanceIDs removes the "provider_balances" edge to ProviderFiatBalance entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemoveProviderBalanceIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemoveProviderBalanceIDs(ids...)
+	return fcuo
+}
+
+// RemoveProviderBalances removes "provider_balances" edges to ProviderFiatBalance entities.
+func (fcuo *FiatCurrencyUpdateOne) RemoveProviderBalances(p ...*ProviderFiatBalance) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.RemoveProviderBalanceIDs(ids...)
+}
+
 // Where appends a list predicates to the FiatCurrencyUpdate builder.
 func (fcuo *FiatCurrencyUpdateOne) Where(ps ...predicate.FiatCurrency) *FiatCurrencyUpdateOne {
 	fcuo.mutation.Where(ps...)
@@ -964,6 +1083,51 @@ func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fcuo.mutation.ProviderBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedProviderBalancesIDs(); len(nodes) > 0 && !fcuo.mutation.ProviderBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.ProviderBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderBalancesTable,
+			Columns: []string{fiatcurrency.ProviderBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &FiatCurrency{config: fcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
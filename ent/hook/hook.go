@@ -21,6 +21,30 @@ func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
 }
 
+// The BeneficiaryFunc type is an adapter to allow the use of ordinary
+// function as Beneficiary mutator.
+type BeneficiaryFunc func(context.Context, *ent.BeneficiaryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BeneficiaryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BeneficiaryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BeneficiaryMutation", m)
+}
+
+// The DisputeFunc type is an adapter to allow the use of ordinary
+// function as Dispute mutator.
+type DisputeFunc func(context.Context, *ent.DisputeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DisputeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DisputeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DisputeMutation", m)
+}
+
 // The FiatCurrencyFunc type is an adapter to allow the use of ordinary
 // function as FiatCurrency mutator.
 type FiatCurrencyFunc func(context.Context, *ent.FiatCurrencyMutation) (ent.Value, error)
@@ -117,6 +141,18 @@ func (f PaymentOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentOrderMutation", m)
 }
 
+// The PaymentOrderBatchFunc type is an adapter to allow the use of ordinary
+// function as PaymentOrderBatch mutator.
+type PaymentOrderBatchFunc func(context.Context, *ent.PaymentOrderBatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentOrderBatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentOrderBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentOrderBatchMutation", m)
+}
+
 // The PaymentOrderRecipientFunc type is an adapter to allow the use of ordinary
 // function as PaymentOrderRecipient mutator.
 type PaymentOrderRecipientFunc func(context.Context, *ent.PaymentOrderRecipientMutation) (ent.Value, error)
@@ -129,6 +165,30 @@ func (f PaymentOrderRecipientFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentOrderRecipientMutation", m)
 }
 
+// The PaymentOrderSplitFunc type is an adapter to allow the use of ordinary
+// function as PaymentOrderSplit mutator.
+type PaymentOrderSplitFunc func(context.Context, *ent.PaymentOrderSplitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentOrderSplitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentOrderSplitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentOrderSplitMutation", m)
+}
+
+// The ProviderFiatBalanceFunc type is an adapter to allow the use of ordinary
+// function as ProviderFiatBalance mutator.
+type ProviderFiatBalanceFunc func(context.Context, *ent.ProviderFiatBalanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderFiatBalanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderFiatBalanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderFiatBalanceMutation", m)
+}
+
 // The ProviderOrderTokenFunc type is an adapter to allow the use of ordinary
 // function as ProviderOrderToken mutator.
 type ProviderOrderTokenFunc func(context.Context, *ent.ProviderOrderTokenMutation) (ent.Value, error)
@@ -153,6 +213,18 @@ func (f ProviderProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderProfileMutation", m)
 }
 
+// The ProviderRateExclusionFunc type is an adapter to allow the use of ordinary
+// function as ProviderRateExclusion mutator.
+type ProviderRateExclusionFunc func(context.Context, *ent.ProviderRateExclusionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderRateExclusionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderRateExclusionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderRateExclusionMutation", m)
+}
+
 // The ProviderRatingFunc type is an adapter to allow the use of ordinary
 // function as ProviderRating mutator.
 type ProviderRatingFunc func(context.Context, *ent.ProviderRatingMutation) (ent.Value, error)
@@ -189,6 +261,42 @@ func (f ReceiveAddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiveAddressMutation", m)
 }
 
+// The ScheduledOrderFunc type is an adapter to allow the use of ordinary
+// function as ScheduledOrder mutator.
+type ScheduledOrderFunc func(context.Context, *ent.ScheduledOrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledOrderMutation", m)
+}
+
+// The ScheduledOrderRunFunc type is an adapter to allow the use of ordinary
+// function as ScheduledOrderRun mutator.
+type ScheduledOrderRunFunc func(context.Context, *ent.ScheduledOrderRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledOrderRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledOrderRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledOrderRunMutation", m)
+}
+
+// The SenderFeeTierFunc type is an adapter to allow the use of ordinary
+// function as SenderFeeTier mutator.
+type SenderFeeTierFunc func(context.Context, *ent.SenderFeeTierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SenderFeeTierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SenderFeeTierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderFeeTierMutation", m)
+}
+
 // The SenderOrderTokenFunc type is an adapter to allow the use of ordinary
 // function as SenderOrderToken mutator.
 type SenderOrderTokenFunc func(context.Context, *ent.SenderOrderTokenMutation) (ent.Value, error)
@@ -213,6 +321,18 @@ func (f SenderProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderProfileMutation", m)
 }
 
+// The TeamMemberFunc type is an adapter to allow the use of ordinary
+// function as TeamMember mutator.
+type TeamMemberFunc func(context.Context, *ent.TeamMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamMemberMutation", m)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary
 // function as Token mutator.
 type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
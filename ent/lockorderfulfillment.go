@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/shopspring/decimal"
 )
 
 // LockOrderFulfillment is the model entity for the LockOrderFulfillment schema.
@@ -27,6 +28,8 @@ type LockOrderFulfillment struct {
 	TxID string `json:"tx_id,omitempty"`
 	// Psp holds the value of the "psp" field.
 	Psp string `json:"psp,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
 	// ValidationStatus holds the value of the "validation_status" field.
 	ValidationStatus lockorderfulfillment.ValidationStatus `json:"validation_status,omitempty"`
 	// ValidationError holds the value of the "validation_error" field.
@@ -63,6 +66,8 @@ func (*LockOrderFulfillment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case lockorderfulfillment.FieldAmount:
+			values[i] = new(decimal.Decimal)
 		case lockorderfulfillment.FieldTxID, lockorderfulfillment.FieldPsp, lockorderfulfillment.FieldValidationStatus, lockorderfulfillment.FieldValidationError:
 			values[i] = new(sql.NullString)
 		case lockorderfulfillment.FieldCreatedAt, lockorderfulfillment.FieldUpdatedAt:
@@ -116,6 +121,12 @@ func (lof *LockOrderFulfillment) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				lof.Psp = value.String
 			}
+		case lockorderfulfillment.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				lof.Amount = *value
+			}
 		case lockorderfulfillment.FieldValidationStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field validation_status", values[i])
@@ -188,6 +199,9 @@ func (lof *LockOrderFulfillment) String() string {
 	builder.WriteString("psp=")
 	builder.WriteString(lof.Psp)
 	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", lof.Amount))
+	builder.WriteString(", ")
 	builder.WriteString("validation_status=")
 	builder.WriteString(fmt.Sprintf("%v", lof.ValidationStatus))
 	builder.WriteString(", ")
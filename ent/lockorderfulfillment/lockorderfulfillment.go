@@ -24,6 +24,8 @@ const (
 	FieldTxID = "tx_id"
 	// FieldPsp holds the string denoting the psp field in the database.
 	FieldPsp = "psp"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
 	// FieldValidationStatus holds the string denoting the validation_status field in the database.
 	FieldValidationStatus = "validation_status"
 	// FieldValidationError holds the string denoting the validation_error field in the database.
@@ -48,6 +50,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldTxID,
 	FieldPsp,
+	FieldAmount,
 	FieldValidationStatus,
 	FieldValidationError,
 }
@@ -139,6 +142,11 @@ func ByPsp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPsp, opts...).ToFunc()
 }
 
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
 // ByValidationStatus orders the results by the validation_status field.
 func ByValidationStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldValidationStatus, opts...).ToFunc()
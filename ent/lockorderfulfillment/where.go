@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -76,6 +77,11 @@ func Psp(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldPsp, v))
 }
 
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldAmount, v))
+}
+
 // ValidationError applies equality check predicate on the "validation_error" field. It's identical to ValidationErrorEQ.
 func ValidationError(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldValidationError, v))
@@ -216,6 +222,16 @@ func TxIDHasSuffix(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldHasSuffix(FieldTxID, v))
 }
 
+// TxIDIsNil applies the IsNil predicate on the "tx_id" field.
+func TxIDIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldTxID))
+}
+
+// TxIDNotNil applies the NotNil predicate on the "tx_id" field.
+func TxIDNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldTxID))
+}
+
 // TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
 func TxIDEqualFold(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEqualFold(FieldTxID, v))
@@ -301,6 +317,46 @@ func PspContainsFold(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldPsp, v))
 }
 
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldAmount, v))
+}
+
 // ValidationStatusEQ applies the EQ predicate on the "validation_status" field.
 func ValidationStatusEQ(v ValidationStatus) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldValidationStatus, v))
@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/shopspring/decimal"
 )
 
 // LockOrderFulfillmentCreate is the builder for creating a LockOrderFulfillment entity.
@@ -59,6 +60,14 @@ func (lofc *LockOrderFulfillmentCreate) SetTxID(s string) *LockOrderFulfillmentC
 	return lofc
 }
 
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillableTxID(s *string) *LockOrderFulfillmentCreate {
+	if s != nil {
+		lofc.SetTxID(*s)
+	}
+	return lofc
+}
+
 // SetPsp sets the "psp" field.
 func (lofc *LockOrderFulfillmentCreate) SetPsp(s string) *LockOrderFulfillmentCreate {
 	lofc.mutation.SetPsp(s)
@@ -73,6 +82,12 @@ func (lofc *LockOrderFulfillmentCreate) SetNillablePsp(s *string) *LockOrderFulf
 	return lofc
 }
 
+// SetAmount sets the "amount" field.
+func (lofc *LockOrderFulfillmentCreate) SetAmount(d decimal.Decimal) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetAmount(d)
+	return lofc
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (lofc *LockOrderFulfillmentCreate) SetValidationStatus(ls lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentCreate {
 	lofc.mutation.SetValidationStatus(ls)
@@ -187,8 +202,8 @@ func (lofc *LockOrderFulfillmentCreate) check() error {
 	if _, ok := lofc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LockOrderFulfillment.updated_at"`)}
 	}
-	if _, ok := lofc.mutation.TxID(); !ok {
-		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "LockOrderFulfillment.tx_id"`)}
+	if _, ok := lofc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LockOrderFulfillment.amount"`)}
 	}
 	if _, ok := lofc.mutation.ValidationStatus(); !ok {
 		return &ValidationError{Name: "validation_status", err: errors.New(`ent: missing required field "LockOrderFulfillment.validation_status"`)}
@@ -253,6 +268,10 @@ func (lofc *LockOrderFulfillmentCreate) createSpec() (*LockOrderFulfillment, *sq
 		_spec.SetField(lockorderfulfillment.FieldPsp, field.TypeString, value)
 		_node.Psp = value
 	}
+	if value, ok := lofc.mutation.Amount(); ok {
+		_spec.SetField(lockorderfulfillment.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
 	if value, ok := lofc.mutation.ValidationStatus(); ok {
 		_spec.SetField(lockorderfulfillment.FieldValidationStatus, field.TypeEnum, value)
 		_node.ValidationStatus = value
@@ -354,6 +373,12 @@ func (u *LockOrderFulfillmentUpsert) UpdateTxID() *LockOrderFulfillmentUpsert {
 	return u
 }
 
+// ClearTxID clears the value of the "tx_id" field.
+func (u *LockOrderFulfillmentUpsert) ClearTxID() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldTxID)
+	return u
+}
+
 // SetPsp sets the "psp" field.
 func (u *LockOrderFulfillmentUpsert) SetPsp(v string) *LockOrderFulfillmentUpsert {
 	u.Set(lockorderfulfillment.FieldPsp, v)
@@ -372,6 +397,24 @@ func (u *LockOrderFulfillmentUpsert) ClearPsp() *LockOrderFulfillmentUpsert {
 	return u
 }
 
+// SetAmount sets the "amount" field.
+func (u *LockOrderFulfillmentUpsert) SetAmount(v decimal.Decimal) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateAmount() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LockOrderFulfillmentUpsert) AddAmount(v decimal.Decimal) *LockOrderFulfillmentUpsert {
+	u.Add(lockorderfulfillment.FieldAmount, v)
+	return u
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (u *LockOrderFulfillmentUpsert) SetValidationStatus(v lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentUpsert {
 	u.Set(lockorderfulfillment.FieldValidationStatus, v)
@@ -481,6 +524,13 @@ func (u *LockOrderFulfillmentUpsertOne) UpdateTxID() *LockOrderFulfillmentUpsert
 	})
 }
 
+// ClearTxID clears the value of the "tx_id" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearTxID() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearTxID()
+	})
+}
+
 // SetPsp sets the "psp" field.
 func (u *LockOrderFulfillmentUpsertOne) SetPsp(v string) *LockOrderFulfillmentUpsertOne {
 	return u.Update(func(s *LockOrderFulfillmentUpsert) {
@@ -502,6 +552,27 @@ func (u *LockOrderFulfillmentUpsertOne) ClearPsp() *LockOrderFulfillmentUpsertOn
 	})
 }
 
+// SetAmount sets the "amount" field.
+func (u *LockOrderFulfillmentUpsertOne) SetAmount(v decimal.Decimal) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LockOrderFulfillmentUpsertOne) AddAmount(v decimal.Decimal) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateAmount() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (u *LockOrderFulfillmentUpsertOne) SetValidationStatus(v lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentUpsertOne {
 	return u.Update(func(s *LockOrderFulfillmentUpsert) {
@@ -783,6 +854,13 @@ func (u *LockOrderFulfillmentUpsertBulk) UpdateTxID() *LockOrderFulfillmentUpser
 	})
 }
 
+// ClearTxID clears the value of the "tx_id" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearTxID() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearTxID()
+	})
+}
+
 // SetPsp sets the "psp" field.
 func (u *LockOrderFulfillmentUpsertBulk) SetPsp(v string) *LockOrderFulfillmentUpsertBulk {
 	return u.Update(func(s *LockOrderFulfillmentUpsert) {
@@ -804,6 +882,27 @@ func (u *LockOrderFulfillmentUpsertBulk) ClearPsp() *LockOrderFulfillmentUpsertB
 	})
 }
 
+// SetAmount sets the "amount" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetAmount(v decimal.Decimal) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LockOrderFulfillmentUpsertBulk) AddAmount(v decimal.Decimal) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateAmount() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (u *LockOrderFulfillmentUpsertBulk) SetValidationStatus(v lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentUpsertBulk {
 	return u.Update(func(s *LockOrderFulfillmentUpsert) {
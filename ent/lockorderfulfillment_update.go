@@ -15,6 +15,7 @@ import (
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // LockOrderFulfillmentUpdate is the builder for updating LockOrderFulfillment entities.
@@ -50,6 +51,12 @@ func (lofu *LockOrderFulfillmentUpdate) SetNillableTxID(s *string) *LockOrderFul
 	return lofu
 }
 
+// ClearTxID clears the value of the "tx_id" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearTxID() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearTxID()
+	return lofu
+}
+
 // SetPsp sets the "psp" field.
 func (lofu *LockOrderFulfillmentUpdate) SetPsp(s string) *LockOrderFulfillmentUpdate {
 	lofu.mutation.SetPsp(s)
@@ -70,6 +77,27 @@ func (lofu *LockOrderFulfillmentUpdate) ClearPsp() *LockOrderFulfillmentUpdate {
 	return lofu
 }
 
+// SetAmount sets the "amount" field.
+func (lofu *LockOrderFulfillmentUpdate) SetAmount(d decimal.Decimal) *LockOrderFulfillmentUpdate {
+	lofu.mutation.ResetAmount()
+	lofu.mutation.SetAmount(d)
+	return lofu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillableAmount(d *decimal.Decimal) *LockOrderFulfillmentUpdate {
+	if d != nil {
+		lofu.SetAmount(*d)
+	}
+	return lofu
+}
+
+// AddAmount adds d to the "amount" field.
+func (lofu *LockOrderFulfillmentUpdate) AddAmount(d decimal.Decimal) *LockOrderFulfillmentUpdate {
+	lofu.mutation.AddAmount(d)
+	return lofu
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (lofu *LockOrderFulfillmentUpdate) SetValidationStatus(ls lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentUpdate {
 	lofu.mutation.SetValidationStatus(ls)
@@ -193,12 +221,21 @@ func (lofu *LockOrderFulfillmentUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := lofu.mutation.TxID(); ok {
 		_spec.SetField(lockorderfulfillment.FieldTxID, field.TypeString, value)
 	}
+	if lofu.mutation.TxIDCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldTxID, field.TypeString)
+	}
 	if value, ok := lofu.mutation.Psp(); ok {
 		_spec.SetField(lockorderfulfillment.FieldPsp, field.TypeString, value)
 	}
 	if lofu.mutation.PspCleared() {
 		_spec.ClearField(lockorderfulfillment.FieldPsp, field.TypeString)
 	}
+	if value, ok := lofu.mutation.Amount(); ok {
+		_spec.SetField(lockorderfulfillment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lofu.mutation.AddedAmount(); ok {
+		_spec.AddField(lockorderfulfillment.FieldAmount, field.TypeFloat64, value)
+	}
 	if value, ok := lofu.mutation.ValidationStatus(); ok {
 		_spec.SetField(lockorderfulfillment.FieldValidationStatus, field.TypeEnum, value)
 	}
@@ -277,6 +314,12 @@ func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableTxID(s *string) *LockOrde
 	return lofuo
 }
 
+// ClearTxID clears the value of the "tx_id" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearTxID() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearTxID()
+	return lofuo
+}
+
 // SetPsp sets the "psp" field.
 func (lofuo *LockOrderFulfillmentUpdateOne) SetPsp(s string) *LockOrderFulfillmentUpdateOne {
 	lofuo.mutation.SetPsp(s)
@@ -297,6 +340,27 @@ func (lofuo *LockOrderFulfillmentUpdateOne) ClearPsp() *LockOrderFulfillmentUpda
 	return lofuo
 }
 
+// SetAmount sets the "amount" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetAmount(d decimal.Decimal) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ResetAmount()
+	lofuo.mutation.SetAmount(d)
+	return lofuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableAmount(d *decimal.Decimal) *LockOrderFulfillmentUpdateOne {
+	if d != nil {
+		lofuo.SetAmount(*d)
+	}
+	return lofuo
+}
+
+// AddAmount adds d to the "amount" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) AddAmount(d decimal.Decimal) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.AddAmount(d)
+	return lofuo
+}
+
 // SetValidationStatus sets the "validation_status" field.
 func (lofuo *LockOrderFulfillmentUpdateOne) SetValidationStatus(ls lockorderfulfillment.ValidationStatus) *LockOrderFulfillmentUpdateOne {
 	lofuo.mutation.SetValidationStatus(ls)
@@ -450,12 +514,21 @@ func (lofuo *LockOrderFulfillmentUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := lofuo.mutation.TxID(); ok {
 		_spec.SetField(lockorderfulfillment.FieldTxID, field.TypeString, value)
 	}
+	if lofuo.mutation.TxIDCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldTxID, field.TypeString)
+	}
 	if value, ok := lofuo.mutation.Psp(); ok {
 		_spec.SetField(lockorderfulfillment.FieldPsp, field.TypeString, value)
 	}
 	if lofuo.mutation.PspCleared() {
 		_spec.ClearField(lockorderfulfillment.FieldPsp, field.TypeString)
 	}
+	if value, ok := lofuo.mutation.Amount(); ok {
+		_spec.SetField(lockorderfulfillment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lofuo.mutation.AddedAmount(); ok {
+		_spec.AddField(lockorderfulfillment.FieldAmount, field.TypeFloat64, value)
+	}
 	if value, ok := lofuo.mutation.ValidationStatus(); ok {
 		_spec.SetField(lockorderfulfillment.FieldValidationStatus, field.TypeEnum, value)
 	}
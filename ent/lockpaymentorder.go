@@ -35,6 +35,8 @@ type LockPaymentOrder struct {
 	Rate decimal.Decimal `json:"rate,omitempty"`
 	// OrderPercent holds the value of the "order_percent" field.
 	OrderPercent decimal.Decimal `json:"order_percent,omitempty"`
+	// AmountFulfilled holds the value of the "amount_fulfilled" field.
+	AmountFulfilled decimal.Decimal `json:"amount_fulfilled,omitempty"`
 	// TxHash holds the value of the "tx_hash" field.
 	TxHash string `json:"tx_hash,omitempty"`
 	// Status holds the value of the "status" field.
@@ -53,6 +55,8 @@ type LockPaymentOrder struct {
 	CancellationCount int `json:"cancellation_count,omitempty"`
 	// CancellationReasons holds the value of the "cancellation_reasons" field.
 	CancellationReasons []string `json:"cancellation_reasons,omitempty"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LockPaymentOrderQuery when eager-loading is set.
 	Edges                                LockPaymentOrderEdges `json:"edges"`
@@ -74,9 +78,11 @@ type LockPaymentOrderEdges struct {
 	Fulfillments []*LockOrderFulfillment `json:"fulfillments,omitempty"`
 	// Transactions holds the value of the transactions edge.
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
+	// Disputes holds the value of the disputes edge.
+	Disputes []*Dispute `json:"disputes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // TokenOrErr returns the Token value or an error if the edge
@@ -130,6 +136,15 @@ func (e LockPaymentOrderEdges) TransactionsOrErr() ([]*TransactionLog, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// DisputesOrErr returns the Disputes value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) DisputesOrErr() ([]*Dispute, error) {
+	if e.loadedTypes[5] {
+		return e.Disputes, nil
+	}
+	return nil, &NotLoadedError{edge: "disputes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LockPaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -137,13 +152,13 @@ func (*LockPaymentOrder) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case lockpaymentorder.FieldCancellationReasons:
 			values[i] = new([]byte)
-		case lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate, lockpaymentorder.FieldOrderPercent:
+		case lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate, lockpaymentorder.FieldOrderPercent, lockpaymentorder.FieldAmountFulfilled:
 			values[i] = new(decimal.Decimal)
 		case lockpaymentorder.FieldBlockNumber, lockpaymentorder.FieldCancellationCount:
 			values[i] = new(sql.NullInt64)
 		case lockpaymentorder.FieldGatewayID, lockpaymentorder.FieldTxHash, lockpaymentorder.FieldStatus, lockpaymentorder.FieldInstitution, lockpaymentorder.FieldAccountIdentifier, lockpaymentorder.FieldAccountName, lockpaymentorder.FieldMemo:
 			values[i] = new(sql.NullString)
-		case lockpaymentorder.FieldCreatedAt, lockpaymentorder.FieldUpdatedAt:
+		case lockpaymentorder.FieldCreatedAt, lockpaymentorder.FieldUpdatedAt, lockpaymentorder.FieldEscalatedAt:
 			values[i] = new(sql.NullTime)
 		case lockpaymentorder.FieldID:
 			values[i] = new(uuid.UUID)
@@ -210,6 +225,12 @@ func (lpo *LockPaymentOrder) assignValues(columns []string, values []any) error
 			} else if value != nil {
 				lpo.OrderPercent = *value
 			}
+		case lockpaymentorder.FieldAmountFulfilled:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_fulfilled", values[i])
+			} else if value != nil {
+				lpo.AmountFulfilled = *value
+			}
 		case lockpaymentorder.FieldTxHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
@@ -266,6 +287,13 @@ func (lpo *LockPaymentOrder) assignValues(columns []string, values []any) error
 					return fmt.Errorf("unmarshal field cancellation_reasons: %w", err)
 				}
 			}
+		case lockpaymentorder.FieldEscalatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field escalated_at", values[i])
+			} else if value.Valid {
+				lpo.EscalatedAt = new(time.Time)
+				*lpo.EscalatedAt = value.Time
+			}
 		case lockpaymentorder.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_assigned_orders", values[i])
@@ -325,6 +353,11 @@ func (lpo *LockPaymentOrder) QueryTransactions() *TransactionLogQuery {
 	return NewLockPaymentOrderClient(lpo.config).QueryTransactions(lpo)
 }
 
+// QueryDisputes queries the "disputes" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryDisputes() *DisputeQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryDisputes(lpo)
+}
+
 // Update returns a builder for updating this LockPaymentOrder.
 // Note that you need to call LockPaymentOrder.Unwrap() before calling this method if this LockPaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -366,6 +399,9 @@ func (lpo *LockPaymentOrder) String() string {
 	builder.WriteString("order_percent=")
 	builder.WriteString(fmt.Sprintf("%v", lpo.OrderPercent))
 	builder.WriteString(", ")
+	builder.WriteString("amount_fulfilled=")
+	builder.WriteString(fmt.Sprintf("%v", lpo.AmountFulfilled))
+	builder.WriteString(", ")
 	builder.WriteString("tx_hash=")
 	builder.WriteString(lpo.TxHash)
 	builder.WriteString(", ")
@@ -392,6 +428,11 @@ func (lpo *LockPaymentOrder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cancellation_reasons=")
 	builder.WriteString(fmt.Sprintf("%v", lpo.CancellationReasons))
+	builder.WriteString(", ")
+	if v := lpo.EscalatedAt; v != nil {
+		builder.WriteString("escalated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
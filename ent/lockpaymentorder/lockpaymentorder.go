@@ -28,6 +28,8 @@ const (
 	FieldRate = "rate"
 	// FieldOrderPercent holds the string denoting the order_percent field in the database.
 	FieldOrderPercent = "order_percent"
+	// FieldAmountFulfilled holds the string denoting the amount_fulfilled field in the database.
+	FieldAmountFulfilled = "amount_fulfilled"
 	// FieldTxHash holds the string denoting the tx_hash field in the database.
 	FieldTxHash = "tx_hash"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -46,6 +48,8 @@ const (
 	FieldCancellationCount = "cancellation_count"
 	// FieldCancellationReasons holds the string denoting the cancellation_reasons field in the database.
 	FieldCancellationReasons = "cancellation_reasons"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
 	// EdgeToken holds the string denoting the token edge name in mutations.
 	EdgeToken = "token"
 	// EdgeProvisionBucket holds the string denoting the provision_bucket edge name in mutations.
@@ -56,6 +60,8 @@ const (
 	EdgeFulfillments = "fulfillments"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
+	// EdgeDisputes holds the string denoting the disputes edge name in mutations.
+	EdgeDisputes = "disputes"
 	// Table holds the table name of the lockpaymentorder in the database.
 	Table = "lock_payment_orders"
 	// TokenTable is the table that holds the token relation/edge.
@@ -93,6 +99,13 @@ const (
 	TransactionsInverseTable = "transaction_logs"
 	// TransactionsColumn is the table column denoting the transactions relation/edge.
 	TransactionsColumn = "lock_payment_order_transactions"
+	// DisputesTable is the table that holds the disputes relation/edge.
+	DisputesTable = "disputes"
+	// DisputesInverseTable is the table name for the Dispute entity.
+	// It exists in this package in order to avoid circular dependency with the "dispute" package.
+	DisputesInverseTable = "disputes"
+	// DisputesColumn is the table column denoting the disputes relation/edge.
+	DisputesColumn = "lock_payment_order_disputes"
 )
 
 // Columns holds all SQL columns for lockpaymentorder fields.
@@ -104,6 +117,7 @@ var Columns = []string{
 	FieldAmount,
 	FieldRate,
 	FieldOrderPercent,
+	FieldAmountFulfilled,
 	FieldTxHash,
 	FieldStatus,
 	FieldBlockNumber,
@@ -113,6 +127,7 @@ var Columns = []string{
 	FieldMemo,
 	FieldCancellationCount,
 	FieldCancellationReasons,
+	FieldEscalatedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "lock_payment_orders"
@@ -224,6 +239,11 @@ func ByOrderPercent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderPercent, opts...).ToFunc()
 }
 
+// ByAmountFulfilled orders the results by the amount_fulfilled field.
+func ByAmountFulfilled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountFulfilled, opts...).ToFunc()
+}
+
 // ByTxHash orders the results by the tx_hash field.
 func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
@@ -264,6 +284,11 @@ func ByCancellationCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCancellationCount, opts...).ToFunc()
 }
 
+// ByEscalatedAt orders the results by the escalated_at field.
+func ByEscalatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalatedAt, opts...).ToFunc()
+}
+
 // ByTokenField orders the results by token field.
 func ByTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -312,6 +337,20 @@ func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDisputesCount orders the results by disputes count.
+func ByDisputesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisputesStep(), opts...)
+	}
+}
+
+// ByDisputes orders the results by disputes terms.
+func ByDisputes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisputesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTokenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -347,3 +386,10 @@ func newTransactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
 	)
 }
+func newDisputesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisputesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+	)
+}
@@ -87,6 +87,11 @@ func OrderPercent(v decimal.Decimal) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldOrderPercent, v))
 }
 
+// AmountFulfilled applies equality check predicate on the "amount_fulfilled" field. It's identical to AmountFulfilledEQ.
+func AmountFulfilled(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldAmountFulfilled, v))
+}
+
 // TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
 func TxHash(v string) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldTxHash, v))
@@ -122,6 +127,11 @@ func CancellationCount(v int) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldCancellationCount, v))
 }
 
+// EscalatedAt applies equality check predicate on the "escalated_at" field. It's identical to EscalatedAtEQ.
+func EscalatedAt(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -387,6 +397,46 @@ func OrderPercentLTE(v decimal.Decimal) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldLTE(FieldOrderPercent, v))
 }
 
+// AmountFulfilledEQ applies the EQ predicate on the "amount_fulfilled" field.
+func AmountFulfilledEQ(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldAmountFulfilled, v))
+}
+
+// AmountFulfilledNEQ applies the NEQ predicate on the "amount_fulfilled" field.
+func AmountFulfilledNEQ(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldAmountFulfilled, v))
+}
+
+// AmountFulfilledIn applies the In predicate on the "amount_fulfilled" field.
+func AmountFulfilledIn(vs ...decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldAmountFulfilled, vs...))
+}
+
+// AmountFulfilledNotIn applies the NotIn predicate on the "amount_fulfilled" field.
+func AmountFulfilledNotIn(vs ...decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldAmountFulfilled, vs...))
+}
+
+// AmountFulfilledGT applies the GT predicate on the "amount_fulfilled" field.
+func AmountFulfilledGT(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGT(FieldAmountFulfilled, v))
+}
+
+// AmountFulfilledGTE applies the GTE predicate on the "amount_fulfilled" field.
+func AmountFulfilledGTE(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGTE(FieldAmountFulfilled, v))
+}
+
+// AmountFulfilledLT applies the LT predicate on the "amount_fulfilled" field.
+func AmountFulfilledLT(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLT(FieldAmountFulfilled, v))
+}
+
+// AmountFulfilledLTE applies the LTE predicate on the "amount_fulfilled" field.
+func AmountFulfilledLTE(v decimal.Decimal) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLTE(FieldAmountFulfilled, v))
+}
+
 // TxHashEQ applies the EQ predicate on the "tx_hash" field.
 func TxHashEQ(v string) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldTxHash, v))
@@ -832,6 +882,56 @@ func CancellationCountLTE(v int) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldLTE(FieldCancellationCount, v))
 }
 
+// EscalatedAtEQ applies the EQ predicate on the "escalated_at" field.
+func EscalatedAtEQ(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtNEQ applies the NEQ predicate on the "escalated_at" field.
+func EscalatedAtNEQ(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIn applies the In predicate on the "escalated_at" field.
+func EscalatedAtIn(vs ...time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtNotIn applies the NotIn predicate on the "escalated_at" field.
+func EscalatedAtNotIn(vs ...time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtGT applies the GT predicate on the "escalated_at" field.
+func EscalatedAtGT(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtGTE applies the GTE predicate on the "escalated_at" field.
+func EscalatedAtGTE(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLT applies the LT predicate on the "escalated_at" field.
+func EscalatedAtLT(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLTE applies the LTE predicate on the "escalated_at" field.
+func EscalatedAtLTE(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIsNil applies the IsNil predicate on the "escalated_at" field.
+func EscalatedAtIsNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIsNull(FieldEscalatedAt))
+}
+
+// EscalatedAtNotNil applies the NotNil predicate on the "escalated_at" field.
+func EscalatedAtNotNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotNull(FieldEscalatedAt))
+}
+
 // HasToken applies the HasEdge predicate on the "token" edge.
 func HasToken() predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(func(s *sql.Selector) {
@@ -947,6 +1047,29 @@ func HasTransactionsWith(preds ...predicate.TransactionLog) predicate.LockPaymen
 	})
 }
 
+// HasDisputes applies the HasEdge predicate on the "disputes" edge.
+func HasDisputes() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDisputesWith applies the HasEdge predicate on the "disputes" edge with a given conditions (other predicates).
+func HasDisputesWith(preds ...predicate.Dispute) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := newDisputesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LockPaymentOrder) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/providerprofile"
@@ -82,6 +83,12 @@ func (lpoc *LockPaymentOrderCreate) SetOrderPercent(d decimal.Decimal) *LockPaym
 	return lpoc
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (lpoc *LockPaymentOrderCreate) SetAmountFulfilled(d decimal.Decimal) *LockPaymentOrderCreate {
+	lpoc.mutation.SetAmountFulfilled(d)
+	return lpoc
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (lpoc *LockPaymentOrderCreate) SetTxHash(s string) *LockPaymentOrderCreate {
 	lpoc.mutation.SetTxHash(s)
@@ -168,6 +175,20 @@ func (lpoc *LockPaymentOrderCreate) SetCancellationReasons(s []string) *LockPaym
 	return lpoc
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (lpoc *LockPaymentOrderCreate) SetEscalatedAt(t time.Time) *LockPaymentOrderCreate {
+	lpoc.mutation.SetEscalatedAt(t)
+	return lpoc
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableEscalatedAt(t *time.Time) *LockPaymentOrderCreate {
+	if t != nil {
+		lpoc.SetEscalatedAt(*t)
+	}
+	return lpoc
+}
+
 // SetID sets the "id" field.
 func (lpoc *LockPaymentOrderCreate) SetID(u uuid.UUID) *LockPaymentOrderCreate {
 	lpoc.mutation.SetID(u)
@@ -261,6 +282,21 @@ func (lpoc *LockPaymentOrderCreate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpoc.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddDisputeIDs(ids...)
+	return lpoc
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpoc *LockPaymentOrderCreate) AddDisputes(d ...*Dispute) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpoc.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpoc *LockPaymentOrderCreate) Mutation() *LockPaymentOrderMutation {
 	return lpoc.mutation
@@ -342,6 +378,9 @@ func (lpoc *LockPaymentOrderCreate) check() error {
 	if _, ok := lpoc.mutation.OrderPercent(); !ok {
 		return &ValidationError{Name: "order_percent", err: errors.New(`ent: missing required field "LockPaymentOrder.order_percent"`)}
 	}
+	if _, ok := lpoc.mutation.AmountFulfilled(); !ok {
+		return &ValidationError{Name: "amount_fulfilled", err: errors.New(`ent: missing required field "LockPaymentOrder.amount_fulfilled"`)}
+	}
 	if v, ok := lpoc.mutation.TxHash(); ok {
 		if err := lockpaymentorder.TxHashValidator(v); err != nil {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.tx_hash": %w`, err)}
@@ -436,6 +475,10 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		_spec.SetField(lockpaymentorder.FieldOrderPercent, field.TypeFloat64, value)
 		_node.OrderPercent = value
 	}
+	if value, ok := lpoc.mutation.AmountFulfilled(); ok {
+		_spec.SetField(lockpaymentorder.FieldAmountFulfilled, field.TypeFloat64, value)
+		_node.AmountFulfilled = value
+	}
 	if value, ok := lpoc.mutation.TxHash(); ok {
 		_spec.SetField(lockpaymentorder.FieldTxHash, field.TypeString, value)
 		_node.TxHash = value
@@ -472,6 +515,10 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		_spec.SetField(lockpaymentorder.FieldCancellationReasons, field.TypeJSON, value)
 		_node.CancellationReasons = value
 	}
+	if value, ok := lpoc.mutation.EscalatedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldEscalatedAt, field.TypeTime, value)
+		_node.EscalatedAt = &value
+	}
 	if nodes := lpoc.mutation.TokenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -555,6 +602,22 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lpoc.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -685,6 +748,24 @@ func (u *LockPaymentOrderUpsert) AddOrderPercent(v decimal.Decimal) *LockPayment
 	return u
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsert) SetAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldAmountFulfilled, v)
+	return u
+}
+
+// UpdateAmountFulfilled sets the "amount_fulfilled" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateAmountFulfilled() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldAmountFulfilled)
+	return u
+}
+
+// AddAmountFulfilled adds v to the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsert) AddAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsert {
+	u.Add(lockpaymentorder.FieldAmountFulfilled, v)
+	return u
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (u *LockPaymentOrderUpsert) SetTxHash(v string) *LockPaymentOrderUpsert {
 	u.Set(lockpaymentorder.FieldTxHash, v)
@@ -817,6 +898,24 @@ func (u *LockPaymentOrderUpsert) UpdateCancellationReasons() *LockPaymentOrderUp
 	return u
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *LockPaymentOrderUpsert) SetEscalatedAt(v time.Time) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldEscalatedAt, v)
+	return u
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateEscalatedAt() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldEscalatedAt)
+	return u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *LockPaymentOrderUpsert) ClearEscalatedAt() *LockPaymentOrderUpsert {
+	u.SetNull(lockpaymentorder.FieldEscalatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -959,6 +1058,27 @@ func (u *LockPaymentOrderUpsertOne) UpdateOrderPercent() *LockPaymentOrderUpsert
 	})
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsertOne) SetAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetAmountFulfilled(v)
+	})
+}
+
+// AddAmountFulfilled adds v to the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsertOne) AddAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.AddAmountFulfilled(v)
+	})
+}
+
+// UpdateAmountFulfilled sets the "amount_fulfilled" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateAmountFulfilled() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateAmountFulfilled()
+	})
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (u *LockPaymentOrderUpsertOne) SetTxHash(v string) *LockPaymentOrderUpsertOne {
 	return u.Update(func(s *LockPaymentOrderUpsert) {
@@ -1113,6 +1233,27 @@ func (u *LockPaymentOrderUpsertOne) UpdateCancellationReasons() *LockPaymentOrde
 	})
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *LockPaymentOrderUpsertOne) SetEscalatedAt(v time.Time) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateEscalatedAt() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *LockPaymentOrderUpsertOne) ClearEscalatedAt() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1422,6 +1563,27 @@ func (u *LockPaymentOrderUpsertBulk) UpdateOrderPercent() *LockPaymentOrderUpser
 	})
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsertBulk) SetAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetAmountFulfilled(v)
+	})
+}
+
+// AddAmountFulfilled adds v to the "amount_fulfilled" field.
+func (u *LockPaymentOrderUpsertBulk) AddAmountFulfilled(v decimal.Decimal) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.AddAmountFulfilled(v)
+	})
+}
+
+// UpdateAmountFulfilled sets the "amount_fulfilled" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateAmountFulfilled() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateAmountFulfilled()
+	})
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (u *LockPaymentOrderUpsertBulk) SetTxHash(v string) *LockPaymentOrderUpsertBulk {
 	return u.Update(func(s *LockPaymentOrderUpsert) {
@@ -1576,6 +1738,27 @@ func (u *LockPaymentOrderUpsertBulk) UpdateCancellationReasons() *LockPaymentOrd
 	})
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (u *LockPaymentOrderUpsertBulk) SetEscalatedAt(v time.Time) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetEscalatedAt(v)
+	})
+}
+
+// UpdateEscalatedAt sets the "escalated_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateEscalatedAt() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateEscalatedAt()
+	})
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (u *LockPaymentOrderUpsertBulk) ClearEscalatedAt() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearEscalatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/predicate"
@@ -34,6 +35,7 @@ type LockPaymentOrderQuery struct {
 	withProvider        *ProviderProfileQuery
 	withFulfillments    *LockOrderFulfillmentQuery
 	withTransactions    *TransactionLogQuery
+	withDisputes        *DisputeQuery
 	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -181,6 +183,28 @@ func (lpoq *LockPaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	return query
 }
 
+// QueryDisputes chains the current query on the "disputes" edge.
+func (lpoq *LockPaymentOrderQuery) QueryDisputes() *DisputeQuery {
+	query := (&DisputeClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.DisputesTable, lockpaymentorder.DisputesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LockPaymentOrder entity from the query.
 // Returns a *NotFoundError when no LockPaymentOrder was found.
 func (lpoq *LockPaymentOrderQuery) First(ctx context.Context) (*LockPaymentOrder, error) {
@@ -378,6 +402,7 @@ func (lpoq *LockPaymentOrderQuery) Clone() *LockPaymentOrderQuery {
 		withProvider:        lpoq.withProvider.Clone(),
 		withFulfillments:    lpoq.withFulfillments.Clone(),
 		withTransactions:    lpoq.withTransactions.Clone(),
+		withDisputes:        lpoq.withDisputes.Clone(),
 		// clone intermediate query.
 		sql:  lpoq.sql.Clone(),
 		path: lpoq.path,
@@ -439,6 +464,17 @@ func (lpoq *LockPaymentOrderQuery) WithTransactions(opts ...func(*TransactionLog
 	return lpoq
 }
 
+// WithDisputes tells the query-builder to eager-load the nodes that are connected to
+// the "disputes" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithDisputes(opts ...func(*DisputeQuery)) *LockPaymentOrderQuery {
+	query := (&DisputeClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withDisputes = query
+	return lpoq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +554,13 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		nodes       = []*LockPaymentOrder{}
 		withFKs     = lpoq.withFKs
 		_spec       = lpoq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			lpoq.withToken != nil,
 			lpoq.withProvisionBucket != nil,
 			lpoq.withProvider != nil,
 			lpoq.withFulfillments != nil,
 			lpoq.withTransactions != nil,
+			lpoq.withDisputes != nil,
 		}
 	)
 	if lpoq.withToken != nil || lpoq.withProvisionBucket != nil || lpoq.withProvider != nil {
@@ -584,6 +621,13 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := lpoq.withDisputes; query != nil {
+		if err := lpoq.loadDisputes(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.Disputes = []*Dispute{} },
+			func(n *LockPaymentOrder, e *Dispute) { n.Edges.Disputes = append(n.Edges.Disputes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -745,6 +789,37 @@ func (lpoq *LockPaymentOrderQuery) loadTransactions(ctx context.Context, query *
 	}
 	return nil
 }
+func (lpoq *LockPaymentOrderQuery) loadDisputes(ctx context.Context, query *DisputeQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *Dispute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Dispute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.DisputesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_disputes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_disputes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_disputes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (lpoq *LockPaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := lpoq.querySpec()
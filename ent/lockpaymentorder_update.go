@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/predicate"
@@ -119,6 +120,27 @@ func (lpou *LockPaymentOrderUpdate) AddOrderPercent(d decimal.Decimal) *LockPaym
 	return lpou
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (lpou *LockPaymentOrderUpdate) SetAmountFulfilled(d decimal.Decimal) *LockPaymentOrderUpdate {
+	lpou.mutation.ResetAmountFulfilled()
+	lpou.mutation.SetAmountFulfilled(d)
+	return lpou
+}
+
+// SetNillableAmountFulfilled sets the "amount_fulfilled" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableAmountFulfilled(d *decimal.Decimal) *LockPaymentOrderUpdate {
+	if d != nil {
+		lpou.SetAmountFulfilled(*d)
+	}
+	return lpou
+}
+
+// AddAmountFulfilled adds d to the "amount_fulfilled" field.
+func (lpou *LockPaymentOrderUpdate) AddAmountFulfilled(d decimal.Decimal) *LockPaymentOrderUpdate {
+	lpou.mutation.AddAmountFulfilled(d)
+	return lpou
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (lpou *LockPaymentOrderUpdate) SetTxHash(s string) *LockPaymentOrderUpdate {
 	lpou.mutation.SetTxHash(s)
@@ -269,6 +291,26 @@ func (lpou *LockPaymentOrderUpdate) AppendCancellationReasons(s []string) *LockP
 	return lpou
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (lpou *LockPaymentOrderUpdate) SetEscalatedAt(t time.Time) *LockPaymentOrderUpdate {
+	lpou.mutation.SetEscalatedAt(t)
+	return lpou
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableEscalatedAt(t *time.Time) *LockPaymentOrderUpdate {
+	if t != nil {
+		lpou.SetEscalatedAt(*t)
+	}
+	return lpou
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (lpou *LockPaymentOrderUpdate) ClearEscalatedAt() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearEscalatedAt()
+	return lpou
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpou *LockPaymentOrderUpdate) SetTokenID(id int) *LockPaymentOrderUpdate {
 	lpou.mutation.SetTokenID(id)
@@ -348,6 +390,21 @@ func (lpou *LockPaymentOrderUpdate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpou.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddDisputeIDs(ids...)
+	return lpou
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpou *LockPaymentOrderUpdate) AddDisputes(d ...*Dispute) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpou.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpou *LockPaymentOrderUpdate) Mutation() *LockPaymentOrderMutation {
 	return lpou.mutation
@@ -413,6 +470,27 @@ func (lpou *LockPaymentOrderUpdate) RemoveTransactions(t ...*TransactionLog) *Lo
 	return lpou.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (lpou *LockPaymentOrderUpdate) ClearDisputes() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearDisputes()
+	return lpou
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveDisputeIDs(ids...)
+	return lpou
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (lpou *LockPaymentOrderUpdate) RemoveDisputes(d ...*Dispute) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpou.RemoveDisputeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (lpou *LockPaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	lpou.defaults()
@@ -503,6 +581,12 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := lpou.mutation.AddedOrderPercent(); ok {
 		_spec.AddField(lockpaymentorder.FieldOrderPercent, field.TypeFloat64, value)
 	}
+	if value, ok := lpou.mutation.AmountFulfilled(); ok {
+		_spec.SetField(lockpaymentorder.FieldAmountFulfilled, field.TypeFloat64, value)
+	}
+	if value, ok := lpou.mutation.AddedAmountFulfilled(); ok {
+		_spec.AddField(lockpaymentorder.FieldAmountFulfilled, field.TypeFloat64, value)
+	}
 	if value, ok := lpou.mutation.TxHash(); ok {
 		_spec.SetField(lockpaymentorder.FieldTxHash, field.TypeString, value)
 	}
@@ -547,6 +631,12 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 			sqljson.Append(u, lockpaymentorder.FieldCancellationReasons, value)
 		})
 	}
+	if value, ok := lpou.mutation.EscalatedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if lpou.mutation.EscalatedAtCleared() {
+		_spec.ClearField(lockpaymentorder.FieldEscalatedAt, field.TypeTime)
+	}
 	if lpou.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -724,6 +814,51 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !lpou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, lpou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lockpaymentorder.Label}
@@ -827,6 +962,27 @@ func (lpouo *LockPaymentOrderUpdateOne) AddOrderPercent(d decimal.Decimal) *Lock
 	return lpouo
 }
 
+// SetAmountFulfilled sets the "amount_fulfilled" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetAmountFulfilled(d decimal.Decimal) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ResetAmountFulfilled()
+	lpouo.mutation.SetAmountFulfilled(d)
+	return lpouo
+}
+
+// SetNillableAmountFulfilled sets the "amount_fulfilled" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableAmountFulfilled(d *decimal.Decimal) *LockPaymentOrderUpdateOne {
+	if d != nil {
+		lpouo.SetAmountFulfilled(*d)
+	}
+	return lpouo
+}
+
+// AddAmountFulfilled adds d to the "amount_fulfilled" field.
+func (lpouo *LockPaymentOrderUpdateOne) AddAmountFulfilled(d decimal.Decimal) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddAmountFulfilled(d)
+	return lpouo
+}
+
 // SetTxHash sets the "tx_hash" field.
 func (lpouo *LockPaymentOrderUpdateOne) SetTxHash(s string) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.SetTxHash(s)
@@ -977,6 +1133,26 @@ func (lpouo *LockPaymentOrderUpdateOne) AppendCancellationReasons(s []string) *L
 	return lpouo
 }
 
+// SetEscalatedAt sets the "escalated_at" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetEscalatedAt(t time.Time) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetEscalatedAt(t)
+	return lpouo
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableEscalatedAt(t *time.Time) *LockPaymentOrderUpdateOne {
+	if t != nil {
+		lpouo.SetEscalatedAt(*t)
+	}
+	return lpouo
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (lpouo *LockPaymentOrderUpdateOne) ClearEscalatedAt() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearEscalatedAt()
+	return lpouo
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpouo *LockPaymentOrderUpdateOne) SetTokenID(id int) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.SetTokenID(id)
@@ -1056,6 +1232,21 @@ func (lpouo *LockPaymentOrderUpdateOne) AddTransactions(t ...*TransactionLog) *L
 	return lpouo.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddDisputeIDs(ids...)
+	return lpouo
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddDisputes(d ...*Dispute) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpouo.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpouo *LockPaymentOrderUpdateOne) Mutation() *LockPaymentOrderMutation {
 	return lpouo.mutation
@@ -1121,6 +1312,27 @@ func (lpouo *LockPaymentOrderUpdateOne) RemoveTransactions(t ...*TransactionLog)
 	return lpouo.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearDisputes() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearDisputes()
+	return lpouo
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveDisputeIDs(ids...)
+	return lpouo
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveDisputes(d ...*Dispute) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpouo.RemoveDisputeIDs(ids...)
+}
+
 // Where appends a list predicates to the LockPaymentOrderUpdate builder.
 func (lpouo *LockPaymentOrderUpdateOne) Where(ps ...predicate.LockPaymentOrder) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.Where(ps...)
@@ -1241,6 +1453,12 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 	if value, ok := lpouo.mutation.AddedOrderPercent(); ok {
 		_spec.AddField(lockpaymentorder.FieldOrderPercent, field.TypeFloat64, value)
 	}
+	if value, ok := lpouo.mutation.AmountFulfilled(); ok {
+		_spec.SetField(lockpaymentorder.FieldAmountFulfilled, field.TypeFloat64, value)
+	}
+	if value, ok := lpouo.mutation.AddedAmountFulfilled(); ok {
+		_spec.AddField(lockpaymentorder.FieldAmountFulfilled, field.TypeFloat64, value)
+	}
 	if value, ok := lpouo.mutation.TxHash(); ok {
 		_spec.SetField(lockpaymentorder.FieldTxHash, field.TypeString, value)
 	}
@@ -1285,6 +1503,12 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 			sqljson.Append(u, lockpaymentorder.FieldCancellationReasons, value)
 		})
 	}
+	if value, ok := lpouo.mutation.EscalatedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if lpouo.mutation.EscalatedAtCleared() {
+		_spec.ClearField(lockpaymentorder.FieldEscalatedAt, field.TypeTime)
+	}
 	if lpouo.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1462,6 +1686,51 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !lpouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &LockPaymentOrder{config: lpouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,8 @@
+-- Create "payment_order_batches" table
+CREATE TABLE "payment_order_batches" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "amount" double precision NOT NULL, "amount_paid" double precision NOT NULL, "tx_hash" character varying NULL, "from_address" character varying NULL, "return_address" character varying NULL, "receive_address_text" character varying NOT NULL, "reference" character varying NULL, "status" character varying NOT NULL DEFAULT 'initiated', "sender_profile_payment_order_batches" uuid NULL, "token_payment_order_batches" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "payment_order_batches_sender_profiles_payment_order_batches" FOREIGN KEY ("sender_profile_payment_order_batches") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "payment_order_batches_tokens_payment_order_batches" FOREIGN KEY ("token_payment_order_batches") REFERENCES "tokens" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "payment_order_batch_payment_orders" uuid NULL, ADD CONSTRAINT "payment_orders_payment_order_batches_payment_orders" FOREIGN KEY ("payment_order_batch_payment_orders") REFERENCES "payment_order_batches" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "receive_addresses" table
+ALTER TABLE "receive_addresses" ADD COLUMN "payment_order_batch_receive_address" uuid NULL, ADD CONSTRAINT "receive_addresses_payment_order_batches_receive_address" FOREIGN KEY ("payment_order_batch_receive_address") REFERENCES "payment_order_batches" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "receive_addresses_payment_order_batch_receive_address_key" to table: "receive_addresses"
+CREATE UNIQUE INDEX "receive_addresses_payment_order_batch_receive_address_key" ON "receive_addresses" ("payment_order_batch_receive_address");
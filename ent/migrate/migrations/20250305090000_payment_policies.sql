@@ -0,0 +1,4 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "underpayment_policy" character varying NOT NULL DEFAULT 'settle', ADD COLUMN "overpayment_policy" character varying NOT NULL DEFAULT 'settle';
+-- Modify "sender_profiles" table
+ALTER TABLE "sender_profiles" ADD COLUMN "underpayment_policy" character varying NOT NULL DEFAULT 'settle', ADD COLUMN "overpayment_policy" character varying NOT NULL DEFAULT 'settle';
@@ -0,0 +1,4 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "fee_tier" character varying NULL;
+-- Create "sender_fee_tiers" table
+CREATE TABLE "sender_fee_tiers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "min_volume" double precision NOT NULL, "fee_percent" double precision NOT NULL, "min_fee" double precision NOT NULL, "max_fee" double precision NOT NULL, "starts_at" timestamptz NULL, "ends_at" timestamptz NULL, "sender_order_token_fee_tiers" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sender_fee_tiers_sender_order_tokens_fee_tiers" FOREIGN KEY ("sender_order_token_fee_tiers") REFERENCES "sender_order_tokens" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
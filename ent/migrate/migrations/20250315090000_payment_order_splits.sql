@@ -0,0 +1,2 @@
+-- Create "payment_order_splits" table
+CREATE TABLE "payment_order_splits" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "institution" character varying NOT NULL, "account_identifier" character varying NOT NULL, "account_name" character varying NOT NULL, "memo" character varying NULL, "amount" double precision NOT NULL, "percent" double precision NOT NULL, "payment_order_splits" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "payment_order_splits_payment_orders_splits" FOREIGN KEY ("payment_order_splits") REFERENCES "payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,6 @@
+-- Create "team_members" table
+CREATE TABLE "team_members" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "email" character varying NOT NULL, "role" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'invited', "invite_token" character varying NULL, "invite_expiry_at" timestamptz NULL, "provider_profile_team_members" character varying NULL, "sender_profile_team_members" uuid NULL, "user_team_memberships" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "team_members_provider_profiles_team_members" FOREIGN KEY ("provider_profile_team_members") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_members_sender_profiles_team_members" FOREIGN KEY ("sender_profile_team_members") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_members_users_team_memberships" FOREIGN KEY ("user_team_memberships") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "teammember_email_provider_profile_team_members" to table: "team_members"
+CREATE UNIQUE INDEX "teammember_email_provider_profile_team_members" ON "team_members" ("email", "provider_profile_team_members");
+-- Create index "teammember_email_sender_profile_team_members" to table: "team_members"
+CREATE UNIQUE INDEX "teammember_email_sender_profile_team_members" ON "team_members" ("email", "sender_profile_team_members");
@@ -0,0 +1,8 @@
+-- Drop index "api_keys_provider_profile_api_key_key" from table: "api_keys"
+DROP INDEX "api_keys_provider_profile_api_key_key";
+-- Drop index "api_keys_sender_profile_api_key_key" from table: "api_keys"
+DROP INDEX "api_keys_sender_profile_api_key_key";
+-- Modify "api_keys" table
+ALTER TABLE "api_keys" DROP CONSTRAINT "api_keys_provider_profiles_api_key", DROP CONSTRAINT "api_keys_sender_profiles_api_key", ADD COLUMN "name" character varying NOT NULL DEFAULT 'Default', ADD COLUMN "scopes" jsonb NULL, ADD COLUMN "is_primary" boolean NOT NULL DEFAULT false, ADD COLUMN "expires_at" timestamptz NULL, ADD COLUMN "last_used_at" timestamptz NULL, ADD COLUMN "revoked_at" timestamptz NULL, ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, ADD CONSTRAINT "api_keys_provider_profiles_api_keys" FOREIGN KEY ("provider_profile_api_key") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "api_keys_sender_profiles_api_keys" FOREIGN KEY ("sender_profile_api_key") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Existing keys become the primary keys of their profiles
+UPDATE "api_keys" SET "is_primary" = true;
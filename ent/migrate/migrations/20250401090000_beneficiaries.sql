@@ -0,0 +1,4 @@
+-- Create "beneficiaries" table
+CREATE TABLE "beneficiaries" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "institution" character varying NOT NULL, "account_identifier" character varying NOT NULL, "account_name" character varying NOT NULL, "memo" character varying NULL, "verified_account_name" character varying NULL, "verified_at" timestamptz NULL, "sender_profile_beneficiaries" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "beneficiaries_sender_profiles_beneficiaries" FOREIGN KEY ("sender_profile_beneficiaries") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "beneficiary_account_sender_profile" to table: "beneficiaries"
+CREATE UNIQUE INDEX "beneficiary_account_sender_profile" ON "beneficiaries" ("institution", "account_identifier", "sender_profile_beneficiaries");
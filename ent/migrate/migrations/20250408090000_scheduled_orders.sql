@@ -0,0 +1,6 @@
+-- Create "scheduled_orders" table
+CREATE TABLE "scheduled_orders" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "amount" double precision NOT NULL, "rate" double precision NOT NULL, "institution" character varying NOT NULL, "account_identifier" character varying NOT NULL, "account_name" character varying NOT NULL, "memo" character varying NULL, "cron_expression" character varying NULL, "start_at" timestamptz NOT NULL, "end_at" timestamptz NULL, "next_run_at" timestamptz NULL, "last_run_at" timestamptz NULL, "status" character varying NOT NULL DEFAULT 'active', "sender_profile_scheduled_orders" uuid NOT NULL, "token_scheduled_orders" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "scheduled_orders_sender_profiles_scheduled_orders" FOREIGN KEY ("sender_profile_scheduled_orders") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "scheduled_orders_tokens_scheduled_orders" FOREIGN KEY ("token_scheduled_orders") REFERENCES "tokens" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "scheduledorder_status_next_run_at" to table: "scheduled_orders"
+CREATE INDEX "scheduledorder_status_next_run_at" ON "scheduled_orders" ("status", "next_run_at");
+-- Create "scheduled_order_runs" table
+CREATE TABLE "scheduled_order_runs" ("id" uuid NOT NULL, "scheduled_for" timestamptz NOT NULL, "status" character varying NOT NULL, "error" character varying NULL, "created_at" timestamptz NOT NULL, "scheduled_order_runs" uuid NOT NULL, "scheduled_order_run_payment_order" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "scheduled_order_runs_payment_orders_payment_order" FOREIGN KEY ("scheduled_order_run_payment_order") REFERENCES "payment_orders" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "scheduled_order_runs_scheduled_orders_runs" FOREIGN KEY ("scheduled_order_runs") REFERENCES "scheduled_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
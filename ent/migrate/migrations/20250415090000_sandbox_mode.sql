@@ -0,0 +1,4 @@
+-- Modify "api_keys" table
+ALTER TABLE "api_keys" ADD COLUMN "is_sandbox" boolean NOT NULL DEFAULT false;
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "is_sandbox" boolean NOT NULL DEFAULT false;
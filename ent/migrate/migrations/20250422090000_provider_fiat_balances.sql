@@ -0,0 +1,4 @@
+-- Create "provider_fiat_balances" table
+CREATE TABLE "provider_fiat_balances" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "available_balance" double precision NOT NULL, "fiat_currency_provider_balances" uuid NOT NULL, "provider_profile_fiat_balances" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_fiat_balances_fiat_currencies_provider_balances" FOREIGN KEY ("fiat_currency_provider_balances") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "provider_fiat_balances_provider_profiles_fiat_balances" FOREIGN KEY ("provider_profile_fiat_balances") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "provider_fiat_balance_provider_currency" to table: "provider_fiat_balances"
+CREATE UNIQUE INDEX "provider_fiat_balance_provider_currency" ON "provider_fiat_balances" ("provider_profile_fiat_balances", "fiat_currency_provider_balances");
@@ -0,0 +1,2 @@
+-- Modify "provider_order_tokens" table
+ALTER TABLE "provider_order_tokens" ADD COLUMN "rate_slippage" double precision NOT NULL DEFAULT 0.5, ADD COLUMN "rate_slippage_type" character varying NOT NULL DEFAULT 'absolute';
@@ -0,0 +1,2 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "timezone" character varying NOT NULL DEFAULT 'UTC', ADD COLUMN "operating_hours" jsonb NULL, ADD COLUMN "holidays" jsonb NULL;
@@ -0,0 +1,2 @@
+-- Modify "provider_ratings" table
+ALTER TABLE "provider_ratings" ADD COLUMN "acceptance_rate" double precision NOT NULL DEFAULT 0, ADD COLUMN "acceptance_score" double precision NOT NULL DEFAULT 0, ADD COLUMN "cancellation_rate" double precision NOT NULL DEFAULT 0, ADD COLUMN "cancellation_score" double precision NOT NULL DEFAULT 0, ADD COLUMN "validation_failure_rate" double precision NOT NULL DEFAULT 0, ADD COLUMN "validation_score" double precision NOT NULL DEFAULT 0, ADD COLUMN "average_fulfillment_time" double precision NOT NULL DEFAULT 0, ADD COLUMN "fulfillment_time_score" double precision NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "auto_adjust_stale_rates" boolean NOT NULL DEFAULT false;
+-- Create "provider_rate_exclusions" table
+CREATE TABLE "provider_rate_exclusions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "reason" character varying NOT NULL, "rate" double precision NOT NULL, "market_rate" double precision NOT NULL, "deviation" double precision NOT NULL, "notified_at" timestamptz NULL, "adjusted_at" timestamptz NULL, "provider_order_token_rate_exclusion" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_rate_exclusions_provider_order_tokens_rate_exclusion" FOREIGN KEY ("provider_order_token_rate_exclusion") REFERENCES "provider_order_tokens" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "provider_rate_exclusions_provider_order_token_rate_exclusion_key" to table: "provider_rate_exclusions"
+CREATE UNIQUE INDEX "provider_rate_exclusions_provider_order_token_rate_exclusion_key" ON "provider_rate_exclusions" ("provider_order_token_rate_exclusion");
@@ -0,0 +1,6 @@
+-- Modify "provider_ratings" table
+ALTER TABLE "provider_ratings" ADD COLUMN "dispute_rate" double precision NOT NULL DEFAULT 0, ADD COLUMN "dispute_score" double precision NOT NULL DEFAULT 0;
+-- Create "disputes" table
+CREATE TABLE "disputes" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'awaiting_provider_evidence', "reason" text NOT NULL, "evidence" jsonb NULL, "provider_at_fault" boolean NULL, "resolution" text NULL, "resolved_at" timestamptz NULL, "evidence_due_at" timestamptz NULL, "resolution_due_at" timestamptz NOT NULL, "escalated_at" timestamptz NULL, "lock_payment_order_disputes" uuid NOT NULL, "payment_order_disputes" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "disputes_lock_payment_orders_disputes" FOREIGN KEY ("lock_payment_order_disputes") REFERENCES "lock_payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "disputes_payment_orders_disputes" FOREIGN KEY ("payment_order_disputes") REFERENCES "payment_orders" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "dispute_status" to table: "disputes"
+CREATE INDEX "dispute_status" ON "disputes" ("status");
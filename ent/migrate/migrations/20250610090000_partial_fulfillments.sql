@@ -0,0 +1,7 @@
+-- Modify "lock_order_fulfillments" table
+ALTER TABLE "lock_order_fulfillments" ADD COLUMN "amount" double precision NOT NULL DEFAULT 0;
+-- Modify "lock_payment_orders" table
+ALTER TABLE "lock_payment_orders" ADD COLUMN "amount_fulfilled" double precision NOT NULL DEFAULT 0;
+-- Backfill existing fulfillments, which each covered the whole order
+UPDATE "lock_order_fulfillments" SET "amount" = "lock_payment_orders"."amount" * "lock_payment_orders"."rate" FROM "lock_payment_orders" WHERE "lock_payment_orders"."id" = "lock_order_fulfillments"."lock_payment_order_fulfillments";
+UPDATE "lock_payment_orders" SET "amount_fulfilled" = "amount" * "rate" WHERE EXISTS (SELECT 1 FROM "lock_order_fulfillments" WHERE "lock_order_fulfillments"."lock_payment_order_fulfillments" = "lock_payment_orders"."id" AND "lock_order_fulfillments"."validation_status" = 'success');
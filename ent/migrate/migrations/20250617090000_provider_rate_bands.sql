@@ -0,0 +1,2 @@
+-- Modify "provider_order_tokens" table
+ALTER TABLE "provider_order_tokens" ADD COLUMN "rate_bands" jsonb NULL;
@@ -0,0 +1,2 @@
+-- Modify "payment_order_batches" table
+ALTER TABLE "payment_order_batches" ADD COLUMN "amount_returned" double precision NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Create index "dispute_lock_payment_order_disputes" to table: "disputes"
+CREATE UNIQUE INDEX "dispute_lock_payment_order_disputes" ON "disputes" ("lock_payment_order_disputes") WHERE (status IN ('open', 'awaiting_provider_evidence'));
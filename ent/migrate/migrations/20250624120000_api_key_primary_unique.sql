@@ -0,0 +1,6 @@
+-- Keep only the newest primary key of each profile
+UPDATE "api_keys" AS "a" SET "is_primary" = false WHERE "a"."is_primary" AND EXISTS (SELECT 1 FROM "api_keys" AS "b" WHERE "b"."is_primary" AND "b"."created_at" > "a"."created_at" AND ("b"."sender_profile_api_key" = "a"."sender_profile_api_key" OR "b"."provider_profile_api_key" = "a"."provider_profile_api_key"));
+-- Create index "apikey_sender_profile_api_key" to table: "api_keys"
+CREATE UNIQUE INDEX "apikey_sender_profile_api_key" ON "api_keys" ("sender_profile_api_key") WHERE is_primary;
+-- Create index "apikey_provider_profile_api_key" to table: "api_keys"
+CREATE UNIQUE INDEX "apikey_provider_profile_api_key" ON "api_keys" ("provider_profile_api_key") WHERE is_primary;
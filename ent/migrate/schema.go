@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -12,8 +13,16 @@ var (
 	APIKeysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "secret", Type: field.TypeString, Unique: true},
-		{Name: "provider_profile_api_key", Type: field.TypeString, Unique: true, Nullable: true},
-		{Name: "sender_profile_api_key", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 80, Default: "Default"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_primary", Type: field.TypeBool, Default: false},
+		{Name: "is_sandbox", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "provider_profile_api_key", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_api_key", Type: field.TypeUUID, Nullable: true},
 	}
 	// APIKeysTable holds the schema information for the "api_keys" table.
 	APIKeysTable = &schema.Table{
@@ -22,18 +31,122 @@ var (
 		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "api_keys_provider_profiles_api_key",
-				Columns:    []*schema.Column{APIKeysColumns[2]},
+				Symbol:     "api_keys_provider_profiles_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[10]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "api_keys_sender_profiles_api_key",
-				Columns:    []*schema.Column{APIKeysColumns[3]},
+				Symbol:     "api_keys_sender_profiles_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[11]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_sender_profile_api_key",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeysColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_primary",
+				},
+			},
+			{
+				Name:    "apikey_provider_profile_api_key",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeysColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_primary",
+				},
+			},
+		},
+	}
+	// BeneficiariesColumns holds the columns for the "beneficiaries" table.
+	BeneficiariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "institution", Type: field.TypeString},
+		{Name: "account_identifier", Type: field.TypeString},
+		{Name: "account_name", Type: field.TypeString},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "verified_account_name", Type: field.TypeString, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sender_profile_beneficiaries", Type: field.TypeUUID},
+	}
+	// BeneficiariesTable holds the schema information for the "beneficiaries" table.
+	BeneficiariesTable = &schema.Table{
+		Name:       "beneficiaries",
+		Columns:    BeneficiariesColumns,
+		PrimaryKey: []*schema.Column{BeneficiariesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "beneficiaries_sender_profiles_beneficiaries",
+				Columns:    []*schema.Column{BeneficiariesColumns[9]},
 				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "beneficiary_account_sender_profile",
+				Unique:  true,
+				Columns: []*schema.Column{BeneficiariesColumns[3], BeneficiariesColumns[4], BeneficiariesColumns[9]},
+			},
+		},
+	}
+	// DisputesColumns holds the columns for the "disputes" table.
+	DisputesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "awaiting_provider_evidence", "resolved", "rejected"}, Default: "awaiting_provider_evidence"},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "evidence", Type: field.TypeJSON, Nullable: true},
+		{Name: "provider_at_fault", Type: field.TypeBool, Nullable: true},
+		{Name: "resolution", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "evidence_due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_due_at", Type: field.TypeTime},
+		{Name: "escalated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_payment_order_disputes", Type: field.TypeUUID},
+		{Name: "payment_order_disputes", Type: field.TypeUUID, Nullable: true},
+	}
+	// DisputesTable holds the schema information for the "disputes" table.
+	DisputesTable = &schema.Table{
+		Name:       "disputes",
+		Columns:    DisputesColumns,
+		PrimaryKey: []*schema.Column{DisputesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "disputes_lock_payment_orders_disputes",
+				Columns:    []*schema.Column{DisputesColumns[12]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "disputes_payment_orders_disputes",
+				Columns:    []*schema.Column{DisputesColumns[13]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dispute_status",
+				Unique:  false,
+				Columns: []*schema.Column{DisputesColumns[3]},
+			},
+			{
+				Name:    "dispute_lock_payment_order_disputes",
+				Unique:  true,
+				Columns: []*schema.Column{DisputesColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status IN ('open', 'awaiting_provider_evidence')",
+				},
+			},
+		},
 	}
 	// FiatCurrenciesColumns holds the columns for the "fiat_currencies" table.
 	FiatCurrenciesColumns = []*schema.Column{
@@ -131,8 +244,9 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "tx_id", Type: field.TypeString, Unique: true},
+		{Name: "tx_id", Type: field.TypeString, Nullable: true},
 		{Name: "psp", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "validation_status", Type: field.TypeEnum, Enums: []string{"pending", "success", "failed"}, Default: "pending"},
 		{Name: "validation_error", Type: field.TypeString, Nullable: true},
 		{Name: "lock_payment_order_fulfillments", Type: field.TypeUUID},
@@ -145,7 +259,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lock_order_fulfillments_lock_payment_orders_fulfillments",
-				Columns:    []*schema.Column{LockOrderFulfillmentsColumns[7]},
+				Columns:    []*schema.Column{LockOrderFulfillmentsColumns[8]},
 				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -160,6 +274,7 @@ var (
 		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "rate", Type: field.TypeFloat64},
 		{Name: "order_percent", Type: field.TypeFloat64},
+		{Name: "amount_fulfilled", Type: field.TypeFloat64},
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "cancelled", "fulfilled", "validated", "settled", "refunded"}, Default: "pending"},
 		{Name: "block_number", Type: field.TypeInt64},
@@ -169,6 +284,7 @@ var (
 		{Name: "memo", Type: field.TypeString, Nullable: true},
 		{Name: "cancellation_count", Type: field.TypeInt, Default: 0},
 		{Name: "cancellation_reasons", Type: field.TypeJSON},
+		{Name: "escalated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "provider_profile_assigned_orders", Type: field.TypeString, Nullable: true},
 		{Name: "provision_bucket_lock_payment_orders", Type: field.TypeInt, Nullable: true},
 		{Name: "token_lock_payment_orders", Type: field.TypeInt},
@@ -181,19 +297,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lock_payment_orders_provider_profiles_assigned_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[16]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[18]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "lock_payment_orders_provision_buckets_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[17]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[19]},
 				RefColumns: []*schema.Column{ProvisionBucketsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "lock_payment_orders_tokens_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[18]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[20]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -202,7 +318,7 @@ var (
 			{
 				Name:    "lockpaymentorder_gateway_id_rate_tx_hash_block_number_institution_account_identifier_account_name_memo_token_lock_payment_orders",
 				Unique:  true,
-				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[5], LockPaymentOrdersColumns[7], LockPaymentOrdersColumns[9], LockPaymentOrdersColumns[10], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[18]},
+				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[5], LockPaymentOrdersColumns[8], LockPaymentOrdersColumns[10], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[14], LockPaymentOrdersColumns[20]},
 			},
 		},
 	}
@@ -247,9 +363,14 @@ var (
 		{Name: "fee_address", Type: field.TypeString, Nullable: true, Size: 60},
 		{Name: "gateway_id", Type: field.TypeString, Nullable: true, Size: 70},
 		{Name: "reference", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "fee_tier", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"initiated", "pending", "expired", "settled", "refunded"}, Default: "initiated"},
+		{Name: "underpayment_policy", Type: field.TypeEnum, Enums: []string{"settle", "top_up"}, Default: "settle"},
+		{Name: "overpayment_policy", Type: field.TypeEnum, Enums: []string{"settle", "refund_excess"}, Default: "settle"},
+		{Name: "is_sandbox", Type: field.TypeBool, Default: false},
 		{Name: "api_key_payment_orders", Type: field.TypeUUID, Nullable: true},
 		{Name: "linked_address_payment_orders", Type: field.TypeInt, Nullable: true},
+		{Name: "payment_order_batch_payment_orders", Type: field.TypeUUID, Nullable: true},
 		{Name: "sender_profile_payment_orders", Type: field.TypeUUID, Nullable: true},
 		{Name: "token_payment_orders", Type: field.TypeInt},
 	}
@@ -261,25 +382,68 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_orders_api_keys_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[21]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[25]},
 				RefColumns: []*schema.Column{APIKeysColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "payment_orders_linked_addresses_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[22]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[26]},
 				RefColumns: []*schema.Column{LinkedAddressesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "payment_orders_payment_order_batches_payment_orders",
+				Columns:    []*schema.Column{PaymentOrdersColumns[27]},
+				RefColumns: []*schema.Column{PaymentOrderBatchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "payment_orders_sender_profiles_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[23]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[28]},
 				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "payment_orders_tokens_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[24]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[29]},
+				RefColumns: []*schema.Column{TokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PaymentOrderBatchesColumns holds the columns for the "payment_order_batches" table.
+	PaymentOrderBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "amount_paid", Type: field.TypeFloat64},
+		{Name: "amount_returned", Type: field.TypeFloat64},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "from_address", Type: field.TypeString, Nullable: true, Size: 60},
+		{Name: "return_address", Type: field.TypeString, Nullable: true, Size: 60},
+		{Name: "receive_address_text", Type: field.TypeString, Size: 60},
+		{Name: "reference", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"initiated", "funded", "expired"}, Default: "initiated"},
+		{Name: "sender_profile_payment_order_batches", Type: field.TypeUUID, Nullable: true},
+		{Name: "token_payment_order_batches", Type: field.TypeInt},
+	}
+	// PaymentOrderBatchesTable holds the schema information for the "payment_order_batches" table.
+	PaymentOrderBatchesTable = &schema.Table{
+		Name:       "payment_order_batches",
+		Columns:    PaymentOrderBatchesColumns,
+		PrimaryKey: []*schema.Column{PaymentOrderBatchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_order_batches_sender_profiles_payment_order_batches",
+				Columns:    []*schema.Column{PaymentOrderBatchesColumns[12]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "payment_order_batches_tokens_payment_order_batches",
+				Columns:    []*schema.Column{PaymentOrderBatchesColumns[13]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -309,6 +473,67 @@ var (
 			},
 		},
 	}
+	// PaymentOrderSplitsColumns holds the columns for the "payment_order_splits" table.
+	PaymentOrderSplitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "institution", Type: field.TypeString},
+		{Name: "account_identifier", Type: field.TypeString},
+		{Name: "account_name", Type: field.TypeString},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "percent", Type: field.TypeFloat64},
+		{Name: "payment_order_splits", Type: field.TypeUUID},
+	}
+	// PaymentOrderSplitsTable holds the schema information for the "payment_order_splits" table.
+	PaymentOrderSplitsTable = &schema.Table{
+		Name:       "payment_order_splits",
+		Columns:    PaymentOrderSplitsColumns,
+		PrimaryKey: []*schema.Column{PaymentOrderSplitsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_order_splits_payment_orders_splits",
+				Columns:    []*schema.Column{PaymentOrderSplitsColumns[7]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProviderFiatBalancesColumns holds the columns for the "provider_fiat_balances" table.
+	ProviderFiatBalancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "available_balance", Type: field.TypeFloat64},
+		{Name: "fiat_currency_provider_balances", Type: field.TypeUUID},
+		{Name: "provider_profile_fiat_balances", Type: field.TypeString},
+	}
+	// ProviderFiatBalancesTable holds the schema information for the "provider_fiat_balances" table.
+	ProviderFiatBalancesTable = &schema.Table{
+		Name:       "provider_fiat_balances",
+		Columns:    ProviderFiatBalancesColumns,
+		PrimaryKey: []*schema.Column{ProviderFiatBalancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_fiat_balances_fiat_currencies_provider_balances",
+				Columns:    []*schema.Column{ProviderFiatBalancesColumns[4]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "provider_fiat_balances_provider_profiles_fiat_balances",
+				Columns:    []*schema.Column{ProviderFiatBalancesColumns[5]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "provider_fiat_balance_provider_currency",
+				Unique:  true,
+				Columns: []*schema.Column{ProviderFiatBalancesColumns[5], ProviderFiatBalancesColumns[4]},
+			},
+		},
+	}
 	// ProviderOrderTokensColumns holds the columns for the "provider_order_tokens" table.
 	ProviderOrderTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -320,7 +545,10 @@ var (
 		{Name: "conversion_rate_type", Type: field.TypeEnum, Enums: []string{"fixed", "floating"}},
 		{Name: "max_order_amount", Type: field.TypeFloat64},
 		{Name: "min_order_amount", Type: field.TypeFloat64},
+		{Name: "rate_slippage", Type: field.TypeFloat64},
+		{Name: "rate_slippage_type", Type: field.TypeEnum, Enums: []string{"percentage", "absolute"}, Default: "absolute"},
 		{Name: "addresses", Type: field.TypeJSON},
+		{Name: "rate_bands", Type: field.TypeJSON, Nullable: true},
 		{Name: "provider_profile_order_tokens", Type: field.TypeString, Nullable: true},
 	}
 	// ProviderOrderTokensTable holds the schema information for the "provider_order_tokens" table.
@@ -331,7 +559,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provider_order_tokens_provider_profiles_order_tokens",
-				Columns:    []*schema.Column{ProviderOrderTokensColumns[10]},
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[13]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -347,6 +575,12 @@ var (
 		{Name: "is_available", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "visibility_mode", Type: field.TypeEnum, Enums: []string{"private", "public"}, Default: "public"},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "operating_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "holidays", Type: field.TypeJSON, Nullable: true},
+		{Name: "supported_institutions", Type: field.TypeJSON, Nullable: true},
+		{Name: "unsupported_institutions", Type: field.TypeJSON, Nullable: true},
+		{Name: "auto_adjust_stale_rates", Type: field.TypeBool, Default: false},
 		{Name: "address", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "mobile_number", Type: field.TypeString, Nullable: true},
 		{Name: "date_of_birth", Type: field.TypeTime, Nullable: true},
@@ -366,24 +600,61 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provider_profiles_fiat_currencies_providers",
-				Columns:    []*schema.Column{ProviderProfilesColumns[16]},
+				Columns:    []*schema.Column{ProviderProfilesColumns[22]},
 				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "provider_profiles_users_provider_profile",
-				Columns:    []*schema.Column{ProviderProfilesColumns[17]},
+				Columns:    []*schema.Column{ProviderProfilesColumns[23]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// ProviderRateExclusionsColumns holds the columns for the "provider_rate_exclusions" table.
+	ProviderRateExclusionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"stale_rate"}},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "market_rate", Type: field.TypeFloat64},
+		{Name: "deviation", Type: field.TypeFloat64},
+		{Name: "notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "adjusted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "provider_order_token_rate_exclusion", Type: field.TypeInt, Unique: true},
+	}
+	// ProviderRateExclusionsTable holds the schema information for the "provider_rate_exclusions" table.
+	ProviderRateExclusionsTable = &schema.Table{
+		Name:       "provider_rate_exclusions",
+		Columns:    ProviderRateExclusionsColumns,
+		PrimaryKey: []*schema.Column{ProviderRateExclusionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_rate_exclusions_provider_order_tokens_rate_exclusion",
+				Columns:    []*schema.Column{ProviderRateExclusionsColumns[9]},
+				RefColumns: []*schema.Column{ProviderOrderTokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// ProviderRatingsColumns holds the columns for the "provider_ratings" table.
 	ProviderRatingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "trust_score", Type: field.TypeFloat64},
+		{Name: "acceptance_rate", Type: field.TypeFloat64},
+		{Name: "acceptance_score", Type: field.TypeFloat64},
+		{Name: "cancellation_rate", Type: field.TypeFloat64},
+		{Name: "cancellation_score", Type: field.TypeFloat64},
+		{Name: "validation_failure_rate", Type: field.TypeFloat64},
+		{Name: "validation_score", Type: field.TypeFloat64},
+		{Name: "average_fulfillment_time", Type: field.TypeFloat64},
+		{Name: "fulfillment_time_score", Type: field.TypeFloat64},
+		{Name: "dispute_rate", Type: field.TypeFloat64},
+		{Name: "dispute_score", Type: field.TypeFloat64},
 		{Name: "provider_profile_provider_rating", Type: field.TypeString, Unique: true},
 	}
 	// ProviderRatingsTable holds the schema information for the "provider_ratings" table.
@@ -394,7 +665,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provider_ratings_provider_profiles_provider_rating",
-				Columns:    []*schema.Column{ProviderRatingsColumns[4]},
+				Columns:    []*schema.Column{ProviderRatingsColumns[14]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -435,6 +706,7 @@ var (
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
 		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
 		{Name: "payment_order_receive_address", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "payment_order_batch_receive_address", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// ReceiveAddressesTable holds the schema information for the "receive_addresses" table.
 	ReceiveAddressesTable = &schema.Table{
@@ -448,6 +720,117 @@ var (
 				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "receive_addresses_payment_order_batches_receive_address",
+				Columns:    []*schema.Column{ReceiveAddressesColumns[11]},
+				RefColumns: []*schema.Column{PaymentOrderBatchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ScheduledOrdersColumns holds the columns for the "scheduled_orders" table.
+	ScheduledOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "institution", Type: field.TypeString},
+		{Name: "account_identifier", Type: field.TypeString},
+		{Name: "account_name", Type: field.TypeString},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "cron_expression", Type: field.TypeString, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "paused", "completed", "cancelled"}, Default: "active"},
+		{Name: "sender_profile_scheduled_orders", Type: field.TypeUUID},
+		{Name: "token_scheduled_orders", Type: field.TypeInt},
+	}
+	// ScheduledOrdersTable holds the schema information for the "scheduled_orders" table.
+	ScheduledOrdersTable = &schema.Table{
+		Name:       "scheduled_orders",
+		Columns:    ScheduledOrdersColumns,
+		PrimaryKey: []*schema.Column{ScheduledOrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_orders_sender_profiles_scheduled_orders",
+				Columns:    []*schema.Column{ScheduledOrdersColumns[15]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "scheduled_orders_tokens_scheduled_orders",
+				Columns:    []*schema.Column{ScheduledOrdersColumns[16]},
+				RefColumns: []*schema.Column{TokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledorder_status_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledOrdersColumns[14], ScheduledOrdersColumns[12]},
+			},
+		},
+	}
+	// ScheduledOrderRunsColumns holds the columns for the "scheduled_order_runs" table.
+	ScheduledOrderRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "scheduled_for", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "scheduled_order_runs", Type: field.TypeUUID},
+		{Name: "scheduled_order_run_payment_order", Type: field.TypeUUID, Nullable: true},
+	}
+	// ScheduledOrderRunsTable holds the schema information for the "scheduled_order_runs" table.
+	ScheduledOrderRunsTable = &schema.Table{
+		Name:       "scheduled_order_runs",
+		Columns:    ScheduledOrderRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduledOrderRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_order_runs_scheduled_orders_runs",
+				Columns:    []*schema.Column{ScheduledOrderRunsColumns[5]},
+				RefColumns: []*schema.Column{ScheduledOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "scheduled_order_runs_payment_orders_payment_order",
+				Columns:    []*schema.Column{ScheduledOrderRunsColumns[6]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SenderFeeTiersColumns holds the columns for the "sender_fee_tiers" table.
+	SenderFeeTiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "min_volume", Type: field.TypeFloat64},
+		{Name: "fee_percent", Type: field.TypeFloat64},
+		{Name: "min_fee", Type: field.TypeFloat64},
+		{Name: "max_fee", Type: field.TypeFloat64},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sender_order_token_fee_tiers", Type: field.TypeInt},
+	}
+	// SenderFeeTiersTable holds the schema information for the "sender_fee_tiers" table.
+	SenderFeeTiersTable = &schema.Table{
+		Name:       "sender_fee_tiers",
+		Columns:    SenderFeeTiersColumns,
+		PrimaryKey: []*schema.Column{SenderFeeTiersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sender_fee_tiers_sender_order_tokens_fee_tiers",
+				Columns:    []*schema.Column{SenderFeeTiersColumns[10]},
+				RefColumns: []*schema.Column{SenderOrderTokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// SenderOrderTokensColumns holds the columns for the "sender_order_tokens" table.
@@ -496,6 +879,8 @@ var (
 		{Name: "provider_id", Type: field.TypeString, Nullable: true},
 		{Name: "is_partner", Type: field.TypeBool, Default: false},
 		{Name: "is_active", Type: field.TypeBool, Default: false},
+		{Name: "underpayment_policy", Type: field.TypeEnum, Enums: []string{"settle", "top_up"}, Default: "settle"},
+		{Name: "overpayment_policy", Type: field.TypeEnum, Enums: []string{"settle", "refund_excess"}, Default: "settle"},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_sender_profile", Type: field.TypeUUID, Unique: true},
 	}
@@ -507,12 +892,64 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sender_profiles_users_sender_profile",
-				Columns:    []*schema.Column{SenderProfilesColumns[7]},
+				Columns:    []*schema.Column{SenderProfilesColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// TeamMembersColumns holds the columns for the "team_members" table.
+	TeamMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "developer", "finance", "read_only"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"invited", "active"}, Default: "invited"},
+		{Name: "invite_token", Type: field.TypeString, Nullable: true},
+		{Name: "invite_expiry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "provider_profile_team_members", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_team_members", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_team_memberships", Type: field.TypeUUID, Nullable: true},
+	}
+	// TeamMembersTable holds the schema information for the "team_members" table.
+	TeamMembersTable = &schema.Table{
+		Name:       "team_members",
+		Columns:    TeamMembersColumns,
+		PrimaryKey: []*schema.Column{TeamMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_members_provider_profiles_team_members",
+				Columns:    []*schema.Column{TeamMembersColumns[8]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_members_sender_profiles_team_members",
+				Columns:    []*schema.Column{TeamMembersColumns[9]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_members_users_team_memberships",
+				Columns:    []*schema.Column{TeamMembersColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teammember_email_sender_profile_team_members",
+				Unique:  true,
+				Columns: []*schema.Column{TeamMembersColumns[3], TeamMembersColumns[9]},
+			},
+			{
+				Name:    "teammember_email_provider_profile_team_members",
+				Unique:  true,
+				Columns: []*schema.Column{TeamMembersColumns[3], TeamMembersColumns[8]},
+			},
+		},
+	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -666,6 +1103,8 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APIKeysTable,
+		BeneficiariesTable,
+		DisputesTable,
 		FiatCurrenciesTable,
 		IdentityVerificationRequestsTable,
 		InstitutionsTable,
@@ -674,14 +1113,22 @@ var (
 		LockPaymentOrdersTable,
 		NetworksTable,
 		PaymentOrdersTable,
+		PaymentOrderBatchesTable,
 		PaymentOrderRecipientsTable,
+		PaymentOrderSplitsTable,
+		ProviderFiatBalancesTable,
 		ProviderOrderTokensTable,
 		ProviderProfilesTable,
+		ProviderRateExclusionsTable,
 		ProviderRatingsTable,
 		ProvisionBucketsTable,
 		ReceiveAddressesTable,
+		ScheduledOrdersTable,
+		ScheduledOrderRunsTable,
+		SenderFeeTiersTable,
 		SenderOrderTokensTable,
 		SenderProfilesTable,
+		TeamMembersTable,
 		TokensTable,
 		TransactionLogsTable,
 		UsersTable,
@@ -694,6 +1141,9 @@ var (
 func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	APIKeysTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	BeneficiariesTable.ForeignKeys[0].RefTable = SenderProfilesTable
+	DisputesTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	DisputesTable.ForeignKeys[1].RefTable = PaymentOrdersTable
 	InstitutionsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	LinkedAddressesTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	LockOrderFulfillmentsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
@@ -702,18 +1152,34 @@ func init() {
 	LockPaymentOrdersTable.ForeignKeys[2].RefTable = TokensTable
 	PaymentOrdersTable.ForeignKeys[0].RefTable = APIKeysTable
 	PaymentOrdersTable.ForeignKeys[1].RefTable = LinkedAddressesTable
-	PaymentOrdersTable.ForeignKeys[2].RefTable = SenderProfilesTable
-	PaymentOrdersTable.ForeignKeys[3].RefTable = TokensTable
+	PaymentOrdersTable.ForeignKeys[2].RefTable = PaymentOrderBatchesTable
+	PaymentOrdersTable.ForeignKeys[3].RefTable = SenderProfilesTable
+	PaymentOrdersTable.ForeignKeys[4].RefTable = TokensTable
+	PaymentOrderBatchesTable.ForeignKeys[0].RefTable = SenderProfilesTable
+	PaymentOrderBatchesTable.ForeignKeys[1].RefTable = TokensTable
 	PaymentOrderRecipientsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	PaymentOrderSplitsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	ProviderFiatBalancesTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	ProviderFiatBalancesTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	ProviderOrderTokensTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	ProviderProfilesTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	ProviderProfilesTable.ForeignKeys[1].RefTable = UsersTable
+	ProviderRateExclusionsTable.ForeignKeys[0].RefTable = ProviderOrderTokensTable
 	ProviderRatingsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	ProvisionBucketsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	ReceiveAddressesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	ReceiveAddressesTable.ForeignKeys[1].RefTable = PaymentOrderBatchesTable
+	ScheduledOrdersTable.ForeignKeys[0].RefTable = SenderProfilesTable
+	ScheduledOrdersTable.ForeignKeys[1].RefTable = TokensTable
+	ScheduledOrderRunsTable.ForeignKeys[0].RefTable = ScheduledOrdersTable
+	ScheduledOrderRunsTable.ForeignKeys[1].RefTable = PaymentOrdersTable
+	SenderFeeTiersTable.ForeignKeys[0].RefTable = SenderOrderTokensTable
 	SenderOrderTokensTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	SenderOrderTokensTable.ForeignKeys[1].RefTable = TokensTable
 	SenderProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	TeamMembersTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	TeamMembersTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	TeamMembersTable.ForeignKeys[2].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = NetworksTable
 	TransactionLogsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
 	TransactionLogsTable.ForeignKeys[1].RefTable = PaymentOrdersTable
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderprofile"
@@ -62,16 +63,25 @@ type PaymentOrder struct {
 	GatewayID string `json:"gateway_id,omitempty"`
 	// Reference holds the value of the "reference" field.
 	Reference string `json:"reference,omitempty"`
+	// FeeTier holds the value of the "fee_tier" field.
+	FeeTier string `json:"fee_tier,omitempty"`
 	// Status holds the value of the "status" field.
 	Status paymentorder.Status `json:"status,omitempty"`
+	// UnderpaymentPolicy holds the value of the "underpayment_policy" field.
+	UnderpaymentPolicy paymentorder.UnderpaymentPolicy `json:"underpayment_policy,omitempty"`
+	// OverpaymentPolicy holds the value of the "overpayment_policy" field.
+	OverpaymentPolicy paymentorder.OverpaymentPolicy `json:"overpayment_policy,omitempty"`
+	// IsSandbox holds the value of the "is_sandbox" field.
+	IsSandbox bool `json:"is_sandbox,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PaymentOrderQuery when eager-loading is set.
-	Edges                         PaymentOrderEdges `json:"edges"`
-	api_key_payment_orders        *uuid.UUID
-	linked_address_payment_orders *int
-	sender_profile_payment_orders *uuid.UUID
-	token_payment_orders          *int
-	selectValues                  sql.SelectValues
+	Edges                              PaymentOrderEdges `json:"edges"`
+	api_key_payment_orders             *uuid.UUID
+	linked_address_payment_orders      *int
+	payment_order_batch_payment_orders *uuid.UUID
+	sender_profile_payment_orders      *uuid.UUID
+	token_payment_orders               *int
+	selectValues                       sql.SelectValues
 }
 
 // PaymentOrderEdges holds the relations/edges for other nodes in the graph.
@@ -82,15 +92,21 @@ type PaymentOrderEdges struct {
 	Token *Token `json:"token,omitempty"`
 	// LinkedAddress holds the value of the linked_address edge.
 	LinkedAddress *LinkedAddress `json:"linked_address,omitempty"`
+	// Batch holds the value of the batch edge.
+	Batch *PaymentOrderBatch `json:"batch,omitempty"`
 	// ReceiveAddress holds the value of the receive_address edge.
 	ReceiveAddress *ReceiveAddress `json:"receive_address,omitempty"`
 	// Recipient holds the value of the recipient edge.
 	Recipient *PaymentOrderRecipient `json:"recipient,omitempty"`
+	// Splits holds the value of the splits edge.
+	Splits []*PaymentOrderSplit `json:"splits,omitempty"`
 	// Transactions holds the value of the transactions edge.
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
+	// Disputes holds the value of the disputes edge.
+	Disputes []*Dispute `json:"disputes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [9]bool
 }
 
 // SenderProfileOrErr returns the SenderProfile value or an error if the edge
@@ -126,12 +142,23 @@ func (e PaymentOrderEdges) LinkedAddressOrErr() (*LinkedAddress, error) {
 	return nil, &NotLoadedError{edge: "linked_address"}
 }
 
+// BatchOrErr returns the Batch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderEdges) BatchOrErr() (*PaymentOrderBatch, error) {
+	if e.Batch != nil {
+		return e.Batch, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: paymentorderbatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "batch"}
+}
+
 // ReceiveAddressOrErr returns the ReceiveAddress value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e PaymentOrderEdges) ReceiveAddressOrErr() (*ReceiveAddress, error) {
 	if e.ReceiveAddress != nil {
 		return e.ReceiveAddress, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: receiveaddress.Label}
 	}
 	return nil, &NotLoadedError{edge: "receive_address"}
@@ -142,21 +169,39 @@ func (e PaymentOrderEdges) ReceiveAddressOrErr() (*ReceiveAddress, error) {
 func (e PaymentOrderEdges) RecipientOrErr() (*PaymentOrderRecipient, error) {
 	if e.Recipient != nil {
 		return e.Recipient, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: paymentorderrecipient.Label}
 	}
 	return nil, &NotLoadedError{edge: "recipient"}
 }
 
+// SplitsOrErr returns the Splits value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) SplitsOrErr() ([]*PaymentOrderSplit, error) {
+	if e.loadedTypes[6] {
+		return e.Splits, nil
+	}
+	return nil, &NotLoadedError{edge: "splits"}
+}
+
 // TransactionsOrErr returns the Transactions value or an error if the edge
 // was not loaded in eager-loading.
 func (e PaymentOrderEdges) TransactionsOrErr() ([]*TransactionLog, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.Transactions, nil
 	}
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// DisputesOrErr returns the Disputes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) DisputesOrErr() ([]*Dispute, error) {
+	if e.loadedTypes[8] {
+		return e.Disputes, nil
+	}
+	return nil, &NotLoadedError{edge: "disputes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -164,9 +209,11 @@ func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case paymentorder.FieldAmount, paymentorder.FieldAmountPaid, paymentorder.FieldAmountReturned, paymentorder.FieldPercentSettled, paymentorder.FieldSenderFee, paymentorder.FieldNetworkFee, paymentorder.FieldProtocolFee, paymentorder.FieldRate, paymentorder.FieldFeePercent:
 			values[i] = new(decimal.Decimal)
+		case paymentorder.FieldIsSandbox:
+			values[i] = new(sql.NullBool)
 		case paymentorder.FieldBlockNumber:
 			values[i] = new(sql.NullInt64)
-		case paymentorder.FieldTxHash, paymentorder.FieldFromAddress, paymentorder.FieldReturnAddress, paymentorder.FieldReceiveAddressText, paymentorder.FieldFeeAddress, paymentorder.FieldGatewayID, paymentorder.FieldReference, paymentorder.FieldStatus:
+		case paymentorder.FieldTxHash, paymentorder.FieldFromAddress, paymentorder.FieldReturnAddress, paymentorder.FieldReceiveAddressText, paymentorder.FieldFeeAddress, paymentorder.FieldGatewayID, paymentorder.FieldReference, paymentorder.FieldFeeTier, paymentorder.FieldStatus, paymentorder.FieldUnderpaymentPolicy, paymentorder.FieldOverpaymentPolicy:
 			values[i] = new(sql.NullString)
 		case paymentorder.FieldCreatedAt, paymentorder.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -176,9 +223,11 @@ func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case paymentorder.ForeignKeys[1]: // linked_address_payment_orders
 			values[i] = new(sql.NullInt64)
-		case paymentorder.ForeignKeys[2]: // sender_profile_payment_orders
+		case paymentorder.ForeignKeys[2]: // payment_order_batch_payment_orders
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case paymentorder.ForeignKeys[3]: // sender_profile_payment_orders
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case paymentorder.ForeignKeys[3]: // token_payment_orders
+		case paymentorder.ForeignKeys[4]: // token_payment_orders
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -315,12 +364,36 @@ func (po *PaymentOrder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.Reference = value.String
 			}
+		case paymentorder.FieldFeeTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_tier", values[i])
+			} else if value.Valid {
+				po.FeeTier = value.String
+			}
 		case paymentorder.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				po.Status = paymentorder.Status(value.String)
 			}
+		case paymentorder.FieldUnderpaymentPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field underpayment_policy", values[i])
+			} else if value.Valid {
+				po.UnderpaymentPolicy = paymentorder.UnderpaymentPolicy(value.String)
+			}
+		case paymentorder.FieldOverpaymentPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field overpayment_policy", values[i])
+			} else if value.Valid {
+				po.OverpaymentPolicy = paymentorder.OverpaymentPolicy(value.String)
+			}
+		case paymentorder.FieldIsSandbox:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_sandbox", values[i])
+			} else if value.Valid {
+				po.IsSandbox = value.Bool
+			}
 		case paymentorder.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field api_key_payment_orders", values[i])
@@ -336,13 +409,20 @@ func (po *PaymentOrder) assignValues(columns []string, values []any) error {
 				*po.linked_address_payment_orders = int(value.Int64)
 			}
 		case paymentorder.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_batch_payment_orders", values[i])
+			} else if value.Valid {
+				po.payment_order_batch_payment_orders = new(uuid.UUID)
+				*po.payment_order_batch_payment_orders = *value.S.(*uuid.UUID)
+			}
+		case paymentorder.ForeignKeys[3]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field sender_profile_payment_orders", values[i])
 			} else if value.Valid {
 				po.sender_profile_payment_orders = new(uuid.UUID)
 				*po.sender_profile_payment_orders = *value.S.(*uuid.UUID)
 			}
-		case paymentorder.ForeignKeys[3]:
+		case paymentorder.ForeignKeys[4]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field token_payment_orders", value)
 			} else if value.Valid {
@@ -377,6 +457,11 @@ func (po *PaymentOrder) QueryLinkedAddress() *LinkedAddressQuery {
 	return NewPaymentOrderClient(po.config).QueryLinkedAddress(po)
 }
 
+// QueryBatch queries the "batch" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryBatch() *PaymentOrderBatchQuery {
+	return NewPaymentOrderClient(po.config).QueryBatch(po)
+}
+
 // QueryReceiveAddress queries the "receive_address" edge of the PaymentOrder entity.
 func (po *PaymentOrder) QueryReceiveAddress() *ReceiveAddressQuery {
 	return NewPaymentOrderClient(po.config).QueryReceiveAddress(po)
@@ -387,11 +472,21 @@ func (po *PaymentOrder) QueryRecipient() *PaymentOrderRecipientQuery {
 	return NewPaymentOrderClient(po.config).QueryRecipient(po)
 }
 
+// QuerySplits queries the "splits" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QuerySplits() *PaymentOrderSplitQuery {
+	return NewPaymentOrderClient(po.config).QuerySplits(po)
+}
+
 // QueryTransactions queries the "transactions" edge of the PaymentOrder entity.
 func (po *PaymentOrder) QueryTransactions() *TransactionLogQuery {
 	return NewPaymentOrderClient(po.config).QueryTransactions(po)
 }
 
+// QueryDisputes queries the "disputes" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryDisputes() *DisputeQuery {
+	return NewPaymentOrderClient(po.config).QueryDisputes(po)
+}
+
 // Update returns a builder for updating this PaymentOrder.
 // Note that you need to call PaymentOrder.Unwrap() before calling this method if this PaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -472,8 +567,20 @@ func (po *PaymentOrder) String() string {
 	builder.WriteString("reference=")
 	builder.WriteString(po.Reference)
 	builder.WriteString(", ")
+	builder.WriteString("fee_tier=")
+	builder.WriteString(po.FeeTier)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", po.Status))
+	builder.WriteString(", ")
+	builder.WriteString("underpayment_policy=")
+	builder.WriteString(fmt.Sprintf("%v", po.UnderpaymentPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("overpayment_policy=")
+	builder.WriteString(fmt.Sprintf("%v", po.OverpaymentPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("is_sandbox=")
+	builder.WriteString(fmt.Sprintf("%v", po.IsSandbox))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -54,20 +54,34 @@ const (
 	FieldGatewayID = "gateway_id"
 	// FieldReference holds the string denoting the reference field in the database.
 	FieldReference = "reference"
+	// FieldFeeTier holds the string denoting the fee_tier field in the database.
+	FieldFeeTier = "fee_tier"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldUnderpaymentPolicy holds the string denoting the underpayment_policy field in the database.
+	FieldUnderpaymentPolicy = "underpayment_policy"
+	// FieldOverpaymentPolicy holds the string denoting the overpayment_policy field in the database.
+	FieldOverpaymentPolicy = "overpayment_policy"
+	// FieldIsSandbox holds the string denoting the is_sandbox field in the database.
+	FieldIsSandbox = "is_sandbox"
 	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
 	EdgeSenderProfile = "sender_profile"
 	// EdgeToken holds the string denoting the token edge name in mutations.
 	EdgeToken = "token"
 	// EdgeLinkedAddress holds the string denoting the linked_address edge name in mutations.
 	EdgeLinkedAddress = "linked_address"
+	// EdgeBatch holds the string denoting the batch edge name in mutations.
+	EdgeBatch = "batch"
 	// EdgeReceiveAddress holds the string denoting the receive_address edge name in mutations.
 	EdgeReceiveAddress = "receive_address"
 	// EdgeRecipient holds the string denoting the recipient edge name in mutations.
 	EdgeRecipient = "recipient"
+	// EdgeSplits holds the string denoting the splits edge name in mutations.
+	EdgeSplits = "splits"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
+	// EdgeDisputes holds the string denoting the disputes edge name in mutations.
+	EdgeDisputes = "disputes"
 	// Table holds the table name of the paymentorder in the database.
 	Table = "payment_orders"
 	// SenderProfileTable is the table that holds the sender_profile relation/edge.
@@ -91,6 +105,13 @@ const (
 	LinkedAddressInverseTable = "linked_addresses"
 	// LinkedAddressColumn is the table column denoting the linked_address relation/edge.
 	LinkedAddressColumn = "linked_address_payment_orders"
+	// BatchTable is the table that holds the batch relation/edge.
+	BatchTable = "payment_orders"
+	// BatchInverseTable is the table name for the PaymentOrderBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorderbatch" package.
+	BatchInverseTable = "payment_order_batches"
+	// BatchColumn is the table column denoting the batch relation/edge.
+	BatchColumn = "payment_order_batch_payment_orders"
 	// ReceiveAddressTable is the table that holds the receive_address relation/edge.
 	ReceiveAddressTable = "receive_addresses"
 	// ReceiveAddressInverseTable is the table name for the ReceiveAddress entity.
@@ -105,6 +126,13 @@ const (
 	RecipientInverseTable = "payment_order_recipients"
 	// RecipientColumn is the table column denoting the recipient relation/edge.
 	RecipientColumn = "payment_order_recipient"
+	// SplitsTable is the table that holds the splits relation/edge.
+	SplitsTable = "payment_order_splits"
+	// SplitsInverseTable is the table name for the PaymentOrderSplit entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentordersplit" package.
+	SplitsInverseTable = "payment_order_splits"
+	// SplitsColumn is the table column denoting the splits relation/edge.
+	SplitsColumn = "payment_order_splits"
 	// TransactionsTable is the table that holds the transactions relation/edge.
 	TransactionsTable = "transaction_logs"
 	// TransactionsInverseTable is the table name for the TransactionLog entity.
@@ -112,6 +140,13 @@ const (
 	TransactionsInverseTable = "transaction_logs"
 	// TransactionsColumn is the table column denoting the transactions relation/edge.
 	TransactionsColumn = "payment_order_transactions"
+	// DisputesTable is the table that holds the disputes relation/edge.
+	DisputesTable = "disputes"
+	// DisputesInverseTable is the table name for the Dispute entity.
+	// It exists in this package in order to avoid circular dependency with the "dispute" package.
+	DisputesInverseTable = "disputes"
+	// DisputesColumn is the table column denoting the disputes relation/edge.
+	DisputesColumn = "payment_order_disputes"
 )
 
 // Columns holds all SQL columns for paymentorder fields.
@@ -136,7 +171,11 @@ var Columns = []string{
 	FieldFeeAddress,
 	FieldGatewayID,
 	FieldReference,
+	FieldFeeTier,
 	FieldStatus,
+	FieldUnderpaymentPolicy,
+	FieldOverpaymentPolicy,
+	FieldIsSandbox,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "payment_orders"
@@ -144,6 +183,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"api_key_payment_orders",
 	"linked_address_payment_orders",
+	"payment_order_batch_payment_orders",
 	"sender_profile_payment_orders",
 	"token_payment_orders",
 }
@@ -186,6 +226,10 @@ var (
 	GatewayIDValidator func(string) error
 	// ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
 	ReferenceValidator func(string) error
+	// FeeTierValidator is a validator for the "fee_tier" field. It is called by the builders before save.
+	FeeTierValidator func(string) error
+	// DefaultIsSandbox holds the default value on creation for the "is_sandbox" field.
+	DefaultIsSandbox bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -219,6 +263,58 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// UnderpaymentPolicy defines the type for the "underpayment_policy" enum field.
+type UnderpaymentPolicy string
+
+// UnderpaymentPolicySettle is the default value of the UnderpaymentPolicy enum.
+const DefaultUnderpaymentPolicy = UnderpaymentPolicySettle
+
+// UnderpaymentPolicy values.
+const (
+	UnderpaymentPolicySettle UnderpaymentPolicy = "settle"
+	UnderpaymentPolicyTopUp  UnderpaymentPolicy = "top_up"
+)
+
+func (up UnderpaymentPolicy) String() string {
+	return string(up)
+}
+
+// UnderpaymentPolicyValidator is a validator for the "underpayment_policy" field enum values. It is called by the builders before save.
+func UnderpaymentPolicyValidator(up UnderpaymentPolicy) error {
+	switch up {
+	case UnderpaymentPolicySettle, UnderpaymentPolicyTopUp:
+		return nil
+	default:
+		return fmt.Errorf("paymentorder: invalid enum value for underpayment_policy field: %q", up)
+	}
+}
+
+// OverpaymentPolicy defines the type for the "overpayment_policy" enum field.
+type OverpaymentPolicy string
+
+// OverpaymentPolicySettle is the default value of the OverpaymentPolicy enum.
+const DefaultOverpaymentPolicy = OverpaymentPolicySettle
+
+// OverpaymentPolicy values.
+const (
+	OverpaymentPolicySettle       OverpaymentPolicy = "settle"
+	OverpaymentPolicyRefundExcess OverpaymentPolicy = "refund_excess"
+)
+
+func (op OverpaymentPolicy) String() string {
+	return string(op)
+}
+
+// OverpaymentPolicyValidator is a validator for the "overpayment_policy" field enum values. It is called by the builders before save.
+func OverpaymentPolicyValidator(op OverpaymentPolicy) error {
+	switch op {
+	case OverpaymentPolicySettle, OverpaymentPolicyRefundExcess:
+		return nil
+	default:
+		return fmt.Errorf("paymentorder: invalid enum value for overpayment_policy field: %q", op)
+	}
+}
+
 // OrderOption defines the ordering options for the PaymentOrder queries.
 type OrderOption func(*sql.Selector)
 
@@ -322,11 +418,31 @@ func ByReference(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReference, opts...).ToFunc()
 }
 
+// ByFeeTier orders the results by the fee_tier field.
+func ByFeeTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeTier, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByUnderpaymentPolicy orders the results by the underpayment_policy field.
+func ByUnderpaymentPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnderpaymentPolicy, opts...).ToFunc()
+}
+
+// ByOverpaymentPolicy orders the results by the overpayment_policy field.
+func ByOverpaymentPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverpaymentPolicy, opts...).ToFunc()
+}
+
+// ByIsSandbox orders the results by the is_sandbox field.
+func ByIsSandbox(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsSandbox, opts...).ToFunc()
+}
+
 // BySenderProfileField orders the results by sender_profile field.
 func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -348,6 +464,13 @@ func ByLinkedAddressField(field string, opts ...sql.OrderTermOption) OrderOption
 	}
 }
 
+// ByBatchField orders the results by batch field.
+func ByBatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByReceiveAddressField orders the results by receive_address field.
 func ByReceiveAddressField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -362,6 +485,20 @@ func ByRecipientField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// BySplitsCount orders the results by splits count.
+func BySplitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSplitsStep(), opts...)
+	}
+}
+
+// BySplits orders the results by splits terms.
+func BySplits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSplitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTransactionsCount orders the results by transactions count.
 func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -375,6 +512,20 @@ func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDisputesCount orders the results by disputes count.
+func ByDisputesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisputesStep(), opts...)
+	}
+}
+
+// ByDisputes orders the results by disputes terms.
+func ByDisputes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisputesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSenderProfileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -396,6 +547,13 @@ func newLinkedAddressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, LinkedAddressTable, LinkedAddressColumn),
 	)
 }
+func newBatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+	)
+}
 func newReceiveAddressStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -410,6 +568,13 @@ func newRecipientStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, RecipientTable, RecipientColumn),
 	)
 }
+func newSplitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SplitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SplitsTable, SplitsColumn),
+	)
+}
 func newTransactionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -417,3 +582,10 @@ func newTransactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
 	)
 }
+func newDisputesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisputesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+	)
+}
@@ -152,6 +152,16 @@ func Reference(v string) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldReference, v))
 }
 
+// FeeTier applies equality check predicate on the "fee_tier" field. It's identical to FeeTierEQ.
+func FeeTier(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFeeTier, v))
+}
+
+// IsSandbox applies equality check predicate on the "is_sandbox" field. It's identical to IsSandboxEQ.
+func IsSandbox(v bool) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldIsSandbox, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -1147,6 +1157,81 @@ func ReferenceContainsFold(v string) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldContainsFold(FieldReference, v))
 }
 
+// FeeTierEQ applies the EQ predicate on the "fee_tier" field.
+func FeeTierEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFeeTier, v))
+}
+
+// FeeTierNEQ applies the NEQ predicate on the "fee_tier" field.
+func FeeTierNEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldFeeTier, v))
+}
+
+// FeeTierIn applies the In predicate on the "fee_tier" field.
+func FeeTierIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldFeeTier, vs...))
+}
+
+// FeeTierNotIn applies the NotIn predicate on the "fee_tier" field.
+func FeeTierNotIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldFeeTier, vs...))
+}
+
+// FeeTierGT applies the GT predicate on the "fee_tier" field.
+func FeeTierGT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldFeeTier, v))
+}
+
+// FeeTierGTE applies the GTE predicate on the "fee_tier" field.
+func FeeTierGTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldFeeTier, v))
+}
+
+// FeeTierLT applies the LT predicate on the "fee_tier" field.
+func FeeTierLT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldFeeTier, v))
+}
+
+// FeeTierLTE applies the LTE predicate on the "fee_tier" field.
+func FeeTierLTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldFeeTier, v))
+}
+
+// FeeTierContains applies the Contains predicate on the "fee_tier" field.
+func FeeTierContains(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContains(FieldFeeTier, v))
+}
+
+// FeeTierHasPrefix applies the HasPrefix predicate on the "fee_tier" field.
+func FeeTierHasPrefix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasPrefix(FieldFeeTier, v))
+}
+
+// FeeTierHasSuffix applies the HasSuffix predicate on the "fee_tier" field.
+func FeeTierHasSuffix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasSuffix(FieldFeeTier, v))
+}
+
+// FeeTierIsNil applies the IsNil predicate on the "fee_tier" field.
+func FeeTierIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldFeeTier))
+}
+
+// FeeTierNotNil applies the NotNil predicate on the "fee_tier" field.
+func FeeTierNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldFeeTier))
+}
+
+// FeeTierEqualFold applies the EqualFold predicate on the "fee_tier" field.
+func FeeTierEqualFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEqualFold(FieldFeeTier, v))
+}
+
+// FeeTierContainsFold applies the ContainsFold predicate on the "fee_tier" field.
+func FeeTierContainsFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContainsFold(FieldFeeTier, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldStatus, v))
@@ -1167,6 +1252,56 @@ func StatusNotIn(vs ...Status) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// UnderpaymentPolicyEQ applies the EQ predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyEQ(v UnderpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldUnderpaymentPolicy, v))
+}
+
+// UnderpaymentPolicyNEQ applies the NEQ predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyNEQ(v UnderpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldUnderpaymentPolicy, v))
+}
+
+// UnderpaymentPolicyIn applies the In predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyIn(vs ...UnderpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldUnderpaymentPolicy, vs...))
+}
+
+// UnderpaymentPolicyNotIn applies the NotIn predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyNotIn(vs ...UnderpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldUnderpaymentPolicy, vs...))
+}
+
+// OverpaymentPolicyEQ applies the EQ predicate on the "overpayment_policy" field.
+func OverpaymentPolicyEQ(v OverpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldOverpaymentPolicy, v))
+}
+
+// OverpaymentPolicyNEQ applies the NEQ predicate on the "overpayment_policy" field.
+func OverpaymentPolicyNEQ(v OverpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldOverpaymentPolicy, v))
+}
+
+// OverpaymentPolicyIn applies the In predicate on the "overpayment_policy" field.
+func OverpaymentPolicyIn(vs ...OverpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldOverpaymentPolicy, vs...))
+}
+
+// OverpaymentPolicyNotIn applies the NotIn predicate on the "overpayment_policy" field.
+func OverpaymentPolicyNotIn(vs ...OverpaymentPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldOverpaymentPolicy, vs...))
+}
+
+// IsSandboxEQ applies the EQ predicate on the "is_sandbox" field.
+func IsSandboxEQ(v bool) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldIsSandbox, v))
+}
+
+// IsSandboxNEQ applies the NEQ predicate on the "is_sandbox" field.
+func IsSandboxNEQ(v bool) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldIsSandbox, v))
+}
+
 // HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
 func HasSenderProfile() predicate.PaymentOrder {
 	return predicate.PaymentOrder(func(s *sql.Selector) {
@@ -1236,6 +1371,29 @@ func HasLinkedAddressWith(preds ...predicate.LinkedAddress) predicate.PaymentOrd
 	})
 }
 
+// HasBatch applies the HasEdge predicate on the "batch" edge.
+func HasBatch() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBatchWith applies the HasEdge predicate on the "batch" edge with a given conditions (other predicates).
+func HasBatchWith(preds ...predicate.PaymentOrderBatch) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newBatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasReceiveAddress applies the HasEdge predicate on the "receive_address" edge.
 func HasReceiveAddress() predicate.PaymentOrder {
 	return predicate.PaymentOrder(func(s *sql.Selector) {
@@ -1282,6 +1440,29 @@ func HasRecipientWith(preds ...predicate.PaymentOrderRecipient) predicate.Paymen
 	})
 }
 
+// HasSplits applies the HasEdge predicate on the "splits" edge.
+func HasSplits() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SplitsTable, SplitsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSplitsWith applies the HasEdge predicate on the "splits" edge with a given conditions (other predicates).
+func HasSplitsWith(preds ...predicate.PaymentOrderSplit) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newSplitsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTransactions applies the HasEdge predicate on the "transactions" edge.
 func HasTransactions() predicate.PaymentOrder {
 	return predicate.PaymentOrder(func(s *sql.Selector) {
@@ -1305,6 +1486,29 @@ func HasTransactionsWith(preds ...predicate.TransactionLog) predicate.PaymentOrd
 	})
 }
 
+// HasDisputes applies the HasEdge predicate on the "disputes" edge.
+func HasDisputes() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDisputesWith applies the HasEdge predicate on the "disputes" edge with a given conditions (other predicates).
+func HasDisputesWith(preds ...predicate.Dispute) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newDisputesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PaymentOrder) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.AndPredicates(predicates...))
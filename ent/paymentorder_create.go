@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -217,6 +220,20 @@ func (poc *PaymentOrderCreate) SetNillableReference(s *string) *PaymentOrderCrea
 	return poc
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (poc *PaymentOrderCreate) SetFeeTier(s string) *PaymentOrderCreate {
+	poc.mutation.SetFeeTier(s)
+	return poc
+}
+
+// SetNillableFeeTier sets the "fee_tier" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableFeeTier(s *string) *PaymentOrderCreate {
+	if s != nil {
+		poc.SetFeeTier(*s)
+	}
+	return poc
+}
+
 // SetStatus sets the "status" field.
 func (poc *PaymentOrderCreate) SetStatus(pa paymentorder.Status) *PaymentOrderCreate {
 	poc.mutation.SetStatus(pa)
@@ -231,6 +248,48 @@ func (poc *PaymentOrderCreate) SetNillableStatus(pa *paymentorder.Status) *Payme
 	return poc
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (poc *PaymentOrderCreate) SetUnderpaymentPolicy(pp paymentorder.UnderpaymentPolicy) *PaymentOrderCreate {
+	poc.mutation.SetUnderpaymentPolicy(pp)
+	return poc
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableUnderpaymentPolicy(pp *paymentorder.UnderpaymentPolicy) *PaymentOrderCreate {
+	if pp != nil {
+		poc.SetUnderpaymentPolicy(*pp)
+	}
+	return poc
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (poc *PaymentOrderCreate) SetOverpaymentPolicy(pp paymentorder.OverpaymentPolicy) *PaymentOrderCreate {
+	poc.mutation.SetOverpaymentPolicy(pp)
+	return poc
+}
+
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableOverpaymentPolicy(pp *paymentorder.OverpaymentPolicy) *PaymentOrderCreate {
+	if pp != nil {
+		poc.SetOverpaymentPolicy(*pp)
+	}
+	return poc
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (poc *PaymentOrderCreate) SetIsSandbox(b bool) *PaymentOrderCreate {
+	poc.mutation.SetIsSandbox(b)
+	return poc
+}
+
+// SetNillableIsSandbox sets the "is_sandbox" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableIsSandbox(b *bool) *PaymentOrderCreate {
+	if b != nil {
+		poc.SetIsSandbox(*b)
+	}
+	return poc
+}
+
 // SetID sets the "id" field.
 func (poc *PaymentOrderCreate) SetID(u uuid.UUID) *PaymentOrderCreate {
 	poc.mutation.SetID(u)
@@ -294,6 +353,25 @@ func (poc *PaymentOrderCreate) SetLinkedAddress(l *LinkedAddress) *PaymentOrderC
 	return poc.SetLinkedAddressID(l.ID)
 }
 
+// SetBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID.
+func (poc *PaymentOrderCreate) SetBatchID(id uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.SetBatchID(id)
+	return poc
+}
+
+// SetNillableBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableBatchID(id *uuid.UUID) *PaymentOrderCreate {
+	if id != nil {
+		poc = poc.SetBatchID(*id)
+	}
+	return poc
+}
+
+// SetBatch sets the "batch" edge to the PaymentOrderBatch entity.
+func (poc *PaymentOrderCreate) SetBatch(p *PaymentOrderBatch) *PaymentOrderCreate {
+	return poc.SetBatchID(p.ID)
+}
+
 // SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
 func (poc *PaymentOrderCreate) SetReceiveAddressID(id int) *PaymentOrderCreate {
 	poc.mutation.SetReceiveAddressID(id)
@@ -332,6 +410,21 @@ func (poc *PaymentOrderCreate) SetRecipient(p *PaymentOrderRecipient) *PaymentOr
 	return poc.SetRecipientID(p.ID)
 }
 
+// AddSplitIDs adds the "splits" edge to the PaymentOrderSplit entity by IDs.
+func (poc *PaymentOrderCreate) AddSplitIDs(ids ...int) *PaymentOrderCreate {
+	poc.mutation.AddSplitIDs(ids...)
+	return poc
+}
+
+// AddSplits adds the "splits" edges to the PaymentOrderSplit entity.
+func (poc *PaymentOrderCreate) AddSplits(p ...*PaymentOrderSplit) *PaymentOrderCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return poc.AddSplitIDs(ids...)
+}
+
 // AddTransactionIDs adds the "transactions" edge to the TransactionLog entity by IDs.
 func (poc *PaymentOrderCreate) AddTransactionIDs(ids ...uuid.UUID) *PaymentOrderCreate {
 	poc.mutation.AddTransactionIDs(ids...)
@@ -347,6 +440,21 @@ func (poc *PaymentOrderCreate) AddTransactions(t ...*TransactionLog) *PaymentOrd
 	return poc.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (poc *PaymentOrderCreate) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddDisputeIDs(ids...)
+	return poc
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (poc *PaymentOrderCreate) AddDisputes(d ...*Dispute) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return poc.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (poc *PaymentOrderCreate) Mutation() *PaymentOrderMutation {
 	return poc.mutation
@@ -398,6 +506,18 @@ func (poc *PaymentOrderCreate) defaults() {
 		v := paymentorder.DefaultStatus
 		poc.mutation.SetStatus(v)
 	}
+	if _, ok := poc.mutation.UnderpaymentPolicy(); !ok {
+		v := paymentorder.DefaultUnderpaymentPolicy
+		poc.mutation.SetUnderpaymentPolicy(v)
+	}
+	if _, ok := poc.mutation.OverpaymentPolicy(); !ok {
+		v := paymentorder.DefaultOverpaymentPolicy
+		poc.mutation.SetOverpaymentPolicy(v)
+	}
+	if _, ok := poc.mutation.IsSandbox(); !ok {
+		v := paymentorder.DefaultIsSandbox
+		poc.mutation.SetIsSandbox(v)
+	}
 	if _, ok := poc.mutation.ID(); !ok {
 		v := paymentorder.DefaultID()
 		poc.mutation.SetID(v)
@@ -480,6 +600,11 @@ func (poc *PaymentOrderCreate) check() error {
 			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.reference": %w`, err)}
 		}
 	}
+	if v, ok := poc.mutation.FeeTier(); ok {
+		if err := paymentorder.FeeTierValidator(v); err != nil {
+			return &ValidationError{Name: "fee_tier", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fee_tier": %w`, err)}
+		}
+	}
 	if _, ok := poc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentOrder.status"`)}
 	}
@@ -488,6 +613,25 @@ func (poc *PaymentOrderCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
 		}
 	}
+	if _, ok := poc.mutation.UnderpaymentPolicy(); !ok {
+		return &ValidationError{Name: "underpayment_policy", err: errors.New(`ent: missing required field "PaymentOrder.underpayment_policy"`)}
+	}
+	if v, ok := poc.mutation.UnderpaymentPolicy(); ok {
+		if err := paymentorder.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.underpayment_policy": %w`, err)}
+		}
+	}
+	if _, ok := poc.mutation.OverpaymentPolicy(); !ok {
+		return &ValidationError{Name: "overpayment_policy", err: errors.New(`ent: missing required field "PaymentOrder.overpayment_policy"`)}
+	}
+	if v, ok := poc.mutation.OverpaymentPolicy(); ok {
+		if err := paymentorder.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.overpayment_policy": %w`, err)}
+		}
+	}
+	if _, ok := poc.mutation.IsSandbox(); !ok {
+		return &ValidationError{Name: "is_sandbox", err: errors.New(`ent: missing required field "PaymentOrder.is_sandbox"`)}
+	}
 	if len(poc.mutation.TokenIDs()) == 0 {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "PaymentOrder.token"`)}
 	}
@@ -603,10 +747,26 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		_spec.SetField(paymentorder.FieldReference, field.TypeString, value)
 		_node.Reference = value
 	}
+	if value, ok := poc.mutation.FeeTier(); ok {
+		_spec.SetField(paymentorder.FieldFeeTier, field.TypeString, value)
+		_node.FeeTier = value
+	}
 	if value, ok := poc.mutation.Status(); ok {
 		_spec.SetField(paymentorder.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := poc.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldUnderpaymentPolicy, field.TypeEnum, value)
+		_node.UnderpaymentPolicy = value
+	}
+	if value, ok := poc.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldOverpaymentPolicy, field.TypeEnum, value)
+		_node.OverpaymentPolicy = value
+	}
+	if value, ok := poc.mutation.IsSandbox(); ok {
+		_spec.SetField(paymentorder.FieldIsSandbox, field.TypeBool, value)
+		_node.IsSandbox = value
+	}
 	if nodes := poc.mutation.SenderProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -658,6 +818,23 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		_node.linked_address_payment_orders = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := poc.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.BatchTable,
+			Columns: []string{paymentorder.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_batch_payment_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := poc.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -690,6 +867,22 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := poc.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := poc.mutation.TransactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -706,6 +899,22 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := poc.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1070,6 +1279,24 @@ func (u *PaymentOrderUpsert) ClearReference() *PaymentOrderUpsert {
 	return u
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (u *PaymentOrderUpsert) SetFeeTier(v string) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldFeeTier, v)
+	return u
+}
+
+// UpdateFeeTier sets the "fee_tier" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateFeeTier() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldFeeTier)
+	return u
+}
+
+// ClearFeeTier clears the value of the "fee_tier" field.
+func (u *PaymentOrderUpsert) ClearFeeTier() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldFeeTier)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *PaymentOrderUpsert) SetStatus(v paymentorder.Status) *PaymentOrderUpsert {
 	u.Set(paymentorder.FieldStatus, v)
@@ -1082,6 +1309,42 @@ func (u *PaymentOrderUpsert) UpdateStatus() *PaymentOrderUpsert {
 	return u
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *PaymentOrderUpsert) SetUnderpaymentPolicy(v paymentorder.UnderpaymentPolicy) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldUnderpaymentPolicy, v)
+	return u
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateUnderpaymentPolicy() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldUnderpaymentPolicy)
+	return u
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *PaymentOrderUpsert) SetOverpaymentPolicy(v paymentorder.OverpaymentPolicy) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldOverpaymentPolicy, v)
+	return u
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateOverpaymentPolicy() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldOverpaymentPolicy)
+	return u
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (u *PaymentOrderUpsert) SetIsSandbox(v bool) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldIsSandbox, v)
+	return u
+}
+
+// UpdateIsSandbox sets the "is_sandbox" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateIsSandbox() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldIsSandbox)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1497,6 +1760,27 @@ func (u *PaymentOrderUpsertOne) ClearReference() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (u *PaymentOrderUpsertOne) SetFeeTier(v string) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFeeTier(v)
+	})
+}
+
+// UpdateFeeTier sets the "fee_tier" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateFeeTier() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFeeTier()
+	})
+}
+
+// ClearFeeTier clears the value of the "fee_tier" field.
+func (u *PaymentOrderUpsertOne) ClearFeeTier() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFeeTier()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *PaymentOrderUpsertOne) SetStatus(v paymentorder.Status) *PaymentOrderUpsertOne {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -1511,6 +1795,48 @@ func (u *PaymentOrderUpsertOne) UpdateStatus() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *PaymentOrderUpsertOne) SetUnderpaymentPolicy(v paymentorder.UnderpaymentPolicy) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetUnderpaymentPolicy(v)
+	})
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateUnderpaymentPolicy() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateUnderpaymentPolicy()
+	})
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *PaymentOrderUpsertOne) SetOverpaymentPolicy(v paymentorder.OverpaymentPolicy) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetOverpaymentPolicy(v)
+	})
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateOverpaymentPolicy() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateOverpaymentPolicy()
+	})
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (u *PaymentOrderUpsertOne) SetIsSandbox(v bool) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetIsSandbox(v)
+	})
+}
+
+// UpdateIsSandbox sets the "is_sandbox" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateIsSandbox() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateIsSandbox()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentOrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2093,6 +2419,27 @@ func (u *PaymentOrderUpsertBulk) ClearReference() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (u *PaymentOrderUpsertBulk) SetFeeTier(v string) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFeeTier(v)
+	})
+}
+
+// UpdateFeeTier sets the "fee_tier" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateFeeTier() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFeeTier()
+	})
+}
+
+// ClearFeeTier clears the value of the "fee_tier" field.
+func (u *PaymentOrderUpsertBulk) ClearFeeTier() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFeeTier()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *PaymentOrderUpsertBulk) SetStatus(v paymentorder.Status) *PaymentOrderUpsertBulk {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -2107,6 +2454,48 @@ func (u *PaymentOrderUpsertBulk) UpdateStatus() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *PaymentOrderUpsertBulk) SetUnderpaymentPolicy(v paymentorder.UnderpaymentPolicy) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetUnderpaymentPolicy(v)
+	})
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateUnderpaymentPolicy() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateUnderpaymentPolicy()
+	})
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *PaymentOrderUpsertBulk) SetOverpaymentPolicy(v paymentorder.OverpaymentPolicy) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetOverpaymentPolicy(v)
+	})
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateOverpaymentPolicy() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateOverpaymentPolicy()
+	})
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (u *PaymentOrderUpsertBulk) SetIsSandbox(v bool) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetIsSandbox(v)
+	})
+}
+
+// UpdateIsSandbox sets the "is_sandbox" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateIsSandbox() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateIsSandbox()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentOrderUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderprofile"
@@ -33,9 +36,12 @@ type PaymentOrderQuery struct {
 	withSenderProfile  *SenderProfileQuery
 	withToken          *TokenQuery
 	withLinkedAddress  *LinkedAddressQuery
+	withBatch          *PaymentOrderBatchQuery
 	withReceiveAddress *ReceiveAddressQuery
 	withRecipient      *PaymentOrderRecipientQuery
+	withSplits         *PaymentOrderSplitQuery
 	withTransactions   *TransactionLogQuery
+	withDisputes       *DisputeQuery
 	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -139,6 +145,28 @@ func (poq *PaymentOrderQuery) QueryLinkedAddress() *LinkedAddressQuery {
 	return query
 }
 
+// QueryBatch chains the current query on the "batch" edge.
+func (poq *PaymentOrderQuery) QueryBatch() *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.BatchTable, paymentorder.BatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryReceiveAddress chains the current query on the "receive_address" edge.
 func (poq *PaymentOrderQuery) QueryReceiveAddress() *ReceiveAddressQuery {
 	query := (&ReceiveAddressClient{config: poq.config}).Query()
@@ -183,6 +211,28 @@ func (poq *PaymentOrderQuery) QueryRecipient() *PaymentOrderRecipientQuery {
 	return query
 }
 
+// QuerySplits chains the current query on the "splits" edge.
+func (poq *PaymentOrderQuery) QuerySplits() *PaymentOrderSplitQuery {
+	query := (&PaymentOrderSplitClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(paymentordersplit.Table, paymentordersplit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.SplitsTable, paymentorder.SplitsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTransactions chains the current query on the "transactions" edge.
 func (poq *PaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	query := (&TransactionLogClient{config: poq.config}).Query()
@@ -205,6 +255,28 @@ func (poq *PaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	return query
 }
 
+// QueryDisputes chains the current query on the "disputes" edge.
+func (poq *PaymentOrderQuery) QueryDisputes() *DisputeQuery {
+	query := (&DisputeClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.DisputesTable, paymentorder.DisputesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PaymentOrder entity from the query.
 // Returns a *NotFoundError when no PaymentOrder was found.
 func (poq *PaymentOrderQuery) First(ctx context.Context) (*PaymentOrder, error) {
@@ -400,9 +472,12 @@ func (poq *PaymentOrderQuery) Clone() *PaymentOrderQuery {
 		withSenderProfile:  poq.withSenderProfile.Clone(),
 		withToken:          poq.withToken.Clone(),
 		withLinkedAddress:  poq.withLinkedAddress.Clone(),
+		withBatch:          poq.withBatch.Clone(),
 		withReceiveAddress: poq.withReceiveAddress.Clone(),
 		withRecipient:      poq.withRecipient.Clone(),
+		withSplits:         poq.withSplits.Clone(),
 		withTransactions:   poq.withTransactions.Clone(),
+		withDisputes:       poq.withDisputes.Clone(),
 		// clone intermediate query.
 		sql:  poq.sql.Clone(),
 		path: poq.path,
@@ -442,6 +517,17 @@ func (poq *PaymentOrderQuery) WithLinkedAddress(opts ...func(*LinkedAddressQuery
 	return poq
 }
 
+// WithBatch tells the query-builder to eager-load the nodes that are connected to
+// the "batch" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithBatch(opts ...func(*PaymentOrderBatchQuery)) *PaymentOrderQuery {
+	query := (&PaymentOrderBatchClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withBatch = query
+	return poq
+}
+
 // WithReceiveAddress tells the query-builder to eager-load the nodes that are connected to
 // the "receive_address" edge. The optional arguments are used to configure the query builder of the edge.
 func (poq *PaymentOrderQuery) WithReceiveAddress(opts ...func(*ReceiveAddressQuery)) *PaymentOrderQuery {
@@ -464,6 +550,17 @@ func (poq *PaymentOrderQuery) WithRecipient(opts ...func(*PaymentOrderRecipientQ
 	return poq
 }
 
+// WithSplits tells the query-builder to eager-load the nodes that are connected to
+// the "splits" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithSplits(opts ...func(*PaymentOrderSplitQuery)) *PaymentOrderQuery {
+	query := (&PaymentOrderSplitClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withSplits = query
+	return poq
+}
+
 // WithTransactions tells the query-builder to eager-load the nodes that are connected to
 // the "transactions" edge. The optional arguments are used to configure the query builder of the edge.
 func (poq *PaymentOrderQuery) WithTransactions(opts ...func(*TransactionLogQuery)) *PaymentOrderQuery {
@@ -475,6 +572,17 @@ func (poq *PaymentOrderQuery) WithTransactions(opts ...func(*TransactionLogQuery
 	return poq
 }
 
+// WithDisputes tells the query-builder to eager-load the nodes that are connected to
+// the "disputes" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithDisputes(opts ...func(*DisputeQuery)) *PaymentOrderQuery {
+	query := (&DisputeClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withDisputes = query
+	return poq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -554,16 +662,19 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*PaymentOrder{}
 		withFKs     = poq.withFKs
 		_spec       = poq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [9]bool{
 			poq.withSenderProfile != nil,
 			poq.withToken != nil,
 			poq.withLinkedAddress != nil,
+			poq.withBatch != nil,
 			poq.withReceiveAddress != nil,
 			poq.withRecipient != nil,
+			poq.withSplits != nil,
 			poq.withTransactions != nil,
+			poq.withDisputes != nil,
 		}
 	)
-	if poq.withSenderProfile != nil || poq.withToken != nil || poq.withLinkedAddress != nil {
+	if poq.withSenderProfile != nil || poq.withToken != nil || poq.withLinkedAddress != nil || poq.withBatch != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -605,6 +716,12 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := poq.withBatch; query != nil {
+		if err := poq.loadBatch(ctx, query, nodes, nil,
+			func(n *PaymentOrder, e *PaymentOrderBatch) { n.Edges.Batch = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := poq.withReceiveAddress; query != nil {
 		if err := poq.loadReceiveAddress(ctx, query, nodes, nil,
 			func(n *PaymentOrder, e *ReceiveAddress) { n.Edges.ReceiveAddress = e }); err != nil {
@@ -617,6 +734,13 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := poq.withSplits; query != nil {
+		if err := poq.loadSplits(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.Splits = []*PaymentOrderSplit{} },
+			func(n *PaymentOrder, e *PaymentOrderSplit) { n.Edges.Splits = append(n.Edges.Splits, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := poq.withTransactions; query != nil {
 		if err := poq.loadTransactions(ctx, query, nodes,
 			func(n *PaymentOrder) { n.Edges.Transactions = []*TransactionLog{} },
@@ -624,6 +748,13 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := poq.withDisputes; query != nil {
+		if err := poq.loadDisputes(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.Disputes = []*Dispute{} },
+			func(n *PaymentOrder, e *Dispute) { n.Edges.Disputes = append(n.Edges.Disputes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -723,6 +854,38 @@ func (poq *PaymentOrderQuery) loadLinkedAddress(ctx context.Context, query *Link
 	}
 	return nil
 }
+func (poq *PaymentOrderQuery) loadBatch(ctx context.Context, query *PaymentOrderBatchQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *PaymentOrderBatch)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PaymentOrder)
+	for i := range nodes {
+		if nodes[i].payment_order_batch_payment_orders == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_batch_payment_orders
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorderbatch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_batch_payment_orders" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (poq *PaymentOrderQuery) loadReceiveAddress(ctx context.Context, query *ReceiveAddressQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *ReceiveAddress)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*PaymentOrder)
@@ -779,6 +942,37 @@ func (poq *PaymentOrderQuery) loadRecipient(ctx context.Context, query *PaymentO
 	}
 	return nil
 }
+func (poq *PaymentOrderQuery) loadSplits(ctx context.Context, query *PaymentOrderSplitQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *PaymentOrderSplit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentOrderSplit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.SplitsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_splits
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_splits" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_splits" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (poq *PaymentOrderQuery) loadTransactions(ctx context.Context, query *TransactionLogQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *TransactionLog)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*PaymentOrder)
@@ -810,6 +1004,37 @@ func (poq *PaymentOrderQuery) loadTransactions(ctx context.Context, query *Trans
 	}
 	return nil
 }
+func (poq *PaymentOrderQuery) loadDisputes(ctx context.Context, query *DisputeQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *Dispute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Dispute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.DisputesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_disputes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_disputes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_disputes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (poq *PaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := poq.querySpec()
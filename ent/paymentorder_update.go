@@ -12,9 +12,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderprofile"
@@ -386,6 +389,26 @@ func (pou *PaymentOrderUpdate) ClearReference() *PaymentOrderUpdate {
 	return pou
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (pou *PaymentOrderUpdate) SetFeeTier(s string) *PaymentOrderUpdate {
+	pou.mutation.SetFeeTier(s)
+	return pou
+}
+
+// SetNillableFeeTier sets the "fee_tier" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableFeeTier(s *string) *PaymentOrderUpdate {
+	if s != nil {
+		pou.SetFeeTier(*s)
+	}
+	return pou
+}
+
+// ClearFeeTier clears the value of the "fee_tier" field.
+func (pou *PaymentOrderUpdate) ClearFeeTier() *PaymentOrderUpdate {
+	pou.mutation.ClearFeeTier()
+	return pou
+}
+
 // SetStatus sets the "status" field.
 func (pou *PaymentOrderUpdate) SetStatus(pa paymentorder.Status) *PaymentOrderUpdate {
 	pou.mutation.SetStatus(pa)
@@ -400,6 +423,48 @@ func (pou *PaymentOrderUpdate) SetNillableStatus(pa *paymentorder.Status) *Payme
 	return pou
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (pou *PaymentOrderUpdate) SetUnderpaymentPolicy(pp paymentorder.UnderpaymentPolicy) *PaymentOrderUpdate {
+	pou.mutation.SetUnderpaymentPolicy(pp)
+	return pou
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableUnderpaymentPolicy(pp *paymentorder.UnderpaymentPolicy) *PaymentOrderUpdate {
+	if pp != nil {
+		pou.SetUnderpaymentPolicy(*pp)
+	}
+	return pou
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (pou *PaymentOrderUpdate) SetOverpaymentPolicy(pp paymentorder.OverpaymentPolicy) *PaymentOrderUpdate {
+	pou.mutation.SetOverpaymentPolicy(pp)
+	return pou
+}
+
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableOverpaymentPolicy(pp *paymentorder.OverpaymentPolicy) *PaymentOrderUpdate {
+	if pp != nil {
+		pou.SetOverpaymentPolicy(*pp)
+	}
+	return pou
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (pou *PaymentOrderUpdate) SetIsSandbox(b bool) *PaymentOrderUpdate {
+	pou.mutation.SetIsSandbox(b)
+	return pou
+}
+
+// SetNillableIsSandbox sets the "is_sandbox" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableIsSandbox(b *bool) *PaymentOrderUpdate {
+	if b != nil {
+		pou.SetIsSandbox(*b)
+	}
+	return pou
+}
+
 // SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
 func (pou *PaymentOrderUpdate) SetSenderProfileID(id uuid.UUID) *PaymentOrderUpdate {
 	pou.mutation.SetSenderProfileID(id)
@@ -449,6 +514,25 @@ func (pou *PaymentOrderUpdate) SetLinkedAddress(l *LinkedAddress) *PaymentOrderU
 	return pou.SetLinkedAddressID(l.ID)
 }
 
+// SetBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID.
+func (pou *PaymentOrderUpdate) SetBatchID(id uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.SetBatchID(id)
+	return pou
+}
+
+// SetNillableBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableBatchID(id *uuid.UUID) *PaymentOrderUpdate {
+	if id != nil {
+		pou = pou.SetBatchID(*id)
+	}
+	return pou
+}
+
+// SetBatch sets the "batch" edge to the PaymentOrderBatch entity.
+func (pou *PaymentOrderUpdate) SetBatch(p *PaymentOrderBatch) *PaymentOrderUpdate {
+	return pou.SetBatchID(p.ID)
+}
+
 // SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
 func (pou *PaymentOrderUpdate) SetReceiveAddressID(id int) *PaymentOrderUpdate {
 	pou.mutation.SetReceiveAddressID(id)
@@ -487,6 +571,21 @@ func (pou *PaymentOrderUpdate) SetRecipient(p *PaymentOrderRecipient) *PaymentOr
 	return pou.SetRecipientID(p.ID)
 }
 
+// AddSplitIDs adds the "splits" edge to the PaymentOrderSplit entity by IDs.
+func (pou *PaymentOrderUpdate) AddSplitIDs(ids ...int) *PaymentOrderUpdate {
+	pou.mutation.AddSplitIDs(ids...)
+	return pou
+}
+
+// AddSplits adds the "splits" edges to the PaymentOrderSplit entity.
+func (pou *PaymentOrderUpdate) AddSplits(p ...*PaymentOrderSplit) *PaymentOrderUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pou.AddSplitIDs(ids...)
+}
+
 // AddTransactionIDs adds the "transactions" edge to the TransactionLog entity by IDs.
 func (pou *PaymentOrderUpdate) AddTransactionIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
 	pou.mutation.AddTransactionIDs(ids...)
@@ -502,6 +601,21 @@ func (pou *PaymentOrderUpdate) AddTransactions(t ...*TransactionLog) *PaymentOrd
 	return pou.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (pou *PaymentOrderUpdate) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddDisputeIDs(ids...)
+	return pou
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (pou *PaymentOrderUpdate) AddDisputes(d ...*Dispute) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pou.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pou *PaymentOrderUpdate) Mutation() *PaymentOrderMutation {
 	return pou.mutation
@@ -525,6 +639,12 @@ func (pou *PaymentOrderUpdate) ClearLinkedAddress() *PaymentOrderUpdate {
 	return pou
 }
 
+// ClearBatch clears the "batch" edge to the PaymentOrderBatch entity.
+func (pou *PaymentOrderUpdate) ClearBatch() *PaymentOrderUpdate {
+	pou.mutation.ClearBatch()
+	return pou
+}
+
 // ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
 func (pou *PaymentOrderUpdate) ClearReceiveAddress() *PaymentOrderUpdate {
 	pou.mutation.ClearReceiveAddress()
@@ -537,6 +657,27 @@ func (pou *PaymentOrderUpdate) ClearRecipient() *PaymentOrderUpdate {
 	return pou
 }
 
+// ClearSplits clears all "splits" edges to the PaymentOrderSplit entity.
+func (pou *PaymentOrderUpdate) ClearSplits() *PaymentOrderUpdate {
+	pou.mutation.ClearSplits()
+	return pou
+}
+
+// RemoveSplitIDs removes the "splits" edge to PaymentOrderSplit entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveSplitIDs(ids ...int) *PaymentOrderUpdate {
+	pou.mutation.RemoveSplitIDs(ids...)
+	return pou
+}
+
+// RemoveSplits removes "splits" edges to PaymentOrderSplit entities.
+func (pou *PaymentOrderUpdate) RemoveSplits(p ...*PaymentOrderSplit) *PaymentOrderUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pou.RemoveSplitIDs(ids...)
+}
+
 // ClearTransactions clears all "transactions" edges to the TransactionLog entity.
 func (pou *PaymentOrderUpdate) ClearTransactions() *PaymentOrderUpdate {
 	pou.mutation.ClearTransactions()
@@ -558,6 +699,27 @@ func (pou *PaymentOrderUpdate) RemoveTransactions(t ...*TransactionLog) *Payment
 	return pou.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (pou *PaymentOrderUpdate) ClearDisputes() *PaymentOrderUpdate {
+	pou.mutation.ClearDisputes()
+	return pou
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveDisputeIDs(ids...)
+	return pou
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (pou *PaymentOrderUpdate) RemoveDisputes(d ...*Dispute) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pou.RemoveDisputeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pou *PaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	pou.defaults()
@@ -631,11 +793,26 @@ func (pou *PaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.reference": %w`, err)}
 		}
 	}
+	if v, ok := pou.mutation.FeeTier(); ok {
+		if err := paymentorder.FeeTierValidator(v); err != nil {
+			return &ValidationError{Name: "fee_tier", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fee_tier": %w`, err)}
+		}
+	}
 	if v, ok := pou.mutation.Status(); ok {
 		if err := paymentorder.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
 		}
 	}
+	if v, ok := pou.mutation.UnderpaymentPolicy(); ok {
+		if err := paymentorder.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.underpayment_policy": %w`, err)}
+		}
+	}
+	if v, ok := pou.mutation.OverpaymentPolicy(); ok {
+		if err := paymentorder.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.overpayment_policy": %w`, err)}
+		}
+	}
 	if pou.mutation.TokenCleared() && len(pou.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PaymentOrder.token"`)
 	}
@@ -756,9 +933,24 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pou.mutation.ReferenceCleared() {
 		_spec.ClearField(paymentorder.FieldReference, field.TypeString)
 	}
+	if value, ok := pou.mutation.FeeTier(); ok {
+		_spec.SetField(paymentorder.FieldFeeTier, field.TypeString, value)
+	}
+	if pou.mutation.FeeTierCleared() {
+		_spec.ClearField(paymentorder.FieldFeeTier, field.TypeString)
+	}
 	if value, ok := pou.mutation.Status(); ok {
 		_spec.SetField(paymentorder.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := pou.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldUnderpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pou.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldOverpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pou.mutation.IsSandbox(); ok {
+		_spec.SetField(paymentorder.FieldIsSandbox, field.TypeBool, value)
+	}
 	if pou.mutation.SenderProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -846,6 +1038,35 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pou.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.BatchTable,
+			Columns: []string{paymentorder.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.BatchTable,
+			Columns: []string{paymentorder.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pou.mutation.ReceiveAddressCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -904,6 +1125,51 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pou.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !pou.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pou.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -949,6 +1215,51 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !pou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentorder.Label}
@@ -1319,6 +1630,26 @@ func (pouo *PaymentOrderUpdateOne) ClearReference() *PaymentOrderUpdateOne {
 	return pouo
 }
 
+// SetFeeTier sets the "fee_tier" field.
+func (pouo *PaymentOrderUpdateOne) SetFeeTier(s string) *PaymentOrderUpdateOne {
+	pouo.mutation.SetFeeTier(s)
+	return pouo
+}
+
+// SetNillableFeeTier sets the "fee_tier" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableFeeTier(s *string) *PaymentOrderUpdateOne {
+	if s != nil {
+		pouo.SetFeeTier(*s)
+	}
+	return pouo
+}
+
+// ClearFeeTier clears the value of the "fee_tier" field.
+func (pouo *PaymentOrderUpdateOne) ClearFeeTier() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearFeeTier()
+	return pouo
+}
+
 // SetStatus sets the "status" field.
 func (pouo *PaymentOrderUpdateOne) SetStatus(pa paymentorder.Status) *PaymentOrderUpdateOne {
 	pouo.mutation.SetStatus(pa)
@@ -1333,6 +1664,48 @@ func (pouo *PaymentOrderUpdateOne) SetNillableStatus(pa *paymentorder.Status) *P
 	return pouo
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (pouo *PaymentOrderUpdateOne) SetUnderpaymentPolicy(pp paymentorder.UnderpaymentPolicy) *PaymentOrderUpdateOne {
+	pouo.mutation.SetUnderpaymentPolicy(pp)
+	return pouo
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableUnderpaymentPolicy(pp *paymentorder.UnderpaymentPolicy) *PaymentOrderUpdateOne {
+	if pp != nil {
+		pouo.SetUnderpaymentPolicy(*pp)
+	}
+	return pouo
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (pouo *PaymentOrderUpdateOne) SetOverpaymentPolicy(pp paymentorder.OverpaymentPolicy) *PaymentOrderUpdateOne {
+	pouo.mutation.SetOverpaymentPolicy(pp)
+	return pouo
+}
+
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableOverpaymentPolicy(pp *paymentorder.OverpaymentPolicy) *PaymentOrderUpdateOne {
+	if pp != nil {
+		pouo.SetOverpaymentPolicy(*pp)
+	}
+	return pouo
+}
+
+// SetIsSandbox sets the "is_sandbox" field.
+func (pouo *PaymentOrderUpdateOne) SetIsSandbox(b bool) *PaymentOrderUpdateOne {
+	pouo.mutation.SetIsSandbox(b)
+	return pouo
+}
+
+// SetNillableIsSandbox sets the "is_sandbox" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableIsSandbox(b *bool) *PaymentOrderUpdateOne {
+	if b != nil {
+		pouo.SetIsSandbox(*b)
+	}
+	return pouo
+}
+
 // SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
 func (pouo *PaymentOrderUpdateOne) SetSenderProfileID(id uuid.UUID) *PaymentOrderUpdateOne {
 	pouo.mutation.SetSenderProfileID(id)
@@ -1382,6 +1755,25 @@ func (pouo *PaymentOrderUpdateOne) SetLinkedAddress(l *LinkedAddress) *PaymentOr
 	return pouo.SetLinkedAddressID(l.ID)
 }
 
+// SetBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetBatchID(id uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.SetBatchID(id)
+	return pouo
+}
+
+// SetNillableBatchID sets the "batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableBatchID(id *uuid.UUID) *PaymentOrderUpdateOne {
+	if id != nil {
+		pouo = pouo.SetBatchID(*id)
+	}
+	return pouo
+}
+
+// SetBatch sets the "batch" edge to the PaymentOrderBatch entity.
+func (pouo *PaymentOrderUpdateOne) SetBatch(p *PaymentOrderBatch) *PaymentOrderUpdateOne {
+	return pouo.SetBatchID(p.ID)
+}
+
 // SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
 func (pouo *PaymentOrderUpdateOne) SetReceiveAddressID(id int) *PaymentOrderUpdateOne {
 	pouo.mutation.SetReceiveAddressID(id)
@@ -1420,6 +1812,21 @@ func (pouo *PaymentOrderUpdateOne) SetRecipient(p *PaymentOrderRecipient) *Payme
 	return pouo.SetRecipientID(p.ID)
 }
 
+// AddSplitIDs adds the "splits" edge to the PaymentOrderSplit entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddSplitIDs(ids ...int) *PaymentOrderUpdateOne {
+	pouo.mutation.AddSplitIDs(ids...)
+	return pouo
+}
+
+// AddSplits adds the "splits" edges to the PaymentOrderSplit entity.
+func (pouo *PaymentOrderUpdateOne) AddSplits(p ...*PaymentOrderSplit) *PaymentOrderUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pouo.AddSplitIDs(ids...)
+}
+
 // AddTransactionIDs adds the "transactions" edge to the TransactionLog entity by IDs.
 func (pouo *PaymentOrderUpdateOne) AddTransactionIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
 	pouo.mutation.AddTransactionIDs(ids...)
@@ -1435,6 +1842,21 @@ func (pouo *PaymentOrderUpdateOne) AddTransactions(t ...*TransactionLog) *Paymen
 	return pouo.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddDisputeIDs(ids...)
+	return pouo
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (pouo *PaymentOrderUpdateOne) AddDisputes(d ...*Dispute) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pouo.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pouo *PaymentOrderUpdateOne) Mutation() *PaymentOrderMutation {
 	return pouo.mutation
@@ -1458,6 +1880,12 @@ func (pouo *PaymentOrderUpdateOne) ClearLinkedAddress() *PaymentOrderUpdateOne {
 	return pouo
 }
 
+// ClearBatch clears the "batch" edge to the PaymentOrderBatch entity.
+func (pouo *PaymentOrderUpdateOne) ClearBatch() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearBatch()
+	return pouo
+}
+
 // ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
 func (pouo *PaymentOrderUpdateOne) ClearReceiveAddress() *PaymentOrderUpdateOne {
 	pouo.mutation.ClearReceiveAddress()
@@ -1470,6 +1898,27 @@ func (pouo *PaymentOrderUpdateOne) ClearRecipient() *PaymentOrderUpdateOne {
 	return pouo
 }
 
+// ClearSplits clears all "splits" edges to the PaymentOrderSplit entity.
+func (pouo *PaymentOrderUpdateOne) ClearSplits() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearSplits()
+	return pouo
+}
+
+// RemoveSplitIDs removes the "splits" edge to PaymentOrderSplit entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveSplitIDs(ids ...int) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveSplitIDs(ids...)
+	return pouo
+}
+
+// RemoveSplits removes "splits" edges to PaymentOrderSplit entities.
+func (pouo *PaymentOrderUpdateOne) RemoveSplits(p ...*PaymentOrderSplit) *PaymentOrderUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pouo.RemoveSplitIDs(ids...)
+}
+
 // ClearTransactions clears all "transactions" edges to the TransactionLog entity.
 func (pouo *PaymentOrderUpdateOne) ClearTransactions() *PaymentOrderUpdateOne {
 	pouo.mutation.ClearTransactions()
@@ -1491,6 +1940,27 @@ func (pouo *PaymentOrderUpdateOne) RemoveTransactions(t ...*TransactionLog) *Pay
 	return pouo.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (pouo *PaymentOrderUpdateOne) ClearDisputes() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearDisputes()
+	return pouo
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveDisputeIDs(ids...)
+	return pouo
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (pouo *PaymentOrderUpdateOne) RemoveDisputes(d ...*Dispute) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pouo.RemoveDisputeIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentOrderUpdate builder.
 func (pouo *PaymentOrderUpdateOne) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdateOne {
 	pouo.mutation.Where(ps...)
@@ -1577,11 +2047,26 @@ func (pouo *PaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.reference": %w`, err)}
 		}
 	}
+	if v, ok := pouo.mutation.FeeTier(); ok {
+		if err := paymentorder.FeeTierValidator(v); err != nil {
+			return &ValidationError{Name: "fee_tier", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fee_tier": %w`, err)}
+		}
+	}
 	if v, ok := pouo.mutation.Status(); ok {
 		if err := paymentorder.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
 		}
 	}
+	if v, ok := pouo.mutation.UnderpaymentPolicy(); ok {
+		if err := paymentorder.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.underpayment_policy": %w`, err)}
+		}
+	}
+	if v, ok := pouo.mutation.OverpaymentPolicy(); ok {
+		if err := paymentorder.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.overpayment_policy": %w`, err)}
+		}
+	}
 	if pouo.mutation.TokenCleared() && len(pouo.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PaymentOrder.token"`)
 	}
@@ -1719,9 +2204,24 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 	if pouo.mutation.ReferenceCleared() {
 		_spec.ClearField(paymentorder.FieldReference, field.TypeString)
 	}
+	if value, ok := pouo.mutation.FeeTier(); ok {
+		_spec.SetField(paymentorder.FieldFeeTier, field.TypeString, value)
+	}
+	if pouo.mutation.FeeTierCleared() {
+		_spec.ClearField(paymentorder.FieldFeeTier, field.TypeString)
+	}
 	if value, ok := pouo.mutation.Status(); ok {
 		_spec.SetField(paymentorder.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := pouo.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldUnderpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pouo.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(paymentorder.FieldOverpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pouo.mutation.IsSandbox(); ok {
+		_spec.SetField(paymentorder.FieldIsSandbox, field.TypeBool, value)
+	}
 	if pouo.mutation.SenderProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1809,6 +2309,35 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pouo.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.BatchTable,
+			Columns: []string{paymentorder.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.BatchTable,
+			Columns: []string{paymentorder.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pouo.mutation.ReceiveAddressCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -1867,6 +2396,51 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pouo.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !pouo.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.SplitsTable,
+			Columns: []string{paymentorder.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pouo.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1912,6 +2486,51 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !pouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentOrder{config: pouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
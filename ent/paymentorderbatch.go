@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderBatch is the model entity for the PaymentOrderBatch schema.
+type PaymentOrderBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// AmountPaid holds the value of the "amount_paid" field.
+	AmountPaid decimal.Decimal `json:"amount_paid,omitempty"`
+	// AmountReturned holds the value of the "amount_returned" field.
+	AmountReturned decimal.Decimal `json:"amount_returned,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// ReturnAddress holds the value of the "return_address" field.
+	ReturnAddress string `json:"return_address,omitempty"`
+	// ReceiveAddressText holds the value of the "receive_address_text" field.
+	ReceiveAddressText string `json:"receive_address_text,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Status holds the value of the "status" field.
+	Status paymentorderbatch.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentOrderBatchQuery when eager-loading is set.
+	Edges                                PaymentOrderBatchEdges `json:"edges"`
+	sender_profile_payment_order_batches *uuid.UUID
+	token_payment_order_batches          *int
+	selectValues                         sql.SelectValues
+}
+
+// PaymentOrderBatchEdges holds the relations/edges for other nodes in the graph.
+type PaymentOrderBatchEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// Token holds the value of the token edge.
+	Token *Token `json:"token,omitempty"`
+	// ReceiveAddress holds the value of the receive_address edge.
+	ReceiveAddress *ReceiveAddress `json:"receive_address,omitempty"`
+	// PaymentOrders holds the value of the payment_orders edge.
+	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderBatchEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// TokenOrErr returns the Token value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderBatchEdges) TokenOrErr() (*Token, error) {
+	if e.Token != nil {
+		return e.Token, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: token.Label}
+	}
+	return nil, &NotLoadedError{edge: "token"}
+}
+
+// ReceiveAddressOrErr returns the ReceiveAddress value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderBatchEdges) ReceiveAddressOrErr() (*ReceiveAddress, error) {
+	if e.ReceiveAddress != nil {
+		return e.ReceiveAddress, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: receiveaddress.Label}
+	}
+	return nil, &NotLoadedError{edge: "receive_address"}
+}
+
+// PaymentOrdersOrErr returns the PaymentOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderBatchEdges) PaymentOrdersOrErr() ([]*PaymentOrder, error) {
+	if e.loadedTypes[3] {
+		return e.PaymentOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_orders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentOrderBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentorderbatch.FieldAmount, paymentorderbatch.FieldAmountPaid, paymentorderbatch.FieldAmountReturned:
+			values[i] = new(decimal.Decimal)
+		case paymentorderbatch.FieldTxHash, paymentorderbatch.FieldFromAddress, paymentorderbatch.FieldReturnAddress, paymentorderbatch.FieldReceiveAddressText, paymentorderbatch.FieldReference, paymentorderbatch.FieldStatus:
+			values[i] = new(sql.NullString)
+		case paymentorderbatch.FieldCreatedAt, paymentorderbatch.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case paymentorderbatch.FieldID:
+			values[i] = new(uuid.UUID)
+		case paymentorderbatch.ForeignKeys[0]: // sender_profile_payment_order_batches
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case paymentorderbatch.ForeignKeys[1]: // token_payment_order_batches
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentOrderBatch fields.
+func (pob *PaymentOrderBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentorderbatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pob.ID = *value
+			}
+		case paymentorderbatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pob.CreatedAt = value.Time
+			}
+		case paymentorderbatch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pob.UpdatedAt = value.Time
+			}
+		case paymentorderbatch.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				pob.Amount = *value
+			}
+		case paymentorderbatch.FieldAmountPaid:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_paid", values[i])
+			} else if value != nil {
+				pob.AmountPaid = *value
+			}
+		case paymentorderbatch.FieldAmountReturned:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_returned", values[i])
+			} else if value != nil {
+				pob.AmountReturned = *value
+			}
+		case paymentorderbatch.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				pob.TxHash = value.String
+			}
+		case paymentorderbatch.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				pob.FromAddress = value.String
+			}
+		case paymentorderbatch.FieldReturnAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field return_address", values[i])
+			} else if value.Valid {
+				pob.ReturnAddress = value.String
+			}
+		case paymentorderbatch.FieldReceiveAddressText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receive_address_text", values[i])
+			} else if value.Valid {
+				pob.ReceiveAddressText = value.String
+			}
+		case paymentorderbatch.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				pob.Reference = value.String
+			}
+		case paymentorderbatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pob.Status = paymentorderbatch.Status(value.String)
+			}
+		case paymentorderbatch.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_payment_order_batches", values[i])
+			} else if value.Valid {
+				pob.sender_profile_payment_order_batches = new(uuid.UUID)
+				*pob.sender_profile_payment_order_batches = *value.S.(*uuid.UUID)
+			}
+		case paymentorderbatch.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field token_payment_order_batches", value)
+			} else if value.Valid {
+				pob.token_payment_order_batches = new(int)
+				*pob.token_payment_order_batches = int(value.Int64)
+			}
+		default:
+			pob.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentOrderBatch.
+// This includes values selected through modifiers, order, etc.
+func (pob *PaymentOrderBatch) Value(name string) (ent.Value, error) {
+	return pob.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the PaymentOrderBatch entity.
+func (pob *PaymentOrderBatch) QuerySenderProfile() *SenderProfileQuery {
+	return NewPaymentOrderBatchClient(pob.config).QuerySenderProfile(pob)
+}
+
+// QueryToken queries the "token" edge of the PaymentOrderBatch entity.
+func (pob *PaymentOrderBatch) QueryToken() *TokenQuery {
+	return NewPaymentOrderBatchClient(pob.config).QueryToken(pob)
+}
+
+// QueryReceiveAddress queries the "receive_address" edge of the PaymentOrderBatch entity.
+func (pob *PaymentOrderBatch) QueryReceiveAddress() *ReceiveAddressQuery {
+	return NewPaymentOrderBatchClient(pob.config).QueryReceiveAddress(pob)
+}
+
+// QueryPaymentOrders queries the "payment_orders" edge of the PaymentOrderBatch entity.
+func (pob *PaymentOrderBatch) QueryPaymentOrders() *PaymentOrderQuery {
+	return NewPaymentOrderBatchClient(pob.config).QueryPaymentOrders(pob)
+}
+
+// Update returns a builder for updating this PaymentOrderBatch.
+// Note that you need to call PaymentOrderBatch.Unwrap() before calling this method if this PaymentOrderBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pob *PaymentOrderBatch) Update() *PaymentOrderBatchUpdateOne {
+	return NewPaymentOrderBatchClient(pob.config).UpdateOne(pob)
+}
+
+// Unwrap unwraps the PaymentOrderBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pob *PaymentOrderBatch) Unwrap() *PaymentOrderBatch {
+	_tx, ok := pob.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentOrderBatch is not a transactional entity")
+	}
+	pob.config.driver = _tx.drv
+	return pob
+}
+
+// String implements the fmt.Stringer.
+func (pob *PaymentOrderBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentOrderBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pob.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pob.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pob.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pob.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", pob.AmountPaid))
+	builder.WriteString(", ")
+	builder.WriteString("amount_returned=")
+	builder.WriteString(fmt.Sprintf("%v", pob.AmountReturned))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(pob.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(pob.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("return_address=")
+	builder.WriteString(pob.ReturnAddress)
+	builder.WriteString(", ")
+	builder.WriteString("receive_address_text=")
+	builder.WriteString(pob.ReceiveAddressText)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(pob.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pob.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentOrderBatches is a parsable slice of PaymentOrderBatch.
+type PaymentOrderBatches []*PaymentOrderBatch
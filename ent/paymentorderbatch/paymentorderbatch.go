@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentorderbatch
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the paymentorderbatch type in the database.
+	Label = "payment_order_batch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldAmountReturned holds the string denoting the amount_returned field in the database.
+	FieldAmountReturned = "amount_returned"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldFromAddress holds the string denoting the from_address field in the database.
+	FieldFromAddress = "from_address"
+	// FieldReturnAddress holds the string denoting the return_address field in the database.
+	FieldReturnAddress = "return_address"
+	// FieldReceiveAddressText holds the string denoting the receive_address_text field in the database.
+	FieldReceiveAddressText = "receive_address_text"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
+	EdgeSenderProfile = "sender_profile"
+	// EdgeToken holds the string denoting the token edge name in mutations.
+	EdgeToken = "token"
+	// EdgeReceiveAddress holds the string denoting the receive_address edge name in mutations.
+	EdgeReceiveAddress = "receive_address"
+	// EdgePaymentOrders holds the string denoting the payment_orders edge name in mutations.
+	EdgePaymentOrders = "payment_orders"
+	// Table holds the table name of the paymentorderbatch in the database.
+	Table = "payment_order_batches"
+	// SenderProfileTable is the table that holds the sender_profile relation/edge.
+	SenderProfileTable = "payment_order_batches"
+	// SenderProfileInverseTable is the table name for the SenderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "senderprofile" package.
+	SenderProfileInverseTable = "sender_profiles"
+	// SenderProfileColumn is the table column denoting the sender_profile relation/edge.
+	SenderProfileColumn = "sender_profile_payment_order_batches"
+	// TokenTable is the table that holds the token relation/edge.
+	TokenTable = "payment_order_batches"
+	// TokenInverseTable is the table name for the Token entity.
+	// It exists in this package in order to avoid circular dependency with the "token" package.
+	TokenInverseTable = "tokens"
+	// TokenColumn is the table column denoting the token relation/edge.
+	TokenColumn = "token_payment_order_batches"
+	// ReceiveAddressTable is the table that holds the receive_address relation/edge.
+	ReceiveAddressTable = "receive_addresses"
+	// ReceiveAddressInverseTable is the table name for the ReceiveAddress entity.
+	// It exists in this package in order to avoid circular dependency with the "receiveaddress" package.
+	ReceiveAddressInverseTable = "receive_addresses"
+	// ReceiveAddressColumn is the table column denoting the receive_address relation/edge.
+	ReceiveAddressColumn = "payment_order_batch_receive_address"
+	// PaymentOrdersTable is the table that holds the payment_orders relation/edge.
+	PaymentOrdersTable = "payment_orders"
+	// PaymentOrdersInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrdersInverseTable = "payment_orders"
+	// PaymentOrdersColumn is the table column denoting the payment_orders relation/edge.
+	PaymentOrdersColumn = "payment_order_batch_payment_orders"
+)
+
+// Columns holds all SQL columns for paymentorderbatch fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldAmount,
+	FieldAmountPaid,
+	FieldAmountReturned,
+	FieldTxHash,
+	FieldFromAddress,
+	FieldReturnAddress,
+	FieldReceiveAddressText,
+	FieldReference,
+	FieldStatus,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "payment_order_batches"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sender_profile_payment_order_batches",
+	"token_payment_order_batches",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	TxHashValidator func(string) error
+	// FromAddressValidator is a validator for the "from_address" field. It is called by the builders before save.
+	FromAddressValidator func(string) error
+	// ReturnAddressValidator is a validator for the "return_address" field. It is called by the builders before save.
+	ReturnAddressValidator func(string) error
+	// ReceiveAddressTextValidator is a validator for the "receive_address_text" field. It is called by the builders before save.
+	ReceiveAddressTextValidator func(string) error
+	// ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
+	ReferenceValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusInitiated is the default value of the Status enum.
+const DefaultStatus = StatusInitiated
+
+// Status values.
+const (
+	StatusInitiated Status = "initiated"
+	StatusFunded    Status = "funded"
+	StatusExpired   Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusInitiated, StatusFunded, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("paymentorderbatch: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PaymentOrderBatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByAmountPaid orders the results by the amount_paid field.
+func ByAmountPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountPaid, opts...).ToFunc()
+}
+
+// ByAmountReturned orders the results by the amount_returned field.
+func ByAmountReturned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountReturned, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// ByFromAddress orders the results by the from_address field.
+func ByFromAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromAddress, opts...).ToFunc()
+}
+
+// ByReturnAddress orders the results by the return_address field.
+func ByReturnAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnAddress, opts...).ToFunc()
+}
+
+// ByReceiveAddressText orders the results by the receive_address_text field.
+func ByReceiveAddressText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiveAddressText, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySenderProfileField orders the results by sender_profile field.
+func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTokenField orders the results by token field.
+func ByTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReceiveAddressField orders the results by receive_address field.
+func ByReceiveAddressField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiveAddressStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentOrdersCount orders the results by payment_orders count.
+func ByPaymentOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentOrdersStep(), opts...)
+	}
+}
+
+// ByPaymentOrders orders the results by payment_orders terms.
+func ByPaymentOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSenderProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+	)
+}
+func newTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
+	)
+}
+func newReceiveAddressStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiveAddressInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ReceiveAddressTable, ReceiveAddressColumn),
+	)
+}
+func newPaymentOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrdersTable, PaymentOrdersColumn),
+	)
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentorderbatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountPaid applies equality check predicate on the "amount_paid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountReturned applies equality check predicate on the "amount_returned" field. It's identical to AmountReturnedEQ.
+func AmountReturned(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmountReturned, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldTxHash, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// ReturnAddress applies equality check predicate on the "return_address" field. It's identical to ReturnAddressEQ.
+func ReturnAddress(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReturnAddress, v))
+}
+
+// ReceiveAddressText applies equality check predicate on the "receive_address_text" field. It's identical to ReceiveAddressTextEQ.
+func ReceiveAddressText(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReceiveAddressText, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amount_paid" field.
+func AmountPaidEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amount_paid" field.
+func AmountPaidNEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidIn applies the In predicate on the "amount_paid" field.
+func AmountPaidIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amount_paid" field.
+func AmountPaidNotIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidGT applies the GT predicate on the "amount_paid" field.
+func AmountPaidGT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldAmountPaid, v))
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amount_paid" field.
+func AmountPaidGTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldAmountPaid, v))
+}
+
+// AmountPaidLT applies the LT predicate on the "amount_paid" field.
+func AmountPaidLT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldAmountPaid, v))
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amount_paid" field.
+func AmountPaidLTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldAmountPaid, v))
+}
+
+// AmountReturnedEQ applies the EQ predicate on the "amount_returned" field.
+func AmountReturnedEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldAmountReturned, v))
+}
+
+// AmountReturnedNEQ applies the NEQ predicate on the "amount_returned" field.
+func AmountReturnedNEQ(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldAmountReturned, v))
+}
+
+// AmountReturnedIn applies the In predicate on the "amount_returned" field.
+func AmountReturnedIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldAmountReturned, vs...))
+}
+
+// AmountReturnedNotIn applies the NotIn predicate on the "amount_returned" field.
+func AmountReturnedNotIn(vs ...decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldAmountReturned, vs...))
+}
+
+// AmountReturnedGT applies the GT predicate on the "amount_returned" field.
+func AmountReturnedGT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldAmountReturned, v))
+}
+
+// AmountReturnedGTE applies the GTE predicate on the "amount_returned" field.
+func AmountReturnedGTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldAmountReturned, v))
+}
+
+// AmountReturnedLT applies the LT predicate on the "amount_returned" field.
+func AmountReturnedLT(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldAmountReturned, v))
+}
+
+// AmountReturnedLTE applies the LTE predicate on the "amount_returned" field.
+func AmountReturnedLTE(v decimal.Decimal) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldAmountReturned, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressIsNil applies the IsNil predicate on the "from_address" field.
+func FromAddressIsNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIsNull(FieldFromAddress))
+}
+
+// FromAddressNotNil applies the NotNil predicate on the "from_address" field.
+func FromAddressNotNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotNull(FieldFromAddress))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// ReturnAddressEQ applies the EQ predicate on the "return_address" field.
+func ReturnAddressEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReturnAddress, v))
+}
+
+// ReturnAddressNEQ applies the NEQ predicate on the "return_address" field.
+func ReturnAddressNEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldReturnAddress, v))
+}
+
+// ReturnAddressIn applies the In predicate on the "return_address" field.
+func ReturnAddressIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldReturnAddress, vs...))
+}
+
+// ReturnAddressNotIn applies the NotIn predicate on the "return_address" field.
+func ReturnAddressNotIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldReturnAddress, vs...))
+}
+
+// ReturnAddressGT applies the GT predicate on the "return_address" field.
+func ReturnAddressGT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldReturnAddress, v))
+}
+
+// ReturnAddressGTE applies the GTE predicate on the "return_address" field.
+func ReturnAddressGTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldReturnAddress, v))
+}
+
+// ReturnAddressLT applies the LT predicate on the "return_address" field.
+func ReturnAddressLT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldReturnAddress, v))
+}
+
+// ReturnAddressLTE applies the LTE predicate on the "return_address" field.
+func ReturnAddressLTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldReturnAddress, v))
+}
+
+// ReturnAddressContains applies the Contains predicate on the "return_address" field.
+func ReturnAddressContains(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContains(FieldReturnAddress, v))
+}
+
+// ReturnAddressHasPrefix applies the HasPrefix predicate on the "return_address" field.
+func ReturnAddressHasPrefix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasPrefix(FieldReturnAddress, v))
+}
+
+// ReturnAddressHasSuffix applies the HasSuffix predicate on the "return_address" field.
+func ReturnAddressHasSuffix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasSuffix(FieldReturnAddress, v))
+}
+
+// ReturnAddressIsNil applies the IsNil predicate on the "return_address" field.
+func ReturnAddressIsNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIsNull(FieldReturnAddress))
+}
+
+// ReturnAddressNotNil applies the NotNil predicate on the "return_address" field.
+func ReturnAddressNotNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotNull(FieldReturnAddress))
+}
+
+// ReturnAddressEqualFold applies the EqualFold predicate on the "return_address" field.
+func ReturnAddressEqualFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEqualFold(FieldReturnAddress, v))
+}
+
+// ReturnAddressContainsFold applies the ContainsFold predicate on the "return_address" field.
+func ReturnAddressContainsFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContainsFold(FieldReturnAddress, v))
+}
+
+// ReceiveAddressTextEQ applies the EQ predicate on the "receive_address_text" field.
+func ReceiveAddressTextEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextNEQ applies the NEQ predicate on the "receive_address_text" field.
+func ReceiveAddressTextNEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextIn applies the In predicate on the "receive_address_text" field.
+func ReceiveAddressTextIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldReceiveAddressText, vs...))
+}
+
+// ReceiveAddressTextNotIn applies the NotIn predicate on the "receive_address_text" field.
+func ReceiveAddressTextNotIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldReceiveAddressText, vs...))
+}
+
+// ReceiveAddressTextGT applies the GT predicate on the "receive_address_text" field.
+func ReceiveAddressTextGT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextGTE applies the GTE predicate on the "receive_address_text" field.
+func ReceiveAddressTextGTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextLT applies the LT predicate on the "receive_address_text" field.
+func ReceiveAddressTextLT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextLTE applies the LTE predicate on the "receive_address_text" field.
+func ReceiveAddressTextLTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextContains applies the Contains predicate on the "receive_address_text" field.
+func ReceiveAddressTextContains(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContains(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextHasPrefix applies the HasPrefix predicate on the "receive_address_text" field.
+func ReceiveAddressTextHasPrefix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasPrefix(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextHasSuffix applies the HasSuffix predicate on the "receive_address_text" field.
+func ReceiveAddressTextHasSuffix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasSuffix(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextEqualFold applies the EqualFold predicate on the "receive_address_text" field.
+func ReceiveAddressTextEqualFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEqualFold(FieldReceiveAddressText, v))
+}
+
+// ReceiveAddressTextContainsFold applies the ContainsFold predicate on the "receive_address_text" field.
+func ReceiveAddressTextContainsFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContainsFold(FieldReceiveAddressText, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldContainsFold(FieldReference, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasToken applies the HasEdge predicate on the "token" edge.
+func HasToken() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenWith applies the HasEdge predicate on the "token" edge with a given conditions (other predicates).
+func HasTokenWith(preds ...predicate.Token) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := newTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceiveAddress applies the HasEdge predicate on the "receive_address" edge.
+func HasReceiveAddress() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ReceiveAddressTable, ReceiveAddressColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiveAddressWith applies the HasEdge predicate on the "receive_address" edge with a given conditions (other predicates).
+func HasReceiveAddressWith(preds ...predicate.ReceiveAddress) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := newReceiveAddressStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentOrders applies the HasEdge predicate on the "payment_orders" edge.
+func HasPaymentOrders() predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrdersTable, PaymentOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrdersWith applies the HasEdge predicate on the "payment_orders" edge with a given conditions (other predicates).
+func HasPaymentOrdersWith(preds ...predicate.PaymentOrder) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		step := newPaymentOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentOrderBatch) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentOrderBatch) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentOrderBatch) predicate.PaymentOrderBatch {
+	return predicate.PaymentOrderBatch(sql.NotPredicates(p))
+}
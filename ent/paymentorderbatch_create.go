@@ -0,0 +1,1369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderBatchCreate is the builder for creating a PaymentOrderBatch entity.
+type PaymentOrderBatchCreate struct {
+	config
+	mutation *PaymentOrderBatchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pobc *PaymentOrderBatchCreate) SetCreatedAt(t time.Time) *PaymentOrderBatchCreate {
+	pobc.mutation.SetCreatedAt(t)
+	return pobc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableCreatedAt(t *time.Time) *PaymentOrderBatchCreate {
+	if t != nil {
+		pobc.SetCreatedAt(*t)
+	}
+	return pobc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pobc *PaymentOrderBatchCreate) SetUpdatedAt(t time.Time) *PaymentOrderBatchCreate {
+	pobc.mutation.SetUpdatedAt(t)
+	return pobc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableUpdatedAt(t *time.Time) *PaymentOrderBatchCreate {
+	if t != nil {
+		pobc.SetUpdatedAt(*t)
+	}
+	return pobc
+}
+
+// SetAmount sets the "amount" field.
+func (pobc *PaymentOrderBatchCreate) SetAmount(d decimal.Decimal) *PaymentOrderBatchCreate {
+	pobc.mutation.SetAmount(d)
+	return pobc
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (pobc *PaymentOrderBatchCreate) SetAmountPaid(d decimal.Decimal) *PaymentOrderBatchCreate {
+	pobc.mutation.SetAmountPaid(d)
+	return pobc
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (pobc *PaymentOrderBatchCreate) SetAmountReturned(d decimal.Decimal) *PaymentOrderBatchCreate {
+	pobc.mutation.SetAmountReturned(d)
+	return pobc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (pobc *PaymentOrderBatchCreate) SetTxHash(s string) *PaymentOrderBatchCreate {
+	pobc.mutation.SetTxHash(s)
+	return pobc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableTxHash(s *string) *PaymentOrderBatchCreate {
+	if s != nil {
+		pobc.SetTxHash(*s)
+	}
+	return pobc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (pobc *PaymentOrderBatchCreate) SetFromAddress(s string) *PaymentOrderBatchCreate {
+	pobc.mutation.SetFromAddress(s)
+	return pobc
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableFromAddress(s *string) *PaymentOrderBatchCreate {
+	if s != nil {
+		pobc.SetFromAddress(*s)
+	}
+	return pobc
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (pobc *PaymentOrderBatchCreate) SetReturnAddress(s string) *PaymentOrderBatchCreate {
+	pobc.mutation.SetReturnAddress(s)
+	return pobc
+}
+
+// SetNillableReturnAddress sets the "return_address" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableReturnAddress(s *string) *PaymentOrderBatchCreate {
+	if s != nil {
+		pobc.SetReturnAddress(*s)
+	}
+	return pobc
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (pobc *PaymentOrderBatchCreate) SetReceiveAddressText(s string) *PaymentOrderBatchCreate {
+	pobc.mutation.SetReceiveAddressText(s)
+	return pobc
+}
+
+// SetReference sets the "reference" field.
+func (pobc *PaymentOrderBatchCreate) SetReference(s string) *PaymentOrderBatchCreate {
+	pobc.mutation.SetReference(s)
+	return pobc
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableReference(s *string) *PaymentOrderBatchCreate {
+	if s != nil {
+		pobc.SetReference(*s)
+	}
+	return pobc
+}
+
+// SetStatus sets the "status" field.
+func (pobc *PaymentOrderBatchCreate) SetStatus(pa paymentorderbatch.Status) *PaymentOrderBatchCreate {
+	pobc.mutation.SetStatus(pa)
+	return pobc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableStatus(pa *paymentorderbatch.Status) *PaymentOrderBatchCreate {
+	if pa != nil {
+		pobc.SetStatus(*pa)
+	}
+	return pobc
+}
+
+// SetID sets the "id" field.
+func (pobc *PaymentOrderBatchCreate) SetID(u uuid.UUID) *PaymentOrderBatchCreate {
+	pobc.mutation.SetID(u)
+	return pobc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableID(u *uuid.UUID) *PaymentOrderBatchCreate {
+	if u != nil {
+		pobc.SetID(*u)
+	}
+	return pobc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (pobc *PaymentOrderBatchCreate) SetSenderProfileID(id uuid.UUID) *PaymentOrderBatchCreate {
+	pobc.mutation.SetSenderProfileID(id)
+	return pobc
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableSenderProfileID(id *uuid.UUID) *PaymentOrderBatchCreate {
+	if id != nil {
+		pobc = pobc.SetSenderProfileID(*id)
+	}
+	return pobc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (pobc *PaymentOrderBatchCreate) SetSenderProfile(s *SenderProfile) *PaymentOrderBatchCreate {
+	return pobc.SetSenderProfileID(s.ID)
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (pobc *PaymentOrderBatchCreate) SetTokenID(id int) *PaymentOrderBatchCreate {
+	pobc.mutation.SetTokenID(id)
+	return pobc
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (pobc *PaymentOrderBatchCreate) SetToken(t *Token) *PaymentOrderBatchCreate {
+	return pobc.SetTokenID(t.ID)
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (pobc *PaymentOrderBatchCreate) SetReceiveAddressID(id int) *PaymentOrderBatchCreate {
+	pobc.mutation.SetReceiveAddressID(id)
+	return pobc
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (pobc *PaymentOrderBatchCreate) SetNillableReceiveAddressID(id *int) *PaymentOrderBatchCreate {
+	if id != nil {
+		pobc = pobc.SetReceiveAddressID(*id)
+	}
+	return pobc
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (pobc *PaymentOrderBatchCreate) SetReceiveAddress(r *ReceiveAddress) *PaymentOrderBatchCreate {
+	return pobc.SetReceiveAddressID(r.ID)
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (pobc *PaymentOrderBatchCreate) AddPaymentOrderIDs(ids ...uuid.UUID) *PaymentOrderBatchCreate {
+	pobc.mutation.AddPaymentOrderIDs(ids...)
+	return pobc
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (pobc *PaymentOrderBatchCreate) AddPaymentOrders(p ...*PaymentOrder) *PaymentOrderBatchCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pobc.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the PaymentOrderBatchMutation object of the builder.
+func (pobc *PaymentOrderBatchCreate) Mutation() *PaymentOrderBatchMutation {
+	return pobc.mutation
+}
+
+// Save creates the PaymentOrderBatch in the database.
+func (pobc *PaymentOrderBatchCreate) Save(ctx context.Context) (*PaymentOrderBatch, error) {
+	pobc.defaults()
+	return withHooks(ctx, pobc.sqlSave, pobc.mutation, pobc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pobc *PaymentOrderBatchCreate) SaveX(ctx context.Context) *PaymentOrderBatch {
+	v, err := pobc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pobc *PaymentOrderBatchCreate) Exec(ctx context.Context) error {
+	_, err := pobc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobc *PaymentOrderBatchCreate) ExecX(ctx context.Context) {
+	if err := pobc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pobc *PaymentOrderBatchCreate) defaults() {
+	if _, ok := pobc.mutation.CreatedAt(); !ok {
+		v := paymentorderbatch.DefaultCreatedAt()
+		pobc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pobc.mutation.UpdatedAt(); !ok {
+		v := paymentorderbatch.DefaultUpdatedAt()
+		pobc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pobc.mutation.Status(); !ok {
+		v := paymentorderbatch.DefaultStatus
+		pobc.mutation.SetStatus(v)
+	}
+	if _, ok := pobc.mutation.ID(); !ok {
+		v := paymentorderbatch.DefaultID()
+		pobc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pobc *PaymentOrderBatchCreate) check() error {
+	if _, ok := pobc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentOrderBatch.created_at"`)}
+	}
+	if _, ok := pobc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentOrderBatch.updated_at"`)}
+	}
+	if _, ok := pobc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentOrderBatch.amount"`)}
+	}
+	if _, ok := pobc.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amount_paid", err: errors.New(`ent: missing required field "PaymentOrderBatch.amount_paid"`)}
+	}
+	if _, ok := pobc.mutation.AmountReturned(); !ok {
+		return &ValidationError{Name: "amount_returned", err: errors.New(`ent: missing required field "PaymentOrderBatch.amount_returned"`)}
+	}
+	if v, ok := pobc.mutation.TxHash(); ok {
+		if err := paymentorderbatch.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := pobc.mutation.FromAddress(); ok {
+		if err := paymentorderbatch.FromAddressValidator(v); err != nil {
+			return &ValidationError{Name: "from_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.from_address": %w`, err)}
+		}
+	}
+	if v, ok := pobc.mutation.ReturnAddress(); ok {
+		if err := paymentorderbatch.ReturnAddressValidator(v); err != nil {
+			return &ValidationError{Name: "return_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.return_address": %w`, err)}
+		}
+	}
+	if _, ok := pobc.mutation.ReceiveAddressText(); !ok {
+		return &ValidationError{Name: "receive_address_text", err: errors.New(`ent: missing required field "PaymentOrderBatch.receive_address_text"`)}
+	}
+	if v, ok := pobc.mutation.ReceiveAddressText(); ok {
+		if err := paymentorderbatch.ReceiveAddressTextValidator(v); err != nil {
+			return &ValidationError{Name: "receive_address_text", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.receive_address_text": %w`, err)}
+		}
+	}
+	if v, ok := pobc.mutation.Reference(); ok {
+		if err := paymentorderbatch.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.reference": %w`, err)}
+		}
+	}
+	if _, ok := pobc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentOrderBatch.status"`)}
+	}
+	if v, ok := pobc.mutation.Status(); ok {
+		if err := paymentorderbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.status": %w`, err)}
+		}
+	}
+	if len(pobc.mutation.TokenIDs()) == 0 {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "PaymentOrderBatch.token"`)}
+	}
+	return nil
+}
+
+func (pobc *PaymentOrderBatchCreate) sqlSave(ctx context.Context) (*PaymentOrderBatch, error) {
+	if err := pobc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pobc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pobc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pobc.mutation.id = &_node.ID
+	pobc.mutation.done = true
+	return _node, nil
+}
+
+func (pobc *PaymentOrderBatchCreate) createSpec() (*PaymentOrderBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentOrderBatch{config: pobc.config}
+		_spec = sqlgraph.NewCreateSpec(paymentorderbatch.Table, sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pobc.conflict
+	if id, ok := pobc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pobc.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentorderbatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pobc.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentorderbatch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pobc.mutation.Amount(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := pobc.mutation.AmountPaid(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountPaid, field.TypeFloat64, value)
+		_node.AmountPaid = value
+	}
+	if value, ok := pobc.mutation.AmountReturned(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountReturned, field.TypeFloat64, value)
+		_node.AmountReturned = value
+	}
+	if value, ok := pobc.mutation.TxHash(); ok {
+		_spec.SetField(paymentorderbatch.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := pobc.mutation.FromAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := pobc.mutation.ReturnAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldReturnAddress, field.TypeString, value)
+		_node.ReturnAddress = value
+	}
+	if value, ok := pobc.mutation.ReceiveAddressText(); ok {
+		_spec.SetField(paymentorderbatch.FieldReceiveAddressText, field.TypeString, value)
+		_node.ReceiveAddressText = value
+	}
+	if value, ok := pobc.mutation.Reference(); ok {
+		_spec.SetField(paymentorderbatch.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := pobc.mutation.Status(); ok {
+		_spec.SetField(paymentorderbatch.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := pobc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.SenderProfileTable,
+			Columns: []string{paymentorderbatch.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_payment_order_batches = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pobc.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.TokenTable,
+			Columns: []string{paymentorderbatch.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.token_payment_order_batches = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pobc.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorderbatch.ReceiveAddressTable,
+			Columns: []string{paymentorderbatch.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pobc.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentOrderBatch.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentOrderBatchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pobc *PaymentOrderBatchCreate) OnConflict(opts ...sql.ConflictOption) *PaymentOrderBatchUpsertOne {
+	pobc.conflict = opts
+	return &PaymentOrderBatchUpsertOne{
+		create: pobc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pobc *PaymentOrderBatchCreate) OnConflictColumns(columns ...string) *PaymentOrderBatchUpsertOne {
+	pobc.conflict = append(pobc.conflict, sql.ConflictColumns(columns...))
+	return &PaymentOrderBatchUpsertOne{
+		create: pobc,
+	}
+}
+
+type (
+	// PaymentOrderBatchUpsertOne is the builder for "upsert"-ing
+	//  one PaymentOrderBatch node.
+	PaymentOrderBatchUpsertOne struct {
+		create *PaymentOrderBatchCreate
+	}
+
+	// PaymentOrderBatchUpsert is the "OnConflict" setter.
+	PaymentOrderBatchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentOrderBatchUpsert) SetUpdatedAt(v time.Time) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateUpdatedAt() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderBatchUpsert) SetAmount(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateAmount() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderBatchUpsert) AddAmount(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Add(paymentorderbatch.FieldAmount, v)
+	return u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *PaymentOrderBatchUpsert) SetAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldAmountPaid, v)
+	return u
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateAmountPaid() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldAmountPaid)
+	return u
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *PaymentOrderBatchUpsert) AddAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Add(paymentorderbatch.FieldAmountPaid, v)
+	return u
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (u *PaymentOrderBatchUpsert) SetAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldAmountReturned, v)
+	return u
+}
+
+// UpdateAmountReturned sets the "amount_returned" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateAmountReturned() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldAmountReturned)
+	return u
+}
+
+// AddAmountReturned adds v to the "amount_returned" field.
+func (u *PaymentOrderBatchUpsert) AddAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsert {
+	u.Add(paymentorderbatch.FieldAmountReturned, v)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *PaymentOrderBatchUpsert) SetTxHash(v string) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateTxHash() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldTxHash)
+	return u
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *PaymentOrderBatchUpsert) ClearTxHash() *PaymentOrderBatchUpsert {
+	u.SetNull(paymentorderbatch.FieldTxHash)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *PaymentOrderBatchUpsert) SetFromAddress(v string) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateFromAddress() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldFromAddress)
+	return u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *PaymentOrderBatchUpsert) ClearFromAddress() *PaymentOrderBatchUpsert {
+	u.SetNull(paymentorderbatch.FieldFromAddress)
+	return u
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (u *PaymentOrderBatchUpsert) SetReturnAddress(v string) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldReturnAddress, v)
+	return u
+}
+
+// UpdateReturnAddress sets the "return_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateReturnAddress() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldReturnAddress)
+	return u
+}
+
+// ClearReturnAddress clears the value of the "return_address" field.
+func (u *PaymentOrderBatchUpsert) ClearReturnAddress() *PaymentOrderBatchUpsert {
+	u.SetNull(paymentorderbatch.FieldReturnAddress)
+	return u
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (u *PaymentOrderBatchUpsert) SetReceiveAddressText(v string) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldReceiveAddressText, v)
+	return u
+}
+
+// UpdateReceiveAddressText sets the "receive_address_text" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateReceiveAddressText() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldReceiveAddressText)
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *PaymentOrderBatchUpsert) SetReference(v string) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldReference, v)
+	return u
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateReference() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldReference)
+	return u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *PaymentOrderBatchUpsert) ClearReference() *PaymentOrderBatchUpsert {
+	u.SetNull(paymentorderbatch.FieldReference)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentOrderBatchUpsert) SetStatus(v paymentorderbatch.Status) *PaymentOrderBatchUpsert {
+	u.Set(paymentorderbatch.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsert) UpdateStatus() *PaymentOrderBatchUpsert {
+	u.SetExcluded(paymentorderbatch.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentorderbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentOrderBatchUpsertOne) UpdateNewValues() *PaymentOrderBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentorderbatch.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentorderbatch.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentOrderBatchUpsertOne) Ignore() *PaymentOrderBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentOrderBatchUpsertOne) DoNothing() *PaymentOrderBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentOrderBatchCreate.OnConflict
+// documentation for more info.
+func (u *PaymentOrderBatchUpsertOne) Update(set func(*PaymentOrderBatchUpsert)) *PaymentOrderBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentOrderBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentOrderBatchUpsertOne) SetUpdatedAt(v time.Time) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateUpdatedAt() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderBatchUpsertOne) SetAmount(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderBatchUpsertOne) AddAmount(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateAmount() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *PaymentOrderBatchUpsertOne) SetAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *PaymentOrderBatchUpsertOne) AddAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateAmountPaid() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (u *PaymentOrderBatchUpsertOne) SetAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmountReturned(v)
+	})
+}
+
+// AddAmountReturned adds v to the "amount_returned" field.
+func (u *PaymentOrderBatchUpsertOne) AddAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmountReturned(v)
+	})
+}
+
+// UpdateAmountReturned sets the "amount_returned" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateAmountReturned() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmountReturned()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *PaymentOrderBatchUpsertOne) SetTxHash(v string) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateTxHash() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *PaymentOrderBatchUpsertOne) ClearTxHash() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *PaymentOrderBatchUpsertOne) SetFromAddress(v string) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateFromAddress() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *PaymentOrderBatchUpsertOne) ClearFromAddress() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (u *PaymentOrderBatchUpsertOne) SetReturnAddress(v string) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReturnAddress(v)
+	})
+}
+
+// UpdateReturnAddress sets the "return_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateReturnAddress() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReturnAddress()
+	})
+}
+
+// ClearReturnAddress clears the value of the "return_address" field.
+func (u *PaymentOrderBatchUpsertOne) ClearReturnAddress() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearReturnAddress()
+	})
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (u *PaymentOrderBatchUpsertOne) SetReceiveAddressText(v string) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReceiveAddressText(v)
+	})
+}
+
+// UpdateReceiveAddressText sets the "receive_address_text" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateReceiveAddressText() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReceiveAddressText()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *PaymentOrderBatchUpsertOne) SetReference(v string) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateReference() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *PaymentOrderBatchUpsertOne) ClearReference() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentOrderBatchUpsertOne) SetStatus(v paymentorderbatch.Status) *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertOne) UpdateStatus() *PaymentOrderBatchUpsertOne {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentOrderBatchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentOrderBatchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentOrderBatchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentOrderBatchUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PaymentOrderBatchUpsertOne.ID is not supported by MySQL driver. Use PaymentOrderBatchUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentOrderBatchUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentOrderBatchCreateBulk is the builder for creating many PaymentOrderBatch entities in bulk.
+type PaymentOrderBatchCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentOrderBatchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentOrderBatch entities in the database.
+func (pobcb *PaymentOrderBatchCreateBulk) Save(ctx context.Context) ([]*PaymentOrderBatch, error) {
+	if pobcb.err != nil {
+		return nil, pobcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pobcb.builders))
+	nodes := make([]*PaymentOrderBatch, len(pobcb.builders))
+	mutators := make([]Mutator, len(pobcb.builders))
+	for i := range pobcb.builders {
+		func(i int, root context.Context) {
+			builder := pobcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentOrderBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pobcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pobcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pobcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pobcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pobcb *PaymentOrderBatchCreateBulk) SaveX(ctx context.Context) []*PaymentOrderBatch {
+	v, err := pobcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pobcb *PaymentOrderBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := pobcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobcb *PaymentOrderBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := pobcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentOrderBatch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentOrderBatchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pobcb *PaymentOrderBatchCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentOrderBatchUpsertBulk {
+	pobcb.conflict = opts
+	return &PaymentOrderBatchUpsertBulk{
+		create: pobcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pobcb *PaymentOrderBatchCreateBulk) OnConflictColumns(columns ...string) *PaymentOrderBatchUpsertBulk {
+	pobcb.conflict = append(pobcb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentOrderBatchUpsertBulk{
+		create: pobcb,
+	}
+}
+
+// PaymentOrderBatchUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentOrderBatch nodes.
+type PaymentOrderBatchUpsertBulk struct {
+	create *PaymentOrderBatchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentorderbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentOrderBatchUpsertBulk) UpdateNewValues() *PaymentOrderBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentorderbatch.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentorderbatch.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderBatch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentOrderBatchUpsertBulk) Ignore() *PaymentOrderBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentOrderBatchUpsertBulk) DoNothing() *PaymentOrderBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentOrderBatchCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentOrderBatchUpsertBulk) Update(set func(*PaymentOrderBatchUpsert)) *PaymentOrderBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentOrderBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentOrderBatchUpsertBulk) SetUpdatedAt(v time.Time) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateUpdatedAt() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderBatchUpsertBulk) SetAmount(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderBatchUpsertBulk) AddAmount(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateAmount() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *PaymentOrderBatchUpsertBulk) SetAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *PaymentOrderBatchUpsertBulk) AddAmountPaid(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateAmountPaid() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (u *PaymentOrderBatchUpsertBulk) SetAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetAmountReturned(v)
+	})
+}
+
+// AddAmountReturned adds v to the "amount_returned" field.
+func (u *PaymentOrderBatchUpsertBulk) AddAmountReturned(v decimal.Decimal) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.AddAmountReturned(v)
+	})
+}
+
+// UpdateAmountReturned sets the "amount_returned" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateAmountReturned() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateAmountReturned()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *PaymentOrderBatchUpsertBulk) SetTxHash(v string) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateTxHash() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *PaymentOrderBatchUpsertBulk) ClearTxHash() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *PaymentOrderBatchUpsertBulk) SetFromAddress(v string) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateFromAddress() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *PaymentOrderBatchUpsertBulk) ClearFromAddress() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (u *PaymentOrderBatchUpsertBulk) SetReturnAddress(v string) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReturnAddress(v)
+	})
+}
+
+// UpdateReturnAddress sets the "return_address" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateReturnAddress() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReturnAddress()
+	})
+}
+
+// ClearReturnAddress clears the value of the "return_address" field.
+func (u *PaymentOrderBatchUpsertBulk) ClearReturnAddress() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearReturnAddress()
+	})
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (u *PaymentOrderBatchUpsertBulk) SetReceiveAddressText(v string) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReceiveAddressText(v)
+	})
+}
+
+// UpdateReceiveAddressText sets the "receive_address_text" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateReceiveAddressText() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReceiveAddressText()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *PaymentOrderBatchUpsertBulk) SetReference(v string) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateReference() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *PaymentOrderBatchUpsertBulk) ClearReference() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentOrderBatchUpsertBulk) SetStatus(v paymentorderbatch.Status) *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentOrderBatchUpsertBulk) UpdateStatus() *PaymentOrderBatchUpsertBulk {
+	return u.Update(func(s *PaymentOrderBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentOrderBatchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentOrderBatchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentOrderBatchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentOrderBatchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
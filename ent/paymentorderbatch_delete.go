@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// PaymentOrderBatchDelete is the builder for deleting a PaymentOrderBatch entity.
+type PaymentOrderBatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentOrderBatchMutation
+}
+
+// Where appends a list predicates to the PaymentOrderBatchDelete builder.
+func (pobd *PaymentOrderBatchDelete) Where(ps ...predicate.PaymentOrderBatch) *PaymentOrderBatchDelete {
+	pobd.mutation.Where(ps...)
+	return pobd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pobd *PaymentOrderBatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pobd.sqlExec, pobd.mutation, pobd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobd *PaymentOrderBatchDelete) ExecX(ctx context.Context) int {
+	n, err := pobd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pobd *PaymentOrderBatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentorderbatch.Table, sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID))
+	if ps := pobd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pobd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pobd.mutation.done = true
+	return affected, err
+}
+
+// PaymentOrderBatchDeleteOne is the builder for deleting a single PaymentOrderBatch entity.
+type PaymentOrderBatchDeleteOne struct {
+	pobd *PaymentOrderBatchDelete
+}
+
+// Where appends a list predicates to the PaymentOrderBatchDelete builder.
+func (pobdo *PaymentOrderBatchDeleteOne) Where(ps ...predicate.PaymentOrderBatch) *PaymentOrderBatchDeleteOne {
+	pobdo.pobd.mutation.Where(ps...)
+	return pobdo
+}
+
+// Exec executes the deletion query.
+func (pobdo *PaymentOrderBatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := pobdo.pobd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentorderbatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobdo *PaymentOrderBatchDeleteOne) ExecX(ctx context.Context) {
+	if err := pobdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
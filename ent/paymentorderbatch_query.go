@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+)
+
+// PaymentOrderBatchQuery is the builder for querying PaymentOrderBatch entities.
+type PaymentOrderBatchQuery struct {
+	config
+	ctx                *QueryContext
+	order              []paymentorderbatch.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.PaymentOrderBatch
+	withSenderProfile  *SenderProfileQuery
+	withToken          *TokenQuery
+	withReceiveAddress *ReceiveAddressQuery
+	withPaymentOrders  *PaymentOrderQuery
+	withFKs            bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentOrderBatchQuery builder.
+func (pobq *PaymentOrderBatchQuery) Where(ps ...predicate.PaymentOrderBatch) *PaymentOrderBatchQuery {
+	pobq.predicates = append(pobq.predicates, ps...)
+	return pobq
+}
+
+// Limit the number of records to be returned by this query.
+func (pobq *PaymentOrderBatchQuery) Limit(limit int) *PaymentOrderBatchQuery {
+	pobq.ctx.Limit = &limit
+	return pobq
+}
+
+// Offset to start from.
+func (pobq *PaymentOrderBatchQuery) Offset(offset int) *PaymentOrderBatchQuery {
+	pobq.ctx.Offset = &offset
+	return pobq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pobq *PaymentOrderBatchQuery) Unique(unique bool) *PaymentOrderBatchQuery {
+	pobq.ctx.Unique = &unique
+	return pobq
+}
+
+// Order specifies how the records should be ordered.
+func (pobq *PaymentOrderBatchQuery) Order(o ...paymentorderbatch.OrderOption) *PaymentOrderBatchQuery {
+	pobq.order = append(pobq.order, o...)
+	return pobq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (pobq *PaymentOrderBatchQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: pobq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pobq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pobq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorderbatch.SenderProfileTable, paymentorderbatch.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pobq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryToken chains the current query on the "token" edge.
+func (pobq *PaymentOrderBatchQuery) QueryToken() *TokenQuery {
+	query := (&TokenClient{config: pobq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pobq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pobq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, selector),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorderbatch.TokenTable, paymentorderbatch.TokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pobq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceiveAddress chains the current query on the "receive_address" edge.
+func (pobq *PaymentOrderBatchQuery) QueryReceiveAddress() *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: pobq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pobq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pobq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, selector),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorderbatch.ReceiveAddressTable, paymentorderbatch.ReceiveAddressColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pobq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentOrders chains the current query on the "payment_orders" edge.
+func (pobq *PaymentOrderBatchQuery) QueryPaymentOrders() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: pobq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pobq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pobq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderbatch.Table, paymentorderbatch.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorderbatch.PaymentOrdersTable, paymentorderbatch.PaymentOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pobq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PaymentOrderBatch entity from the query.
+// Returns a *NotFoundError when no PaymentOrderBatch was found.
+func (pobq *PaymentOrderBatchQuery) First(ctx context.Context) (*PaymentOrderBatch, error) {
+	nodes, err := pobq.Limit(1).All(setContextOp(ctx, pobq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentorderbatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) FirstX(ctx context.Context) *PaymentOrderBatch {
+	node, err := pobq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentOrderBatch ID from the query.
+// Returns a *NotFoundError when no PaymentOrderBatch ID was found.
+func (pobq *PaymentOrderBatchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pobq.Limit(1).IDs(setContextOp(ctx, pobq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentorderbatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pobq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentOrderBatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentOrderBatch entity is found.
+// Returns a *NotFoundError when no PaymentOrderBatch entities are found.
+func (pobq *PaymentOrderBatchQuery) Only(ctx context.Context) (*PaymentOrderBatch, error) {
+	nodes, err := pobq.Limit(2).All(setContextOp(ctx, pobq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentorderbatch.Label}
+	default:
+		return nil, &NotSingularError{paymentorderbatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) OnlyX(ctx context.Context) *PaymentOrderBatch {
+	node, err := pobq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentOrderBatch ID in the query.
+// Returns a *NotSingularError when more than one PaymentOrderBatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pobq *PaymentOrderBatchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pobq.Limit(2).IDs(setContextOp(ctx, pobq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentorderbatch.Label}
+	default:
+		err = &NotSingularError{paymentorderbatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pobq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentOrderBatches.
+func (pobq *PaymentOrderBatchQuery) All(ctx context.Context) ([]*PaymentOrderBatch, error) {
+	ctx = setContextOp(ctx, pobq.ctx, ent.OpQueryAll)
+	if err := pobq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentOrderBatch, *PaymentOrderBatchQuery]()
+	return withInterceptors[[]*PaymentOrderBatch](ctx, pobq, qr, pobq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) AllX(ctx context.Context) []*PaymentOrderBatch {
+	nodes, err := pobq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentOrderBatch IDs.
+func (pobq *PaymentOrderBatchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pobq.ctx.Unique == nil && pobq.path != nil {
+		pobq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pobq.ctx, ent.OpQueryIDs)
+	if err = pobq.Select(paymentorderbatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pobq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pobq *PaymentOrderBatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pobq.ctx, ent.OpQueryCount)
+	if err := pobq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pobq, querierCount[*PaymentOrderBatchQuery](), pobq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) CountX(ctx context.Context) int {
+	count, err := pobq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pobq *PaymentOrderBatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pobq.ctx, ent.OpQueryExist)
+	switch _, err := pobq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pobq *PaymentOrderBatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := pobq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentOrderBatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pobq *PaymentOrderBatchQuery) Clone() *PaymentOrderBatchQuery {
+	if pobq == nil {
+		return nil
+	}
+	return &PaymentOrderBatchQuery{
+		config:             pobq.config,
+		ctx:                pobq.ctx.Clone(),
+		order:              append([]paymentorderbatch.OrderOption{}, pobq.order...),
+		inters:             append([]Interceptor{}, pobq.inters...),
+		predicates:         append([]predicate.PaymentOrderBatch{}, pobq.predicates...),
+		withSenderProfile:  pobq.withSenderProfile.Clone(),
+		withToken:          pobq.withToken.Clone(),
+		withReceiveAddress: pobq.withReceiveAddress.Clone(),
+		withPaymentOrders:  pobq.withPaymentOrders.Clone(),
+		// clone intermediate query.
+		sql:  pobq.sql.Clone(),
+		path: pobq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (pobq *PaymentOrderBatchQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *PaymentOrderBatchQuery {
+	query := (&SenderProfileClient{config: pobq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pobq.withSenderProfile = query
+	return pobq
+}
+
+// WithToken tells the query-builder to eager-load the nodes that are connected to
+// the "token" edge. The optional arguments are used to configure the query builder of the edge.
+func (pobq *PaymentOrderBatchQuery) WithToken(opts ...func(*TokenQuery)) *PaymentOrderBatchQuery {
+	query := (&TokenClient{config: pobq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pobq.withToken = query
+	return pobq
+}
+
+// WithReceiveAddress tells the query-builder to eager-load the nodes that are connected to
+// the "receive_address" edge. The optional arguments are used to configure the query builder of the edge.
+func (pobq *PaymentOrderBatchQuery) WithReceiveAddress(opts ...func(*ReceiveAddressQuery)) *PaymentOrderBatchQuery {
+	query := (&ReceiveAddressClient{config: pobq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pobq.withReceiveAddress = query
+	return pobq
+}
+
+// WithPaymentOrders tells the query-builder to eager-load the nodes that are connected to
+// the "payment_orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (pobq *PaymentOrderBatchQuery) WithPaymentOrders(opts ...func(*PaymentOrderQuery)) *PaymentOrderBatchQuery {
+	query := (&PaymentOrderClient{config: pobq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pobq.withPaymentOrders = query
+	return pobq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentOrderBatch.Query().
+//		GroupBy(paymentorderbatch.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pobq *PaymentOrderBatchQuery) GroupBy(field string, fields ...string) *PaymentOrderBatchGroupBy {
+	pobq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentOrderBatchGroupBy{build: pobq}
+	grbuild.flds = &pobq.ctx.Fields
+	grbuild.label = paymentorderbatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PaymentOrderBatch.Query().
+//		Select(paymentorderbatch.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pobq *PaymentOrderBatchQuery) Select(fields ...string) *PaymentOrderBatchSelect {
+	pobq.ctx.Fields = append(pobq.ctx.Fields, fields...)
+	sbuild := &PaymentOrderBatchSelect{PaymentOrderBatchQuery: pobq}
+	sbuild.label = paymentorderbatch.Label
+	sbuild.flds, sbuild.scan = &pobq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentOrderBatchSelect configured with the given aggregations.
+func (pobq *PaymentOrderBatchQuery) Aggregate(fns ...AggregateFunc) *PaymentOrderBatchSelect {
+	return pobq.Select().Aggregate(fns...)
+}
+
+func (pobq *PaymentOrderBatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pobq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pobq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pobq.ctx.Fields {
+		if !paymentorderbatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pobq.path != nil {
+		prev, err := pobq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pobq.sql = prev
+	}
+	return nil
+}
+
+func (pobq *PaymentOrderBatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentOrderBatch, error) {
+	var (
+		nodes       = []*PaymentOrderBatch{}
+		withFKs     = pobq.withFKs
+		_spec       = pobq.querySpec()
+		loadedTypes = [4]bool{
+			pobq.withSenderProfile != nil,
+			pobq.withToken != nil,
+			pobq.withReceiveAddress != nil,
+			pobq.withPaymentOrders != nil,
+		}
+	)
+	if pobq.withSenderProfile != nil || pobq.withToken != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentorderbatch.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentOrderBatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentOrderBatch{config: pobq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pobq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pobq.withSenderProfile; query != nil {
+		if err := pobq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *PaymentOrderBatch, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pobq.withToken; query != nil {
+		if err := pobq.loadToken(ctx, query, nodes, nil,
+			func(n *PaymentOrderBatch, e *Token) { n.Edges.Token = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pobq.withReceiveAddress; query != nil {
+		if err := pobq.loadReceiveAddress(ctx, query, nodes, nil,
+			func(n *PaymentOrderBatch, e *ReceiveAddress) { n.Edges.ReceiveAddress = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pobq.withPaymentOrders; query != nil {
+		if err := pobq.loadPaymentOrders(ctx, query, nodes,
+			func(n *PaymentOrderBatch) { n.Edges.PaymentOrders = []*PaymentOrder{} },
+			func(n *PaymentOrderBatch, e *PaymentOrder) { n.Edges.PaymentOrders = append(n.Edges.PaymentOrders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pobq *PaymentOrderBatchQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*PaymentOrderBatch, init func(*PaymentOrderBatch), assign func(*PaymentOrderBatch, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PaymentOrderBatch)
+	for i := range nodes {
+		if nodes[i].sender_profile_payment_order_batches == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_payment_order_batches
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_payment_order_batches" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pobq *PaymentOrderBatchQuery) loadToken(ctx context.Context, query *TokenQuery, nodes []*PaymentOrderBatch, init func(*PaymentOrderBatch), assign func(*PaymentOrderBatch, *Token)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PaymentOrderBatch)
+	for i := range nodes {
+		if nodes[i].token_payment_order_batches == nil {
+			continue
+		}
+		fk := *nodes[i].token_payment_order_batches
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(token.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "token_payment_order_batches" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pobq *PaymentOrderBatchQuery) loadReceiveAddress(ctx context.Context, query *ReceiveAddressQuery, nodes []*PaymentOrderBatch, init func(*PaymentOrderBatch), assign func(*PaymentOrderBatch, *ReceiveAddress)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrderBatch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.ReceiveAddress(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorderbatch.ReceiveAddressColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_batch_receive_address
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_batch_receive_address" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_batch_receive_address" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pobq *PaymentOrderBatchQuery) loadPaymentOrders(ctx context.Context, query *PaymentOrderQuery, nodes []*PaymentOrderBatch, init func(*PaymentOrderBatch), assign func(*PaymentOrderBatch, *PaymentOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrderBatch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorderbatch.PaymentOrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_batch_payment_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_batch_payment_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_batch_payment_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pobq *PaymentOrderBatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pobq.querySpec()
+	_spec.Node.Columns = pobq.ctx.Fields
+	if len(pobq.ctx.Fields) > 0 {
+		_spec.Unique = pobq.ctx.Unique != nil && *pobq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pobq.driver, _spec)
+}
+
+func (pobq *PaymentOrderBatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentorderbatch.Table, paymentorderbatch.Columns, sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID))
+	_spec.From = pobq.sql
+	if unique := pobq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pobq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pobq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentorderbatch.FieldID)
+		for i := range fields {
+			if fields[i] != paymentorderbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pobq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pobq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pobq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pobq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pobq *PaymentOrderBatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pobq.driver.Dialect())
+	t1 := builder.Table(paymentorderbatch.Table)
+	columns := pobq.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentorderbatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pobq.sql != nil {
+		selector = pobq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pobq.ctx.Unique != nil && *pobq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pobq.predicates {
+		p(selector)
+	}
+	for _, p := range pobq.order {
+		p(selector)
+	}
+	if offset := pobq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pobq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PaymentOrderBatchGroupBy is the group-by builder for PaymentOrderBatch entities.
+type PaymentOrderBatchGroupBy struct {
+	selector
+	build *PaymentOrderBatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pobgb *PaymentOrderBatchGroupBy) Aggregate(fns ...AggregateFunc) *PaymentOrderBatchGroupBy {
+	pobgb.fns = append(pobgb.fns, fns...)
+	return pobgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pobgb *PaymentOrderBatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pobgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pobgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentOrderBatchQuery, *PaymentOrderBatchGroupBy](ctx, pobgb.build, pobgb, pobgb.build.inters, v)
+}
+
+func (pobgb *PaymentOrderBatchGroupBy) sqlScan(ctx context.Context, root *PaymentOrderBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pobgb.fns))
+	for _, fn := range pobgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pobgb.flds)+len(pobgb.fns))
+		for _, f := range *pobgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pobgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pobgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentOrderBatchSelect is the builder for selecting fields of PaymentOrderBatch entities.
+type PaymentOrderBatchSelect struct {
+	*PaymentOrderBatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pobs *PaymentOrderBatchSelect) Aggregate(fns ...AggregateFunc) *PaymentOrderBatchSelect {
+	pobs.fns = append(pobs.fns, fns...)
+	return pobs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pobs *PaymentOrderBatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pobs.ctx, ent.OpQuerySelect)
+	if err := pobs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentOrderBatchQuery, *PaymentOrderBatchSelect](ctx, pobs.PaymentOrderBatchQuery, pobs, pobs.inters, v)
+}
+
+func (pobs *PaymentOrderBatchSelect) sqlScan(ctx context.Context, root *PaymentOrderBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pobs.fns))
+	for _, fn := range pobs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pobs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pobs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
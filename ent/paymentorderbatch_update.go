@@ -0,0 +1,1208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderBatchUpdate is the builder for updating PaymentOrderBatch entities.
+type PaymentOrderBatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentOrderBatchMutation
+}
+
+// Where appends a list predicates to the PaymentOrderBatchUpdate builder.
+func (pobu *PaymentOrderBatchUpdate) Where(ps ...predicate.PaymentOrderBatch) *PaymentOrderBatchUpdate {
+	pobu.mutation.Where(ps...)
+	return pobu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pobu *PaymentOrderBatchUpdate) SetUpdatedAt(t time.Time) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetUpdatedAt(t)
+	return pobu
+}
+
+// SetAmount sets the "amount" field.
+func (pobu *PaymentOrderBatchUpdate) SetAmount(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.ResetAmount()
+	pobu.mutation.SetAmount(d)
+	return pobu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableAmount(d *decimal.Decimal) *PaymentOrderBatchUpdate {
+	if d != nil {
+		pobu.SetAmount(*d)
+	}
+	return pobu
+}
+
+// AddAmount adds d to the "amount" field.
+func (pobu *PaymentOrderBatchUpdate) AddAmount(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.AddAmount(d)
+	return pobu
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (pobu *PaymentOrderBatchUpdate) SetAmountPaid(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.ResetAmountPaid()
+	pobu.mutation.SetAmountPaid(d)
+	return pobu
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableAmountPaid(d *decimal.Decimal) *PaymentOrderBatchUpdate {
+	if d != nil {
+		pobu.SetAmountPaid(*d)
+	}
+	return pobu
+}
+
+// AddAmountPaid adds d to the "amount_paid" field.
+func (pobu *PaymentOrderBatchUpdate) AddAmountPaid(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.AddAmountPaid(d)
+	return pobu
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (pobu *PaymentOrderBatchUpdate) SetAmountReturned(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.ResetAmountReturned()
+	pobu.mutation.SetAmountReturned(d)
+	return pobu
+}
+
+// SetNillableAmountReturned sets the "amount_returned" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableAmountReturned(d *decimal.Decimal) *PaymentOrderBatchUpdate {
+	if d != nil {
+		pobu.SetAmountReturned(*d)
+	}
+	return pobu
+}
+
+// AddAmountReturned adds d to the "amount_returned" field.
+func (pobu *PaymentOrderBatchUpdate) AddAmountReturned(d decimal.Decimal) *PaymentOrderBatchUpdate {
+	pobu.mutation.AddAmountReturned(d)
+	return pobu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (pobu *PaymentOrderBatchUpdate) SetTxHash(s string) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetTxHash(s)
+	return pobu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableTxHash(s *string) *PaymentOrderBatchUpdate {
+	if s != nil {
+		pobu.SetTxHash(*s)
+	}
+	return pobu
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (pobu *PaymentOrderBatchUpdate) ClearTxHash() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearTxHash()
+	return pobu
+}
+
+// SetFromAddress sets the "from_address" field.
+func (pobu *PaymentOrderBatchUpdate) SetFromAddress(s string) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetFromAddress(s)
+	return pobu
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableFromAddress(s *string) *PaymentOrderBatchUpdate {
+	if s != nil {
+		pobu.SetFromAddress(*s)
+	}
+	return pobu
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (pobu *PaymentOrderBatchUpdate) ClearFromAddress() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearFromAddress()
+	return pobu
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (pobu *PaymentOrderBatchUpdate) SetReturnAddress(s string) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetReturnAddress(s)
+	return pobu
+}
+
+// SetNillableReturnAddress sets the "return_address" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableReturnAddress(s *string) *PaymentOrderBatchUpdate {
+	if s != nil {
+		pobu.SetReturnAddress(*s)
+	}
+	return pobu
+}
+
+// ClearReturnAddress clears the value of the "return_address" field.
+func (pobu *PaymentOrderBatchUpdate) ClearReturnAddress() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearReturnAddress()
+	return pobu
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (pobu *PaymentOrderBatchUpdate) SetReceiveAddressText(s string) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetReceiveAddressText(s)
+	return pobu
+}
+
+// SetNillableReceiveAddressText sets the "receive_address_text" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableReceiveAddressText(s *string) *PaymentOrderBatchUpdate {
+	if s != nil {
+		pobu.SetReceiveAddressText(*s)
+	}
+	return pobu
+}
+
+// SetReference sets the "reference" field.
+func (pobu *PaymentOrderBatchUpdate) SetReference(s string) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetReference(s)
+	return pobu
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableReference(s *string) *PaymentOrderBatchUpdate {
+	if s != nil {
+		pobu.SetReference(*s)
+	}
+	return pobu
+}
+
+// ClearReference clears the value of the "reference" field.
+func (pobu *PaymentOrderBatchUpdate) ClearReference() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearReference()
+	return pobu
+}
+
+// SetStatus sets the "status" field.
+func (pobu *PaymentOrderBatchUpdate) SetStatus(pa paymentorderbatch.Status) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetStatus(pa)
+	return pobu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableStatus(pa *paymentorderbatch.Status) *PaymentOrderBatchUpdate {
+	if pa != nil {
+		pobu.SetStatus(*pa)
+	}
+	return pobu
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (pobu *PaymentOrderBatchUpdate) SetSenderProfileID(id uuid.UUID) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetSenderProfileID(id)
+	return pobu
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableSenderProfileID(id *uuid.UUID) *PaymentOrderBatchUpdate {
+	if id != nil {
+		pobu = pobu.SetSenderProfileID(*id)
+	}
+	return pobu
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (pobu *PaymentOrderBatchUpdate) SetSenderProfile(s *SenderProfile) *PaymentOrderBatchUpdate {
+	return pobu.SetSenderProfileID(s.ID)
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (pobu *PaymentOrderBatchUpdate) SetTokenID(id int) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetTokenID(id)
+	return pobu
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (pobu *PaymentOrderBatchUpdate) SetToken(t *Token) *PaymentOrderBatchUpdate {
+	return pobu.SetTokenID(t.ID)
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (pobu *PaymentOrderBatchUpdate) SetReceiveAddressID(id int) *PaymentOrderBatchUpdate {
+	pobu.mutation.SetReceiveAddressID(id)
+	return pobu
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (pobu *PaymentOrderBatchUpdate) SetNillableReceiveAddressID(id *int) *PaymentOrderBatchUpdate {
+	if id != nil {
+		pobu = pobu.SetReceiveAddressID(*id)
+	}
+	return pobu
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (pobu *PaymentOrderBatchUpdate) SetReceiveAddress(r *ReceiveAddress) *PaymentOrderBatchUpdate {
+	return pobu.SetReceiveAddressID(r.ID)
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (pobu *PaymentOrderBatchUpdate) AddPaymentOrderIDs(ids ...uuid.UUID) *PaymentOrderBatchUpdate {
+	pobu.mutation.AddPaymentOrderIDs(ids...)
+	return pobu
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (pobu *PaymentOrderBatchUpdate) AddPaymentOrders(p ...*PaymentOrder) *PaymentOrderBatchUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pobu.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the PaymentOrderBatchMutation object of the builder.
+func (pobu *PaymentOrderBatchUpdate) Mutation() *PaymentOrderBatchMutation {
+	return pobu.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (pobu *PaymentOrderBatchUpdate) ClearSenderProfile() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearSenderProfile()
+	return pobu
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (pobu *PaymentOrderBatchUpdate) ClearToken() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearToken()
+	return pobu
+}
+
+// ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
+func (pobu *PaymentOrderBatchUpdate) ClearReceiveAddress() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearReceiveAddress()
+	return pobu
+}
+
+// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
+func (pobu *PaymentOrderBatchUpdate) ClearPaymentOrders() *PaymentOrderBatchUpdate {
+	pobu.mutation.ClearPaymentOrders()
+	return pobu
+}
+
+// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
+func (pobu *PaymentOrderBatchUpdate) RemovePaymentOrderIDs(ids ...uuid.UUID) *PaymentOrderBatchUpdate {
+	pobu.mutation.RemovePaymentOrderIDs(ids...)
+	return pobu
+}
+
+// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
+func (pobu *PaymentOrderBatchUpdate) RemovePaymentOrders(p ...*PaymentOrder) *PaymentOrderBatchUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pobu.RemovePaymentOrderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pobu *PaymentOrderBatchUpdate) Save(ctx context.Context) (int, error) {
+	pobu.defaults()
+	return withHooks(ctx, pobu.sqlSave, pobu.mutation, pobu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pobu *PaymentOrderBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := pobu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pobu *PaymentOrderBatchUpdate) Exec(ctx context.Context) error {
+	_, err := pobu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobu *PaymentOrderBatchUpdate) ExecX(ctx context.Context) {
+	if err := pobu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pobu *PaymentOrderBatchUpdate) defaults() {
+	if _, ok := pobu.mutation.UpdatedAt(); !ok {
+		v := paymentorderbatch.UpdateDefaultUpdatedAt()
+		pobu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pobu *PaymentOrderBatchUpdate) check() error {
+	if v, ok := pobu.mutation.TxHash(); ok {
+		if err := paymentorderbatch.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := pobu.mutation.FromAddress(); ok {
+		if err := paymentorderbatch.FromAddressValidator(v); err != nil {
+			return &ValidationError{Name: "from_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.from_address": %w`, err)}
+		}
+	}
+	if v, ok := pobu.mutation.ReturnAddress(); ok {
+		if err := paymentorderbatch.ReturnAddressValidator(v); err != nil {
+			return &ValidationError{Name: "return_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.return_address": %w`, err)}
+		}
+	}
+	if v, ok := pobu.mutation.ReceiveAddressText(); ok {
+		if err := paymentorderbatch.ReceiveAddressTextValidator(v); err != nil {
+			return &ValidationError{Name: "receive_address_text", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.receive_address_text": %w`, err)}
+		}
+	}
+	if v, ok := pobu.mutation.Reference(); ok {
+		if err := paymentorderbatch.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.reference": %w`, err)}
+		}
+	}
+	if v, ok := pobu.mutation.Status(); ok {
+		if err := paymentorderbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.status": %w`, err)}
+		}
+	}
+	if pobu.mutation.TokenCleared() && len(pobu.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentOrderBatch.token"`)
+	}
+	return nil
+}
+
+func (pobu *PaymentOrderBatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pobu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentorderbatch.Table, paymentorderbatch.Columns, sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID))
+	if ps := pobu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pobu.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentorderbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pobu.mutation.Amount(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.AmountPaid(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountPaid, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmountPaid, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.AmountReturned(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountReturned, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.AddedAmountReturned(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmountReturned, field.TypeFloat64, value)
+	}
+	if value, ok := pobu.mutation.TxHash(); ok {
+		_spec.SetField(paymentorderbatch.FieldTxHash, field.TypeString, value)
+	}
+	if pobu.mutation.TxHashCleared() {
+		_spec.ClearField(paymentorderbatch.FieldTxHash, field.TypeString)
+	}
+	if value, ok := pobu.mutation.FromAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldFromAddress, field.TypeString, value)
+	}
+	if pobu.mutation.FromAddressCleared() {
+		_spec.ClearField(paymentorderbatch.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := pobu.mutation.ReturnAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldReturnAddress, field.TypeString, value)
+	}
+	if pobu.mutation.ReturnAddressCleared() {
+		_spec.ClearField(paymentorderbatch.FieldReturnAddress, field.TypeString)
+	}
+	if value, ok := pobu.mutation.ReceiveAddressText(); ok {
+		_spec.SetField(paymentorderbatch.FieldReceiveAddressText, field.TypeString, value)
+	}
+	if value, ok := pobu.mutation.Reference(); ok {
+		_spec.SetField(paymentorderbatch.FieldReference, field.TypeString, value)
+	}
+	if pobu.mutation.ReferenceCleared() {
+		_spec.ClearField(paymentorderbatch.FieldReference, field.TypeString)
+	}
+	if value, ok := pobu.mutation.Status(); ok {
+		_spec.SetField(paymentorderbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if pobu.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.SenderProfileTable,
+			Columns: []string{paymentorderbatch.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobu.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.SenderProfileTable,
+			Columns: []string{paymentorderbatch.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobu.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.TokenTable,
+			Columns: []string{paymentorderbatch.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobu.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.TokenTable,
+			Columns: []string{paymentorderbatch.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobu.mutation.ReceiveAddressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorderbatch.ReceiveAddressTable,
+			Columns: []string{paymentorderbatch.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobu.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorderbatch.ReceiveAddressTable,
+			Columns: []string{paymentorderbatch.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobu.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobu.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !pobu.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobu.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pobu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentorderbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pobu.mutation.done = true
+	return n, nil
+}
+
+// PaymentOrderBatchUpdateOne is the builder for updating a single PaymentOrderBatch entity.
+type PaymentOrderBatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentOrderBatchMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetUpdatedAt(t time.Time) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetUpdatedAt(t)
+	return pobuo
+}
+
+// SetAmount sets the "amount" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetAmount(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ResetAmount()
+	pobuo.mutation.SetAmount(d)
+	return pobuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableAmount(d *decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	if d != nil {
+		pobuo.SetAmount(*d)
+	}
+	return pobuo
+}
+
+// AddAmount adds d to the "amount" field.
+func (pobuo *PaymentOrderBatchUpdateOne) AddAmount(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.AddAmount(d)
+	return pobuo
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetAmountPaid(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ResetAmountPaid()
+	pobuo.mutation.SetAmountPaid(d)
+	return pobuo
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableAmountPaid(d *decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	if d != nil {
+		pobuo.SetAmountPaid(*d)
+	}
+	return pobuo
+}
+
+// AddAmountPaid adds d to the "amount_paid" field.
+func (pobuo *PaymentOrderBatchUpdateOne) AddAmountPaid(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.AddAmountPaid(d)
+	return pobuo
+}
+
+// SetAmountReturned sets the "amount_returned" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetAmountReturned(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ResetAmountReturned()
+	pobuo.mutation.SetAmountReturned(d)
+	return pobuo
+}
+
+// SetNillableAmountReturned sets the "amount_returned" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableAmountReturned(d *decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	if d != nil {
+		pobuo.SetAmountReturned(*d)
+	}
+	return pobuo
+}
+
+// AddAmountReturned adds d to the "amount_returned" field.
+func (pobuo *PaymentOrderBatchUpdateOne) AddAmountReturned(d decimal.Decimal) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.AddAmountReturned(d)
+	return pobuo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetTxHash(s string) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetTxHash(s)
+	return pobuo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableTxHash(s *string) *PaymentOrderBatchUpdateOne {
+	if s != nil {
+		pobuo.SetTxHash(*s)
+	}
+	return pobuo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearTxHash() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearTxHash()
+	return pobuo
+}
+
+// SetFromAddress sets the "from_address" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetFromAddress(s string) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetFromAddress(s)
+	return pobuo
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableFromAddress(s *string) *PaymentOrderBatchUpdateOne {
+	if s != nil {
+		pobuo.SetFromAddress(*s)
+	}
+	return pobuo
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearFromAddress() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearFromAddress()
+	return pobuo
+}
+
+// SetReturnAddress sets the "return_address" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetReturnAddress(s string) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetReturnAddress(s)
+	return pobuo
+}
+
+// SetNillableReturnAddress sets the "return_address" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableReturnAddress(s *string) *PaymentOrderBatchUpdateOne {
+	if s != nil {
+		pobuo.SetReturnAddress(*s)
+	}
+	return pobuo
+}
+
+// ClearReturnAddress clears the value of the "return_address" field.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearReturnAddress() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearReturnAddress()
+	return pobuo
+}
+
+// SetReceiveAddressText sets the "receive_address_text" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetReceiveAddressText(s string) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetReceiveAddressText(s)
+	return pobuo
+}
+
+// SetNillableReceiveAddressText sets the "receive_address_text" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableReceiveAddressText(s *string) *PaymentOrderBatchUpdateOne {
+	if s != nil {
+		pobuo.SetReceiveAddressText(*s)
+	}
+	return pobuo
+}
+
+// SetReference sets the "reference" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetReference(s string) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetReference(s)
+	return pobuo
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableReference(s *string) *PaymentOrderBatchUpdateOne {
+	if s != nil {
+		pobuo.SetReference(*s)
+	}
+	return pobuo
+}
+
+// ClearReference clears the value of the "reference" field.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearReference() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearReference()
+	return pobuo
+}
+
+// SetStatus sets the "status" field.
+func (pobuo *PaymentOrderBatchUpdateOne) SetStatus(pa paymentorderbatch.Status) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetStatus(pa)
+	return pobuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableStatus(pa *paymentorderbatch.Status) *PaymentOrderBatchUpdateOne {
+	if pa != nil {
+		pobuo.SetStatus(*pa)
+	}
+	return pobuo
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (pobuo *PaymentOrderBatchUpdateOne) SetSenderProfileID(id uuid.UUID) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetSenderProfileID(id)
+	return pobuo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *PaymentOrderBatchUpdateOne {
+	if id != nil {
+		pobuo = pobuo.SetSenderProfileID(*id)
+	}
+	return pobuo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (pobuo *PaymentOrderBatchUpdateOne) SetSenderProfile(s *SenderProfile) *PaymentOrderBatchUpdateOne {
+	return pobuo.SetSenderProfileID(s.ID)
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (pobuo *PaymentOrderBatchUpdateOne) SetTokenID(id int) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetTokenID(id)
+	return pobuo
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (pobuo *PaymentOrderBatchUpdateOne) SetToken(t *Token) *PaymentOrderBatchUpdateOne {
+	return pobuo.SetTokenID(t.ID)
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (pobuo *PaymentOrderBatchUpdateOne) SetReceiveAddressID(id int) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.SetReceiveAddressID(id)
+	return pobuo
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (pobuo *PaymentOrderBatchUpdateOne) SetNillableReceiveAddressID(id *int) *PaymentOrderBatchUpdateOne {
+	if id != nil {
+		pobuo = pobuo.SetReceiveAddressID(*id)
+	}
+	return pobuo
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (pobuo *PaymentOrderBatchUpdateOne) SetReceiveAddress(r *ReceiveAddress) *PaymentOrderBatchUpdateOne {
+	return pobuo.SetReceiveAddressID(r.ID)
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (pobuo *PaymentOrderBatchUpdateOne) AddPaymentOrderIDs(ids ...uuid.UUID) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.AddPaymentOrderIDs(ids...)
+	return pobuo
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (pobuo *PaymentOrderBatchUpdateOne) AddPaymentOrders(p ...*PaymentOrder) *PaymentOrderBatchUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pobuo.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the PaymentOrderBatchMutation object of the builder.
+func (pobuo *PaymentOrderBatchUpdateOne) Mutation() *PaymentOrderBatchMutation {
+	return pobuo.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearSenderProfile() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearSenderProfile()
+	return pobuo
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearToken() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearToken()
+	return pobuo
+}
+
+// ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearReceiveAddress() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearReceiveAddress()
+	return pobuo
+}
+
+// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
+func (pobuo *PaymentOrderBatchUpdateOne) ClearPaymentOrders() *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.ClearPaymentOrders()
+	return pobuo
+}
+
+// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
+func (pobuo *PaymentOrderBatchUpdateOne) RemovePaymentOrderIDs(ids ...uuid.UUID) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.RemovePaymentOrderIDs(ids...)
+	return pobuo
+}
+
+// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
+func (pobuo *PaymentOrderBatchUpdateOne) RemovePaymentOrders(p ...*PaymentOrder) *PaymentOrderBatchUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pobuo.RemovePaymentOrderIDs(ids...)
+}
+
+// Where appends a list predicates to the PaymentOrderBatchUpdate builder.
+func (pobuo *PaymentOrderBatchUpdateOne) Where(ps ...predicate.PaymentOrderBatch) *PaymentOrderBatchUpdateOne {
+	pobuo.mutation.Where(ps...)
+	return pobuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pobuo *PaymentOrderBatchUpdateOne) Select(field string, fields ...string) *PaymentOrderBatchUpdateOne {
+	pobuo.fields = append([]string{field}, fields...)
+	return pobuo
+}
+
+// Save executes the query and returns the updated PaymentOrderBatch entity.
+func (pobuo *PaymentOrderBatchUpdateOne) Save(ctx context.Context) (*PaymentOrderBatch, error) {
+	pobuo.defaults()
+	return withHooks(ctx, pobuo.sqlSave, pobuo.mutation, pobuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pobuo *PaymentOrderBatchUpdateOne) SaveX(ctx context.Context) *PaymentOrderBatch {
+	node, err := pobuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pobuo *PaymentOrderBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := pobuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pobuo *PaymentOrderBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := pobuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pobuo *PaymentOrderBatchUpdateOne) defaults() {
+	if _, ok := pobuo.mutation.UpdatedAt(); !ok {
+		v := paymentorderbatch.UpdateDefaultUpdatedAt()
+		pobuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pobuo *PaymentOrderBatchUpdateOne) check() error {
+	if v, ok := pobuo.mutation.TxHash(); ok {
+		if err := paymentorderbatch.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := pobuo.mutation.FromAddress(); ok {
+		if err := paymentorderbatch.FromAddressValidator(v); err != nil {
+			return &ValidationError{Name: "from_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.from_address": %w`, err)}
+		}
+	}
+	if v, ok := pobuo.mutation.ReturnAddress(); ok {
+		if err := paymentorderbatch.ReturnAddressValidator(v); err != nil {
+			return &ValidationError{Name: "return_address", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.return_address": %w`, err)}
+		}
+	}
+	if v, ok := pobuo.mutation.ReceiveAddressText(); ok {
+		if err := paymentorderbatch.ReceiveAddressTextValidator(v); err != nil {
+			return &ValidationError{Name: "receive_address_text", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.receive_address_text": %w`, err)}
+		}
+	}
+	if v, ok := pobuo.mutation.Reference(); ok {
+		if err := paymentorderbatch.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.reference": %w`, err)}
+		}
+	}
+	if v, ok := pobuo.mutation.Status(); ok {
+		if err := paymentorderbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrderBatch.status": %w`, err)}
+		}
+	}
+	if pobuo.mutation.TokenCleared() && len(pobuo.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentOrderBatch.token"`)
+	}
+	return nil
+}
+
+func (pobuo *PaymentOrderBatchUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrderBatch, err error) {
+	if err := pobuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentorderbatch.Table, paymentorderbatch.Columns, sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID))
+	id, ok := pobuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentOrderBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pobuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentorderbatch.FieldID)
+		for _, f := range fields {
+			if !paymentorderbatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentorderbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pobuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pobuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentorderbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pobuo.mutation.Amount(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.AmountPaid(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountPaid, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmountPaid, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.AmountReturned(); ok {
+		_spec.SetField(paymentorderbatch.FieldAmountReturned, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.AddedAmountReturned(); ok {
+		_spec.AddField(paymentorderbatch.FieldAmountReturned, field.TypeFloat64, value)
+	}
+	if value, ok := pobuo.mutation.TxHash(); ok {
+		_spec.SetField(paymentorderbatch.FieldTxHash, field.TypeString, value)
+	}
+	if pobuo.mutation.TxHashCleared() {
+		_spec.ClearField(paymentorderbatch.FieldTxHash, field.TypeString)
+	}
+	if value, ok := pobuo.mutation.FromAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldFromAddress, field.TypeString, value)
+	}
+	if pobuo.mutation.FromAddressCleared() {
+		_spec.ClearField(paymentorderbatch.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := pobuo.mutation.ReturnAddress(); ok {
+		_spec.SetField(paymentorderbatch.FieldReturnAddress, field.TypeString, value)
+	}
+	if pobuo.mutation.ReturnAddressCleared() {
+		_spec.ClearField(paymentorderbatch.FieldReturnAddress, field.TypeString)
+	}
+	if value, ok := pobuo.mutation.ReceiveAddressText(); ok {
+		_spec.SetField(paymentorderbatch.FieldReceiveAddressText, field.TypeString, value)
+	}
+	if value, ok := pobuo.mutation.Reference(); ok {
+		_spec.SetField(paymentorderbatch.FieldReference, field.TypeString, value)
+	}
+	if pobuo.mutation.ReferenceCleared() {
+		_spec.ClearField(paymentorderbatch.FieldReference, field.TypeString)
+	}
+	if value, ok := pobuo.mutation.Status(); ok {
+		_spec.SetField(paymentorderbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if pobuo.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.SenderProfileTable,
+			Columns: []string{paymentorderbatch.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobuo.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.SenderProfileTable,
+			Columns: []string{paymentorderbatch.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobuo.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.TokenTable,
+			Columns: []string{paymentorderbatch.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobuo.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorderbatch.TokenTable,
+			Columns: []string{paymentorderbatch.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobuo.mutation.ReceiveAddressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorderbatch.ReceiveAddressTable,
+			Columns: []string{paymentorderbatch.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobuo.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentorderbatch.ReceiveAddressTable,
+			Columns: []string{paymentorderbatch.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pobuo.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobuo.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !pobuo.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pobuo.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorderbatch.PaymentOrdersTable,
+			Columns: []string{paymentorderbatch.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentOrderBatch{config: pobuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pobuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentorderbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pobuo.mutation.done = true
+	return _node, nil
+}
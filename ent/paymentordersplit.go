@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderSplit is the model entity for the PaymentOrderSplit schema.
+type PaymentOrderSplit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Institution holds the value of the "institution" field.
+	Institution string `json:"institution,omitempty"`
+	// AccountIdentifier holds the value of the "account_identifier" field.
+	AccountIdentifier string `json:"account_identifier,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName string `json:"account_name,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Percent holds the value of the "percent" field.
+	Percent decimal.Decimal `json:"percent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentOrderSplitQuery when eager-loading is set.
+	Edges                PaymentOrderSplitEdges `json:"edges"`
+	payment_order_splits *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// PaymentOrderSplitEdges holds the relations/edges for other nodes in the graph.
+type PaymentOrderSplitEdges struct {
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderSplitEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentOrderSplit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentordersplit.FieldAmount, paymentordersplit.FieldPercent:
+			values[i] = new(decimal.Decimal)
+		case paymentordersplit.FieldID:
+			values[i] = new(sql.NullInt64)
+		case paymentordersplit.FieldInstitution, paymentordersplit.FieldAccountIdentifier, paymentordersplit.FieldAccountName, paymentordersplit.FieldMemo:
+			values[i] = new(sql.NullString)
+		case paymentordersplit.ForeignKeys[0]: // payment_order_splits
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentOrderSplit fields.
+func (pos *PaymentOrderSplit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentordersplit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pos.ID = int(value.Int64)
+		case paymentordersplit.FieldInstitution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution", values[i])
+			} else if value.Valid {
+				pos.Institution = value.String
+			}
+		case paymentordersplit.FieldAccountIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_identifier", values[i])
+			} else if value.Valid {
+				pos.AccountIdentifier = value.String
+			}
+		case paymentordersplit.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				pos.AccountName = value.String
+			}
+		case paymentordersplit.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				pos.Memo = value.String
+			}
+		case paymentordersplit.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				pos.Amount = *value
+			}
+		case paymentordersplit.FieldPercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field percent", values[i])
+			} else if value != nil {
+				pos.Percent = *value
+			}
+		case paymentordersplit.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_splits", values[i])
+			} else if value.Valid {
+				pos.payment_order_splits = new(uuid.UUID)
+				*pos.payment_order_splits = *value.S.(*uuid.UUID)
+			}
+		default:
+			pos.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentOrderSplit.
+// This includes values selected through modifiers, order, etc.
+func (pos *PaymentOrderSplit) Value(name string) (ent.Value, error) {
+	return pos.selectValues.Get(name)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the PaymentOrderSplit entity.
+func (pos *PaymentOrderSplit) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewPaymentOrderSplitClient(pos.config).QueryPaymentOrder(pos)
+}
+
+// Update returns a builder for updating this PaymentOrderSplit.
+// Note that you need to call PaymentOrderSplit.Unwrap() before calling this method if this PaymentOrderSplit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pos *PaymentOrderSplit) Update() *PaymentOrderSplitUpdateOne {
+	return NewPaymentOrderSplitClient(pos.config).UpdateOne(pos)
+}
+
+// Unwrap unwraps the PaymentOrderSplit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pos *PaymentOrderSplit) Unwrap() *PaymentOrderSplit {
+	_tx, ok := pos.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentOrderSplit is not a transactional entity")
+	}
+	pos.config.driver = _tx.drv
+	return pos
+}
+
+// String implements the fmt.Stringer.
+func (pos *PaymentOrderSplit) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentOrderSplit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pos.ID))
+	builder.WriteString("institution=")
+	builder.WriteString(pos.Institution)
+	builder.WriteString(", ")
+	builder.WriteString("account_identifier=")
+	builder.WriteString(pos.AccountIdentifier)
+	builder.WriteString(", ")
+	builder.WriteString("account_name=")
+	builder.WriteString(pos.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(pos.Memo)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pos.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("percent=")
+	builder.WriteString(fmt.Sprintf("%v", pos.Percent))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentOrderSplits is a parsable slice of PaymentOrderSplit.
+type PaymentOrderSplits []*PaymentOrderSplit
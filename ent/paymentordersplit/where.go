@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentordersplit
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldID, id))
+}
+
+// Institution applies equality check predicate on the "institution" field. It's identical to InstitutionEQ.
+func Institution(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldInstitution, v))
+}
+
+// AccountIdentifier applies equality check predicate on the "account_identifier" field. It's identical to AccountIdentifierEQ.
+func AccountIdentifier(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAccountName, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldMemo, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAmount, v))
+}
+
+// Percent applies equality check predicate on the "percent" field. It's identical to PercentEQ.
+func Percent(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldPercent, v))
+}
+
+// InstitutionEQ applies the EQ predicate on the "institution" field.
+func InstitutionEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldInstitution, v))
+}
+
+// InstitutionNEQ applies the NEQ predicate on the "institution" field.
+func InstitutionNEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldInstitution, v))
+}
+
+// InstitutionIn applies the In predicate on the "institution" field.
+func InstitutionIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldInstitution, vs...))
+}
+
+// InstitutionNotIn applies the NotIn predicate on the "institution" field.
+func InstitutionNotIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldInstitution, vs...))
+}
+
+// InstitutionGT applies the GT predicate on the "institution" field.
+func InstitutionGT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldInstitution, v))
+}
+
+// InstitutionGTE applies the GTE predicate on the "institution" field.
+func InstitutionGTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldInstitution, v))
+}
+
+// InstitutionLT applies the LT predicate on the "institution" field.
+func InstitutionLT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldInstitution, v))
+}
+
+// InstitutionLTE applies the LTE predicate on the "institution" field.
+func InstitutionLTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldInstitution, v))
+}
+
+// InstitutionContains applies the Contains predicate on the "institution" field.
+func InstitutionContains(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContains(FieldInstitution, v))
+}
+
+// InstitutionHasPrefix applies the HasPrefix predicate on the "institution" field.
+func InstitutionHasPrefix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasPrefix(FieldInstitution, v))
+}
+
+// InstitutionHasSuffix applies the HasSuffix predicate on the "institution" field.
+func InstitutionHasSuffix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasSuffix(FieldInstitution, v))
+}
+
+// InstitutionEqualFold applies the EqualFold predicate on the "institution" field.
+func InstitutionEqualFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEqualFold(FieldInstitution, v))
+}
+
+// InstitutionContainsFold applies the ContainsFold predicate on the "institution" field.
+func InstitutionContainsFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContainsFold(FieldInstitution, v))
+}
+
+// AccountIdentifierEQ applies the EQ predicate on the "account_identifier" field.
+func AccountIdentifierEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierNEQ applies the NEQ predicate on the "account_identifier" field.
+func AccountIdentifierNEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierIn applies the In predicate on the "account_identifier" field.
+func AccountIdentifierIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierNotIn applies the NotIn predicate on the "account_identifier" field.
+func AccountIdentifierNotIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierGT applies the GT predicate on the "account_identifier" field.
+func AccountIdentifierGT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierGTE applies the GTE predicate on the "account_identifier" field.
+func AccountIdentifierGTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLT applies the LT predicate on the "account_identifier" field.
+func AccountIdentifierLT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLTE applies the LTE predicate on the "account_identifier" field.
+func AccountIdentifierLTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContains applies the Contains predicate on the "account_identifier" field.
+func AccountIdentifierContains(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContains(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasPrefix applies the HasPrefix predicate on the "account_identifier" field.
+func AccountIdentifierHasPrefix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasPrefix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasSuffix applies the HasSuffix predicate on the "account_identifier" field.
+func AccountIdentifierHasSuffix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasSuffix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierEqualFold applies the EqualFold predicate on the "account_identifier" field.
+func AccountIdentifierEqualFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEqualFold(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContainsFold applies the ContainsFold predicate on the "account_identifier" field.
+func AccountIdentifierContainsFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContainsFold(FieldAccountIdentifier, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldAmount, v))
+}
+
+// PercentEQ applies the EQ predicate on the "percent" field.
+func PercentEQ(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldEQ(FieldPercent, v))
+}
+
+// PercentNEQ applies the NEQ predicate on the "percent" field.
+func PercentNEQ(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNEQ(FieldPercent, v))
+}
+
+// PercentIn applies the In predicate on the "percent" field.
+func PercentIn(vs ...decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldIn(FieldPercent, vs...))
+}
+
+// PercentNotIn applies the NotIn predicate on the "percent" field.
+func PercentNotIn(vs ...decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldNotIn(FieldPercent, vs...))
+}
+
+// PercentGT applies the GT predicate on the "percent" field.
+func PercentGT(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGT(FieldPercent, v))
+}
+
+// PercentGTE applies the GTE predicate on the "percent" field.
+func PercentGTE(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldGTE(FieldPercent, v))
+}
+
+// PercentLT applies the LT predicate on the "percent" field.
+func PercentLT(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLT(FieldPercent, v))
+}
+
+// PercentLTE applies the LTE predicate on the "percent" field.
+func PercentLTE(v decimal.Decimal) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.FieldLTE(FieldPercent, v))
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentOrderSplit) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentOrderSplit) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentOrderSplit) predicate.PaymentOrderSplit {
+	return predicate.PaymentOrderSplit(sql.NotPredicates(p))
+}
@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderSplitCreate is the builder for creating a PaymentOrderSplit entity.
+type PaymentOrderSplitCreate struct {
+	config
+	mutation *PaymentOrderSplitMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetInstitution sets the "institution" field.
+func (posc *PaymentOrderSplitCreate) SetInstitution(s string) *PaymentOrderSplitCreate {
+	posc.mutation.SetInstitution(s)
+	return posc
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (posc *PaymentOrderSplitCreate) SetAccountIdentifier(s string) *PaymentOrderSplitCreate {
+	posc.mutation.SetAccountIdentifier(s)
+	return posc
+}
+
+// SetAccountName sets the "account_name" field.
+func (posc *PaymentOrderSplitCreate) SetAccountName(s string) *PaymentOrderSplitCreate {
+	posc.mutation.SetAccountName(s)
+	return posc
+}
+
+// SetMemo sets the "memo" field.
+func (posc *PaymentOrderSplitCreate) SetMemo(s string) *PaymentOrderSplitCreate {
+	posc.mutation.SetMemo(s)
+	return posc
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (posc *PaymentOrderSplitCreate) SetNillableMemo(s *string) *PaymentOrderSplitCreate {
+	if s != nil {
+		posc.SetMemo(*s)
+	}
+	return posc
+}
+
+// SetAmount sets the "amount" field.
+func (posc *PaymentOrderSplitCreate) SetAmount(d decimal.Decimal) *PaymentOrderSplitCreate {
+	posc.mutation.SetAmount(d)
+	return posc
+}
+
+// SetPercent sets the "percent" field.
+func (posc *PaymentOrderSplitCreate) SetPercent(d decimal.Decimal) *PaymentOrderSplitCreate {
+	posc.mutation.SetPercent(d)
+	return posc
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (posc *PaymentOrderSplitCreate) SetPaymentOrderID(id uuid.UUID) *PaymentOrderSplitCreate {
+	posc.mutation.SetPaymentOrderID(id)
+	return posc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (posc *PaymentOrderSplitCreate) SetPaymentOrder(p *PaymentOrder) *PaymentOrderSplitCreate {
+	return posc.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the PaymentOrderSplitMutation object of the builder.
+func (posc *PaymentOrderSplitCreate) Mutation() *PaymentOrderSplitMutation {
+	return posc.mutation
+}
+
+// Save creates the PaymentOrderSplit in the database.
+func (posc *PaymentOrderSplitCreate) Save(ctx context.Context) (*PaymentOrderSplit, error) {
+	return withHooks(ctx, posc.sqlSave, posc.mutation, posc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (posc *PaymentOrderSplitCreate) SaveX(ctx context.Context) *PaymentOrderSplit {
+	v, err := posc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (posc *PaymentOrderSplitCreate) Exec(ctx context.Context) error {
+	_, err := posc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (posc *PaymentOrderSplitCreate) ExecX(ctx context.Context) {
+	if err := posc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (posc *PaymentOrderSplitCreate) check() error {
+	if _, ok := posc.mutation.Institution(); !ok {
+		return &ValidationError{Name: "institution", err: errors.New(`ent: missing required field "PaymentOrderSplit.institution"`)}
+	}
+	if _, ok := posc.mutation.AccountIdentifier(); !ok {
+		return &ValidationError{Name: "account_identifier", err: errors.New(`ent: missing required field "PaymentOrderSplit.account_identifier"`)}
+	}
+	if _, ok := posc.mutation.AccountName(); !ok {
+		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "PaymentOrderSplit.account_name"`)}
+	}
+	if _, ok := posc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentOrderSplit.amount"`)}
+	}
+	if _, ok := posc.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "PaymentOrderSplit.percent"`)}
+	}
+	if len(posc.mutation.PaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "payment_order", err: errors.New(`ent: missing required edge "PaymentOrderSplit.payment_order"`)}
+	}
+	return nil
+}
+
+func (posc *PaymentOrderSplitCreate) sqlSave(ctx context.Context) (*PaymentOrderSplit, error) {
+	if err := posc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := posc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, posc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	posc.mutation.id = &_node.ID
+	posc.mutation.done = true
+	return _node, nil
+}
+
+func (posc *PaymentOrderSplitCreate) createSpec() (*PaymentOrderSplit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentOrderSplit{config: posc.config}
+		_spec = sqlgraph.NewCreateSpec(paymentordersplit.Table, sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = posc.conflict
+	if value, ok := posc.mutation.Institution(); ok {
+		_spec.SetField(paymentordersplit.FieldInstitution, field.TypeString, value)
+		_node.Institution = value
+	}
+	if value, ok := posc.mutation.AccountIdentifier(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountIdentifier, field.TypeString, value)
+		_node.AccountIdentifier = value
+	}
+	if value, ok := posc.mutation.AccountName(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := posc.mutation.Memo(); ok {
+		_spec.SetField(paymentordersplit.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := posc.mutation.Amount(); ok {
+		_spec.SetField(paymentordersplit.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := posc.mutation.Percent(); ok {
+		_spec.SetField(paymentordersplit.FieldPercent, field.TypeFloat64, value)
+		_node.Percent = value
+	}
+	if nodes := posc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentordersplit.PaymentOrderTable,
+			Columns: []string{paymentordersplit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_splits = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentOrderSplit.Create().
+//		SetInstitution(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentOrderSplitUpsert) {
+//			SetInstitution(v+v).
+//		}).
+//		Exec(ctx)
+func (posc *PaymentOrderSplitCreate) OnConflict(opts ...sql.ConflictOption) *PaymentOrderSplitUpsertOne {
+	posc.conflict = opts
+	return &PaymentOrderSplitUpsertOne{
+		create: posc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (posc *PaymentOrderSplitCreate) OnConflictColumns(columns ...string) *PaymentOrderSplitUpsertOne {
+	posc.conflict = append(posc.conflict, sql.ConflictColumns(columns...))
+	return &PaymentOrderSplitUpsertOne{
+		create: posc,
+	}
+}
+
+type (
+	// PaymentOrderSplitUpsertOne is the builder for "upsert"-ing
+	//  one PaymentOrderSplit node.
+	PaymentOrderSplitUpsertOne struct {
+		create *PaymentOrderSplitCreate
+	}
+
+	// PaymentOrderSplitUpsert is the "OnConflict" setter.
+	PaymentOrderSplitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetInstitution sets the "institution" field.
+func (u *PaymentOrderSplitUpsert) SetInstitution(v string) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldInstitution, v)
+	return u
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdateInstitution() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldInstitution)
+	return u
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *PaymentOrderSplitUpsert) SetAccountIdentifier(v string) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldAccountIdentifier, v)
+	return u
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdateAccountIdentifier() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldAccountIdentifier)
+	return u
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *PaymentOrderSplitUpsert) SetAccountName(v string) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldAccountName, v)
+	return u
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdateAccountName() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldAccountName)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *PaymentOrderSplitUpsert) SetMemo(v string) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdateMemo() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *PaymentOrderSplitUpsert) ClearMemo() *PaymentOrderSplitUpsert {
+	u.SetNull(paymentordersplit.FieldMemo)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderSplitUpsert) SetAmount(v decimal.Decimal) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdateAmount() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderSplitUpsert) AddAmount(v decimal.Decimal) *PaymentOrderSplitUpsert {
+	u.Add(paymentordersplit.FieldAmount, v)
+	return u
+}
+
+// SetPercent sets the "percent" field.
+func (u *PaymentOrderSplitUpsert) SetPercent(v decimal.Decimal) *PaymentOrderSplitUpsert {
+	u.Set(paymentordersplit.FieldPercent, v)
+	return u
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsert) UpdatePercent() *PaymentOrderSplitUpsert {
+	u.SetExcluded(paymentordersplit.FieldPercent)
+	return u
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *PaymentOrderSplitUpsert) AddPercent(v decimal.Decimal) *PaymentOrderSplitUpsert {
+	u.Add(paymentordersplit.FieldPercent, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentOrderSplitUpsertOne) UpdateNewValues() *PaymentOrderSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentOrderSplitUpsertOne) Ignore() *PaymentOrderSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentOrderSplitUpsertOne) DoNothing() *PaymentOrderSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentOrderSplitCreate.OnConflict
+// documentation for more info.
+func (u *PaymentOrderSplitUpsertOne) Update(set func(*PaymentOrderSplitUpsert)) *PaymentOrderSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentOrderSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInstitution sets the "institution" field.
+func (u *PaymentOrderSplitUpsertOne) SetInstitution(v string) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdateInstitution() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *PaymentOrderSplitUpsertOne) SetAccountIdentifier(v string) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdateAccountIdentifier() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *PaymentOrderSplitUpsertOne) SetAccountName(v string) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdateAccountName() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *PaymentOrderSplitUpsertOne) SetMemo(v string) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdateMemo() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *PaymentOrderSplitUpsertOne) ClearMemo() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderSplitUpsertOne) SetAmount(v decimal.Decimal) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderSplitUpsertOne) AddAmount(v decimal.Decimal) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdateAmount() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPercent sets the "percent" field.
+func (u *PaymentOrderSplitUpsertOne) SetPercent(v decimal.Decimal) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *PaymentOrderSplitUpsertOne) AddPercent(v decimal.Decimal) *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertOne) UpdatePercent() *PaymentOrderSplitUpsertOne {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentOrderSplitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentOrderSplitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentOrderSplitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentOrderSplitUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentOrderSplitUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentOrderSplitCreateBulk is the builder for creating many PaymentOrderSplit entities in bulk.
+type PaymentOrderSplitCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentOrderSplitCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentOrderSplit entities in the database.
+func (poscb *PaymentOrderSplitCreateBulk) Save(ctx context.Context) ([]*PaymentOrderSplit, error) {
+	if poscb.err != nil {
+		return nil, poscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(poscb.builders))
+	nodes := make([]*PaymentOrderSplit, len(poscb.builders))
+	mutators := make([]Mutator, len(poscb.builders))
+	for i := range poscb.builders {
+		func(i int, root context.Context) {
+			builder := poscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentOrderSplitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, poscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = poscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, poscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, poscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (poscb *PaymentOrderSplitCreateBulk) SaveX(ctx context.Context) []*PaymentOrderSplit {
+	v, err := poscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (poscb *PaymentOrderSplitCreateBulk) Exec(ctx context.Context) error {
+	_, err := poscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (poscb *PaymentOrderSplitCreateBulk) ExecX(ctx context.Context) {
+	if err := poscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentOrderSplit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentOrderSplitUpsert) {
+//			SetInstitution(v+v).
+//		}).
+//		Exec(ctx)
+func (poscb *PaymentOrderSplitCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentOrderSplitUpsertBulk {
+	poscb.conflict = opts
+	return &PaymentOrderSplitUpsertBulk{
+		create: poscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (poscb *PaymentOrderSplitCreateBulk) OnConflictColumns(columns ...string) *PaymentOrderSplitUpsertBulk {
+	poscb.conflict = append(poscb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentOrderSplitUpsertBulk{
+		create: poscb,
+	}
+}
+
+// PaymentOrderSplitUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentOrderSplit nodes.
+type PaymentOrderSplitUpsertBulk struct {
+	create *PaymentOrderSplitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentOrderSplitUpsertBulk) UpdateNewValues() *PaymentOrderSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentOrderSplit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentOrderSplitUpsertBulk) Ignore() *PaymentOrderSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentOrderSplitUpsertBulk) DoNothing() *PaymentOrderSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentOrderSplitCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentOrderSplitUpsertBulk) Update(set func(*PaymentOrderSplitUpsert)) *PaymentOrderSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentOrderSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInstitution sets the "institution" field.
+func (u *PaymentOrderSplitUpsertBulk) SetInstitution(v string) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdateInstitution() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *PaymentOrderSplitUpsertBulk) SetAccountIdentifier(v string) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdateAccountIdentifier() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *PaymentOrderSplitUpsertBulk) SetAccountName(v string) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdateAccountName() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *PaymentOrderSplitUpsertBulk) SetMemo(v string) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdateMemo() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *PaymentOrderSplitUpsertBulk) ClearMemo() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentOrderSplitUpsertBulk) SetAmount(v decimal.Decimal) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentOrderSplitUpsertBulk) AddAmount(v decimal.Decimal) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdateAmount() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPercent sets the "percent" field.
+func (u *PaymentOrderSplitUpsertBulk) SetPercent(v decimal.Decimal) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *PaymentOrderSplitUpsertBulk) AddPercent(v decimal.Decimal) *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *PaymentOrderSplitUpsertBulk) UpdatePercent() *PaymentOrderSplitUpsertBulk {
+	return u.Update(func(s *PaymentOrderSplitUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentOrderSplitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentOrderSplitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentOrderSplitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentOrderSplitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
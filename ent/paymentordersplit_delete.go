@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// PaymentOrderSplitDelete is the builder for deleting a PaymentOrderSplit entity.
+type PaymentOrderSplitDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentOrderSplitMutation
+}
+
+// Where appends a list predicates to the PaymentOrderSplitDelete builder.
+func (posd *PaymentOrderSplitDelete) Where(ps ...predicate.PaymentOrderSplit) *PaymentOrderSplitDelete {
+	posd.mutation.Where(ps...)
+	return posd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (posd *PaymentOrderSplitDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, posd.sqlExec, posd.mutation, posd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (posd *PaymentOrderSplitDelete) ExecX(ctx context.Context) int {
+	n, err := posd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (posd *PaymentOrderSplitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentordersplit.Table, sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt))
+	if ps := posd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, posd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	posd.mutation.done = true
+	return affected, err
+}
+
+// PaymentOrderSplitDeleteOne is the builder for deleting a single PaymentOrderSplit entity.
+type PaymentOrderSplitDeleteOne struct {
+	posd *PaymentOrderSplitDelete
+}
+
+// Where appends a list predicates to the PaymentOrderSplitDelete builder.
+func (posdo *PaymentOrderSplitDeleteOne) Where(ps ...predicate.PaymentOrderSplit) *PaymentOrderSplitDeleteOne {
+	posdo.posd.mutation.Where(ps...)
+	return posdo
+}
+
+// Exec executes the deletion query.
+func (posdo *PaymentOrderSplitDeleteOne) Exec(ctx context.Context) error {
+	n, err := posdo.posd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentordersplit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (posdo *PaymentOrderSplitDeleteOne) ExecX(ctx context.Context) {
+	if err := posdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
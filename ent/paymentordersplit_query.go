@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// PaymentOrderSplitQuery is the builder for querying PaymentOrderSplit entities.
+type PaymentOrderSplitQuery struct {
+	config
+	ctx              *QueryContext
+	order            []paymentordersplit.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.PaymentOrderSplit
+	withPaymentOrder *PaymentOrderQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentOrderSplitQuery builder.
+func (posq *PaymentOrderSplitQuery) Where(ps ...predicate.PaymentOrderSplit) *PaymentOrderSplitQuery {
+	posq.predicates = append(posq.predicates, ps...)
+	return posq
+}
+
+// Limit the number of records to be returned by this query.
+func (posq *PaymentOrderSplitQuery) Limit(limit int) *PaymentOrderSplitQuery {
+	posq.ctx.Limit = &limit
+	return posq
+}
+
+// Offset to start from.
+func (posq *PaymentOrderSplitQuery) Offset(offset int) *PaymentOrderSplitQuery {
+	posq.ctx.Offset = &offset
+	return posq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (posq *PaymentOrderSplitQuery) Unique(unique bool) *PaymentOrderSplitQuery {
+	posq.ctx.Unique = &unique
+	return posq
+}
+
+// Order specifies how the records should be ordered.
+func (posq *PaymentOrderSplitQuery) Order(o ...paymentordersplit.OrderOption) *PaymentOrderSplitQuery {
+	posq.order = append(posq.order, o...)
+	return posq
+}
+
+// QueryPaymentOrder chains the current query on the "payment_order" edge.
+func (posq *PaymentOrderSplitQuery) QueryPaymentOrder() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: posq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := posq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := posq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentordersplit.Table, paymentordersplit.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentordersplit.PaymentOrderTable, paymentordersplit.PaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(posq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PaymentOrderSplit entity from the query.
+// Returns a *NotFoundError when no PaymentOrderSplit was found.
+func (posq *PaymentOrderSplitQuery) First(ctx context.Context) (*PaymentOrderSplit, error) {
+	nodes, err := posq.Limit(1).All(setContextOp(ctx, posq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentordersplit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) FirstX(ctx context.Context) *PaymentOrderSplit {
+	node, err := posq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentOrderSplit ID from the query.
+// Returns a *NotFoundError when no PaymentOrderSplit ID was found.
+func (posq *PaymentOrderSplitQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = posq.Limit(1).IDs(setContextOp(ctx, posq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentordersplit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) FirstIDX(ctx context.Context) int {
+	id, err := posq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentOrderSplit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentOrderSplit entity is found.
+// Returns a *NotFoundError when no PaymentOrderSplit entities are found.
+func (posq *PaymentOrderSplitQuery) Only(ctx context.Context) (*PaymentOrderSplit, error) {
+	nodes, err := posq.Limit(2).All(setContextOp(ctx, posq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentordersplit.Label}
+	default:
+		return nil, &NotSingularError{paymentordersplit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) OnlyX(ctx context.Context) *PaymentOrderSplit {
+	node, err := posq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentOrderSplit ID in the query.
+// Returns a *NotSingularError when more than one PaymentOrderSplit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (posq *PaymentOrderSplitQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = posq.Limit(2).IDs(setContextOp(ctx, posq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentordersplit.Label}
+	default:
+		err = &NotSingularError{paymentordersplit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) OnlyIDX(ctx context.Context) int {
+	id, err := posq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentOrderSplits.
+func (posq *PaymentOrderSplitQuery) All(ctx context.Context) ([]*PaymentOrderSplit, error) {
+	ctx = setContextOp(ctx, posq.ctx, ent.OpQueryAll)
+	if err := posq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentOrderSplit, *PaymentOrderSplitQuery]()
+	return withInterceptors[[]*PaymentOrderSplit](ctx, posq, qr, posq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) AllX(ctx context.Context) []*PaymentOrderSplit {
+	nodes, err := posq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentOrderSplit IDs.
+func (posq *PaymentOrderSplitQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if posq.ctx.Unique == nil && posq.path != nil {
+		posq.Unique(true)
+	}
+	ctx = setContextOp(ctx, posq.ctx, ent.OpQueryIDs)
+	if err = posq.Select(paymentordersplit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) IDsX(ctx context.Context) []int {
+	ids, err := posq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (posq *PaymentOrderSplitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, posq.ctx, ent.OpQueryCount)
+	if err := posq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, posq, querierCount[*PaymentOrderSplitQuery](), posq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) CountX(ctx context.Context) int {
+	count, err := posq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (posq *PaymentOrderSplitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, posq.ctx, ent.OpQueryExist)
+	switch _, err := posq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (posq *PaymentOrderSplitQuery) ExistX(ctx context.Context) bool {
+	exist, err := posq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentOrderSplitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (posq *PaymentOrderSplitQuery) Clone() *PaymentOrderSplitQuery {
+	if posq == nil {
+		return nil
+	}
+	return &PaymentOrderSplitQuery{
+		config:           posq.config,
+		ctx:              posq.ctx.Clone(),
+		order:            append([]paymentordersplit.OrderOption{}, posq.order...),
+		inters:           append([]Interceptor{}, posq.inters...),
+		predicates:       append([]predicate.PaymentOrderSplit{}, posq.predicates...),
+		withPaymentOrder: posq.withPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  posq.sql.Clone(),
+		path: posq.path,
+	}
+}
+
+// WithPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (posq *PaymentOrderSplitQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery)) *PaymentOrderSplitQuery {
+	query := (&PaymentOrderClient{config: posq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	posq.withPaymentOrder = query
+	return posq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Institution string `json:"institution,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentOrderSplit.Query().
+//		GroupBy(paymentordersplit.FieldInstitution).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (posq *PaymentOrderSplitQuery) GroupBy(field string, fields ...string) *PaymentOrderSplitGroupBy {
+	posq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentOrderSplitGroupBy{build: posq}
+	grbuild.flds = &posq.ctx.Fields
+	grbuild.label = paymentordersplit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Institution string `json:"institution,omitempty"`
+//	}
+//
+//	client.PaymentOrderSplit.Query().
+//		Select(paymentordersplit.FieldInstitution).
+//		Scan(ctx, &v)
+func (posq *PaymentOrderSplitQuery) Select(fields ...string) *PaymentOrderSplitSelect {
+	posq.ctx.Fields = append(posq.ctx.Fields, fields...)
+	sbuild := &PaymentOrderSplitSelect{PaymentOrderSplitQuery: posq}
+	sbuild.label = paymentordersplit.Label
+	sbuild.flds, sbuild.scan = &posq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentOrderSplitSelect configured with the given aggregations.
+func (posq *PaymentOrderSplitQuery) Aggregate(fns ...AggregateFunc) *PaymentOrderSplitSelect {
+	return posq.Select().Aggregate(fns...)
+}
+
+func (posq *PaymentOrderSplitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range posq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, posq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range posq.ctx.Fields {
+		if !paymentordersplit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if posq.path != nil {
+		prev, err := posq.path(ctx)
+		if err != nil {
+			return err
+		}
+		posq.sql = prev
+	}
+	return nil
+}
+
+func (posq *PaymentOrderSplitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentOrderSplit, error) {
+	var (
+		nodes       = []*PaymentOrderSplit{}
+		withFKs     = posq.withFKs
+		_spec       = posq.querySpec()
+		loadedTypes = [1]bool{
+			posq.withPaymentOrder != nil,
+		}
+	)
+	if posq.withPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentordersplit.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentOrderSplit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentOrderSplit{config: posq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, posq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := posq.withPaymentOrder; query != nil {
+		if err := posq.loadPaymentOrder(ctx, query, nodes, nil,
+			func(n *PaymentOrderSplit, e *PaymentOrder) { n.Edges.PaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (posq *PaymentOrderSplitQuery) loadPaymentOrder(ctx context.Context, query *PaymentOrderQuery, nodes []*PaymentOrderSplit, init func(*PaymentOrderSplit), assign func(*PaymentOrderSplit, *PaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PaymentOrderSplit)
+	for i := range nodes {
+		if nodes[i].payment_order_splits == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_splits
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_splits" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (posq *PaymentOrderSplitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := posq.querySpec()
+	_spec.Node.Columns = posq.ctx.Fields
+	if len(posq.ctx.Fields) > 0 {
+		_spec.Unique = posq.ctx.Unique != nil && *posq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, posq.driver, _spec)
+}
+
+func (posq *PaymentOrderSplitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentordersplit.Table, paymentordersplit.Columns, sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt))
+	_spec.From = posq.sql
+	if unique := posq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if posq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := posq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentordersplit.FieldID)
+		for i := range fields {
+			if fields[i] != paymentordersplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := posq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := posq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := posq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := posq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (posq *PaymentOrderSplitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(posq.driver.Dialect())
+	t1 := builder.Table(paymentordersplit.Table)
+	columns := posq.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentordersplit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if posq.sql != nil {
+		selector = posq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if posq.ctx.Unique != nil && *posq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range posq.predicates {
+		p(selector)
+	}
+	for _, p := range posq.order {
+		p(selector)
+	}
+	if offset := posq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := posq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PaymentOrderSplitGroupBy is the group-by builder for PaymentOrderSplit entities.
+type PaymentOrderSplitGroupBy struct {
+	selector
+	build *PaymentOrderSplitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (posgb *PaymentOrderSplitGroupBy) Aggregate(fns ...AggregateFunc) *PaymentOrderSplitGroupBy {
+	posgb.fns = append(posgb.fns, fns...)
+	return posgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (posgb *PaymentOrderSplitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, posgb.build.ctx, ent.OpQueryGroupBy)
+	if err := posgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentOrderSplitQuery, *PaymentOrderSplitGroupBy](ctx, posgb.build, posgb, posgb.build.inters, v)
+}
+
+func (posgb *PaymentOrderSplitGroupBy) sqlScan(ctx context.Context, root *PaymentOrderSplitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(posgb.fns))
+	for _, fn := range posgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*posgb.flds)+len(posgb.fns))
+		for _, f := range *posgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*posgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := posgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentOrderSplitSelect is the builder for selecting fields of PaymentOrderSplit entities.
+type PaymentOrderSplitSelect struct {
+	*PaymentOrderSplitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (poss *PaymentOrderSplitSelect) Aggregate(fns ...AggregateFunc) *PaymentOrderSplitSelect {
+	poss.fns = append(poss.fns, fns...)
+	return poss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (poss *PaymentOrderSplitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, poss.ctx, ent.OpQuerySelect)
+	if err := poss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentOrderSplitQuery, *PaymentOrderSplitSelect](ctx, poss.PaymentOrderSplitQuery, poss, poss.inters, v)
+}
+
+func (poss *PaymentOrderSplitSelect) sqlScan(ctx context.Context, root *PaymentOrderSplitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(poss.fns))
+	for _, fn := range poss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*poss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := poss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderSplitUpdate is the builder for updating PaymentOrderSplit entities.
+type PaymentOrderSplitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentOrderSplitMutation
+}
+
+// Where appends a list predicates to the PaymentOrderSplitUpdate builder.
+func (posu *PaymentOrderSplitUpdate) Where(ps ...predicate.PaymentOrderSplit) *PaymentOrderSplitUpdate {
+	posu.mutation.Where(ps...)
+	return posu
+}
+
+// SetInstitution sets the "institution" field.
+func (posu *PaymentOrderSplitUpdate) SetInstitution(s string) *PaymentOrderSplitUpdate {
+	posu.mutation.SetInstitution(s)
+	return posu
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillableInstitution(s *string) *PaymentOrderSplitUpdate {
+	if s != nil {
+		posu.SetInstitution(*s)
+	}
+	return posu
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (posu *PaymentOrderSplitUpdate) SetAccountIdentifier(s string) *PaymentOrderSplitUpdate {
+	posu.mutation.SetAccountIdentifier(s)
+	return posu
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillableAccountIdentifier(s *string) *PaymentOrderSplitUpdate {
+	if s != nil {
+		posu.SetAccountIdentifier(*s)
+	}
+	return posu
+}
+
+// SetAccountName sets the "account_name" field.
+func (posu *PaymentOrderSplitUpdate) SetAccountName(s string) *PaymentOrderSplitUpdate {
+	posu.mutation.SetAccountName(s)
+	return posu
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillableAccountName(s *string) *PaymentOrderSplitUpdate {
+	if s != nil {
+		posu.SetAccountName(*s)
+	}
+	return posu
+}
+
+// SetMemo sets the "memo" field.
+func (posu *PaymentOrderSplitUpdate) SetMemo(s string) *PaymentOrderSplitUpdate {
+	posu.mutation.SetMemo(s)
+	return posu
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillableMemo(s *string) *PaymentOrderSplitUpdate {
+	if s != nil {
+		posu.SetMemo(*s)
+	}
+	return posu
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (posu *PaymentOrderSplitUpdate) ClearMemo() *PaymentOrderSplitUpdate {
+	posu.mutation.ClearMemo()
+	return posu
+}
+
+// SetAmount sets the "amount" field.
+func (posu *PaymentOrderSplitUpdate) SetAmount(d decimal.Decimal) *PaymentOrderSplitUpdate {
+	posu.mutation.ResetAmount()
+	posu.mutation.SetAmount(d)
+	return posu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillableAmount(d *decimal.Decimal) *PaymentOrderSplitUpdate {
+	if d != nil {
+		posu.SetAmount(*d)
+	}
+	return posu
+}
+
+// AddAmount adds d to the "amount" field.
+func (posu *PaymentOrderSplitUpdate) AddAmount(d decimal.Decimal) *PaymentOrderSplitUpdate {
+	posu.mutation.AddAmount(d)
+	return posu
+}
+
+// SetPercent sets the "percent" field.
+func (posu *PaymentOrderSplitUpdate) SetPercent(d decimal.Decimal) *PaymentOrderSplitUpdate {
+	posu.mutation.ResetPercent()
+	posu.mutation.SetPercent(d)
+	return posu
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (posu *PaymentOrderSplitUpdate) SetNillablePercent(d *decimal.Decimal) *PaymentOrderSplitUpdate {
+	if d != nil {
+		posu.SetPercent(*d)
+	}
+	return posu
+}
+
+// AddPercent adds d to the "percent" field.
+func (posu *PaymentOrderSplitUpdate) AddPercent(d decimal.Decimal) *PaymentOrderSplitUpdate {
+	posu.mutation.AddPercent(d)
+	return posu
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (posu *PaymentOrderSplitUpdate) SetPaymentOrderID(id uuid.UUID) *PaymentOrderSplitUpdate {
+	posu.mutation.SetPaymentOrderID(id)
+	return posu
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (posu *PaymentOrderSplitUpdate) SetPaymentOrder(p *PaymentOrder) *PaymentOrderSplitUpdate {
+	return posu.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the PaymentOrderSplitMutation object of the builder.
+func (posu *PaymentOrderSplitUpdate) Mutation() *PaymentOrderSplitMutation {
+	return posu.mutation
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (posu *PaymentOrderSplitUpdate) ClearPaymentOrder() *PaymentOrderSplitUpdate {
+	posu.mutation.ClearPaymentOrder()
+	return posu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (posu *PaymentOrderSplitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, posu.sqlSave, posu.mutation, posu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (posu *PaymentOrderSplitUpdate) SaveX(ctx context.Context) int {
+	affected, err := posu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (posu *PaymentOrderSplitUpdate) Exec(ctx context.Context) error {
+	_, err := posu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (posu *PaymentOrderSplitUpdate) ExecX(ctx context.Context) {
+	if err := posu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (posu *PaymentOrderSplitUpdate) check() error {
+	if posu.mutation.PaymentOrderCleared() && len(posu.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentOrderSplit.payment_order"`)
+	}
+	return nil
+}
+
+func (posu *PaymentOrderSplitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := posu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentordersplit.Table, paymentordersplit.Columns, sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt))
+	if ps := posu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := posu.mutation.Institution(); ok {
+		_spec.SetField(paymentordersplit.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := posu.mutation.AccountIdentifier(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := posu.mutation.AccountName(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := posu.mutation.Memo(); ok {
+		_spec.SetField(paymentordersplit.FieldMemo, field.TypeString, value)
+	}
+	if posu.mutation.MemoCleared() {
+		_spec.ClearField(paymentordersplit.FieldMemo, field.TypeString)
+	}
+	if value, ok := posu.mutation.Amount(); ok {
+		_spec.SetField(paymentordersplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := posu.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentordersplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := posu.mutation.Percent(); ok {
+		_spec.SetField(paymentordersplit.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := posu.mutation.AddedPercent(); ok {
+		_spec.AddField(paymentordersplit.FieldPercent, field.TypeFloat64, value)
+	}
+	if posu.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentordersplit.PaymentOrderTable,
+			Columns: []string{paymentordersplit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posu.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentordersplit.PaymentOrderTable,
+			Columns: []string{paymentordersplit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, posu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentordersplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	posu.mutation.done = true
+	return n, nil
+}
+
+// PaymentOrderSplitUpdateOne is the builder for updating a single PaymentOrderSplit entity.
+type PaymentOrderSplitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentOrderSplitMutation
+}
+
+// SetInstitution sets the "institution" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetInstitution(s string) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.SetInstitution(s)
+	return posuo
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillableInstitution(s *string) *PaymentOrderSplitUpdateOne {
+	if s != nil {
+		posuo.SetInstitution(*s)
+	}
+	return posuo
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetAccountIdentifier(s string) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.SetAccountIdentifier(s)
+	return posuo
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillableAccountIdentifier(s *string) *PaymentOrderSplitUpdateOne {
+	if s != nil {
+		posuo.SetAccountIdentifier(*s)
+	}
+	return posuo
+}
+
+// SetAccountName sets the "account_name" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetAccountName(s string) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.SetAccountName(s)
+	return posuo
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillableAccountName(s *string) *PaymentOrderSplitUpdateOne {
+	if s != nil {
+		posuo.SetAccountName(*s)
+	}
+	return posuo
+}
+
+// SetMemo sets the "memo" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetMemo(s string) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.SetMemo(s)
+	return posuo
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillableMemo(s *string) *PaymentOrderSplitUpdateOne {
+	if s != nil {
+		posuo.SetMemo(*s)
+	}
+	return posuo
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (posuo *PaymentOrderSplitUpdateOne) ClearMemo() *PaymentOrderSplitUpdateOne {
+	posuo.mutation.ClearMemo()
+	return posuo
+}
+
+// SetAmount sets the "amount" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetAmount(d decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.ResetAmount()
+	posuo.mutation.SetAmount(d)
+	return posuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillableAmount(d *decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	if d != nil {
+		posuo.SetAmount(*d)
+	}
+	return posuo
+}
+
+// AddAmount adds d to the "amount" field.
+func (posuo *PaymentOrderSplitUpdateOne) AddAmount(d decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.AddAmount(d)
+	return posuo
+}
+
+// SetPercent sets the "percent" field.
+func (posuo *PaymentOrderSplitUpdateOne) SetPercent(d decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.ResetPercent()
+	posuo.mutation.SetPercent(d)
+	return posuo
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (posuo *PaymentOrderSplitUpdateOne) SetNillablePercent(d *decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	if d != nil {
+		posuo.SetPercent(*d)
+	}
+	return posuo
+}
+
+// AddPercent adds d to the "percent" field.
+func (posuo *PaymentOrderSplitUpdateOne) AddPercent(d decimal.Decimal) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.AddPercent(d)
+	return posuo
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (posuo *PaymentOrderSplitUpdateOne) SetPaymentOrderID(id uuid.UUID) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.SetPaymentOrderID(id)
+	return posuo
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (posuo *PaymentOrderSplitUpdateOne) SetPaymentOrder(p *PaymentOrder) *PaymentOrderSplitUpdateOne {
+	return posuo.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the PaymentOrderSplitMutation object of the builder.
+func (posuo *PaymentOrderSplitUpdateOne) Mutation() *PaymentOrderSplitMutation {
+	return posuo.mutation
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (posuo *PaymentOrderSplitUpdateOne) ClearPaymentOrder() *PaymentOrderSplitUpdateOne {
+	posuo.mutation.ClearPaymentOrder()
+	return posuo
+}
+
+// Where appends a list predicates to the PaymentOrderSplitUpdate builder.
+func (posuo *PaymentOrderSplitUpdateOne) Where(ps ...predicate.PaymentOrderSplit) *PaymentOrderSplitUpdateOne {
+	posuo.mutation.Where(ps...)
+	return posuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (posuo *PaymentOrderSplitUpdateOne) Select(field string, fields ...string) *PaymentOrderSplitUpdateOne {
+	posuo.fields = append([]string{field}, fields...)
+	return posuo
+}
+
+// Save executes the query and returns the updated PaymentOrderSplit entity.
+func (posuo *PaymentOrderSplitUpdateOne) Save(ctx context.Context) (*PaymentOrderSplit, error) {
+	return withHooks(ctx, posuo.sqlSave, posuo.mutation, posuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (posuo *PaymentOrderSplitUpdateOne) SaveX(ctx context.Context) *PaymentOrderSplit {
+	node, err := posuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (posuo *PaymentOrderSplitUpdateOne) Exec(ctx context.Context) error {
+	_, err := posuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (posuo *PaymentOrderSplitUpdateOne) ExecX(ctx context.Context) {
+	if err := posuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (posuo *PaymentOrderSplitUpdateOne) check() error {
+	if posuo.mutation.PaymentOrderCleared() && len(posuo.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentOrderSplit.payment_order"`)
+	}
+	return nil
+}
+
+func (posuo *PaymentOrderSplitUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrderSplit, err error) {
+	if err := posuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentordersplit.Table, paymentordersplit.Columns, sqlgraph.NewFieldSpec(paymentordersplit.FieldID, field.TypeInt))
+	id, ok := posuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentOrderSplit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := posuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentordersplit.FieldID)
+		for _, f := range fields {
+			if !paymentordersplit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentordersplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := posuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := posuo.mutation.Institution(); ok {
+		_spec.SetField(paymentordersplit.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := posuo.mutation.AccountIdentifier(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := posuo.mutation.AccountName(); ok {
+		_spec.SetField(paymentordersplit.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := posuo.mutation.Memo(); ok {
+		_spec.SetField(paymentordersplit.FieldMemo, field.TypeString, value)
+	}
+	if posuo.mutation.MemoCleared() {
+		_spec.ClearField(paymentordersplit.FieldMemo, field.TypeString)
+	}
+	if value, ok := posuo.mutation.Amount(); ok {
+		_spec.SetField(paymentordersplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := posuo.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentordersplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := posuo.mutation.Percent(); ok {
+		_spec.SetField(paymentordersplit.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := posuo.mutation.AddedPercent(); ok {
+		_spec.AddField(paymentordersplit.FieldPercent, field.TypeFloat64, value)
+	}
+	if posuo.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentordersplit.PaymentOrderTable,
+			Columns: []string{paymentordersplit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posuo.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentordersplit.PaymentOrderTable,
+			Columns: []string{paymentordersplit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentOrderSplit{config: posuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, posuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentordersplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	posuo.mutation.done = true
+	return _node, nil
+}
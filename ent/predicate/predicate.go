@@ -9,6 +9,12 @@ import (
 // APIKey is the predicate function for apikey builders.
 type APIKey func(*sql.Selector)
 
+// Beneficiary is the predicate function for beneficiary builders.
+type Beneficiary func(*sql.Selector)
+
+// Dispute is the predicate function for dispute builders.
+type Dispute func(*sql.Selector)
+
 // FiatCurrency is the predicate function for fiatcurrency builders.
 type FiatCurrency func(*sql.Selector)
 
@@ -33,15 +39,27 @@ type Network func(*sql.Selector)
 // PaymentOrder is the predicate function for paymentorder builders.
 type PaymentOrder func(*sql.Selector)
 
+// PaymentOrderBatch is the predicate function for paymentorderbatch builders.
+type PaymentOrderBatch func(*sql.Selector)
+
 // PaymentOrderRecipient is the predicate function for paymentorderrecipient builders.
 type PaymentOrderRecipient func(*sql.Selector)
 
+// PaymentOrderSplit is the predicate function for paymentordersplit builders.
+type PaymentOrderSplit func(*sql.Selector)
+
+// ProviderFiatBalance is the predicate function for providerfiatbalance builders.
+type ProviderFiatBalance func(*sql.Selector)
+
 // ProviderOrderToken is the predicate function for providerordertoken builders.
 type ProviderOrderToken func(*sql.Selector)
 
 // ProviderProfile is the predicate function for providerprofile builders.
 type ProviderProfile func(*sql.Selector)
 
+// ProviderRateExclusion is the predicate function for providerrateexclusion builders.
+type ProviderRateExclusion func(*sql.Selector)
+
 // ProviderRating is the predicate function for providerrating builders.
 type ProviderRating func(*sql.Selector)
 
@@ -51,12 +69,24 @@ type ProvisionBucket func(*sql.Selector)
 // ReceiveAddress is the predicate function for receiveaddress builders.
 type ReceiveAddress func(*sql.Selector)
 
+// ScheduledOrder is the predicate function for scheduledorder builders.
+type ScheduledOrder func(*sql.Selector)
+
+// ScheduledOrderRun is the predicate function for scheduledorderrun builders.
+type ScheduledOrderRun func(*sql.Selector)
+
+// SenderFeeTier is the predicate function for senderfeetier builders.
+type SenderFeeTier func(*sql.Selector)
+
 // SenderOrderToken is the predicate function for senderordertoken builders.
 type SenderOrderToken func(*sql.Selector)
 
 // SenderProfile is the predicate function for senderprofile builders.
 type SenderProfile func(*sql.Selector)
 
+// TeamMember is the predicate function for teammember builders.
+type TeamMember func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
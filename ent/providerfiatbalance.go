@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderFiatBalance is the model entity for the ProviderFiatBalance schema.
+type ProviderFiatBalance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AvailableBalance holds the value of the "available_balance" field.
+	AvailableBalance decimal.Decimal `json:"available_balance,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderFiatBalanceQuery when eager-loading is set.
+	Edges                           ProviderFiatBalanceEdges `json:"edges"`
+	fiat_currency_provider_balances *uuid.UUID
+	provider_profile_fiat_balances  *string
+	selectValues                    sql.SelectValues
+}
+
+// ProviderFiatBalanceEdges holds the relations/edges for other nodes in the graph.
+type ProviderFiatBalanceEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderFiatBalanceEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderFiatBalanceEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderFiatBalance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerfiatbalance.FieldAvailableBalance:
+			values[i] = new(decimal.Decimal)
+		case providerfiatbalance.FieldCreatedAt, providerfiatbalance.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case providerfiatbalance.FieldID:
+			values[i] = new(uuid.UUID)
+		case providerfiatbalance.ForeignKeys[0]: // fiat_currency_provider_balances
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case providerfiatbalance.ForeignKeys[1]: // provider_profile_fiat_balances
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderFiatBalance fields.
+func (pfb *ProviderFiatBalance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerfiatbalance.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pfb.ID = *value
+			}
+		case providerfiatbalance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pfb.CreatedAt = value.Time
+			}
+		case providerfiatbalance.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pfb.UpdatedAt = value.Time
+			}
+		case providerfiatbalance.FieldAvailableBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field available_balance", values[i])
+			} else if value != nil {
+				pfb.AvailableBalance = *value
+			}
+		case providerfiatbalance.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_provider_balances", values[i])
+			} else if value.Valid {
+				pfb.fiat_currency_provider_balances = new(uuid.UUID)
+				*pfb.fiat_currency_provider_balances = *value.S.(*uuid.UUID)
+			}
+		case providerfiatbalance.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_fiat_balances", values[i])
+			} else if value.Valid {
+				pfb.provider_profile_fiat_balances = new(string)
+				*pfb.provider_profile_fiat_balances = value.String
+			}
+		default:
+			pfb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderFiatBalance.
+// This includes values selected through modifiers, order, etc.
+func (pfb *ProviderFiatBalance) Value(name string) (ent.Value, error) {
+	return pfb.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ProviderFiatBalance entity.
+func (pfb *ProviderFiatBalance) QueryProvider() *ProviderProfileQuery {
+	return NewProviderFiatBalanceClient(pfb.config).QueryProvider(pfb)
+}
+
+// QueryCurrency queries the "currency" edge of the ProviderFiatBalance entity.
+func (pfb *ProviderFiatBalance) QueryCurrency() *FiatCurrencyQuery {
+	return NewProviderFiatBalanceClient(pfb.config).QueryCurrency(pfb)
+}
+
+// Update returns a builder for updating this ProviderFiatBalance.
+// Note that you need to call ProviderFiatBalance.Unwrap() before calling this method if this ProviderFiatBalance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pfb *ProviderFiatBalance) Update() *ProviderFiatBalanceUpdateOne {
+	return NewProviderFiatBalanceClient(pfb.config).UpdateOne(pfb)
+}
+
+// Unwrap unwraps the ProviderFiatBalance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pfb *ProviderFiatBalance) Unwrap() *ProviderFiatBalance {
+	_tx, ok := pfb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderFiatBalance is not a transactional entity")
+	}
+	pfb.config.driver = _tx.drv
+	return pfb
+}
+
+// String implements the fmt.Stringer.
+func (pfb *ProviderFiatBalance) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderFiatBalance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pfb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pfb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pfb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("available_balance=")
+	builder.WriteString(fmt.Sprintf("%v", pfb.AvailableBalance))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderFiatBalances is a parsable slice of ProviderFiatBalance.
+type ProviderFiatBalances []*ProviderFiatBalance
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerfiatbalance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AvailableBalance applies equality check predicate on the "available_balance" field. It's identical to AvailableBalanceEQ.
+func AvailableBalance(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldAvailableBalance, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AvailableBalanceEQ applies the EQ predicate on the "available_balance" field.
+func AvailableBalanceEQ(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldEQ(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceNEQ applies the NEQ predicate on the "available_balance" field.
+func AvailableBalanceNEQ(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNEQ(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceIn applies the In predicate on the "available_balance" field.
+func AvailableBalanceIn(vs ...decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldIn(FieldAvailableBalance, vs...))
+}
+
+// AvailableBalanceNotIn applies the NotIn predicate on the "available_balance" field.
+func AvailableBalanceNotIn(vs ...decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldNotIn(FieldAvailableBalance, vs...))
+}
+
+// AvailableBalanceGT applies the GT predicate on the "available_balance" field.
+func AvailableBalanceGT(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGT(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceGTE applies the GTE predicate on the "available_balance" field.
+func AvailableBalanceGTE(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldGTE(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceLT applies the LT predicate on the "available_balance" field.
+func AvailableBalanceLT(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLT(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceLTE applies the LTE predicate on the "available_balance" field.
+func AvailableBalanceLTE(v decimal.Decimal) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.FieldLTE(FieldAvailableBalance, v))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderFiatBalance) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderFiatBalance) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderFiatBalance) predicate.ProviderFiatBalance {
+	return predicate.ProviderFiatBalance(sql.NotPredicates(p))
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderFiatBalanceCreate is the builder for creating a ProviderFiatBalance entity.
+type ProviderFiatBalanceCreate struct {
+	config
+	mutation *ProviderFiatBalanceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pfbc *ProviderFiatBalanceCreate) SetCreatedAt(t time.Time) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetCreatedAt(t)
+	return pfbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pfbc *ProviderFiatBalanceCreate) SetNillableCreatedAt(t *time.Time) *ProviderFiatBalanceCreate {
+	if t != nil {
+		pfbc.SetCreatedAt(*t)
+	}
+	return pfbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pfbc *ProviderFiatBalanceCreate) SetUpdatedAt(t time.Time) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetUpdatedAt(t)
+	return pfbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pfbc *ProviderFiatBalanceCreate) SetNillableUpdatedAt(t *time.Time) *ProviderFiatBalanceCreate {
+	if t != nil {
+		pfbc.SetUpdatedAt(*t)
+	}
+	return pfbc
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pfbc *ProviderFiatBalanceCreate) SetAvailableBalance(d decimal.Decimal) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetAvailableBalance(d)
+	return pfbc
+}
+
+// SetID sets the "id" field.
+func (pfbc *ProviderFiatBalanceCreate) SetID(u uuid.UUID) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetID(u)
+	return pfbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pfbc *ProviderFiatBalanceCreate) SetNillableID(u *uuid.UUID) *ProviderFiatBalanceCreate {
+	if u != nil {
+		pfbc.SetID(*u)
+	}
+	return pfbc
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (pfbc *ProviderFiatBalanceCreate) SetProviderID(id string) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetProviderID(id)
+	return pfbc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (pfbc *ProviderFiatBalanceCreate) SetProvider(p *ProviderProfile) *ProviderFiatBalanceCreate {
+	return pfbc.SetProviderID(p.ID)
+}
+
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (pfbc *ProviderFiatBalanceCreate) SetCurrencyID(id uuid.UUID) *ProviderFiatBalanceCreate {
+	pfbc.mutation.SetCurrencyID(id)
+	return pfbc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (pfbc *ProviderFiatBalanceCreate) SetCurrency(f *FiatCurrency) *ProviderFiatBalanceCreate {
+	return pfbc.SetCurrencyID(f.ID)
+}
+
+// Mutation returns the ProviderFiatBalanceMutation object of the builder.
+func (pfbc *ProviderFiatBalanceCreate) Mutation() *ProviderFiatBalanceMutation {
+	return pfbc.mutation
+}
+
+// Save creates the ProviderFiatBalance in the database.
+func (pfbc *ProviderFiatBalanceCreate) Save(ctx context.Context) (*ProviderFiatBalance, error) {
+	pfbc.defaults()
+	return withHooks(ctx, pfbc.sqlSave, pfbc.mutation, pfbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pfbc *ProviderFiatBalanceCreate) SaveX(ctx context.Context) *ProviderFiatBalance {
+	v, err := pfbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfbc *ProviderFiatBalanceCreate) Exec(ctx context.Context) error {
+	_, err := pfbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbc *ProviderFiatBalanceCreate) ExecX(ctx context.Context) {
+	if err := pfbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pfbc *ProviderFiatBalanceCreate) defaults() {
+	if _, ok := pfbc.mutation.CreatedAt(); !ok {
+		v := providerfiatbalance.DefaultCreatedAt()
+		pfbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pfbc.mutation.UpdatedAt(); !ok {
+		v := providerfiatbalance.DefaultUpdatedAt()
+		pfbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pfbc.mutation.ID(); !ok {
+		v := providerfiatbalance.DefaultID()
+		pfbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfbc *ProviderFiatBalanceCreate) check() error {
+	if _, ok := pfbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderFiatBalance.created_at"`)}
+	}
+	if _, ok := pfbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProviderFiatBalance.updated_at"`)}
+	}
+	if _, ok := pfbc.mutation.AvailableBalance(); !ok {
+		return &ValidationError{Name: "available_balance", err: errors.New(`ent: missing required field "ProviderFiatBalance.available_balance"`)}
+	}
+	if len(pfbc.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ProviderFiatBalance.provider"`)}
+	}
+	if len(pfbc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "ProviderFiatBalance.currency"`)}
+	}
+	return nil
+}
+
+func (pfbc *ProviderFiatBalanceCreate) sqlSave(ctx context.Context) (*ProviderFiatBalance, error) {
+	if err := pfbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pfbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pfbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pfbc.mutation.id = &_node.ID
+	pfbc.mutation.done = true
+	return _node, nil
+}
+
+func (pfbc *ProviderFiatBalanceCreate) createSpec() (*ProviderFiatBalance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderFiatBalance{config: pfbc.config}
+		_spec = sqlgraph.NewCreateSpec(providerfiatbalance.Table, sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pfbc.conflict
+	if id, ok := pfbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pfbc.mutation.CreatedAt(); ok {
+		_spec.SetField(providerfiatbalance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pfbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerfiatbalance.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pfbc.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerfiatbalance.FieldAvailableBalance, field.TypeFloat64, value)
+		_node.AvailableBalance = value
+	}
+	if nodes := pfbc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerfiatbalance.ProviderTable,
+			Columns: []string{providerfiatbalance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_fiat_balances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pfbc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerfiatbalance.CurrencyTable,
+			Columns: []string{providerfiatbalance.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_provider_balances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderFiatBalance.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderFiatBalanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pfbc *ProviderFiatBalanceCreate) OnConflict(opts ...sql.ConflictOption) *ProviderFiatBalanceUpsertOne {
+	pfbc.conflict = opts
+	return &ProviderFiatBalanceUpsertOne{
+		create: pfbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pfbc *ProviderFiatBalanceCreate) OnConflictColumns(columns ...string) *ProviderFiatBalanceUpsertOne {
+	pfbc.conflict = append(pfbc.conflict, sql.ConflictColumns(columns...))
+	return &ProviderFiatBalanceUpsertOne{
+		create: pfbc,
+	}
+}
+
+type (
+	// ProviderFiatBalanceUpsertOne is the builder for "upsert"-ing
+	//  one ProviderFiatBalance node.
+	ProviderFiatBalanceUpsertOne struct {
+		create *ProviderFiatBalanceCreate
+	}
+
+	// ProviderFiatBalanceUpsert is the "OnConflict" setter.
+	ProviderFiatBalanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderFiatBalanceUpsert) SetUpdatedAt(v time.Time) *ProviderFiatBalanceUpsert {
+	u.Set(providerfiatbalance.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsert) UpdateUpdatedAt() *ProviderFiatBalanceUpsert {
+	u.SetExcluded(providerfiatbalance.FieldUpdatedAt)
+	return u
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderFiatBalanceUpsert) SetAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsert {
+	u.Set(providerfiatbalance.FieldAvailableBalance, v)
+	return u
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsert) UpdateAvailableBalance() *ProviderFiatBalanceUpsert {
+	u.SetExcluded(providerfiatbalance.FieldAvailableBalance)
+	return u
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderFiatBalanceUpsert) AddAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsert {
+	u.Add(providerfiatbalance.FieldAvailableBalance, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerfiatbalance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderFiatBalanceUpsertOne) UpdateNewValues() *ProviderFiatBalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(providerfiatbalance.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(providerfiatbalance.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderFiatBalanceUpsertOne) Ignore() *ProviderFiatBalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderFiatBalanceUpsertOne) DoNothing() *ProviderFiatBalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderFiatBalanceCreate.OnConflict
+// documentation for more info.
+func (u *ProviderFiatBalanceUpsertOne) Update(set func(*ProviderFiatBalanceUpsert)) *ProviderFiatBalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderFiatBalanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderFiatBalanceUpsertOne) SetUpdatedAt(v time.Time) *ProviderFiatBalanceUpsertOne {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsertOne) UpdateUpdatedAt() *ProviderFiatBalanceUpsertOne {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderFiatBalanceUpsertOne) SetAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsertOne {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.SetAvailableBalance(v)
+	})
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderFiatBalanceUpsertOne) AddAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsertOne {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.AddAvailableBalance(v)
+	})
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsertOne) UpdateAvailableBalance() *ProviderFiatBalanceUpsertOne {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.UpdateAvailableBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderFiatBalanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderFiatBalanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderFiatBalanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderFiatBalanceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProviderFiatBalanceUpsertOne.ID is not supported by MySQL driver. Use ProviderFiatBalanceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderFiatBalanceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderFiatBalanceCreateBulk is the builder for creating many ProviderFiatBalance entities in bulk.
+type ProviderFiatBalanceCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderFiatBalanceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderFiatBalance entities in the database.
+func (pfbcb *ProviderFiatBalanceCreateBulk) Save(ctx context.Context) ([]*ProviderFiatBalance, error) {
+	if pfbcb.err != nil {
+		return nil, pfbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pfbcb.builders))
+	nodes := make([]*ProviderFiatBalance, len(pfbcb.builders))
+	mutators := make([]Mutator, len(pfbcb.builders))
+	for i := range pfbcb.builders {
+		func(i int, root context.Context) {
+			builder := pfbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderFiatBalanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pfbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pfbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pfbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pfbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfbcb *ProviderFiatBalanceCreateBulk) SaveX(ctx context.Context) []*ProviderFiatBalance {
+	v, err := pfbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfbcb *ProviderFiatBalanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := pfbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbcb *ProviderFiatBalanceCreateBulk) ExecX(ctx context.Context) {
+	if err := pfbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderFiatBalance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderFiatBalanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pfbcb *ProviderFiatBalanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderFiatBalanceUpsertBulk {
+	pfbcb.conflict = opts
+	return &ProviderFiatBalanceUpsertBulk{
+		create: pfbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pfbcb *ProviderFiatBalanceCreateBulk) OnConflictColumns(columns ...string) *ProviderFiatBalanceUpsertBulk {
+	pfbcb.conflict = append(pfbcb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderFiatBalanceUpsertBulk{
+		create: pfbcb,
+	}
+}
+
+// ProviderFiatBalanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderFiatBalance nodes.
+type ProviderFiatBalanceUpsertBulk struct {
+	create *ProviderFiatBalanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerfiatbalance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderFiatBalanceUpsertBulk) UpdateNewValues() *ProviderFiatBalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(providerfiatbalance.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(providerfiatbalance.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderFiatBalance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderFiatBalanceUpsertBulk) Ignore() *ProviderFiatBalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderFiatBalanceUpsertBulk) DoNothing() *ProviderFiatBalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderFiatBalanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderFiatBalanceUpsertBulk) Update(set func(*ProviderFiatBalanceUpsert)) *ProviderFiatBalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderFiatBalanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderFiatBalanceUpsertBulk) SetUpdatedAt(v time.Time) *ProviderFiatBalanceUpsertBulk {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsertBulk) UpdateUpdatedAt() *ProviderFiatBalanceUpsertBulk {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderFiatBalanceUpsertBulk) SetAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsertBulk {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.SetAvailableBalance(v)
+	})
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderFiatBalanceUpsertBulk) AddAvailableBalance(v decimal.Decimal) *ProviderFiatBalanceUpsertBulk {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.AddAvailableBalance(v)
+	})
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderFiatBalanceUpsertBulk) UpdateAvailableBalance() *ProviderFiatBalanceUpsertBulk {
+	return u.Update(func(s *ProviderFiatBalanceUpsert) {
+		s.UpdateAvailableBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderFiatBalanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderFiatBalanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderFiatBalanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderFiatBalanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+)
+
+// ProviderFiatBalanceDelete is the builder for deleting a ProviderFiatBalance entity.
+type ProviderFiatBalanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderFiatBalanceMutation
+}
+
+// Where appends a list predicates to the ProviderFiatBalanceDelete builder.
+func (pfbd *ProviderFiatBalanceDelete) Where(ps ...predicate.ProviderFiatBalance) *ProviderFiatBalanceDelete {
+	pfbd.mutation.Where(ps...)
+	return pfbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pfbd *ProviderFiatBalanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pfbd.sqlExec, pfbd.mutation, pfbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbd *ProviderFiatBalanceDelete) ExecX(ctx context.Context) int {
+	n, err := pfbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pfbd *ProviderFiatBalanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerfiatbalance.Table, sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID))
+	if ps := pfbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pfbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pfbd.mutation.done = true
+	return affected, err
+}
+
+// ProviderFiatBalanceDeleteOne is the builder for deleting a single ProviderFiatBalance entity.
+type ProviderFiatBalanceDeleteOne struct {
+	pfbd *ProviderFiatBalanceDelete
+}
+
+// Where appends a list predicates to the ProviderFiatBalanceDelete builder.
+func (pfbdo *ProviderFiatBalanceDeleteOne) Where(ps ...predicate.ProviderFiatBalance) *ProviderFiatBalanceDeleteOne {
+	pfbdo.pfbd.mutation.Where(ps...)
+	return pfbdo
+}
+
+// Exec executes the deletion query.
+func (pfbdo *ProviderFiatBalanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := pfbdo.pfbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerfiatbalance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbdo *ProviderFiatBalanceDeleteOne) ExecX(ctx context.Context) {
+	if err := pfbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// ProviderFiatBalanceQuery is the builder for querying ProviderFiatBalance entities.
+type ProviderFiatBalanceQuery struct {
+	config
+	ctx          *QueryContext
+	order        []providerfiatbalance.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProviderFiatBalance
+	withProvider *ProviderProfileQuery
+	withCurrency *FiatCurrencyQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderFiatBalanceQuery builder.
+func (pfbq *ProviderFiatBalanceQuery) Where(ps ...predicate.ProviderFiatBalance) *ProviderFiatBalanceQuery {
+	pfbq.predicates = append(pfbq.predicates, ps...)
+	return pfbq
+}
+
+// Limit the number of records to be returned by this query.
+func (pfbq *ProviderFiatBalanceQuery) Limit(limit int) *ProviderFiatBalanceQuery {
+	pfbq.ctx.Limit = &limit
+	return pfbq
+}
+
+// Offset to start from.
+func (pfbq *ProviderFiatBalanceQuery) Offset(offset int) *ProviderFiatBalanceQuery {
+	pfbq.ctx.Offset = &offset
+	return pfbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pfbq *ProviderFiatBalanceQuery) Unique(unique bool) *ProviderFiatBalanceQuery {
+	pfbq.ctx.Unique = &unique
+	return pfbq
+}
+
+// Order specifies how the records should be ordered.
+func (pfbq *ProviderFiatBalanceQuery) Order(o ...providerfiatbalance.OrderOption) *ProviderFiatBalanceQuery {
+	pfbq.order = append(pfbq.order, o...)
+	return pfbq
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (pfbq *ProviderFiatBalanceQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: pfbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerfiatbalance.Table, providerfiatbalance.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerfiatbalance.ProviderTable, providerfiatbalance.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pfbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCurrency chains the current query on the "currency" edge.
+func (pfbq *ProviderFiatBalanceQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: pfbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerfiatbalance.Table, providerfiatbalance.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerfiatbalance.CurrencyTable, providerfiatbalance.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pfbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderFiatBalance entity from the query.
+// Returns a *NotFoundError when no ProviderFiatBalance was found.
+func (pfbq *ProviderFiatBalanceQuery) First(ctx context.Context) (*ProviderFiatBalance, error) {
+	nodes, err := pfbq.Limit(1).All(setContextOp(ctx, pfbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerfiatbalance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) FirstX(ctx context.Context) *ProviderFiatBalance {
+	node, err := pfbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderFiatBalance ID from the query.
+// Returns a *NotFoundError when no ProviderFiatBalance ID was found.
+func (pfbq *ProviderFiatBalanceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pfbq.Limit(1).IDs(setContextOp(ctx, pfbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerfiatbalance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pfbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderFiatBalance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderFiatBalance entity is found.
+// Returns a *NotFoundError when no ProviderFiatBalance entities are found.
+func (pfbq *ProviderFiatBalanceQuery) Only(ctx context.Context) (*ProviderFiatBalance, error) {
+	nodes, err := pfbq.Limit(2).All(setContextOp(ctx, pfbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerfiatbalance.Label}
+	default:
+		return nil, &NotSingularError{providerfiatbalance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) OnlyX(ctx context.Context) *ProviderFiatBalance {
+	node, err := pfbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderFiatBalance ID in the query.
+// Returns a *NotSingularError when more than one ProviderFiatBalance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pfbq *ProviderFiatBalanceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pfbq.Limit(2).IDs(setContextOp(ctx, pfbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerfiatbalance.Label}
+	default:
+		err = &NotSingularError{providerfiatbalance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pfbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderFiatBalances.
+func (pfbq *ProviderFiatBalanceQuery) All(ctx context.Context) ([]*ProviderFiatBalance, error) {
+	ctx = setContextOp(ctx, pfbq.ctx, ent.OpQueryAll)
+	if err := pfbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderFiatBalance, *ProviderFiatBalanceQuery]()
+	return withInterceptors[[]*ProviderFiatBalance](ctx, pfbq, qr, pfbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) AllX(ctx context.Context) []*ProviderFiatBalance {
+	nodes, err := pfbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderFiatBalance IDs.
+func (pfbq *ProviderFiatBalanceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pfbq.ctx.Unique == nil && pfbq.path != nil {
+		pfbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pfbq.ctx, ent.OpQueryIDs)
+	if err = pfbq.Select(providerfiatbalance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pfbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pfbq *ProviderFiatBalanceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pfbq.ctx, ent.OpQueryCount)
+	if err := pfbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pfbq, querierCount[*ProviderFiatBalanceQuery](), pfbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) CountX(ctx context.Context) int {
+	count, err := pfbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pfbq *ProviderFiatBalanceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pfbq.ctx, ent.OpQueryExist)
+	switch _, err := pfbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pfbq *ProviderFiatBalanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := pfbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderFiatBalanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pfbq *ProviderFiatBalanceQuery) Clone() *ProviderFiatBalanceQuery {
+	if pfbq == nil {
+		return nil
+	}
+	return &ProviderFiatBalanceQuery{
+		config:       pfbq.config,
+		ctx:          pfbq.ctx.Clone(),
+		order:        append([]providerfiatbalance.OrderOption{}, pfbq.order...),
+		inters:       append([]Interceptor{}, pfbq.inters...),
+		predicates:   append([]predicate.ProviderFiatBalance{}, pfbq.predicates...),
+		withProvider: pfbq.withProvider.Clone(),
+		withCurrency: pfbq.withCurrency.Clone(),
+		// clone intermediate query.
+		sql:  pfbq.sql.Clone(),
+		path: pfbq.path,
+	}
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfbq *ProviderFiatBalanceQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *ProviderFiatBalanceQuery {
+	query := (&ProviderProfileClient{config: pfbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfbq.withProvider = query
+	return pfbq
+}
+
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfbq *ProviderFiatBalanceQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *ProviderFiatBalanceQuery {
+	query := (&FiatCurrencyClient{config: pfbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfbq.withCurrency = query
+	return pfbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderFiatBalance.Query().
+//		GroupBy(providerfiatbalance.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pfbq *ProviderFiatBalanceQuery) GroupBy(field string, fields ...string) *ProviderFiatBalanceGroupBy {
+	pfbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderFiatBalanceGroupBy{build: pfbq}
+	grbuild.flds = &pfbq.ctx.Fields
+	grbuild.label = providerfiatbalance.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProviderFiatBalance.Query().
+//		Select(providerfiatbalance.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pfbq *ProviderFiatBalanceQuery) Select(fields ...string) *ProviderFiatBalanceSelect {
+	pfbq.ctx.Fields = append(pfbq.ctx.Fields, fields...)
+	sbuild := &ProviderFiatBalanceSelect{ProviderFiatBalanceQuery: pfbq}
+	sbuild.label = providerfiatbalance.Label
+	sbuild.flds, sbuild.scan = &pfbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderFiatBalanceSelect configured with the given aggregations.
+func (pfbq *ProviderFiatBalanceQuery) Aggregate(fns ...AggregateFunc) *ProviderFiatBalanceSelect {
+	return pfbq.Select().Aggregate(fns...)
+}
+
+func (pfbq *ProviderFiatBalanceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pfbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pfbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pfbq.ctx.Fields {
+		if !providerfiatbalance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pfbq.path != nil {
+		prev, err := pfbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pfbq.sql = prev
+	}
+	return nil
+}
+
+func (pfbq *ProviderFiatBalanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderFiatBalance, error) {
+	var (
+		nodes       = []*ProviderFiatBalance{}
+		withFKs     = pfbq.withFKs
+		_spec       = pfbq.querySpec()
+		loadedTypes = [2]bool{
+			pfbq.withProvider != nil,
+			pfbq.withCurrency != nil,
+		}
+	)
+	if pfbq.withProvider != nil || pfbq.withCurrency != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerfiatbalance.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderFiatBalance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderFiatBalance{config: pfbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pfbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pfbq.withProvider; query != nil {
+		if err := pfbq.loadProvider(ctx, query, nodes, nil,
+			func(n *ProviderFiatBalance, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pfbq.withCurrency; query != nil {
+		if err := pfbq.loadCurrency(ctx, query, nodes, nil,
+			func(n *ProviderFiatBalance, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pfbq *ProviderFiatBalanceQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*ProviderFiatBalance, init func(*ProviderFiatBalance), assign func(*ProviderFiatBalance, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProviderFiatBalance)
+	for i := range nodes {
+		if nodes[i].provider_profile_fiat_balances == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_fiat_balances
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_fiat_balances" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pfbq *ProviderFiatBalanceQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*ProviderFiatBalance, init func(*ProviderFiatBalance), assign func(*ProviderFiatBalance, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProviderFiatBalance)
+	for i := range nodes {
+		if nodes[i].fiat_currency_provider_balances == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_provider_balances
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_provider_balances" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pfbq *ProviderFiatBalanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pfbq.querySpec()
+	_spec.Node.Columns = pfbq.ctx.Fields
+	if len(pfbq.ctx.Fields) > 0 {
+		_spec.Unique = pfbq.ctx.Unique != nil && *pfbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pfbq.driver, _spec)
+}
+
+func (pfbq *ProviderFiatBalanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerfiatbalance.Table, providerfiatbalance.Columns, sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID))
+	_spec.From = pfbq.sql
+	if unique := pfbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pfbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pfbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerfiatbalance.FieldID)
+		for i := range fields {
+			if fields[i] != providerfiatbalance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pfbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pfbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pfbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pfbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pfbq *ProviderFiatBalanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pfbq.driver.Dialect())
+	t1 := builder.Table(providerfiatbalance.Table)
+	columns := pfbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerfiatbalance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pfbq.sql != nil {
+		selector = pfbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pfbq.ctx.Unique != nil && *pfbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pfbq.predicates {
+		p(selector)
+	}
+	for _, p := range pfbq.order {
+		p(selector)
+	}
+	if offset := pfbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pfbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderFiatBalanceGroupBy is the group-by builder for ProviderFiatBalance entities.
+type ProviderFiatBalanceGroupBy struct {
+	selector
+	build *ProviderFiatBalanceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pfbgb *ProviderFiatBalanceGroupBy) Aggregate(fns ...AggregateFunc) *ProviderFiatBalanceGroupBy {
+	pfbgb.fns = append(pfbgb.fns, fns...)
+	return pfbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pfbgb *ProviderFiatBalanceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pfbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pfbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderFiatBalanceQuery, *ProviderFiatBalanceGroupBy](ctx, pfbgb.build, pfbgb, pfbgb.build.inters, v)
+}
+
+func (pfbgb *ProviderFiatBalanceGroupBy) sqlScan(ctx context.Context, root *ProviderFiatBalanceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pfbgb.fns))
+	for _, fn := range pfbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pfbgb.flds)+len(pfbgb.fns))
+		for _, f := range *pfbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pfbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pfbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderFiatBalanceSelect is the builder for selecting fields of ProviderFiatBalance entities.
+type ProviderFiatBalanceSelect struct {
+	*ProviderFiatBalanceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pfbs *ProviderFiatBalanceSelect) Aggregate(fns ...AggregateFunc) *ProviderFiatBalanceSelect {
+	pfbs.fns = append(pfbs.fns, fns...)
+	return pfbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pfbs *ProviderFiatBalanceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pfbs.ctx, ent.OpQuerySelect)
+	if err := pfbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderFiatBalanceQuery, *ProviderFiatBalanceSelect](ctx, pfbs.ProviderFiatBalanceQuery, pfbs, pfbs.inters, v)
+}
+
+func (pfbs *ProviderFiatBalanceSelect) sqlScan(ctx context.Context, root *ProviderFiatBalanceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pfbs.fns))
+	for _, fn := range pfbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pfbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pfbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderFiatBalanceUpdate is the builder for updating ProviderFiatBalance entities.
+type ProviderFiatBalanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderFiatBalanceMutation
+}
+
+// Where appends a list predicates to the ProviderFiatBalanceUpdate builder.
+func (pfbu *ProviderFiatBalanceUpdate) Where(ps ...predicate.ProviderFiatBalance) *ProviderFiatBalanceUpdate {
+	pfbu.mutation.Where(ps...)
+	return pfbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pfbu *ProviderFiatBalanceUpdate) SetUpdatedAt(t time.Time) *ProviderFiatBalanceUpdate {
+	pfbu.mutation.SetUpdatedAt(t)
+	return pfbu
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pfbu *ProviderFiatBalanceUpdate) SetAvailableBalance(d decimal.Decimal) *ProviderFiatBalanceUpdate {
+	pfbu.mutation.ResetAvailableBalance()
+	pfbu.mutation.SetAvailableBalance(d)
+	return pfbu
+}
+
+// SetNillableAvailableBalance sets the "available_balance" field if the given value is not nil.
+func (pfbu *ProviderFiatBalanceUpdate) SetNillableAvailableBalance(d *decimal.Decimal) *ProviderFiatBalanceUpdate {
+	if d != nil {
+		pfbu.SetAvailableBalance(*d)
+	}
+	return pfbu
+}
+
+// AddAvailableBalance adds d to the "available_balance" field.
+func (pfbu *ProviderFiatBalanceUpdate) AddAvailableBalance(d decimal.Decimal) *ProviderFiatBalanceUpdate {
+	pfbu.mutation.AddAvailableBalance(d)
+	return pfbu
+}
+
+// Mutation returns the ProviderFiatBalanceMutation object of the builder.
+func (pfbu *ProviderFiatBalanceUpdate) Mutation() *ProviderFiatBalanceMutation {
+	return pfbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pfbu *ProviderFiatBalanceUpdate) Save(ctx context.Context) (int, error) {
+	pfbu.defaults()
+	return withHooks(ctx, pfbu.sqlSave, pfbu.mutation, pfbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfbu *ProviderFiatBalanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := pfbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pfbu *ProviderFiatBalanceUpdate) Exec(ctx context.Context) error {
+	_, err := pfbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbu *ProviderFiatBalanceUpdate) ExecX(ctx context.Context) {
+	if err := pfbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pfbu *ProviderFiatBalanceUpdate) defaults() {
+	if _, ok := pfbu.mutation.UpdatedAt(); !ok {
+		v := providerfiatbalance.UpdateDefaultUpdatedAt()
+		pfbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfbu *ProviderFiatBalanceUpdate) check() error {
+	if pfbu.mutation.ProviderCleared() && len(pfbu.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderFiatBalance.provider"`)
+	}
+	if pfbu.mutation.CurrencyCleared() && len(pfbu.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderFiatBalance.currency"`)
+	}
+	return nil
+}
+
+func (pfbu *ProviderFiatBalanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pfbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerfiatbalance.Table, providerfiatbalance.Columns, sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID))
+	if ps := pfbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerfiatbalance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pfbu.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerfiatbalance.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pfbu.mutation.AddedAvailableBalance(); ok {
+		_spec.AddField(providerfiatbalance.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pfbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerfiatbalance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pfbu.mutation.done = true
+	return n, nil
+}
+
+// ProviderFiatBalanceUpdateOne is the builder for updating a single ProviderFiatBalance entity.
+type ProviderFiatBalanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderFiatBalanceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pfbuo *ProviderFiatBalanceUpdateOne) SetUpdatedAt(t time.Time) *ProviderFiatBalanceUpdateOne {
+	pfbuo.mutation.SetUpdatedAt(t)
+	return pfbuo
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pfbuo *ProviderFiatBalanceUpdateOne) SetAvailableBalance(d decimal.Decimal) *ProviderFiatBalanceUpdateOne {
+	pfbuo.mutation.ResetAvailableBalance()
+	pfbuo.mutation.SetAvailableBalance(d)
+	return pfbuo
+}
+
+// SetNillableAvailableBalance sets the "available_balance" field if the given value is not nil.
+func (pfbuo *ProviderFiatBalanceUpdateOne) SetNillableAvailableBalance(d *decimal.Decimal) *ProviderFiatBalanceUpdateOne {
+	if d != nil {
+		pfbuo.SetAvailableBalance(*d)
+	}
+	return pfbuo
+}
+
+// AddAvailableBalance adds d to the "available_balance" field.
+func (pfbuo *ProviderFiatBalanceUpdateOne) AddAvailableBalance(d decimal.Decimal) *ProviderFiatBalanceUpdateOne {
+	pfbuo.mutation.AddAvailableBalance(d)
+	return pfbuo
+}
+
+// Mutation returns the ProviderFiatBalanceMutation object of the builder.
+func (pfbuo *ProviderFiatBalanceUpdateOne) Mutation() *ProviderFiatBalanceMutation {
+	return pfbuo.mutation
+}
+
+// Where appends a list predicates to the ProviderFiatBalanceUpdate builder.
+func (pfbuo *ProviderFiatBalanceUpdateOne) Where(ps ...predicate.ProviderFiatBalance) *ProviderFiatBalanceUpdateOne {
+	pfbuo.mutation.Where(ps...)
+	return pfbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pfbuo *ProviderFiatBalanceUpdateOne) Select(field string, fields ...string) *ProviderFiatBalanceUpdateOne {
+	pfbuo.fields = append([]string{field}, fields...)
+	return pfbuo
+}
+
+// Save executes the query and returns the updated ProviderFiatBalance entity.
+func (pfbuo *ProviderFiatBalanceUpdateOne) Save(ctx context.Context) (*ProviderFiatBalance, error) {
+	pfbuo.defaults()
+	return withHooks(ctx, pfbuo.sqlSave, pfbuo.mutation, pfbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfbuo *ProviderFiatBalanceUpdateOne) SaveX(ctx context.Context) *ProviderFiatBalance {
+	node, err := pfbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pfbuo *ProviderFiatBalanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := pfbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfbuo *ProviderFiatBalanceUpdateOne) ExecX(ctx context.Context) {
+	if err := pfbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pfbuo *ProviderFiatBalanceUpdateOne) defaults() {
+	if _, ok := pfbuo.mutation.UpdatedAt(); !ok {
+		v := providerfiatbalance.UpdateDefaultUpdatedAt()
+		pfbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfbuo *ProviderFiatBalanceUpdateOne) check() error {
+	if pfbuo.mutation.ProviderCleared() && len(pfbuo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderFiatBalance.provider"`)
+	}
+	if pfbuo.mutation.CurrencyCleared() && len(pfbuo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderFiatBalance.currency"`)
+	}
+	return nil
+}
+
+func (pfbuo *ProviderFiatBalanceUpdateOne) sqlSave(ctx context.Context) (_node *ProviderFiatBalance, err error) {
+	if err := pfbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerfiatbalance.Table, providerfiatbalance.Columns, sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID))
+	id, ok := pfbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderFiatBalance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pfbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerfiatbalance.FieldID)
+		for _, f := range fields {
+			if !providerfiatbalance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerfiatbalance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pfbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerfiatbalance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pfbuo.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerfiatbalance.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pfbuo.mutation.AddedAvailableBalance(); ok {
+		_spec.AddField(providerfiatbalance.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	_node = &ProviderFiatBalance{config: pfbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pfbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerfiatbalance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pfbuo.mutation.done = true
+	return _node, nil
+}
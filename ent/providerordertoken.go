@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/shopspring/decimal"
 )
 
@@ -36,11 +37,22 @@ type ProviderOrderToken struct {
 	MaxOrderAmount decimal.Decimal `json:"max_order_amount,omitempty"`
 	// MinOrderAmount holds the value of the "min_order_amount" field.
 	MinOrderAmount decimal.Decimal `json:"min_order_amount,omitempty"`
+	// RateSlippage holds the value of the "rate_slippage" field.
+	RateSlippage decimal.Decimal `json:"rate_slippage,omitempty"`
+	// RateSlippageType holds the value of the "rate_slippage_type" field.
+	RateSlippageType providerordertoken.RateSlippageType `json:"rate_slippage_type,omitempty"`
 	// Addresses holds the value of the "addresses" field.
 	Addresses []struct {
 		Address string "json:\"address\""
 		Network string "json:\"network\""
 	} `json:"addresses,omitempty"`
+	// RateBands holds the value of the "rate_bands" field.
+	RateBands []struct {
+		MinAmount              decimal.Decimal "json:\"minAmount\""
+		MaxAmount              decimal.Decimal "json:\"maxAmount\""
+		FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+		FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+	} `json:"rate_bands,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProviderOrderTokenQuery when eager-loading is set.
 	Edges                         ProviderOrderTokenEdges `json:"edges"`
@@ -52,9 +64,11 @@ type ProviderOrderToken struct {
 type ProviderOrderTokenEdges struct {
 	// Provider holds the value of the provider edge.
 	Provider *ProviderProfile `json:"provider,omitempty"`
+	// RateExclusion holds the value of the rate_exclusion edge.
+	RateExclusion *ProviderRateExclusion `json:"rate_exclusion,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ProviderOrErr returns the Provider value or an error if the edge
@@ -68,18 +82,29 @@ func (e ProviderOrderTokenEdges) ProviderOrErr() (*ProviderProfile, error) {
 	return nil, &NotLoadedError{edge: "provider"}
 }
 
+// RateExclusionOrErr returns the RateExclusion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderOrderTokenEdges) RateExclusionOrErr() (*ProviderRateExclusion, error) {
+	if e.RateExclusion != nil {
+		return e.RateExclusion, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: providerrateexclusion.Label}
+	}
+	return nil, &NotLoadedError{edge: "rate_exclusion"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProviderOrderToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case providerordertoken.FieldAddresses:
+		case providerordertoken.FieldAddresses, providerordertoken.FieldRateBands:
 			values[i] = new([]byte)
-		case providerordertoken.FieldFixedConversionRate, providerordertoken.FieldFloatingConversionRate, providerordertoken.FieldMaxOrderAmount, providerordertoken.FieldMinOrderAmount:
+		case providerordertoken.FieldFixedConversionRate, providerordertoken.FieldFloatingConversionRate, providerordertoken.FieldMaxOrderAmount, providerordertoken.FieldMinOrderAmount, providerordertoken.FieldRateSlippage:
 			values[i] = new(decimal.Decimal)
 		case providerordertoken.FieldID:
 			values[i] = new(sql.NullInt64)
-		case providerordertoken.FieldSymbol, providerordertoken.FieldConversionRateType:
+		case providerordertoken.FieldSymbol, providerordertoken.FieldConversionRateType, providerordertoken.FieldRateSlippageType:
 			values[i] = new(sql.NullString)
 		case providerordertoken.FieldCreatedAt, providerordertoken.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -154,6 +179,18 @@ func (pot *ProviderOrderToken) assignValues(columns []string, values []any) erro
 			} else if value != nil {
 				pot.MinOrderAmount = *value
 			}
+		case providerordertoken.FieldRateSlippage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_slippage", values[i])
+			} else if value != nil {
+				pot.RateSlippage = *value
+			}
+		case providerordertoken.FieldRateSlippageType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_slippage_type", values[i])
+			} else if value.Valid {
+				pot.RateSlippageType = providerordertoken.RateSlippageType(value.String)
+			}
 		case providerordertoken.FieldAddresses:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field addresses", values[i])
@@ -162,6 +199,14 @@ func (pot *ProviderOrderToken) assignValues(columns []string, values []any) erro
 					return fmt.Errorf("unmarshal field addresses: %w", err)
 				}
 			}
+		case providerordertoken.FieldRateBands:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_bands", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pot.RateBands); err != nil {
+					return fmt.Errorf("unmarshal field rate_bands: %w", err)
+				}
+			}
 		case providerordertoken.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_order_tokens", values[i])
@@ -187,6 +232,11 @@ func (pot *ProviderOrderToken) QueryProvider() *ProviderProfileQuery {
 	return NewProviderOrderTokenClient(pot.config).QueryProvider(pot)
 }
 
+// QueryRateExclusion queries the "rate_exclusion" edge of the ProviderOrderToken entity.
+func (pot *ProviderOrderToken) QueryRateExclusion() *ProviderRateExclusionQuery {
+	return NewProviderOrderTokenClient(pot.config).QueryRateExclusion(pot)
+}
+
 // Update returns a builder for updating this ProviderOrderToken.
 // Note that you need to call ProviderOrderToken.Unwrap() before calling this method if this ProviderOrderToken
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -234,8 +284,17 @@ func (pot *ProviderOrderToken) String() string {
 	builder.WriteString("min_order_amount=")
 	builder.WriteString(fmt.Sprintf("%v", pot.MinOrderAmount))
 	builder.WriteString(", ")
+	builder.WriteString("rate_slippage=")
+	builder.WriteString(fmt.Sprintf("%v", pot.RateSlippage))
+	builder.WriteString(", ")
+	builder.WriteString("rate_slippage_type=")
+	builder.WriteString(fmt.Sprintf("%v", pot.RateSlippageType))
+	builder.WriteString(", ")
 	builder.WriteString("addresses=")
 	builder.WriteString(fmt.Sprintf("%v", pot.Addresses))
+	builder.WriteString(", ")
+	builder.WriteString("rate_bands=")
+	builder.WriteString(fmt.Sprintf("%v", pot.RateBands))
 	builder.WriteByte(')')
 	return builder.String()
 }
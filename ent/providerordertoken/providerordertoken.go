@@ -31,10 +31,18 @@ const (
 	FieldMaxOrderAmount = "max_order_amount"
 	// FieldMinOrderAmount holds the string denoting the min_order_amount field in the database.
 	FieldMinOrderAmount = "min_order_amount"
+	// FieldRateSlippage holds the string denoting the rate_slippage field in the database.
+	FieldRateSlippage = "rate_slippage"
+	// FieldRateSlippageType holds the string denoting the rate_slippage_type field in the database.
+	FieldRateSlippageType = "rate_slippage_type"
 	// FieldAddresses holds the string denoting the addresses field in the database.
 	FieldAddresses = "addresses"
+	// FieldRateBands holds the string denoting the rate_bands field in the database.
+	FieldRateBands = "rate_bands"
 	// EdgeProvider holds the string denoting the provider edge name in mutations.
 	EdgeProvider = "provider"
+	// EdgeRateExclusion holds the string denoting the rate_exclusion edge name in mutations.
+	EdgeRateExclusion = "rate_exclusion"
 	// Table holds the table name of the providerordertoken in the database.
 	Table = "provider_order_tokens"
 	// ProviderTable is the table that holds the provider relation/edge.
@@ -44,6 +52,13 @@ const (
 	ProviderInverseTable = "provider_profiles"
 	// ProviderColumn is the table column denoting the provider relation/edge.
 	ProviderColumn = "provider_profile_order_tokens"
+	// RateExclusionTable is the table that holds the rate_exclusion relation/edge.
+	RateExclusionTable = "provider_rate_exclusions"
+	// RateExclusionInverseTable is the table name for the ProviderRateExclusion entity.
+	// It exists in this package in order to avoid circular dependency with the "providerrateexclusion" package.
+	RateExclusionInverseTable = "provider_rate_exclusions"
+	// RateExclusionColumn is the table column denoting the rate_exclusion relation/edge.
+	RateExclusionColumn = "provider_order_token_rate_exclusion"
 )
 
 // Columns holds all SQL columns for providerordertoken fields.
@@ -57,7 +72,10 @@ var Columns = []string{
 	FieldConversionRateType,
 	FieldMaxOrderAmount,
 	FieldMinOrderAmount,
+	FieldRateSlippage,
+	FieldRateSlippageType,
 	FieldAddresses,
+	FieldRateBands,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provider_order_tokens"
@@ -113,6 +131,32 @@ func ConversionRateTypeValidator(crt ConversionRateType) error {
 	}
 }
 
+// RateSlippageType defines the type for the "rate_slippage_type" enum field.
+type RateSlippageType string
+
+// RateSlippageTypeAbsolute is the default value of the RateSlippageType enum.
+const DefaultRateSlippageType = RateSlippageTypeAbsolute
+
+// RateSlippageType values.
+const (
+	RateSlippageTypePercentage RateSlippageType = "percentage"
+	RateSlippageTypeAbsolute   RateSlippageType = "absolute"
+)
+
+func (rst RateSlippageType) String() string {
+	return string(rst)
+}
+
+// RateSlippageTypeValidator is a validator for the "rate_slippage_type" field enum values. It is called by the builders before save.
+func RateSlippageTypeValidator(rst RateSlippageType) error {
+	switch rst {
+	case RateSlippageTypePercentage, RateSlippageTypeAbsolute:
+		return nil
+	default:
+		return fmt.Errorf("providerordertoken: invalid enum value for rate_slippage_type field: %q", rst)
+	}
+}
+
 // OrderOption defines the ordering options for the ProviderOrderToken queries.
 type OrderOption func(*sql.Selector)
 
@@ -161,12 +205,29 @@ func ByMinOrderAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMinOrderAmount, opts...).ToFunc()
 }
 
+// ByRateSlippage orders the results by the rate_slippage field.
+func ByRateSlippage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateSlippage, opts...).ToFunc()
+}
+
+// ByRateSlippageType orders the results by the rate_slippage_type field.
+func ByRateSlippageType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateSlippageType, opts...).ToFunc()
+}
+
 // ByProviderField orders the results by provider field.
 func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRateExclusionField orders the results by rate_exclusion field.
+func ByRateExclusionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRateExclusionStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProviderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +235,10 @@ func newProviderStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
 	)
 }
+func newRateExclusionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RateExclusionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RateExclusionTable, RateExclusionColumn),
+	)
+}
@@ -91,6 +91,11 @@ func MinOrderAmount(v decimal.Decimal) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldMinOrderAmount, v))
 }
 
+// RateSlippage applies equality check predicate on the "rate_slippage" field. It's identical to RateSlippageEQ.
+func RateSlippage(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateSlippage, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -416,6 +421,76 @@ func MinOrderAmountLTE(v decimal.Decimal) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldLTE(FieldMinOrderAmount, v))
 }
 
+// RateSlippageEQ applies the EQ predicate on the "rate_slippage" field.
+func RateSlippageEQ(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateSlippage, v))
+}
+
+// RateSlippageNEQ applies the NEQ predicate on the "rate_slippage" field.
+func RateSlippageNEQ(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNEQ(FieldRateSlippage, v))
+}
+
+// RateSlippageIn applies the In predicate on the "rate_slippage" field.
+func RateSlippageIn(vs ...decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIn(FieldRateSlippage, vs...))
+}
+
+// RateSlippageNotIn applies the NotIn predicate on the "rate_slippage" field.
+func RateSlippageNotIn(vs ...decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotIn(FieldRateSlippage, vs...))
+}
+
+// RateSlippageGT applies the GT predicate on the "rate_slippage" field.
+func RateSlippageGT(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGT(FieldRateSlippage, v))
+}
+
+// RateSlippageGTE applies the GTE predicate on the "rate_slippage" field.
+func RateSlippageGTE(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGTE(FieldRateSlippage, v))
+}
+
+// RateSlippageLT applies the LT predicate on the "rate_slippage" field.
+func RateSlippageLT(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLT(FieldRateSlippage, v))
+}
+
+// RateSlippageLTE applies the LTE predicate on the "rate_slippage" field.
+func RateSlippageLTE(v decimal.Decimal) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLTE(FieldRateSlippage, v))
+}
+
+// RateSlippageTypeEQ applies the EQ predicate on the "rate_slippage_type" field.
+func RateSlippageTypeEQ(v RateSlippageType) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateSlippageType, v))
+}
+
+// RateSlippageTypeNEQ applies the NEQ predicate on the "rate_slippage_type" field.
+func RateSlippageTypeNEQ(v RateSlippageType) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNEQ(FieldRateSlippageType, v))
+}
+
+// RateSlippageTypeIn applies the In predicate on the "rate_slippage_type" field.
+func RateSlippageTypeIn(vs ...RateSlippageType) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIn(FieldRateSlippageType, vs...))
+}
+
+// RateSlippageTypeNotIn applies the NotIn predicate on the "rate_slippage_type" field.
+func RateSlippageTypeNotIn(vs ...RateSlippageType) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotIn(FieldRateSlippageType, vs...))
+}
+
+// RateBandsIsNil applies the IsNil predicate on the "rate_bands" field.
+func RateBandsIsNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIsNull(FieldRateBands))
+}
+
+// RateBandsNotNil applies the NotNil predicate on the "rate_bands" field.
+func RateBandsNotNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotNull(FieldRateBands))
+}
+
 // HasProvider applies the HasEdge predicate on the "provider" edge.
 func HasProvider() predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(func(s *sql.Selector) {
@@ -439,6 +514,29 @@ func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderOrder
 	})
 }
 
+// HasRateExclusion applies the HasEdge predicate on the "rate_exclusion" edge.
+func HasRateExclusion() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RateExclusionTable, RateExclusionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRateExclusionWith applies the HasEdge predicate on the "rate_exclusion" edge with a given conditions (other predicates).
+func HasRateExclusionWith(preds ...predicate.ProviderRateExclusion) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(func(s *sql.Selector) {
+		step := newRateExclusionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProviderOrderToken) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.AndPredicates(predicates...))
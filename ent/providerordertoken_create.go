@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/shopspring/decimal"
 )
 
@@ -88,6 +90,26 @@ func (potc *ProviderOrderTokenCreate) SetMinOrderAmount(d decimal.Decimal) *Prov
 	return potc
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (potc *ProviderOrderTokenCreate) SetRateSlippage(d decimal.Decimal) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateSlippage(d)
+	return potc
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (potc *ProviderOrderTokenCreate) SetRateSlippageType(pst providerordertoken.RateSlippageType) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateSlippageType(pst)
+	return potc
+}
+
+// SetNillableRateSlippageType sets the "rate_slippage_type" field if the given value is not nil.
+func (potc *ProviderOrderTokenCreate) SetNillableRateSlippageType(pst *providerordertoken.RateSlippageType) *ProviderOrderTokenCreate {
+	if pst != nil {
+		potc.SetRateSlippageType(*pst)
+	}
+	return potc
+}
+
 // SetAddresses sets the "addresses" field.
 func (potc *ProviderOrderTokenCreate) SetAddresses(s []struct {
 	Address string "json:\"address\""
@@ -97,6 +119,17 @@ func (potc *ProviderOrderTokenCreate) SetAddresses(s []struct {
 	return potc
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (potc *ProviderOrderTokenCreate) SetRateBands(saaaacrcrcrcr []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateBands(saaaacrcrcrcr)
+	return potc
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potc *ProviderOrderTokenCreate) SetProviderID(id string) *ProviderOrderTokenCreate {
 	potc.mutation.SetProviderID(id)
@@ -116,6 +149,25 @@ func (potc *ProviderOrderTokenCreate) SetProvider(p *ProviderProfile) *ProviderO
 	return potc.SetProviderID(p.ID)
 }
 
+// SetRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID.
+func (potc *ProviderOrderTokenCreate) SetRateExclusionID(id uuid.UUID) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateExclusionID(id)
+	return potc
+}
+
+// SetNillableRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID if the given value is not nil.
+func (potc *ProviderOrderTokenCreate) SetNillableRateExclusionID(id *uuid.UUID) *ProviderOrderTokenCreate {
+	if id != nil {
+		potc = potc.SetRateExclusionID(*id)
+	}
+	return potc
+}
+
+// SetRateExclusion sets the "rate_exclusion" edge to the ProviderRateExclusion entity.
+func (potc *ProviderOrderTokenCreate) SetRateExclusion(p *ProviderRateExclusion) *ProviderOrderTokenCreate {
+	return potc.SetRateExclusionID(p.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potc *ProviderOrderTokenCreate) Mutation() *ProviderOrderTokenMutation {
 	return potc.mutation
@@ -159,6 +211,10 @@ func (potc *ProviderOrderTokenCreate) defaults() {
 		v := providerordertoken.DefaultUpdatedAt()
 		potc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := potc.mutation.RateSlippageType(); !ok {
+		v := providerordertoken.DefaultRateSlippageType
+		potc.mutation.SetRateSlippageType(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -192,6 +248,17 @@ func (potc *ProviderOrderTokenCreate) check() error {
 	if _, ok := potc.mutation.MinOrderAmount(); !ok {
 		return &ValidationError{Name: "min_order_amount", err: errors.New(`ent: missing required field "ProviderOrderToken.min_order_amount"`)}
 	}
+	if _, ok := potc.mutation.RateSlippage(); !ok {
+		return &ValidationError{Name: "rate_slippage", err: errors.New(`ent: missing required field "ProviderOrderToken.rate_slippage"`)}
+	}
+	if _, ok := potc.mutation.RateSlippageType(); !ok {
+		return &ValidationError{Name: "rate_slippage_type", err: errors.New(`ent: missing required field "ProviderOrderToken.rate_slippage_type"`)}
+	}
+	if v, ok := potc.mutation.RateSlippageType(); ok {
+		if err := providerordertoken.RateSlippageTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rate_slippage_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.rate_slippage_type": %w`, err)}
+		}
+	}
 	if _, ok := potc.mutation.Addresses(); !ok {
 		return &ValidationError{Name: "addresses", err: errors.New(`ent: missing required field "ProviderOrderToken.addresses"`)}
 	}
@@ -254,10 +321,22 @@ func (potc *ProviderOrderTokenCreate) createSpec() (*ProviderOrderToken, *sqlgra
 		_spec.SetField(providerordertoken.FieldMinOrderAmount, field.TypeFloat64, value)
 		_node.MinOrderAmount = value
 	}
+	if value, ok := potc.mutation.RateSlippage(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippage, field.TypeFloat64, value)
+		_node.RateSlippage = value
+	}
+	if value, ok := potc.mutation.RateSlippageType(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippageType, field.TypeEnum, value)
+		_node.RateSlippageType = value
+	}
 	if value, ok := potc.mutation.Addresses(); ok {
 		_spec.SetField(providerordertoken.FieldAddresses, field.TypeJSON, value)
 		_node.Addresses = value
 	}
+	if value, ok := potc.mutation.RateBands(); ok {
+		_spec.SetField(providerordertoken.FieldRateBands, field.TypeJSON, value)
+		_node.RateBands = value
+	}
 	if nodes := potc.mutation.ProviderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -275,6 +354,22 @@ func (potc *ProviderOrderTokenCreate) createSpec() (*ProviderOrderToken, *sqlgra
 		_node.provider_profile_order_tokens = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := potc.mutation.RateExclusionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerordertoken.RateExclusionTable,
+			Columns: []string{providerordertoken.RateExclusionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -435,6 +530,36 @@ func (u *ProviderOrderTokenUpsert) AddMinOrderAmount(v decimal.Decimal) *Provide
 	return u
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsert) SetRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldRateSlippage, v)
+	return u
+}
+
+// UpdateRateSlippage sets the "rate_slippage" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateRateSlippage() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldRateSlippage)
+	return u
+}
+
+// AddRateSlippage adds v to the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsert) AddRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsert {
+	u.Add(providerordertoken.FieldRateSlippage, v)
+	return u
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (u *ProviderOrderTokenUpsert) SetRateSlippageType(v providerordertoken.RateSlippageType) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldRateSlippageType, v)
+	return u
+}
+
+// UpdateRateSlippageType sets the "rate_slippage_type" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateRateSlippageType() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldRateSlippageType)
+	return u
+}
+
 // SetAddresses sets the "addresses" field.
 func (u *ProviderOrderTokenUpsert) SetAddresses(v []struct {
 	Address string "json:\"address\""
@@ -450,6 +575,29 @@ func (u *ProviderOrderTokenUpsert) UpdateAddresses() *ProviderOrderTokenUpsert {
 	return u
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (u *ProviderOrderTokenUpsert) SetRateBands(v []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldRateBands, v)
+	return u
+}
+
+// UpdateRateBands sets the "rate_bands" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateRateBands() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldRateBands)
+	return u
+}
+
+// ClearRateBands clears the value of the "rate_bands" field.
+func (u *ProviderOrderTokenUpsert) ClearRateBands() *ProviderOrderTokenUpsert {
+	u.SetNull(providerordertoken.FieldRateBands)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -621,6 +769,41 @@ func (u *ProviderOrderTokenUpsertOne) UpdateMinOrderAmount() *ProviderOrderToken
 	})
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsertOne) SetRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateSlippage(v)
+	})
+}
+
+// AddRateSlippage adds v to the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsertOne) AddRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.AddRateSlippage(v)
+	})
+}
+
+// UpdateRateSlippage sets the "rate_slippage" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateRateSlippage() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateSlippage()
+	})
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (u *ProviderOrderTokenUpsertOne) SetRateSlippageType(v providerordertoken.RateSlippageType) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateSlippageType(v)
+	})
+}
+
+// UpdateRateSlippageType sets the "rate_slippage_type" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateRateSlippageType() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateSlippageType()
+	})
+}
+
 // SetAddresses sets the "addresses" field.
 func (u *ProviderOrderTokenUpsertOne) SetAddresses(v []struct {
 	Address string "json:\"address\""
@@ -638,6 +821,32 @@ func (u *ProviderOrderTokenUpsertOne) UpdateAddresses() *ProviderOrderTokenUpser
 	})
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (u *ProviderOrderTokenUpsertOne) SetRateBands(v []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateBands(v)
+	})
+}
+
+// UpdateRateBands sets the "rate_bands" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateRateBands() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateBands()
+	})
+}
+
+// ClearRateBands clears the value of the "rate_bands" field.
+func (u *ProviderOrderTokenUpsertOne) ClearRateBands() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateBands()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -975,6 +1184,41 @@ func (u *ProviderOrderTokenUpsertBulk) UpdateMinOrderAmount() *ProviderOrderToke
 	})
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsertBulk) SetRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateSlippage(v)
+	})
+}
+
+// AddRateSlippage adds v to the "rate_slippage" field.
+func (u *ProviderOrderTokenUpsertBulk) AddRateSlippage(v decimal.Decimal) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.AddRateSlippage(v)
+	})
+}
+
+// UpdateRateSlippage sets the "rate_slippage" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateRateSlippage() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateSlippage()
+	})
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (u *ProviderOrderTokenUpsertBulk) SetRateSlippageType(v providerordertoken.RateSlippageType) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateSlippageType(v)
+	})
+}
+
+// UpdateRateSlippageType sets the "rate_slippage_type" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateRateSlippageType() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateSlippageType()
+	})
+}
+
 // SetAddresses sets the "addresses" field.
 func (u *ProviderOrderTokenUpsertBulk) SetAddresses(v []struct {
 	Address string "json:\"address\""
@@ -992,6 +1236,32 @@ func (u *ProviderOrderTokenUpsertBulk) UpdateAddresses() *ProviderOrderTokenUpse
 	})
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (u *ProviderOrderTokenUpsertBulk) SetRateBands(v []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateBands(v)
+	})
+}
+
+// UpdateRateBands sets the "rate_bands" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateRateBands() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateBands()
+	})
+}
+
+// ClearRateBands clears the value of the "rate_bands" field.
+func (u *ProviderOrderTokenUpsertBulk) ClearRateBands() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateBands()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,17 +15,19 @@ import (
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 )
 
 // ProviderOrderTokenQuery is the builder for querying ProviderOrderToken entities.
 type ProviderOrderTokenQuery struct {
 	config
-	ctx          *QueryContext
-	order        []providerordertoken.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.ProviderOrderToken
-	withProvider *ProviderProfileQuery
-	withFKs      bool
+	ctx               *QueryContext
+	order             []providerordertoken.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ProviderOrderToken
+	withProvider      *ProviderProfileQuery
+	withRateExclusion *ProviderRateExclusionQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +86,28 @@ func (potq *ProviderOrderTokenQuery) QueryProvider() *ProviderProfileQuery {
 	return query
 }
 
+// QueryRateExclusion chains the current query on the "rate_exclusion" edge.
+func (potq *ProviderOrderTokenQuery) QueryRateExclusion() *ProviderRateExclusionQuery {
+	query := (&ProviderRateExclusionClient{config: potq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := potq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := potq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, selector),
+			sqlgraph.To(providerrateexclusion.Table, providerrateexclusion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerordertoken.RateExclusionTable, providerordertoken.RateExclusionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(potq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProviderOrderToken entity from the query.
 // Returns a *NotFoundError when no ProviderOrderToken was found.
 func (potq *ProviderOrderTokenQuery) First(ctx context.Context) (*ProviderOrderToken, error) {
@@ -270,12 +295,13 @@ func (potq *ProviderOrderTokenQuery) Clone() *ProviderOrderTokenQuery {
 		return nil
 	}
 	return &ProviderOrderTokenQuery{
-		config:       potq.config,
-		ctx:          potq.ctx.Clone(),
-		order:        append([]providerordertoken.OrderOption{}, potq.order...),
-		inters:       append([]Interceptor{}, potq.inters...),
-		predicates:   append([]predicate.ProviderOrderToken{}, potq.predicates...),
-		withProvider: potq.withProvider.Clone(),
+		config:            potq.config,
+		ctx:               potq.ctx.Clone(),
+		order:             append([]providerordertoken.OrderOption{}, potq.order...),
+		inters:            append([]Interceptor{}, potq.inters...),
+		predicates:        append([]predicate.ProviderOrderToken{}, potq.predicates...),
+		withProvider:      potq.withProvider.Clone(),
+		withRateExclusion: potq.withRateExclusion.Clone(),
 		// clone intermediate query.
 		sql:  potq.sql.Clone(),
 		path: potq.path,
@@ -293,6 +319,17 @@ func (potq *ProviderOrderTokenQuery) WithProvider(opts ...func(*ProviderProfileQ
 	return potq
 }
 
+// WithRateExclusion tells the query-builder to eager-load the nodes that are connected to
+// the "rate_exclusion" edge. The optional arguments are used to configure the query builder of the edge.
+func (potq *ProviderOrderTokenQuery) WithRateExclusion(opts ...func(*ProviderRateExclusionQuery)) *ProviderOrderTokenQuery {
+	query := (&ProviderRateExclusionClient{config: potq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	potq.withRateExclusion = query
+	return potq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +409,9 @@ func (potq *ProviderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryH
 		nodes       = []*ProviderOrderToken{}
 		withFKs     = potq.withFKs
 		_spec       = potq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			potq.withProvider != nil,
+			potq.withRateExclusion != nil,
 		}
 	)
 	if potq.withProvider != nil {
@@ -406,6 +444,12 @@ func (potq *ProviderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryH
 			return nil, err
 		}
 	}
+	if query := potq.withRateExclusion; query != nil {
+		if err := potq.loadRateExclusion(ctx, query, nodes, nil,
+			func(n *ProviderOrderToken, e *ProviderRateExclusion) { n.Edges.RateExclusion = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,6 +485,34 @@ func (potq *ProviderOrderTokenQuery) loadProvider(ctx context.Context, query *Pr
 	}
 	return nil
 }
+func (potq *ProviderOrderTokenQuery) loadRateExclusion(ctx context.Context, query *ProviderRateExclusionQuery, nodes []*ProviderOrderToken, init func(*ProviderOrderToken), assign func(*ProviderOrderToken, *ProviderRateExclusion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ProviderOrderToken)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderRateExclusion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerordertoken.RateExclusionColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_order_token_rate_exclusion
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_order_token_rate_exclusion" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_order_token_rate_exclusion" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (potq *ProviderOrderTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := potq.querySpec()
@@ -12,9 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/shopspring/decimal"
 )
 
@@ -149,6 +151,41 @@ func (potu *ProviderOrderTokenUpdate) AddMinOrderAmount(d decimal.Decimal) *Prov
 	return potu
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (potu *ProviderOrderTokenUpdate) SetRateSlippage(d decimal.Decimal) *ProviderOrderTokenUpdate {
+	potu.mutation.ResetRateSlippage()
+	potu.mutation.SetRateSlippage(d)
+	return potu
+}
+
+// SetNillableRateSlippage sets the "rate_slippage" field if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableRateSlippage(d *decimal.Decimal) *ProviderOrderTokenUpdate {
+	if d != nil {
+		potu.SetRateSlippage(*d)
+	}
+	return potu
+}
+
+// AddRateSlippage adds d to the "rate_slippage" field.
+func (potu *ProviderOrderTokenUpdate) AddRateSlippage(d decimal.Decimal) *ProviderOrderTokenUpdate {
+	potu.mutation.AddRateSlippage(d)
+	return potu
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (potu *ProviderOrderTokenUpdate) SetRateSlippageType(pst providerordertoken.RateSlippageType) *ProviderOrderTokenUpdate {
+	potu.mutation.SetRateSlippageType(pst)
+	return potu
+}
+
+// SetNillableRateSlippageType sets the "rate_slippage_type" field if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableRateSlippageType(pst *providerordertoken.RateSlippageType) *ProviderOrderTokenUpdate {
+	if pst != nil {
+		potu.SetRateSlippageType(*pst)
+	}
+	return potu
+}
+
 // SetAddresses sets the "addresses" field.
 func (potu *ProviderOrderTokenUpdate) SetAddresses(s []struct {
 	Address string "json:\"address\""
@@ -167,6 +204,34 @@ func (potu *ProviderOrderTokenUpdate) AppendAddresses(s []struct {
 	return potu
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (potu *ProviderOrderTokenUpdate) SetRateBands(saaaacrcrcrcr []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpdate {
+	potu.mutation.SetRateBands(saaaacrcrcrcr)
+	return potu
+}
+
+// AppendRateBands appends saaaacrcrcrcr to the "rate_bands" field.
+func (potu *ProviderOrderTokenUpdate) AppendRateBands(saaaacrcrcrcr []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpdate {
+	potu.mutation.AppendRateBands(saaaacrcrcrcr)
+	return potu
+}
+
+// ClearRateBands clears the value of the "rate_bands" field.
+func (potu *ProviderOrderTokenUpdate) ClearRateBands() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearRateBands()
+	return potu
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potu *ProviderOrderTokenUpdate) SetProviderID(id string) *ProviderOrderTokenUpdate {
 	potu.mutation.SetProviderID(id)
@@ -186,6 +251,25 @@ func (potu *ProviderOrderTokenUpdate) SetProvider(p *ProviderProfile) *ProviderO
 	return potu.SetProviderID(p.ID)
 }
 
+// SetRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID.
+func (potu *ProviderOrderTokenUpdate) SetRateExclusionID(id uuid.UUID) *ProviderOrderTokenUpdate {
+	potu.mutation.SetRateExclusionID(id)
+	return potu
+}
+
+// SetNillableRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableRateExclusionID(id *uuid.UUID) *ProviderOrderTokenUpdate {
+	if id != nil {
+		potu = potu.SetRateExclusionID(*id)
+	}
+	return potu
+}
+
+// SetRateExclusion sets the "rate_exclusion" edge to the ProviderRateExclusion entity.
+func (potu *ProviderOrderTokenUpdate) SetRateExclusion(p *ProviderRateExclusion) *ProviderOrderTokenUpdate {
+	return potu.SetRateExclusionID(p.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potu *ProviderOrderTokenUpdate) Mutation() *ProviderOrderTokenMutation {
 	return potu.mutation
@@ -197,6 +281,12 @@ func (potu *ProviderOrderTokenUpdate) ClearProvider() *ProviderOrderTokenUpdate
 	return potu
 }
 
+// ClearRateExclusion clears the "rate_exclusion" edge to the ProviderRateExclusion entity.
+func (potu *ProviderOrderTokenUpdate) ClearRateExclusion() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearRateExclusion()
+	return potu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (potu *ProviderOrderTokenUpdate) Save(ctx context.Context) (int, error) {
 	potu.defaults()
@@ -240,6 +330,11 @@ func (potu *ProviderOrderTokenUpdate) check() error {
 			return &ValidationError{Name: "conversion_rate_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.conversion_rate_type": %w`, err)}
 		}
 	}
+	if v, ok := potu.mutation.RateSlippageType(); ok {
+		if err := providerordertoken.RateSlippageTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rate_slippage_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.rate_slippage_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -288,6 +383,15 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 	if value, ok := potu.mutation.AddedMinOrderAmount(); ok {
 		_spec.AddField(providerordertoken.FieldMinOrderAmount, field.TypeFloat64, value)
 	}
+	if value, ok := potu.mutation.RateSlippage(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippage, field.TypeFloat64, value)
+	}
+	if value, ok := potu.mutation.AddedRateSlippage(); ok {
+		_spec.AddField(providerordertoken.FieldRateSlippage, field.TypeFloat64, value)
+	}
+	if value, ok := potu.mutation.RateSlippageType(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippageType, field.TypeEnum, value)
+	}
 	if value, ok := potu.mutation.Addresses(); ok {
 		_spec.SetField(providerordertoken.FieldAddresses, field.TypeJSON, value)
 	}
@@ -296,6 +400,17 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 			sqljson.Append(u, providerordertoken.FieldAddresses, value)
 		})
 	}
+	if value, ok := potu.mutation.RateBands(); ok {
+		_spec.SetField(providerordertoken.FieldRateBands, field.TypeJSON, value)
+	}
+	if value, ok := potu.mutation.AppendedRateBands(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerordertoken.FieldRateBands, value)
+		})
+	}
+	if potu.mutation.RateBandsCleared() {
+		_spec.ClearField(providerordertoken.FieldRateBands, field.TypeJSON)
+	}
 	if potu.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -325,6 +440,35 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if potu.mutation.RateExclusionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerordertoken.RateExclusionTable,
+			Columns: []string{providerordertoken.RateExclusionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := potu.mutation.RateExclusionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerordertoken.RateExclusionTable,
+			Columns: []string{providerordertoken.RateExclusionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, potu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{providerordertoken.Label}
@@ -463,6 +607,41 @@ func (potuo *ProviderOrderTokenUpdateOne) AddMinOrderAmount(d decimal.Decimal) *
 	return potuo
 }
 
+// SetRateSlippage sets the "rate_slippage" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateSlippage(d decimal.Decimal) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ResetRateSlippage()
+	potuo.mutation.SetRateSlippage(d)
+	return potuo
+}
+
+// SetNillableRateSlippage sets the "rate_slippage" field if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableRateSlippage(d *decimal.Decimal) *ProviderOrderTokenUpdateOne {
+	if d != nil {
+		potuo.SetRateSlippage(*d)
+	}
+	return potuo
+}
+
+// AddRateSlippage adds d to the "rate_slippage" field.
+func (potuo *ProviderOrderTokenUpdateOne) AddRateSlippage(d decimal.Decimal) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.AddRateSlippage(d)
+	return potuo
+}
+
+// SetRateSlippageType sets the "rate_slippage_type" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateSlippageType(pst providerordertoken.RateSlippageType) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetRateSlippageType(pst)
+	return potuo
+}
+
+// SetNillableRateSlippageType sets the "rate_slippage_type" field if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableRateSlippageType(pst *providerordertoken.RateSlippageType) *ProviderOrderTokenUpdateOne {
+	if pst != nil {
+		potuo.SetRateSlippageType(*pst)
+	}
+	return potuo
+}
+
 // SetAddresses sets the "addresses" field.
 func (potuo *ProviderOrderTokenUpdateOne) SetAddresses(s []struct {
 	Address string "json:\"address\""
@@ -481,6 +660,34 @@ func (potuo *ProviderOrderTokenUpdateOne) AppendAddresses(s []struct {
 	return potuo
 }
 
+// SetRateBands sets the "rate_bands" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateBands(saaaacrcrcrcr []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetRateBands(saaaacrcrcrcr)
+	return potuo
+}
+
+// AppendRateBands appends saaaacrcrcrcr to the "rate_bands" field.
+func (potuo *ProviderOrderTokenUpdateOne) AppendRateBands(saaaacrcrcrcr []struct {
+	MinAmount              decimal.Decimal "json:\"minAmount\""
+	MaxAmount              decimal.Decimal "json:\"maxAmount\""
+	FixedConversionRate    decimal.Decimal "json:\"fixedConversionRate\""
+	FloatingConversionRate decimal.Decimal "json:\"floatingConversionRate\""
+}) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.AppendRateBands(saaaacrcrcrcr)
+	return potuo
+}
+
+// ClearRateBands clears the value of the "rate_bands" field.
+func (potuo *ProviderOrderTokenUpdateOne) ClearRateBands() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearRateBands()
+	return potuo
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potuo *ProviderOrderTokenUpdateOne) SetProviderID(id string) *ProviderOrderTokenUpdateOne {
 	potuo.mutation.SetProviderID(id)
@@ -500,6 +707,25 @@ func (potuo *ProviderOrderTokenUpdateOne) SetProvider(p *ProviderProfile) *Provi
 	return potuo.SetProviderID(p.ID)
 }
 
+// SetRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateExclusionID(id uuid.UUID) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetRateExclusionID(id)
+	return potuo
+}
+
+// SetNillableRateExclusionID sets the "rate_exclusion" edge to the ProviderRateExclusion entity by ID if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableRateExclusionID(id *uuid.UUID) *ProviderOrderTokenUpdateOne {
+	if id != nil {
+		potuo = potuo.SetRateExclusionID(*id)
+	}
+	return potuo
+}
+
+// SetRateExclusion sets the "rate_exclusion" edge to the ProviderRateExclusion entity.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateExclusion(p *ProviderRateExclusion) *ProviderOrderTokenUpdateOne {
+	return potuo.SetRateExclusionID(p.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potuo *ProviderOrderTokenUpdateOne) Mutation() *ProviderOrderTokenMutation {
 	return potuo.mutation
@@ -511,6 +737,12 @@ func (potuo *ProviderOrderTokenUpdateOne) ClearProvider() *ProviderOrderTokenUpd
 	return potuo
 }
 
+// ClearRateExclusion clears the "rate_exclusion" edge to the ProviderRateExclusion entity.
+func (potuo *ProviderOrderTokenUpdateOne) ClearRateExclusion() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearRateExclusion()
+	return potuo
+}
+
 // Where appends a list predicates to the ProviderOrderTokenUpdate builder.
 func (potuo *ProviderOrderTokenUpdateOne) Where(ps ...predicate.ProviderOrderToken) *ProviderOrderTokenUpdateOne {
 	potuo.mutation.Where(ps...)
@@ -567,6 +799,11 @@ func (potuo *ProviderOrderTokenUpdateOne) check() error {
 			return &ValidationError{Name: "conversion_rate_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.conversion_rate_type": %w`, err)}
 		}
 	}
+	if v, ok := potuo.mutation.RateSlippageType(); ok {
+		if err := providerordertoken.RateSlippageTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rate_slippage_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.rate_slippage_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -632,6 +869,15 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 	if value, ok := potuo.mutation.AddedMinOrderAmount(); ok {
 		_spec.AddField(providerordertoken.FieldMinOrderAmount, field.TypeFloat64, value)
 	}
+	if value, ok := potuo.mutation.RateSlippage(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippage, field.TypeFloat64, value)
+	}
+	if value, ok := potuo.mutation.AddedRateSlippage(); ok {
+		_spec.AddField(providerordertoken.FieldRateSlippage, field.TypeFloat64, value)
+	}
+	if value, ok := potuo.mutation.RateSlippageType(); ok {
+		_spec.SetField(providerordertoken.FieldRateSlippageType, field.TypeEnum, value)
+	}
 	if value, ok := potuo.mutation.Addresses(); ok {
 		_spec.SetField(providerordertoken.FieldAddresses, field.TypeJSON, value)
 	}
@@ -640,6 +886,17 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 			sqljson.Append(u, providerordertoken.FieldAddresses, value)
 		})
 	}
+	if value, ok := potuo.mutation.RateBands(); ok {
+		_spec.SetField(providerordertoken.FieldRateBands, field.TypeJSON, value)
+	}
+	if value, ok := potuo.mutation.AppendedRateBands(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerordertoken.FieldRateBands, value)
+		})
+	}
+	if potuo.mutation.RateBandsCleared() {
+		_spec.ClearField(providerordertoken.FieldRateBands, field.TypeJSON)
+	}
 	if potuo.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -669,6 +926,35 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if potuo.mutation.RateExclusionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerordertoken.RateExclusionTable,
+			Columns: []string{providerordertoken.RateExclusionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := potuo.mutation.RateExclusionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerordertoken.RateExclusionTable,
+			Columns: []string{providerordertoken.RateExclusionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ProviderOrderToken{config: potuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
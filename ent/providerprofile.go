@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,7 +11,6 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
-	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
@@ -36,6 +36,22 @@ type ProviderProfile struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// VisibilityMode holds the value of the "visibility_mode" field.
 	VisibilityMode providerprofile.VisibilityMode `json:"visibility_mode,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// OperatingHours holds the value of the "operating_hours" field.
+	OperatingHours []struct {
+		Day   int    "json:\"day\""
+		Open  string "json:\"open\""
+		Close string "json:\"close\""
+	} `json:"operating_hours,omitempty"`
+	// Holidays holds the value of the "holidays" field.
+	Holidays []string `json:"holidays,omitempty"`
+	// SupportedInstitutions holds the value of the "supported_institutions" field.
+	SupportedInstitutions []string `json:"supported_institutions,omitempty"`
+	// UnsupportedInstitutions holds the value of the "unsupported_institutions" field.
+	UnsupportedInstitutions []string `json:"unsupported_institutions,omitempty"`
+	// AutoAdjustStaleRates holds the value of the "auto_adjust_stale_rates" field.
+	AutoAdjustStaleRates bool `json:"auto_adjust_stale_rates,omitempty"`
 	// Address holds the value of the "address" field.
 	Address string `json:"address,omitempty"`
 	// MobileNumber holds the value of the "mobile_number" field.
@@ -64,8 +80,8 @@ type ProviderProfile struct {
 type ProviderProfileEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
-	// APIKey holds the value of the api_key edge.
-	APIKey *APIKey `json:"api_key,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
 	// Currency holds the value of the currency edge.
 	Currency *FiatCurrency `json:"currency,omitempty"`
 	// ProvisionBuckets holds the value of the provision_buckets edge.
@@ -76,9 +92,13 @@ type ProviderProfileEdges struct {
 	ProviderRating *ProviderRating `json:"provider_rating,omitempty"`
 	// AssignedOrders holds the value of the assigned_orders edge.
 	AssignedOrders []*LockPaymentOrder `json:"assigned_orders,omitempty"`
+	// TeamMembers holds the value of the team_members edge.
+	TeamMembers []*TeamMember `json:"team_members,omitempty"`
+	// FiatBalances holds the value of the fiat_balances edge.
+	FiatBalances []*ProviderFiatBalance `json:"fiat_balances,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -92,15 +112,13 @@ func (e ProviderProfileEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
-// APIKeyOrErr returns the APIKey value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e ProviderProfileEdges) APIKeyOrErr() (*APIKey, error) {
-	if e.APIKey != nil {
-		return e.APIKey, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: apikey.Label}
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
+		return e.APIKeys, nil
 	}
-	return nil, &NotLoadedError{edge: "api_key"}
+	return nil, &NotLoadedError{edge: "api_keys"}
 }
 
 // CurrencyOrErr returns the Currency value or an error if the edge
@@ -152,14 +170,34 @@ func (e ProviderProfileEdges) AssignedOrdersOrErr() ([]*LockPaymentOrder, error)
 	return nil, &NotLoadedError{edge: "assigned_orders"}
 }
 
+// TeamMembersOrErr returns the TeamMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) TeamMembersOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[7] {
+		return e.TeamMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "team_members"}
+}
+
+// FiatBalancesOrErr returns the FiatBalances value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) FiatBalancesOrErr() ([]*ProviderFiatBalance, error) {
+	if e.loadedTypes[8] {
+		return e.FiatBalances, nil
+	}
+	return nil, &NotLoadedError{edge: "fiat_balances"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProviderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case providerprofile.FieldIsActive, providerprofile.FieldIsAvailable, providerprofile.FieldIsKybVerified:
+		case providerprofile.FieldOperatingHours, providerprofile.FieldHolidays, providerprofile.FieldSupportedInstitutions, providerprofile.FieldUnsupportedInstitutions:
+			values[i] = new([]byte)
+		case providerprofile.FieldIsActive, providerprofile.FieldIsAvailable, providerprofile.FieldAutoAdjustStaleRates, providerprofile.FieldIsKybVerified:
 			values[i] = new(sql.NullBool)
-		case providerprofile.FieldID, providerprofile.FieldTradingName, providerprofile.FieldHostIdentifier, providerprofile.FieldProvisionMode, providerprofile.FieldVisibilityMode, providerprofile.FieldAddress, providerprofile.FieldMobileNumber, providerprofile.FieldBusinessName, providerprofile.FieldIdentityDocumentType, providerprofile.FieldIdentityDocument, providerprofile.FieldBusinessDocument:
+		case providerprofile.FieldID, providerprofile.FieldTradingName, providerprofile.FieldHostIdentifier, providerprofile.FieldProvisionMode, providerprofile.FieldVisibilityMode, providerprofile.FieldTimezone, providerprofile.FieldAddress, providerprofile.FieldMobileNumber, providerprofile.FieldBusinessName, providerprofile.FieldIdentityDocumentType, providerprofile.FieldIdentityDocument, providerprofile.FieldBusinessDocument:
 			values[i] = new(sql.NullString)
 		case providerprofile.FieldUpdatedAt, providerprofile.FieldDateOfBirth:
 			values[i] = new(sql.NullTime)
@@ -230,6 +268,50 @@ func (pp *ProviderProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pp.VisibilityMode = providerprofile.VisibilityMode(value.String)
 			}
+		case providerprofile.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				pp.Timezone = value.String
+			}
+		case providerprofile.FieldOperatingHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field operating_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.OperatingHours); err != nil {
+					return fmt.Errorf("unmarshal field operating_hours: %w", err)
+				}
+			}
+		case providerprofile.FieldHolidays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field holidays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.Holidays); err != nil {
+					return fmt.Errorf("unmarshal field holidays: %w", err)
+				}
+			}
+		case providerprofile.FieldSupportedInstitutions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field supported_institutions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.SupportedInstitutions); err != nil {
+					return fmt.Errorf("unmarshal field supported_institutions: %w", err)
+				}
+			}
+		case providerprofile.FieldUnsupportedInstitutions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unsupported_institutions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.UnsupportedInstitutions); err != nil {
+					return fmt.Errorf("unmarshal field unsupported_institutions: %w", err)
+				}
+			}
+		case providerprofile.FieldAutoAdjustStaleRates:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_adjust_stale_rates", values[i])
+			} else if value.Valid {
+				pp.AutoAdjustStaleRates = value.Bool
+			}
 		case providerprofile.FieldAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field address", values[i])
@@ -310,9 +392,9 @@ func (pp *ProviderProfile) QueryUser() *UserQuery {
 	return NewProviderProfileClient(pp.config).QueryUser(pp)
 }
 
-// QueryAPIKey queries the "api_key" edge of the ProviderProfile entity.
-func (pp *ProviderProfile) QueryAPIKey() *APIKeyQuery {
-	return NewProviderProfileClient(pp.config).QueryAPIKey(pp)
+// QueryAPIKeys queries the "api_keys" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryAPIKeys() *APIKeyQuery {
+	return NewProviderProfileClient(pp.config).QueryAPIKeys(pp)
 }
 
 // QueryCurrency queries the "currency" edge of the ProviderProfile entity.
@@ -340,6 +422,16 @@ func (pp *ProviderProfile) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return NewProviderProfileClient(pp.config).QueryAssignedOrders(pp)
 }
 
+// QueryTeamMembers queries the "team_members" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryTeamMembers() *TeamMemberQuery {
+	return NewProviderProfileClient(pp.config).QueryTeamMembers(pp)
+}
+
+// QueryFiatBalances queries the "fiat_balances" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryFiatBalances() *ProviderFiatBalanceQuery {
+	return NewProviderProfileClient(pp.config).QueryFiatBalances(pp)
+}
+
 // Update returns a builder for updating this ProviderProfile.
 // Note that you need to call ProviderProfile.Unwrap() before calling this method if this ProviderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -384,6 +476,24 @@ func (pp *ProviderProfile) String() string {
 	builder.WriteString("visibility_mode=")
 	builder.WriteString(fmt.Sprintf("%v", pp.VisibilityMode))
 	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(pp.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("operating_hours=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OperatingHours))
+	builder.WriteString(", ")
+	builder.WriteString("holidays=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Holidays))
+	builder.WriteString(", ")
+	builder.WriteString("supported_institutions=")
+	builder.WriteString(fmt.Sprintf("%v", pp.SupportedInstitutions))
+	builder.WriteString(", ")
+	builder.WriteString("unsupported_institutions=")
+	builder.WriteString(fmt.Sprintf("%v", pp.UnsupportedInstitutions))
+	builder.WriteString(", ")
+	builder.WriteString("auto_adjust_stale_rates=")
+	builder.WriteString(fmt.Sprintf("%v", pp.AutoAdjustStaleRates))
+	builder.WriteString(", ")
 	builder.WriteString("address=")
 	builder.WriteString(pp.Address)
 	builder.WriteString(", ")
@@ -29,6 +29,18 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldVisibilityMode holds the string denoting the visibility_mode field in the database.
 	FieldVisibilityMode = "visibility_mode"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldOperatingHours holds the string denoting the operating_hours field in the database.
+	FieldOperatingHours = "operating_hours"
+	// FieldHolidays holds the string denoting the holidays field in the database.
+	FieldHolidays = "holidays"
+	// FieldSupportedInstitutions holds the string denoting the supported_institutions field in the database.
+	FieldSupportedInstitutions = "supported_institutions"
+	// FieldUnsupportedInstitutions holds the string denoting the unsupported_institutions field in the database.
+	FieldUnsupportedInstitutions = "unsupported_institutions"
+	// FieldAutoAdjustStaleRates holds the string denoting the auto_adjust_stale_rates field in the database.
+	FieldAutoAdjustStaleRates = "auto_adjust_stale_rates"
 	// FieldAddress holds the string denoting the address field in the database.
 	FieldAddress = "address"
 	// FieldMobileNumber holds the string denoting the mobile_number field in the database.
@@ -47,8 +59,8 @@ const (
 	FieldIsKybVerified = "is_kyb_verified"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
-	// EdgeAPIKey holds the string denoting the api_key edge name in mutations.
-	EdgeAPIKey = "api_key"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
 	// EdgeCurrency holds the string denoting the currency edge name in mutations.
 	EdgeCurrency = "currency"
 	// EdgeProvisionBuckets holds the string denoting the provision_buckets edge name in mutations.
@@ -59,6 +71,10 @@ const (
 	EdgeProviderRating = "provider_rating"
 	// EdgeAssignedOrders holds the string denoting the assigned_orders edge name in mutations.
 	EdgeAssignedOrders = "assigned_orders"
+	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
+	EdgeTeamMembers = "team_members"
+	// EdgeFiatBalances holds the string denoting the fiat_balances edge name in mutations.
+	EdgeFiatBalances = "fiat_balances"
 	// Table holds the table name of the providerprofile in the database.
 	Table = "provider_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -68,13 +84,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_provider_profile"
-	// APIKeyTable is the table that holds the api_key relation/edge.
-	APIKeyTable = "api_keys"
-	// APIKeyInverseTable is the table name for the APIKey entity.
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the APIKey entity.
 	// It exists in this package in order to avoid circular dependency with the "apikey" package.
-	APIKeyInverseTable = "api_keys"
-	// APIKeyColumn is the table column denoting the api_key relation/edge.
-	APIKeyColumn = "provider_profile_api_key"
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "provider_profile_api_key"
 	// CurrencyTable is the table that holds the currency relation/edge.
 	CurrencyTable = "provider_profiles"
 	// CurrencyInverseTable is the table name for the FiatCurrency entity.
@@ -108,6 +124,20 @@ const (
 	AssignedOrdersInverseTable = "lock_payment_orders"
 	// AssignedOrdersColumn is the table column denoting the assigned_orders relation/edge.
 	AssignedOrdersColumn = "provider_profile_assigned_orders"
+	// TeamMembersTable is the table that holds the team_members relation/edge.
+	TeamMembersTable = "team_members"
+	// TeamMembersInverseTable is the table name for the TeamMember entity.
+	// It exists in this package in order to avoid circular dependency with the "teammember" package.
+	TeamMembersInverseTable = "team_members"
+	// TeamMembersColumn is the table column denoting the team_members relation/edge.
+	TeamMembersColumn = "provider_profile_team_members"
+	// FiatBalancesTable is the table that holds the fiat_balances relation/edge.
+	FiatBalancesTable = "provider_fiat_balances"
+	// FiatBalancesInverseTable is the table name for the ProviderFiatBalance entity.
+	// It exists in this package in order to avoid circular dependency with the "providerfiatbalance" package.
+	FiatBalancesInverseTable = "provider_fiat_balances"
+	// FiatBalancesColumn is the table column denoting the fiat_balances relation/edge.
+	FiatBalancesColumn = "provider_profile_fiat_balances"
 )
 
 // Columns holds all SQL columns for providerprofile fields.
@@ -120,6 +150,12 @@ var Columns = []string{
 	FieldIsAvailable,
 	FieldUpdatedAt,
 	FieldVisibilityMode,
+	FieldTimezone,
+	FieldOperatingHours,
+	FieldHolidays,
+	FieldSupportedInstitutions,
+	FieldUnsupportedInstitutions,
+	FieldAutoAdjustStaleRates,
 	FieldAddress,
 	FieldMobileNumber,
 	FieldDateOfBirth,
@@ -169,6 +205,10 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// DefaultAutoAdjustStaleRates holds the default value on creation for the "auto_adjust_stale_rates" field.
+	DefaultAutoAdjustStaleRates bool
 	// DefaultIsKybVerified holds the default value on creation for the "is_kyb_verified" field.
 	DefaultIsKybVerified bool
 	// DefaultID holds the default value on creation for the "id" field.
@@ -294,6 +334,16 @@ func ByVisibilityMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVisibilityMode, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByAutoAdjustStaleRates orders the results by the auto_adjust_stale_rates field.
+func ByAutoAdjustStaleRates(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoAdjustStaleRates, opts...).ToFunc()
+}
+
 // ByAddress orders the results by the address field.
 func ByAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddress, opts...).ToFunc()
@@ -341,10 +391,17 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByAPIKeyField orders the results by api_key field.
-func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -403,6 +460,34 @@ func ByAssignedOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAssignedOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTeamMembersCount orders the results by team_members count.
+func ByTeamMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamMembersStep(), opts...)
+	}
+}
+
+// ByTeamMembers orders the results by team_members terms.
+func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFiatBalancesCount orders the results by fiat_balances count.
+func ByFiatBalancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFiatBalancesStep(), opts...)
+	}
+}
+
+// ByFiatBalances orders the results by fiat_balances terms.
+func ByFiatBalances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFiatBalancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -410,11 +495,11 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
 	)
 }
-func newAPIKeyStep() *sqlgraph.Step {
+func newAPIKeysStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(APIKeyInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
 	)
 }
 func newCurrencyStep() *sqlgraph.Step {
@@ -452,3 +537,17 @@ func newAssignedOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AssignedOrdersTable, AssignedOrdersColumn),
 	)
 }
+func newTeamMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamMembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+	)
+}
+func newFiatBalancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FiatBalancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FiatBalancesTable, FiatBalancesColumn),
+	)
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -129,6 +131,62 @@ func (ppc *ProviderProfileCreate) SetNillableVisibilityMode(pm *providerprofile.
 	return ppc
 }
 
+// SetTimezone sets the "timezone" field.
+func (ppc *ProviderProfileCreate) SetTimezone(s string) *ProviderProfileCreate {
+	ppc.mutation.SetTimezone(s)
+	return ppc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableTimezone(s *string) *ProviderProfileCreate {
+	if s != nil {
+		ppc.SetTimezone(*s)
+	}
+	return ppc
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppc *ProviderProfileCreate) SetOperatingHours(s []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileCreate {
+	ppc.mutation.SetOperatingHours(s)
+	return ppc
+}
+
+// SetHolidays sets the "holidays" field.
+func (ppc *ProviderProfileCreate) SetHolidays(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetHolidays(s)
+	return ppc
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppc *ProviderProfileCreate) SetSupportedInstitutions(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetSupportedInstitutions(s)
+	return ppc
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (ppc *ProviderProfileCreate) SetUnsupportedInstitutions(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetUnsupportedInstitutions(s)
+	return ppc
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (ppc *ProviderProfileCreate) SetAutoAdjustStaleRates(b bool) *ProviderProfileCreate {
+	ppc.mutation.SetAutoAdjustStaleRates(b)
+	return ppc
+}
+
+// SetNillableAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableAutoAdjustStaleRates(b *bool) *ProviderProfileCreate {
+	if b != nil {
+		ppc.SetAutoAdjustStaleRates(*b)
+	}
+	return ppc
+}
+
 // SetAddress sets the "address" field.
 func (ppc *ProviderProfileCreate) SetAddress(s string) *ProviderProfileCreate {
 	ppc.mutation.SetAddress(s)
@@ -266,23 +324,19 @@ func (ppc *ProviderProfileCreate) SetUser(u *User) *ProviderProfileCreate {
 	return ppc.SetUserID(u.ID)
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppc *ProviderProfileCreate) SetAPIKeyID(id uuid.UUID) *ProviderProfileCreate {
-	ppc.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ppc *ProviderProfileCreate) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddAPIKeyIDs(ids...)
 	return ppc
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppc *ProviderProfileCreate) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileCreate {
-	if id != nil {
-		ppc = ppc.SetAPIKeyID(*id)
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ppc *ProviderProfileCreate) AddAPIKeys(a ...*APIKey) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppc
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppc *ProviderProfileCreate) SetAPIKey(a *APIKey) *ProviderProfileCreate {
-	return ppc.SetAPIKeyID(a.ID)
+	return ppc.AddAPIKeyIDs(ids...)
 }
 
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
@@ -360,6 +414,36 @@ func (ppc *ProviderProfileCreate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppc.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppc *ProviderProfileCreate) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddTeamMemberIDs(ids...)
+	return ppc
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppc *ProviderProfileCreate) AddTeamMembers(t ...*TeamMember) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppc.AddTeamMemberIDs(ids...)
+}
+
+// AddFiatBalanceIDs adds the "fiat_balances" edge to the ProviderFiatBalance entity by IDs.
+func (ppc *ProviderProfileCreate) AddFiatBalanceIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddFiatBalanceIDs(ids...)
+	return ppc
+}
+
+// AddFiatBalances adds the "fiat_balances" edges to the ProviderFiatBalance entity.
+func (ppc *ProviderProfileCreate) AddFiatBalances(p ...*ProviderFiatBalance) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppc.AddFiatBalanceIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppc *ProviderProfileCreate) Mutation() *ProviderProfileMutation {
 	return ppc.mutation
@@ -415,6 +499,14 @@ func (ppc *ProviderProfileCreate) defaults() {
 		v := providerprofile.DefaultVisibilityMode
 		ppc.mutation.SetVisibilityMode(v)
 	}
+	if _, ok := ppc.mutation.Timezone(); !ok {
+		v := providerprofile.DefaultTimezone
+		ppc.mutation.SetTimezone(v)
+	}
+	if _, ok := ppc.mutation.AutoAdjustStaleRates(); !ok {
+		v := providerprofile.DefaultAutoAdjustStaleRates
+		ppc.mutation.SetAutoAdjustStaleRates(v)
+	}
 	if _, ok := ppc.mutation.IsKybVerified(); !ok {
 		v := providerprofile.DefaultIsKybVerified
 		ppc.mutation.SetIsKybVerified(v)
@@ -457,6 +549,12 @@ func (ppc *ProviderProfileCreate) check() error {
 			return &ValidationError{Name: "visibility_mode", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.visibility_mode": %w`, err)}
 		}
 	}
+	if _, ok := ppc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "ProviderProfile.timezone"`)}
+	}
+	if _, ok := ppc.mutation.AutoAdjustStaleRates(); !ok {
+		return &ValidationError{Name: "auto_adjust_stale_rates", err: errors.New(`ent: missing required field "ProviderProfile.auto_adjust_stale_rates"`)}
+	}
 	if v, ok := ppc.mutation.IdentityDocumentType(); ok {
 		if err := providerprofile.IdentityDocumentTypeValidator(v); err != nil {
 			return &ValidationError{Name: "identity_document_type", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.identity_document_type": %w`, err)}
@@ -535,6 +633,30 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		_spec.SetField(providerprofile.FieldVisibilityMode, field.TypeEnum, value)
 		_node.VisibilityMode = value
 	}
+	if value, ok := ppc.mutation.Timezone(); ok {
+		_spec.SetField(providerprofile.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := ppc.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+		_node.OperatingHours = value
+	}
+	if value, ok := ppc.mutation.Holidays(); ok {
+		_spec.SetField(providerprofile.FieldHolidays, field.TypeJSON, value)
+		_node.Holidays = value
+	}
+	if value, ok := ppc.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+		_node.SupportedInstitutions = value
+	}
+	if value, ok := ppc.mutation.UnsupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldUnsupportedInstitutions, field.TypeJSON, value)
+		_node.UnsupportedInstitutions = value
+	}
+	if value, ok := ppc.mutation.AutoAdjustStaleRates(); ok {
+		_spec.SetField(providerprofile.FieldAutoAdjustStaleRates, field.TypeBool, value)
+		_node.AutoAdjustStaleRates = value
+	}
 	if value, ok := ppc.mutation.Address(); ok {
 		_spec.SetField(providerprofile.FieldAddress, field.TypeString, value)
 		_node.Address = value
@@ -584,12 +706,12 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		_node.user_provider_profile = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := ppc.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := ppc.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -681,6 +803,38 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ppc.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.FiatBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -829,6 +983,106 @@ func (u *ProviderProfileUpsert) UpdateVisibilityMode() *ProviderProfileUpsert {
 	return u
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *ProviderProfileUpsert) SetTimezone(v string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateTimezone() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldTimezone)
+	return u
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsert) SetOperatingHours(v []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldOperatingHours, v)
+	return u
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateOperatingHours() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldOperatingHours)
+	return u
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsert) ClearOperatingHours() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldOperatingHours)
+	return u
+}
+
+// SetHolidays sets the "holidays" field.
+func (u *ProviderProfileUpsert) SetHolidays(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldHolidays, v)
+	return u
+}
+
+// UpdateHolidays sets the "holidays" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateHolidays() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldHolidays)
+	return u
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (u *ProviderProfileUpsert) ClearHolidays() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldHolidays)
+	return u
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsert) SetSupportedInstitutions(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldSupportedInstitutions, v)
+	return u
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateSupportedInstitutions() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldSupportedInstitutions)
+	return u
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsert) ClearSupportedInstitutions() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldSupportedInstitutions)
+	return u
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (u *ProviderProfileUpsert) SetUnsupportedInstitutions(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldUnsupportedInstitutions, v)
+	return u
+}
+
+// UpdateUnsupportedInstitutions sets the "unsupported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateUnsupportedInstitutions() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldUnsupportedInstitutions)
+	return u
+}
+
+// ClearUnsupportedInstitutions clears the value of the "unsupported_institutions" field.
+func (u *ProviderProfileUpsert) ClearUnsupportedInstitutions() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldUnsupportedInstitutions)
+	return u
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (u *ProviderProfileUpsert) SetAutoAdjustStaleRates(v bool) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldAutoAdjustStaleRates, v)
+	return u
+}
+
+// UpdateAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateAutoAdjustStaleRates() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldAutoAdjustStaleRates)
+	return u
+}
+
 // SetAddress sets the "address" field.
 func (u *ProviderProfileUpsert) SetAddress(v string) *ProviderProfileUpsert {
 	u.Set(providerprofile.FieldAddress, v)
@@ -1127,6 +1381,122 @@ func (u *ProviderProfileUpsertOne) UpdateVisibilityMode() *ProviderProfileUpsert
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *ProviderProfileUpsertOne) SetTimezone(v string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateTimezone() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsertOne) SetOperatingHours(v []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHours(v)
+	})
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateOperatingHours() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHours()
+	})
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsertOne) ClearOperatingHours() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHours()
+	})
+}
+
+// SetHolidays sets the "holidays" field.
+func (u *ProviderProfileUpsertOne) SetHolidays(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetHolidays(v)
+	})
+}
+
+// UpdateHolidays sets the "holidays" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateHolidays() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateHolidays()
+	})
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (u *ProviderProfileUpsertOne) ClearHolidays() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearHolidays()
+	})
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsertOne) SetSupportedInstitutions(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutions(v)
+	})
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateSupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutions()
+	})
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsertOne) ClearSupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutions()
+	})
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (u *ProviderProfileUpsertOne) SetUnsupportedInstitutions(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetUnsupportedInstitutions(v)
+	})
+}
+
+// UpdateUnsupportedInstitutions sets the "unsupported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateUnsupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateUnsupportedInstitutions()
+	})
+}
+
+// ClearUnsupportedInstitutions clears the value of the "unsupported_institutions" field.
+func (u *ProviderProfileUpsertOne) ClearUnsupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearUnsupportedInstitutions()
+	})
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (u *ProviderProfileUpsertOne) SetAutoAdjustStaleRates(v bool) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetAutoAdjustStaleRates(v)
+	})
+}
+
+// UpdateAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateAutoAdjustStaleRates() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateAutoAdjustStaleRates()
+	})
+}
+
 // SetAddress sets the "address" field.
 func (u *ProviderProfileUpsertOne) SetAddress(v string) *ProviderProfileUpsertOne {
 	return u.Update(func(s *ProviderProfileUpsert) {
@@ -1615,6 +1985,122 @@ func (u *ProviderProfileUpsertBulk) UpdateVisibilityMode() *ProviderProfileUpser
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *ProviderProfileUpsertBulk) SetTimezone(v string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateTimezone() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsertBulk) SetOperatingHours(v []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHours(v)
+	})
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateOperatingHours() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHours()
+	})
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsertBulk) ClearOperatingHours() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHours()
+	})
+}
+
+// SetHolidays sets the "holidays" field.
+func (u *ProviderProfileUpsertBulk) SetHolidays(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetHolidays(v)
+	})
+}
+
+// UpdateHolidays sets the "holidays" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateHolidays() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateHolidays()
+	})
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (u *ProviderProfileUpsertBulk) ClearHolidays() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearHolidays()
+	})
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsertBulk) SetSupportedInstitutions(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutions(v)
+	})
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateSupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutions()
+	})
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsertBulk) ClearSupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutions()
+	})
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (u *ProviderProfileUpsertBulk) SetUnsupportedInstitutions(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetUnsupportedInstitutions(v)
+	})
+}
+
+// UpdateUnsupportedInstitutions sets the "unsupported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateUnsupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateUnsupportedInstitutions()
+	})
+}
+
+// ClearUnsupportedInstitutions clears the value of the "unsupported_institutions" field.
+func (u *ProviderProfileUpsertBulk) ClearUnsupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearUnsupportedInstitutions()
+	})
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (u *ProviderProfileUpsertBulk) SetAutoAdjustStaleRates(v bool) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetAutoAdjustStaleRates(v)
+	})
+}
+
+// UpdateAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateAutoAdjustStaleRates() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateAutoAdjustStaleRates()
+	})
+}
+
 // SetAddress sets the "address" field.
 func (u *ProviderProfileUpsertBulk) SetAddress(v string) *ProviderProfileUpsertBulk {
 	return u.Update(func(s *ProviderProfileUpsert) {
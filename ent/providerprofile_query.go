@@ -17,10 +17,12 @@ import (
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -32,12 +34,14 @@ type ProviderProfileQuery struct {
 	inters               []Interceptor
 	predicates           []predicate.ProviderProfile
 	withUser             *UserQuery
-	withAPIKey           *APIKeyQuery
+	withAPIKeys          *APIKeyQuery
 	withCurrency         *FiatCurrencyQuery
 	withProvisionBuckets *ProvisionBucketQuery
 	withOrderTokens      *ProviderOrderTokenQuery
 	withProviderRating   *ProviderRatingQuery
 	withAssignedOrders   *LockPaymentOrderQuery
+	withTeamMembers      *TeamMemberQuery
+	withFiatBalances     *ProviderFiatBalanceQuery
 	withFKs              bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -97,8 +101,8 @@ func (ppq *ProviderProfileQuery) QueryUser() *UserQuery {
 	return query
 }
 
-// QueryAPIKey chains the current query on the "api_key" edge.
-func (ppq *ProviderProfileQuery) QueryAPIKey() *APIKeyQuery {
+// QueryAPIKeys chains the current query on the "api_keys" edge.
+func (ppq *ProviderProfileQuery) QueryAPIKeys() *APIKeyQuery {
 	query := (&APIKeyClient{config: ppq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ppq.prepareQuery(ctx); err != nil {
@@ -111,7 +115,7 @@ func (ppq *ProviderProfileQuery) QueryAPIKey() *APIKeyQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.APIKeysTable, providerprofile.APIKeysColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
 		return fromU, nil
@@ -229,6 +233,50 @@ func (ppq *ProviderProfileQuery) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return query
 }
 
+// QueryTeamMembers chains the current query on the "team_members" edge.
+func (ppq *ProviderProfileQuery) QueryTeamMembers() *TeamMemberQuery {
+	query := (&TeamMemberClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamMembersTable, providerprofile.TeamMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFiatBalances chains the current query on the "fiat_balances" edge.
+func (ppq *ProviderProfileQuery) QueryFiatBalances() *ProviderFiatBalanceQuery {
+	query := (&ProviderFiatBalanceClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(providerfiatbalance.Table, providerfiatbalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.FiatBalancesTable, providerprofile.FiatBalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProviderProfile entity from the query.
 // Returns a *NotFoundError when no ProviderProfile was found.
 func (ppq *ProviderProfileQuery) First(ctx context.Context) (*ProviderProfile, error) {
@@ -422,12 +470,14 @@ func (ppq *ProviderProfileQuery) Clone() *ProviderProfileQuery {
 		inters:               append([]Interceptor{}, ppq.inters...),
 		predicates:           append([]predicate.ProviderProfile{}, ppq.predicates...),
 		withUser:             ppq.withUser.Clone(),
-		withAPIKey:           ppq.withAPIKey.Clone(),
+		withAPIKeys:          ppq.withAPIKeys.Clone(),
 		withCurrency:         ppq.withCurrency.Clone(),
 		withProvisionBuckets: ppq.withProvisionBuckets.Clone(),
 		withOrderTokens:      ppq.withOrderTokens.Clone(),
 		withProviderRating:   ppq.withProviderRating.Clone(),
 		withAssignedOrders:   ppq.withAssignedOrders.Clone(),
+		withTeamMembers:      ppq.withTeamMembers.Clone(),
+		withFiatBalances:     ppq.withFiatBalances.Clone(),
 		// clone intermediate query.
 		sql:  ppq.sql.Clone(),
 		path: ppq.path,
@@ -445,14 +495,14 @@ func (ppq *ProviderProfileQuery) WithUser(opts ...func(*UserQuery)) *ProviderPro
 	return ppq
 }
 
-// WithAPIKey tells the query-builder to eager-load the nodes that are connected to
-// the "api_key" edge. The optional arguments are used to configure the query builder of the edge.
-func (ppq *ProviderProfileQuery) WithAPIKey(opts ...func(*APIKeyQuery)) *ProviderProfileQuery {
+// WithAPIKeys tells the query-builder to eager-load the nodes that are connected to
+// the "api_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithAPIKeys(opts ...func(*APIKeyQuery)) *ProviderProfileQuery {
 	query := (&APIKeyClient{config: ppq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	ppq.withAPIKey = query
+	ppq.withAPIKeys = query
 	return ppq
 }
 
@@ -511,6 +561,28 @@ func (ppq *ProviderProfileQuery) WithAssignedOrders(opts ...func(*LockPaymentOrd
 	return ppq
 }
 
+// WithTeamMembers tells the query-builder to eager-load the nodes that are connected to
+// the "team_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithTeamMembers(opts ...func(*TeamMemberQuery)) *ProviderProfileQuery {
+	query := (&TeamMemberClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withTeamMembers = query
+	return ppq
+}
+
+// WithFiatBalances tells the query-builder to eager-load the nodes that are connected to
+// the "fiat_balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithFiatBalances(opts ...func(*ProviderFiatBalanceQuery)) *ProviderProfileQuery {
+	query := (&ProviderFiatBalanceClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withFiatBalances = query
+	return ppq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,14 +662,16 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ProviderProfile{}
 		withFKs     = ppq.withFKs
 		_spec       = ppq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [9]bool{
 			ppq.withUser != nil,
-			ppq.withAPIKey != nil,
+			ppq.withAPIKeys != nil,
 			ppq.withCurrency != nil,
 			ppq.withProvisionBuckets != nil,
 			ppq.withOrderTokens != nil,
 			ppq.withProviderRating != nil,
 			ppq.withAssignedOrders != nil,
+			ppq.withTeamMembers != nil,
+			ppq.withFiatBalances != nil,
 		}
 	)
 	if ppq.withUser != nil || ppq.withCurrency != nil {
@@ -630,9 +704,10 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
-	if query := ppq.withAPIKey; query != nil {
-		if err := ppq.loadAPIKey(ctx, query, nodes, nil,
-			func(n *ProviderProfile, e *APIKey) { n.Edges.APIKey = e }); err != nil {
+	if query := ppq.withAPIKeys; query != nil {
+		if err := ppq.loadAPIKeys(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.APIKeys = []*APIKey{} },
+			func(n *ProviderProfile, e *APIKey) { n.Edges.APIKeys = append(n.Edges.APIKeys, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -673,6 +748,22 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := ppq.withTeamMembers; query != nil {
+		if err := ppq.loadTeamMembers(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.TeamMembers = []*TeamMember{} },
+			func(n *ProviderProfile, e *TeamMember) { n.Edges.TeamMembers = append(n.Edges.TeamMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withFiatBalances; query != nil {
+		if err := ppq.loadFiatBalances(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.FiatBalances = []*ProviderFiatBalance{} },
+			func(n *ProviderProfile, e *ProviderFiatBalance) {
+				n.Edges.FiatBalances = append(n.Edges.FiatBalances, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -708,16 +799,19 @@ func (ppq *ProviderProfileQuery) loadUser(ctx context.Context, query *UserQuery,
 	}
 	return nil
 }
-func (ppq *ProviderProfileQuery) loadAPIKey(ctx context.Context, query *APIKeyQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *APIKey)) error {
+func (ppq *ProviderProfileQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *APIKey)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*ProviderProfile)
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.APIKey(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(providerprofile.APIKeyColumn), fks...))
+		s.Where(sql.InValues(s.C(providerprofile.APIKeysColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -919,6 +1013,68 @@ func (ppq *ProviderProfileQuery) loadAssignedOrders(ctx context.Context, query *
 	}
 	return nil
 }
+func (ppq *ProviderProfileQuery) loadTeamMembers(ctx context.Context, query *TeamMemberQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *TeamMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.TeamMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_team_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_team_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_team_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadFiatBalances(ctx context.Context, query *ProviderFiatBalanceQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *ProviderFiatBalance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderFiatBalance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.FiatBalancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_fiat_balances
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_fiat_balances" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_fiat_balances" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ppq *ProviderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ppq.querySpec()
@@ -10,16 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/teammember"
 )
 
 // ProviderProfileUpdate is the builder for updating ProviderProfile entities.
@@ -137,6 +140,114 @@ func (ppu *ProviderProfileUpdate) SetNillableVisibilityMode(pm *providerprofile.
 	return ppu
 }
 
+// SetTimezone sets the "timezone" field.
+func (ppu *ProviderProfileUpdate) SetTimezone(s string) *ProviderProfileUpdate {
+	ppu.mutation.SetTimezone(s)
+	return ppu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableTimezone(s *string) *ProviderProfileUpdate {
+	if s != nil {
+		ppu.SetTimezone(*s)
+	}
+	return ppu
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) SetOperatingHours(s []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.SetOperatingHours(s)
+	return ppu
+}
+
+// AppendOperatingHours appends s to the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) AppendOperatingHours(s []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.AppendOperatingHours(s)
+	return ppu
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) ClearOperatingHours() *ProviderProfileUpdate {
+	ppu.mutation.ClearOperatingHours()
+	return ppu
+}
+
+// SetHolidays sets the "holidays" field.
+func (ppu *ProviderProfileUpdate) SetHolidays(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetHolidays(s)
+	return ppu
+}
+
+// AppendHolidays appends s to the "holidays" field.
+func (ppu *ProviderProfileUpdate) AppendHolidays(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendHolidays(s)
+	return ppu
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (ppu *ProviderProfileUpdate) ClearHolidays() *ProviderProfileUpdate {
+	ppu.mutation.ClearHolidays()
+	return ppu
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) SetSupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetSupportedInstitutions(s)
+	return ppu
+}
+
+// AppendSupportedInstitutions appends s to the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) AppendSupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendSupportedInstitutions(s)
+	return ppu
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) ClearSupportedInstitutions() *ProviderProfileUpdate {
+	ppu.mutation.ClearSupportedInstitutions()
+	return ppu
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (ppu *ProviderProfileUpdate) SetUnsupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetUnsupportedInstitutions(s)
+	return ppu
+}
+
+// AppendUnsupportedInstitutions appends s to the "unsupported_institutions" field.
+func (ppu *ProviderProfileUpdate) AppendUnsupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendUnsupportedInstitutions(s)
+	return ppu
+}
+
+// ClearUnsupportedInstitutions clears the value of the "unsupported_institutions" field.
+func (ppu *ProviderProfileUpdate) ClearUnsupportedInstitutions() *ProviderProfileUpdate {
+	ppu.mutation.ClearUnsupportedInstitutions()
+	return ppu
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (ppu *ProviderProfileUpdate) SetAutoAdjustStaleRates(b bool) *ProviderProfileUpdate {
+	ppu.mutation.SetAutoAdjustStaleRates(b)
+	return ppu
+}
+
+// SetNillableAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableAutoAdjustStaleRates(b *bool) *ProviderProfileUpdate {
+	if b != nil {
+		ppu.SetAutoAdjustStaleRates(*b)
+	}
+	return ppu
+}
+
 // SetAddress sets the "address" field.
 func (ppu *ProviderProfileUpdate) SetAddress(s string) *ProviderProfileUpdate {
 	ppu.mutation.SetAddress(s)
@@ -291,23 +402,19 @@ func (ppu *ProviderProfileUpdate) SetNillableIsKybVerified(b *bool) *ProviderPro
 	return ppu
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppu *ProviderProfileUpdate) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdate {
-	ppu.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ppu *ProviderProfileUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddAPIKeyIDs(ids...)
 	return ppu
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppu *ProviderProfileUpdate) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileUpdate {
-	if id != nil {
-		ppu = ppu.SetAPIKeyID(*id)
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ppu *ProviderProfileUpdate) AddAPIKeys(a ...*APIKey) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppu
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppu *ProviderProfileUpdate) SetAPIKey(a *APIKey) *ProviderProfileUpdate {
-	return ppu.SetAPIKeyID(a.ID)
+	return ppu.AddAPIKeyIDs(ids...)
 }
 
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
@@ -385,17 +492,62 @@ func (ppu *ProviderProfileUpdate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppu.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppu *ProviderProfileUpdate) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddTeamMemberIDs(ids...)
+	return ppu
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppu *ProviderProfileUpdate) AddTeamMembers(t ...*TeamMember) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppu.AddTeamMemberIDs(ids...)
+}
+
+// AddFiatBalanceIDs adds the "fiat_balances" edge to the ProviderFiatBalance entity by IDs.
+func (ppu *ProviderProfileUpdate) AddFiatBalanceIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddFiatBalanceIDs(ids...)
+	return ppu
+}
+
+// AddFiatBalances adds the "fiat_balances" edges to the ProviderFiatBalance entity.
+func (ppu *ProviderProfileUpdate) AddFiatBalances(p ...*ProviderFiatBalance) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.AddFiatBalanceIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppu *ProviderProfileUpdate) Mutation() *ProviderProfileMutation {
 	return ppu.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
-func (ppu *ProviderProfileUpdate) ClearAPIKey() *ProviderProfileUpdate {
-	ppu.mutation.ClearAPIKey()
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ppu *ProviderProfileUpdate) ClearAPIKeys() *ProviderProfileUpdate {
+	ppu.mutation.ClearAPIKeys()
+	return ppu
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveAPIKeyIDs(ids...)
 	return ppu
 }
 
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ppu *ProviderProfileUpdate) RemoveAPIKeys(a ...*APIKey) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ppu.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearCurrency clears the "currency" edge to the FiatCurrency entity.
 func (ppu *ProviderProfileUpdate) ClearCurrency() *ProviderProfileUpdate {
 	ppu.mutation.ClearCurrency()
@@ -471,6 +623,48 @@ func (ppu *ProviderProfileUpdate) RemoveAssignedOrders(l ...*LockPaymentOrder) *
 	return ppu.RemoveAssignedOrderIDs(ids...)
 }
 
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (ppu *ProviderProfileUpdate) ClearTeamMembers() *ProviderProfileUpdate {
+	ppu.mutation.ClearTeamMembers()
+	return ppu
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveTeamMemberIDs(ids...)
+	return ppu
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (ppu *ProviderProfileUpdate) RemoveTeamMembers(t ...*TeamMember) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppu.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearFiatBalances clears all "fiat_balances" edges to the ProviderFiatBalance entity.
+func (ppu *ProviderProfileUpdate) ClearFiatBalances() *ProviderProfileUpdate {
+	ppu.mutation.ClearFiatBalances()
+	return ppu
+}
+
+// RemoveFiatBalanceIDs removes the "fiat_balances" edge to ProviderFiatBalance entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveFiatBalanceIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveFiatBalanceIDs(ids...)
+	return ppu
+}
+
+// RemoveFiatBalances removes "fiat_balances" edges to ProviderFiatBalance entities.
+func (ppu *ProviderProfileUpdate) RemoveFiatBalances(p ...*ProviderFiatBalance) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.RemoveFiatBalanceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ppu *ProviderProfileUpdate) Save(ctx context.Context) (int, error) {
 	ppu.defaults()
@@ -577,6 +771,56 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := ppu.mutation.VisibilityMode(); ok {
 		_spec.SetField(providerprofile.FieldVisibilityMode, field.TypeEnum, value)
 	}
+	if value, ok := ppu.mutation.Timezone(); ok {
+		_spec.SetField(providerprofile.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := ppu.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedOperatingHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHours, value)
+		})
+	}
+	if ppu.mutation.OperatingHoursCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHours, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.Holidays(); ok {
+		_spec.SetField(providerprofile.FieldHolidays, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedHolidays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldHolidays, value)
+		})
+	}
+	if ppu.mutation.HolidaysCleared() {
+		_spec.ClearField(providerprofile.FieldHolidays, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedSupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutions, value)
+		})
+	}
+	if ppu.mutation.SupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.UnsupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldUnsupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedUnsupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldUnsupportedInstitutions, value)
+		})
+	}
+	if ppu.mutation.UnsupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldUnsupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.AutoAdjustStaleRates(); ok {
+		_spec.SetField(providerprofile.FieldAutoAdjustStaleRates, field.TypeBool, value)
+	}
 	if value, ok := ppu.mutation.Address(); ok {
 		_spec.SetField(providerprofile.FieldAddress, field.TypeString, value)
 	}
@@ -622,12 +866,12 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := ppu.mutation.IsKybVerified(); ok {
 		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
 	}
-	if ppu.mutation.APIKeyCleared() {
+	if ppu.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -635,12 +879,28 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppu.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := ppu.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ppu.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -844,6 +1104,96 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ppu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !ppu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.FiatBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedFiatBalancesIDs(); len(nodes) > 0 && !ppu.mutation.FiatBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.FiatBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{providerprofile.Label}
@@ -966,6 +1316,114 @@ func (ppuo *ProviderProfileUpdateOne) SetNillableVisibilityMode(pm *providerprof
 	return ppuo
 }
 
+// SetTimezone sets the "timezone" field.
+func (ppuo *ProviderProfileUpdateOne) SetTimezone(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetTimezone(s)
+	return ppuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableTimezone(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetTimezone(*s)
+	}
+	return ppuo
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) SetOperatingHours(s []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetOperatingHours(s)
+	return ppuo
+}
+
+// AppendOperatingHours appends s to the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) AppendOperatingHours(s []struct {
+	Day   int    "json:\"day\""
+	Open  string "json:\"open\""
+	Close string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendOperatingHours(s)
+	return ppuo
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) ClearOperatingHours() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearOperatingHours()
+	return ppuo
+}
+
+// SetHolidays sets the "holidays" field.
+func (ppuo *ProviderProfileUpdateOne) SetHolidays(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetHolidays(s)
+	return ppuo
+}
+
+// AppendHolidays appends s to the "holidays" field.
+func (ppuo *ProviderProfileUpdateOne) AppendHolidays(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendHolidays(s)
+	return ppuo
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (ppuo *ProviderProfileUpdateOne) ClearHolidays() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearHolidays()
+	return ppuo
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) SetSupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetSupportedInstitutions(s)
+	return ppuo
+}
+
+// AppendSupportedInstitutions appends s to the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) AppendSupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendSupportedInstitutions(s)
+	return ppuo
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) ClearSupportedInstitutions() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearSupportedInstitutions()
+	return ppuo
+}
+
+// SetUnsupportedInstitutions sets the "unsupported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) SetUnsupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetUnsupportedInstitutions(s)
+	return ppuo
+}
+
+// AppendUnsupportedInstitutions appends s to the "unsupported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) AppendUnsupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendUnsupportedInstitutions(s)
+	return ppuo
+}
+
+// ClearUnsupportedInstitutions clears the value of the "unsupported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) ClearUnsupportedInstitutions() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearUnsupportedInstitutions()
+	return ppuo
+}
+
+// SetAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field.
+func (ppuo *ProviderProfileUpdateOne) SetAutoAdjustStaleRates(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetAutoAdjustStaleRates(b)
+	return ppuo
+}
+
+// SetNillableAutoAdjustStaleRates sets the "auto_adjust_stale_rates" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableAutoAdjustStaleRates(b *bool) *ProviderProfileUpdateOne {
+	if b != nil {
+		ppuo.SetAutoAdjustStaleRates(*b)
+	}
+	return ppuo
+}
+
 // SetAddress sets the "address" field.
 func (ppuo *ProviderProfileUpdateOne) SetAddress(s string) *ProviderProfileUpdateOne {
 	ppuo.mutation.SetAddress(s)
@@ -1120,23 +1578,19 @@ func (ppuo *ProviderProfileUpdateOne) SetNillableIsKybVerified(b *bool) *Provide
 	return ppuo
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppuo *ProviderProfileUpdateOne) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddAPIKeyIDs(ids...)
 	return ppuo
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileUpdateOne {
-	if id != nil {
-		ppuo = ppuo.SetAPIKeyID(*id)
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ppuo *ProviderProfileUpdateOne) AddAPIKeys(a ...*APIKey) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppuo
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppuo *ProviderProfileUpdateOne) SetAPIKey(a *APIKey) *ProviderProfileUpdateOne {
-	return ppuo.SetAPIKeyID(a.ID)
+	return ppuo.AddAPIKeyIDs(ids...)
 }
 
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
@@ -1214,17 +1668,62 @@ func (ppuo *ProviderProfileUpdateOne) AddAssignedOrders(l ...*LockPaymentOrder)
 	return ppuo.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddTeamMemberIDs(ids...)
+	return ppuo
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppuo *ProviderProfileUpdateOne) AddTeamMembers(t ...*TeamMember) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.AddTeamMemberIDs(ids...)
+}
+
+// AddFiatBalanceIDs adds the "fiat_balances" edge to the ProviderFiatBalance entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddFiatBalanceIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddFiatBalanceIDs(ids...)
+	return ppuo
+}
+
+// AddFiatBalances adds the "fiat_balances" edges to the ProviderFiatBalance entity.
+func (ppuo *ProviderProfileUpdateOne) AddFiatBalances(p ...*ProviderFiatBalance) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.AddFiatBalanceIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppuo *ProviderProfileUpdateOne) Mutation() *ProviderProfileMutation {
 	return ppuo.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
-func (ppuo *ProviderProfileUpdateOne) ClearAPIKey() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearAPIKey()
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ppuo *ProviderProfileUpdateOne) ClearAPIKeys() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearAPIKeys()
+	return ppuo
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveAPIKeyIDs(ids...)
 	return ppuo
 }
 
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveAPIKeys(a ...*APIKey) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ppuo.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearCurrency clears the "currency" edge to the FiatCurrency entity.
 func (ppuo *ProviderProfileUpdateOne) ClearCurrency() *ProviderProfileUpdateOne {
 	ppuo.mutation.ClearCurrency()
@@ -1300,6 +1799,48 @@ func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrders(l ...*LockPaymentOrde
 	return ppuo.RemoveAssignedOrderIDs(ids...)
 }
 
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (ppuo *ProviderProfileUpdateOne) ClearTeamMembers() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearTeamMembers()
+	return ppuo
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveTeamMemberIDs(ids...)
+	return ppuo
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamMembers(t ...*TeamMember) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearFiatBalances clears all "fiat_balances" edges to the ProviderFiatBalance entity.
+func (ppuo *ProviderProfileUpdateOne) ClearFiatBalances() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearFiatBalances()
+	return ppuo
+}
+
+// RemoveFiatBalanceIDs removes the "fiat_balances" edge to ProviderFiatBalance entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveFiatBalanceIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveFiatBalanceIDs(ids...)
+	return ppuo
+}
+
+// RemoveFiatBalances removes "fiat_balances" edges to ProviderFiatBalance entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveFiatBalances(p ...*ProviderFiatBalance) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveFiatBalanceIDs(ids...)
+}
+
 // Where appends a list predicates to the ProviderProfileUpdate builder.
 func (ppuo *ProviderProfileUpdateOne) Where(ps ...predicate.ProviderProfile) *ProviderProfileUpdateOne {
 	ppuo.mutation.Where(ps...)
@@ -1436,6 +1977,56 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 	if value, ok := ppuo.mutation.VisibilityMode(); ok {
 		_spec.SetField(providerprofile.FieldVisibilityMode, field.TypeEnum, value)
 	}
+	if value, ok := ppuo.mutation.Timezone(); ok {
+		_spec.SetField(providerprofile.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := ppuo.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedOperatingHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHours, value)
+		})
+	}
+	if ppuo.mutation.OperatingHoursCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHours, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.Holidays(); ok {
+		_spec.SetField(providerprofile.FieldHolidays, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedHolidays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldHolidays, value)
+		})
+	}
+	if ppuo.mutation.HolidaysCleared() {
+		_spec.ClearField(providerprofile.FieldHolidays, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedSupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutions, value)
+		})
+	}
+	if ppuo.mutation.SupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.UnsupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldUnsupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedUnsupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldUnsupportedInstitutions, value)
+		})
+	}
+	if ppuo.mutation.UnsupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldUnsupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.AutoAdjustStaleRates(); ok {
+		_spec.SetField(providerprofile.FieldAutoAdjustStaleRates, field.TypeBool, value)
+	}
 	if value, ok := ppuo.mutation.Address(); ok {
 		_spec.SetField(providerprofile.FieldAddress, field.TypeString, value)
 	}
@@ -1481,12 +2072,12 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 	if value, ok := ppuo.mutation.IsKybVerified(); ok {
 		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
 	}
-	if ppuo.mutation.APIKeyCleared() {
+	if ppuo.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -1494,12 +2085,28 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ppuo.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.APIKeysTable,
+			Columns: []string{providerprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -1703,6 +2310,96 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ppuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !ppuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.FiatBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedFiatBalancesIDs(); len(nodes) > 0 && !ppuo.mutation.FiatBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.FiatBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.FiatBalancesTable,
+			Columns: []string{providerprofile.FiatBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerfiatbalance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ProviderProfile{config: ppuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
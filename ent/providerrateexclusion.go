@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderRateExclusion is the model entity for the ProviderRateExclusion schema.
+type ProviderRateExclusion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason providerrateexclusion.Reason `json:"reason,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// MarketRate holds the value of the "market_rate" field.
+	MarketRate decimal.Decimal `json:"market_rate,omitempty"`
+	// Deviation holds the value of the "deviation" field.
+	Deviation decimal.Decimal `json:"deviation,omitempty"`
+	// NotifiedAt holds the value of the "notified_at" field.
+	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	// AdjustedAt holds the value of the "adjusted_at" field.
+	AdjustedAt *time.Time `json:"adjusted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderRateExclusionQuery when eager-loading is set.
+	Edges                               ProviderRateExclusionEdges `json:"edges"`
+	provider_order_token_rate_exclusion *int
+	selectValues                        sql.SelectValues
+}
+
+// ProviderRateExclusionEdges holds the relations/edges for other nodes in the graph.
+type ProviderRateExclusionEdges struct {
+	// OrderToken holds the value of the order_token edge.
+	OrderToken *ProviderOrderToken `json:"order_token,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderTokenOrErr returns the OrderToken value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderRateExclusionEdges) OrderTokenOrErr() (*ProviderOrderToken, error) {
+	if e.OrderToken != nil {
+		return e.OrderToken, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerordertoken.Label}
+	}
+	return nil, &NotLoadedError{edge: "order_token"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderRateExclusion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerrateexclusion.FieldRate, providerrateexclusion.FieldMarketRate, providerrateexclusion.FieldDeviation:
+			values[i] = new(decimal.Decimal)
+		case providerrateexclusion.FieldReason:
+			values[i] = new(sql.NullString)
+		case providerrateexclusion.FieldCreatedAt, providerrateexclusion.FieldUpdatedAt, providerrateexclusion.FieldNotifiedAt, providerrateexclusion.FieldAdjustedAt:
+			values[i] = new(sql.NullTime)
+		case providerrateexclusion.FieldID:
+			values[i] = new(uuid.UUID)
+		case providerrateexclusion.ForeignKeys[0]: // provider_order_token_rate_exclusion
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderRateExclusion fields.
+func (pre *ProviderRateExclusion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerrateexclusion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pre.ID = *value
+			}
+		case providerrateexclusion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pre.CreatedAt = value.Time
+			}
+		case providerrateexclusion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pre.UpdatedAt = value.Time
+			}
+		case providerrateexclusion.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				pre.Reason = providerrateexclusion.Reason(value.String)
+			}
+		case providerrateexclusion.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				pre.Rate = *value
+			}
+		case providerrateexclusion.FieldMarketRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field market_rate", values[i])
+			} else if value != nil {
+				pre.MarketRate = *value
+			}
+		case providerrateexclusion.FieldDeviation:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field deviation", values[i])
+			} else if value != nil {
+				pre.Deviation = *value
+			}
+		case providerrateexclusion.FieldNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_at", values[i])
+			} else if value.Valid {
+				pre.NotifiedAt = new(time.Time)
+				*pre.NotifiedAt = value.Time
+			}
+		case providerrateexclusion.FieldAdjustedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field adjusted_at", values[i])
+			} else if value.Valid {
+				pre.AdjustedAt = new(time.Time)
+				*pre.AdjustedAt = value.Time
+			}
+		case providerrateexclusion.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field provider_order_token_rate_exclusion", value)
+			} else if value.Valid {
+				pre.provider_order_token_rate_exclusion = new(int)
+				*pre.provider_order_token_rate_exclusion = int(value.Int64)
+			}
+		default:
+			pre.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderRateExclusion.
+// This includes values selected through modifiers, order, etc.
+func (pre *ProviderRateExclusion) Value(name string) (ent.Value, error) {
+	return pre.selectValues.Get(name)
+}
+
+// QueryOrderToken queries the "order_token" edge of the ProviderRateExclusion entity.
+func (pre *ProviderRateExclusion) QueryOrderToken() *ProviderOrderTokenQuery {
+	return NewProviderRateExclusionClient(pre.config).QueryOrderToken(pre)
+}
+
+// Update returns a builder for updating this ProviderRateExclusion.
+// Note that you need to call ProviderRateExclusion.Unwrap() before calling this method if this ProviderRateExclusion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pre *ProviderRateExclusion) Update() *ProviderRateExclusionUpdateOne {
+	return NewProviderRateExclusionClient(pre.config).UpdateOne(pre)
+}
+
+// Unwrap unwraps the ProviderRateExclusion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pre *ProviderRateExclusion) Unwrap() *ProviderRateExclusion {
+	_tx, ok := pre.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderRateExclusion is not a transactional entity")
+	}
+	pre.config.driver = _tx.drv
+	return pre
+}
+
+// String implements the fmt.Stringer.
+func (pre *ProviderRateExclusion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderRateExclusion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pre.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pre.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pre.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", pre.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", pre.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("market_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pre.MarketRate))
+	builder.WriteString(", ")
+	builder.WriteString("deviation=")
+	builder.WriteString(fmt.Sprintf("%v", pre.Deviation))
+	builder.WriteString(", ")
+	if v := pre.NotifiedAt; v != nil {
+		builder.WriteString("notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pre.AdjustedAt; v != nil {
+		builder.WriteString("adjusted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderRateExclusions is a parsable slice of ProviderRateExclusion.
+type ProviderRateExclusions []*ProviderRateExclusion
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerrateexclusion
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the providerrateexclusion type in the database.
+	Label = "provider_rate_exclusion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldMarketRate holds the string denoting the market_rate field in the database.
+	FieldMarketRate = "market_rate"
+	// FieldDeviation holds the string denoting the deviation field in the database.
+	FieldDeviation = "deviation"
+	// FieldNotifiedAt holds the string denoting the notified_at field in the database.
+	FieldNotifiedAt = "notified_at"
+	// FieldAdjustedAt holds the string denoting the adjusted_at field in the database.
+	FieldAdjustedAt = "adjusted_at"
+	// EdgeOrderToken holds the string denoting the order_token edge name in mutations.
+	EdgeOrderToken = "order_token"
+	// Table holds the table name of the providerrateexclusion in the database.
+	Table = "provider_rate_exclusions"
+	// OrderTokenTable is the table that holds the order_token relation/edge.
+	OrderTokenTable = "provider_rate_exclusions"
+	// OrderTokenInverseTable is the table name for the ProviderOrderToken entity.
+	// It exists in this package in order to avoid circular dependency with the "providerordertoken" package.
+	OrderTokenInverseTable = "provider_order_tokens"
+	// OrderTokenColumn is the table column denoting the order_token relation/edge.
+	OrderTokenColumn = "provider_order_token_rate_exclusion"
+)
+
+// Columns holds all SQL columns for providerrateexclusion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldReason,
+	FieldRate,
+	FieldMarketRate,
+	FieldDeviation,
+	FieldNotifiedAt,
+	FieldAdjustedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "provider_rate_exclusions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"provider_order_token_rate_exclusion",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonStaleRate Reason = "stale_rate"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonStaleRate:
+		return nil
+	default:
+		return fmt.Errorf("providerrateexclusion: invalid enum value for reason field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the ProviderRateExclusion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByMarketRate orders the results by the market_rate field.
+func ByMarketRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMarketRate, opts...).ToFunc()
+}
+
+// ByDeviation orders the results by the deviation field.
+func ByDeviation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviation, opts...).ToFunc()
+}
+
+// ByNotifiedAt orders the results by the notified_at field.
+func ByNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifiedAt, opts...).ToFunc()
+}
+
+// ByAdjustedAt orders the results by the adjusted_at field.
+func ByAdjustedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdjustedAt, opts...).ToFunc()
+}
+
+// ByOrderTokenField orders the results by order_token field.
+func ByOrderTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderTokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, OrderTokenTable, OrderTokenColumn),
+	)
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerrateexclusion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldRate, v))
+}
+
+// MarketRate applies equality check predicate on the "market_rate" field. It's identical to MarketRateEQ.
+func MarketRate(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldMarketRate, v))
+}
+
+// Deviation applies equality check predicate on the "deviation" field. It's identical to DeviationEQ.
+func Deviation(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldDeviation, v))
+}
+
+// NotifiedAt applies equality check predicate on the "notified_at" field. It's identical to NotifiedAtEQ.
+func NotifiedAt(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// AdjustedAt applies equality check predicate on the "adjusted_at" field. It's identical to AdjustedAtEQ.
+func AdjustedAt(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldAdjustedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldRate, v))
+}
+
+// MarketRateEQ applies the EQ predicate on the "market_rate" field.
+func MarketRateEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldMarketRate, v))
+}
+
+// MarketRateNEQ applies the NEQ predicate on the "market_rate" field.
+func MarketRateNEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldMarketRate, v))
+}
+
+// MarketRateIn applies the In predicate on the "market_rate" field.
+func MarketRateIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldMarketRate, vs...))
+}
+
+// MarketRateNotIn applies the NotIn predicate on the "market_rate" field.
+func MarketRateNotIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldMarketRate, vs...))
+}
+
+// MarketRateGT applies the GT predicate on the "market_rate" field.
+func MarketRateGT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldMarketRate, v))
+}
+
+// MarketRateGTE applies the GTE predicate on the "market_rate" field.
+func MarketRateGTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldMarketRate, v))
+}
+
+// MarketRateLT applies the LT predicate on the "market_rate" field.
+func MarketRateLT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldMarketRate, v))
+}
+
+// MarketRateLTE applies the LTE predicate on the "market_rate" field.
+func MarketRateLTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldMarketRate, v))
+}
+
+// DeviationEQ applies the EQ predicate on the "deviation" field.
+func DeviationEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldDeviation, v))
+}
+
+// DeviationNEQ applies the NEQ predicate on the "deviation" field.
+func DeviationNEQ(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldDeviation, v))
+}
+
+// DeviationIn applies the In predicate on the "deviation" field.
+func DeviationIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldDeviation, vs...))
+}
+
+// DeviationNotIn applies the NotIn predicate on the "deviation" field.
+func DeviationNotIn(vs ...decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldDeviation, vs...))
+}
+
+// DeviationGT applies the GT predicate on the "deviation" field.
+func DeviationGT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldDeviation, v))
+}
+
+// DeviationGTE applies the GTE predicate on the "deviation" field.
+func DeviationGTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldDeviation, v))
+}
+
+// DeviationLT applies the LT predicate on the "deviation" field.
+func DeviationLT(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldDeviation, v))
+}
+
+// DeviationLTE applies the LTE predicate on the "deviation" field.
+func DeviationLTE(v decimal.Decimal) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldDeviation, v))
+}
+
+// NotifiedAtEQ applies the EQ predicate on the "notified_at" field.
+func NotifiedAtEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtNEQ applies the NEQ predicate on the "notified_at" field.
+func NotifiedAtNEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIn applies the In predicate on the "notified_at" field.
+func NotifiedAtIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtNotIn applies the NotIn predicate on the "notified_at" field.
+func NotifiedAtNotIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtGT applies the GT predicate on the "notified_at" field.
+func NotifiedAtGT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtGTE applies the GTE predicate on the "notified_at" field.
+func NotifiedAtGTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLT applies the LT predicate on the "notified_at" field.
+func NotifiedAtLT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLTE applies the LTE predicate on the "notified_at" field.
+func NotifiedAtLTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIsNil applies the IsNil predicate on the "notified_at" field.
+func NotifiedAtIsNil() predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIsNull(FieldNotifiedAt))
+}
+
+// NotifiedAtNotNil applies the NotNil predicate on the "notified_at" field.
+func NotifiedAtNotNil() predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotNull(FieldNotifiedAt))
+}
+
+// AdjustedAtEQ applies the EQ predicate on the "adjusted_at" field.
+func AdjustedAtEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldEQ(FieldAdjustedAt, v))
+}
+
+// AdjustedAtNEQ applies the NEQ predicate on the "adjusted_at" field.
+func AdjustedAtNEQ(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNEQ(FieldAdjustedAt, v))
+}
+
+// AdjustedAtIn applies the In predicate on the "adjusted_at" field.
+func AdjustedAtIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIn(FieldAdjustedAt, vs...))
+}
+
+// AdjustedAtNotIn applies the NotIn predicate on the "adjusted_at" field.
+func AdjustedAtNotIn(vs ...time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotIn(FieldAdjustedAt, vs...))
+}
+
+// AdjustedAtGT applies the GT predicate on the "adjusted_at" field.
+func AdjustedAtGT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGT(FieldAdjustedAt, v))
+}
+
+// AdjustedAtGTE applies the GTE predicate on the "adjusted_at" field.
+func AdjustedAtGTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldGTE(FieldAdjustedAt, v))
+}
+
+// AdjustedAtLT applies the LT predicate on the "adjusted_at" field.
+func AdjustedAtLT(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLT(FieldAdjustedAt, v))
+}
+
+// AdjustedAtLTE applies the LTE predicate on the "adjusted_at" field.
+func AdjustedAtLTE(v time.Time) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldLTE(FieldAdjustedAt, v))
+}
+
+// AdjustedAtIsNil applies the IsNil predicate on the "adjusted_at" field.
+func AdjustedAtIsNil() predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldIsNull(FieldAdjustedAt))
+}
+
+// AdjustedAtNotNil applies the NotNil predicate on the "adjusted_at" field.
+func AdjustedAtNotNil() predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.FieldNotNull(FieldAdjustedAt))
+}
+
+// HasOrderToken applies the HasEdge predicate on the "order_token" edge.
+func HasOrderToken() predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, OrderTokenTable, OrderTokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderTokenWith applies the HasEdge predicate on the "order_token" edge with a given conditions (other predicates).
+func HasOrderTokenWith(preds ...predicate.ProviderOrderToken) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(func(s *sql.Selector) {
+		step := newOrderTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderRateExclusion) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderRateExclusion) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderRateExclusion) predicate.ProviderRateExclusion {
+	return predicate.ProviderRateExclusion(sql.NotPredicates(p))
+}
@@ -0,0 +1,1018 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderRateExclusionCreate is the builder for creating a ProviderRateExclusion entity.
+type ProviderRateExclusionCreate struct {
+	config
+	mutation *ProviderRateExclusionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prec *ProviderRateExclusionCreate) SetCreatedAt(t time.Time) *ProviderRateExclusionCreate {
+	prec.mutation.SetCreatedAt(t)
+	return prec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prec *ProviderRateExclusionCreate) SetNillableCreatedAt(t *time.Time) *ProviderRateExclusionCreate {
+	if t != nil {
+		prec.SetCreatedAt(*t)
+	}
+	return prec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prec *ProviderRateExclusionCreate) SetUpdatedAt(t time.Time) *ProviderRateExclusionCreate {
+	prec.mutation.SetUpdatedAt(t)
+	return prec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prec *ProviderRateExclusionCreate) SetNillableUpdatedAt(t *time.Time) *ProviderRateExclusionCreate {
+	if t != nil {
+		prec.SetUpdatedAt(*t)
+	}
+	return prec
+}
+
+// SetReason sets the "reason" field.
+func (prec *ProviderRateExclusionCreate) SetReason(pr providerrateexclusion.Reason) *ProviderRateExclusionCreate {
+	prec.mutation.SetReason(pr)
+	return prec
+}
+
+// SetRate sets the "rate" field.
+func (prec *ProviderRateExclusionCreate) SetRate(d decimal.Decimal) *ProviderRateExclusionCreate {
+	prec.mutation.SetRate(d)
+	return prec
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (prec *ProviderRateExclusionCreate) SetMarketRate(d decimal.Decimal) *ProviderRateExclusionCreate {
+	prec.mutation.SetMarketRate(d)
+	return prec
+}
+
+// SetDeviation sets the "deviation" field.
+func (prec *ProviderRateExclusionCreate) SetDeviation(d decimal.Decimal) *ProviderRateExclusionCreate {
+	prec.mutation.SetDeviation(d)
+	return prec
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (prec *ProviderRateExclusionCreate) SetNotifiedAt(t time.Time) *ProviderRateExclusionCreate {
+	prec.mutation.SetNotifiedAt(t)
+	return prec
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (prec *ProviderRateExclusionCreate) SetNillableNotifiedAt(t *time.Time) *ProviderRateExclusionCreate {
+	if t != nil {
+		prec.SetNotifiedAt(*t)
+	}
+	return prec
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (prec *ProviderRateExclusionCreate) SetAdjustedAt(t time.Time) *ProviderRateExclusionCreate {
+	prec.mutation.SetAdjustedAt(t)
+	return prec
+}
+
+// SetNillableAdjustedAt sets the "adjusted_at" field if the given value is not nil.
+func (prec *ProviderRateExclusionCreate) SetNillableAdjustedAt(t *time.Time) *ProviderRateExclusionCreate {
+	if t != nil {
+		prec.SetAdjustedAt(*t)
+	}
+	return prec
+}
+
+// SetID sets the "id" field.
+func (prec *ProviderRateExclusionCreate) SetID(u uuid.UUID) *ProviderRateExclusionCreate {
+	prec.mutation.SetID(u)
+	return prec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prec *ProviderRateExclusionCreate) SetNillableID(u *uuid.UUID) *ProviderRateExclusionCreate {
+	if u != nil {
+		prec.SetID(*u)
+	}
+	return prec
+}
+
+// SetOrderTokenID sets the "order_token" edge to the ProviderOrderToken entity by ID.
+func (prec *ProviderRateExclusionCreate) SetOrderTokenID(id int) *ProviderRateExclusionCreate {
+	prec.mutation.SetOrderTokenID(id)
+	return prec
+}
+
+// SetOrderToken sets the "order_token" edge to the ProviderOrderToken entity.
+func (prec *ProviderRateExclusionCreate) SetOrderToken(p *ProviderOrderToken) *ProviderRateExclusionCreate {
+	return prec.SetOrderTokenID(p.ID)
+}
+
+// Mutation returns the ProviderRateExclusionMutation object of the builder.
+func (prec *ProviderRateExclusionCreate) Mutation() *ProviderRateExclusionMutation {
+	return prec.mutation
+}
+
+// Save creates the ProviderRateExclusion in the database.
+func (prec *ProviderRateExclusionCreate) Save(ctx context.Context) (*ProviderRateExclusion, error) {
+	prec.defaults()
+	return withHooks(ctx, prec.sqlSave, prec.mutation, prec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prec *ProviderRateExclusionCreate) SaveX(ctx context.Context) *ProviderRateExclusion {
+	v, err := prec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prec *ProviderRateExclusionCreate) Exec(ctx context.Context) error {
+	_, err := prec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prec *ProviderRateExclusionCreate) ExecX(ctx context.Context) {
+	if err := prec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prec *ProviderRateExclusionCreate) defaults() {
+	if _, ok := prec.mutation.CreatedAt(); !ok {
+		v := providerrateexclusion.DefaultCreatedAt()
+		prec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prec.mutation.UpdatedAt(); !ok {
+		v := providerrateexclusion.DefaultUpdatedAt()
+		prec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prec.mutation.ID(); !ok {
+		v := providerrateexclusion.DefaultID()
+		prec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prec *ProviderRateExclusionCreate) check() error {
+	if _, ok := prec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderRateExclusion.created_at"`)}
+	}
+	if _, ok := prec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProviderRateExclusion.updated_at"`)}
+	}
+	if _, ok := prec.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ProviderRateExclusion.reason"`)}
+	}
+	if v, ok := prec.mutation.Reason(); ok {
+		if err := providerrateexclusion.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProviderRateExclusion.reason": %w`, err)}
+		}
+	}
+	if _, ok := prec.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ProviderRateExclusion.rate"`)}
+	}
+	if _, ok := prec.mutation.MarketRate(); !ok {
+		return &ValidationError{Name: "market_rate", err: errors.New(`ent: missing required field "ProviderRateExclusion.market_rate"`)}
+	}
+	if _, ok := prec.mutation.Deviation(); !ok {
+		return &ValidationError{Name: "deviation", err: errors.New(`ent: missing required field "ProviderRateExclusion.deviation"`)}
+	}
+	if len(prec.mutation.OrderTokenIDs()) == 0 {
+		return &ValidationError{Name: "order_token", err: errors.New(`ent: missing required edge "ProviderRateExclusion.order_token"`)}
+	}
+	return nil
+}
+
+func (prec *ProviderRateExclusionCreate) sqlSave(ctx context.Context) (*ProviderRateExclusion, error) {
+	if err := prec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prec.mutation.id = &_node.ID
+	prec.mutation.done = true
+	return _node, nil
+}
+
+func (prec *ProviderRateExclusionCreate) createSpec() (*ProviderRateExclusion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderRateExclusion{config: prec.config}
+		_spec = sqlgraph.NewCreateSpec(providerrateexclusion.Table, sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prec.conflict
+	if id, ok := prec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prec.mutation.CreatedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prec.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prec.mutation.Reason(); ok {
+		_spec.SetField(providerrateexclusion.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := prec.mutation.Rate(); ok {
+		_spec.SetField(providerrateexclusion.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := prec.mutation.MarketRate(); ok {
+		_spec.SetField(providerrateexclusion.FieldMarketRate, field.TypeFloat64, value)
+		_node.MarketRate = value
+	}
+	if value, ok := prec.mutation.Deviation(); ok {
+		_spec.SetField(providerrateexclusion.FieldDeviation, field.TypeFloat64, value)
+		_node.Deviation = value
+	}
+	if value, ok := prec.mutation.NotifiedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldNotifiedAt, field.TypeTime, value)
+		_node.NotifiedAt = &value
+	}
+	if value, ok := prec.mutation.AdjustedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldAdjustedAt, field.TypeTime, value)
+		_node.AdjustedAt = &value
+	}
+	if nodes := prec.mutation.OrderTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerrateexclusion.OrderTokenTable,
+			Columns: []string{providerrateexclusion.OrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_order_token_rate_exclusion = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderRateExclusion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderRateExclusionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prec *ProviderRateExclusionCreate) OnConflict(opts ...sql.ConflictOption) *ProviderRateExclusionUpsertOne {
+	prec.conflict = opts
+	return &ProviderRateExclusionUpsertOne{
+		create: prec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prec *ProviderRateExclusionCreate) OnConflictColumns(columns ...string) *ProviderRateExclusionUpsertOne {
+	prec.conflict = append(prec.conflict, sql.ConflictColumns(columns...))
+	return &ProviderRateExclusionUpsertOne{
+		create: prec,
+	}
+}
+
+type (
+	// ProviderRateExclusionUpsertOne is the builder for "upsert"-ing
+	//  one ProviderRateExclusion node.
+	ProviderRateExclusionUpsertOne struct {
+		create *ProviderRateExclusionCreate
+	}
+
+	// ProviderRateExclusionUpsert is the "OnConflict" setter.
+	ProviderRateExclusionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderRateExclusionUpsert) SetUpdatedAt(v time.Time) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateUpdatedAt() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldUpdatedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ProviderRateExclusionUpsert) SetReason(v providerrateexclusion.Reason) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateReason() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldReason)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *ProviderRateExclusionUpsert) SetRate(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateRate() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ProviderRateExclusionUpsert) AddRate(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Add(providerrateexclusion.FieldRate, v)
+	return u
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *ProviderRateExclusionUpsert) SetMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldMarketRate, v)
+	return u
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateMarketRate() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldMarketRate)
+	return u
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *ProviderRateExclusionUpsert) AddMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Add(providerrateexclusion.FieldMarketRate, v)
+	return u
+}
+
+// SetDeviation sets the "deviation" field.
+func (u *ProviderRateExclusionUpsert) SetDeviation(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldDeviation, v)
+	return u
+}
+
+// UpdateDeviation sets the "deviation" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateDeviation() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldDeviation)
+	return u
+}
+
+// AddDeviation adds v to the "deviation" field.
+func (u *ProviderRateExclusionUpsert) AddDeviation(v decimal.Decimal) *ProviderRateExclusionUpsert {
+	u.Add(providerrateexclusion.FieldDeviation, v)
+	return u
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (u *ProviderRateExclusionUpsert) SetNotifiedAt(v time.Time) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldNotifiedAt, v)
+	return u
+}
+
+// UpdateNotifiedAt sets the "notified_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateNotifiedAt() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldNotifiedAt)
+	return u
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (u *ProviderRateExclusionUpsert) ClearNotifiedAt() *ProviderRateExclusionUpsert {
+	u.SetNull(providerrateexclusion.FieldNotifiedAt)
+	return u
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsert) SetAdjustedAt(v time.Time) *ProviderRateExclusionUpsert {
+	u.Set(providerrateexclusion.FieldAdjustedAt, v)
+	return u
+}
+
+// UpdateAdjustedAt sets the "adjusted_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsert) UpdateAdjustedAt() *ProviderRateExclusionUpsert {
+	u.SetExcluded(providerrateexclusion.FieldAdjustedAt)
+	return u
+}
+
+// ClearAdjustedAt clears the value of the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsert) ClearAdjustedAt() *ProviderRateExclusionUpsert {
+	u.SetNull(providerrateexclusion.FieldAdjustedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerrateexclusion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderRateExclusionUpsertOne) UpdateNewValues() *ProviderRateExclusionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(providerrateexclusion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(providerrateexclusion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderRateExclusionUpsertOne) Ignore() *ProviderRateExclusionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderRateExclusionUpsertOne) DoNothing() *ProviderRateExclusionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderRateExclusionCreate.OnConflict
+// documentation for more info.
+func (u *ProviderRateExclusionUpsertOne) Update(set func(*ProviderRateExclusionUpsert)) *ProviderRateExclusionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderRateExclusionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderRateExclusionUpsertOne) SetUpdatedAt(v time.Time) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateUpdatedAt() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ProviderRateExclusionUpsertOne) SetReason(v providerrateexclusion.Reason) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateReason() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ProviderRateExclusionUpsertOne) SetRate(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ProviderRateExclusionUpsertOne) AddRate(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateRate() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *ProviderRateExclusionUpsertOne) SetMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetMarketRate(v)
+	})
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *ProviderRateExclusionUpsertOne) AddMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddMarketRate(v)
+	})
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateMarketRate() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateMarketRate()
+	})
+}
+
+// SetDeviation sets the "deviation" field.
+func (u *ProviderRateExclusionUpsertOne) SetDeviation(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetDeviation(v)
+	})
+}
+
+// AddDeviation adds v to the "deviation" field.
+func (u *ProviderRateExclusionUpsertOne) AddDeviation(v decimal.Decimal) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddDeviation(v)
+	})
+}
+
+// UpdateDeviation sets the "deviation" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateDeviation() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateDeviation()
+	})
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (u *ProviderRateExclusionUpsertOne) SetNotifiedAt(v time.Time) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetNotifiedAt(v)
+	})
+}
+
+// UpdateNotifiedAt sets the "notified_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateNotifiedAt() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateNotifiedAt()
+	})
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (u *ProviderRateExclusionUpsertOne) ClearNotifiedAt() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.ClearNotifiedAt()
+	})
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsertOne) SetAdjustedAt(v time.Time) *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetAdjustedAt(v)
+	})
+}
+
+// UpdateAdjustedAt sets the "adjusted_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertOne) UpdateAdjustedAt() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateAdjustedAt()
+	})
+}
+
+// ClearAdjustedAt clears the value of the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsertOne) ClearAdjustedAt() *ProviderRateExclusionUpsertOne {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.ClearAdjustedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderRateExclusionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderRateExclusionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderRateExclusionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderRateExclusionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProviderRateExclusionUpsertOne.ID is not supported by MySQL driver. Use ProviderRateExclusionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderRateExclusionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderRateExclusionCreateBulk is the builder for creating many ProviderRateExclusion entities in bulk.
+type ProviderRateExclusionCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderRateExclusionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderRateExclusion entities in the database.
+func (precb *ProviderRateExclusionCreateBulk) Save(ctx context.Context) ([]*ProviderRateExclusion, error) {
+	if precb.err != nil {
+		return nil, precb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(precb.builders))
+	nodes := make([]*ProviderRateExclusion, len(precb.builders))
+	mutators := make([]Mutator, len(precb.builders))
+	for i := range precb.builders {
+		func(i int, root context.Context) {
+			builder := precb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderRateExclusionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, precb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = precb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, precb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, precb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (precb *ProviderRateExclusionCreateBulk) SaveX(ctx context.Context) []*ProviderRateExclusion {
+	v, err := precb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (precb *ProviderRateExclusionCreateBulk) Exec(ctx context.Context) error {
+	_, err := precb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (precb *ProviderRateExclusionCreateBulk) ExecX(ctx context.Context) {
+	if err := precb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderRateExclusion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderRateExclusionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (precb *ProviderRateExclusionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderRateExclusionUpsertBulk {
+	precb.conflict = opts
+	return &ProviderRateExclusionUpsertBulk{
+		create: precb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (precb *ProviderRateExclusionCreateBulk) OnConflictColumns(columns ...string) *ProviderRateExclusionUpsertBulk {
+	precb.conflict = append(precb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderRateExclusionUpsertBulk{
+		create: precb,
+	}
+}
+
+// ProviderRateExclusionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderRateExclusion nodes.
+type ProviderRateExclusionUpsertBulk struct {
+	create *ProviderRateExclusionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerrateexclusion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderRateExclusionUpsertBulk) UpdateNewValues() *ProviderRateExclusionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(providerrateexclusion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(providerrateexclusion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderRateExclusion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderRateExclusionUpsertBulk) Ignore() *ProviderRateExclusionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderRateExclusionUpsertBulk) DoNothing() *ProviderRateExclusionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderRateExclusionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderRateExclusionUpsertBulk) Update(set func(*ProviderRateExclusionUpsert)) *ProviderRateExclusionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderRateExclusionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderRateExclusionUpsertBulk) SetUpdatedAt(v time.Time) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateUpdatedAt() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ProviderRateExclusionUpsertBulk) SetReason(v providerrateexclusion.Reason) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateReason() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ProviderRateExclusionUpsertBulk) SetRate(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ProviderRateExclusionUpsertBulk) AddRate(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateRate() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *ProviderRateExclusionUpsertBulk) SetMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetMarketRate(v)
+	})
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *ProviderRateExclusionUpsertBulk) AddMarketRate(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddMarketRate(v)
+	})
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateMarketRate() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateMarketRate()
+	})
+}
+
+// SetDeviation sets the "deviation" field.
+func (u *ProviderRateExclusionUpsertBulk) SetDeviation(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetDeviation(v)
+	})
+}
+
+// AddDeviation adds v to the "deviation" field.
+func (u *ProviderRateExclusionUpsertBulk) AddDeviation(v decimal.Decimal) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.AddDeviation(v)
+	})
+}
+
+// UpdateDeviation sets the "deviation" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateDeviation() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateDeviation()
+	})
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (u *ProviderRateExclusionUpsertBulk) SetNotifiedAt(v time.Time) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetNotifiedAt(v)
+	})
+}
+
+// UpdateNotifiedAt sets the "notified_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateNotifiedAt() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateNotifiedAt()
+	})
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (u *ProviderRateExclusionUpsertBulk) ClearNotifiedAt() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.ClearNotifiedAt()
+	})
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsertBulk) SetAdjustedAt(v time.Time) *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.SetAdjustedAt(v)
+	})
+}
+
+// UpdateAdjustedAt sets the "adjusted_at" field to the value that was provided on create.
+func (u *ProviderRateExclusionUpsertBulk) UpdateAdjustedAt() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.UpdateAdjustedAt()
+	})
+}
+
+// ClearAdjustedAt clears the value of the "adjusted_at" field.
+func (u *ProviderRateExclusionUpsertBulk) ClearAdjustedAt() *ProviderRateExclusionUpsertBulk {
+	return u.Update(func(s *ProviderRateExclusionUpsert) {
+		s.ClearAdjustedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderRateExclusionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderRateExclusionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderRateExclusionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderRateExclusionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+)
+
+// ProviderRateExclusionDelete is the builder for deleting a ProviderRateExclusion entity.
+type ProviderRateExclusionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderRateExclusionMutation
+}
+
+// Where appends a list predicates to the ProviderRateExclusionDelete builder.
+func (pred *ProviderRateExclusionDelete) Where(ps ...predicate.ProviderRateExclusion) *ProviderRateExclusionDelete {
+	pred.mutation.Where(ps...)
+	return pred
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pred *ProviderRateExclusionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pred.sqlExec, pred.mutation, pred.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pred *ProviderRateExclusionDelete) ExecX(ctx context.Context) int {
+	n, err := pred.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pred *ProviderRateExclusionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerrateexclusion.Table, sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID))
+	if ps := pred.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pred.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pred.mutation.done = true
+	return affected, err
+}
+
+// ProviderRateExclusionDeleteOne is the builder for deleting a single ProviderRateExclusion entity.
+type ProviderRateExclusionDeleteOne struct {
+	pred *ProviderRateExclusionDelete
+}
+
+// Where appends a list predicates to the ProviderRateExclusionDelete builder.
+func (predo *ProviderRateExclusionDeleteOne) Where(ps ...predicate.ProviderRateExclusion) *ProviderRateExclusionDeleteOne {
+	predo.pred.mutation.Where(ps...)
+	return predo
+}
+
+// Exec executes the deletion query.
+func (predo *ProviderRateExclusionDeleteOne) Exec(ctx context.Context) error {
+	n, err := predo.pred.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerrateexclusion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (predo *ProviderRateExclusionDeleteOne) ExecX(ctx context.Context) {
+	if err := predo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+)
+
+// ProviderRateExclusionQuery is the builder for querying ProviderRateExclusion entities.
+type ProviderRateExclusionQuery struct {
+	config
+	ctx            *QueryContext
+	order          []providerrateexclusion.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.ProviderRateExclusion
+	withOrderToken *ProviderOrderTokenQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderRateExclusionQuery builder.
+func (preq *ProviderRateExclusionQuery) Where(ps ...predicate.ProviderRateExclusion) *ProviderRateExclusionQuery {
+	preq.predicates = append(preq.predicates, ps...)
+	return preq
+}
+
+// Limit the number of records to be returned by this query.
+func (preq *ProviderRateExclusionQuery) Limit(limit int) *ProviderRateExclusionQuery {
+	preq.ctx.Limit = &limit
+	return preq
+}
+
+// Offset to start from.
+func (preq *ProviderRateExclusionQuery) Offset(offset int) *ProviderRateExclusionQuery {
+	preq.ctx.Offset = &offset
+	return preq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (preq *ProviderRateExclusionQuery) Unique(unique bool) *ProviderRateExclusionQuery {
+	preq.ctx.Unique = &unique
+	return preq
+}
+
+// Order specifies how the records should be ordered.
+func (preq *ProviderRateExclusionQuery) Order(o ...providerrateexclusion.OrderOption) *ProviderRateExclusionQuery {
+	preq.order = append(preq.order, o...)
+	return preq
+}
+
+// QueryOrderToken chains the current query on the "order_token" edge.
+func (preq *ProviderRateExclusionQuery) QueryOrderToken() *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: preq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := preq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := preq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerrateexclusion.Table, providerrateexclusion.FieldID, selector),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerrateexclusion.OrderTokenTable, providerrateexclusion.OrderTokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(preq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderRateExclusion entity from the query.
+// Returns a *NotFoundError when no ProviderRateExclusion was found.
+func (preq *ProviderRateExclusionQuery) First(ctx context.Context) (*ProviderRateExclusion, error) {
+	nodes, err := preq.Limit(1).All(setContextOp(ctx, preq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerrateexclusion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) FirstX(ctx context.Context) *ProviderRateExclusion {
+	node, err := preq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderRateExclusion ID from the query.
+// Returns a *NotFoundError when no ProviderRateExclusion ID was found.
+func (preq *ProviderRateExclusionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = preq.Limit(1).IDs(setContextOp(ctx, preq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerrateexclusion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := preq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderRateExclusion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderRateExclusion entity is found.
+// Returns a *NotFoundError when no ProviderRateExclusion entities are found.
+func (preq *ProviderRateExclusionQuery) Only(ctx context.Context) (*ProviderRateExclusion, error) {
+	nodes, err := preq.Limit(2).All(setContextOp(ctx, preq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerrateexclusion.Label}
+	default:
+		return nil, &NotSingularError{providerrateexclusion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) OnlyX(ctx context.Context) *ProviderRateExclusion {
+	node, err := preq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderRateExclusion ID in the query.
+// Returns a *NotSingularError when more than one ProviderRateExclusion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (preq *ProviderRateExclusionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = preq.Limit(2).IDs(setContextOp(ctx, preq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerrateexclusion.Label}
+	default:
+		err = &NotSingularError{providerrateexclusion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := preq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderRateExclusions.
+func (preq *ProviderRateExclusionQuery) All(ctx context.Context) ([]*ProviderRateExclusion, error) {
+	ctx = setContextOp(ctx, preq.ctx, ent.OpQueryAll)
+	if err := preq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderRateExclusion, *ProviderRateExclusionQuery]()
+	return withInterceptors[[]*ProviderRateExclusion](ctx, preq, qr, preq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) AllX(ctx context.Context) []*ProviderRateExclusion {
+	nodes, err := preq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderRateExclusion IDs.
+func (preq *ProviderRateExclusionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if preq.ctx.Unique == nil && preq.path != nil {
+		preq.Unique(true)
+	}
+	ctx = setContextOp(ctx, preq.ctx, ent.OpQueryIDs)
+	if err = preq.Select(providerrateexclusion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := preq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (preq *ProviderRateExclusionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, preq.ctx, ent.OpQueryCount)
+	if err := preq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, preq, querierCount[*ProviderRateExclusionQuery](), preq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) CountX(ctx context.Context) int {
+	count, err := preq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (preq *ProviderRateExclusionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, preq.ctx, ent.OpQueryExist)
+	switch _, err := preq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (preq *ProviderRateExclusionQuery) ExistX(ctx context.Context) bool {
+	exist, err := preq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderRateExclusionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (preq *ProviderRateExclusionQuery) Clone() *ProviderRateExclusionQuery {
+	if preq == nil {
+		return nil
+	}
+	return &ProviderRateExclusionQuery{
+		config:         preq.config,
+		ctx:            preq.ctx.Clone(),
+		order:          append([]providerrateexclusion.OrderOption{}, preq.order...),
+		inters:         append([]Interceptor{}, preq.inters...),
+		predicates:     append([]predicate.ProviderRateExclusion{}, preq.predicates...),
+		withOrderToken: preq.withOrderToken.Clone(),
+		// clone intermediate query.
+		sql:  preq.sql.Clone(),
+		path: preq.path,
+	}
+}
+
+// WithOrderToken tells the query-builder to eager-load the nodes that are connected to
+// the "order_token" edge. The optional arguments are used to configure the query builder of the edge.
+func (preq *ProviderRateExclusionQuery) WithOrderToken(opts ...func(*ProviderOrderTokenQuery)) *ProviderRateExclusionQuery {
+	query := (&ProviderOrderTokenClient{config: preq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	preq.withOrderToken = query
+	return preq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderRateExclusion.Query().
+//		GroupBy(providerrateexclusion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (preq *ProviderRateExclusionQuery) GroupBy(field string, fields ...string) *ProviderRateExclusionGroupBy {
+	preq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderRateExclusionGroupBy{build: preq}
+	grbuild.flds = &preq.ctx.Fields
+	grbuild.label = providerrateexclusion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProviderRateExclusion.Query().
+//		Select(providerrateexclusion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (preq *ProviderRateExclusionQuery) Select(fields ...string) *ProviderRateExclusionSelect {
+	preq.ctx.Fields = append(preq.ctx.Fields, fields...)
+	sbuild := &ProviderRateExclusionSelect{ProviderRateExclusionQuery: preq}
+	sbuild.label = providerrateexclusion.Label
+	sbuild.flds, sbuild.scan = &preq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderRateExclusionSelect configured with the given aggregations.
+func (preq *ProviderRateExclusionQuery) Aggregate(fns ...AggregateFunc) *ProviderRateExclusionSelect {
+	return preq.Select().Aggregate(fns...)
+}
+
+func (preq *ProviderRateExclusionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range preq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, preq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range preq.ctx.Fields {
+		if !providerrateexclusion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if preq.path != nil {
+		prev, err := preq.path(ctx)
+		if err != nil {
+			return err
+		}
+		preq.sql = prev
+	}
+	return nil
+}
+
+func (preq *ProviderRateExclusionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderRateExclusion, error) {
+	var (
+		nodes       = []*ProviderRateExclusion{}
+		withFKs     = preq.withFKs
+		_spec       = preq.querySpec()
+		loadedTypes = [1]bool{
+			preq.withOrderToken != nil,
+		}
+	)
+	if preq.withOrderToken != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerrateexclusion.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderRateExclusion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderRateExclusion{config: preq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, preq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := preq.withOrderToken; query != nil {
+		if err := preq.loadOrderToken(ctx, query, nodes, nil,
+			func(n *ProviderRateExclusion, e *ProviderOrderToken) { n.Edges.OrderToken = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (preq *ProviderRateExclusionQuery) loadOrderToken(ctx context.Context, query *ProviderOrderTokenQuery, nodes []*ProviderRateExclusion, init func(*ProviderRateExclusion), assign func(*ProviderRateExclusion, *ProviderOrderToken)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProviderRateExclusion)
+	for i := range nodes {
+		if nodes[i].provider_order_token_rate_exclusion == nil {
+			continue
+		}
+		fk := *nodes[i].provider_order_token_rate_exclusion
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerordertoken.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_order_token_rate_exclusion" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (preq *ProviderRateExclusionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := preq.querySpec()
+	_spec.Node.Columns = preq.ctx.Fields
+	if len(preq.ctx.Fields) > 0 {
+		_spec.Unique = preq.ctx.Unique != nil && *preq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, preq.driver, _spec)
+}
+
+func (preq *ProviderRateExclusionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerrateexclusion.Table, providerrateexclusion.Columns, sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID))
+	_spec.From = preq.sql
+	if unique := preq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if preq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := preq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerrateexclusion.FieldID)
+		for i := range fields {
+			if fields[i] != providerrateexclusion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := preq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := preq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (preq *ProviderRateExclusionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(preq.driver.Dialect())
+	t1 := builder.Table(providerrateexclusion.Table)
+	columns := preq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerrateexclusion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if preq.sql != nil {
+		selector = preq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if preq.ctx.Unique != nil && *preq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range preq.predicates {
+		p(selector)
+	}
+	for _, p := range preq.order {
+		p(selector)
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderRateExclusionGroupBy is the group-by builder for ProviderRateExclusion entities.
+type ProviderRateExclusionGroupBy struct {
+	selector
+	build *ProviderRateExclusionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pregb *ProviderRateExclusionGroupBy) Aggregate(fns ...AggregateFunc) *ProviderRateExclusionGroupBy {
+	pregb.fns = append(pregb.fns, fns...)
+	return pregb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pregb *ProviderRateExclusionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pregb.build.ctx, ent.OpQueryGroupBy)
+	if err := pregb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderRateExclusionQuery, *ProviderRateExclusionGroupBy](ctx, pregb.build, pregb, pregb.build.inters, v)
+}
+
+func (pregb *ProviderRateExclusionGroupBy) sqlScan(ctx context.Context, root *ProviderRateExclusionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pregb.fns))
+	for _, fn := range pregb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pregb.flds)+len(pregb.fns))
+		for _, f := range *pregb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pregb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pregb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderRateExclusionSelect is the builder for selecting fields of ProviderRateExclusion entities.
+type ProviderRateExclusionSelect struct {
+	*ProviderRateExclusionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pres *ProviderRateExclusionSelect) Aggregate(fns ...AggregateFunc) *ProviderRateExclusionSelect {
+	pres.fns = append(pres.fns, fns...)
+	return pres
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pres *ProviderRateExclusionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pres.ctx, ent.OpQuerySelect)
+	if err := pres.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderRateExclusionQuery, *ProviderRateExclusionSelect](ctx, pres.ProviderRateExclusionQuery, pres, pres.inters, v)
+}
+
+func (pres *ProviderRateExclusionSelect) sqlScan(ctx context.Context, root *ProviderRateExclusionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pres.fns))
+	for _, fn := range pres.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pres.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pres.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
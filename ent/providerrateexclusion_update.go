@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderRateExclusionUpdate is the builder for updating ProviderRateExclusion entities.
+type ProviderRateExclusionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderRateExclusionMutation
+}
+
+// Where appends a list predicates to the ProviderRateExclusionUpdate builder.
+func (preu *ProviderRateExclusionUpdate) Where(ps ...predicate.ProviderRateExclusion) *ProviderRateExclusionUpdate {
+	preu.mutation.Where(ps...)
+	return preu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (preu *ProviderRateExclusionUpdate) SetUpdatedAt(t time.Time) *ProviderRateExclusionUpdate {
+	preu.mutation.SetUpdatedAt(t)
+	return preu
+}
+
+// SetReason sets the "reason" field.
+func (preu *ProviderRateExclusionUpdate) SetReason(pr providerrateexclusion.Reason) *ProviderRateExclusionUpdate {
+	preu.mutation.SetReason(pr)
+	return preu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableReason(pr *providerrateexclusion.Reason) *ProviderRateExclusionUpdate {
+	if pr != nil {
+		preu.SetReason(*pr)
+	}
+	return preu
+}
+
+// SetRate sets the "rate" field.
+func (preu *ProviderRateExclusionUpdate) SetRate(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.ResetRate()
+	preu.mutation.SetRate(d)
+	return preu
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableRate(d *decimal.Decimal) *ProviderRateExclusionUpdate {
+	if d != nil {
+		preu.SetRate(*d)
+	}
+	return preu
+}
+
+// AddRate adds d to the "rate" field.
+func (preu *ProviderRateExclusionUpdate) AddRate(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.AddRate(d)
+	return preu
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (preu *ProviderRateExclusionUpdate) SetMarketRate(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.ResetMarketRate()
+	preu.mutation.SetMarketRate(d)
+	return preu
+}
+
+// SetNillableMarketRate sets the "market_rate" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableMarketRate(d *decimal.Decimal) *ProviderRateExclusionUpdate {
+	if d != nil {
+		preu.SetMarketRate(*d)
+	}
+	return preu
+}
+
+// AddMarketRate adds d to the "market_rate" field.
+func (preu *ProviderRateExclusionUpdate) AddMarketRate(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.AddMarketRate(d)
+	return preu
+}
+
+// SetDeviation sets the "deviation" field.
+func (preu *ProviderRateExclusionUpdate) SetDeviation(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.ResetDeviation()
+	preu.mutation.SetDeviation(d)
+	return preu
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableDeviation(d *decimal.Decimal) *ProviderRateExclusionUpdate {
+	if d != nil {
+		preu.SetDeviation(*d)
+	}
+	return preu
+}
+
+// AddDeviation adds d to the "deviation" field.
+func (preu *ProviderRateExclusionUpdate) AddDeviation(d decimal.Decimal) *ProviderRateExclusionUpdate {
+	preu.mutation.AddDeviation(d)
+	return preu
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (preu *ProviderRateExclusionUpdate) SetNotifiedAt(t time.Time) *ProviderRateExclusionUpdate {
+	preu.mutation.SetNotifiedAt(t)
+	return preu
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableNotifiedAt(t *time.Time) *ProviderRateExclusionUpdate {
+	if t != nil {
+		preu.SetNotifiedAt(*t)
+	}
+	return preu
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (preu *ProviderRateExclusionUpdate) ClearNotifiedAt() *ProviderRateExclusionUpdate {
+	preu.mutation.ClearNotifiedAt()
+	return preu
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (preu *ProviderRateExclusionUpdate) SetAdjustedAt(t time.Time) *ProviderRateExclusionUpdate {
+	preu.mutation.SetAdjustedAt(t)
+	return preu
+}
+
+// SetNillableAdjustedAt sets the "adjusted_at" field if the given value is not nil.
+func (preu *ProviderRateExclusionUpdate) SetNillableAdjustedAt(t *time.Time) *ProviderRateExclusionUpdate {
+	if t != nil {
+		preu.SetAdjustedAt(*t)
+	}
+	return preu
+}
+
+// ClearAdjustedAt clears the value of the "adjusted_at" field.
+func (preu *ProviderRateExclusionUpdate) ClearAdjustedAt() *ProviderRateExclusionUpdate {
+	preu.mutation.ClearAdjustedAt()
+	return preu
+}
+
+// Mutation returns the ProviderRateExclusionMutation object of the builder.
+func (preu *ProviderRateExclusionUpdate) Mutation() *ProviderRateExclusionMutation {
+	return preu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (preu *ProviderRateExclusionUpdate) Save(ctx context.Context) (int, error) {
+	preu.defaults()
+	return withHooks(ctx, preu.sqlSave, preu.mutation, preu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preu *ProviderRateExclusionUpdate) SaveX(ctx context.Context) int {
+	affected, err := preu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (preu *ProviderRateExclusionUpdate) Exec(ctx context.Context) error {
+	_, err := preu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preu *ProviderRateExclusionUpdate) ExecX(ctx context.Context) {
+	if err := preu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (preu *ProviderRateExclusionUpdate) defaults() {
+	if _, ok := preu.mutation.UpdatedAt(); !ok {
+		v := providerrateexclusion.UpdateDefaultUpdatedAt()
+		preu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preu *ProviderRateExclusionUpdate) check() error {
+	if v, ok := preu.mutation.Reason(); ok {
+		if err := providerrateexclusion.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProviderRateExclusion.reason": %w`, err)}
+		}
+	}
+	if preu.mutation.OrderTokenCleared() && len(preu.mutation.OrderTokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderRateExclusion.order_token"`)
+	}
+	return nil
+}
+
+func (preu *ProviderRateExclusionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := preu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerrateexclusion.Table, providerrateexclusion.Columns, sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID))
+	if ps := preu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preu.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := preu.mutation.Reason(); ok {
+		_spec.SetField(providerrateexclusion.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := preu.mutation.Rate(); ok {
+		_spec.SetField(providerrateexclusion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.AddedRate(); ok {
+		_spec.AddField(providerrateexclusion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.MarketRate(); ok {
+		_spec.SetField(providerrateexclusion.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.AddedMarketRate(); ok {
+		_spec.AddField(providerrateexclusion.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.Deviation(); ok {
+		_spec.SetField(providerrateexclusion.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.AddedDeviation(); ok {
+		_spec.AddField(providerrateexclusion.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := preu.mutation.NotifiedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if preu.mutation.NotifiedAtCleared() {
+		_spec.ClearField(providerrateexclusion.FieldNotifiedAt, field.TypeTime)
+	}
+	if value, ok := preu.mutation.AdjustedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldAdjustedAt, field.TypeTime, value)
+	}
+	if preu.mutation.AdjustedAtCleared() {
+		_spec.ClearField(providerrateexclusion.FieldAdjustedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, preu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerrateexclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	preu.mutation.done = true
+	return n, nil
+}
+
+// ProviderRateExclusionUpdateOne is the builder for updating a single ProviderRateExclusion entity.
+type ProviderRateExclusionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderRateExclusionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetUpdatedAt(t time.Time) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.SetUpdatedAt(t)
+	return preuo
+}
+
+// SetReason sets the "reason" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetReason(pr providerrateexclusion.Reason) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.SetReason(pr)
+	return preuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableReason(pr *providerrateexclusion.Reason) *ProviderRateExclusionUpdateOne {
+	if pr != nil {
+		preuo.SetReason(*pr)
+	}
+	return preuo
+}
+
+// SetRate sets the "rate" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetRate(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.ResetRate()
+	preuo.mutation.SetRate(d)
+	return preuo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableRate(d *decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	if d != nil {
+		preuo.SetRate(*d)
+	}
+	return preuo
+}
+
+// AddRate adds d to the "rate" field.
+func (preuo *ProviderRateExclusionUpdateOne) AddRate(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.AddRate(d)
+	return preuo
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetMarketRate(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.ResetMarketRate()
+	preuo.mutation.SetMarketRate(d)
+	return preuo
+}
+
+// SetNillableMarketRate sets the "market_rate" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableMarketRate(d *decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	if d != nil {
+		preuo.SetMarketRate(*d)
+	}
+	return preuo
+}
+
+// AddMarketRate adds d to the "market_rate" field.
+func (preuo *ProviderRateExclusionUpdateOne) AddMarketRate(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.AddMarketRate(d)
+	return preuo
+}
+
+// SetDeviation sets the "deviation" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetDeviation(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.ResetDeviation()
+	preuo.mutation.SetDeviation(d)
+	return preuo
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableDeviation(d *decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	if d != nil {
+		preuo.SetDeviation(*d)
+	}
+	return preuo
+}
+
+// AddDeviation adds d to the "deviation" field.
+func (preuo *ProviderRateExclusionUpdateOne) AddDeviation(d decimal.Decimal) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.AddDeviation(d)
+	return preuo
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetNotifiedAt(t time.Time) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.SetNotifiedAt(t)
+	return preuo
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableNotifiedAt(t *time.Time) *ProviderRateExclusionUpdateOne {
+	if t != nil {
+		preuo.SetNotifiedAt(*t)
+	}
+	return preuo
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (preuo *ProviderRateExclusionUpdateOne) ClearNotifiedAt() *ProviderRateExclusionUpdateOne {
+	preuo.mutation.ClearNotifiedAt()
+	return preuo
+}
+
+// SetAdjustedAt sets the "adjusted_at" field.
+func (preuo *ProviderRateExclusionUpdateOne) SetAdjustedAt(t time.Time) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.SetAdjustedAt(t)
+	return preuo
+}
+
+// SetNillableAdjustedAt sets the "adjusted_at" field if the given value is not nil.
+func (preuo *ProviderRateExclusionUpdateOne) SetNillableAdjustedAt(t *time.Time) *ProviderRateExclusionUpdateOne {
+	if t != nil {
+		preuo.SetAdjustedAt(*t)
+	}
+	return preuo
+}
+
+// ClearAdjustedAt clears the value of the "adjusted_at" field.
+func (preuo *ProviderRateExclusionUpdateOne) ClearAdjustedAt() *ProviderRateExclusionUpdateOne {
+	preuo.mutation.ClearAdjustedAt()
+	return preuo
+}
+
+// Mutation returns the ProviderRateExclusionMutation object of the builder.
+func (preuo *ProviderRateExclusionUpdateOne) Mutation() *ProviderRateExclusionMutation {
+	return preuo.mutation
+}
+
+// Where appends a list predicates to the ProviderRateExclusionUpdate builder.
+func (preuo *ProviderRateExclusionUpdateOne) Where(ps ...predicate.ProviderRateExclusion) *ProviderRateExclusionUpdateOne {
+	preuo.mutation.Where(ps...)
+	return preuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (preuo *ProviderRateExclusionUpdateOne) Select(field string, fields ...string) *ProviderRateExclusionUpdateOne {
+	preuo.fields = append([]string{field}, fields...)
+	return preuo
+}
+
+// Save executes the query and returns the updated ProviderRateExclusion entity.
+func (preuo *ProviderRateExclusionUpdateOne) Save(ctx context.Context) (*ProviderRateExclusion, error) {
+	preuo.defaults()
+	return withHooks(ctx, preuo.sqlSave, preuo.mutation, preuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preuo *ProviderRateExclusionUpdateOne) SaveX(ctx context.Context) *ProviderRateExclusion {
+	node, err := preuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (preuo *ProviderRateExclusionUpdateOne) Exec(ctx context.Context) error {
+	_, err := preuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preuo *ProviderRateExclusionUpdateOne) ExecX(ctx context.Context) {
+	if err := preuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (preuo *ProviderRateExclusionUpdateOne) defaults() {
+	if _, ok := preuo.mutation.UpdatedAt(); !ok {
+		v := providerrateexclusion.UpdateDefaultUpdatedAt()
+		preuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preuo *ProviderRateExclusionUpdateOne) check() error {
+	if v, ok := preuo.mutation.Reason(); ok {
+		if err := providerrateexclusion.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProviderRateExclusion.reason": %w`, err)}
+		}
+	}
+	if preuo.mutation.OrderTokenCleared() && len(preuo.mutation.OrderTokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderRateExclusion.order_token"`)
+	}
+	return nil
+}
+
+func (preuo *ProviderRateExclusionUpdateOne) sqlSave(ctx context.Context) (_node *ProviderRateExclusion, err error) {
+	if err := preuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerrateexclusion.Table, providerrateexclusion.Columns, sqlgraph.NewFieldSpec(providerrateexclusion.FieldID, field.TypeUUID))
+	id, ok := preuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderRateExclusion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := preuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerrateexclusion.FieldID)
+		for _, f := range fields {
+			if !providerrateexclusion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerrateexclusion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := preuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := preuo.mutation.Reason(); ok {
+		_spec.SetField(providerrateexclusion.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := preuo.mutation.Rate(); ok {
+		_spec.SetField(providerrateexclusion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.AddedRate(); ok {
+		_spec.AddField(providerrateexclusion.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.MarketRate(); ok {
+		_spec.SetField(providerrateexclusion.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.AddedMarketRate(); ok {
+		_spec.AddField(providerrateexclusion.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.Deviation(); ok {
+		_spec.SetField(providerrateexclusion.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.AddedDeviation(); ok {
+		_spec.AddField(providerrateexclusion.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := preuo.mutation.NotifiedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if preuo.mutation.NotifiedAtCleared() {
+		_spec.ClearField(providerrateexclusion.FieldNotifiedAt, field.TypeTime)
+	}
+	if value, ok := preuo.mutation.AdjustedAt(); ok {
+		_spec.SetField(providerrateexclusion.FieldAdjustedAt, field.TypeTime, value)
+	}
+	if preuo.mutation.AdjustedAtCleared() {
+		_spec.ClearField(providerrateexclusion.FieldAdjustedAt, field.TypeTime)
+	}
+	_node = &ProviderRateExclusion{config: preuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, preuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerrateexclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	preuo.mutation.done = true
+	return _node, nil
+}
@@ -25,6 +25,26 @@ type ProviderRating struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// TrustScore holds the value of the "trust_score" field.
 	TrustScore decimal.Decimal `json:"trust_score,omitempty"`
+	// AcceptanceRate holds the value of the "acceptance_rate" field.
+	AcceptanceRate decimal.Decimal `json:"acceptance_rate,omitempty"`
+	// AcceptanceScore holds the value of the "acceptance_score" field.
+	AcceptanceScore decimal.Decimal `json:"acceptance_score,omitempty"`
+	// CancellationRate holds the value of the "cancellation_rate" field.
+	CancellationRate decimal.Decimal `json:"cancellation_rate,omitempty"`
+	// CancellationScore holds the value of the "cancellation_score" field.
+	CancellationScore decimal.Decimal `json:"cancellation_score,omitempty"`
+	// ValidationFailureRate holds the value of the "validation_failure_rate" field.
+	ValidationFailureRate decimal.Decimal `json:"validation_failure_rate,omitempty"`
+	// ValidationScore holds the value of the "validation_score" field.
+	ValidationScore decimal.Decimal `json:"validation_score,omitempty"`
+	// AverageFulfillmentTime holds the value of the "average_fulfillment_time" field.
+	AverageFulfillmentTime decimal.Decimal `json:"average_fulfillment_time,omitempty"`
+	// FulfillmentTimeScore holds the value of the "fulfillment_time_score" field.
+	FulfillmentTimeScore decimal.Decimal `json:"fulfillment_time_score,omitempty"`
+	// DisputeRate holds the value of the "dispute_rate" field.
+	DisputeRate decimal.Decimal `json:"dispute_rate,omitempty"`
+	// DisputeScore holds the value of the "dispute_score" field.
+	DisputeScore decimal.Decimal `json:"dispute_score,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProviderRatingQuery when eager-loading is set.
 	Edges                            ProviderRatingEdges `json:"edges"`
@@ -57,7 +77,7 @@ func (*ProviderRating) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case providerrating.FieldTrustScore:
+		case providerrating.FieldTrustScore, providerrating.FieldAcceptanceRate, providerrating.FieldAcceptanceScore, providerrating.FieldCancellationRate, providerrating.FieldCancellationScore, providerrating.FieldValidationFailureRate, providerrating.FieldValidationScore, providerrating.FieldAverageFulfillmentTime, providerrating.FieldFulfillmentTimeScore, providerrating.FieldDisputeRate, providerrating.FieldDisputeScore:
 			values[i] = new(decimal.Decimal)
 		case providerrating.FieldID:
 			values[i] = new(sql.NullInt64)
@@ -104,6 +124,66 @@ func (pr *ProviderRating) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pr.TrustScore = *value
 			}
+		case providerrating.FieldAcceptanceRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field acceptance_rate", values[i])
+			} else if value != nil {
+				pr.AcceptanceRate = *value
+			}
+		case providerrating.FieldAcceptanceScore:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field acceptance_score", values[i])
+			} else if value != nil {
+				pr.AcceptanceScore = *value
+			}
+		case providerrating.FieldCancellationRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_rate", values[i])
+			} else if value != nil {
+				pr.CancellationRate = *value
+			}
+		case providerrating.FieldCancellationScore:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_score", values[i])
+			} else if value != nil {
+				pr.CancellationScore = *value
+			}
+		case providerrating.FieldValidationFailureRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_failure_rate", values[i])
+			} else if value != nil {
+				pr.ValidationFailureRate = *value
+			}
+		case providerrating.FieldValidationScore:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_score", values[i])
+			} else if value != nil {
+				pr.ValidationScore = *value
+			}
+		case providerrating.FieldAverageFulfillmentTime:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field average_fulfillment_time", values[i])
+			} else if value != nil {
+				pr.AverageFulfillmentTime = *value
+			}
+		case providerrating.FieldFulfillmentTimeScore:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfillment_time_score", values[i])
+			} else if value != nil {
+				pr.FulfillmentTimeScore = *value
+			}
+		case providerrating.FieldDisputeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_rate", values[i])
+			} else if value != nil {
+				pr.DisputeRate = *value
+			}
+		case providerrating.FieldDisputeScore:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_score", values[i])
+			} else if value != nil {
+				pr.DisputeScore = *value
+			}
 		case providerrating.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_provider_rating", values[i])
@@ -160,6 +240,36 @@ func (pr *ProviderRating) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("trust_score=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TrustScore))
+	builder.WriteString(", ")
+	builder.WriteString("acceptance_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AcceptanceRate))
+	builder.WriteString(", ")
+	builder.WriteString("acceptance_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AcceptanceScore))
+	builder.WriteString(", ")
+	builder.WriteString("cancellation_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CancellationRate))
+	builder.WriteString(", ")
+	builder.WriteString("cancellation_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CancellationScore))
+	builder.WriteString(", ")
+	builder.WriteString("validation_failure_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ValidationFailureRate))
+	builder.WriteString(", ")
+	builder.WriteString("validation_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ValidationScore))
+	builder.WriteString(", ")
+	builder.WriteString("average_fulfillment_time=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AverageFulfillmentTime))
+	builder.WriteString(", ")
+	builder.WriteString("fulfillment_time_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FulfillmentTimeScore))
+	builder.WriteString(", ")
+	builder.WriteString("dispute_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DisputeRate))
+	builder.WriteString(", ")
+	builder.WriteString("dispute_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DisputeScore))
 	builder.WriteByte(')')
 	return builder.String()
 }
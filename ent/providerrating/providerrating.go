@@ -20,6 +20,26 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldTrustScore holds the string denoting the trust_score field in the database.
 	FieldTrustScore = "trust_score"
+	// FieldAcceptanceRate holds the string denoting the acceptance_rate field in the database.
+	FieldAcceptanceRate = "acceptance_rate"
+	// FieldAcceptanceScore holds the string denoting the acceptance_score field in the database.
+	FieldAcceptanceScore = "acceptance_score"
+	// FieldCancellationRate holds the string denoting the cancellation_rate field in the database.
+	FieldCancellationRate = "cancellation_rate"
+	// FieldCancellationScore holds the string denoting the cancellation_score field in the database.
+	FieldCancellationScore = "cancellation_score"
+	// FieldValidationFailureRate holds the string denoting the validation_failure_rate field in the database.
+	FieldValidationFailureRate = "validation_failure_rate"
+	// FieldValidationScore holds the string denoting the validation_score field in the database.
+	FieldValidationScore = "validation_score"
+	// FieldAverageFulfillmentTime holds the string denoting the average_fulfillment_time field in the database.
+	FieldAverageFulfillmentTime = "average_fulfillment_time"
+	// FieldFulfillmentTimeScore holds the string denoting the fulfillment_time_score field in the database.
+	FieldFulfillmentTimeScore = "fulfillment_time_score"
+	// FieldDisputeRate holds the string denoting the dispute_rate field in the database.
+	FieldDisputeRate = "dispute_rate"
+	// FieldDisputeScore holds the string denoting the dispute_score field in the database.
+	FieldDisputeScore = "dispute_score"
 	// EdgeProviderProfile holds the string denoting the provider_profile edge name in mutations.
 	EdgeProviderProfile = "provider_profile"
 	// Table holds the table name of the providerrating in the database.
@@ -39,6 +59,16 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldTrustScore,
+	FieldAcceptanceRate,
+	FieldAcceptanceScore,
+	FieldCancellationRate,
+	FieldCancellationScore,
+	FieldValidationFailureRate,
+	FieldValidationScore,
+	FieldAverageFulfillmentTime,
+	FieldFulfillmentTimeScore,
+	FieldDisputeRate,
+	FieldDisputeScore,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provider_ratings"
@@ -94,6 +124,56 @@ func ByTrustScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTrustScore, opts...).ToFunc()
 }
 
+// ByAcceptanceRate orders the results by the acceptance_rate field.
+func ByAcceptanceRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptanceRate, opts...).ToFunc()
+}
+
+// ByAcceptanceScore orders the results by the acceptance_score field.
+func ByAcceptanceScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptanceScore, opts...).ToFunc()
+}
+
+// ByCancellationRate orders the results by the cancellation_rate field.
+func ByCancellationRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationRate, opts...).ToFunc()
+}
+
+// ByCancellationScore orders the results by the cancellation_score field.
+func ByCancellationScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationScore, opts...).ToFunc()
+}
+
+// ByValidationFailureRate orders the results by the validation_failure_rate field.
+func ByValidationFailureRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationFailureRate, opts...).ToFunc()
+}
+
+// ByValidationScore orders the results by the validation_score field.
+func ByValidationScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationScore, opts...).ToFunc()
+}
+
+// ByAverageFulfillmentTime orders the results by the average_fulfillment_time field.
+func ByAverageFulfillmentTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAverageFulfillmentTime, opts...).ToFunc()
+}
+
+// ByFulfillmentTimeScore orders the results by the fulfillment_time_score field.
+func ByFulfillmentTimeScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfillmentTimeScore, opts...).ToFunc()
+}
+
+// ByDisputeRate orders the results by the dispute_rate field.
+func ByDisputeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputeRate, opts...).ToFunc()
+}
+
+// ByDisputeScore orders the results by the dispute_score field.
+func ByDisputeScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputeScore, opts...).ToFunc()
+}
+
 // ByProviderProfileField orders the results by provider_profile field.
 func ByProviderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -71,6 +71,56 @@ func TrustScore(v decimal.Decimal) predicate.ProviderRating {
 	return predicate.ProviderRating(sql.FieldEQ(FieldTrustScore, v))
 }
 
+// AcceptanceRate applies equality check predicate on the "acceptance_rate" field. It's identical to AcceptanceRateEQ.
+func AcceptanceRate(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAcceptanceRate, v))
+}
+
+// AcceptanceScore applies equality check predicate on the "acceptance_score" field. It's identical to AcceptanceScoreEQ.
+func AcceptanceScore(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAcceptanceScore, v))
+}
+
+// CancellationRate applies equality check predicate on the "cancellation_rate" field. It's identical to CancellationRateEQ.
+func CancellationRate(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldCancellationRate, v))
+}
+
+// CancellationScore applies equality check predicate on the "cancellation_score" field. It's identical to CancellationScoreEQ.
+func CancellationScore(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldCancellationScore, v))
+}
+
+// ValidationFailureRate applies equality check predicate on the "validation_failure_rate" field. It's identical to ValidationFailureRateEQ.
+func ValidationFailureRate(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldValidationFailureRate, v))
+}
+
+// ValidationScore applies equality check predicate on the "validation_score" field. It's identical to ValidationScoreEQ.
+func ValidationScore(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldValidationScore, v))
+}
+
+// AverageFulfillmentTime applies equality check predicate on the "average_fulfillment_time" field. It's identical to AverageFulfillmentTimeEQ.
+func AverageFulfillmentTime(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAverageFulfillmentTime, v))
+}
+
+// FulfillmentTimeScore applies equality check predicate on the "fulfillment_time_score" field. It's identical to FulfillmentTimeScoreEQ.
+func FulfillmentTimeScore(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldFulfillmentTimeScore, v))
+}
+
+// DisputeRate applies equality check predicate on the "dispute_rate" field. It's identical to DisputeRateEQ.
+func DisputeRate(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldDisputeRate, v))
+}
+
+// DisputeScore applies equality check predicate on the "dispute_score" field. It's identical to DisputeScoreEQ.
+func DisputeScore(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldDisputeScore, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProviderRating {
 	return predicate.ProviderRating(sql.FieldEQ(FieldCreatedAt, v))
@@ -191,6 +241,406 @@ func TrustScoreLTE(v decimal.Decimal) predicate.ProviderRating {
 	return predicate.ProviderRating(sql.FieldLTE(FieldTrustScore, v))
 }
 
+// AcceptanceRateEQ applies the EQ predicate on the "acceptance_rate" field.
+func AcceptanceRateEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAcceptanceRate, v))
+}
+
+// AcceptanceRateNEQ applies the NEQ predicate on the "acceptance_rate" field.
+func AcceptanceRateNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldAcceptanceRate, v))
+}
+
+// AcceptanceRateIn applies the In predicate on the "acceptance_rate" field.
+func AcceptanceRateIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldAcceptanceRate, vs...))
+}
+
+// AcceptanceRateNotIn applies the NotIn predicate on the "acceptance_rate" field.
+func AcceptanceRateNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldAcceptanceRate, vs...))
+}
+
+// AcceptanceRateGT applies the GT predicate on the "acceptance_rate" field.
+func AcceptanceRateGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldAcceptanceRate, v))
+}
+
+// AcceptanceRateGTE applies the GTE predicate on the "acceptance_rate" field.
+func AcceptanceRateGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldAcceptanceRate, v))
+}
+
+// AcceptanceRateLT applies the LT predicate on the "acceptance_rate" field.
+func AcceptanceRateLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldAcceptanceRate, v))
+}
+
+// AcceptanceRateLTE applies the LTE predicate on the "acceptance_rate" field.
+func AcceptanceRateLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldAcceptanceRate, v))
+}
+
+// AcceptanceScoreEQ applies the EQ predicate on the "acceptance_score" field.
+func AcceptanceScoreEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAcceptanceScore, v))
+}
+
+// AcceptanceScoreNEQ applies the NEQ predicate on the "acceptance_score" field.
+func AcceptanceScoreNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldAcceptanceScore, v))
+}
+
+// AcceptanceScoreIn applies the In predicate on the "acceptance_score" field.
+func AcceptanceScoreIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldAcceptanceScore, vs...))
+}
+
+// AcceptanceScoreNotIn applies the NotIn predicate on the "acceptance_score" field.
+func AcceptanceScoreNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldAcceptanceScore, vs...))
+}
+
+// AcceptanceScoreGT applies the GT predicate on the "acceptance_score" field.
+func AcceptanceScoreGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldAcceptanceScore, v))
+}
+
+// AcceptanceScoreGTE applies the GTE predicate on the "acceptance_score" field.
+func AcceptanceScoreGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldAcceptanceScore, v))
+}
+
+// AcceptanceScoreLT applies the LT predicate on the "acceptance_score" field.
+func AcceptanceScoreLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldAcceptanceScore, v))
+}
+
+// AcceptanceScoreLTE applies the LTE predicate on the "acceptance_score" field.
+func AcceptanceScoreLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldAcceptanceScore, v))
+}
+
+// CancellationRateEQ applies the EQ predicate on the "cancellation_rate" field.
+func CancellationRateEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldCancellationRate, v))
+}
+
+// CancellationRateNEQ applies the NEQ predicate on the "cancellation_rate" field.
+func CancellationRateNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldCancellationRate, v))
+}
+
+// CancellationRateIn applies the In predicate on the "cancellation_rate" field.
+func CancellationRateIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldCancellationRate, vs...))
+}
+
+// CancellationRateNotIn applies the NotIn predicate on the "cancellation_rate" field.
+func CancellationRateNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldCancellationRate, vs...))
+}
+
+// CancellationRateGT applies the GT predicate on the "cancellation_rate" field.
+func CancellationRateGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldCancellationRate, v))
+}
+
+// CancellationRateGTE applies the GTE predicate on the "cancellation_rate" field.
+func CancellationRateGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldCancellationRate, v))
+}
+
+// CancellationRateLT applies the LT predicate on the "cancellation_rate" field.
+func CancellationRateLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldCancellationRate, v))
+}
+
+// CancellationRateLTE applies the LTE predicate on the "cancellation_rate" field.
+func CancellationRateLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldCancellationRate, v))
+}
+
+// CancellationScoreEQ applies the EQ predicate on the "cancellation_score" field.
+func CancellationScoreEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldCancellationScore, v))
+}
+
+// CancellationScoreNEQ applies the NEQ predicate on the "cancellation_score" field.
+func CancellationScoreNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldCancellationScore, v))
+}
+
+// CancellationScoreIn applies the In predicate on the "cancellation_score" field.
+func CancellationScoreIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldCancellationScore, vs...))
+}
+
+// CancellationScoreNotIn applies the NotIn predicate on the "cancellation_score" field.
+func CancellationScoreNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldCancellationScore, vs...))
+}
+
+// CancellationScoreGT applies the GT predicate on the "cancellation_score" field.
+func CancellationScoreGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldCancellationScore, v))
+}
+
+// CancellationScoreGTE applies the GTE predicate on the "cancellation_score" field.
+func CancellationScoreGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldCancellationScore, v))
+}
+
+// CancellationScoreLT applies the LT predicate on the "cancellation_score" field.
+func CancellationScoreLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldCancellationScore, v))
+}
+
+// CancellationScoreLTE applies the LTE predicate on the "cancellation_score" field.
+func CancellationScoreLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldCancellationScore, v))
+}
+
+// ValidationFailureRateEQ applies the EQ predicate on the "validation_failure_rate" field.
+func ValidationFailureRateEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldValidationFailureRate, v))
+}
+
+// ValidationFailureRateNEQ applies the NEQ predicate on the "validation_failure_rate" field.
+func ValidationFailureRateNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldValidationFailureRate, v))
+}
+
+// ValidationFailureRateIn applies the In predicate on the "validation_failure_rate" field.
+func ValidationFailureRateIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldValidationFailureRate, vs...))
+}
+
+// ValidationFailureRateNotIn applies the NotIn predicate on the "validation_failure_rate" field.
+func ValidationFailureRateNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldValidationFailureRate, vs...))
+}
+
+// ValidationFailureRateGT applies the GT predicate on the "validation_failure_rate" field.
+func ValidationFailureRateGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldValidationFailureRate, v))
+}
+
+// ValidationFailureRateGTE applies the GTE predicate on the "validation_failure_rate" field.
+func ValidationFailureRateGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldValidationFailureRate, v))
+}
+
+// ValidationFailureRateLT applies the LT predicate on the "validation_failure_rate" field.
+func ValidationFailureRateLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldValidationFailureRate, v))
+}
+
+// ValidationFailureRateLTE applies the LTE predicate on the "validation_failure_rate" field.
+func ValidationFailureRateLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldValidationFailureRate, v))
+}
+
+// ValidationScoreEQ applies the EQ predicate on the "validation_score" field.
+func ValidationScoreEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldValidationScore, v))
+}
+
+// ValidationScoreNEQ applies the NEQ predicate on the "validation_score" field.
+func ValidationScoreNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldValidationScore, v))
+}
+
+// ValidationScoreIn applies the In predicate on the "validation_score" field.
+func ValidationScoreIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldValidationScore, vs...))
+}
+
+// ValidationScoreNotIn applies the NotIn predicate on the "validation_score" field.
+func ValidationScoreNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldValidationScore, vs...))
+}
+
+// ValidationScoreGT applies the GT predicate on the "validation_score" field.
+func ValidationScoreGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldValidationScore, v))
+}
+
+// ValidationScoreGTE applies the GTE predicate on the "validation_score" field.
+func ValidationScoreGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldValidationScore, v))
+}
+
+// ValidationScoreLT applies the LT predicate on the "validation_score" field.
+func ValidationScoreLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldValidationScore, v))
+}
+
+// ValidationScoreLTE applies the LTE predicate on the "validation_score" field.
+func ValidationScoreLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldValidationScore, v))
+}
+
+// AverageFulfillmentTimeEQ applies the EQ predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldAverageFulfillmentTime, v))
+}
+
+// AverageFulfillmentTimeNEQ applies the NEQ predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldAverageFulfillmentTime, v))
+}
+
+// AverageFulfillmentTimeIn applies the In predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldAverageFulfillmentTime, vs...))
+}
+
+// AverageFulfillmentTimeNotIn applies the NotIn predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldAverageFulfillmentTime, vs...))
+}
+
+// AverageFulfillmentTimeGT applies the GT predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldAverageFulfillmentTime, v))
+}
+
+// AverageFulfillmentTimeGTE applies the GTE predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldAverageFulfillmentTime, v))
+}
+
+// AverageFulfillmentTimeLT applies the LT predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldAverageFulfillmentTime, v))
+}
+
+// AverageFulfillmentTimeLTE applies the LTE predicate on the "average_fulfillment_time" field.
+func AverageFulfillmentTimeLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldAverageFulfillmentTime, v))
+}
+
+// FulfillmentTimeScoreEQ applies the EQ predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldFulfillmentTimeScore, v))
+}
+
+// FulfillmentTimeScoreNEQ applies the NEQ predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldFulfillmentTimeScore, v))
+}
+
+// FulfillmentTimeScoreIn applies the In predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldFulfillmentTimeScore, vs...))
+}
+
+// FulfillmentTimeScoreNotIn applies the NotIn predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldFulfillmentTimeScore, vs...))
+}
+
+// FulfillmentTimeScoreGT applies the GT predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldFulfillmentTimeScore, v))
+}
+
+// FulfillmentTimeScoreGTE applies the GTE predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldFulfillmentTimeScore, v))
+}
+
+// FulfillmentTimeScoreLT applies the LT predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldFulfillmentTimeScore, v))
+}
+
+// FulfillmentTimeScoreLTE applies the LTE predicate on the "fulfillment_time_score" field.
+func FulfillmentTimeScoreLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldFulfillmentTimeScore, v))
+}
+
+// DisputeRateEQ applies the EQ predicate on the "dispute_rate" field.
+func DisputeRateEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldDisputeRate, v))
+}
+
+// DisputeRateNEQ applies the NEQ predicate on the "dispute_rate" field.
+func DisputeRateNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldDisputeRate, v))
+}
+
+// DisputeRateIn applies the In predicate on the "dispute_rate" field.
+func DisputeRateIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldDisputeRate, vs...))
+}
+
+// DisputeRateNotIn applies the NotIn predicate on the "dispute_rate" field.
+func DisputeRateNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldDisputeRate, vs...))
+}
+
+// DisputeRateGT applies the GT predicate on the "dispute_rate" field.
+func DisputeRateGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldDisputeRate, v))
+}
+
+// DisputeRateGTE applies the GTE predicate on the "dispute_rate" field.
+func DisputeRateGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldDisputeRate, v))
+}
+
+// DisputeRateLT applies the LT predicate on the "dispute_rate" field.
+func DisputeRateLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldDisputeRate, v))
+}
+
+// DisputeRateLTE applies the LTE predicate on the "dispute_rate" field.
+func DisputeRateLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldDisputeRate, v))
+}
+
+// DisputeScoreEQ applies the EQ predicate on the "dispute_score" field.
+func DisputeScoreEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldEQ(FieldDisputeScore, v))
+}
+
+// DisputeScoreNEQ applies the NEQ predicate on the "dispute_score" field.
+func DisputeScoreNEQ(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNEQ(FieldDisputeScore, v))
+}
+
+// DisputeScoreIn applies the In predicate on the "dispute_score" field.
+func DisputeScoreIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldIn(FieldDisputeScore, vs...))
+}
+
+// DisputeScoreNotIn applies the NotIn predicate on the "dispute_score" field.
+func DisputeScoreNotIn(vs ...decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldNotIn(FieldDisputeScore, vs...))
+}
+
+// DisputeScoreGT applies the GT predicate on the "dispute_score" field.
+func DisputeScoreGT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGT(FieldDisputeScore, v))
+}
+
+// DisputeScoreGTE applies the GTE predicate on the "dispute_score" field.
+func DisputeScoreGTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldGTE(FieldDisputeScore, v))
+}
+
+// DisputeScoreLT applies the LT predicate on the "dispute_score" field.
+func DisputeScoreLT(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLT(FieldDisputeScore, v))
+}
+
+// DisputeScoreLTE applies the LTE predicate on the "dispute_score" field.
+func DisputeScoreLTE(v decimal.Decimal) predicate.ProviderRating {
+	return predicate.ProviderRating(sql.FieldLTE(FieldDisputeScore, v))
+}
+
 // HasProviderProfile applies the HasEdge predicate on the "provider_profile" edge.
 func HasProviderProfile() predicate.ProviderRating {
 	return predicate.ProviderRating(func(s *sql.Selector) {
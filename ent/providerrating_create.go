@@ -58,6 +58,66 @@ func (prc *ProviderRatingCreate) SetTrustScore(d decimal.Decimal) *ProviderRatin
 	return prc
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (prc *ProviderRatingCreate) SetAcceptanceRate(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetAcceptanceRate(d)
+	return prc
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (prc *ProviderRatingCreate) SetAcceptanceScore(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetAcceptanceScore(d)
+	return prc
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (prc *ProviderRatingCreate) SetCancellationRate(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetCancellationRate(d)
+	return prc
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (prc *ProviderRatingCreate) SetCancellationScore(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetCancellationScore(d)
+	return prc
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (prc *ProviderRatingCreate) SetValidationFailureRate(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetValidationFailureRate(d)
+	return prc
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (prc *ProviderRatingCreate) SetValidationScore(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetValidationScore(d)
+	return prc
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (prc *ProviderRatingCreate) SetAverageFulfillmentTime(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetAverageFulfillmentTime(d)
+	return prc
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (prc *ProviderRatingCreate) SetFulfillmentTimeScore(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetFulfillmentTimeScore(d)
+	return prc
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (prc *ProviderRatingCreate) SetDisputeRate(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetDisputeRate(d)
+	return prc
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (prc *ProviderRatingCreate) SetDisputeScore(d decimal.Decimal) *ProviderRatingCreate {
+	prc.mutation.SetDisputeScore(d)
+	return prc
+}
+
 // SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
 func (prc *ProviderRatingCreate) SetProviderProfileID(id string) *ProviderRatingCreate {
 	prc.mutation.SetProviderProfileID(id)
@@ -125,6 +185,36 @@ func (prc *ProviderRatingCreate) check() error {
 	if _, ok := prc.mutation.TrustScore(); !ok {
 		return &ValidationError{Name: "trust_score", err: errors.New(`ent: missing required field "ProviderRating.trust_score"`)}
 	}
+	if _, ok := prc.mutation.AcceptanceRate(); !ok {
+		return &ValidationError{Name: "acceptance_rate", err: errors.New(`ent: missing required field "ProviderRating.acceptance_rate"`)}
+	}
+	if _, ok := prc.mutation.AcceptanceScore(); !ok {
+		return &ValidationError{Name: "acceptance_score", err: errors.New(`ent: missing required field "ProviderRating.acceptance_score"`)}
+	}
+	if _, ok := prc.mutation.CancellationRate(); !ok {
+		return &ValidationError{Name: "cancellation_rate", err: errors.New(`ent: missing required field "ProviderRating.cancellation_rate"`)}
+	}
+	if _, ok := prc.mutation.CancellationScore(); !ok {
+		return &ValidationError{Name: "cancellation_score", err: errors.New(`ent: missing required field "ProviderRating.cancellation_score"`)}
+	}
+	if _, ok := prc.mutation.ValidationFailureRate(); !ok {
+		return &ValidationError{Name: "validation_failure_rate", err: errors.New(`ent: missing required field "ProviderRating.validation_failure_rate"`)}
+	}
+	if _, ok := prc.mutation.ValidationScore(); !ok {
+		return &ValidationError{Name: "validation_score", err: errors.New(`ent: missing required field "ProviderRating.validation_score"`)}
+	}
+	if _, ok := prc.mutation.AverageFulfillmentTime(); !ok {
+		return &ValidationError{Name: "average_fulfillment_time", err: errors.New(`ent: missing required field "ProviderRating.average_fulfillment_time"`)}
+	}
+	if _, ok := prc.mutation.FulfillmentTimeScore(); !ok {
+		return &ValidationError{Name: "fulfillment_time_score", err: errors.New(`ent: missing required field "ProviderRating.fulfillment_time_score"`)}
+	}
+	if _, ok := prc.mutation.DisputeRate(); !ok {
+		return &ValidationError{Name: "dispute_rate", err: errors.New(`ent: missing required field "ProviderRating.dispute_rate"`)}
+	}
+	if _, ok := prc.mutation.DisputeScore(); !ok {
+		return &ValidationError{Name: "dispute_score", err: errors.New(`ent: missing required field "ProviderRating.dispute_score"`)}
+	}
 	if len(prc.mutation.ProviderProfileIDs()) == 0 {
 		return &ValidationError{Name: "provider_profile", err: errors.New(`ent: missing required edge "ProviderRating.provider_profile"`)}
 	}
@@ -167,6 +257,46 @@ func (prc *ProviderRatingCreate) createSpec() (*ProviderRating, *sqlgraph.Create
 		_spec.SetField(providerrating.FieldTrustScore, field.TypeFloat64, value)
 		_node.TrustScore = value
 	}
+	if value, ok := prc.mutation.AcceptanceRate(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceRate, field.TypeFloat64, value)
+		_node.AcceptanceRate = value
+	}
+	if value, ok := prc.mutation.AcceptanceScore(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceScore, field.TypeFloat64, value)
+		_node.AcceptanceScore = value
+	}
+	if value, ok := prc.mutation.CancellationRate(); ok {
+		_spec.SetField(providerrating.FieldCancellationRate, field.TypeFloat64, value)
+		_node.CancellationRate = value
+	}
+	if value, ok := prc.mutation.CancellationScore(); ok {
+		_spec.SetField(providerrating.FieldCancellationScore, field.TypeFloat64, value)
+		_node.CancellationScore = value
+	}
+	if value, ok := prc.mutation.ValidationFailureRate(); ok {
+		_spec.SetField(providerrating.FieldValidationFailureRate, field.TypeFloat64, value)
+		_node.ValidationFailureRate = value
+	}
+	if value, ok := prc.mutation.ValidationScore(); ok {
+		_spec.SetField(providerrating.FieldValidationScore, field.TypeFloat64, value)
+		_node.ValidationScore = value
+	}
+	if value, ok := prc.mutation.AverageFulfillmentTime(); ok {
+		_spec.SetField(providerrating.FieldAverageFulfillmentTime, field.TypeFloat64, value)
+		_node.AverageFulfillmentTime = value
+	}
+	if value, ok := prc.mutation.FulfillmentTimeScore(); ok {
+		_spec.SetField(providerrating.FieldFulfillmentTimeScore, field.TypeFloat64, value)
+		_node.FulfillmentTimeScore = value
+	}
+	if value, ok := prc.mutation.DisputeRate(); ok {
+		_spec.SetField(providerrating.FieldDisputeRate, field.TypeFloat64, value)
+		_node.DisputeRate = value
+	}
+	if value, ok := prc.mutation.DisputeScore(); ok {
+		_spec.SetField(providerrating.FieldDisputeScore, field.TypeFloat64, value)
+		_node.DisputeScore = value
+	}
 	if nodes := prc.mutation.ProviderProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -266,6 +396,186 @@ func (u *ProviderRatingUpsert) AddTrustScore(v decimal.Decimal) *ProviderRatingU
 	return u
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (u *ProviderRatingUpsert) SetAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldAcceptanceRate, v)
+	return u
+}
+
+// UpdateAcceptanceRate sets the "acceptance_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateAcceptanceRate() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldAcceptanceRate)
+	return u
+}
+
+// AddAcceptanceRate adds v to the "acceptance_rate" field.
+func (u *ProviderRatingUpsert) AddAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldAcceptanceRate, v)
+	return u
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (u *ProviderRatingUpsert) SetAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldAcceptanceScore, v)
+	return u
+}
+
+// UpdateAcceptanceScore sets the "acceptance_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateAcceptanceScore() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldAcceptanceScore)
+	return u
+}
+
+// AddAcceptanceScore adds v to the "acceptance_score" field.
+func (u *ProviderRatingUpsert) AddAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldAcceptanceScore, v)
+	return u
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderRatingUpsert) SetCancellationRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldCancellationRate, v)
+	return u
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateCancellationRate() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldCancellationRate)
+	return u
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderRatingUpsert) AddCancellationRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldCancellationRate, v)
+	return u
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (u *ProviderRatingUpsert) SetCancellationScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldCancellationScore, v)
+	return u
+}
+
+// UpdateCancellationScore sets the "cancellation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateCancellationScore() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldCancellationScore)
+	return u
+}
+
+// AddCancellationScore adds v to the "cancellation_score" field.
+func (u *ProviderRatingUpsert) AddCancellationScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldCancellationScore, v)
+	return u
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (u *ProviderRatingUpsert) SetValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldValidationFailureRate, v)
+	return u
+}
+
+// UpdateValidationFailureRate sets the "validation_failure_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateValidationFailureRate() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldValidationFailureRate)
+	return u
+}
+
+// AddValidationFailureRate adds v to the "validation_failure_rate" field.
+func (u *ProviderRatingUpsert) AddValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldValidationFailureRate, v)
+	return u
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (u *ProviderRatingUpsert) SetValidationScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldValidationScore, v)
+	return u
+}
+
+// UpdateValidationScore sets the "validation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateValidationScore() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldValidationScore)
+	return u
+}
+
+// AddValidationScore adds v to the "validation_score" field.
+func (u *ProviderRatingUpsert) AddValidationScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldValidationScore, v)
+	return u
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsert) SetAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldAverageFulfillmentTime, v)
+	return u
+}
+
+// UpdateAverageFulfillmentTime sets the "average_fulfillment_time" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateAverageFulfillmentTime() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldAverageFulfillmentTime)
+	return u
+}
+
+// AddAverageFulfillmentTime adds v to the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsert) AddAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldAverageFulfillmentTime, v)
+	return u
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsert) SetFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldFulfillmentTimeScore, v)
+	return u
+}
+
+// UpdateFulfillmentTimeScore sets the "fulfillment_time_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateFulfillmentTimeScore() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldFulfillmentTimeScore)
+	return u
+}
+
+// AddFulfillmentTimeScore adds v to the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsert) AddFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldFulfillmentTimeScore, v)
+	return u
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (u *ProviderRatingUpsert) SetDisputeRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldDisputeRate, v)
+	return u
+}
+
+// UpdateDisputeRate sets the "dispute_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateDisputeRate() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldDisputeRate)
+	return u
+}
+
+// AddDisputeRate adds v to the "dispute_rate" field.
+func (u *ProviderRatingUpsert) AddDisputeRate(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldDisputeRate, v)
+	return u
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (u *ProviderRatingUpsert) SetDisputeScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Set(providerrating.FieldDisputeScore, v)
+	return u
+}
+
+// UpdateDisputeScore sets the "dispute_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsert) UpdateDisputeScore() *ProviderRatingUpsert {
+	u.SetExcluded(providerrating.FieldDisputeScore)
+	return u
+}
+
+// AddDisputeScore adds v to the "dispute_score" field.
+func (u *ProviderRatingUpsert) AddDisputeScore(v decimal.Decimal) *ProviderRatingUpsert {
+	u.Add(providerrating.FieldDisputeScore, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -346,6 +656,216 @@ func (u *ProviderRatingUpsertOne) UpdateTrustScore() *ProviderRatingUpsertOne {
 	})
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (u *ProviderRatingUpsertOne) SetAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAcceptanceRate(v)
+	})
+}
+
+// AddAcceptanceRate adds v to the "acceptance_rate" field.
+func (u *ProviderRatingUpsertOne) AddAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAcceptanceRate(v)
+	})
+}
+
+// UpdateAcceptanceRate sets the "acceptance_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateAcceptanceRate() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAcceptanceRate()
+	})
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (u *ProviderRatingUpsertOne) SetAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAcceptanceScore(v)
+	})
+}
+
+// AddAcceptanceScore adds v to the "acceptance_score" field.
+func (u *ProviderRatingUpsertOne) AddAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAcceptanceScore(v)
+	})
+}
+
+// UpdateAcceptanceScore sets the "acceptance_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateAcceptanceScore() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAcceptanceScore()
+	})
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderRatingUpsertOne) SetCancellationRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetCancellationRate(v)
+	})
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderRatingUpsertOne) AddCancellationRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddCancellationRate(v)
+	})
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateCancellationRate() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateCancellationRate()
+	})
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (u *ProviderRatingUpsertOne) SetCancellationScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetCancellationScore(v)
+	})
+}
+
+// AddCancellationScore adds v to the "cancellation_score" field.
+func (u *ProviderRatingUpsertOne) AddCancellationScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddCancellationScore(v)
+	})
+}
+
+// UpdateCancellationScore sets the "cancellation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateCancellationScore() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateCancellationScore()
+	})
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (u *ProviderRatingUpsertOne) SetValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetValidationFailureRate(v)
+	})
+}
+
+// AddValidationFailureRate adds v to the "validation_failure_rate" field.
+func (u *ProviderRatingUpsertOne) AddValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddValidationFailureRate(v)
+	})
+}
+
+// UpdateValidationFailureRate sets the "validation_failure_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateValidationFailureRate() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateValidationFailureRate()
+	})
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (u *ProviderRatingUpsertOne) SetValidationScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetValidationScore(v)
+	})
+}
+
+// AddValidationScore adds v to the "validation_score" field.
+func (u *ProviderRatingUpsertOne) AddValidationScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddValidationScore(v)
+	})
+}
+
+// UpdateValidationScore sets the "validation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateValidationScore() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateValidationScore()
+	})
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsertOne) SetAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAverageFulfillmentTime(v)
+	})
+}
+
+// AddAverageFulfillmentTime adds v to the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsertOne) AddAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAverageFulfillmentTime(v)
+	})
+}
+
+// UpdateAverageFulfillmentTime sets the "average_fulfillment_time" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateAverageFulfillmentTime() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAverageFulfillmentTime()
+	})
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsertOne) SetFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetFulfillmentTimeScore(v)
+	})
+}
+
+// AddFulfillmentTimeScore adds v to the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsertOne) AddFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddFulfillmentTimeScore(v)
+	})
+}
+
+// UpdateFulfillmentTimeScore sets the "fulfillment_time_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateFulfillmentTimeScore() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateFulfillmentTimeScore()
+	})
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (u *ProviderRatingUpsertOne) SetDisputeRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetDisputeRate(v)
+	})
+}
+
+// AddDisputeRate adds v to the "dispute_rate" field.
+func (u *ProviderRatingUpsertOne) AddDisputeRate(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddDisputeRate(v)
+	})
+}
+
+// UpdateDisputeRate sets the "dispute_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateDisputeRate() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateDisputeRate()
+	})
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (u *ProviderRatingUpsertOne) SetDisputeScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetDisputeScore(v)
+	})
+}
+
+// AddDisputeScore adds v to the "dispute_score" field.
+func (u *ProviderRatingUpsertOne) AddDisputeScore(v decimal.Decimal) *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddDisputeScore(v)
+	})
+}
+
+// UpdateDisputeScore sets the "dispute_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertOne) UpdateDisputeScore() *ProviderRatingUpsertOne {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateDisputeScore()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderRatingUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -592,6 +1112,216 @@ func (u *ProviderRatingUpsertBulk) UpdateTrustScore() *ProviderRatingUpsertBulk
 	})
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (u *ProviderRatingUpsertBulk) SetAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAcceptanceRate(v)
+	})
+}
+
+// AddAcceptanceRate adds v to the "acceptance_rate" field.
+func (u *ProviderRatingUpsertBulk) AddAcceptanceRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAcceptanceRate(v)
+	})
+}
+
+// UpdateAcceptanceRate sets the "acceptance_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateAcceptanceRate() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAcceptanceRate()
+	})
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (u *ProviderRatingUpsertBulk) SetAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAcceptanceScore(v)
+	})
+}
+
+// AddAcceptanceScore adds v to the "acceptance_score" field.
+func (u *ProviderRatingUpsertBulk) AddAcceptanceScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAcceptanceScore(v)
+	})
+}
+
+// UpdateAcceptanceScore sets the "acceptance_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateAcceptanceScore() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAcceptanceScore()
+	})
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderRatingUpsertBulk) SetCancellationRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetCancellationRate(v)
+	})
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderRatingUpsertBulk) AddCancellationRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddCancellationRate(v)
+	})
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateCancellationRate() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateCancellationRate()
+	})
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (u *ProviderRatingUpsertBulk) SetCancellationScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetCancellationScore(v)
+	})
+}
+
+// AddCancellationScore adds v to the "cancellation_score" field.
+func (u *ProviderRatingUpsertBulk) AddCancellationScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddCancellationScore(v)
+	})
+}
+
+// UpdateCancellationScore sets the "cancellation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateCancellationScore() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateCancellationScore()
+	})
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (u *ProviderRatingUpsertBulk) SetValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetValidationFailureRate(v)
+	})
+}
+
+// AddValidationFailureRate adds v to the "validation_failure_rate" field.
+func (u *ProviderRatingUpsertBulk) AddValidationFailureRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddValidationFailureRate(v)
+	})
+}
+
+// UpdateValidationFailureRate sets the "validation_failure_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateValidationFailureRate() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateValidationFailureRate()
+	})
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (u *ProviderRatingUpsertBulk) SetValidationScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetValidationScore(v)
+	})
+}
+
+// AddValidationScore adds v to the "validation_score" field.
+func (u *ProviderRatingUpsertBulk) AddValidationScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddValidationScore(v)
+	})
+}
+
+// UpdateValidationScore sets the "validation_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateValidationScore() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateValidationScore()
+	})
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsertBulk) SetAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetAverageFulfillmentTime(v)
+	})
+}
+
+// AddAverageFulfillmentTime adds v to the "average_fulfillment_time" field.
+func (u *ProviderRatingUpsertBulk) AddAverageFulfillmentTime(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddAverageFulfillmentTime(v)
+	})
+}
+
+// UpdateAverageFulfillmentTime sets the "average_fulfillment_time" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateAverageFulfillmentTime() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateAverageFulfillmentTime()
+	})
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsertBulk) SetFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetFulfillmentTimeScore(v)
+	})
+}
+
+// AddFulfillmentTimeScore adds v to the "fulfillment_time_score" field.
+func (u *ProviderRatingUpsertBulk) AddFulfillmentTimeScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddFulfillmentTimeScore(v)
+	})
+}
+
+// UpdateFulfillmentTimeScore sets the "fulfillment_time_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateFulfillmentTimeScore() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateFulfillmentTimeScore()
+	})
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (u *ProviderRatingUpsertBulk) SetDisputeRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetDisputeRate(v)
+	})
+}
+
+// AddDisputeRate adds v to the "dispute_rate" field.
+func (u *ProviderRatingUpsertBulk) AddDisputeRate(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddDisputeRate(v)
+	})
+}
+
+// UpdateDisputeRate sets the "dispute_rate" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateDisputeRate() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateDisputeRate()
+	})
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (u *ProviderRatingUpsertBulk) SetDisputeScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.SetDisputeScore(v)
+	})
+}
+
+// AddDisputeScore adds v to the "dispute_score" field.
+func (u *ProviderRatingUpsertBulk) AddDisputeScore(v decimal.Decimal) *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.AddDisputeScore(v)
+	})
+}
+
+// UpdateDisputeScore sets the "dispute_score" field to the value that was provided on create.
+func (u *ProviderRatingUpsertBulk) UpdateDisputeScore() *ProviderRatingUpsertBulk {
+	return u.Update(func(s *ProviderRatingUpsert) {
+		s.UpdateDisputeScore()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderRatingUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -56,6 +56,216 @@ func (pru *ProviderRatingUpdate) AddTrustScore(d decimal.Decimal) *ProviderRatin
 	return pru
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (pru *ProviderRatingUpdate) SetAcceptanceRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetAcceptanceRate()
+	pru.mutation.SetAcceptanceRate(d)
+	return pru
+}
+
+// SetNillableAcceptanceRate sets the "acceptance_rate" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableAcceptanceRate(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetAcceptanceRate(*d)
+	}
+	return pru
+}
+
+// AddAcceptanceRate adds d to the "acceptance_rate" field.
+func (pru *ProviderRatingUpdate) AddAcceptanceRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddAcceptanceRate(d)
+	return pru
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (pru *ProviderRatingUpdate) SetAcceptanceScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetAcceptanceScore()
+	pru.mutation.SetAcceptanceScore(d)
+	return pru
+}
+
+// SetNillableAcceptanceScore sets the "acceptance_score" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableAcceptanceScore(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetAcceptanceScore(*d)
+	}
+	return pru
+}
+
+// AddAcceptanceScore adds d to the "acceptance_score" field.
+func (pru *ProviderRatingUpdate) AddAcceptanceScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddAcceptanceScore(d)
+	return pru
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (pru *ProviderRatingUpdate) SetCancellationRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetCancellationRate()
+	pru.mutation.SetCancellationRate(d)
+	return pru
+}
+
+// SetNillableCancellationRate sets the "cancellation_rate" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableCancellationRate(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetCancellationRate(*d)
+	}
+	return pru
+}
+
+// AddCancellationRate adds d to the "cancellation_rate" field.
+func (pru *ProviderRatingUpdate) AddCancellationRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddCancellationRate(d)
+	return pru
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (pru *ProviderRatingUpdate) SetCancellationScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetCancellationScore()
+	pru.mutation.SetCancellationScore(d)
+	return pru
+}
+
+// SetNillableCancellationScore sets the "cancellation_score" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableCancellationScore(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetCancellationScore(*d)
+	}
+	return pru
+}
+
+// AddCancellationScore adds d to the "cancellation_score" field.
+func (pru *ProviderRatingUpdate) AddCancellationScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddCancellationScore(d)
+	return pru
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (pru *ProviderRatingUpdate) SetValidationFailureRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetValidationFailureRate()
+	pru.mutation.SetValidationFailureRate(d)
+	return pru
+}
+
+// SetNillableValidationFailureRate sets the "validation_failure_rate" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableValidationFailureRate(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetValidationFailureRate(*d)
+	}
+	return pru
+}
+
+// AddValidationFailureRate adds d to the "validation_failure_rate" field.
+func (pru *ProviderRatingUpdate) AddValidationFailureRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddValidationFailureRate(d)
+	return pru
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (pru *ProviderRatingUpdate) SetValidationScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetValidationScore()
+	pru.mutation.SetValidationScore(d)
+	return pru
+}
+
+// SetNillableValidationScore sets the "validation_score" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableValidationScore(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetValidationScore(*d)
+	}
+	return pru
+}
+
+// AddValidationScore adds d to the "validation_score" field.
+func (pru *ProviderRatingUpdate) AddValidationScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddValidationScore(d)
+	return pru
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (pru *ProviderRatingUpdate) SetAverageFulfillmentTime(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetAverageFulfillmentTime()
+	pru.mutation.SetAverageFulfillmentTime(d)
+	return pru
+}
+
+// SetNillableAverageFulfillmentTime sets the "average_fulfillment_time" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableAverageFulfillmentTime(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetAverageFulfillmentTime(*d)
+	}
+	return pru
+}
+
+// AddAverageFulfillmentTime adds d to the "average_fulfillment_time" field.
+func (pru *ProviderRatingUpdate) AddAverageFulfillmentTime(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddAverageFulfillmentTime(d)
+	return pru
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (pru *ProviderRatingUpdate) SetFulfillmentTimeScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetFulfillmentTimeScore()
+	pru.mutation.SetFulfillmentTimeScore(d)
+	return pru
+}
+
+// SetNillableFulfillmentTimeScore sets the "fulfillment_time_score" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableFulfillmentTimeScore(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetFulfillmentTimeScore(*d)
+	}
+	return pru
+}
+
+// AddFulfillmentTimeScore adds d to the "fulfillment_time_score" field.
+func (pru *ProviderRatingUpdate) AddFulfillmentTimeScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddFulfillmentTimeScore(d)
+	return pru
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (pru *ProviderRatingUpdate) SetDisputeRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetDisputeRate()
+	pru.mutation.SetDisputeRate(d)
+	return pru
+}
+
+// SetNillableDisputeRate sets the "dispute_rate" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableDisputeRate(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetDisputeRate(*d)
+	}
+	return pru
+}
+
+// AddDisputeRate adds d to the "dispute_rate" field.
+func (pru *ProviderRatingUpdate) AddDisputeRate(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddDisputeRate(d)
+	return pru
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (pru *ProviderRatingUpdate) SetDisputeScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.ResetDisputeScore()
+	pru.mutation.SetDisputeScore(d)
+	return pru
+}
+
+// SetNillableDisputeScore sets the "dispute_score" field if the given value is not nil.
+func (pru *ProviderRatingUpdate) SetNillableDisputeScore(d *decimal.Decimal) *ProviderRatingUpdate {
+	if d != nil {
+		pru.SetDisputeScore(*d)
+	}
+	return pru
+}
+
+// AddDisputeScore adds d to the "dispute_score" field.
+func (pru *ProviderRatingUpdate) AddDisputeScore(d decimal.Decimal) *ProviderRatingUpdate {
+	pru.mutation.AddDisputeScore(d)
+	return pru
+}
+
 // Mutation returns the ProviderRatingMutation object of the builder.
 func (pru *ProviderRatingUpdate) Mutation() *ProviderRatingMutation {
 	return pru.mutation
@@ -126,6 +336,66 @@ func (pru *ProviderRatingUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := pru.mutation.AddedTrustScore(); ok {
 		_spec.AddField(providerrating.FieldTrustScore, field.TypeFloat64, value)
 	}
+	if value, ok := pru.mutation.AcceptanceRate(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedAcceptanceRate(); ok {
+		_spec.AddField(providerrating.FieldAcceptanceRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AcceptanceScore(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedAcceptanceScore(); ok {
+		_spec.AddField(providerrating.FieldAcceptanceScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.CancellationRate(); ok {
+		_spec.SetField(providerrating.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedCancellationRate(); ok {
+		_spec.AddField(providerrating.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.CancellationScore(); ok {
+		_spec.SetField(providerrating.FieldCancellationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedCancellationScore(); ok {
+		_spec.AddField(providerrating.FieldCancellationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.ValidationFailureRate(); ok {
+		_spec.SetField(providerrating.FieldValidationFailureRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedValidationFailureRate(); ok {
+		_spec.AddField(providerrating.FieldValidationFailureRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.ValidationScore(); ok {
+		_spec.SetField(providerrating.FieldValidationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedValidationScore(); ok {
+		_spec.AddField(providerrating.FieldValidationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AverageFulfillmentTime(); ok {
+		_spec.SetField(providerrating.FieldAverageFulfillmentTime, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedAverageFulfillmentTime(); ok {
+		_spec.AddField(providerrating.FieldAverageFulfillmentTime, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.FulfillmentTimeScore(); ok {
+		_spec.SetField(providerrating.FieldFulfillmentTimeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedFulfillmentTimeScore(); ok {
+		_spec.AddField(providerrating.FieldFulfillmentTimeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.DisputeRate(); ok {
+		_spec.SetField(providerrating.FieldDisputeRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedDisputeRate(); ok {
+		_spec.AddField(providerrating.FieldDisputeRate, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.DisputeScore(); ok {
+		_spec.SetField(providerrating.FieldDisputeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pru.mutation.AddedDisputeScore(); ok {
+		_spec.AddField(providerrating.FieldDisputeScore, field.TypeFloat64, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{providerrating.Label}
@@ -173,6 +443,216 @@ func (pruo *ProviderRatingUpdateOne) AddTrustScore(d decimal.Decimal) *ProviderR
 	return pruo
 }
 
+// SetAcceptanceRate sets the "acceptance_rate" field.
+func (pruo *ProviderRatingUpdateOne) SetAcceptanceRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetAcceptanceRate()
+	pruo.mutation.SetAcceptanceRate(d)
+	return pruo
+}
+
+// SetNillableAcceptanceRate sets the "acceptance_rate" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableAcceptanceRate(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetAcceptanceRate(*d)
+	}
+	return pruo
+}
+
+// AddAcceptanceRate adds d to the "acceptance_rate" field.
+func (pruo *ProviderRatingUpdateOne) AddAcceptanceRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddAcceptanceRate(d)
+	return pruo
+}
+
+// SetAcceptanceScore sets the "acceptance_score" field.
+func (pruo *ProviderRatingUpdateOne) SetAcceptanceScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetAcceptanceScore()
+	pruo.mutation.SetAcceptanceScore(d)
+	return pruo
+}
+
+// SetNillableAcceptanceScore sets the "acceptance_score" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableAcceptanceScore(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetAcceptanceScore(*d)
+	}
+	return pruo
+}
+
+// AddAcceptanceScore adds d to the "acceptance_score" field.
+func (pruo *ProviderRatingUpdateOne) AddAcceptanceScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddAcceptanceScore(d)
+	return pruo
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (pruo *ProviderRatingUpdateOne) SetCancellationRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetCancellationRate()
+	pruo.mutation.SetCancellationRate(d)
+	return pruo
+}
+
+// SetNillableCancellationRate sets the "cancellation_rate" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableCancellationRate(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetCancellationRate(*d)
+	}
+	return pruo
+}
+
+// AddCancellationRate adds d to the "cancellation_rate" field.
+func (pruo *ProviderRatingUpdateOne) AddCancellationRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddCancellationRate(d)
+	return pruo
+}
+
+// SetCancellationScore sets the "cancellation_score" field.
+func (pruo *ProviderRatingUpdateOne) SetCancellationScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetCancellationScore()
+	pruo.mutation.SetCancellationScore(d)
+	return pruo
+}
+
+// SetNillableCancellationScore sets the "cancellation_score" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableCancellationScore(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetCancellationScore(*d)
+	}
+	return pruo
+}
+
+// AddCancellationScore adds d to the "cancellation_score" field.
+func (pruo *ProviderRatingUpdateOne) AddCancellationScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddCancellationScore(d)
+	return pruo
+}
+
+// SetValidationFailureRate sets the "validation_failure_rate" field.
+func (pruo *ProviderRatingUpdateOne) SetValidationFailureRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetValidationFailureRate()
+	pruo.mutation.SetValidationFailureRate(d)
+	return pruo
+}
+
+// SetNillableValidationFailureRate sets the "validation_failure_rate" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableValidationFailureRate(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetValidationFailureRate(*d)
+	}
+	return pruo
+}
+
+// AddValidationFailureRate adds d to the "validation_failure_rate" field.
+func (pruo *ProviderRatingUpdateOne) AddValidationFailureRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddValidationFailureRate(d)
+	return pruo
+}
+
+// SetValidationScore sets the "validation_score" field.
+func (pruo *ProviderRatingUpdateOne) SetValidationScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetValidationScore()
+	pruo.mutation.SetValidationScore(d)
+	return pruo
+}
+
+// SetNillableValidationScore sets the "validation_score" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableValidationScore(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetValidationScore(*d)
+	}
+	return pruo
+}
+
+// AddValidationScore adds d to the "validation_score" field.
+func (pruo *ProviderRatingUpdateOne) AddValidationScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddValidationScore(d)
+	return pruo
+}
+
+// SetAverageFulfillmentTime sets the "average_fulfillment_time" field.
+func (pruo *ProviderRatingUpdateOne) SetAverageFulfillmentTime(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetAverageFulfillmentTime()
+	pruo.mutation.SetAverageFulfillmentTime(d)
+	return pruo
+}
+
+// SetNillableAverageFulfillmentTime sets the "average_fulfillment_time" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableAverageFulfillmentTime(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetAverageFulfillmentTime(*d)
+	}
+	return pruo
+}
+
+// AddAverageFulfillmentTime adds d to the "average_fulfillment_time" field.
+func (pruo *ProviderRatingUpdateOne) AddAverageFulfillmentTime(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddAverageFulfillmentTime(d)
+	return pruo
+}
+
+// SetFulfillmentTimeScore sets the "fulfillment_time_score" field.
+func (pruo *ProviderRatingUpdateOne) SetFulfillmentTimeScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetFulfillmentTimeScore()
+	pruo.mutation.SetFulfillmentTimeScore(d)
+	return pruo
+}
+
+// SetNillableFulfillmentTimeScore sets the "fulfillment_time_score" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableFulfillmentTimeScore(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetFulfillmentTimeScore(*d)
+	}
+	return pruo
+}
+
+// AddFulfillmentTimeScore adds d to the "fulfillment_time_score" field.
+func (pruo *ProviderRatingUpdateOne) AddFulfillmentTimeScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddFulfillmentTimeScore(d)
+	return pruo
+}
+
+// SetDisputeRate sets the "dispute_rate" field.
+func (pruo *ProviderRatingUpdateOne) SetDisputeRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetDisputeRate()
+	pruo.mutation.SetDisputeRate(d)
+	return pruo
+}
+
+// SetNillableDisputeRate sets the "dispute_rate" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableDisputeRate(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetDisputeRate(*d)
+	}
+	return pruo
+}
+
+// AddDisputeRate adds d to the "dispute_rate" field.
+func (pruo *ProviderRatingUpdateOne) AddDisputeRate(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddDisputeRate(d)
+	return pruo
+}
+
+// SetDisputeScore sets the "dispute_score" field.
+func (pruo *ProviderRatingUpdateOne) SetDisputeScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.ResetDisputeScore()
+	pruo.mutation.SetDisputeScore(d)
+	return pruo
+}
+
+// SetNillableDisputeScore sets the "dispute_score" field if the given value is not nil.
+func (pruo *ProviderRatingUpdateOne) SetNillableDisputeScore(d *decimal.Decimal) *ProviderRatingUpdateOne {
+	if d != nil {
+		pruo.SetDisputeScore(*d)
+	}
+	return pruo
+}
+
+// AddDisputeScore adds d to the "dispute_score" field.
+func (pruo *ProviderRatingUpdateOne) AddDisputeScore(d decimal.Decimal) *ProviderRatingUpdateOne {
+	pruo.mutation.AddDisputeScore(d)
+	return pruo
+}
+
 // Mutation returns the ProviderRatingMutation object of the builder.
 func (pruo *ProviderRatingUpdateOne) Mutation() *ProviderRatingMutation {
 	return pruo.mutation
@@ -273,6 +753,66 @@ func (pruo *ProviderRatingUpdateOne) sqlSave(ctx context.Context) (_node *Provid
 	if value, ok := pruo.mutation.AddedTrustScore(); ok {
 		_spec.AddField(providerrating.FieldTrustScore, field.TypeFloat64, value)
 	}
+	if value, ok := pruo.mutation.AcceptanceRate(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedAcceptanceRate(); ok {
+		_spec.AddField(providerrating.FieldAcceptanceRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AcceptanceScore(); ok {
+		_spec.SetField(providerrating.FieldAcceptanceScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedAcceptanceScore(); ok {
+		_spec.AddField(providerrating.FieldAcceptanceScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.CancellationRate(); ok {
+		_spec.SetField(providerrating.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedCancellationRate(); ok {
+		_spec.AddField(providerrating.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.CancellationScore(); ok {
+		_spec.SetField(providerrating.FieldCancellationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedCancellationScore(); ok {
+		_spec.AddField(providerrating.FieldCancellationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.ValidationFailureRate(); ok {
+		_spec.SetField(providerrating.FieldValidationFailureRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedValidationFailureRate(); ok {
+		_spec.AddField(providerrating.FieldValidationFailureRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.ValidationScore(); ok {
+		_spec.SetField(providerrating.FieldValidationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedValidationScore(); ok {
+		_spec.AddField(providerrating.FieldValidationScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AverageFulfillmentTime(); ok {
+		_spec.SetField(providerrating.FieldAverageFulfillmentTime, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedAverageFulfillmentTime(); ok {
+		_spec.AddField(providerrating.FieldAverageFulfillmentTime, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.FulfillmentTimeScore(); ok {
+		_spec.SetField(providerrating.FieldFulfillmentTimeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedFulfillmentTimeScore(); ok {
+		_spec.AddField(providerrating.FieldFulfillmentTimeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.DisputeRate(); ok {
+		_spec.SetField(providerrating.FieldDisputeRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedDisputeRate(); ok {
+		_spec.AddField(providerrating.FieldDisputeRate, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.DisputeScore(); ok {
+		_spec.SetField(providerrating.FieldDisputeScore, field.TypeFloat64, value)
+	}
+	if value, ok := pruo.mutation.AddedDisputeScore(); ok {
+		_spec.AddField(providerrating.FieldDisputeScore, field.TypeFloat64, value)
+	}
 	_node = &ProviderRating{config: pruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
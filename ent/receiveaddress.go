@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 )
 
@@ -39,18 +40,21 @@ type ReceiveAddress struct {
 	ValidUntil time.Time `json:"valid_until,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ReceiveAddressQuery when eager-loading is set.
-	Edges                         ReceiveAddressEdges `json:"edges"`
-	payment_order_receive_address *uuid.UUID
-	selectValues                  sql.SelectValues
+	Edges                               ReceiveAddressEdges `json:"edges"`
+	payment_order_receive_address       *uuid.UUID
+	payment_order_batch_receive_address *uuid.UUID
+	selectValues                        sql.SelectValues
 }
 
 // ReceiveAddressEdges holds the relations/edges for other nodes in the graph.
 type ReceiveAddressEdges struct {
 	// PaymentOrder holds the value of the payment_order edge.
 	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// PaymentOrderBatch holds the value of the payment_order_batch edge.
+	PaymentOrderBatch *PaymentOrderBatch `json:"payment_order_batch,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
@@ -64,6 +68,17 @@ func (e ReceiveAddressEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
 	return nil, &NotLoadedError{edge: "payment_order"}
 }
 
+// PaymentOrderBatchOrErr returns the PaymentOrderBatch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiveAddressEdges) PaymentOrderBatchOrErr() (*PaymentOrderBatch, error) {
+	if e.PaymentOrderBatch != nil {
+		return e.PaymentOrderBatch, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorderbatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order_batch"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ReceiveAddress) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -79,6 +94,8 @@ func (*ReceiveAddress) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case receiveaddress.ForeignKeys[0]: // payment_order_receive_address
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case receiveaddress.ForeignKeys[1]: // payment_order_batch_receive_address
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -161,6 +178,13 @@ func (ra *ReceiveAddress) assignValues(columns []string, values []any) error {
 				ra.payment_order_receive_address = new(uuid.UUID)
 				*ra.payment_order_receive_address = *value.S.(*uuid.UUID)
 			}
+		case receiveaddress.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_batch_receive_address", values[i])
+			} else if value.Valid {
+				ra.payment_order_batch_receive_address = new(uuid.UUID)
+				*ra.payment_order_batch_receive_address = *value.S.(*uuid.UUID)
+			}
 		default:
 			ra.selectValues.Set(columns[i], values[i])
 		}
@@ -179,6 +203,11 @@ func (ra *ReceiveAddress) QueryPaymentOrder() *PaymentOrderQuery {
 	return NewReceiveAddressClient(ra.config).QueryPaymentOrder(ra)
 }
 
+// QueryPaymentOrderBatch queries the "payment_order_batch" edge of the ReceiveAddress entity.
+func (ra *ReceiveAddress) QueryPaymentOrderBatch() *PaymentOrderBatchQuery {
+	return NewReceiveAddressClient(ra.config).QueryPaymentOrderBatch(ra)
+}
+
 // Update returns a builder for updating this ReceiveAddress.
 // Note that you need to call ReceiveAddress.Unwrap() before calling this method if this ReceiveAddress
 // was returned from a transaction, and the transaction was committed or rolled back.
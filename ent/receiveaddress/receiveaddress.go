@@ -35,6 +35,8 @@ const (
 	FieldValidUntil = "valid_until"
 	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
 	EdgePaymentOrder = "payment_order"
+	// EdgePaymentOrderBatch holds the string denoting the payment_order_batch edge name in mutations.
+	EdgePaymentOrderBatch = "payment_order_batch"
 	// Table holds the table name of the receiveaddress in the database.
 	Table = "receive_addresses"
 	// PaymentOrderTable is the table that holds the payment_order relation/edge.
@@ -44,6 +46,13 @@ const (
 	PaymentOrderInverseTable = "payment_orders"
 	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
 	PaymentOrderColumn = "payment_order_receive_address"
+	// PaymentOrderBatchTable is the table that holds the payment_order_batch relation/edge.
+	PaymentOrderBatchTable = "receive_addresses"
+	// PaymentOrderBatchInverseTable is the table name for the PaymentOrderBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorderbatch" package.
+	PaymentOrderBatchInverseTable = "payment_order_batches"
+	// PaymentOrderBatchColumn is the table column denoting the payment_order_batch relation/edge.
+	PaymentOrderBatchColumn = "payment_order_batch_receive_address"
 )
 
 // Columns holds all SQL columns for receiveaddress fields.
@@ -64,6 +73,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"payment_order_receive_address",
+	"payment_order_batch_receive_address",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -173,6 +183,13 @@ func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPaymentOrderBatchField orders the results by payment_order_batch field.
+func ByPaymentOrderBatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderBatchStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newPaymentOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -180,3 +197,10 @@ func newPaymentOrderStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, PaymentOrderTable, PaymentOrderColumn),
 	)
 }
+func newPaymentOrderBatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderBatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, PaymentOrderBatchTable, PaymentOrderBatchColumn),
+	)
+}
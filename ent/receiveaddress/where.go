@@ -548,6 +548,29 @@ func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.ReceiveAddre
 	})
 }
 
+// HasPaymentOrderBatch applies the HasEdge predicate on the "payment_order_batch" edge.
+func HasPaymentOrderBatch() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, PaymentOrderBatchTable, PaymentOrderBatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderBatchWith applies the HasEdge predicate on the "payment_order_batch" edge with a given conditions (other predicates).
+func HasPaymentOrderBatchWith(preds ...predicate.PaymentOrderBatch) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(func(s *sql.Selector) {
+		step := newPaymentOrderBatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ReceiveAddress) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 )
 
@@ -153,6 +154,25 @@ func (rac *ReceiveAddressCreate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddres
 	return rac.SetPaymentOrderID(p.ID)
 }
 
+// SetPaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID.
+func (rac *ReceiveAddressCreate) SetPaymentOrderBatchID(id uuid.UUID) *ReceiveAddressCreate {
+	rac.mutation.SetPaymentOrderBatchID(id)
+	return rac
+}
+
+// SetNillablePaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (rac *ReceiveAddressCreate) SetNillablePaymentOrderBatchID(id *uuid.UUID) *ReceiveAddressCreate {
+	if id != nil {
+		rac = rac.SetPaymentOrderBatchID(*id)
+	}
+	return rac
+}
+
+// SetPaymentOrderBatch sets the "payment_order_batch" edge to the PaymentOrderBatch entity.
+func (rac *ReceiveAddressCreate) SetPaymentOrderBatch(p *PaymentOrderBatch) *ReceiveAddressCreate {
+	return rac.SetPaymentOrderBatchID(p.ID)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rac *ReceiveAddressCreate) Mutation() *ReceiveAddressMutation {
 	return rac.mutation
@@ -309,6 +329,23 @@ func (rac *ReceiveAddressCreate) createSpec() (*ReceiveAddress, *sqlgraph.Create
 		_node.payment_order_receive_address = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rac.mutation.PaymentOrderBatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receiveaddress.PaymentOrderBatchTable,
+			Columns: []string{receiveaddress.PaymentOrderBatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_batch_receive_address = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 )
@@ -20,12 +21,13 @@ import (
 // ReceiveAddressQuery is the builder for querying ReceiveAddress entities.
 type ReceiveAddressQuery struct {
 	config
-	ctx              *QueryContext
-	order            []receiveaddress.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.ReceiveAddress
-	withPaymentOrder *PaymentOrderQuery
-	withFKs          bool
+	ctx                   *QueryContext
+	order                 []receiveaddress.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.ReceiveAddress
+	withPaymentOrder      *PaymentOrderQuery
+	withPaymentOrderBatch *PaymentOrderBatchQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (raq *ReceiveAddressQuery) QueryPaymentOrder() *PaymentOrderQuery {
 	return query
 }
 
+// QueryPaymentOrderBatch chains the current query on the "payment_order_batch" edge.
+func (raq *ReceiveAddressQuery) QueryPaymentOrderBatch() *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, selector),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderBatchTable, receiveaddress.PaymentOrderBatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ReceiveAddress entity from the query.
 // Returns a *NotFoundError when no ReceiveAddress was found.
 func (raq *ReceiveAddressQuery) First(ctx context.Context) (*ReceiveAddress, error) {
@@ -271,12 +295,13 @@ func (raq *ReceiveAddressQuery) Clone() *ReceiveAddressQuery {
 		return nil
 	}
 	return &ReceiveAddressQuery{
-		config:           raq.config,
-		ctx:              raq.ctx.Clone(),
-		order:            append([]receiveaddress.OrderOption{}, raq.order...),
-		inters:           append([]Interceptor{}, raq.inters...),
-		predicates:       append([]predicate.ReceiveAddress{}, raq.predicates...),
-		withPaymentOrder: raq.withPaymentOrder.Clone(),
+		config:                raq.config,
+		ctx:                   raq.ctx.Clone(),
+		order:                 append([]receiveaddress.OrderOption{}, raq.order...),
+		inters:                append([]Interceptor{}, raq.inters...),
+		predicates:            append([]predicate.ReceiveAddress{}, raq.predicates...),
+		withPaymentOrder:      raq.withPaymentOrder.Clone(),
+		withPaymentOrderBatch: raq.withPaymentOrderBatch.Clone(),
 		// clone intermediate query.
 		sql:  raq.sql.Clone(),
 		path: raq.path,
@@ -294,6 +319,17 @@ func (raq *ReceiveAddressQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery
 	return raq
 }
 
+// WithPaymentOrderBatch tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order_batch" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *ReceiveAddressQuery) WithPaymentOrderBatch(opts ...func(*PaymentOrderBatchQuery)) *ReceiveAddressQuery {
+	query := (&PaymentOrderBatchClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withPaymentOrderBatch = query
+	return raq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,11 +409,12 @@ func (raq *ReceiveAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ReceiveAddress{}
 		withFKs     = raq.withFKs
 		_spec       = raq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			raq.withPaymentOrder != nil,
+			raq.withPaymentOrderBatch != nil,
 		}
 	)
-	if raq.withPaymentOrder != nil {
+	if raq.withPaymentOrder != nil || raq.withPaymentOrderBatch != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -407,6 +444,12 @@ func (raq *ReceiveAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := raq.withPaymentOrderBatch; query != nil {
+		if err := raq.loadPaymentOrderBatch(ctx, query, nodes, nil,
+			func(n *ReceiveAddress, e *PaymentOrderBatch) { n.Edges.PaymentOrderBatch = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +485,38 @@ func (raq *ReceiveAddressQuery) loadPaymentOrder(ctx context.Context, query *Pay
 	}
 	return nil
 }
+func (raq *ReceiveAddressQuery) loadPaymentOrderBatch(ctx context.Context, query *PaymentOrderBatchQuery, nodes []*ReceiveAddress, init func(*ReceiveAddress), assign func(*ReceiveAddress, *PaymentOrderBatch)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReceiveAddress)
+	for i := range nodes {
+		if nodes[i].payment_order_batch_receive_address == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_batch_receive_address
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorderbatch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_batch_receive_address" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (raq *ReceiveAddressQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := raq.querySpec()
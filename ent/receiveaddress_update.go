@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 )
@@ -170,6 +171,25 @@ func (rau *ReceiveAddressUpdate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddres
 	return rau.SetPaymentOrderID(p.ID)
 }
 
+// SetPaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID.
+func (rau *ReceiveAddressUpdate) SetPaymentOrderBatchID(id uuid.UUID) *ReceiveAddressUpdate {
+	rau.mutation.SetPaymentOrderBatchID(id)
+	return rau
+}
+
+// SetNillablePaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (rau *ReceiveAddressUpdate) SetNillablePaymentOrderBatchID(id *uuid.UUID) *ReceiveAddressUpdate {
+	if id != nil {
+		rau = rau.SetPaymentOrderBatchID(*id)
+	}
+	return rau
+}
+
+// SetPaymentOrderBatch sets the "payment_order_batch" edge to the PaymentOrderBatch entity.
+func (rau *ReceiveAddressUpdate) SetPaymentOrderBatch(p *PaymentOrderBatch) *ReceiveAddressUpdate {
+	return rau.SetPaymentOrderBatchID(p.ID)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rau *ReceiveAddressUpdate) Mutation() *ReceiveAddressMutation {
 	return rau.mutation
@@ -181,6 +201,12 @@ func (rau *ReceiveAddressUpdate) ClearPaymentOrder() *ReceiveAddressUpdate {
 	return rau
 }
 
+// ClearPaymentOrderBatch clears the "payment_order_batch" edge to the PaymentOrderBatch entity.
+func (rau *ReceiveAddressUpdate) ClearPaymentOrderBatch() *ReceiveAddressUpdate {
+	rau.mutation.ClearPaymentOrderBatch()
+	return rau
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (rau *ReceiveAddressUpdate) Save(ctx context.Context) (int, error) {
 	rau.defaults()
@@ -309,6 +335,35 @@ func (rau *ReceiveAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if rau.mutation.PaymentOrderBatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receiveaddress.PaymentOrderBatchTable,
+			Columns: []string{receiveaddress.PaymentOrderBatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.PaymentOrderBatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receiveaddress.PaymentOrderBatchTable,
+			Columns: []string{receiveaddress.PaymentOrderBatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{receiveaddress.Label}
@@ -469,6 +524,25 @@ func (rauo *ReceiveAddressUpdateOne) SetPaymentOrder(p *PaymentOrder) *ReceiveAd
 	return rauo.SetPaymentOrderID(p.ID)
 }
 
+// SetPaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID.
+func (rauo *ReceiveAddressUpdateOne) SetPaymentOrderBatchID(id uuid.UUID) *ReceiveAddressUpdateOne {
+	rauo.mutation.SetPaymentOrderBatchID(id)
+	return rauo
+}
+
+// SetNillablePaymentOrderBatchID sets the "payment_order_batch" edge to the PaymentOrderBatch entity by ID if the given value is not nil.
+func (rauo *ReceiveAddressUpdateOne) SetNillablePaymentOrderBatchID(id *uuid.UUID) *ReceiveAddressUpdateOne {
+	if id != nil {
+		rauo = rauo.SetPaymentOrderBatchID(*id)
+	}
+	return rauo
+}
+
+// SetPaymentOrderBatch sets the "payment_order_batch" edge to the PaymentOrderBatch entity.
+func (rauo *ReceiveAddressUpdateOne) SetPaymentOrderBatch(p *PaymentOrderBatch) *ReceiveAddressUpdateOne {
+	return rauo.SetPaymentOrderBatchID(p.ID)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rauo *ReceiveAddressUpdateOne) Mutation() *ReceiveAddressMutation {
 	return rauo.mutation
@@ -480,6 +554,12 @@ func (rauo *ReceiveAddressUpdateOne) ClearPaymentOrder() *ReceiveAddressUpdateOn
 	return rauo
 }
 
+// ClearPaymentOrderBatch clears the "payment_order_batch" edge to the PaymentOrderBatch entity.
+func (rauo *ReceiveAddressUpdateOne) ClearPaymentOrderBatch() *ReceiveAddressUpdateOne {
+	rauo.mutation.ClearPaymentOrderBatch()
+	return rauo
+}
+
 // Where appends a list predicates to the ReceiveAddressUpdate builder.
 func (rauo *ReceiveAddressUpdateOne) Where(ps ...predicate.ReceiveAddress) *ReceiveAddressUpdateOne {
 	rauo.mutation.Where(ps...)
@@ -638,6 +718,35 @@ func (rauo *ReceiveAddressUpdateOne) sqlSave(ctx context.Context) (_node *Receiv
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if rauo.mutation.PaymentOrderBatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receiveaddress.PaymentOrderBatchTable,
+			Columns: []string{receiveaddress.PaymentOrderBatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.PaymentOrderBatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receiveaddress.PaymentOrderBatchTable,
+			Columns: []string{receiveaddress.PaymentOrderBatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ReceiveAddress{config: rauo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
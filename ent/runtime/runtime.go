@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -15,14 +17,21 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
 	"github.com/paycrest/aggregator/ent/schema"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
@@ -40,10 +49,66 @@ func init() {
 	apikeyDescSecret := apikeyFields[1].Descriptor()
 	// apikey.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
 	apikey.SecretValidator = apikeyDescSecret.Validators[0].(func(string) error)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.DefaultName holds the default value on creation for the name field.
+	apikey.DefaultName = apikeyDescName.Default.(string)
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescIsPrimary is the schema descriptor for is_primary field.
+	apikeyDescIsPrimary := apikeyFields[4].Descriptor()
+	// apikey.DefaultIsPrimary holds the default value on creation for the is_primary field.
+	apikey.DefaultIsPrimary = apikeyDescIsPrimary.Default.(bool)
+	// apikeyDescIsSandbox is the schema descriptor for is_sandbox field.
+	apikeyDescIsSandbox := apikeyFields[5].Descriptor()
+	// apikey.DefaultIsSandbox holds the default value on creation for the is_sandbox field.
+	apikey.DefaultIsSandbox = apikeyDescIsSandbox.Default.(bool)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
 	// apikeyDescID is the schema descriptor for id field.
 	apikeyDescID := apikeyFields[0].Descriptor()
 	// apikey.DefaultID holds the default value on creation for the id field.
 	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	beneficiaryMixin := schema.Beneficiary{}.Mixin()
+	beneficiaryMixinFields0 := beneficiaryMixin[0].Fields()
+	_ = beneficiaryMixinFields0
+	beneficiaryFields := schema.Beneficiary{}.Fields()
+	_ = beneficiaryFields
+	// beneficiaryDescCreatedAt is the schema descriptor for created_at field.
+	beneficiaryDescCreatedAt := beneficiaryMixinFields0[0].Descriptor()
+	// beneficiary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	beneficiary.DefaultCreatedAt = beneficiaryDescCreatedAt.Default.(func() time.Time)
+	// beneficiaryDescUpdatedAt is the schema descriptor for updated_at field.
+	beneficiaryDescUpdatedAt := beneficiaryMixinFields0[1].Descriptor()
+	// beneficiary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	beneficiary.DefaultUpdatedAt = beneficiaryDescUpdatedAt.Default.(func() time.Time)
+	// beneficiary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	beneficiary.UpdateDefaultUpdatedAt = beneficiaryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// beneficiaryDescID is the schema descriptor for id field.
+	beneficiaryDescID := beneficiaryFields[0].Descriptor()
+	// beneficiary.DefaultID holds the default value on creation for the id field.
+	beneficiary.DefaultID = beneficiaryDescID.Default.(func() uuid.UUID)
+	disputeMixin := schema.Dispute{}.Mixin()
+	disputeMixinFields0 := disputeMixin[0].Fields()
+	_ = disputeMixinFields0
+	disputeFields := schema.Dispute{}.Fields()
+	_ = disputeFields
+	// disputeDescCreatedAt is the schema descriptor for created_at field.
+	disputeDescCreatedAt := disputeMixinFields0[0].Descriptor()
+	// dispute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dispute.DefaultCreatedAt = disputeDescCreatedAt.Default.(func() time.Time)
+	// disputeDescUpdatedAt is the schema descriptor for updated_at field.
+	disputeDescUpdatedAt := disputeMixinFields0[1].Descriptor()
+	// dispute.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dispute.DefaultUpdatedAt = disputeDescUpdatedAt.Default.(func() time.Time)
+	// dispute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dispute.UpdateDefaultUpdatedAt = disputeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// disputeDescID is the schema descriptor for id field.
+	disputeDescID := disputeFields[0].Descriptor()
+	// dispute.DefaultID holds the default value on creation for the id field.
+	dispute.DefaultID = disputeDescID.Default.(func() uuid.UUID)
 	fiatcurrencyMixin := schema.FiatCurrency{}.Mixin()
 	fiatcurrencyMixinFields0 := fiatcurrencyMixin[0].Fields()
 	_ = fiatcurrencyMixinFields0
@@ -160,15 +225,15 @@ func init() {
 	// lockpaymentorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	lockpaymentorder.UpdateDefaultUpdatedAt = lockpaymentorderDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// lockpaymentorderDescTxHash is the schema descriptor for tx_hash field.
-	lockpaymentorderDescTxHash := lockpaymentorderFields[5].Descriptor()
+	lockpaymentorderDescTxHash := lockpaymentorderFields[6].Descriptor()
 	// lockpaymentorder.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	lockpaymentorder.TxHashValidator = lockpaymentorderDescTxHash.Validators[0].(func(string) error)
 	// lockpaymentorderDescCancellationCount is the schema descriptor for cancellation_count field.
-	lockpaymentorderDescCancellationCount := lockpaymentorderFields[12].Descriptor()
+	lockpaymentorderDescCancellationCount := lockpaymentorderFields[13].Descriptor()
 	// lockpaymentorder.DefaultCancellationCount holds the default value on creation for the cancellation_count field.
 	lockpaymentorder.DefaultCancellationCount = lockpaymentorderDescCancellationCount.Default.(int)
 	// lockpaymentorderDescCancellationReasons is the schema descriptor for cancellation_reasons field.
-	lockpaymentorderDescCancellationReasons := lockpaymentorderFields[13].Descriptor()
+	lockpaymentorderDescCancellationReasons := lockpaymentorderFields[14].Descriptor()
 	// lockpaymentorder.DefaultCancellationReasons holds the default value on creation for the cancellation_reasons field.
 	lockpaymentorder.DefaultCancellationReasons = lockpaymentorderDescCancellationReasons.Default.([]string)
 	// lockpaymentorderDescID is the schema descriptor for id field.
@@ -241,10 +306,76 @@ func init() {
 	paymentorderDescReference := paymentorderFields[17].Descriptor()
 	// paymentorder.ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
 	paymentorder.ReferenceValidator = paymentorderDescReference.Validators[0].(func(string) error)
+	// paymentorderDescFeeTier is the schema descriptor for fee_tier field.
+	paymentorderDescFeeTier := paymentorderFields[18].Descriptor()
+	// paymentorder.FeeTierValidator is a validator for the "fee_tier" field. It is called by the builders before save.
+	paymentorder.FeeTierValidator = paymentorderDescFeeTier.Validators[0].(func(string) error)
+	// paymentorderDescIsSandbox is the schema descriptor for is_sandbox field.
+	paymentorderDescIsSandbox := paymentorderFields[22].Descriptor()
+	// paymentorder.DefaultIsSandbox holds the default value on creation for the is_sandbox field.
+	paymentorder.DefaultIsSandbox = paymentorderDescIsSandbox.Default.(bool)
 	// paymentorderDescID is the schema descriptor for id field.
 	paymentorderDescID := paymentorderFields[0].Descriptor()
 	// paymentorder.DefaultID holds the default value on creation for the id field.
 	paymentorder.DefaultID = paymentorderDescID.Default.(func() uuid.UUID)
+	paymentorderbatchMixin := schema.PaymentOrderBatch{}.Mixin()
+	paymentorderbatchMixinFields0 := paymentorderbatchMixin[0].Fields()
+	_ = paymentorderbatchMixinFields0
+	paymentorderbatchFields := schema.PaymentOrderBatch{}.Fields()
+	_ = paymentorderbatchFields
+	// paymentorderbatchDescCreatedAt is the schema descriptor for created_at field.
+	paymentorderbatchDescCreatedAt := paymentorderbatchMixinFields0[0].Descriptor()
+	// paymentorderbatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentorderbatch.DefaultCreatedAt = paymentorderbatchDescCreatedAt.Default.(func() time.Time)
+	// paymentorderbatchDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentorderbatchDescUpdatedAt := paymentorderbatchMixinFields0[1].Descriptor()
+	// paymentorderbatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentorderbatch.DefaultUpdatedAt = paymentorderbatchDescUpdatedAt.Default.(func() time.Time)
+	// paymentorderbatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentorderbatch.UpdateDefaultUpdatedAt = paymentorderbatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentorderbatchDescTxHash is the schema descriptor for tx_hash field.
+	paymentorderbatchDescTxHash := paymentorderbatchFields[4].Descriptor()
+	// paymentorderbatch.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	paymentorderbatch.TxHashValidator = paymentorderbatchDescTxHash.Validators[0].(func(string) error)
+	// paymentorderbatchDescFromAddress is the schema descriptor for from_address field.
+	paymentorderbatchDescFromAddress := paymentorderbatchFields[5].Descriptor()
+	// paymentorderbatch.FromAddressValidator is a validator for the "from_address" field. It is called by the builders before save.
+	paymentorderbatch.FromAddressValidator = paymentorderbatchDescFromAddress.Validators[0].(func(string) error)
+	// paymentorderbatchDescReturnAddress is the schema descriptor for return_address field.
+	paymentorderbatchDescReturnAddress := paymentorderbatchFields[6].Descriptor()
+	// paymentorderbatch.ReturnAddressValidator is a validator for the "return_address" field. It is called by the builders before save.
+	paymentorderbatch.ReturnAddressValidator = paymentorderbatchDescReturnAddress.Validators[0].(func(string) error)
+	// paymentorderbatchDescReceiveAddressText is the schema descriptor for receive_address_text field.
+	paymentorderbatchDescReceiveAddressText := paymentorderbatchFields[7].Descriptor()
+	// paymentorderbatch.ReceiveAddressTextValidator is a validator for the "receive_address_text" field. It is called by the builders before save.
+	paymentorderbatch.ReceiveAddressTextValidator = paymentorderbatchDescReceiveAddressText.Validators[0].(func(string) error)
+	// paymentorderbatchDescReference is the schema descriptor for reference field.
+	paymentorderbatchDescReference := paymentorderbatchFields[8].Descriptor()
+	// paymentorderbatch.ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
+	paymentorderbatch.ReferenceValidator = paymentorderbatchDescReference.Validators[0].(func(string) error)
+	// paymentorderbatchDescID is the schema descriptor for id field.
+	paymentorderbatchDescID := paymentorderbatchFields[0].Descriptor()
+	// paymentorderbatch.DefaultID holds the default value on creation for the id field.
+	paymentorderbatch.DefaultID = paymentorderbatchDescID.Default.(func() uuid.UUID)
+	providerfiatbalanceMixin := schema.ProviderFiatBalance{}.Mixin()
+	providerfiatbalanceMixinFields0 := providerfiatbalanceMixin[0].Fields()
+	_ = providerfiatbalanceMixinFields0
+	providerfiatbalanceFields := schema.ProviderFiatBalance{}.Fields()
+	_ = providerfiatbalanceFields
+	// providerfiatbalanceDescCreatedAt is the schema descriptor for created_at field.
+	providerfiatbalanceDescCreatedAt := providerfiatbalanceMixinFields0[0].Descriptor()
+	// providerfiatbalance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	providerfiatbalance.DefaultCreatedAt = providerfiatbalanceDescCreatedAt.Default.(func() time.Time)
+	// providerfiatbalanceDescUpdatedAt is the schema descriptor for updated_at field.
+	providerfiatbalanceDescUpdatedAt := providerfiatbalanceMixinFields0[1].Descriptor()
+	// providerfiatbalance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	providerfiatbalance.DefaultUpdatedAt = providerfiatbalanceDescUpdatedAt.Default.(func() time.Time)
+	// providerfiatbalance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	providerfiatbalance.UpdateDefaultUpdatedAt = providerfiatbalanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerfiatbalanceDescID is the schema descriptor for id field.
+	providerfiatbalanceDescID := providerfiatbalanceFields[0].Descriptor()
+	// providerfiatbalance.DefaultID holds the default value on creation for the id field.
+	providerfiatbalance.DefaultID = providerfiatbalanceDescID.Default.(func() uuid.UUID)
 	providerordertokenMixin := schema.ProviderOrderToken{}.Mixin()
 	providerordertokenMixinFields0 := providerordertokenMixin[0].Fields()
 	_ = providerordertokenMixinFields0
@@ -280,14 +411,41 @@ func init() {
 	providerprofile.DefaultUpdatedAt = providerprofileDescUpdatedAt.Default.(func() time.Time)
 	// providerprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	providerprofile.UpdateDefaultUpdatedAt = providerprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerprofileDescTimezone is the schema descriptor for timezone field.
+	providerprofileDescTimezone := providerprofileFields[8].Descriptor()
+	// providerprofile.DefaultTimezone holds the default value on creation for the timezone field.
+	providerprofile.DefaultTimezone = providerprofileDescTimezone.Default.(string)
+	// providerprofileDescAutoAdjustStaleRates is the schema descriptor for auto_adjust_stale_rates field.
+	providerprofileDescAutoAdjustStaleRates := providerprofileFields[13].Descriptor()
+	// providerprofile.DefaultAutoAdjustStaleRates holds the default value on creation for the auto_adjust_stale_rates field.
+	providerprofile.DefaultAutoAdjustStaleRates = providerprofileDescAutoAdjustStaleRates.Default.(bool)
 	// providerprofileDescIsKybVerified is the schema descriptor for is_kyb_verified field.
-	providerprofileDescIsKybVerified := providerprofileFields[15].Descriptor()
+	providerprofileDescIsKybVerified := providerprofileFields[21].Descriptor()
 	// providerprofile.DefaultIsKybVerified holds the default value on creation for the is_kyb_verified field.
 	providerprofile.DefaultIsKybVerified = providerprofileDescIsKybVerified.Default.(bool)
 	// providerprofileDescID is the schema descriptor for id field.
 	providerprofileDescID := providerprofileFields[0].Descriptor()
 	// providerprofile.DefaultID holds the default value on creation for the id field.
 	providerprofile.DefaultID = providerprofileDescID.Default.(func() string)
+	providerrateexclusionMixin := schema.ProviderRateExclusion{}.Mixin()
+	providerrateexclusionMixinFields0 := providerrateexclusionMixin[0].Fields()
+	_ = providerrateexclusionMixinFields0
+	providerrateexclusionFields := schema.ProviderRateExclusion{}.Fields()
+	_ = providerrateexclusionFields
+	// providerrateexclusionDescCreatedAt is the schema descriptor for created_at field.
+	providerrateexclusionDescCreatedAt := providerrateexclusionMixinFields0[0].Descriptor()
+	// providerrateexclusion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	providerrateexclusion.DefaultCreatedAt = providerrateexclusionDescCreatedAt.Default.(func() time.Time)
+	// providerrateexclusionDescUpdatedAt is the schema descriptor for updated_at field.
+	providerrateexclusionDescUpdatedAt := providerrateexclusionMixinFields0[1].Descriptor()
+	// providerrateexclusion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	providerrateexclusion.DefaultUpdatedAt = providerrateexclusionDescUpdatedAt.Default.(func() time.Time)
+	// providerrateexclusion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	providerrateexclusion.UpdateDefaultUpdatedAt = providerrateexclusionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerrateexclusionDescID is the schema descriptor for id field.
+	providerrateexclusionDescID := providerrateexclusionFields[0].Descriptor()
+	// providerrateexclusion.DefaultID holds the default value on creation for the id field.
+	providerrateexclusion.DefaultID = providerrateexclusionDescID.Default.(func() uuid.UUID)
 	providerratingMixin := schema.ProviderRating{}.Mixin()
 	providerratingMixinFields0 := providerratingMixin[0].Fields()
 	_ = providerratingMixinFields0
@@ -328,6 +486,54 @@ func init() {
 	receiveaddressDescTxHash := receiveaddressFields[5].Descriptor()
 	// receiveaddress.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	receiveaddress.TxHashValidator = receiveaddressDescTxHash.Validators[0].(func(string) error)
+	scheduledorderMixin := schema.ScheduledOrder{}.Mixin()
+	scheduledorderMixinFields0 := scheduledorderMixin[0].Fields()
+	_ = scheduledorderMixinFields0
+	scheduledorderFields := schema.ScheduledOrder{}.Fields()
+	_ = scheduledorderFields
+	// scheduledorderDescCreatedAt is the schema descriptor for created_at field.
+	scheduledorderDescCreatedAt := scheduledorderMixinFields0[0].Descriptor()
+	// scheduledorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledorder.DefaultCreatedAt = scheduledorderDescCreatedAt.Default.(func() time.Time)
+	// scheduledorderDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledorderDescUpdatedAt := scheduledorderMixinFields0[1].Descriptor()
+	// scheduledorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledorder.DefaultUpdatedAt = scheduledorderDescUpdatedAt.Default.(func() time.Time)
+	// scheduledorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledorder.UpdateDefaultUpdatedAt = scheduledorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledorderDescID is the schema descriptor for id field.
+	scheduledorderDescID := scheduledorderFields[0].Descriptor()
+	// scheduledorder.DefaultID holds the default value on creation for the id field.
+	scheduledorder.DefaultID = scheduledorderDescID.Default.(func() uuid.UUID)
+	scheduledorderrunFields := schema.ScheduledOrderRun{}.Fields()
+	_ = scheduledorderrunFields
+	// scheduledorderrunDescCreatedAt is the schema descriptor for created_at field.
+	scheduledorderrunDescCreatedAt := scheduledorderrunFields[4].Descriptor()
+	// scheduledorderrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledorderrun.DefaultCreatedAt = scheduledorderrunDescCreatedAt.Default.(func() time.Time)
+	// scheduledorderrunDescID is the schema descriptor for id field.
+	scheduledorderrunDescID := scheduledorderrunFields[0].Descriptor()
+	// scheduledorderrun.DefaultID holds the default value on creation for the id field.
+	scheduledorderrun.DefaultID = scheduledorderrunDescID.Default.(func() uuid.UUID)
+	senderfeetierMixin := schema.SenderFeeTier{}.Mixin()
+	senderfeetierMixinFields0 := senderfeetierMixin[0].Fields()
+	_ = senderfeetierMixinFields0
+	senderfeetierFields := schema.SenderFeeTier{}.Fields()
+	_ = senderfeetierFields
+	// senderfeetierDescCreatedAt is the schema descriptor for created_at field.
+	senderfeetierDescCreatedAt := senderfeetierMixinFields0[0].Descriptor()
+	// senderfeetier.DefaultCreatedAt holds the default value on creation for the created_at field.
+	senderfeetier.DefaultCreatedAt = senderfeetierDescCreatedAt.Default.(func() time.Time)
+	// senderfeetierDescUpdatedAt is the schema descriptor for updated_at field.
+	senderfeetierDescUpdatedAt := senderfeetierMixinFields0[1].Descriptor()
+	// senderfeetier.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	senderfeetier.DefaultUpdatedAt = senderfeetierDescUpdatedAt.Default.(func() time.Time)
+	// senderfeetier.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	senderfeetier.UpdateDefaultUpdatedAt = senderfeetierDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// senderfeetierDescName is the schema descriptor for name field.
+	senderfeetierDescName := senderfeetierFields[0].Descriptor()
+	// senderfeetier.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	senderfeetier.NameValidator = senderfeetierDescName.Validators[0].(func(string) error)
 	senderordertokenMixin := schema.SenderOrderToken{}.Mixin()
 	senderordertokenMixinFields0 := senderordertokenMixin[0].Fields()
 	_ = senderordertokenMixinFields0
@@ -366,7 +572,7 @@ func init() {
 	// senderprofile.DefaultIsActive holds the default value on creation for the is_active field.
 	senderprofile.DefaultIsActive = senderprofileDescIsActive.Default.(bool)
 	// senderprofileDescUpdatedAt is the schema descriptor for updated_at field.
-	senderprofileDescUpdatedAt := senderprofileFields[6].Descriptor()
+	senderprofileDescUpdatedAt := senderprofileFields[8].Descriptor()
 	// senderprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	senderprofile.DefaultUpdatedAt = senderprofileDescUpdatedAt.Default.(func() time.Time)
 	// senderprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -375,6 +581,27 @@ func init() {
 	senderprofileDescID := senderprofileFields[0].Descriptor()
 	// senderprofile.DefaultID holds the default value on creation for the id field.
 	senderprofile.DefaultID = senderprofileDescID.Default.(func() uuid.UUID)
+	teammemberMixin := schema.TeamMember{}.Mixin()
+	teammemberHooks := schema.TeamMember{}.Hooks()
+	teammember.Hooks[0] = teammemberHooks[0]
+	teammemberMixinFields0 := teammemberMixin[0].Fields()
+	_ = teammemberMixinFields0
+	teammemberFields := schema.TeamMember{}.Fields()
+	_ = teammemberFields
+	// teammemberDescCreatedAt is the schema descriptor for created_at field.
+	teammemberDescCreatedAt := teammemberMixinFields0[0].Descriptor()
+	// teammember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teammember.DefaultCreatedAt = teammemberDescCreatedAt.Default.(func() time.Time)
+	// teammemberDescUpdatedAt is the schema descriptor for updated_at field.
+	teammemberDescUpdatedAt := teammemberMixinFields0[1].Descriptor()
+	// teammember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teammember.DefaultUpdatedAt = teammemberDescUpdatedAt.Default.(func() time.Time)
+	// teammember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teammember.UpdateDefaultUpdatedAt = teammemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teammemberDescID is the schema descriptor for id field.
+	teammemberDescID := teammemberFields[0].Descriptor()
+	// teammember.DefaultID holds the default value on creation for the id field.
+	teammember.DefaultID = teammemberDescID.Default.(func() uuid.UUID)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinFields0 := tokenMixin[0].Fields()
 	_ = tokenMixinFields0
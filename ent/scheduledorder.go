@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledOrder is the model entity for the ScheduledOrder schema.
+type ScheduledOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// Institution holds the value of the "institution" field.
+	Institution string `json:"institution,omitempty"`
+	// AccountIdentifier holds the value of the "account_identifier" field.
+	AccountIdentifier string `json:"account_identifier,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName string `json:"account_name,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// CronExpression holds the value of the "cron_expression" field.
+	CronExpression string `json:"cron_expression,omitempty"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at,omitempty"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt time.Time `json:"end_at,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt time.Time `json:"last_run_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scheduledorder.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledOrderQuery when eager-loading is set.
+	Edges                           ScheduledOrderEdges `json:"edges"`
+	sender_profile_scheduled_orders *uuid.UUID
+	token_scheduled_orders          *int
+	selectValues                    sql.SelectValues
+}
+
+// ScheduledOrderEdges holds the relations/edges for other nodes in the graph.
+type ScheduledOrderEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// Token holds the value of the token edge.
+	Token *Token `json:"token,omitempty"`
+	// Runs holds the value of the runs edge.
+	Runs []*ScheduledOrderRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledOrderEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// TokenOrErr returns the Token value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledOrderEdges) TokenOrErr() (*Token, error) {
+	if e.Token != nil {
+		return e.Token, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: token.Label}
+	}
+	return nil, &NotLoadedError{edge: "token"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScheduledOrderEdges) RunsOrErr() ([]*ScheduledOrderRun, error) {
+	if e.loadedTypes[2] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledorder.FieldAmount, scheduledorder.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case scheduledorder.FieldInstitution, scheduledorder.FieldAccountIdentifier, scheduledorder.FieldAccountName, scheduledorder.FieldMemo, scheduledorder.FieldCronExpression, scheduledorder.FieldStatus:
+			values[i] = new(sql.NullString)
+		case scheduledorder.FieldCreatedAt, scheduledorder.FieldUpdatedAt, scheduledorder.FieldStartAt, scheduledorder.FieldEndAt, scheduledorder.FieldNextRunAt, scheduledorder.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		case scheduledorder.FieldID:
+			values[i] = new(uuid.UUID)
+		case scheduledorder.ForeignKeys[0]: // sender_profile_scheduled_orders
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case scheduledorder.ForeignKeys[1]: // token_scheduled_orders
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledOrder fields.
+func (so *ScheduledOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledorder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				so.ID = *value
+			}
+		case scheduledorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				so.CreatedAt = value.Time
+			}
+		case scheduledorder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				so.UpdatedAt = value.Time
+			}
+		case scheduledorder.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				so.Amount = *value
+			}
+		case scheduledorder.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				so.Rate = *value
+			}
+		case scheduledorder.FieldInstitution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution", values[i])
+			} else if value.Valid {
+				so.Institution = value.String
+			}
+		case scheduledorder.FieldAccountIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_identifier", values[i])
+			} else if value.Valid {
+				so.AccountIdentifier = value.String
+			}
+		case scheduledorder.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				so.AccountName = value.String
+			}
+		case scheduledorder.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				so.Memo = value.String
+			}
+		case scheduledorder.FieldCronExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expression", values[i])
+			} else if value.Valid {
+				so.CronExpression = value.String
+			}
+		case scheduledorder.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				so.StartAt = value.Time
+			}
+		case scheduledorder.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				so.EndAt = value.Time
+			}
+		case scheduledorder.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				so.NextRunAt = value.Time
+			}
+		case scheduledorder.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				so.LastRunAt = value.Time
+			}
+		case scheduledorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				so.Status = scheduledorder.Status(value.String)
+			}
+		case scheduledorder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_scheduled_orders", values[i])
+			} else if value.Valid {
+				so.sender_profile_scheduled_orders = new(uuid.UUID)
+				*so.sender_profile_scheduled_orders = *value.S.(*uuid.UUID)
+			}
+		case scheduledorder.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field token_scheduled_orders", value)
+			} else if value.Valid {
+				so.token_scheduled_orders = new(int)
+				*so.token_scheduled_orders = int(value.Int64)
+			}
+		default:
+			so.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledOrder.
+// This includes values selected through modifiers, order, etc.
+func (so *ScheduledOrder) Value(name string) (ent.Value, error) {
+	return so.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the ScheduledOrder entity.
+func (so *ScheduledOrder) QuerySenderProfile() *SenderProfileQuery {
+	return NewScheduledOrderClient(so.config).QuerySenderProfile(so)
+}
+
+// QueryToken queries the "token" edge of the ScheduledOrder entity.
+func (so *ScheduledOrder) QueryToken() *TokenQuery {
+	return NewScheduledOrderClient(so.config).QueryToken(so)
+}
+
+// QueryRuns queries the "runs" edge of the ScheduledOrder entity.
+func (so *ScheduledOrder) QueryRuns() *ScheduledOrderRunQuery {
+	return NewScheduledOrderClient(so.config).QueryRuns(so)
+}
+
+// Update returns a builder for updating this ScheduledOrder.
+// Note that you need to call ScheduledOrder.Unwrap() before calling this method if this ScheduledOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (so *ScheduledOrder) Update() *ScheduledOrderUpdateOne {
+	return NewScheduledOrderClient(so.config).UpdateOne(so)
+}
+
+// Unwrap unwraps the ScheduledOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (so *ScheduledOrder) Unwrap() *ScheduledOrder {
+	_tx, ok := so.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledOrder is not a transactional entity")
+	}
+	so.config.driver = _tx.drv
+	return so
+}
+
+// String implements the fmt.Stringer.
+func (so *ScheduledOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", so.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(so.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(so.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", so.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", so.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("institution=")
+	builder.WriteString(so.Institution)
+	builder.WriteString(", ")
+	builder.WriteString("account_identifier=")
+	builder.WriteString(so.AccountIdentifier)
+	builder.WriteString(", ")
+	builder.WriteString("account_name=")
+	builder.WriteString(so.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(so.Memo)
+	builder.WriteString(", ")
+	builder.WriteString("cron_expression=")
+	builder.WriteString(so.CronExpression)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(so.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(so.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(so.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_run_at=")
+	builder.WriteString(so.LastRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", so.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledOrders is a parsable slice of ScheduledOrder.
+type ScheduledOrders []*ScheduledOrder
@@ -0,0 +1,922 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledorder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAmount, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldRate, v))
+}
+
+// Institution applies equality check predicate on the "institution" field. It's identical to InstitutionEQ.
+func Institution(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldInstitution, v))
+}
+
+// AccountIdentifier applies equality check predicate on the "account_identifier" field. It's identical to AccountIdentifierEQ.
+func AccountIdentifier(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAccountName, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldMemo, v))
+}
+
+// CronExpression applies equality check predicate on the "cron_expression" field. It's identical to CronExpressionEQ.
+func CronExpression(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldEndAt, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldAmount, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldRate, v))
+}
+
+// InstitutionEQ applies the EQ predicate on the "institution" field.
+func InstitutionEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldInstitution, v))
+}
+
+// InstitutionNEQ applies the NEQ predicate on the "institution" field.
+func InstitutionNEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldInstitution, v))
+}
+
+// InstitutionIn applies the In predicate on the "institution" field.
+func InstitutionIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldInstitution, vs...))
+}
+
+// InstitutionNotIn applies the NotIn predicate on the "institution" field.
+func InstitutionNotIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldInstitution, vs...))
+}
+
+// InstitutionGT applies the GT predicate on the "institution" field.
+func InstitutionGT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldInstitution, v))
+}
+
+// InstitutionGTE applies the GTE predicate on the "institution" field.
+func InstitutionGTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldInstitution, v))
+}
+
+// InstitutionLT applies the LT predicate on the "institution" field.
+func InstitutionLT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldInstitution, v))
+}
+
+// InstitutionLTE applies the LTE predicate on the "institution" field.
+func InstitutionLTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldInstitution, v))
+}
+
+// InstitutionContains applies the Contains predicate on the "institution" field.
+func InstitutionContains(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContains(FieldInstitution, v))
+}
+
+// InstitutionHasPrefix applies the HasPrefix predicate on the "institution" field.
+func InstitutionHasPrefix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasPrefix(FieldInstitution, v))
+}
+
+// InstitutionHasSuffix applies the HasSuffix predicate on the "institution" field.
+func InstitutionHasSuffix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasSuffix(FieldInstitution, v))
+}
+
+// InstitutionEqualFold applies the EqualFold predicate on the "institution" field.
+func InstitutionEqualFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEqualFold(FieldInstitution, v))
+}
+
+// InstitutionContainsFold applies the ContainsFold predicate on the "institution" field.
+func InstitutionContainsFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContainsFold(FieldInstitution, v))
+}
+
+// AccountIdentifierEQ applies the EQ predicate on the "account_identifier" field.
+func AccountIdentifierEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierNEQ applies the NEQ predicate on the "account_identifier" field.
+func AccountIdentifierNEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierIn applies the In predicate on the "account_identifier" field.
+func AccountIdentifierIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierNotIn applies the NotIn predicate on the "account_identifier" field.
+func AccountIdentifierNotIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldAccountIdentifier, vs...))
+}
+
+// AccountIdentifierGT applies the GT predicate on the "account_identifier" field.
+func AccountIdentifierGT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierGTE applies the GTE predicate on the "account_identifier" field.
+func AccountIdentifierGTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLT applies the LT predicate on the "account_identifier" field.
+func AccountIdentifierLT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierLTE applies the LTE predicate on the "account_identifier" field.
+func AccountIdentifierLTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContains applies the Contains predicate on the "account_identifier" field.
+func AccountIdentifierContains(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContains(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasPrefix applies the HasPrefix predicate on the "account_identifier" field.
+func AccountIdentifierHasPrefix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasPrefix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierHasSuffix applies the HasSuffix predicate on the "account_identifier" field.
+func AccountIdentifierHasSuffix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasSuffix(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierEqualFold applies the EqualFold predicate on the "account_identifier" field.
+func AccountIdentifierEqualFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEqualFold(FieldAccountIdentifier, v))
+}
+
+// AccountIdentifierContainsFold applies the ContainsFold predicate on the "account_identifier" field.
+func AccountIdentifierContainsFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContainsFold(FieldAccountIdentifier, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// CronExpressionEQ applies the EQ predicate on the "cron_expression" field.
+func CronExpressionEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// CronExpressionNEQ applies the NEQ predicate on the "cron_expression" field.
+func CronExpressionNEQ(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldCronExpression, v))
+}
+
+// CronExpressionIn applies the In predicate on the "cron_expression" field.
+func CronExpressionIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionNotIn applies the NotIn predicate on the "cron_expression" field.
+func CronExpressionNotIn(vs ...string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionGT applies the GT predicate on the "cron_expression" field.
+func CronExpressionGT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldCronExpression, v))
+}
+
+// CronExpressionGTE applies the GTE predicate on the "cron_expression" field.
+func CronExpressionGTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldCronExpression, v))
+}
+
+// CronExpressionLT applies the LT predicate on the "cron_expression" field.
+func CronExpressionLT(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldCronExpression, v))
+}
+
+// CronExpressionLTE applies the LTE predicate on the "cron_expression" field.
+func CronExpressionLTE(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldCronExpression, v))
+}
+
+// CronExpressionContains applies the Contains predicate on the "cron_expression" field.
+func CronExpressionContains(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContains(FieldCronExpression, v))
+}
+
+// CronExpressionHasPrefix applies the HasPrefix predicate on the "cron_expression" field.
+func CronExpressionHasPrefix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasPrefix(FieldCronExpression, v))
+}
+
+// CronExpressionHasSuffix applies the HasSuffix predicate on the "cron_expression" field.
+func CronExpressionHasSuffix(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldHasSuffix(FieldCronExpression, v))
+}
+
+// CronExpressionIsNil applies the IsNil predicate on the "cron_expression" field.
+func CronExpressionIsNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIsNull(FieldCronExpression))
+}
+
+// CronExpressionNotNil applies the NotNil predicate on the "cron_expression" field.
+func CronExpressionNotNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotNull(FieldCronExpression))
+}
+
+// CronExpressionEqualFold applies the EqualFold predicate on the "cron_expression" field.
+func CronExpressionEqualFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEqualFold(FieldCronExpression, v))
+}
+
+// CronExpressionContainsFold applies the ContainsFold predicate on the "cron_expression" field.
+func CronExpressionContainsFold(v string) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldContainsFold(FieldCronExpression, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldEndAt, v))
+}
+
+// EndAtIsNil applies the IsNil predicate on the "end_at" field.
+func EndAtIsNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIsNull(FieldEndAt))
+}
+
+// EndAtNotNil applies the NotNil predicate on the "end_at" field.
+func EndAtNotNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotNull(FieldEndAt))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasToken applies the HasEdge predicate on the "token" edge.
+func HasToken() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenWith applies the HasEdge predicate on the "token" edge with a given conditions (other predicates).
+func HasTokenWith(preds ...predicate.Token) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := newTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.ScheduledOrderRun) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledOrder) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledOrder) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledOrder) predicate.ScheduledOrder {
+	return predicate.ScheduledOrder(sql.NotPredicates(p))
+}
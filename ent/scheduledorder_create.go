@@ -0,0 +1,1467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledOrderCreate is the builder for creating a ScheduledOrder entity.
+type ScheduledOrderCreate struct {
+	config
+	mutation *ScheduledOrderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (soc *ScheduledOrderCreate) SetCreatedAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetCreatedAt(t)
+	return soc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableCreatedAt(t *time.Time) *ScheduledOrderCreate {
+	if t != nil {
+		soc.SetCreatedAt(*t)
+	}
+	return soc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (soc *ScheduledOrderCreate) SetUpdatedAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetUpdatedAt(t)
+	return soc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableUpdatedAt(t *time.Time) *ScheduledOrderCreate {
+	if t != nil {
+		soc.SetUpdatedAt(*t)
+	}
+	return soc
+}
+
+// SetAmount sets the "amount" field.
+func (soc *ScheduledOrderCreate) SetAmount(d decimal.Decimal) *ScheduledOrderCreate {
+	soc.mutation.SetAmount(d)
+	return soc
+}
+
+// SetRate sets the "rate" field.
+func (soc *ScheduledOrderCreate) SetRate(d decimal.Decimal) *ScheduledOrderCreate {
+	soc.mutation.SetRate(d)
+	return soc
+}
+
+// SetInstitution sets the "institution" field.
+func (soc *ScheduledOrderCreate) SetInstitution(s string) *ScheduledOrderCreate {
+	soc.mutation.SetInstitution(s)
+	return soc
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (soc *ScheduledOrderCreate) SetAccountIdentifier(s string) *ScheduledOrderCreate {
+	soc.mutation.SetAccountIdentifier(s)
+	return soc
+}
+
+// SetAccountName sets the "account_name" field.
+func (soc *ScheduledOrderCreate) SetAccountName(s string) *ScheduledOrderCreate {
+	soc.mutation.SetAccountName(s)
+	return soc
+}
+
+// SetMemo sets the "memo" field.
+func (soc *ScheduledOrderCreate) SetMemo(s string) *ScheduledOrderCreate {
+	soc.mutation.SetMemo(s)
+	return soc
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableMemo(s *string) *ScheduledOrderCreate {
+	if s != nil {
+		soc.SetMemo(*s)
+	}
+	return soc
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (soc *ScheduledOrderCreate) SetCronExpression(s string) *ScheduledOrderCreate {
+	soc.mutation.SetCronExpression(s)
+	return soc
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableCronExpression(s *string) *ScheduledOrderCreate {
+	if s != nil {
+		soc.SetCronExpression(*s)
+	}
+	return soc
+}
+
+// SetStartAt sets the "start_at" field.
+func (soc *ScheduledOrderCreate) SetStartAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetStartAt(t)
+	return soc
+}
+
+// SetEndAt sets the "end_at" field.
+func (soc *ScheduledOrderCreate) SetEndAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetEndAt(t)
+	return soc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableEndAt(t *time.Time) *ScheduledOrderCreate {
+	if t != nil {
+		soc.SetEndAt(*t)
+	}
+	return soc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (soc *ScheduledOrderCreate) SetNextRunAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetNextRunAt(t)
+	return soc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableNextRunAt(t *time.Time) *ScheduledOrderCreate {
+	if t != nil {
+		soc.SetNextRunAt(*t)
+	}
+	return soc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (soc *ScheduledOrderCreate) SetLastRunAt(t time.Time) *ScheduledOrderCreate {
+	soc.mutation.SetLastRunAt(t)
+	return soc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableLastRunAt(t *time.Time) *ScheduledOrderCreate {
+	if t != nil {
+		soc.SetLastRunAt(*t)
+	}
+	return soc
+}
+
+// SetStatus sets the "status" field.
+func (soc *ScheduledOrderCreate) SetStatus(s scheduledorder.Status) *ScheduledOrderCreate {
+	soc.mutation.SetStatus(s)
+	return soc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableStatus(s *scheduledorder.Status) *ScheduledOrderCreate {
+	if s != nil {
+		soc.SetStatus(*s)
+	}
+	return soc
+}
+
+// SetID sets the "id" field.
+func (soc *ScheduledOrderCreate) SetID(u uuid.UUID) *ScheduledOrderCreate {
+	soc.mutation.SetID(u)
+	return soc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (soc *ScheduledOrderCreate) SetNillableID(u *uuid.UUID) *ScheduledOrderCreate {
+	if u != nil {
+		soc.SetID(*u)
+	}
+	return soc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (soc *ScheduledOrderCreate) SetSenderProfileID(id uuid.UUID) *ScheduledOrderCreate {
+	soc.mutation.SetSenderProfileID(id)
+	return soc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (soc *ScheduledOrderCreate) SetSenderProfile(s *SenderProfile) *ScheduledOrderCreate {
+	return soc.SetSenderProfileID(s.ID)
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (soc *ScheduledOrderCreate) SetTokenID(id int) *ScheduledOrderCreate {
+	soc.mutation.SetTokenID(id)
+	return soc
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (soc *ScheduledOrderCreate) SetToken(t *Token) *ScheduledOrderCreate {
+	return soc.SetTokenID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduledOrderRun entity by IDs.
+func (soc *ScheduledOrderCreate) AddRunIDs(ids ...uuid.UUID) *ScheduledOrderCreate {
+	soc.mutation.AddRunIDs(ids...)
+	return soc
+}
+
+// AddRuns adds the "runs" edges to the ScheduledOrderRun entity.
+func (soc *ScheduledOrderCreate) AddRuns(s ...*ScheduledOrderRun) *ScheduledOrderCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return soc.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduledOrderMutation object of the builder.
+func (soc *ScheduledOrderCreate) Mutation() *ScheduledOrderMutation {
+	return soc.mutation
+}
+
+// Save creates the ScheduledOrder in the database.
+func (soc *ScheduledOrderCreate) Save(ctx context.Context) (*ScheduledOrder, error) {
+	soc.defaults()
+	return withHooks(ctx, soc.sqlSave, soc.mutation, soc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (soc *ScheduledOrderCreate) SaveX(ctx context.Context) *ScheduledOrder {
+	v, err := soc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (soc *ScheduledOrderCreate) Exec(ctx context.Context) error {
+	_, err := soc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soc *ScheduledOrderCreate) ExecX(ctx context.Context) {
+	if err := soc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soc *ScheduledOrderCreate) defaults() {
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		v := scheduledorder.DefaultCreatedAt()
+		soc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := soc.mutation.UpdatedAt(); !ok {
+		v := scheduledorder.DefaultUpdatedAt()
+		soc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := soc.mutation.Status(); !ok {
+		v := scheduledorder.DefaultStatus
+		soc.mutation.SetStatus(v)
+	}
+	if _, ok := soc.mutation.ID(); !ok {
+		v := scheduledorder.DefaultID()
+		soc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soc *ScheduledOrderCreate) check() error {
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledOrder.created_at"`)}
+	}
+	if _, ok := soc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledOrder.updated_at"`)}
+	}
+	if _, ok := soc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ScheduledOrder.amount"`)}
+	}
+	if _, ok := soc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ScheduledOrder.rate"`)}
+	}
+	if _, ok := soc.mutation.Institution(); !ok {
+		return &ValidationError{Name: "institution", err: errors.New(`ent: missing required field "ScheduledOrder.institution"`)}
+	}
+	if _, ok := soc.mutation.AccountIdentifier(); !ok {
+		return &ValidationError{Name: "account_identifier", err: errors.New(`ent: missing required field "ScheduledOrder.account_identifier"`)}
+	}
+	if _, ok := soc.mutation.AccountName(); !ok {
+		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "ScheduledOrder.account_name"`)}
+	}
+	if _, ok := soc.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "ScheduledOrder.start_at"`)}
+	}
+	if _, ok := soc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledOrder.status"`)}
+	}
+	if v, ok := soc.mutation.Status(); ok {
+		if err := scheduledorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrder.status": %w`, err)}
+		}
+	}
+	if len(soc.mutation.SenderProfileIDs()) == 0 {
+		return &ValidationError{Name: "sender_profile", err: errors.New(`ent: missing required edge "ScheduledOrder.sender_profile"`)}
+	}
+	if len(soc.mutation.TokenIDs()) == 0 {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "ScheduledOrder.token"`)}
+	}
+	return nil
+}
+
+func (soc *ScheduledOrderCreate) sqlSave(ctx context.Context) (*ScheduledOrder, error) {
+	if err := soc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := soc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, soc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	soc.mutation.id = &_node.ID
+	soc.mutation.done = true
+	return _node, nil
+}
+
+func (soc *ScheduledOrderCreate) createSpec() (*ScheduledOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledOrder{config: soc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledorder.Table, sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = soc.conflict
+	if id, ok := soc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := soc.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledorder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := soc.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledorder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := soc.mutation.Amount(); ok {
+		_spec.SetField(scheduledorder.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := soc.mutation.Rate(); ok {
+		_spec.SetField(scheduledorder.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := soc.mutation.Institution(); ok {
+		_spec.SetField(scheduledorder.FieldInstitution, field.TypeString, value)
+		_node.Institution = value
+	}
+	if value, ok := soc.mutation.AccountIdentifier(); ok {
+		_spec.SetField(scheduledorder.FieldAccountIdentifier, field.TypeString, value)
+		_node.AccountIdentifier = value
+	}
+	if value, ok := soc.mutation.AccountName(); ok {
+		_spec.SetField(scheduledorder.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := soc.mutation.Memo(); ok {
+		_spec.SetField(scheduledorder.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := soc.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledorder.FieldCronExpression, field.TypeString, value)
+		_node.CronExpression = value
+	}
+	if value, ok := soc.mutation.StartAt(); ok {
+		_spec.SetField(scheduledorder.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := soc.mutation.EndAt(); ok {
+		_spec.SetField(scheduledorder.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := soc.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := soc.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = value
+	}
+	if value, ok := soc.mutation.Status(); ok {
+		_spec.SetField(scheduledorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := soc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.SenderProfileTable,
+			Columns: []string{scheduledorder.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_scheduled_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := soc.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.TokenTable,
+			Columns: []string{scheduledorder.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.token_scheduled_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := soc.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledOrder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (soc *ScheduledOrderCreate) OnConflict(opts ...sql.ConflictOption) *ScheduledOrderUpsertOne {
+	soc.conflict = opts
+	return &ScheduledOrderUpsertOne{
+		create: soc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (soc *ScheduledOrderCreate) OnConflictColumns(columns ...string) *ScheduledOrderUpsertOne {
+	soc.conflict = append(soc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledOrderUpsertOne{
+		create: soc,
+	}
+}
+
+type (
+	// ScheduledOrderUpsertOne is the builder for "upsert"-ing
+	//  one ScheduledOrder node.
+	ScheduledOrderUpsertOne struct {
+		create *ScheduledOrderCreate
+	}
+
+	// ScheduledOrderUpsert is the "OnConflict" setter.
+	ScheduledOrderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledOrderUpsert) SetUpdatedAt(v time.Time) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateUpdatedAt() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *ScheduledOrderUpsert) SetAmount(v decimal.Decimal) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateAmount() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ScheduledOrderUpsert) AddAmount(v decimal.Decimal) *ScheduledOrderUpsert {
+	u.Add(scheduledorder.FieldAmount, v)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *ScheduledOrderUpsert) SetRate(v decimal.Decimal) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateRate() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ScheduledOrderUpsert) AddRate(v decimal.Decimal) *ScheduledOrderUpsert {
+	u.Add(scheduledorder.FieldRate, v)
+	return u
+}
+
+// SetInstitution sets the "institution" field.
+func (u *ScheduledOrderUpsert) SetInstitution(v string) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldInstitution, v)
+	return u
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateInstitution() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldInstitution)
+	return u
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *ScheduledOrderUpsert) SetAccountIdentifier(v string) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldAccountIdentifier, v)
+	return u
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateAccountIdentifier() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldAccountIdentifier)
+	return u
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *ScheduledOrderUpsert) SetAccountName(v string) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldAccountName, v)
+	return u
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateAccountName() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldAccountName)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *ScheduledOrderUpsert) SetMemo(v string) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateMemo() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *ScheduledOrderUpsert) ClearMemo() *ScheduledOrderUpsert {
+	u.SetNull(scheduledorder.FieldMemo)
+	return u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduledOrderUpsert) SetCronExpression(v string) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldCronExpression, v)
+	return u
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateCronExpression() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldCronExpression)
+	return u
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (u *ScheduledOrderUpsert) ClearCronExpression() *ScheduledOrderUpsert {
+	u.SetNull(scheduledorder.FieldCronExpression)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *ScheduledOrderUpsert) SetStartAt(v time.Time) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateStartAt() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldStartAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *ScheduledOrderUpsert) SetEndAt(v time.Time) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateEndAt() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldEndAt)
+	return u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *ScheduledOrderUpsert) ClearEndAt() *ScheduledOrderUpsert {
+	u.SetNull(scheduledorder.FieldEndAt)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledOrderUpsert) SetNextRunAt(v time.Time) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateNextRunAt() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduledOrderUpsert) ClearNextRunAt() *ScheduledOrderUpsert {
+	u.SetNull(scheduledorder.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduledOrderUpsert) SetLastRunAt(v time.Time) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateLastRunAt() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduledOrderUpsert) ClearLastRunAt() *ScheduledOrderUpsert {
+	u.SetNull(scheduledorder.FieldLastRunAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderUpsert) SetStatus(v scheduledorder.Status) *ScheduledOrderUpsert {
+	u.Set(scheduledorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderUpsert) UpdateStatus() *ScheduledOrderUpsert {
+	u.SetExcluded(scheduledorder.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledOrderUpsertOne) UpdateNewValues() *ScheduledOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scheduledorder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scheduledorder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduledOrderUpsertOne) Ignore() *ScheduledOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledOrderUpsertOne) DoNothing() *ScheduledOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledOrderCreate.OnConflict
+// documentation for more info.
+func (u *ScheduledOrderUpsertOne) Update(set func(*ScheduledOrderUpsert)) *ScheduledOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledOrderUpsertOne) SetUpdatedAt(v time.Time) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateUpdatedAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ScheduledOrderUpsertOne) SetAmount(v decimal.Decimal) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ScheduledOrderUpsertOne) AddAmount(v decimal.Decimal) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateAmount() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ScheduledOrderUpsertOne) SetRate(v decimal.Decimal) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ScheduledOrderUpsertOne) AddRate(v decimal.Decimal) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateRate() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetInstitution sets the "institution" field.
+func (u *ScheduledOrderUpsertOne) SetInstitution(v string) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateInstitution() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *ScheduledOrderUpsertOne) SetAccountIdentifier(v string) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateAccountIdentifier() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *ScheduledOrderUpsertOne) SetAccountName(v string) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateAccountName() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *ScheduledOrderUpsertOne) SetMemo(v string) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateMemo() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *ScheduledOrderUpsertOne) ClearMemo() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduledOrderUpsertOne) SetCronExpression(v string) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateCronExpression() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (u *ScheduledOrderUpsertOne) ClearCronExpression() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearCronExpression()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *ScheduledOrderUpsertOne) SetStartAt(v time.Time) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateStartAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *ScheduledOrderUpsertOne) SetEndAt(v time.Time) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateEndAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *ScheduledOrderUpsertOne) ClearEndAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledOrderUpsertOne) SetNextRunAt(v time.Time) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateNextRunAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduledOrderUpsertOne) ClearNextRunAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduledOrderUpsertOne) SetLastRunAt(v time.Time) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateLastRunAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduledOrderUpsertOne) ClearLastRunAt() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderUpsertOne) SetStatus(v scheduledorder.Status) *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertOne) UpdateStatus() *ScheduledOrderUpsertOne {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledOrderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledOrderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledOrderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduledOrderUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScheduledOrderUpsertOne.ID is not supported by MySQL driver. Use ScheduledOrderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduledOrderUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduledOrderCreateBulk is the builder for creating many ScheduledOrder entities in bulk.
+type ScheduledOrderCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledOrderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduledOrder entities in the database.
+func (socb *ScheduledOrderCreateBulk) Save(ctx context.Context) ([]*ScheduledOrder, error) {
+	if socb.err != nil {
+		return nil, socb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(socb.builders))
+	nodes := make([]*ScheduledOrder, len(socb.builders))
+	mutators := make([]Mutator, len(socb.builders))
+	for i := range socb.builders {
+		func(i int, root context.Context) {
+			builder := socb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, socb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = socb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, socb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, socb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (socb *ScheduledOrderCreateBulk) SaveX(ctx context.Context) []*ScheduledOrder {
+	v, err := socb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (socb *ScheduledOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := socb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (socb *ScheduledOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := socb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledOrder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (socb *ScheduledOrderCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduledOrderUpsertBulk {
+	socb.conflict = opts
+	return &ScheduledOrderUpsertBulk{
+		create: socb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (socb *ScheduledOrderCreateBulk) OnConflictColumns(columns ...string) *ScheduledOrderUpsertBulk {
+	socb.conflict = append(socb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledOrderUpsertBulk{
+		create: socb,
+	}
+}
+
+// ScheduledOrderUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduledOrder nodes.
+type ScheduledOrderUpsertBulk struct {
+	create *ScheduledOrderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledOrderUpsertBulk) UpdateNewValues() *ScheduledOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scheduledorder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scheduledorder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduledOrderUpsertBulk) Ignore() *ScheduledOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledOrderUpsertBulk) DoNothing() *ScheduledOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledOrderCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduledOrderUpsertBulk) Update(set func(*ScheduledOrderUpsert)) *ScheduledOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledOrderUpsertBulk) SetUpdatedAt(v time.Time) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateUpdatedAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ScheduledOrderUpsertBulk) SetAmount(v decimal.Decimal) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ScheduledOrderUpsertBulk) AddAmount(v decimal.Decimal) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateAmount() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ScheduledOrderUpsertBulk) SetRate(v decimal.Decimal) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ScheduledOrderUpsertBulk) AddRate(v decimal.Decimal) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateRate() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetInstitution sets the "institution" field.
+func (u *ScheduledOrderUpsertBulk) SetInstitution(v string) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetInstitution(v)
+	})
+}
+
+// UpdateInstitution sets the "institution" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateInstitution() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateInstitution()
+	})
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (u *ScheduledOrderUpsertBulk) SetAccountIdentifier(v string) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAccountIdentifier(v)
+	})
+}
+
+// UpdateAccountIdentifier sets the "account_identifier" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateAccountIdentifier() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAccountIdentifier()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *ScheduledOrderUpsertBulk) SetAccountName(v string) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateAccountName() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *ScheduledOrderUpsertBulk) SetMemo(v string) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateMemo() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *ScheduledOrderUpsertBulk) ClearMemo() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduledOrderUpsertBulk) SetCronExpression(v string) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateCronExpression() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (u *ScheduledOrderUpsertBulk) ClearCronExpression() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearCronExpression()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *ScheduledOrderUpsertBulk) SetStartAt(v time.Time) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateStartAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *ScheduledOrderUpsertBulk) SetEndAt(v time.Time) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateEndAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *ScheduledOrderUpsertBulk) ClearEndAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduledOrderUpsertBulk) SetNextRunAt(v time.Time) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateNextRunAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduledOrderUpsertBulk) ClearNextRunAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduledOrderUpsertBulk) SetLastRunAt(v time.Time) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateLastRunAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduledOrderUpsertBulk) ClearLastRunAt() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderUpsertBulk) SetStatus(v scheduledorder.Status) *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderUpsertBulk) UpdateStatus() *ScheduledOrderUpsertBulk {
+	return u.Update(func(s *ScheduledOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledOrderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduledOrderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledOrderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledOrderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+)
+
+// ScheduledOrderDelete is the builder for deleting a ScheduledOrder entity.
+type ScheduledOrderDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledOrderMutation
+}
+
+// Where appends a list predicates to the ScheduledOrderDelete builder.
+func (sod *ScheduledOrderDelete) Where(ps ...predicate.ScheduledOrder) *ScheduledOrderDelete {
+	sod.mutation.Where(ps...)
+	return sod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sod *ScheduledOrderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sod.sqlExec, sod.mutation, sod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sod *ScheduledOrderDelete) ExecX(ctx context.Context) int {
+	n, err := sod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sod *ScheduledOrderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scheduledorder.Table, sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID))
+	if ps := sod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sod.mutation.done = true
+	return affected, err
+}
+
+// ScheduledOrderDeleteOne is the builder for deleting a single ScheduledOrder entity.
+type ScheduledOrderDeleteOne struct {
+	sod *ScheduledOrderDelete
+}
+
+// Where appends a list predicates to the ScheduledOrderDelete builder.
+func (sodo *ScheduledOrderDeleteOne) Where(ps ...predicate.ScheduledOrder) *ScheduledOrderDeleteOne {
+	sodo.sod.mutation.Where(ps...)
+	return sodo
+}
+
+// Exec executes the deletion query.
+func (sodo *ScheduledOrderDeleteOne) Exec(ctx context.Context) error {
+	n, err := sodo.sod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scheduledorder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sodo *ScheduledOrderDeleteOne) ExecX(ctx context.Context) {
+	if err := sodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
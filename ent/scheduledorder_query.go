@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/token"
+)
+
+// ScheduledOrderQuery is the builder for querying ScheduledOrder entities.
+type ScheduledOrderQuery struct {
+	config
+	ctx               *QueryContext
+	order             []scheduledorder.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ScheduledOrder
+	withSenderProfile *SenderProfileQuery
+	withToken         *TokenQuery
+	withRuns          *ScheduledOrderRunQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduledOrderQuery builder.
+func (soq *ScheduledOrderQuery) Where(ps ...predicate.ScheduledOrder) *ScheduledOrderQuery {
+	soq.predicates = append(soq.predicates, ps...)
+	return soq
+}
+
+// Limit the number of records to be returned by this query.
+func (soq *ScheduledOrderQuery) Limit(limit int) *ScheduledOrderQuery {
+	soq.ctx.Limit = &limit
+	return soq
+}
+
+// Offset to start from.
+func (soq *ScheduledOrderQuery) Offset(offset int) *ScheduledOrderQuery {
+	soq.ctx.Offset = &offset
+	return soq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (soq *ScheduledOrderQuery) Unique(unique bool) *ScheduledOrderQuery {
+	soq.ctx.Unique = &unique
+	return soq
+}
+
+// Order specifies how the records should be ordered.
+func (soq *ScheduledOrderQuery) Order(o ...scheduledorder.OrderOption) *ScheduledOrderQuery {
+	soq.order = append(soq.order, o...)
+	return soq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (soq *ScheduledOrderQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: soq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := soq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := soq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorder.SenderProfileTable, scheduledorder.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(soq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryToken chains the current query on the "token" edge.
+func (soq *ScheduledOrderQuery) QueryToken() *TokenQuery {
+	query := (&TokenClient{config: soq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := soq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := soq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, selector),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorder.TokenTable, scheduledorder.TokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(soq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRuns chains the current query on the "runs" edge.
+func (soq *ScheduledOrderQuery) QueryRuns() *ScheduledOrderRunQuery {
+	query := (&ScheduledOrderRunClient{config: soq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := soq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := soq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorder.Table, scheduledorder.FieldID, selector),
+			sqlgraph.To(scheduledorderrun.Table, scheduledorderrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scheduledorder.RunsTable, scheduledorder.RunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(soq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScheduledOrder entity from the query.
+// Returns a *NotFoundError when no ScheduledOrder was found.
+func (soq *ScheduledOrderQuery) First(ctx context.Context) (*ScheduledOrder, error) {
+	nodes, err := soq.Limit(1).All(setContextOp(ctx, soq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scheduledorder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) FirstX(ctx context.Context) *ScheduledOrder {
+	node, err := soq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduledOrder ID from the query.
+// Returns a *NotFoundError when no ScheduledOrder ID was found.
+func (soq *ScheduledOrderQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = soq.Limit(1).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scheduledorder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := soq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduledOrder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScheduledOrder entity is found.
+// Returns a *NotFoundError when no ScheduledOrder entities are found.
+func (soq *ScheduledOrderQuery) Only(ctx context.Context) (*ScheduledOrder, error) {
+	nodes, err := soq.Limit(2).All(setContextOp(ctx, soq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scheduledorder.Label}
+	default:
+		return nil, &NotSingularError{scheduledorder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) OnlyX(ctx context.Context) *ScheduledOrder {
+	node, err := soq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduledOrder ID in the query.
+// Returns a *NotSingularError when more than one ScheduledOrder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (soq *ScheduledOrderQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = soq.Limit(2).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scheduledorder.Label}
+	default:
+		err = &NotSingularError{scheduledorder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := soq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduledOrders.
+func (soq *ScheduledOrderQuery) All(ctx context.Context) ([]*ScheduledOrder, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryAll)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScheduledOrder, *ScheduledOrderQuery]()
+	return withInterceptors[[]*ScheduledOrder](ctx, soq, qr, soq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) AllX(ctx context.Context) []*ScheduledOrder {
+	nodes, err := soq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduledOrder IDs.
+func (soq *ScheduledOrderQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if soq.ctx.Unique == nil && soq.path != nil {
+		soq.Unique(true)
+	}
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryIDs)
+	if err = soq.Select(scheduledorder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := soq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (soq *ScheduledOrderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryCount)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, soq, querierCount[*ScheduledOrderQuery](), soq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) CountX(ctx context.Context) int {
+	count, err := soq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (soq *ScheduledOrderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryExist)
+	switch _, err := soq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (soq *ScheduledOrderQuery) ExistX(ctx context.Context) bool {
+	exist, err := soq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduledOrderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (soq *ScheduledOrderQuery) Clone() *ScheduledOrderQuery {
+	if soq == nil {
+		return nil
+	}
+	return &ScheduledOrderQuery{
+		config:            soq.config,
+		ctx:               soq.ctx.Clone(),
+		order:             append([]scheduledorder.OrderOption{}, soq.order...),
+		inters:            append([]Interceptor{}, soq.inters...),
+		predicates:        append([]predicate.ScheduledOrder{}, soq.predicates...),
+		withSenderProfile: soq.withSenderProfile.Clone(),
+		withToken:         soq.withToken.Clone(),
+		withRuns:          soq.withRuns.Clone(),
+		// clone intermediate query.
+		sql:  soq.sql.Clone(),
+		path: soq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (soq *ScheduledOrderQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *ScheduledOrderQuery {
+	query := (&SenderProfileClient{config: soq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	soq.withSenderProfile = query
+	return soq
+}
+
+// WithToken tells the query-builder to eager-load the nodes that are connected to
+// the "token" edge. The optional arguments are used to configure the query builder of the edge.
+func (soq *ScheduledOrderQuery) WithToken(opts ...func(*TokenQuery)) *ScheduledOrderQuery {
+	query := (&TokenClient{config: soq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	soq.withToken = query
+	return soq
+}
+
+// WithRuns tells the query-builder to eager-load the nodes that are connected to
+// the "runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (soq *ScheduledOrderQuery) WithRuns(opts ...func(*ScheduledOrderRunQuery)) *ScheduledOrderQuery {
+	query := (&ScheduledOrderRunClient{config: soq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	soq.withRuns = query
+	return soq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduledOrder.Query().
+//		GroupBy(scheduledorder.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (soq *ScheduledOrderQuery) GroupBy(field string, fields ...string) *ScheduledOrderGroupBy {
+	soq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScheduledOrderGroupBy{build: soq}
+	grbuild.flds = &soq.ctx.Fields
+	grbuild.label = scheduledorder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ScheduledOrder.Query().
+//		Select(scheduledorder.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (soq *ScheduledOrderQuery) Select(fields ...string) *ScheduledOrderSelect {
+	soq.ctx.Fields = append(soq.ctx.Fields, fields...)
+	sbuild := &ScheduledOrderSelect{ScheduledOrderQuery: soq}
+	sbuild.label = scheduledorder.Label
+	sbuild.flds, sbuild.scan = &soq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScheduledOrderSelect configured with the given aggregations.
+func (soq *ScheduledOrderQuery) Aggregate(fns ...AggregateFunc) *ScheduledOrderSelect {
+	return soq.Select().Aggregate(fns...)
+}
+
+func (soq *ScheduledOrderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range soq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, soq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range soq.ctx.Fields {
+		if !scheduledorder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if soq.path != nil {
+		prev, err := soq.path(ctx)
+		if err != nil {
+			return err
+		}
+		soq.sql = prev
+	}
+	return nil
+}
+
+func (soq *ScheduledOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScheduledOrder, error) {
+	var (
+		nodes       = []*ScheduledOrder{}
+		withFKs     = soq.withFKs
+		_spec       = soq.querySpec()
+		loadedTypes = [3]bool{
+			soq.withSenderProfile != nil,
+			soq.withToken != nil,
+			soq.withRuns != nil,
+		}
+	)
+	if soq.withSenderProfile != nil || soq.withToken != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorder.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScheduledOrder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScheduledOrder{config: soq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, soq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := soq.withSenderProfile; query != nil {
+		if err := soq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *ScheduledOrder, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := soq.withToken; query != nil {
+		if err := soq.loadToken(ctx, query, nodes, nil,
+			func(n *ScheduledOrder, e *Token) { n.Edges.Token = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := soq.withRuns; query != nil {
+		if err := soq.loadRuns(ctx, query, nodes,
+			func(n *ScheduledOrder) { n.Edges.Runs = []*ScheduledOrderRun{} },
+			func(n *ScheduledOrder, e *ScheduledOrderRun) { n.Edges.Runs = append(n.Edges.Runs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (soq *ScheduledOrderQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*ScheduledOrder, init func(*ScheduledOrder), assign func(*ScheduledOrder, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScheduledOrder)
+	for i := range nodes {
+		if nodes[i].sender_profile_scheduled_orders == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_scheduled_orders
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_scheduled_orders" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (soq *ScheduledOrderQuery) loadToken(ctx context.Context, query *TokenQuery, nodes []*ScheduledOrder, init func(*ScheduledOrder), assign func(*ScheduledOrder, *Token)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScheduledOrder)
+	for i := range nodes {
+		if nodes[i].token_scheduled_orders == nil {
+			continue
+		}
+		fk := *nodes[i].token_scheduled_orders
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(token.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "token_scheduled_orders" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (soq *ScheduledOrderQuery) loadRuns(ctx context.Context, query *ScheduledOrderRunQuery, nodes []*ScheduledOrder, init func(*ScheduledOrder), assign func(*ScheduledOrder, *ScheduledOrderRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ScheduledOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScheduledOrderRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(scheduledorder.RunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.scheduled_order_runs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "scheduled_order_runs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "scheduled_order_runs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (soq *ScheduledOrderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := soq.querySpec()
+	_spec.Node.Columns = soq.ctx.Fields
+	if len(soq.ctx.Fields) > 0 {
+		_spec.Unique = soq.ctx.Unique != nil && *soq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, soq.driver, _spec)
+}
+
+func (soq *ScheduledOrderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scheduledorder.Table, scheduledorder.Columns, sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID))
+	_spec.From = soq.sql
+	if unique := soq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if soq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := soq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorder.FieldID)
+		for i := range fields {
+			if fields[i] != scheduledorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := soq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := soq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (soq *ScheduledOrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(soq.driver.Dialect())
+	t1 := builder.Table(scheduledorder.Table)
+	columns := soq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scheduledorder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if soq.sql != nil {
+		selector = soq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if soq.ctx.Unique != nil && *soq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range soq.predicates {
+		p(selector)
+	}
+	for _, p := range soq.order {
+		p(selector)
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScheduledOrderGroupBy is the group-by builder for ScheduledOrder entities.
+type ScheduledOrderGroupBy struct {
+	selector
+	build *ScheduledOrderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sogb *ScheduledOrderGroupBy) Aggregate(fns ...AggregateFunc) *ScheduledOrderGroupBy {
+	sogb.fns = append(sogb.fns, fns...)
+	return sogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sogb *ScheduledOrderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sogb.build.ctx, ent.OpQueryGroupBy)
+	if err := sogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledOrderQuery, *ScheduledOrderGroupBy](ctx, sogb.build, sogb, sogb.build.inters, v)
+}
+
+func (sogb *ScheduledOrderGroupBy) sqlScan(ctx context.Context, root *ScheduledOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sogb.fns))
+	for _, fn := range sogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sogb.flds)+len(sogb.fns))
+		for _, f := range *sogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScheduledOrderSelect is the builder for selecting fields of ScheduledOrder entities.
+type ScheduledOrderSelect struct {
+	*ScheduledOrderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sos *ScheduledOrderSelect) Aggregate(fns ...AggregateFunc) *ScheduledOrderSelect {
+	sos.fns = append(sos.fns, fns...)
+	return sos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sos *ScheduledOrderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sos.ctx, ent.OpQuerySelect)
+	if err := sos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledOrderQuery, *ScheduledOrderSelect](ctx, sos.ScheduledOrderQuery, sos, sos.inters, v)
+}
+
+func (sos *ScheduledOrderSelect) sqlScan(ctx context.Context, root *ScheduledOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sos.fns))
+	for _, fn := range sos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1046 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/token"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledOrderUpdate is the builder for updating ScheduledOrder entities.
+type ScheduledOrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledOrderMutation
+}
+
+// Where appends a list predicates to the ScheduledOrderUpdate builder.
+func (sou *ScheduledOrderUpdate) Where(ps ...predicate.ScheduledOrder) *ScheduledOrderUpdate {
+	sou.mutation.Where(ps...)
+	return sou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sou *ScheduledOrderUpdate) SetUpdatedAt(t time.Time) *ScheduledOrderUpdate {
+	sou.mutation.SetUpdatedAt(t)
+	return sou
+}
+
+// SetAmount sets the "amount" field.
+func (sou *ScheduledOrderUpdate) SetAmount(d decimal.Decimal) *ScheduledOrderUpdate {
+	sou.mutation.ResetAmount()
+	sou.mutation.SetAmount(d)
+	return sou
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableAmount(d *decimal.Decimal) *ScheduledOrderUpdate {
+	if d != nil {
+		sou.SetAmount(*d)
+	}
+	return sou
+}
+
+// AddAmount adds d to the "amount" field.
+func (sou *ScheduledOrderUpdate) AddAmount(d decimal.Decimal) *ScheduledOrderUpdate {
+	sou.mutation.AddAmount(d)
+	return sou
+}
+
+// SetRate sets the "rate" field.
+func (sou *ScheduledOrderUpdate) SetRate(d decimal.Decimal) *ScheduledOrderUpdate {
+	sou.mutation.ResetRate()
+	sou.mutation.SetRate(d)
+	return sou
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableRate(d *decimal.Decimal) *ScheduledOrderUpdate {
+	if d != nil {
+		sou.SetRate(*d)
+	}
+	return sou
+}
+
+// AddRate adds d to the "rate" field.
+func (sou *ScheduledOrderUpdate) AddRate(d decimal.Decimal) *ScheduledOrderUpdate {
+	sou.mutation.AddRate(d)
+	return sou
+}
+
+// SetInstitution sets the "institution" field.
+func (sou *ScheduledOrderUpdate) SetInstitution(s string) *ScheduledOrderUpdate {
+	sou.mutation.SetInstitution(s)
+	return sou
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableInstitution(s *string) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetInstitution(*s)
+	}
+	return sou
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (sou *ScheduledOrderUpdate) SetAccountIdentifier(s string) *ScheduledOrderUpdate {
+	sou.mutation.SetAccountIdentifier(s)
+	return sou
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableAccountIdentifier(s *string) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetAccountIdentifier(*s)
+	}
+	return sou
+}
+
+// SetAccountName sets the "account_name" field.
+func (sou *ScheduledOrderUpdate) SetAccountName(s string) *ScheduledOrderUpdate {
+	sou.mutation.SetAccountName(s)
+	return sou
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableAccountName(s *string) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetAccountName(*s)
+	}
+	return sou
+}
+
+// SetMemo sets the "memo" field.
+func (sou *ScheduledOrderUpdate) SetMemo(s string) *ScheduledOrderUpdate {
+	sou.mutation.SetMemo(s)
+	return sou
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableMemo(s *string) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetMemo(*s)
+	}
+	return sou
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (sou *ScheduledOrderUpdate) ClearMemo() *ScheduledOrderUpdate {
+	sou.mutation.ClearMemo()
+	return sou
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (sou *ScheduledOrderUpdate) SetCronExpression(s string) *ScheduledOrderUpdate {
+	sou.mutation.SetCronExpression(s)
+	return sou
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableCronExpression(s *string) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetCronExpression(*s)
+	}
+	return sou
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (sou *ScheduledOrderUpdate) ClearCronExpression() *ScheduledOrderUpdate {
+	sou.mutation.ClearCronExpression()
+	return sou
+}
+
+// SetStartAt sets the "start_at" field.
+func (sou *ScheduledOrderUpdate) SetStartAt(t time.Time) *ScheduledOrderUpdate {
+	sou.mutation.SetStartAt(t)
+	return sou
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableStartAt(t *time.Time) *ScheduledOrderUpdate {
+	if t != nil {
+		sou.SetStartAt(*t)
+	}
+	return sou
+}
+
+// SetEndAt sets the "end_at" field.
+func (sou *ScheduledOrderUpdate) SetEndAt(t time.Time) *ScheduledOrderUpdate {
+	sou.mutation.SetEndAt(t)
+	return sou
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableEndAt(t *time.Time) *ScheduledOrderUpdate {
+	if t != nil {
+		sou.SetEndAt(*t)
+	}
+	return sou
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (sou *ScheduledOrderUpdate) ClearEndAt() *ScheduledOrderUpdate {
+	sou.mutation.ClearEndAt()
+	return sou
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sou *ScheduledOrderUpdate) SetNextRunAt(t time.Time) *ScheduledOrderUpdate {
+	sou.mutation.SetNextRunAt(t)
+	return sou
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableNextRunAt(t *time.Time) *ScheduledOrderUpdate {
+	if t != nil {
+		sou.SetNextRunAt(*t)
+	}
+	return sou
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (sou *ScheduledOrderUpdate) ClearNextRunAt() *ScheduledOrderUpdate {
+	sou.mutation.ClearNextRunAt()
+	return sou
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sou *ScheduledOrderUpdate) SetLastRunAt(t time.Time) *ScheduledOrderUpdate {
+	sou.mutation.SetLastRunAt(t)
+	return sou
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableLastRunAt(t *time.Time) *ScheduledOrderUpdate {
+	if t != nil {
+		sou.SetLastRunAt(*t)
+	}
+	return sou
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (sou *ScheduledOrderUpdate) ClearLastRunAt() *ScheduledOrderUpdate {
+	sou.mutation.ClearLastRunAt()
+	return sou
+}
+
+// SetStatus sets the "status" field.
+func (sou *ScheduledOrderUpdate) SetStatus(s scheduledorder.Status) *ScheduledOrderUpdate {
+	sou.mutation.SetStatus(s)
+	return sou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sou *ScheduledOrderUpdate) SetNillableStatus(s *scheduledorder.Status) *ScheduledOrderUpdate {
+	if s != nil {
+		sou.SetStatus(*s)
+	}
+	return sou
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (sou *ScheduledOrderUpdate) SetTokenID(id int) *ScheduledOrderUpdate {
+	sou.mutation.SetTokenID(id)
+	return sou
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (sou *ScheduledOrderUpdate) SetToken(t *Token) *ScheduledOrderUpdate {
+	return sou.SetTokenID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduledOrderRun entity by IDs.
+func (sou *ScheduledOrderUpdate) AddRunIDs(ids ...uuid.UUID) *ScheduledOrderUpdate {
+	sou.mutation.AddRunIDs(ids...)
+	return sou
+}
+
+// AddRuns adds the "runs" edges to the ScheduledOrderRun entity.
+func (sou *ScheduledOrderUpdate) AddRuns(s ...*ScheduledOrderRun) *ScheduledOrderUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sou.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduledOrderMutation object of the builder.
+func (sou *ScheduledOrderUpdate) Mutation() *ScheduledOrderMutation {
+	return sou.mutation
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (sou *ScheduledOrderUpdate) ClearToken() *ScheduledOrderUpdate {
+	sou.mutation.ClearToken()
+	return sou
+}
+
+// ClearRuns clears all "runs" edges to the ScheduledOrderRun entity.
+func (sou *ScheduledOrderUpdate) ClearRuns() *ScheduledOrderUpdate {
+	sou.mutation.ClearRuns()
+	return sou
+}
+
+// RemoveRunIDs removes the "runs" edge to ScheduledOrderRun entities by IDs.
+func (sou *ScheduledOrderUpdate) RemoveRunIDs(ids ...uuid.UUID) *ScheduledOrderUpdate {
+	sou.mutation.RemoveRunIDs(ids...)
+	return sou
+}
+
+// RemoveRuns removes "runs" edges to ScheduledOrderRun entities.
+func (sou *ScheduledOrderUpdate) RemoveRuns(s ...*ScheduledOrderRun) *ScheduledOrderUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sou.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sou *ScheduledOrderUpdate) Save(ctx context.Context) (int, error) {
+	sou.defaults()
+	return withHooks(ctx, sou.sqlSave, sou.mutation, sou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sou *ScheduledOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := sou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sou *ScheduledOrderUpdate) Exec(ctx context.Context) error {
+	_, err := sou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sou *ScheduledOrderUpdate) ExecX(ctx context.Context) {
+	if err := sou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sou *ScheduledOrderUpdate) defaults() {
+	if _, ok := sou.mutation.UpdatedAt(); !ok {
+		v := scheduledorder.UpdateDefaultUpdatedAt()
+		sou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sou *ScheduledOrderUpdate) check() error {
+	if v, ok := sou.mutation.Status(); ok {
+		if err := scheduledorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrder.status": %w`, err)}
+		}
+	}
+	if sou.mutation.SenderProfileCleared() && len(sou.mutation.SenderProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrder.sender_profile"`)
+	}
+	if sou.mutation.TokenCleared() && len(sou.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrder.token"`)
+	}
+	return nil
+}
+
+func (sou *ScheduledOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledorder.Table, scheduledorder.Columns, sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID))
+	if ps := sou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sou.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sou.mutation.Amount(); ok {
+		_spec.SetField(scheduledorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sou.mutation.AddedAmount(); ok {
+		_spec.AddField(scheduledorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sou.mutation.Rate(); ok {
+		_spec.SetField(scheduledorder.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := sou.mutation.AddedRate(); ok {
+		_spec.AddField(scheduledorder.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := sou.mutation.Institution(); ok {
+		_spec.SetField(scheduledorder.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.AccountIdentifier(); ok {
+		_spec.SetField(scheduledorder.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.AccountName(); ok {
+		_spec.SetField(scheduledorder.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.Memo(); ok {
+		_spec.SetField(scheduledorder.FieldMemo, field.TypeString, value)
+	}
+	if sou.mutation.MemoCleared() {
+		_spec.ClearField(scheduledorder.FieldMemo, field.TypeString)
+	}
+	if value, ok := sou.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledorder.FieldCronExpression, field.TypeString, value)
+	}
+	if sou.mutation.CronExpressionCleared() {
+		_spec.ClearField(scheduledorder.FieldCronExpression, field.TypeString)
+	}
+	if value, ok := sou.mutation.StartAt(); ok {
+		_spec.SetField(scheduledorder.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := sou.mutation.EndAt(); ok {
+		_spec.SetField(scheduledorder.FieldEndAt, field.TypeTime, value)
+	}
+	if sou.mutation.EndAtCleared() {
+		_spec.ClearField(scheduledorder.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := sou.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldNextRunAt, field.TypeTime, value)
+	}
+	if sou.mutation.NextRunAtCleared() {
+		_spec.ClearField(scheduledorder.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := sou.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldLastRunAt, field.TypeTime, value)
+	}
+	if sou.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledorder.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := sou.mutation.Status(); ok {
+		_spec.SetField(scheduledorder.FieldStatus, field.TypeEnum, value)
+	}
+	if sou.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.TokenTable,
+			Columns: []string{scheduledorder.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sou.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.TokenTable,
+			Columns: []string{scheduledorder.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sou.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sou.mutation.RemovedRunsIDs(); len(nodes) > 0 && !sou.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sou.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sou.mutation.done = true
+	return n, nil
+}
+
+// ScheduledOrderUpdateOne is the builder for updating a single ScheduledOrder entity.
+type ScheduledOrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledOrderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (souo *ScheduledOrderUpdateOne) SetUpdatedAt(t time.Time) *ScheduledOrderUpdateOne {
+	souo.mutation.SetUpdatedAt(t)
+	return souo
+}
+
+// SetAmount sets the "amount" field.
+func (souo *ScheduledOrderUpdateOne) SetAmount(d decimal.Decimal) *ScheduledOrderUpdateOne {
+	souo.mutation.ResetAmount()
+	souo.mutation.SetAmount(d)
+	return souo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableAmount(d *decimal.Decimal) *ScheduledOrderUpdateOne {
+	if d != nil {
+		souo.SetAmount(*d)
+	}
+	return souo
+}
+
+// AddAmount adds d to the "amount" field.
+func (souo *ScheduledOrderUpdateOne) AddAmount(d decimal.Decimal) *ScheduledOrderUpdateOne {
+	souo.mutation.AddAmount(d)
+	return souo
+}
+
+// SetRate sets the "rate" field.
+func (souo *ScheduledOrderUpdateOne) SetRate(d decimal.Decimal) *ScheduledOrderUpdateOne {
+	souo.mutation.ResetRate()
+	souo.mutation.SetRate(d)
+	return souo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableRate(d *decimal.Decimal) *ScheduledOrderUpdateOne {
+	if d != nil {
+		souo.SetRate(*d)
+	}
+	return souo
+}
+
+// AddRate adds d to the "rate" field.
+func (souo *ScheduledOrderUpdateOne) AddRate(d decimal.Decimal) *ScheduledOrderUpdateOne {
+	souo.mutation.AddRate(d)
+	return souo
+}
+
+// SetInstitution sets the "institution" field.
+func (souo *ScheduledOrderUpdateOne) SetInstitution(s string) *ScheduledOrderUpdateOne {
+	souo.mutation.SetInstitution(s)
+	return souo
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableInstitution(s *string) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetInstitution(*s)
+	}
+	return souo
+}
+
+// SetAccountIdentifier sets the "account_identifier" field.
+func (souo *ScheduledOrderUpdateOne) SetAccountIdentifier(s string) *ScheduledOrderUpdateOne {
+	souo.mutation.SetAccountIdentifier(s)
+	return souo
+}
+
+// SetNillableAccountIdentifier sets the "account_identifier" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableAccountIdentifier(s *string) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetAccountIdentifier(*s)
+	}
+	return souo
+}
+
+// SetAccountName sets the "account_name" field.
+func (souo *ScheduledOrderUpdateOne) SetAccountName(s string) *ScheduledOrderUpdateOne {
+	souo.mutation.SetAccountName(s)
+	return souo
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableAccountName(s *string) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetAccountName(*s)
+	}
+	return souo
+}
+
+// SetMemo sets the "memo" field.
+func (souo *ScheduledOrderUpdateOne) SetMemo(s string) *ScheduledOrderUpdateOne {
+	souo.mutation.SetMemo(s)
+	return souo
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableMemo(s *string) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetMemo(*s)
+	}
+	return souo
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (souo *ScheduledOrderUpdateOne) ClearMemo() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearMemo()
+	return souo
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (souo *ScheduledOrderUpdateOne) SetCronExpression(s string) *ScheduledOrderUpdateOne {
+	souo.mutation.SetCronExpression(s)
+	return souo
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableCronExpression(s *string) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetCronExpression(*s)
+	}
+	return souo
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (souo *ScheduledOrderUpdateOne) ClearCronExpression() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearCronExpression()
+	return souo
+}
+
+// SetStartAt sets the "start_at" field.
+func (souo *ScheduledOrderUpdateOne) SetStartAt(t time.Time) *ScheduledOrderUpdateOne {
+	souo.mutation.SetStartAt(t)
+	return souo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableStartAt(t *time.Time) *ScheduledOrderUpdateOne {
+	if t != nil {
+		souo.SetStartAt(*t)
+	}
+	return souo
+}
+
+// SetEndAt sets the "end_at" field.
+func (souo *ScheduledOrderUpdateOne) SetEndAt(t time.Time) *ScheduledOrderUpdateOne {
+	souo.mutation.SetEndAt(t)
+	return souo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableEndAt(t *time.Time) *ScheduledOrderUpdateOne {
+	if t != nil {
+		souo.SetEndAt(*t)
+	}
+	return souo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (souo *ScheduledOrderUpdateOne) ClearEndAt() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearEndAt()
+	return souo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (souo *ScheduledOrderUpdateOne) SetNextRunAt(t time.Time) *ScheduledOrderUpdateOne {
+	souo.mutation.SetNextRunAt(t)
+	return souo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduledOrderUpdateOne {
+	if t != nil {
+		souo.SetNextRunAt(*t)
+	}
+	return souo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (souo *ScheduledOrderUpdateOne) ClearNextRunAt() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearNextRunAt()
+	return souo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (souo *ScheduledOrderUpdateOne) SetLastRunAt(t time.Time) *ScheduledOrderUpdateOne {
+	souo.mutation.SetLastRunAt(t)
+	return souo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableLastRunAt(t *time.Time) *ScheduledOrderUpdateOne {
+	if t != nil {
+		souo.SetLastRunAt(*t)
+	}
+	return souo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (souo *ScheduledOrderUpdateOne) ClearLastRunAt() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearLastRunAt()
+	return souo
+}
+
+// SetStatus sets the "status" field.
+func (souo *ScheduledOrderUpdateOne) SetStatus(s scheduledorder.Status) *ScheduledOrderUpdateOne {
+	souo.mutation.SetStatus(s)
+	return souo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (souo *ScheduledOrderUpdateOne) SetNillableStatus(s *scheduledorder.Status) *ScheduledOrderUpdateOne {
+	if s != nil {
+		souo.SetStatus(*s)
+	}
+	return souo
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (souo *ScheduledOrderUpdateOne) SetTokenID(id int) *ScheduledOrderUpdateOne {
+	souo.mutation.SetTokenID(id)
+	return souo
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (souo *ScheduledOrderUpdateOne) SetToken(t *Token) *ScheduledOrderUpdateOne {
+	return souo.SetTokenID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduledOrderRun entity by IDs.
+func (souo *ScheduledOrderUpdateOne) AddRunIDs(ids ...uuid.UUID) *ScheduledOrderUpdateOne {
+	souo.mutation.AddRunIDs(ids...)
+	return souo
+}
+
+// AddRuns adds the "runs" edges to the ScheduledOrderRun entity.
+func (souo *ScheduledOrderUpdateOne) AddRuns(s ...*ScheduledOrderRun) *ScheduledOrderUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return souo.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduledOrderMutation object of the builder.
+func (souo *ScheduledOrderUpdateOne) Mutation() *ScheduledOrderMutation {
+	return souo.mutation
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (souo *ScheduledOrderUpdateOne) ClearToken() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearToken()
+	return souo
+}
+
+// ClearRuns clears all "runs" edges to the ScheduledOrderRun entity.
+func (souo *ScheduledOrderUpdateOne) ClearRuns() *ScheduledOrderUpdateOne {
+	souo.mutation.ClearRuns()
+	return souo
+}
+
+// RemoveRunIDs removes the "runs" edge to ScheduledOrderRun entities by IDs.
+func (souo *ScheduledOrderUpdateOne) RemoveRunIDs(ids ...uuid.UUID) *ScheduledOrderUpdateOne {
+	souo.mutation.RemoveRunIDs(ids...)
+	return souo
+}
+
+// RemoveRuns removes "runs" edges to ScheduledOrderRun entities.
+func (souo *ScheduledOrderUpdateOne) RemoveRuns(s ...*ScheduledOrderRun) *ScheduledOrderUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return souo.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the ScheduledOrderUpdate builder.
+func (souo *ScheduledOrderUpdateOne) Where(ps ...predicate.ScheduledOrder) *ScheduledOrderUpdateOne {
+	souo.mutation.Where(ps...)
+	return souo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (souo *ScheduledOrderUpdateOne) Select(field string, fields ...string) *ScheduledOrderUpdateOne {
+	souo.fields = append([]string{field}, fields...)
+	return souo
+}
+
+// Save executes the query and returns the updated ScheduledOrder entity.
+func (souo *ScheduledOrderUpdateOne) Save(ctx context.Context) (*ScheduledOrder, error) {
+	souo.defaults()
+	return withHooks(ctx, souo.sqlSave, souo.mutation, souo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (souo *ScheduledOrderUpdateOne) SaveX(ctx context.Context) *ScheduledOrder {
+	node, err := souo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (souo *ScheduledOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := souo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (souo *ScheduledOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := souo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (souo *ScheduledOrderUpdateOne) defaults() {
+	if _, ok := souo.mutation.UpdatedAt(); !ok {
+		v := scheduledorder.UpdateDefaultUpdatedAt()
+		souo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (souo *ScheduledOrderUpdateOne) check() error {
+	if v, ok := souo.mutation.Status(); ok {
+		if err := scheduledorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrder.status": %w`, err)}
+		}
+	}
+	if souo.mutation.SenderProfileCleared() && len(souo.mutation.SenderProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrder.sender_profile"`)
+	}
+	if souo.mutation.TokenCleared() && len(souo.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrder.token"`)
+	}
+	return nil
+}
+
+func (souo *ScheduledOrderUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledOrder, err error) {
+	if err := souo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledorder.Table, scheduledorder.Columns, sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID))
+	id, ok := souo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := souo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorder.FieldID)
+		for _, f := range fields {
+			if !scheduledorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := souo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := souo.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := souo.mutation.Amount(); ok {
+		_spec.SetField(scheduledorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := souo.mutation.AddedAmount(); ok {
+		_spec.AddField(scheduledorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := souo.mutation.Rate(); ok {
+		_spec.SetField(scheduledorder.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := souo.mutation.AddedRate(); ok {
+		_spec.AddField(scheduledorder.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := souo.mutation.Institution(); ok {
+		_spec.SetField(scheduledorder.FieldInstitution, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.AccountIdentifier(); ok {
+		_spec.SetField(scheduledorder.FieldAccountIdentifier, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.AccountName(); ok {
+		_spec.SetField(scheduledorder.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.Memo(); ok {
+		_spec.SetField(scheduledorder.FieldMemo, field.TypeString, value)
+	}
+	if souo.mutation.MemoCleared() {
+		_spec.ClearField(scheduledorder.FieldMemo, field.TypeString)
+	}
+	if value, ok := souo.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledorder.FieldCronExpression, field.TypeString, value)
+	}
+	if souo.mutation.CronExpressionCleared() {
+		_spec.ClearField(scheduledorder.FieldCronExpression, field.TypeString)
+	}
+	if value, ok := souo.mutation.StartAt(); ok {
+		_spec.SetField(scheduledorder.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := souo.mutation.EndAt(); ok {
+		_spec.SetField(scheduledorder.FieldEndAt, field.TypeTime, value)
+	}
+	if souo.mutation.EndAtCleared() {
+		_spec.ClearField(scheduledorder.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := souo.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldNextRunAt, field.TypeTime, value)
+	}
+	if souo.mutation.NextRunAtCleared() {
+		_spec.ClearField(scheduledorder.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := souo.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledorder.FieldLastRunAt, field.TypeTime, value)
+	}
+	if souo.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledorder.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := souo.mutation.Status(); ok {
+		_spec.SetField(scheduledorder.FieldStatus, field.TypeEnum, value)
+	}
+	if souo.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.TokenTable,
+			Columns: []string{scheduledorder.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := souo.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorder.TokenTable,
+			Columns: []string{scheduledorder.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if souo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := souo.mutation.RemovedRunsIDs(); len(nodes) > 0 && !souo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := souo.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledorder.RunsTable,
+			Columns: []string{scheduledorder.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScheduledOrder{config: souo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, souo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	souo.mutation.done = true
+	return _node, nil
+}
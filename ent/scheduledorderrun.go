@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+)
+
+// ScheduledOrderRun is the model entity for the ScheduledOrderRun schema.
+type ScheduledOrderRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ScheduledFor holds the value of the "scheduled_for" field.
+	ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scheduledorderrun.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledOrderRunQuery when eager-loading is set.
+	Edges                             ScheduledOrderRunEdges `json:"edges"`
+	scheduled_order_runs              *uuid.UUID
+	scheduled_order_run_payment_order *uuid.UUID
+	selectValues                      sql.SelectValues
+}
+
+// ScheduledOrderRunEdges holds the relations/edges for other nodes in the graph.
+type ScheduledOrderRunEdges struct {
+	// ScheduledOrder holds the value of the scheduled_order edge.
+	ScheduledOrder *ScheduledOrder `json:"scheduled_order,omitempty"`
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ScheduledOrderOrErr returns the ScheduledOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledOrderRunEdges) ScheduledOrderOrErr() (*ScheduledOrder, error) {
+	if e.ScheduledOrder != nil {
+		return e.ScheduledOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: scheduledorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "scheduled_order"}
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledOrderRunEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledOrderRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledorderrun.FieldStatus, scheduledorderrun.FieldError:
+			values[i] = new(sql.NullString)
+		case scheduledorderrun.FieldScheduledFor, scheduledorderrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case scheduledorderrun.FieldID:
+			values[i] = new(uuid.UUID)
+		case scheduledorderrun.ForeignKeys[0]: // scheduled_order_runs
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case scheduledorderrun.ForeignKeys[1]: // scheduled_order_run_payment_order
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledOrderRun fields.
+func (sor *ScheduledOrderRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledorderrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sor.ID = *value
+			}
+		case scheduledorderrun.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				sor.ScheduledFor = value.Time
+			}
+		case scheduledorderrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sor.Status = scheduledorderrun.Status(value.String)
+			}
+		case scheduledorderrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sor.Error = value.String
+			}
+		case scheduledorderrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sor.CreatedAt = value.Time
+			}
+		case scheduledorderrun.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_order_runs", values[i])
+			} else if value.Valid {
+				sor.scheduled_order_runs = new(uuid.UUID)
+				*sor.scheduled_order_runs = *value.S.(*uuid.UUID)
+			}
+		case scheduledorderrun.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_order_run_payment_order", values[i])
+			} else if value.Valid {
+				sor.scheduled_order_run_payment_order = new(uuid.UUID)
+				*sor.scheduled_order_run_payment_order = *value.S.(*uuid.UUID)
+			}
+		default:
+			sor.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledOrderRun.
+// This includes values selected through modifiers, order, etc.
+func (sor *ScheduledOrderRun) Value(name string) (ent.Value, error) {
+	return sor.selectValues.Get(name)
+}
+
+// QueryScheduledOrder queries the "scheduled_order" edge of the ScheduledOrderRun entity.
+func (sor *ScheduledOrderRun) QueryScheduledOrder() *ScheduledOrderQuery {
+	return NewScheduledOrderRunClient(sor.config).QueryScheduledOrder(sor)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the ScheduledOrderRun entity.
+func (sor *ScheduledOrderRun) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewScheduledOrderRunClient(sor.config).QueryPaymentOrder(sor)
+}
+
+// Update returns a builder for updating this ScheduledOrderRun.
+// Note that you need to call ScheduledOrderRun.Unwrap() before calling this method if this ScheduledOrderRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sor *ScheduledOrderRun) Update() *ScheduledOrderRunUpdateOne {
+	return NewScheduledOrderRunClient(sor.config).UpdateOne(sor)
+}
+
+// Unwrap unwraps the ScheduledOrderRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sor *ScheduledOrderRun) Unwrap() *ScheduledOrderRun {
+	_tx, ok := sor.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledOrderRun is not a transactional entity")
+	}
+	sor.config.driver = _tx.drv
+	return sor
+}
+
+// String implements the fmt.Stringer.
+func (sor *ScheduledOrderRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledOrderRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sor.ID))
+	builder.WriteString("scheduled_for=")
+	builder.WriteString(sor.ScheduledFor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sor.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(sor.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sor.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledOrderRuns is a parsable slice of ScheduledOrderRun.
+type ScheduledOrderRuns []*ScheduledOrderRun
@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+)
+
+// ScheduledOrderRunCreate is the builder for creating a ScheduledOrderRun entity.
+type ScheduledOrderRunCreate struct {
+	config
+	mutation *ScheduledOrderRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (sorc *ScheduledOrderRunCreate) SetScheduledFor(t time.Time) *ScheduledOrderRunCreate {
+	sorc.mutation.SetScheduledFor(t)
+	return sorc
+}
+
+// SetStatus sets the "status" field.
+func (sorc *ScheduledOrderRunCreate) SetStatus(s scheduledorderrun.Status) *ScheduledOrderRunCreate {
+	sorc.mutation.SetStatus(s)
+	return sorc
+}
+
+// SetError sets the "error" field.
+func (sorc *ScheduledOrderRunCreate) SetError(s string) *ScheduledOrderRunCreate {
+	sorc.mutation.SetError(s)
+	return sorc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sorc *ScheduledOrderRunCreate) SetNillableError(s *string) *ScheduledOrderRunCreate {
+	if s != nil {
+		sorc.SetError(*s)
+	}
+	return sorc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sorc *ScheduledOrderRunCreate) SetCreatedAt(t time.Time) *ScheduledOrderRunCreate {
+	sorc.mutation.SetCreatedAt(t)
+	return sorc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sorc *ScheduledOrderRunCreate) SetNillableCreatedAt(t *time.Time) *ScheduledOrderRunCreate {
+	if t != nil {
+		sorc.SetCreatedAt(*t)
+	}
+	return sorc
+}
+
+// SetID sets the "id" field.
+func (sorc *ScheduledOrderRunCreate) SetID(u uuid.UUID) *ScheduledOrderRunCreate {
+	sorc.mutation.SetID(u)
+	return sorc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sorc *ScheduledOrderRunCreate) SetNillableID(u *uuid.UUID) *ScheduledOrderRunCreate {
+	if u != nil {
+		sorc.SetID(*u)
+	}
+	return sorc
+}
+
+// SetScheduledOrderID sets the "scheduled_order" edge to the ScheduledOrder entity by ID.
+func (sorc *ScheduledOrderRunCreate) SetScheduledOrderID(id uuid.UUID) *ScheduledOrderRunCreate {
+	sorc.mutation.SetScheduledOrderID(id)
+	return sorc
+}
+
+// SetScheduledOrder sets the "scheduled_order" edge to the ScheduledOrder entity.
+func (sorc *ScheduledOrderRunCreate) SetScheduledOrder(s *ScheduledOrder) *ScheduledOrderRunCreate {
+	return sorc.SetScheduledOrderID(s.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (sorc *ScheduledOrderRunCreate) SetPaymentOrderID(id uuid.UUID) *ScheduledOrderRunCreate {
+	sorc.mutation.SetPaymentOrderID(id)
+	return sorc
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (sorc *ScheduledOrderRunCreate) SetNillablePaymentOrderID(id *uuid.UUID) *ScheduledOrderRunCreate {
+	if id != nil {
+		sorc = sorc.SetPaymentOrderID(*id)
+	}
+	return sorc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (sorc *ScheduledOrderRunCreate) SetPaymentOrder(p *PaymentOrder) *ScheduledOrderRunCreate {
+	return sorc.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ScheduledOrderRunMutation object of the builder.
+func (sorc *ScheduledOrderRunCreate) Mutation() *ScheduledOrderRunMutation {
+	return sorc.mutation
+}
+
+// Save creates the ScheduledOrderRun in the database.
+func (sorc *ScheduledOrderRunCreate) Save(ctx context.Context) (*ScheduledOrderRun, error) {
+	sorc.defaults()
+	return withHooks(ctx, sorc.sqlSave, sorc.mutation, sorc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sorc *ScheduledOrderRunCreate) SaveX(ctx context.Context) *ScheduledOrderRun {
+	v, err := sorc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sorc *ScheduledOrderRunCreate) Exec(ctx context.Context) error {
+	_, err := sorc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sorc *ScheduledOrderRunCreate) ExecX(ctx context.Context) {
+	if err := sorc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sorc *ScheduledOrderRunCreate) defaults() {
+	if _, ok := sorc.mutation.CreatedAt(); !ok {
+		v := scheduledorderrun.DefaultCreatedAt()
+		sorc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sorc.mutation.ID(); !ok {
+		v := scheduledorderrun.DefaultID()
+		sorc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sorc *ScheduledOrderRunCreate) check() error {
+	if _, ok := sorc.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`ent: missing required field "ScheduledOrderRun.scheduled_for"`)}
+	}
+	if _, ok := sorc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledOrderRun.status"`)}
+	}
+	if v, ok := sorc.mutation.Status(); ok {
+		if err := scheduledorderrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrderRun.status": %w`, err)}
+		}
+	}
+	if _, ok := sorc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledOrderRun.created_at"`)}
+	}
+	if len(sorc.mutation.ScheduledOrderIDs()) == 0 {
+		return &ValidationError{Name: "scheduled_order", err: errors.New(`ent: missing required edge "ScheduledOrderRun.scheduled_order"`)}
+	}
+	return nil
+}
+
+func (sorc *ScheduledOrderRunCreate) sqlSave(ctx context.Context) (*ScheduledOrderRun, error) {
+	if err := sorc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sorc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sorc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sorc.mutation.id = &_node.ID
+	sorc.mutation.done = true
+	return _node, nil
+}
+
+func (sorc *ScheduledOrderRunCreate) createSpec() (*ScheduledOrderRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledOrderRun{config: sorc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledorderrun.Table, sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sorc.conflict
+	if id, ok := sorc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sorc.mutation.ScheduledFor(); ok {
+		_spec.SetField(scheduledorderrun.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := sorc.mutation.Status(); ok {
+		_spec.SetField(scheduledorderrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sorc.mutation.Error(); ok {
+		_spec.SetField(scheduledorderrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := sorc.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledorderrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sorc.mutation.ScheduledOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledorderrun.ScheduledOrderTable,
+			Columns: []string{scheduledorderrun.ScheduledOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.scheduled_order_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sorc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledorderrun.PaymentOrderTable,
+			Columns: []string{scheduledorderrun.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.scheduled_order_run_payment_order = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledOrderRun.Create().
+//		SetScheduledFor(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledOrderRunUpsert) {
+//			SetScheduledFor(v+v).
+//		}).
+//		Exec(ctx)
+func (sorc *ScheduledOrderRunCreate) OnConflict(opts ...sql.ConflictOption) *ScheduledOrderRunUpsertOne {
+	sorc.conflict = opts
+	return &ScheduledOrderRunUpsertOne{
+		create: sorc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sorc *ScheduledOrderRunCreate) OnConflictColumns(columns ...string) *ScheduledOrderRunUpsertOne {
+	sorc.conflict = append(sorc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledOrderRunUpsertOne{
+		create: sorc,
+	}
+}
+
+type (
+	// ScheduledOrderRunUpsertOne is the builder for "upsert"-ing
+	//  one ScheduledOrderRun node.
+	ScheduledOrderRunUpsertOne struct {
+		create *ScheduledOrderRunCreate
+	}
+
+	// ScheduledOrderRunUpsert is the "OnConflict" setter.
+	ScheduledOrderRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderRunUpsert) SetStatus(v scheduledorderrun.Status) *ScheduledOrderRunUpsert {
+	u.Set(scheduledorderrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsert) UpdateStatus() *ScheduledOrderRunUpsert {
+	u.SetExcluded(scheduledorderrun.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ScheduledOrderRunUpsert) SetError(v string) *ScheduledOrderRunUpsert {
+	u.Set(scheduledorderrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsert) UpdateError() *ScheduledOrderRunUpsert {
+	u.SetExcluded(scheduledorderrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduledOrderRunUpsert) ClearError() *ScheduledOrderRunUpsert {
+	u.SetNull(scheduledorderrun.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledorderrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledOrderRunUpsertOne) UpdateNewValues() *ScheduledOrderRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scheduledorderrun.FieldID)
+		}
+		if _, exists := u.create.mutation.ScheduledFor(); exists {
+			s.SetIgnore(scheduledorderrun.FieldScheduledFor)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scheduledorderrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduledOrderRunUpsertOne) Ignore() *ScheduledOrderRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledOrderRunUpsertOne) DoNothing() *ScheduledOrderRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledOrderRunCreate.OnConflict
+// documentation for more info.
+func (u *ScheduledOrderRunUpsertOne) Update(set func(*ScheduledOrderRunUpsert)) *ScheduledOrderRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledOrderRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderRunUpsertOne) SetStatus(v scheduledorderrun.Status) *ScheduledOrderRunUpsertOne {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsertOne) UpdateStatus() *ScheduledOrderRunUpsertOne {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ScheduledOrderRunUpsertOne) SetError(v string) *ScheduledOrderRunUpsertOne {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsertOne) UpdateError() *ScheduledOrderRunUpsertOne {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduledOrderRunUpsertOne) ClearError() *ScheduledOrderRunUpsertOne {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledOrderRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledOrderRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledOrderRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduledOrderRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScheduledOrderRunUpsertOne.ID is not supported by MySQL driver. Use ScheduledOrderRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduledOrderRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduledOrderRunCreateBulk is the builder for creating many ScheduledOrderRun entities in bulk.
+type ScheduledOrderRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledOrderRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduledOrderRun entities in the database.
+func (sorcb *ScheduledOrderRunCreateBulk) Save(ctx context.Context) ([]*ScheduledOrderRun, error) {
+	if sorcb.err != nil {
+		return nil, sorcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sorcb.builders))
+	nodes := make([]*ScheduledOrderRun, len(sorcb.builders))
+	mutators := make([]Mutator, len(sorcb.builders))
+	for i := range sorcb.builders {
+		func(i int, root context.Context) {
+			builder := sorcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledOrderRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sorcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sorcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sorcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sorcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sorcb *ScheduledOrderRunCreateBulk) SaveX(ctx context.Context) []*ScheduledOrderRun {
+	v, err := sorcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sorcb *ScheduledOrderRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := sorcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sorcb *ScheduledOrderRunCreateBulk) ExecX(ctx context.Context) {
+	if err := sorcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledOrderRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledOrderRunUpsert) {
+//			SetScheduledFor(v+v).
+//		}).
+//		Exec(ctx)
+func (sorcb *ScheduledOrderRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduledOrderRunUpsertBulk {
+	sorcb.conflict = opts
+	return &ScheduledOrderRunUpsertBulk{
+		create: sorcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sorcb *ScheduledOrderRunCreateBulk) OnConflictColumns(columns ...string) *ScheduledOrderRunUpsertBulk {
+	sorcb.conflict = append(sorcb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledOrderRunUpsertBulk{
+		create: sorcb,
+	}
+}
+
+// ScheduledOrderRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduledOrderRun nodes.
+type ScheduledOrderRunUpsertBulk struct {
+	create *ScheduledOrderRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledorderrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledOrderRunUpsertBulk) UpdateNewValues() *ScheduledOrderRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scheduledorderrun.FieldID)
+			}
+			if _, exists := b.mutation.ScheduledFor(); exists {
+				s.SetIgnore(scheduledorderrun.FieldScheduledFor)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scheduledorderrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledOrderRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduledOrderRunUpsertBulk) Ignore() *ScheduledOrderRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledOrderRunUpsertBulk) DoNothing() *ScheduledOrderRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledOrderRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduledOrderRunUpsertBulk) Update(set func(*ScheduledOrderRunUpsert)) *ScheduledOrderRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledOrderRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledOrderRunUpsertBulk) SetStatus(v scheduledorderrun.Status) *ScheduledOrderRunUpsertBulk {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsertBulk) UpdateStatus() *ScheduledOrderRunUpsertBulk {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ScheduledOrderRunUpsertBulk) SetError(v string) *ScheduledOrderRunUpsertBulk {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduledOrderRunUpsertBulk) UpdateError() *ScheduledOrderRunUpsertBulk {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduledOrderRunUpsertBulk) ClearError() *ScheduledOrderRunUpsertBulk {
+	return u.Update(func(s *ScheduledOrderRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledOrderRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduledOrderRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledOrderRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledOrderRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
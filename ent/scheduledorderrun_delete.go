@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+)
+
+// ScheduledOrderRunDelete is the builder for deleting a ScheduledOrderRun entity.
+type ScheduledOrderRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledOrderRunMutation
+}
+
+// Where appends a list predicates to the ScheduledOrderRunDelete builder.
+func (sord *ScheduledOrderRunDelete) Where(ps ...predicate.ScheduledOrderRun) *ScheduledOrderRunDelete {
+	sord.mutation.Where(ps...)
+	return sord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sord *ScheduledOrderRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sord.sqlExec, sord.mutation, sord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sord *ScheduledOrderRunDelete) ExecX(ctx context.Context) int {
+	n, err := sord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sord *ScheduledOrderRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scheduledorderrun.Table, sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID))
+	if ps := sord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sord.mutation.done = true
+	return affected, err
+}
+
+// ScheduledOrderRunDeleteOne is the builder for deleting a single ScheduledOrderRun entity.
+type ScheduledOrderRunDeleteOne struct {
+	sord *ScheduledOrderRunDelete
+}
+
+// Where appends a list predicates to the ScheduledOrderRunDelete builder.
+func (sordo *ScheduledOrderRunDeleteOne) Where(ps ...predicate.ScheduledOrderRun) *ScheduledOrderRunDeleteOne {
+	sordo.sord.mutation.Where(ps...)
+	return sordo
+}
+
+// Exec executes the deletion query.
+func (sordo *ScheduledOrderRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := sordo.sord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scheduledorderrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sordo *ScheduledOrderRunDeleteOne) ExecX(ctx context.Context) {
+	if err := sordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
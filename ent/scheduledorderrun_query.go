@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+)
+
+// ScheduledOrderRunQuery is the builder for querying ScheduledOrderRun entities.
+type ScheduledOrderRunQuery struct {
+	config
+	ctx                *QueryContext
+	order              []scheduledorderrun.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.ScheduledOrderRun
+	withScheduledOrder *ScheduledOrderQuery
+	withPaymentOrder   *PaymentOrderQuery
+	withFKs            bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduledOrderRunQuery builder.
+func (sorq *ScheduledOrderRunQuery) Where(ps ...predicate.ScheduledOrderRun) *ScheduledOrderRunQuery {
+	sorq.predicates = append(sorq.predicates, ps...)
+	return sorq
+}
+
+// Limit the number of records to be returned by this query.
+func (sorq *ScheduledOrderRunQuery) Limit(limit int) *ScheduledOrderRunQuery {
+	sorq.ctx.Limit = &limit
+	return sorq
+}
+
+// Offset to start from.
+func (sorq *ScheduledOrderRunQuery) Offset(offset int) *ScheduledOrderRunQuery {
+	sorq.ctx.Offset = &offset
+	return sorq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sorq *ScheduledOrderRunQuery) Unique(unique bool) *ScheduledOrderRunQuery {
+	sorq.ctx.Unique = &unique
+	return sorq
+}
+
+// Order specifies how the records should be ordered.
+func (sorq *ScheduledOrderRunQuery) Order(o ...scheduledorderrun.OrderOption) *ScheduledOrderRunQuery {
+	sorq.order = append(sorq.order, o...)
+	return sorq
+}
+
+// QueryScheduledOrder chains the current query on the "scheduled_order" edge.
+func (sorq *ScheduledOrderRunQuery) QueryScheduledOrder() *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: sorq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sorq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sorq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorderrun.Table, scheduledorderrun.FieldID, selector),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledorderrun.ScheduledOrderTable, scheduledorderrun.ScheduledOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sorq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder chains the current query on the "payment_order" edge.
+func (sorq *ScheduledOrderRunQuery) QueryPaymentOrder() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: sorq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sorq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sorq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledorderrun.Table, scheduledorderrun.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledorderrun.PaymentOrderTable, scheduledorderrun.PaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sorq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScheduledOrderRun entity from the query.
+// Returns a *NotFoundError when no ScheduledOrderRun was found.
+func (sorq *ScheduledOrderRunQuery) First(ctx context.Context) (*ScheduledOrderRun, error) {
+	nodes, err := sorq.Limit(1).All(setContextOp(ctx, sorq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scheduledorderrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) FirstX(ctx context.Context) *ScheduledOrderRun {
+	node, err := sorq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduledOrderRun ID from the query.
+// Returns a *NotFoundError when no ScheduledOrderRun ID was found.
+func (sorq *ScheduledOrderRunQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sorq.Limit(1).IDs(setContextOp(ctx, sorq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scheduledorderrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sorq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduledOrderRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScheduledOrderRun entity is found.
+// Returns a *NotFoundError when no ScheduledOrderRun entities are found.
+func (sorq *ScheduledOrderRunQuery) Only(ctx context.Context) (*ScheduledOrderRun, error) {
+	nodes, err := sorq.Limit(2).All(setContextOp(ctx, sorq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scheduledorderrun.Label}
+	default:
+		return nil, &NotSingularError{scheduledorderrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) OnlyX(ctx context.Context) *ScheduledOrderRun {
+	node, err := sorq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduledOrderRun ID in the query.
+// Returns a *NotSingularError when more than one ScheduledOrderRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sorq *ScheduledOrderRunQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sorq.Limit(2).IDs(setContextOp(ctx, sorq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scheduledorderrun.Label}
+	default:
+		err = &NotSingularError{scheduledorderrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sorq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduledOrderRuns.
+func (sorq *ScheduledOrderRunQuery) All(ctx context.Context) ([]*ScheduledOrderRun, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryAll)
+	if err := sorq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScheduledOrderRun, *ScheduledOrderRunQuery]()
+	return withInterceptors[[]*ScheduledOrderRun](ctx, sorq, qr, sorq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) AllX(ctx context.Context) []*ScheduledOrderRun {
+	nodes, err := sorq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduledOrderRun IDs.
+func (sorq *ScheduledOrderRunQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sorq.ctx.Unique == nil && sorq.path != nil {
+		sorq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryIDs)
+	if err = sorq.Select(scheduledorderrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sorq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sorq *ScheduledOrderRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryCount)
+	if err := sorq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sorq, querierCount[*ScheduledOrderRunQuery](), sorq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) CountX(ctx context.Context) int {
+	count, err := sorq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sorq *ScheduledOrderRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sorq.ctx, ent.OpQueryExist)
+	switch _, err := sorq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sorq *ScheduledOrderRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := sorq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduledOrderRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sorq *ScheduledOrderRunQuery) Clone() *ScheduledOrderRunQuery {
+	if sorq == nil {
+		return nil
+	}
+	return &ScheduledOrderRunQuery{
+		config:             sorq.config,
+		ctx:                sorq.ctx.Clone(),
+		order:              append([]scheduledorderrun.OrderOption{}, sorq.order...),
+		inters:             append([]Interceptor{}, sorq.inters...),
+		predicates:         append([]predicate.ScheduledOrderRun{}, sorq.predicates...),
+		withScheduledOrder: sorq.withScheduledOrder.Clone(),
+		withPaymentOrder:   sorq.withPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  sorq.sql.Clone(),
+		path: sorq.path,
+	}
+}
+
+// WithScheduledOrder tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (sorq *ScheduledOrderRunQuery) WithScheduledOrder(opts ...func(*ScheduledOrderQuery)) *ScheduledOrderRunQuery {
+	query := (&ScheduledOrderClient{config: sorq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sorq.withScheduledOrder = query
+	return sorq
+}
+
+// WithPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (sorq *ScheduledOrderRunQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery)) *ScheduledOrderRunQuery {
+	query := (&PaymentOrderClient{config: sorq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sorq.withPaymentOrder = query
+	return sorq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduledOrderRun.Query().
+//		GroupBy(scheduledorderrun.FieldScheduledFor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sorq *ScheduledOrderRunQuery) GroupBy(field string, fields ...string) *ScheduledOrderRunGroupBy {
+	sorq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScheduledOrderRunGroupBy{build: sorq}
+	grbuild.flds = &sorq.ctx.Fields
+	grbuild.label = scheduledorderrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+//	}
+//
+//	client.ScheduledOrderRun.Query().
+//		Select(scheduledorderrun.FieldScheduledFor).
+//		Scan(ctx, &v)
+func (sorq *ScheduledOrderRunQuery) Select(fields ...string) *ScheduledOrderRunSelect {
+	sorq.ctx.Fields = append(sorq.ctx.Fields, fields...)
+	sbuild := &ScheduledOrderRunSelect{ScheduledOrderRunQuery: sorq}
+	sbuild.label = scheduledorderrun.Label
+	sbuild.flds, sbuild.scan = &sorq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScheduledOrderRunSelect configured with the given aggregations.
+func (sorq *ScheduledOrderRunQuery) Aggregate(fns ...AggregateFunc) *ScheduledOrderRunSelect {
+	return sorq.Select().Aggregate(fns...)
+}
+
+func (sorq *ScheduledOrderRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sorq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sorq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sorq.ctx.Fields {
+		if !scheduledorderrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sorq.path != nil {
+		prev, err := sorq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sorq.sql = prev
+	}
+	return nil
+}
+
+func (sorq *ScheduledOrderRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScheduledOrderRun, error) {
+	var (
+		nodes       = []*ScheduledOrderRun{}
+		withFKs     = sorq.withFKs
+		_spec       = sorq.querySpec()
+		loadedTypes = [2]bool{
+			sorq.withScheduledOrder != nil,
+			sorq.withPaymentOrder != nil,
+		}
+	)
+	if sorq.withScheduledOrder != nil || sorq.withPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorderrun.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScheduledOrderRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScheduledOrderRun{config: sorq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sorq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sorq.withScheduledOrder; query != nil {
+		if err := sorq.loadScheduledOrder(ctx, query, nodes, nil,
+			func(n *ScheduledOrderRun, e *ScheduledOrder) { n.Edges.ScheduledOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sorq.withPaymentOrder; query != nil {
+		if err := sorq.loadPaymentOrder(ctx, query, nodes, nil,
+			func(n *ScheduledOrderRun, e *PaymentOrder) { n.Edges.PaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sorq *ScheduledOrderRunQuery) loadScheduledOrder(ctx context.Context, query *ScheduledOrderQuery, nodes []*ScheduledOrderRun, init func(*ScheduledOrderRun), assign func(*ScheduledOrderRun, *ScheduledOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScheduledOrderRun)
+	for i := range nodes {
+		if nodes[i].scheduled_order_runs == nil {
+			continue
+		}
+		fk := *nodes[i].scheduled_order_runs
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(scheduledorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "scheduled_order_runs" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (sorq *ScheduledOrderRunQuery) loadPaymentOrder(ctx context.Context, query *PaymentOrderQuery, nodes []*ScheduledOrderRun, init func(*ScheduledOrderRun), assign func(*ScheduledOrderRun, *PaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScheduledOrderRun)
+	for i := range nodes {
+		if nodes[i].scheduled_order_run_payment_order == nil {
+			continue
+		}
+		fk := *nodes[i].scheduled_order_run_payment_order
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "scheduled_order_run_payment_order" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sorq *ScheduledOrderRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sorq.querySpec()
+	_spec.Node.Columns = sorq.ctx.Fields
+	if len(sorq.ctx.Fields) > 0 {
+		_spec.Unique = sorq.ctx.Unique != nil && *sorq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sorq.driver, _spec)
+}
+
+func (sorq *ScheduledOrderRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scheduledorderrun.Table, scheduledorderrun.Columns, sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID))
+	_spec.From = sorq.sql
+	if unique := sorq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sorq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sorq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorderrun.FieldID)
+		for i := range fields {
+			if fields[i] != scheduledorderrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sorq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sorq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sorq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sorq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sorq *ScheduledOrderRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sorq.driver.Dialect())
+	t1 := builder.Table(scheduledorderrun.Table)
+	columns := sorq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scheduledorderrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sorq.sql != nil {
+		selector = sorq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sorq.ctx.Unique != nil && *sorq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sorq.predicates {
+		p(selector)
+	}
+	for _, p := range sorq.order {
+		p(selector)
+	}
+	if offset := sorq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sorq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScheduledOrderRunGroupBy is the group-by builder for ScheduledOrderRun entities.
+type ScheduledOrderRunGroupBy struct {
+	selector
+	build *ScheduledOrderRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sorgb *ScheduledOrderRunGroupBy) Aggregate(fns ...AggregateFunc) *ScheduledOrderRunGroupBy {
+	sorgb.fns = append(sorgb.fns, fns...)
+	return sorgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sorgb *ScheduledOrderRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sorgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sorgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledOrderRunQuery, *ScheduledOrderRunGroupBy](ctx, sorgb.build, sorgb, sorgb.build.inters, v)
+}
+
+func (sorgb *ScheduledOrderRunGroupBy) sqlScan(ctx context.Context, root *ScheduledOrderRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sorgb.fns))
+	for _, fn := range sorgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sorgb.flds)+len(sorgb.fns))
+		for _, f := range *sorgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sorgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sorgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScheduledOrderRunSelect is the builder for selecting fields of ScheduledOrderRun entities.
+type ScheduledOrderRunSelect struct {
+	*ScheduledOrderRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sors *ScheduledOrderRunSelect) Aggregate(fns ...AggregateFunc) *ScheduledOrderRunSelect {
+	sors.fns = append(sors.fns, fns...)
+	return sors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sors *ScheduledOrderRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sors.ctx, ent.OpQuerySelect)
+	if err := sors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledOrderRunQuery, *ScheduledOrderRunSelect](ctx, sors.ScheduledOrderRunQuery, sors, sors.inters, v)
+}
+
+func (sors *ScheduledOrderRunSelect) sqlScan(ctx context.Context, root *ScheduledOrderRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sors.fns))
+	for _, fn := range sors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+)
+
+// ScheduledOrderRunUpdate is the builder for updating ScheduledOrderRun entities.
+type ScheduledOrderRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledOrderRunMutation
+}
+
+// Where appends a list predicates to the ScheduledOrderRunUpdate builder.
+func (soru *ScheduledOrderRunUpdate) Where(ps ...predicate.ScheduledOrderRun) *ScheduledOrderRunUpdate {
+	soru.mutation.Where(ps...)
+	return soru
+}
+
+// SetStatus sets the "status" field.
+func (soru *ScheduledOrderRunUpdate) SetStatus(s scheduledorderrun.Status) *ScheduledOrderRunUpdate {
+	soru.mutation.SetStatus(s)
+	return soru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (soru *ScheduledOrderRunUpdate) SetNillableStatus(s *scheduledorderrun.Status) *ScheduledOrderRunUpdate {
+	if s != nil {
+		soru.SetStatus(*s)
+	}
+	return soru
+}
+
+// SetError sets the "error" field.
+func (soru *ScheduledOrderRunUpdate) SetError(s string) *ScheduledOrderRunUpdate {
+	soru.mutation.SetError(s)
+	return soru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (soru *ScheduledOrderRunUpdate) SetNillableError(s *string) *ScheduledOrderRunUpdate {
+	if s != nil {
+		soru.SetError(*s)
+	}
+	return soru
+}
+
+// ClearError clears the value of the "error" field.
+func (soru *ScheduledOrderRunUpdate) ClearError() *ScheduledOrderRunUpdate {
+	soru.mutation.ClearError()
+	return soru
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (soru *ScheduledOrderRunUpdate) SetPaymentOrderID(id uuid.UUID) *ScheduledOrderRunUpdate {
+	soru.mutation.SetPaymentOrderID(id)
+	return soru
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (soru *ScheduledOrderRunUpdate) SetNillablePaymentOrderID(id *uuid.UUID) *ScheduledOrderRunUpdate {
+	if id != nil {
+		soru = soru.SetPaymentOrderID(*id)
+	}
+	return soru
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (soru *ScheduledOrderRunUpdate) SetPaymentOrder(p *PaymentOrder) *ScheduledOrderRunUpdate {
+	return soru.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ScheduledOrderRunMutation object of the builder.
+func (soru *ScheduledOrderRunUpdate) Mutation() *ScheduledOrderRunMutation {
+	return soru.mutation
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (soru *ScheduledOrderRunUpdate) ClearPaymentOrder() *ScheduledOrderRunUpdate {
+	soru.mutation.ClearPaymentOrder()
+	return soru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (soru *ScheduledOrderRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, soru.sqlSave, soru.mutation, soru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (soru *ScheduledOrderRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := soru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (soru *ScheduledOrderRunUpdate) Exec(ctx context.Context) error {
+	_, err := soru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soru *ScheduledOrderRunUpdate) ExecX(ctx context.Context) {
+	if err := soru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soru *ScheduledOrderRunUpdate) check() error {
+	if v, ok := soru.mutation.Status(); ok {
+		if err := scheduledorderrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrderRun.status": %w`, err)}
+		}
+	}
+	if soru.mutation.ScheduledOrderCleared() && len(soru.mutation.ScheduledOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrderRun.scheduled_order"`)
+	}
+	return nil
+}
+
+func (soru *ScheduledOrderRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := soru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledorderrun.Table, scheduledorderrun.Columns, sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID))
+	if ps := soru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := soru.mutation.Status(); ok {
+		_spec.SetField(scheduledorderrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := soru.mutation.Error(); ok {
+		_spec.SetField(scheduledorderrun.FieldError, field.TypeString, value)
+	}
+	if soru.mutation.ErrorCleared() {
+		_spec.ClearField(scheduledorderrun.FieldError, field.TypeString)
+	}
+	if soru.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledorderrun.PaymentOrderTable,
+			Columns: []string{scheduledorderrun.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := soru.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledorderrun.PaymentOrderTable,
+			Columns: []string{scheduledorderrun.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, soru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledorderrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	soru.mutation.done = true
+	return n, nil
+}
+
+// ScheduledOrderRunUpdateOne is the builder for updating a single ScheduledOrderRun entity.
+type ScheduledOrderRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledOrderRunMutation
+}
+
+// SetStatus sets the "status" field.
+func (soruo *ScheduledOrderRunUpdateOne) SetStatus(s scheduledorderrun.Status) *ScheduledOrderRunUpdateOne {
+	soruo.mutation.SetStatus(s)
+	return soruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (soruo *ScheduledOrderRunUpdateOne) SetNillableStatus(s *scheduledorderrun.Status) *ScheduledOrderRunUpdateOne {
+	if s != nil {
+		soruo.SetStatus(*s)
+	}
+	return soruo
+}
+
+// SetError sets the "error" field.
+func (soruo *ScheduledOrderRunUpdateOne) SetError(s string) *ScheduledOrderRunUpdateOne {
+	soruo.mutation.SetError(s)
+	return soruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (soruo *ScheduledOrderRunUpdateOne) SetNillableError(s *string) *ScheduledOrderRunUpdateOne {
+	if s != nil {
+		soruo.SetError(*s)
+	}
+	return soruo
+}
+
+// ClearError clears the value of the "error" field.
+func (soruo *ScheduledOrderRunUpdateOne) ClearError() *ScheduledOrderRunUpdateOne {
+	soruo.mutation.ClearError()
+	return soruo
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (soruo *ScheduledOrderRunUpdateOne) SetPaymentOrderID(id uuid.UUID) *ScheduledOrderRunUpdateOne {
+	soruo.mutation.SetPaymentOrderID(id)
+	return soruo
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (soruo *ScheduledOrderRunUpdateOne) SetNillablePaymentOrderID(id *uuid.UUID) *ScheduledOrderRunUpdateOne {
+	if id != nil {
+		soruo = soruo.SetPaymentOrderID(*id)
+	}
+	return soruo
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (soruo *ScheduledOrderRunUpdateOne) SetPaymentOrder(p *PaymentOrder) *ScheduledOrderRunUpdateOne {
+	return soruo.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ScheduledOrderRunMutation object of the builder.
+func (soruo *ScheduledOrderRunUpdateOne) Mutation() *ScheduledOrderRunMutation {
+	return soruo.mutation
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (soruo *ScheduledOrderRunUpdateOne) ClearPaymentOrder() *ScheduledOrderRunUpdateOne {
+	soruo.mutation.ClearPaymentOrder()
+	return soruo
+}
+
+// Where appends a list predicates to the ScheduledOrderRunUpdate builder.
+func (soruo *ScheduledOrderRunUpdateOne) Where(ps ...predicate.ScheduledOrderRun) *ScheduledOrderRunUpdateOne {
+	soruo.mutation.Where(ps...)
+	return soruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (soruo *ScheduledOrderRunUpdateOne) Select(field string, fields ...string) *ScheduledOrderRunUpdateOne {
+	soruo.fields = append([]string{field}, fields...)
+	return soruo
+}
+
+// Save executes the query and returns the updated ScheduledOrderRun entity.
+func (soruo *ScheduledOrderRunUpdateOne) Save(ctx context.Context) (*ScheduledOrderRun, error) {
+	return withHooks(ctx, soruo.sqlSave, soruo.mutation, soruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (soruo *ScheduledOrderRunUpdateOne) SaveX(ctx context.Context) *ScheduledOrderRun {
+	node, err := soruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (soruo *ScheduledOrderRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := soruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soruo *ScheduledOrderRunUpdateOne) ExecX(ctx context.Context) {
+	if err := soruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soruo *ScheduledOrderRunUpdateOne) check() error {
+	if v, ok := soruo.mutation.Status(); ok {
+		if err := scheduledorderrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledOrderRun.status": %w`, err)}
+		}
+	}
+	if soruo.mutation.ScheduledOrderCleared() && len(soruo.mutation.ScheduledOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledOrderRun.scheduled_order"`)
+	}
+	return nil
+}
+
+func (soruo *ScheduledOrderRunUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledOrderRun, err error) {
+	if err := soruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledorderrun.Table, scheduledorderrun.Columns, sqlgraph.NewFieldSpec(scheduledorderrun.FieldID, field.TypeUUID))
+	id, ok := soruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledOrderRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := soruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledorderrun.FieldID)
+		for _, f := range fields {
+			if !scheduledorderrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledorderrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := soruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := soruo.mutation.Status(); ok {
+		_spec.SetField(scheduledorderrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := soruo.mutation.Error(); ok {
+		_spec.SetField(scheduledorderrun.FieldError, field.TypeString, value)
+	}
+	if soruo.mutation.ErrorCleared() {
+		_spec.ClearField(scheduledorderrun.FieldError, field.TypeString)
+	}
+	if soruo.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledorderrun.PaymentOrderTable,
+			Columns: []string{scheduledorderrun.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := soruo.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledorderrun.PaymentOrderTable,
+			Columns: []string{scheduledorderrun.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScheduledOrderRun{config: soruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, soruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledorderrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	soruo.mutation.done = true
+	return _node, nil
+}
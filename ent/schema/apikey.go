@@ -1,10 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -21,6 +24,27 @@ func (APIKey) Fields() []ent.Field {
 		field.String("secret").
 			NotEmpty().
 			Unique(),
+		field.String("name").
+			MaxLen(80).
+			Default("Default"),
+		// Scopes limit the routes a key can access, keys without scopes have full access
+		field.Strings("scopes").
+			Optional(),
+		// The primary key signs webhooks and requests to provider nodes
+		field.Bool("is_primary").
+			Default(false),
+		// Sandbox keys create orders that are settled by simulated deposits and provider outcomes
+		field.Bool("is_sandbox").
+			Default(false),
+		field.Time("expires_at").
+			Optional(),
+		field.Time("last_used_at").
+			Optional(),
+		field.Time("revoked_at").
+			Optional(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
 	}
 }
 
@@ -28,14 +52,27 @@ func (APIKey) Fields() []ent.Field {
 func (APIKey) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("sender_profile", SenderProfile.Type).
-			Ref("api_key").
+			Ref("api_keys").
 			Unique().
 			Immutable(),
 		edge.From("provider_profile", ProviderProfile.Type).
-			Ref("api_key").
+			Ref("api_keys").
 			Unique().
 			Immutable(),
 		edge.To("payment_orders", PaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// A profile can only have one primary key
+		index.Edges("sender_profile").
+			Unique().
+			Annotations(entsql.IndexWhere("is_primary")),
+		index.Edges("provider_profile").
+			Unique().
+			Annotations(entsql.IndexWhere("is_primary")),
+	}
+}
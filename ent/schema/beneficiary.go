@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Beneficiary holds the schema definition for the Beneficiary entity.
+type Beneficiary struct {
+	ent.Schema
+}
+
+// Mixin of the Beneficiary.
+func (Beneficiary) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Beneficiary.
+func (Beneficiary) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("institution"),
+		field.String("account_identifier"),
+		field.String("account_name"),
+		field.String("memo").
+			Optional(),
+		field.String("verified_account_name").
+			Optional(),
+		field.Time("verified_at").
+			Optional(),
+	}
+}
+
+// Edges of the Beneficiary.
+func (Beneficiary) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("beneficiaries").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Beneficiary.
+func (Beneficiary) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("institution", "account_identifier").
+			Edges("sender_profile").
+			Unique().
+			StorageKey("beneficiary_account_sender_profile"),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Dispute holds the schema definition for the Dispute entity.
+type Dispute struct {
+	ent.Schema
+}
+
+// Mixin of the Dispute.
+func (Dispute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Dispute.
+func (Dispute) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Enum("status").
+			Values("open", "awaiting_provider_evidence", "resolved", "rejected").
+			Default("awaiting_provider_evidence"),
+		field.Text("reason"),
+		field.JSON("evidence", []struct {
+			Type        string    `json:"type"`
+			Reference   string    `json:"reference"`
+			URL         string    `json:"url"`
+			Note        string    `json:"note"`
+			SubmittedAt time.Time `json:"submittedAt"`
+		}{}).
+			Optional(),
+
+		// Resolution
+		field.Bool("provider_at_fault").
+			Optional().
+			Nillable(),
+		field.Text("resolution").
+			Optional(),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+
+		// SLA timers
+		field.Time("evidence_due_at").
+			Optional().
+			Nillable(),
+		field.Time("resolution_due_at"),
+		field.Time("escalated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Dispute.
+func (Dispute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lock_payment_order", LockPaymentOrder.Type).
+			Ref("disputes").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("disputes").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Dispute.
+func (Dispute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		// A lock order can only have one unresolved dispute at a time
+		index.Edges("lock_payment_order").
+			Unique().
+			Annotations(entsql.IndexWhere("status IN ('open', 'awaiting_provider_evidence')")),
+	}
+}
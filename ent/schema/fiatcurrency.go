@@ -45,5 +45,7 @@ func (FiatCurrency) Edges() []ent.Edge {
 		edge.To("provision_buckets", ProvisionBucket.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("institutions", Institution.Type),
+		edge.To("provider_balances", ProviderFiatBalance.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
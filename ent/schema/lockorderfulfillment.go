@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // LockOrderFulfillment holds the schema definition for the LockOrderFulfillment entity.
@@ -28,6 +29,8 @@ func (LockOrderFulfillment) Fields() []ent.Field {
 			Optional(),
 		field.String("psp").
 			Optional(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}),
 		field.Enum("validation_status").
 			Values("pending", "success", "failed").
 			Default("pending"),
@@ -34,6 +34,8 @@ func (LockPaymentOrder) Fields() []ent.Field {
 			GoType(decimal.Decimal{}),
 		field.Float("order_percent").
 			GoType(decimal.Decimal{}),
+		field.Float("amount_fulfilled").
+			GoType(decimal.Decimal{}),
 		field.String("tx_hash").
 			MaxLen(70).
 			Optional(),
@@ -50,6 +52,9 @@ func (LockPaymentOrder) Fields() []ent.Field {
 			Default(0),
 		field.Strings("cancellation_reasons").
 			Default([]string{}),
+		field.Time("escalated_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -69,6 +74,8 @@ func (LockPaymentOrder) Edges() []ent.Edge {
 		edge.To("fulfillments", LockOrderFulfillment.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("transactions", TransactionLog.Type),
+		edge.To("disputes", Dispute.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -55,9 +55,20 @@ func (PaymentOrder) Fields() []ent.Field {
 		field.String("reference").
 			MaxLen(70).
 			Optional(),
+		field.String("fee_tier").
+			MaxLen(50).
+			Optional(),
 		field.Enum("status").
 			Values("initiated", "pending", "expired", "settled", "refunded").
 			Default("initiated"),
+		field.Enum("underpayment_policy").
+			Values("settle", "top_up").
+			Default("settle"),
+		field.Enum("overpayment_policy").
+			Values("settle", "refund_excess").
+			Default("settle"),
+		field.Bool("is_sandbox").
+			Default(false),
 	}
 }
 
@@ -74,12 +85,19 @@ func (PaymentOrder) Edges() []ent.Edge {
 		edge.From("linked_address", LinkedAddress.Type).
 			Ref("payment_orders").
 			Unique(),
+		edge.From("batch", PaymentOrderBatch.Type).
+			Ref("payment_orders").
+			Unique(),
 		edge.To("receive_address", ReceiveAddress.Type).
 			Unique().
 			Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("recipient", PaymentOrderRecipient.Type).
 			Unique().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("splits", PaymentOrderSplit.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("transactions", TransactionLog.Type),
+		edge.To("disputes", Dispute.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
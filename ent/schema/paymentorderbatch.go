@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderBatch holds the schema definition for the PaymentOrderBatch entity.
+type PaymentOrderBatch struct {
+	ent.Schema
+}
+
+// Mixin of the PaymentOrderBatch.
+func (PaymentOrderBatch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PaymentOrderBatch.
+func (PaymentOrderBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		field.Float("amount_paid").GoType(decimal.Decimal{}),
+		field.Float("amount_returned").GoType(decimal.Decimal{}),
+		field.String("tx_hash").
+			MaxLen(70).
+			Optional(),
+		field.String("from_address").
+			MaxLen(60).
+			Optional(),
+		field.String("return_address").
+			MaxLen(60).
+			Optional(),
+		field.String("receive_address_text").
+			MaxLen(60),
+		field.String("reference").
+			MaxLen(70).
+			Optional(),
+		field.Enum("status").
+			Values("initiated", "funded", "expired").
+			Default("initiated"),
+	}
+}
+
+// Edges of the PaymentOrderBatch.
+func (PaymentOrderBatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("payment_order_batches").
+			Unique(),
+		edge.From("token", Token.Type).
+			Ref("payment_order_batches").
+			Unique().
+			Required(),
+		edge.To("receive_address", ReceiveAddress.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("payment_orders", PaymentOrder.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
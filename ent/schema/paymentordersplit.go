@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentOrderSplit holds the schema definition for the PaymentOrderSplit entity.
+type PaymentOrderSplit struct {
+	ent.Schema
+}
+
+// Fields of the PaymentOrderSplit.
+func (PaymentOrderSplit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("institution"),
+		field.String("account_identifier"),
+		field.String("account_name"),
+		field.String("memo").
+			Optional(),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		field.Float("percent").GoType(decimal.Decimal{}),
+	}
+}
+
+// Edges of the PaymentOrderSplit.
+func (PaymentOrderSplit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("splits").
+			Required().
+			Unique(),
+	}
+}
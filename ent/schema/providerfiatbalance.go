@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderFiatBalance holds the schema definition for the ProviderFiatBalance entity.
+type ProviderFiatBalance struct {
+	ent.Schema
+}
+
+// Mixin of the ProviderFiatBalance.
+func (ProviderFiatBalance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProviderFiatBalance.
+func (ProviderFiatBalance) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Float("available_balance").
+			GoType(decimal.Decimal{}),
+	}
+}
+
+// Edges of the ProviderFiatBalance.
+func (ProviderFiatBalance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", ProviderProfile.Type).
+			Ref("fiat_balances").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("currency", FiatCurrency.Type).
+			Ref("provider_balances").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ProviderFiatBalance.
+func (ProviderFiatBalance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("provider", "currency").
+			Unique().
+			StorageKey("provider_fiat_balance_provider_currency"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/shopspring/decimal"
@@ -33,10 +34,22 @@ func (ProviderOrderToken) Fields() []ent.Field {
 			GoType(decimal.Decimal{}),
 		field.Float("min_order_amount").
 			GoType(decimal.Decimal{}),
+		field.Float("rate_slippage").
+			GoType(decimal.Decimal{}),
+		field.Enum("rate_slippage_type").
+			Values("percentage", "absolute").
+			Default("absolute"),
 		field.JSON("addresses", []struct {
 			Address string `json:"address"`
 			Network string `json:"network"`
 		}{}),
+		field.JSON("rate_bands", []struct {
+			MinAmount              decimal.Decimal `json:"minAmount"`
+			MaxAmount              decimal.Decimal `json:"maxAmount"`
+			FixedConversionRate    decimal.Decimal `json:"fixedConversionRate"`
+			FloatingConversionRate decimal.Decimal `json:"floatingConversionRate"`
+		}{}).
+			Optional(),
 	}
 }
 
@@ -46,6 +59,9 @@ func (ProviderOrderToken) Edges() []ent.Edge {
 		edge.From("provider", ProviderProfile.Type).
 			Ref("order_tokens").
 			Unique(),
+		edge.To("rate_exclusion", ProviderRateExclusion.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
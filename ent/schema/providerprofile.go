@@ -39,6 +39,29 @@ func (ProviderProfile) Fields() []ent.Field {
 			Values("private", "public").
 			Default("public"),
 
+		// Operating hours
+		field.String("timezone").
+			Default("UTC"),
+		field.JSON("operating_hours", []struct {
+			Day   int    `json:"day"`
+			Open  string `json:"open"`
+			Close string `json:"close"`
+		}{}).
+			Optional(),
+		field.Strings("holidays").
+			Optional(),
+
+		// Institution codes the provider can pay out to. Providers with no supported
+		// institutions can pay out to every institution that is not unsupported
+		field.Strings("supported_institutions").
+			Optional(),
+		field.Strings("unsupported_institutions").
+			Optional(),
+
+		// Adjust floating rates excluded from the queue as stale once the grace period has passed
+		field.Bool("auto_adjust_stale_rates").
+			Default(false),
+
 		// KYB fields
 		field.Text("address").Optional(),
 		field.String("mobile_number").Optional(),
@@ -61,8 +84,8 @@ func (ProviderProfile) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
-		edge.To("api_key", APIKey.Type).
-			Unique().
+		edge.To("api_keys", APIKey.Type).
+			StorageKey(edge.Column("provider_profile_api_key")).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("currency", FiatCurrency.Type).
 			Ref("providers").
@@ -76,6 +99,10 @@ func (ProviderProfile) Edges() []ent.Edge {
 			Unique(),
 		edge.To("assigned_orders", LockPaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_members", TeamMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("fiat_balances", ProviderFiatBalance.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
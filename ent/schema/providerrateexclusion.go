@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderRateExclusion holds the schema definition for the ProviderRateExclusion entity.
+type ProviderRateExclusion struct {
+	ent.Schema
+}
+
+// Mixin of the ProviderRateExclusion.
+func (ProviderRateExclusion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProviderRateExclusion.
+func (ProviderRateExclusion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Enum("reason").
+			Values("stale_rate"),
+		field.Float("rate").
+			GoType(decimal.Decimal{}),
+		field.Float("market_rate").
+			GoType(decimal.Decimal{}),
+		field.Float("deviation").
+			GoType(decimal.Decimal{}),
+		field.Time("notified_at").
+			Optional().
+			Nillable(),
+		field.Time("adjusted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ProviderRateExclusion.
+func (ProviderRateExclusion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order_token", ProviderOrderToken.Type).
+			Ref("rate_exclusion").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
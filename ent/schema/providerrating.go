@@ -24,6 +24,30 @@ func (ProviderRating) Fields() []ent.Field {
 	return []ent.Field{
 		field.Float("trust_score").
 			GoType(decimal.Decimal{}),
+
+		// Score breakdown, each score is out of 100
+		field.Float("acceptance_rate").
+			GoType(decimal.Decimal{}),
+		field.Float("acceptance_score").
+			GoType(decimal.Decimal{}),
+		field.Float("cancellation_rate").
+			GoType(decimal.Decimal{}),
+		field.Float("cancellation_score").
+			GoType(decimal.Decimal{}),
+		field.Float("validation_failure_rate").
+			GoType(decimal.Decimal{}),
+		field.Float("validation_score").
+			GoType(decimal.Decimal{}),
+		// Average seconds from accepting an order to a successful fulfillment
+		field.Float("average_fulfillment_time").
+			GoType(decimal.Decimal{}),
+		field.Float("fulfillment_time_score").
+			GoType(decimal.Decimal{}),
+		// Percentage of successful fulfillments with a dispute the provider was found at fault for
+		field.Float("dispute_rate").
+			GoType(decimal.Decimal{}),
+		field.Float("dispute_score").
+			GoType(decimal.Decimal{}),
 	}
 }
 
@@ -39,5 +39,8 @@ func (ReceiveAddress) Edges() []ent.Edge {
 		edge.From("payment_order", PaymentOrder.Type).
 			Ref("receive_address").
 			Unique(),
+		edge.From("payment_order_batch", PaymentOrderBatch.Type).
+			Ref("receive_address").
+			Unique(),
 	}
 }
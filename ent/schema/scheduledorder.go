@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledOrder holds the schema definition for the ScheduledOrder entity.
+// It is a template a payment order is created from at each run.
+type ScheduledOrder struct {
+	ent.Schema
+}
+
+// Mixin of the ScheduledOrder.
+func (ScheduledOrder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ScheduledOrder.
+func (ScheduledOrder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		// A zero rate uses the market rate at each run
+		field.Float("rate").GoType(decimal.Decimal{}),
+		field.String("institution"),
+		field.String("account_identifier"),
+		field.String("account_name"),
+		field.String("memo").
+			Optional(),
+		// An empty cron expression runs the order once at start_at
+		field.String("cron_expression").
+			Optional(),
+		field.Time("start_at"),
+		field.Time("end_at").
+			Optional(),
+		field.Time("next_run_at").
+			Optional(),
+		field.Time("last_run_at").
+			Optional(),
+		field.Enum("status").
+			Values("active", "paused", "completed", "cancelled").
+			Default("active"),
+	}
+}
+
+// Edges of the ScheduledOrder.
+func (ScheduledOrder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("scheduled_orders").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("token", Token.Type).
+			Ref("scheduled_orders").
+			Unique().
+			Required(),
+		edge.To("runs", ScheduledOrderRun.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ScheduledOrder.
+func (ScheduledOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_run_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduledOrderRun holds the schema definition for the ScheduledOrderRun entity.
+type ScheduledOrderRun struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledOrderRun.
+func (ScheduledOrderRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Time("scheduled_for").
+			Immutable(),
+		field.Enum("status").
+			Values("succeeded", "failed"),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the ScheduledOrderRun.
+func (ScheduledOrderRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("scheduled_order", ScheduledOrder.Type).
+			Ref("runs").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("payment_order", PaymentOrder.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
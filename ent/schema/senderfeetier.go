@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SenderFeeTier holds the schema definition for the SenderFeeTier entity.
+type SenderFeeTier struct {
+	ent.Schema
+}
+
+// Mixin of the SenderFeeTier.
+func (SenderFeeTier) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SenderFeeTier.
+func (SenderFeeTier) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50),
+		field.Float("min_volume").GoType(decimal.Decimal{}),
+		field.Float("fee_percent").GoType(decimal.Decimal{}),
+		field.Float("min_fee").GoType(decimal.Decimal{}),
+		field.Float("max_fee").GoType(decimal.Decimal{}),
+		field.Time("starts_at").Optional(),
+		field.Time("ends_at").Optional(),
+	}
+}
+
+// Edges of the SenderFeeTier.
+func (SenderFeeTier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_order_token", SenderOrderToken.Type).
+			Ref("fee_tiers").
+			Unique().
+			Required(),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -41,6 +42,8 @@ func (SenderOrderToken) Edges() []ent.Edge {
 			Ref("sender_settings").
 			Required().
 			Unique(),
+		edge.To("fee_tiers", SenderFeeTier.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
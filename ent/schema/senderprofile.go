@@ -27,6 +27,12 @@ func (SenderProfile) Fields() []ent.Field {
 		field.Bool("is_partner").Default(false),
 		field.Bool("is_active").
 			Default(false),
+		field.Enum("underpayment_policy").
+			Values("settle", "top_up").
+			Default("settle"),
+		field.Enum("overpayment_policy").
+			Values("settle", "refund_excess").
+			Default("settle"),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -41,8 +47,8 @@ func (SenderProfile) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
-		edge.To("api_key", APIKey.Type).
-			Unique().
+		edge.To("api_keys", APIKey.Type).
+			StorageKey(edge.Column("sender_profile_api_key")).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("payment_orders", PaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
@@ -50,5 +56,13 @@ func (SenderProfile) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("linked_address", LinkedAddress.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("payment_order_batches", PaymentOrderBatch.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("team_members", TeamMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("beneficiaries", Beneficiary.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("scheduled_orders", ScheduledOrder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	gen "github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/hook"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TeamMember holds the schema definition for the TeamMember entity.
+type TeamMember struct {
+	ent.Schema
+}
+
+// Mixin of the TeamMember.
+func (TeamMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TeamMember.
+func (TeamMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("email"),
+		field.Enum("role").
+			Values("owner", "admin", "developer", "finance", "read_only"),
+		field.Enum("status").
+			Values("invited", "active").
+			Default("invited"),
+		field.String("invite_token").
+			Optional().
+			Sensitive(),
+		field.Time("invite_expiry_at").
+			Optional(),
+	}
+}
+
+// Edges of the TeamMember.
+func (TeamMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("team_memberships").
+			Unique(),
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("team_members").
+			Unique(),
+		edge.From("provider_profile", ProviderProfile.Type).
+			Ref("team_members").
+			Unique(),
+	}
+}
+
+// Indexes of the TeamMember.
+func (TeamMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").
+			Edges("sender_profile").
+			Unique(),
+		index.Fields("email").
+			Edges("provider_profile").
+			Unique(),
+	}
+}
+
+// Hooks of the TeamMember.
+func (TeamMember) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(generateInviteToken(), ent.OpCreate),
+	}
+}
+
+// generateInviteToken is a hook that generates the invitation token of a new team member from the invited email.
+func generateInviteToken() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.TeamMemberFunc(func(ctx context.Context, m *gen.TeamMemberMutation) (ent.Value, error) {
+			if email, exist := m.Email(); exist {
+				hash, _ := bcrypt.GenerateFromPassword([]byte(email+time.Now().String()), bcrypt.DefaultCost)
+				hasher := md5.New()
+				hasher.Write(hash)
+
+				m.SetInviteToken(hex.EncodeToString(hasher.Sum(nil)))
+				if _, ok := m.InviteExpiryAt(); !ok {
+					m.SetInviteExpiryAt(time.Now().Add(7 * 24 * time.Hour))
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
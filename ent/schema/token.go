@@ -41,5 +41,9 @@ func (Token) Edges() []ent.Edge {
 		edge.To("lock_payment_orders", LockPaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("sender_settings", SenderOrderToken.Type),
+		edge.To("payment_order_batches", PaymentOrderBatch.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("scheduled_orders", ScheduledOrder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+	"github.com/shopspring/decimal"
+)
+
+// SenderFeeTier is the model entity for the SenderFeeTier schema.
+type SenderFeeTier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MinVolume holds the value of the "min_volume" field.
+	MinVolume decimal.Decimal `json:"min_volume,omitempty"`
+	// FeePercent holds the value of the "fee_percent" field.
+	FeePercent decimal.Decimal `json:"fee_percent,omitempty"`
+	// MinFee holds the value of the "min_fee" field.
+	MinFee decimal.Decimal `json:"min_fee,omitempty"`
+	// MaxFee holds the value of the "max_fee" field.
+	MaxFee decimal.Decimal `json:"max_fee,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SenderFeeTierQuery when eager-loading is set.
+	Edges                        SenderFeeTierEdges `json:"edges"`
+	sender_order_token_fee_tiers *int
+	selectValues                 sql.SelectValues
+}
+
+// SenderFeeTierEdges holds the relations/edges for other nodes in the graph.
+type SenderFeeTierEdges struct {
+	// SenderOrderToken holds the value of the sender_order_token edge.
+	SenderOrderToken *SenderOrderToken `json:"sender_order_token,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SenderOrderTokenOrErr returns the SenderOrderToken value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SenderFeeTierEdges) SenderOrderTokenOrErr() (*SenderOrderToken, error) {
+	if e.SenderOrderToken != nil {
+		return e.SenderOrderToken, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderordertoken.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_order_token"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SenderFeeTier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case senderfeetier.FieldMinVolume, senderfeetier.FieldFeePercent, senderfeetier.FieldMinFee, senderfeetier.FieldMaxFee:
+			values[i] = new(decimal.Decimal)
+		case senderfeetier.FieldID:
+			values[i] = new(sql.NullInt64)
+		case senderfeetier.FieldName:
+			values[i] = new(sql.NullString)
+		case senderfeetier.FieldCreatedAt, senderfeetier.FieldUpdatedAt, senderfeetier.FieldStartsAt, senderfeetier.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case senderfeetier.ForeignKeys[0]: // sender_order_token_fee_tiers
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SenderFeeTier fields.
+func (sft *SenderFeeTier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case senderfeetier.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sft.ID = int(value.Int64)
+		case senderfeetier.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sft.CreatedAt = value.Time
+			}
+		case senderfeetier.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sft.UpdatedAt = value.Time
+			}
+		case senderfeetier.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sft.Name = value.String
+			}
+		case senderfeetier.FieldMinVolume:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field min_volume", values[i])
+			} else if value != nil {
+				sft.MinVolume = *value
+			}
+		case senderfeetier.FieldFeePercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_percent", values[i])
+			} else if value != nil {
+				sft.FeePercent = *value
+			}
+		case senderfeetier.FieldMinFee:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field min_fee", values[i])
+			} else if value != nil {
+				sft.MinFee = *value
+			}
+		case senderfeetier.FieldMaxFee:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field max_fee", values[i])
+			} else if value != nil {
+				sft.MaxFee = *value
+			}
+		case senderfeetier.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				sft.StartsAt = value.Time
+			}
+		case senderfeetier.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				sft.EndsAt = value.Time
+			}
+		case senderfeetier.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field sender_order_token_fee_tiers", value)
+			} else if value.Valid {
+				sft.sender_order_token_fee_tiers = new(int)
+				*sft.sender_order_token_fee_tiers = int(value.Int64)
+			}
+		default:
+			sft.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SenderFeeTier.
+// This includes values selected through modifiers, order, etc.
+func (sft *SenderFeeTier) Value(name string) (ent.Value, error) {
+	return sft.selectValues.Get(name)
+}
+
+// QuerySenderOrderToken queries the "sender_order_token" edge of the SenderFeeTier entity.
+func (sft *SenderFeeTier) QuerySenderOrderToken() *SenderOrderTokenQuery {
+	return NewSenderFeeTierClient(sft.config).QuerySenderOrderToken(sft)
+}
+
+// Update returns a builder for updating this SenderFeeTier.
+// Note that you need to call SenderFeeTier.Unwrap() before calling this method if this SenderFeeTier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sft *SenderFeeTier) Update() *SenderFeeTierUpdateOne {
+	return NewSenderFeeTierClient(sft.config).UpdateOne(sft)
+}
+
+// Unwrap unwraps the SenderFeeTier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sft *SenderFeeTier) Unwrap() *SenderFeeTier {
+	_tx, ok := sft.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SenderFeeTier is not a transactional entity")
+	}
+	sft.config.driver = _tx.drv
+	return sft
+}
+
+// String implements the fmt.Stringer.
+func (sft *SenderFeeTier) String() string {
+	var builder strings.Builder
+	builder.WriteString("SenderFeeTier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sft.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sft.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sft.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sft.Name)
+	builder.WriteString(", ")
+	builder.WriteString("min_volume=")
+	builder.WriteString(fmt.Sprintf("%v", sft.MinVolume))
+	builder.WriteString(", ")
+	builder.WriteString("fee_percent=")
+	builder.WriteString(fmt.Sprintf("%v", sft.FeePercent))
+	builder.WriteString(", ")
+	builder.WriteString("min_fee=")
+	builder.WriteString(fmt.Sprintf("%v", sft.MinFee))
+	builder.WriteString(", ")
+	builder.WriteString("max_fee=")
+	builder.WriteString(fmt.Sprintf("%v", sft.MaxFee))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(sft.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(sft.EndsAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SenderFeeTiers is a parsable slice of SenderFeeTier.
+type SenderFeeTiers []*SenderFeeTier
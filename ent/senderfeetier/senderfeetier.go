@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package senderfeetier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the senderfeetier type in the database.
+	Label = "sender_fee_tier"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMinVolume holds the string denoting the min_volume field in the database.
+	FieldMinVolume = "min_volume"
+	// FieldFeePercent holds the string denoting the fee_percent field in the database.
+	FieldFeePercent = "fee_percent"
+	// FieldMinFee holds the string denoting the min_fee field in the database.
+	FieldMinFee = "min_fee"
+	// FieldMaxFee holds the string denoting the max_fee field in the database.
+	FieldMaxFee = "max_fee"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// EdgeSenderOrderToken holds the string denoting the sender_order_token edge name in mutations.
+	EdgeSenderOrderToken = "sender_order_token"
+	// Table holds the table name of the senderfeetier in the database.
+	Table = "sender_fee_tiers"
+	// SenderOrderTokenTable is the table that holds the sender_order_token relation/edge.
+	SenderOrderTokenTable = "sender_fee_tiers"
+	// SenderOrderTokenInverseTable is the table name for the SenderOrderToken entity.
+	// It exists in this package in order to avoid circular dependency with the "senderordertoken" package.
+	SenderOrderTokenInverseTable = "sender_order_tokens"
+	// SenderOrderTokenColumn is the table column denoting the sender_order_token relation/edge.
+	SenderOrderTokenColumn = "sender_order_token_fee_tiers"
+)
+
+// Columns holds all SQL columns for senderfeetier fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldMinVolume,
+	FieldFeePercent,
+	FieldMinFee,
+	FieldMaxFee,
+	FieldStartsAt,
+	FieldEndsAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sender_fee_tiers"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sender_order_token_fee_tiers",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SenderFeeTier queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMinVolume orders the results by the min_volume field.
+func ByMinVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinVolume, opts...).ToFunc()
+}
+
+// ByFeePercent orders the results by the fee_percent field.
+func ByFeePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeePercent, opts...).ToFunc()
+}
+
+// ByMinFee orders the results by the min_fee field.
+func ByMinFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinFee, opts...).ToFunc()
+}
+
+// ByMaxFee orders the results by the max_fee field.
+func ByMaxFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxFee, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// BySenderOrderTokenField orders the results by sender_order_token field.
+func BySenderOrderTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderOrderTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSenderOrderTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderOrderTokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderOrderTokenTable, SenderOrderTokenColumn),
+	)
+}
@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package senderfeetier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldName, v))
+}
+
+// MinVolume applies equality check predicate on the "min_volume" field. It's identical to MinVolumeEQ.
+func MinVolume(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMinVolume, v))
+}
+
+// FeePercent applies equality check predicate on the "fee_percent" field. It's identical to FeePercentEQ.
+func FeePercent(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldFeePercent, v))
+}
+
+// MinFee applies equality check predicate on the "min_fee" field. It's identical to MinFeeEQ.
+func MinFee(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMinFee, v))
+}
+
+// MaxFee applies equality check predicate on the "max_fee" field. It's identical to MaxFeeEQ.
+func MaxFee(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMaxFee, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldContainsFold(FieldName, v))
+}
+
+// MinVolumeEQ applies the EQ predicate on the "min_volume" field.
+func MinVolumeEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMinVolume, v))
+}
+
+// MinVolumeNEQ applies the NEQ predicate on the "min_volume" field.
+func MinVolumeNEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldMinVolume, v))
+}
+
+// MinVolumeIn applies the In predicate on the "min_volume" field.
+func MinVolumeIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldMinVolume, vs...))
+}
+
+// MinVolumeNotIn applies the NotIn predicate on the "min_volume" field.
+func MinVolumeNotIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldMinVolume, vs...))
+}
+
+// MinVolumeGT applies the GT predicate on the "min_volume" field.
+func MinVolumeGT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldMinVolume, v))
+}
+
+// MinVolumeGTE applies the GTE predicate on the "min_volume" field.
+func MinVolumeGTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldMinVolume, v))
+}
+
+// MinVolumeLT applies the LT predicate on the "min_volume" field.
+func MinVolumeLT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldMinVolume, v))
+}
+
+// MinVolumeLTE applies the LTE predicate on the "min_volume" field.
+func MinVolumeLTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldMinVolume, v))
+}
+
+// FeePercentEQ applies the EQ predicate on the "fee_percent" field.
+func FeePercentEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldFeePercent, v))
+}
+
+// FeePercentNEQ applies the NEQ predicate on the "fee_percent" field.
+func FeePercentNEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldFeePercent, v))
+}
+
+// FeePercentIn applies the In predicate on the "fee_percent" field.
+func FeePercentIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldFeePercent, vs...))
+}
+
+// FeePercentNotIn applies the NotIn predicate on the "fee_percent" field.
+func FeePercentNotIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldFeePercent, vs...))
+}
+
+// FeePercentGT applies the GT predicate on the "fee_percent" field.
+func FeePercentGT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldFeePercent, v))
+}
+
+// FeePercentGTE applies the GTE predicate on the "fee_percent" field.
+func FeePercentGTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldFeePercent, v))
+}
+
+// FeePercentLT applies the LT predicate on the "fee_percent" field.
+func FeePercentLT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldFeePercent, v))
+}
+
+// FeePercentLTE applies the LTE predicate on the "fee_percent" field.
+func FeePercentLTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldFeePercent, v))
+}
+
+// MinFeeEQ applies the EQ predicate on the "min_fee" field.
+func MinFeeEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMinFee, v))
+}
+
+// MinFeeNEQ applies the NEQ predicate on the "min_fee" field.
+func MinFeeNEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldMinFee, v))
+}
+
+// MinFeeIn applies the In predicate on the "min_fee" field.
+func MinFeeIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldMinFee, vs...))
+}
+
+// MinFeeNotIn applies the NotIn predicate on the "min_fee" field.
+func MinFeeNotIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldMinFee, vs...))
+}
+
+// MinFeeGT applies the GT predicate on the "min_fee" field.
+func MinFeeGT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldMinFee, v))
+}
+
+// MinFeeGTE applies the GTE predicate on the "min_fee" field.
+func MinFeeGTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldMinFee, v))
+}
+
+// MinFeeLT applies the LT predicate on the "min_fee" field.
+func MinFeeLT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldMinFee, v))
+}
+
+// MinFeeLTE applies the LTE predicate on the "min_fee" field.
+func MinFeeLTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldMinFee, v))
+}
+
+// MaxFeeEQ applies the EQ predicate on the "max_fee" field.
+func MaxFeeEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldMaxFee, v))
+}
+
+// MaxFeeNEQ applies the NEQ predicate on the "max_fee" field.
+func MaxFeeNEQ(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldMaxFee, v))
+}
+
+// MaxFeeIn applies the In predicate on the "max_fee" field.
+func MaxFeeIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldMaxFee, vs...))
+}
+
+// MaxFeeNotIn applies the NotIn predicate on the "max_fee" field.
+func MaxFeeNotIn(vs ...decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldMaxFee, vs...))
+}
+
+// MaxFeeGT applies the GT predicate on the "max_fee" field.
+func MaxFeeGT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldMaxFee, v))
+}
+
+// MaxFeeGTE applies the GTE predicate on the "max_fee" field.
+func MaxFeeGTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldMaxFee, v))
+}
+
+// MaxFeeLT applies the LT predicate on the "max_fee" field.
+func MaxFeeLT(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldMaxFee, v))
+}
+
+// MaxFeeLTE applies the LTE predicate on the "max_fee" field.
+func MaxFeeLTE(v decimal.Decimal) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldMaxFee, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotNull(FieldStartsAt))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.FieldNotNull(FieldEndsAt))
+}
+
+// HasSenderOrderToken applies the HasEdge predicate on the "sender_order_token" edge.
+func HasSenderOrderToken() predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderOrderTokenTable, SenderOrderTokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderOrderTokenWith applies the HasEdge predicate on the "sender_order_token" edge with a given conditions (other predicates).
+func HasSenderOrderTokenWith(preds ...predicate.SenderOrderToken) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(func(s *sql.Selector) {
+		step := newSenderOrderTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SenderFeeTier) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SenderFeeTier) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SenderFeeTier) predicate.SenderFeeTier {
+	return predicate.SenderFeeTier(sql.NotPredicates(p))
+}
@@ -0,0 +1,1049 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+	"github.com/shopspring/decimal"
+)
+
+// SenderFeeTierCreate is the builder for creating a SenderFeeTier entity.
+type SenderFeeTierCreate struct {
+	config
+	mutation *SenderFeeTierMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sftc *SenderFeeTierCreate) SetCreatedAt(t time.Time) *SenderFeeTierCreate {
+	sftc.mutation.SetCreatedAt(t)
+	return sftc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sftc *SenderFeeTierCreate) SetNillableCreatedAt(t *time.Time) *SenderFeeTierCreate {
+	if t != nil {
+		sftc.SetCreatedAt(*t)
+	}
+	return sftc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sftc *SenderFeeTierCreate) SetUpdatedAt(t time.Time) *SenderFeeTierCreate {
+	sftc.mutation.SetUpdatedAt(t)
+	return sftc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sftc *SenderFeeTierCreate) SetNillableUpdatedAt(t *time.Time) *SenderFeeTierCreate {
+	if t != nil {
+		sftc.SetUpdatedAt(*t)
+	}
+	return sftc
+}
+
+// SetName sets the "name" field.
+func (sftc *SenderFeeTierCreate) SetName(s string) *SenderFeeTierCreate {
+	sftc.mutation.SetName(s)
+	return sftc
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (sftc *SenderFeeTierCreate) SetMinVolume(d decimal.Decimal) *SenderFeeTierCreate {
+	sftc.mutation.SetMinVolume(d)
+	return sftc
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (sftc *SenderFeeTierCreate) SetFeePercent(d decimal.Decimal) *SenderFeeTierCreate {
+	sftc.mutation.SetFeePercent(d)
+	return sftc
+}
+
+// SetMinFee sets the "min_fee" field.
+func (sftc *SenderFeeTierCreate) SetMinFee(d decimal.Decimal) *SenderFeeTierCreate {
+	sftc.mutation.SetMinFee(d)
+	return sftc
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (sftc *SenderFeeTierCreate) SetMaxFee(d decimal.Decimal) *SenderFeeTierCreate {
+	sftc.mutation.SetMaxFee(d)
+	return sftc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sftc *SenderFeeTierCreate) SetStartsAt(t time.Time) *SenderFeeTierCreate {
+	sftc.mutation.SetStartsAt(t)
+	return sftc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (sftc *SenderFeeTierCreate) SetNillableStartsAt(t *time.Time) *SenderFeeTierCreate {
+	if t != nil {
+		sftc.SetStartsAt(*t)
+	}
+	return sftc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sftc *SenderFeeTierCreate) SetEndsAt(t time.Time) *SenderFeeTierCreate {
+	sftc.mutation.SetEndsAt(t)
+	return sftc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (sftc *SenderFeeTierCreate) SetNillableEndsAt(t *time.Time) *SenderFeeTierCreate {
+	if t != nil {
+		sftc.SetEndsAt(*t)
+	}
+	return sftc
+}
+
+// SetSenderOrderTokenID sets the "sender_order_token" edge to the SenderOrderToken entity by ID.
+func (sftc *SenderFeeTierCreate) SetSenderOrderTokenID(id int) *SenderFeeTierCreate {
+	sftc.mutation.SetSenderOrderTokenID(id)
+	return sftc
+}
+
+// SetSenderOrderToken sets the "sender_order_token" edge to the SenderOrderToken entity.
+func (sftc *SenderFeeTierCreate) SetSenderOrderToken(s *SenderOrderToken) *SenderFeeTierCreate {
+	return sftc.SetSenderOrderTokenID(s.ID)
+}
+
+// Mutation returns the SenderFeeTierMutation object of the builder.
+func (sftc *SenderFeeTierCreate) Mutation() *SenderFeeTierMutation {
+	return sftc.mutation
+}
+
+// Save creates the SenderFeeTier in the database.
+func (sftc *SenderFeeTierCreate) Save(ctx context.Context) (*SenderFeeTier, error) {
+	sftc.defaults()
+	return withHooks(ctx, sftc.sqlSave, sftc.mutation, sftc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sftc *SenderFeeTierCreate) SaveX(ctx context.Context) *SenderFeeTier {
+	v, err := sftc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sftc *SenderFeeTierCreate) Exec(ctx context.Context) error {
+	_, err := sftc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftc *SenderFeeTierCreate) ExecX(ctx context.Context) {
+	if err := sftc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sftc *SenderFeeTierCreate) defaults() {
+	if _, ok := sftc.mutation.CreatedAt(); !ok {
+		v := senderfeetier.DefaultCreatedAt()
+		sftc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sftc.mutation.UpdatedAt(); !ok {
+		v := senderfeetier.DefaultUpdatedAt()
+		sftc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sftc *SenderFeeTierCreate) check() error {
+	if _, ok := sftc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SenderFeeTier.created_at"`)}
+	}
+	if _, ok := sftc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SenderFeeTier.updated_at"`)}
+	}
+	if _, ok := sftc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SenderFeeTier.name"`)}
+	}
+	if v, ok := sftc.mutation.Name(); ok {
+		if err := senderfeetier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SenderFeeTier.name": %w`, err)}
+		}
+	}
+	if _, ok := sftc.mutation.MinVolume(); !ok {
+		return &ValidationError{Name: "min_volume", err: errors.New(`ent: missing required field "SenderFeeTier.min_volume"`)}
+	}
+	if _, ok := sftc.mutation.FeePercent(); !ok {
+		return &ValidationError{Name: "fee_percent", err: errors.New(`ent: missing required field "SenderFeeTier.fee_percent"`)}
+	}
+	if _, ok := sftc.mutation.MinFee(); !ok {
+		return &ValidationError{Name: "min_fee", err: errors.New(`ent: missing required field "SenderFeeTier.min_fee"`)}
+	}
+	if _, ok := sftc.mutation.MaxFee(); !ok {
+		return &ValidationError{Name: "max_fee", err: errors.New(`ent: missing required field "SenderFeeTier.max_fee"`)}
+	}
+	if len(sftc.mutation.SenderOrderTokenIDs()) == 0 {
+		return &ValidationError{Name: "sender_order_token", err: errors.New(`ent: missing required edge "SenderFeeTier.sender_order_token"`)}
+	}
+	return nil
+}
+
+func (sftc *SenderFeeTierCreate) sqlSave(ctx context.Context) (*SenderFeeTier, error) {
+	if err := sftc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sftc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sftc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sftc.mutation.id = &_node.ID
+	sftc.mutation.done = true
+	return _node, nil
+}
+
+func (sftc *SenderFeeTierCreate) createSpec() (*SenderFeeTier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SenderFeeTier{config: sftc.config}
+		_spec = sqlgraph.NewCreateSpec(senderfeetier.Table, sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sftc.conflict
+	if value, ok := sftc.mutation.CreatedAt(); ok {
+		_spec.SetField(senderfeetier.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sftc.mutation.UpdatedAt(); ok {
+		_spec.SetField(senderfeetier.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sftc.mutation.Name(); ok {
+		_spec.SetField(senderfeetier.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sftc.mutation.MinVolume(); ok {
+		_spec.SetField(senderfeetier.FieldMinVolume, field.TypeFloat64, value)
+		_node.MinVolume = value
+	}
+	if value, ok := sftc.mutation.FeePercent(); ok {
+		_spec.SetField(senderfeetier.FieldFeePercent, field.TypeFloat64, value)
+		_node.FeePercent = value
+	}
+	if value, ok := sftc.mutation.MinFee(); ok {
+		_spec.SetField(senderfeetier.FieldMinFee, field.TypeFloat64, value)
+		_node.MinFee = value
+	}
+	if value, ok := sftc.mutation.MaxFee(); ok {
+		_spec.SetField(senderfeetier.FieldMaxFee, field.TypeFloat64, value)
+		_node.MaxFee = value
+	}
+	if value, ok := sftc.mutation.StartsAt(); ok {
+		_spec.SetField(senderfeetier.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := sftc.mutation.EndsAt(); ok {
+		_spec.SetField(senderfeetier.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if nodes := sftc.mutation.SenderOrderTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   senderfeetier.SenderOrderTokenTable,
+			Columns: []string{senderfeetier.SenderOrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_order_token_fee_tiers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SenderFeeTier.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SenderFeeTierUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sftc *SenderFeeTierCreate) OnConflict(opts ...sql.ConflictOption) *SenderFeeTierUpsertOne {
+	sftc.conflict = opts
+	return &SenderFeeTierUpsertOne{
+		create: sftc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sftc *SenderFeeTierCreate) OnConflictColumns(columns ...string) *SenderFeeTierUpsertOne {
+	sftc.conflict = append(sftc.conflict, sql.ConflictColumns(columns...))
+	return &SenderFeeTierUpsertOne{
+		create: sftc,
+	}
+}
+
+type (
+	// SenderFeeTierUpsertOne is the builder for "upsert"-ing
+	//  one SenderFeeTier node.
+	SenderFeeTierUpsertOne struct {
+		create *SenderFeeTierCreate
+	}
+
+	// SenderFeeTierUpsert is the "OnConflict" setter.
+	SenderFeeTierUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderFeeTierUpsert) SetUpdatedAt(v time.Time) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateUpdatedAt() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SenderFeeTierUpsert) SetName(v string) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateName() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldName)
+	return u
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (u *SenderFeeTierUpsert) SetMinVolume(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldMinVolume, v)
+	return u
+}
+
+// UpdateMinVolume sets the "min_volume" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateMinVolume() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldMinVolume)
+	return u
+}
+
+// AddMinVolume adds v to the "min_volume" field.
+func (u *SenderFeeTierUpsert) AddMinVolume(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Add(senderfeetier.FieldMinVolume, v)
+	return u
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (u *SenderFeeTierUpsert) SetFeePercent(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldFeePercent, v)
+	return u
+}
+
+// UpdateFeePercent sets the "fee_percent" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateFeePercent() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldFeePercent)
+	return u
+}
+
+// AddFeePercent adds v to the "fee_percent" field.
+func (u *SenderFeeTierUpsert) AddFeePercent(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Add(senderfeetier.FieldFeePercent, v)
+	return u
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *SenderFeeTierUpsert) SetMinFee(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldMinFee, v)
+	return u
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateMinFee() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldMinFee)
+	return u
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *SenderFeeTierUpsert) AddMinFee(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Add(senderfeetier.FieldMinFee, v)
+	return u
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *SenderFeeTierUpsert) SetMaxFee(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldMaxFee, v)
+	return u
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateMaxFee() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldMaxFee)
+	return u
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *SenderFeeTierUpsert) AddMaxFee(v decimal.Decimal) *SenderFeeTierUpsert {
+	u.Add(senderfeetier.FieldMaxFee, v)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SenderFeeTierUpsert) SetStartsAt(v time.Time) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateStartsAt() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldStartsAt)
+	return u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *SenderFeeTierUpsert) ClearStartsAt() *SenderFeeTierUpsert {
+	u.SetNull(senderfeetier.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SenderFeeTierUpsert) SetEndsAt(v time.Time) *SenderFeeTierUpsert {
+	u.Set(senderfeetier.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsert) UpdateEndsAt() *SenderFeeTierUpsert {
+	u.SetExcluded(senderfeetier.FieldEndsAt)
+	return u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SenderFeeTierUpsert) ClearEndsAt() *SenderFeeTierUpsert {
+	u.SetNull(senderfeetier.FieldEndsAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SenderFeeTierUpsertOne) UpdateNewValues() *SenderFeeTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(senderfeetier.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SenderFeeTierUpsertOne) Ignore() *SenderFeeTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SenderFeeTierUpsertOne) DoNothing() *SenderFeeTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SenderFeeTierCreate.OnConflict
+// documentation for more info.
+func (u *SenderFeeTierUpsertOne) Update(set func(*SenderFeeTierUpsert)) *SenderFeeTierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SenderFeeTierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderFeeTierUpsertOne) SetUpdatedAt(v time.Time) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateUpdatedAt() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SenderFeeTierUpsertOne) SetName(v string) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateName() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (u *SenderFeeTierUpsertOne) SetMinVolume(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMinVolume(v)
+	})
+}
+
+// AddMinVolume adds v to the "min_volume" field.
+func (u *SenderFeeTierUpsertOne) AddMinVolume(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMinVolume(v)
+	})
+}
+
+// UpdateMinVolume sets the "min_volume" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateMinVolume() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMinVolume()
+	})
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (u *SenderFeeTierUpsertOne) SetFeePercent(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetFeePercent(v)
+	})
+}
+
+// AddFeePercent adds v to the "fee_percent" field.
+func (u *SenderFeeTierUpsertOne) AddFeePercent(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddFeePercent(v)
+	})
+}
+
+// UpdateFeePercent sets the "fee_percent" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateFeePercent() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateFeePercent()
+	})
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *SenderFeeTierUpsertOne) SetMinFee(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMinFee(v)
+	})
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *SenderFeeTierUpsertOne) AddMinFee(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMinFee(v)
+	})
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateMinFee() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMinFee()
+	})
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *SenderFeeTierUpsertOne) SetMaxFee(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMaxFee(v)
+	})
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *SenderFeeTierUpsertOne) AddMaxFee(v decimal.Decimal) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMaxFee(v)
+	})
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateMaxFee() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMaxFee()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SenderFeeTierUpsertOne) SetStartsAt(v time.Time) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateStartsAt() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *SenderFeeTierUpsertOne) ClearStartsAt() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SenderFeeTierUpsertOne) SetEndsAt(v time.Time) *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertOne) UpdateEndsAt() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SenderFeeTierUpsertOne) ClearEndsAt() *SenderFeeTierUpsertOne {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SenderFeeTierUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SenderFeeTierCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SenderFeeTierUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SenderFeeTierUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SenderFeeTierUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SenderFeeTierCreateBulk is the builder for creating many SenderFeeTier entities in bulk.
+type SenderFeeTierCreateBulk struct {
+	config
+	err      error
+	builders []*SenderFeeTierCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SenderFeeTier entities in the database.
+func (sftcb *SenderFeeTierCreateBulk) Save(ctx context.Context) ([]*SenderFeeTier, error) {
+	if sftcb.err != nil {
+		return nil, sftcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sftcb.builders))
+	nodes := make([]*SenderFeeTier, len(sftcb.builders))
+	mutators := make([]Mutator, len(sftcb.builders))
+	for i := range sftcb.builders {
+		func(i int, root context.Context) {
+			builder := sftcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SenderFeeTierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sftcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sftcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sftcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sftcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sftcb *SenderFeeTierCreateBulk) SaveX(ctx context.Context) []*SenderFeeTier {
+	v, err := sftcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sftcb *SenderFeeTierCreateBulk) Exec(ctx context.Context) error {
+	_, err := sftcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftcb *SenderFeeTierCreateBulk) ExecX(ctx context.Context) {
+	if err := sftcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SenderFeeTier.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SenderFeeTierUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sftcb *SenderFeeTierCreateBulk) OnConflict(opts ...sql.ConflictOption) *SenderFeeTierUpsertBulk {
+	sftcb.conflict = opts
+	return &SenderFeeTierUpsertBulk{
+		create: sftcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sftcb *SenderFeeTierCreateBulk) OnConflictColumns(columns ...string) *SenderFeeTierUpsertBulk {
+	sftcb.conflict = append(sftcb.conflict, sql.ConflictColumns(columns...))
+	return &SenderFeeTierUpsertBulk{
+		create: sftcb,
+	}
+}
+
+// SenderFeeTierUpsertBulk is the builder for "upsert"-ing
+// a bulk of SenderFeeTier nodes.
+type SenderFeeTierUpsertBulk struct {
+	create *SenderFeeTierCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SenderFeeTierUpsertBulk) UpdateNewValues() *SenderFeeTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(senderfeetier.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SenderFeeTier.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SenderFeeTierUpsertBulk) Ignore() *SenderFeeTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SenderFeeTierUpsertBulk) DoNothing() *SenderFeeTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SenderFeeTierCreateBulk.OnConflict
+// documentation for more info.
+func (u *SenderFeeTierUpsertBulk) Update(set func(*SenderFeeTierUpsert)) *SenderFeeTierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SenderFeeTierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderFeeTierUpsertBulk) SetUpdatedAt(v time.Time) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateUpdatedAt() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SenderFeeTierUpsertBulk) SetName(v string) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateName() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (u *SenderFeeTierUpsertBulk) SetMinVolume(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMinVolume(v)
+	})
+}
+
+// AddMinVolume adds v to the "min_volume" field.
+func (u *SenderFeeTierUpsertBulk) AddMinVolume(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMinVolume(v)
+	})
+}
+
+// UpdateMinVolume sets the "min_volume" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateMinVolume() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMinVolume()
+	})
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (u *SenderFeeTierUpsertBulk) SetFeePercent(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetFeePercent(v)
+	})
+}
+
+// AddFeePercent adds v to the "fee_percent" field.
+func (u *SenderFeeTierUpsertBulk) AddFeePercent(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddFeePercent(v)
+	})
+}
+
+// UpdateFeePercent sets the "fee_percent" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateFeePercent() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateFeePercent()
+	})
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *SenderFeeTierUpsertBulk) SetMinFee(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMinFee(v)
+	})
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *SenderFeeTierUpsertBulk) AddMinFee(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMinFee(v)
+	})
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateMinFee() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMinFee()
+	})
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *SenderFeeTierUpsertBulk) SetMaxFee(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetMaxFee(v)
+	})
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *SenderFeeTierUpsertBulk) AddMaxFee(v decimal.Decimal) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.AddMaxFee(v)
+	})
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateMaxFee() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateMaxFee()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SenderFeeTierUpsertBulk) SetStartsAt(v time.Time) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateStartsAt() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *SenderFeeTierUpsertBulk) ClearStartsAt() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SenderFeeTierUpsertBulk) SetEndsAt(v time.Time) *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SenderFeeTierUpsertBulk) UpdateEndsAt() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SenderFeeTierUpsertBulk) ClearEndsAt() *SenderFeeTierUpsertBulk {
+	return u.Update(func(s *SenderFeeTierUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SenderFeeTierUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SenderFeeTierCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SenderFeeTierCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SenderFeeTierUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
+)
+
+// SenderFeeTierDelete is the builder for deleting a SenderFeeTier entity.
+type SenderFeeTierDelete struct {
+	config
+	hooks    []Hook
+	mutation *SenderFeeTierMutation
+}
+
+// Where appends a list predicates to the SenderFeeTierDelete builder.
+func (sftd *SenderFeeTierDelete) Where(ps ...predicate.SenderFeeTier) *SenderFeeTierDelete {
+	sftd.mutation.Where(ps...)
+	return sftd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sftd *SenderFeeTierDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sftd.sqlExec, sftd.mutation, sftd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftd *SenderFeeTierDelete) ExecX(ctx context.Context) int {
+	n, err := sftd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sftd *SenderFeeTierDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(senderfeetier.Table, sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt))
+	if ps := sftd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sftd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sftd.mutation.done = true
+	return affected, err
+}
+
+// SenderFeeTierDeleteOne is the builder for deleting a single SenderFeeTier entity.
+type SenderFeeTierDeleteOne struct {
+	sftd *SenderFeeTierDelete
+}
+
+// Where appends a list predicates to the SenderFeeTierDelete builder.
+func (sftdo *SenderFeeTierDeleteOne) Where(ps ...predicate.SenderFeeTier) *SenderFeeTierDeleteOne {
+	sftdo.sftd.mutation.Where(ps...)
+	return sftdo
+}
+
+// Exec executes the deletion query.
+func (sftdo *SenderFeeTierDeleteOne) Exec(ctx context.Context) error {
+	n, err := sftdo.sftd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{senderfeetier.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftdo *SenderFeeTierDeleteOne) ExecX(ctx context.Context) {
+	if err := sftdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
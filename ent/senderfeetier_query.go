@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+)
+
+// SenderFeeTierQuery is the builder for querying SenderFeeTier entities.
+type SenderFeeTierQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []senderfeetier.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.SenderFeeTier
+	withSenderOrderToken *SenderOrderTokenQuery
+	withFKs              bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SenderFeeTierQuery builder.
+func (sftq *SenderFeeTierQuery) Where(ps ...predicate.SenderFeeTier) *SenderFeeTierQuery {
+	sftq.predicates = append(sftq.predicates, ps...)
+	return sftq
+}
+
+// Limit the number of records to be returned by this query.
+func (sftq *SenderFeeTierQuery) Limit(limit int) *SenderFeeTierQuery {
+	sftq.ctx.Limit = &limit
+	return sftq
+}
+
+// Offset to start from.
+func (sftq *SenderFeeTierQuery) Offset(offset int) *SenderFeeTierQuery {
+	sftq.ctx.Offset = &offset
+	return sftq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sftq *SenderFeeTierQuery) Unique(unique bool) *SenderFeeTierQuery {
+	sftq.ctx.Unique = &unique
+	return sftq
+}
+
+// Order specifies how the records should be ordered.
+func (sftq *SenderFeeTierQuery) Order(o ...senderfeetier.OrderOption) *SenderFeeTierQuery {
+	sftq.order = append(sftq.order, o...)
+	return sftq
+}
+
+// QuerySenderOrderToken chains the current query on the "sender_order_token" edge.
+func (sftq *SenderFeeTierQuery) QuerySenderOrderToken() *SenderOrderTokenQuery {
+	query := (&SenderOrderTokenClient{config: sftq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sftq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderfeetier.Table, senderfeetier.FieldID, selector),
+			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, senderfeetier.SenderOrderTokenTable, senderfeetier.SenderOrderTokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sftq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SenderFeeTier entity from the query.
+// Returns a *NotFoundError when no SenderFeeTier was found.
+func (sftq *SenderFeeTierQuery) First(ctx context.Context) (*SenderFeeTier, error) {
+	nodes, err := sftq.Limit(1).All(setContextOp(ctx, sftq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{senderfeetier.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) FirstX(ctx context.Context) *SenderFeeTier {
+	node, err := sftq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SenderFeeTier ID from the query.
+// Returns a *NotFoundError when no SenderFeeTier ID was found.
+func (sftq *SenderFeeTierQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sftq.Limit(1).IDs(setContextOp(ctx, sftq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{senderfeetier.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) FirstIDX(ctx context.Context) int {
+	id, err := sftq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SenderFeeTier entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SenderFeeTier entity is found.
+// Returns a *NotFoundError when no SenderFeeTier entities are found.
+func (sftq *SenderFeeTierQuery) Only(ctx context.Context) (*SenderFeeTier, error) {
+	nodes, err := sftq.Limit(2).All(setContextOp(ctx, sftq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{senderfeetier.Label}
+	default:
+		return nil, &NotSingularError{senderfeetier.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) OnlyX(ctx context.Context) *SenderFeeTier {
+	node, err := sftq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SenderFeeTier ID in the query.
+// Returns a *NotSingularError when more than one SenderFeeTier ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sftq *SenderFeeTierQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sftq.Limit(2).IDs(setContextOp(ctx, sftq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{senderfeetier.Label}
+	default:
+		err = &NotSingularError{senderfeetier.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sftq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SenderFeeTiers.
+func (sftq *SenderFeeTierQuery) All(ctx context.Context) ([]*SenderFeeTier, error) {
+	ctx = setContextOp(ctx, sftq.ctx, ent.OpQueryAll)
+	if err := sftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SenderFeeTier, *SenderFeeTierQuery]()
+	return withInterceptors[[]*SenderFeeTier](ctx, sftq, qr, sftq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) AllX(ctx context.Context) []*SenderFeeTier {
+	nodes, err := sftq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SenderFeeTier IDs.
+func (sftq *SenderFeeTierQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if sftq.ctx.Unique == nil && sftq.path != nil {
+		sftq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sftq.ctx, ent.OpQueryIDs)
+	if err = sftq.Select(senderfeetier.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) IDsX(ctx context.Context) []int {
+	ids, err := sftq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sftq *SenderFeeTierQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sftq.ctx, ent.OpQueryCount)
+	if err := sftq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sftq, querierCount[*SenderFeeTierQuery](), sftq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) CountX(ctx context.Context) int {
+	count, err := sftq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sftq *SenderFeeTierQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sftq.ctx, ent.OpQueryExist)
+	switch _, err := sftq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sftq *SenderFeeTierQuery) ExistX(ctx context.Context) bool {
+	exist, err := sftq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SenderFeeTierQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sftq *SenderFeeTierQuery) Clone() *SenderFeeTierQuery {
+	if sftq == nil {
+		return nil
+	}
+	return &SenderFeeTierQuery{
+		config:               sftq.config,
+		ctx:                  sftq.ctx.Clone(),
+		order:                append([]senderfeetier.OrderOption{}, sftq.order...),
+		inters:               append([]Interceptor{}, sftq.inters...),
+		predicates:           append([]predicate.SenderFeeTier{}, sftq.predicates...),
+		withSenderOrderToken: sftq.withSenderOrderToken.Clone(),
+		// clone intermediate query.
+		sql:  sftq.sql.Clone(),
+		path: sftq.path,
+	}
+}
+
+// WithSenderOrderToken tells the query-builder to eager-load the nodes that are connected to
+// the "sender_order_token" edge. The optional arguments are used to configure the query builder of the edge.
+func (sftq *SenderFeeTierQuery) WithSenderOrderToken(opts ...func(*SenderOrderTokenQuery)) *SenderFeeTierQuery {
+	query := (&SenderOrderTokenClient{config: sftq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sftq.withSenderOrderToken = query
+	return sftq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SenderFeeTier.Query().
+//		GroupBy(senderfeetier.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sftq *SenderFeeTierQuery) GroupBy(field string, fields ...string) *SenderFeeTierGroupBy {
+	sftq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SenderFeeTierGroupBy{build: sftq}
+	grbuild.flds = &sftq.ctx.Fields
+	grbuild.label = senderfeetier.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SenderFeeTier.Query().
+//		Select(senderfeetier.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (sftq *SenderFeeTierQuery) Select(fields ...string) *SenderFeeTierSelect {
+	sftq.ctx.Fields = append(sftq.ctx.Fields, fields...)
+	sbuild := &SenderFeeTierSelect{SenderFeeTierQuery: sftq}
+	sbuild.label = senderfeetier.Label
+	sbuild.flds, sbuild.scan = &sftq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SenderFeeTierSelect configured with the given aggregations.
+func (sftq *SenderFeeTierQuery) Aggregate(fns ...AggregateFunc) *SenderFeeTierSelect {
+	return sftq.Select().Aggregate(fns...)
+}
+
+func (sftq *SenderFeeTierQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sftq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sftq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sftq.ctx.Fields {
+		if !senderfeetier.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sftq.path != nil {
+		prev, err := sftq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sftq.sql = prev
+	}
+	return nil
+}
+
+func (sftq *SenderFeeTierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SenderFeeTier, error) {
+	var (
+		nodes       = []*SenderFeeTier{}
+		withFKs     = sftq.withFKs
+		_spec       = sftq.querySpec()
+		loadedTypes = [1]bool{
+			sftq.withSenderOrderToken != nil,
+		}
+	)
+	if sftq.withSenderOrderToken != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, senderfeetier.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SenderFeeTier).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SenderFeeTier{config: sftq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sftq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sftq.withSenderOrderToken; query != nil {
+		if err := sftq.loadSenderOrderToken(ctx, query, nodes, nil,
+			func(n *SenderFeeTier, e *SenderOrderToken) { n.Edges.SenderOrderToken = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sftq *SenderFeeTierQuery) loadSenderOrderToken(ctx context.Context, query *SenderOrderTokenQuery, nodes []*SenderFeeTier, init func(*SenderFeeTier), assign func(*SenderFeeTier, *SenderOrderToken)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SenderFeeTier)
+	for i := range nodes {
+		if nodes[i].sender_order_token_fee_tiers == nil {
+			continue
+		}
+		fk := *nodes[i].sender_order_token_fee_tiers
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderordertoken.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_order_token_fee_tiers" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sftq *SenderFeeTierQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sftq.querySpec()
+	_spec.Node.Columns = sftq.ctx.Fields
+	if len(sftq.ctx.Fields) > 0 {
+		_spec.Unique = sftq.ctx.Unique != nil && *sftq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sftq.driver, _spec)
+}
+
+func (sftq *SenderFeeTierQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(senderfeetier.Table, senderfeetier.Columns, sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt))
+	_spec.From = sftq.sql
+	if unique := sftq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sftq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sftq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, senderfeetier.FieldID)
+		for i := range fields {
+			if fields[i] != senderfeetier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sftq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sftq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sftq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sftq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sftq *SenderFeeTierQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sftq.driver.Dialect())
+	t1 := builder.Table(senderfeetier.Table)
+	columns := sftq.ctx.Fields
+	if len(columns) == 0 {
+		columns = senderfeetier.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sftq.sql != nil {
+		selector = sftq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sftq.ctx.Unique != nil && *sftq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sftq.predicates {
+		p(selector)
+	}
+	for _, p := range sftq.order {
+		p(selector)
+	}
+	if offset := sftq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sftq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SenderFeeTierGroupBy is the group-by builder for SenderFeeTier entities.
+type SenderFeeTierGroupBy struct {
+	selector
+	build *SenderFeeTierQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sftgb *SenderFeeTierGroupBy) Aggregate(fns ...AggregateFunc) *SenderFeeTierGroupBy {
+	sftgb.fns = append(sftgb.fns, fns...)
+	return sftgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sftgb *SenderFeeTierGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sftgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sftgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SenderFeeTierQuery, *SenderFeeTierGroupBy](ctx, sftgb.build, sftgb, sftgb.build.inters, v)
+}
+
+func (sftgb *SenderFeeTierGroupBy) sqlScan(ctx context.Context, root *SenderFeeTierQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sftgb.fns))
+	for _, fn := range sftgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sftgb.flds)+len(sftgb.fns))
+		for _, f := range *sftgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sftgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sftgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SenderFeeTierSelect is the builder for selecting fields of SenderFeeTier entities.
+type SenderFeeTierSelect struct {
+	*SenderFeeTierQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sfts *SenderFeeTierSelect) Aggregate(fns ...AggregateFunc) *SenderFeeTierSelect {
+	sfts.fns = append(sfts.fns, fns...)
+	return sfts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sfts *SenderFeeTierSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sfts.ctx, ent.OpQuerySelect)
+	if err := sfts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SenderFeeTierQuery, *SenderFeeTierSelect](ctx, sfts.SenderFeeTierQuery, sfts, sfts.inters, v)
+}
+
+func (sfts *SenderFeeTierSelect) sqlScan(ctx context.Context, root *SenderFeeTierQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sfts.fns))
+	for _, fn := range sfts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sfts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sfts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+	"github.com/shopspring/decimal"
+)
+
+// SenderFeeTierUpdate is the builder for updating SenderFeeTier entities.
+type SenderFeeTierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SenderFeeTierMutation
+}
+
+// Where appends a list predicates to the SenderFeeTierUpdate builder.
+func (sftu *SenderFeeTierUpdate) Where(ps ...predicate.SenderFeeTier) *SenderFeeTierUpdate {
+	sftu.mutation.Where(ps...)
+	return sftu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sftu *SenderFeeTierUpdate) SetUpdatedAt(t time.Time) *SenderFeeTierUpdate {
+	sftu.mutation.SetUpdatedAt(t)
+	return sftu
+}
+
+// SetName sets the "name" field.
+func (sftu *SenderFeeTierUpdate) SetName(s string) *SenderFeeTierUpdate {
+	sftu.mutation.SetName(s)
+	return sftu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableName(s *string) *SenderFeeTierUpdate {
+	if s != nil {
+		sftu.SetName(*s)
+	}
+	return sftu
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (sftu *SenderFeeTierUpdate) SetMinVolume(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.ResetMinVolume()
+	sftu.mutation.SetMinVolume(d)
+	return sftu
+}
+
+// SetNillableMinVolume sets the "min_volume" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableMinVolume(d *decimal.Decimal) *SenderFeeTierUpdate {
+	if d != nil {
+		sftu.SetMinVolume(*d)
+	}
+	return sftu
+}
+
+// AddMinVolume adds d to the "min_volume" field.
+func (sftu *SenderFeeTierUpdate) AddMinVolume(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.AddMinVolume(d)
+	return sftu
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (sftu *SenderFeeTierUpdate) SetFeePercent(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.ResetFeePercent()
+	sftu.mutation.SetFeePercent(d)
+	return sftu
+}
+
+// SetNillableFeePercent sets the "fee_percent" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableFeePercent(d *decimal.Decimal) *SenderFeeTierUpdate {
+	if d != nil {
+		sftu.SetFeePercent(*d)
+	}
+	return sftu
+}
+
+// AddFeePercent adds d to the "fee_percent" field.
+func (sftu *SenderFeeTierUpdate) AddFeePercent(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.AddFeePercent(d)
+	return sftu
+}
+
+// SetMinFee sets the "min_fee" field.
+func (sftu *SenderFeeTierUpdate) SetMinFee(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.ResetMinFee()
+	sftu.mutation.SetMinFee(d)
+	return sftu
+}
+
+// SetNillableMinFee sets the "min_fee" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableMinFee(d *decimal.Decimal) *SenderFeeTierUpdate {
+	if d != nil {
+		sftu.SetMinFee(*d)
+	}
+	return sftu
+}
+
+// AddMinFee adds d to the "min_fee" field.
+func (sftu *SenderFeeTierUpdate) AddMinFee(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.AddMinFee(d)
+	return sftu
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (sftu *SenderFeeTierUpdate) SetMaxFee(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.ResetMaxFee()
+	sftu.mutation.SetMaxFee(d)
+	return sftu
+}
+
+// SetNillableMaxFee sets the "max_fee" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableMaxFee(d *decimal.Decimal) *SenderFeeTierUpdate {
+	if d != nil {
+		sftu.SetMaxFee(*d)
+	}
+	return sftu
+}
+
+// AddMaxFee adds d to the "max_fee" field.
+func (sftu *SenderFeeTierUpdate) AddMaxFee(d decimal.Decimal) *SenderFeeTierUpdate {
+	sftu.mutation.AddMaxFee(d)
+	return sftu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sftu *SenderFeeTierUpdate) SetStartsAt(t time.Time) *SenderFeeTierUpdate {
+	sftu.mutation.SetStartsAt(t)
+	return sftu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableStartsAt(t *time.Time) *SenderFeeTierUpdate {
+	if t != nil {
+		sftu.SetStartsAt(*t)
+	}
+	return sftu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (sftu *SenderFeeTierUpdate) ClearStartsAt() *SenderFeeTierUpdate {
+	sftu.mutation.ClearStartsAt()
+	return sftu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sftu *SenderFeeTierUpdate) SetEndsAt(t time.Time) *SenderFeeTierUpdate {
+	sftu.mutation.SetEndsAt(t)
+	return sftu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (sftu *SenderFeeTierUpdate) SetNillableEndsAt(t *time.Time) *SenderFeeTierUpdate {
+	if t != nil {
+		sftu.SetEndsAt(*t)
+	}
+	return sftu
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (sftu *SenderFeeTierUpdate) ClearEndsAt() *SenderFeeTierUpdate {
+	sftu.mutation.ClearEndsAt()
+	return sftu
+}
+
+// SetSenderOrderTokenID sets the "sender_order_token" edge to the SenderOrderToken entity by ID.
+func (sftu *SenderFeeTierUpdate) SetSenderOrderTokenID(id int) *SenderFeeTierUpdate {
+	sftu.mutation.SetSenderOrderTokenID(id)
+	return sftu
+}
+
+// SetSenderOrderToken sets the "sender_order_token" edge to the SenderOrderToken entity.
+func (sftu *SenderFeeTierUpdate) SetSenderOrderToken(s *SenderOrderToken) *SenderFeeTierUpdate {
+	return sftu.SetSenderOrderTokenID(s.ID)
+}
+
+// Mutation returns the SenderFeeTierMutation object of the builder.
+func (sftu *SenderFeeTierUpdate) Mutation() *SenderFeeTierMutation {
+	return sftu.mutation
+}
+
+// ClearSenderOrderToken clears the "sender_order_token" edge to the SenderOrderToken entity.
+func (sftu *SenderFeeTierUpdate) ClearSenderOrderToken() *SenderFeeTierUpdate {
+	sftu.mutation.ClearSenderOrderToken()
+	return sftu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sftu *SenderFeeTierUpdate) Save(ctx context.Context) (int, error) {
+	sftu.defaults()
+	return withHooks(ctx, sftu.sqlSave, sftu.mutation, sftu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sftu *SenderFeeTierUpdate) SaveX(ctx context.Context) int {
+	affected, err := sftu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sftu *SenderFeeTierUpdate) Exec(ctx context.Context) error {
+	_, err := sftu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftu *SenderFeeTierUpdate) ExecX(ctx context.Context) {
+	if err := sftu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sftu *SenderFeeTierUpdate) defaults() {
+	if _, ok := sftu.mutation.UpdatedAt(); !ok {
+		v := senderfeetier.UpdateDefaultUpdatedAt()
+		sftu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sftu *SenderFeeTierUpdate) check() error {
+	if v, ok := sftu.mutation.Name(); ok {
+		if err := senderfeetier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SenderFeeTier.name": %w`, err)}
+		}
+	}
+	if sftu.mutation.SenderOrderTokenCleared() && len(sftu.mutation.SenderOrderTokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SenderFeeTier.sender_order_token"`)
+	}
+	return nil
+}
+
+func (sftu *SenderFeeTierUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sftu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(senderfeetier.Table, senderfeetier.Columns, sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt))
+	if ps := sftu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sftu.mutation.UpdatedAt(); ok {
+		_spec.SetField(senderfeetier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sftu.mutation.Name(); ok {
+		_spec.SetField(senderfeetier.FieldName, field.TypeString, value)
+	}
+	if value, ok := sftu.mutation.MinVolume(); ok {
+		_spec.SetField(senderfeetier.FieldMinVolume, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.AddedMinVolume(); ok {
+		_spec.AddField(senderfeetier.FieldMinVolume, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.FeePercent(); ok {
+		_spec.SetField(senderfeetier.FieldFeePercent, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.AddedFeePercent(); ok {
+		_spec.AddField(senderfeetier.FieldFeePercent, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.MinFee(); ok {
+		_spec.SetField(senderfeetier.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.AddedMinFee(); ok {
+		_spec.AddField(senderfeetier.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.MaxFee(); ok {
+		_spec.SetField(senderfeetier.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.AddedMaxFee(); ok {
+		_spec.AddField(senderfeetier.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftu.mutation.StartsAt(); ok {
+		_spec.SetField(senderfeetier.FieldStartsAt, field.TypeTime, value)
+	}
+	if sftu.mutation.StartsAtCleared() {
+		_spec.ClearField(senderfeetier.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := sftu.mutation.EndsAt(); ok {
+		_spec.SetField(senderfeetier.FieldEndsAt, field.TypeTime, value)
+	}
+	if sftu.mutation.EndsAtCleared() {
+		_spec.ClearField(senderfeetier.FieldEndsAt, field.TypeTime)
+	}
+	if sftu.mutation.SenderOrderTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   senderfeetier.SenderOrderTokenTable,
+			Columns: []string{senderfeetier.SenderOrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderordertoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sftu.mutation.SenderOrderTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   senderfeetier.SenderOrderTokenTable,
+			Columns: []string{senderfeetier.SenderOrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sftu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{senderfeetier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sftu.mutation.done = true
+	return n, nil
+}
+
+// SenderFeeTierUpdateOne is the builder for updating a single SenderFeeTier entity.
+type SenderFeeTierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SenderFeeTierMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sftuo *SenderFeeTierUpdateOne) SetUpdatedAt(t time.Time) *SenderFeeTierUpdateOne {
+	sftuo.mutation.SetUpdatedAt(t)
+	return sftuo
+}
+
+// SetName sets the "name" field.
+func (sftuo *SenderFeeTierUpdateOne) SetName(s string) *SenderFeeTierUpdateOne {
+	sftuo.mutation.SetName(s)
+	return sftuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableName(s *string) *SenderFeeTierUpdateOne {
+	if s != nil {
+		sftuo.SetName(*s)
+	}
+	return sftuo
+}
+
+// SetMinVolume sets the "min_volume" field.
+func (sftuo *SenderFeeTierUpdateOne) SetMinVolume(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.ResetMinVolume()
+	sftuo.mutation.SetMinVolume(d)
+	return sftuo
+}
+
+// SetNillableMinVolume sets the "min_volume" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableMinVolume(d *decimal.Decimal) *SenderFeeTierUpdateOne {
+	if d != nil {
+		sftuo.SetMinVolume(*d)
+	}
+	return sftuo
+}
+
+// AddMinVolume adds d to the "min_volume" field.
+func (sftuo *SenderFeeTierUpdateOne) AddMinVolume(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.AddMinVolume(d)
+	return sftuo
+}
+
+// SetFeePercent sets the "fee_percent" field.
+func (sftuo *SenderFeeTierUpdateOne) SetFeePercent(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.ResetFeePercent()
+	sftuo.mutation.SetFeePercent(d)
+	return sftuo
+}
+
+// SetNillableFeePercent sets the "fee_percent" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableFeePercent(d *decimal.Decimal) *SenderFeeTierUpdateOne {
+	if d != nil {
+		sftuo.SetFeePercent(*d)
+	}
+	return sftuo
+}
+
+// AddFeePercent adds d to the "fee_percent" field.
+func (sftuo *SenderFeeTierUpdateOne) AddFeePercent(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.AddFeePercent(d)
+	return sftuo
+}
+
+// SetMinFee sets the "min_fee" field.
+func (sftuo *SenderFeeTierUpdateOne) SetMinFee(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.ResetMinFee()
+	sftuo.mutation.SetMinFee(d)
+	return sftuo
+}
+
+// SetNillableMinFee sets the "min_fee" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableMinFee(d *decimal.Decimal) *SenderFeeTierUpdateOne {
+	if d != nil {
+		sftuo.SetMinFee(*d)
+	}
+	return sftuo
+}
+
+// AddMinFee adds d to the "min_fee" field.
+func (sftuo *SenderFeeTierUpdateOne) AddMinFee(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.AddMinFee(d)
+	return sftuo
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (sftuo *SenderFeeTierUpdateOne) SetMaxFee(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.ResetMaxFee()
+	sftuo.mutation.SetMaxFee(d)
+	return sftuo
+}
+
+// SetNillableMaxFee sets the "max_fee" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableMaxFee(d *decimal.Decimal) *SenderFeeTierUpdateOne {
+	if d != nil {
+		sftuo.SetMaxFee(*d)
+	}
+	return sftuo
+}
+
+// AddMaxFee adds d to the "max_fee" field.
+func (sftuo *SenderFeeTierUpdateOne) AddMaxFee(d decimal.Decimal) *SenderFeeTierUpdateOne {
+	sftuo.mutation.AddMaxFee(d)
+	return sftuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sftuo *SenderFeeTierUpdateOne) SetStartsAt(t time.Time) *SenderFeeTierUpdateOne {
+	sftuo.mutation.SetStartsAt(t)
+	return sftuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableStartsAt(t *time.Time) *SenderFeeTierUpdateOne {
+	if t != nil {
+		sftuo.SetStartsAt(*t)
+	}
+	return sftuo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (sftuo *SenderFeeTierUpdateOne) ClearStartsAt() *SenderFeeTierUpdateOne {
+	sftuo.mutation.ClearStartsAt()
+	return sftuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sftuo *SenderFeeTierUpdateOne) SetEndsAt(t time.Time) *SenderFeeTierUpdateOne {
+	sftuo.mutation.SetEndsAt(t)
+	return sftuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (sftuo *SenderFeeTierUpdateOne) SetNillableEndsAt(t *time.Time) *SenderFeeTierUpdateOne {
+	if t != nil {
+		sftuo.SetEndsAt(*t)
+	}
+	return sftuo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (sftuo *SenderFeeTierUpdateOne) ClearEndsAt() *SenderFeeTierUpdateOne {
+	sftuo.mutation.ClearEndsAt()
+	return sftuo
+}
+
+// SetSenderOrderTokenID sets the "sender_order_token" edge to the SenderOrderToken entity by ID.
+func (sftuo *SenderFeeTierUpdateOne) SetSenderOrderTokenID(id int) *SenderFeeTierUpdateOne {
+	sftuo.mutation.SetSenderOrderTokenID(id)
+	return sftuo
+}
+
+// SetSenderOrderToken sets the "sender_order_token" edge to the SenderOrderToken entity.
+func (sftuo *SenderFeeTierUpdateOne) SetSenderOrderToken(s *SenderOrderToken) *SenderFeeTierUpdateOne {
+	return sftuo.SetSenderOrderTokenID(s.ID)
+}
+
+// Mutation returns the SenderFeeTierMutation object of the builder.
+func (sftuo *SenderFeeTierUpdateOne) Mutation() *SenderFeeTierMutation {
+	return sftuo.mutation
+}
+
+// ClearSenderOrderToken clears the "sender_order_token" edge to the SenderOrderToken entity.
+func (sftuo *SenderFeeTierUpdateOne) ClearSenderOrderToken() *SenderFeeTierUpdateOne {
+	sftuo.mutation.ClearSenderOrderToken()
+	return sftuo
+}
+
+// Where appends a list predicates to the SenderFeeTierUpdate builder.
+func (sftuo *SenderFeeTierUpdateOne) Where(ps ...predicate.SenderFeeTier) *SenderFeeTierUpdateOne {
+	sftuo.mutation.Where(ps...)
+	return sftuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sftuo *SenderFeeTierUpdateOne) Select(field string, fields ...string) *SenderFeeTierUpdateOne {
+	sftuo.fields = append([]string{field}, fields...)
+	return sftuo
+}
+
+// Save executes the query and returns the updated SenderFeeTier entity.
+func (sftuo *SenderFeeTierUpdateOne) Save(ctx context.Context) (*SenderFeeTier, error) {
+	sftuo.defaults()
+	return withHooks(ctx, sftuo.sqlSave, sftuo.mutation, sftuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sftuo *SenderFeeTierUpdateOne) SaveX(ctx context.Context) *SenderFeeTier {
+	node, err := sftuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sftuo *SenderFeeTierUpdateOne) Exec(ctx context.Context) error {
+	_, err := sftuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sftuo *SenderFeeTierUpdateOne) ExecX(ctx context.Context) {
+	if err := sftuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sftuo *SenderFeeTierUpdateOne) defaults() {
+	if _, ok := sftuo.mutation.UpdatedAt(); !ok {
+		v := senderfeetier.UpdateDefaultUpdatedAt()
+		sftuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sftuo *SenderFeeTierUpdateOne) check() error {
+	if v, ok := sftuo.mutation.Name(); ok {
+		if err := senderfeetier.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SenderFeeTier.name": %w`, err)}
+		}
+	}
+	if sftuo.mutation.SenderOrderTokenCleared() && len(sftuo.mutation.SenderOrderTokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SenderFeeTier.sender_order_token"`)
+	}
+	return nil
+}
+
+func (sftuo *SenderFeeTierUpdateOne) sqlSave(ctx context.Context) (_node *SenderFeeTier, err error) {
+	if err := sftuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(senderfeetier.Table, senderfeetier.Columns, sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt))
+	id, ok := sftuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SenderFeeTier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sftuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, senderfeetier.FieldID)
+		for _, f := range fields {
+			if !senderfeetier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != senderfeetier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sftuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sftuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(senderfeetier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sftuo.mutation.Name(); ok {
+		_spec.SetField(senderfeetier.FieldName, field.TypeString, value)
+	}
+	if value, ok := sftuo.mutation.MinVolume(); ok {
+		_spec.SetField(senderfeetier.FieldMinVolume, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.AddedMinVolume(); ok {
+		_spec.AddField(senderfeetier.FieldMinVolume, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.FeePercent(); ok {
+		_spec.SetField(senderfeetier.FieldFeePercent, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.AddedFeePercent(); ok {
+		_spec.AddField(senderfeetier.FieldFeePercent, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.MinFee(); ok {
+		_spec.SetField(senderfeetier.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.AddedMinFee(); ok {
+		_spec.AddField(senderfeetier.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.MaxFee(); ok {
+		_spec.SetField(senderfeetier.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.AddedMaxFee(); ok {
+		_spec.AddField(senderfeetier.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := sftuo.mutation.StartsAt(); ok {
+		_spec.SetField(senderfeetier.FieldStartsAt, field.TypeTime, value)
+	}
+	if sftuo.mutation.StartsAtCleared() {
+		_spec.ClearField(senderfeetier.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := sftuo.mutation.EndsAt(); ok {
+		_spec.SetField(senderfeetier.FieldEndsAt, field.TypeTime, value)
+	}
+	if sftuo.mutation.EndsAtCleared() {
+		_spec.ClearField(senderfeetier.FieldEndsAt, field.TypeTime)
+	}
+	if sftuo.mutation.SenderOrderTokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   senderfeetier.SenderOrderTokenTable,
+			Columns: []string{senderfeetier.SenderOrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderordertoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sftuo.mutation.SenderOrderTokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   senderfeetier.SenderOrderTokenTable,
+			Columns: []string{senderfeetier.SenderOrderTokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SenderFeeTier{config: sftuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sftuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{senderfeetier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sftuo.mutation.done = true
+	return _node, nil
+}
@@ -45,9 +45,11 @@ type SenderOrderTokenEdges struct {
 	Sender *SenderProfile `json:"sender,omitempty"`
 	// Token holds the value of the token edge.
 	Token *Token `json:"token,omitempty"`
+	// FeeTiers holds the value of the fee_tiers edge.
+	FeeTiers []*SenderFeeTier `json:"fee_tiers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // SenderOrErr returns the Sender value or an error if the edge
@@ -72,6 +74,15 @@ func (e SenderOrderTokenEdges) TokenOrErr() (*Token, error) {
 	return nil, &NotLoadedError{edge: "token"}
 }
 
+// FeeTiersOrErr returns the FeeTiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderOrderTokenEdges) FeeTiersOrErr() ([]*SenderFeeTier, error) {
+	if e.loadedTypes[2] {
+		return e.FeeTiers, nil
+	}
+	return nil, &NotLoadedError{edge: "fee_tiers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SenderOrderToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -177,6 +188,11 @@ func (sot *SenderOrderToken) QueryToken() *TokenQuery {
 	return NewSenderOrderTokenClient(sot.config).QueryToken(sot)
 }
 
+// QueryFeeTiers queries the "fee_tiers" edge of the SenderOrderToken entity.
+func (sot *SenderOrderToken) QueryFeeTiers() *SenderFeeTierQuery {
+	return NewSenderOrderTokenClient(sot.config).QueryFeeTiers(sot)
+}
+
 // Update returns a builder for updating this SenderOrderToken.
 // Note that you need to call SenderOrderToken.Unwrap() before calling this method if this SenderOrderToken
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -28,6 +28,8 @@ const (
 	EdgeSender = "sender"
 	// EdgeToken holds the string denoting the token edge name in mutations.
 	EdgeToken = "token"
+	// EdgeFeeTiers holds the string denoting the fee_tiers edge name in mutations.
+	EdgeFeeTiers = "fee_tiers"
 	// Table holds the table name of the senderordertoken in the database.
 	Table = "sender_order_tokens"
 	// SenderTable is the table that holds the sender relation/edge.
@@ -44,6 +46,13 @@ const (
 	TokenInverseTable = "tokens"
 	// TokenColumn is the table column denoting the token relation/edge.
 	TokenColumn = "token_sender_settings"
+	// FeeTiersTable is the table that holds the fee_tiers relation/edge.
+	FeeTiersTable = "sender_fee_tiers"
+	// FeeTiersInverseTable is the table name for the SenderFeeTier entity.
+	// It exists in this package in order to avoid circular dependency with the "senderfeetier" package.
+	FeeTiersInverseTable = "sender_fee_tiers"
+	// FeeTiersColumn is the table column denoting the fee_tiers relation/edge.
+	FeeTiersColumn = "sender_order_token_fee_tiers"
 )
 
 // Columns holds all SQL columns for senderordertoken fields.
@@ -137,6 +146,20 @@ func ByTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTokenStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFeeTiersCount orders the results by fee_tiers count.
+func ByFeeTiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFeeTiersStep(), opts...)
+	}
+}
+
+// ByFeeTiers orders the results by fee_tiers terms.
+func ByFeeTiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeeTiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSenderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -151,3 +174,10 @@ func newTokenStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
 	)
 }
+func newFeeTiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FeeTiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FeeTiersTable, FeeTiersColumn),
+	)
+}
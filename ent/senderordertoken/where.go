@@ -377,6 +377,29 @@ func HasTokenWith(preds ...predicate.Token) predicate.SenderOrderToken {
 	})
 }
 
+// HasFeeTiers applies the HasEdge predicate on the "fee_tiers" edge.
+func HasFeeTiers() predicate.SenderOrderToken {
+	return predicate.SenderOrderToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeeTiersTable, FeeTiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeeTiersWith applies the HasEdge predicate on the "fee_tiers" edge with a given conditions (other predicates).
+func HasFeeTiersWith(preds ...predicate.SenderFeeTier) predicate.SenderOrderToken {
+	return predicate.SenderOrderToken(func(s *sql.Selector) {
+		step := newFeeTiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SenderOrderToken) predicate.SenderOrderToken {
 	return predicate.SenderOrderToken(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -94,6 +95,21 @@ func (sotc *SenderOrderTokenCreate) SetToken(t *Token) *SenderOrderTokenCreate {
 	return sotc.SetTokenID(t.ID)
 }
 
+// AddFeeTierIDs adds the "fee_tiers" edge to the SenderFeeTier entity by IDs.
+func (sotc *SenderOrderTokenCreate) AddFeeTierIDs(ids ...int) *SenderOrderTokenCreate {
+	sotc.mutation.AddFeeTierIDs(ids...)
+	return sotc
+}
+
+// AddFeeTiers adds the "fee_tiers" edges to the SenderFeeTier entity.
+func (sotc *SenderOrderTokenCreate) AddFeeTiers(s ...*SenderFeeTier) *SenderOrderTokenCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sotc.AddFeeTierIDs(ids...)
+}
+
 // Mutation returns the SenderOrderTokenMutation object of the builder.
 func (sotc *SenderOrderTokenCreate) Mutation() *SenderOrderTokenMutation {
 	return sotc.mutation
@@ -253,6 +269,22 @@ func (sotc *SenderOrderTokenCreate) createSpec() (*SenderOrderToken, *sqlgraph.C
 		_node.token_sender_settings = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sotc.mutation.FeeTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -21,13 +23,14 @@ import (
 // SenderOrderTokenQuery is the builder for querying SenderOrderToken entities.
 type SenderOrderTokenQuery struct {
 	config
-	ctx        *QueryContext
-	order      []senderordertoken.OrderOption
-	inters     []Interceptor
-	predicates []predicate.SenderOrderToken
-	withSender *SenderProfileQuery
-	withToken  *TokenQuery
-	withFKs    bool
+	ctx          *QueryContext
+	order        []senderordertoken.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.SenderOrderToken
+	withSender   *SenderProfileQuery
+	withToken    *TokenQuery
+	withFeeTiers *SenderFeeTierQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +111,28 @@ func (sotq *SenderOrderTokenQuery) QueryToken() *TokenQuery {
 	return query
 }
 
+// QueryFeeTiers chains the current query on the "fee_tiers" edge.
+func (sotq *SenderOrderTokenQuery) QueryFeeTiers() *SenderFeeTierQuery {
+	query := (&SenderFeeTierClient{config: sotq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sotq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sotq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, selector),
+			sqlgraph.To(senderfeetier.Table, senderfeetier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderordertoken.FeeTiersTable, senderordertoken.FeeTiersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sotq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SenderOrderToken entity from the query.
 // Returns a *NotFoundError when no SenderOrderToken was found.
 func (sotq *SenderOrderTokenQuery) First(ctx context.Context) (*SenderOrderToken, error) {
@@ -295,13 +320,14 @@ func (sotq *SenderOrderTokenQuery) Clone() *SenderOrderTokenQuery {
 		return nil
 	}
 	return &SenderOrderTokenQuery{
-		config:     sotq.config,
-		ctx:        sotq.ctx.Clone(),
-		order:      append([]senderordertoken.OrderOption{}, sotq.order...),
-		inters:     append([]Interceptor{}, sotq.inters...),
-		predicates: append([]predicate.SenderOrderToken{}, sotq.predicates...),
-		withSender: sotq.withSender.Clone(),
-		withToken:  sotq.withToken.Clone(),
+		config:       sotq.config,
+		ctx:          sotq.ctx.Clone(),
+		order:        append([]senderordertoken.OrderOption{}, sotq.order...),
+		inters:       append([]Interceptor{}, sotq.inters...),
+		predicates:   append([]predicate.SenderOrderToken{}, sotq.predicates...),
+		withSender:   sotq.withSender.Clone(),
+		withToken:    sotq.withToken.Clone(),
+		withFeeTiers: sotq.withFeeTiers.Clone(),
 		// clone intermediate query.
 		sql:  sotq.sql.Clone(),
 		path: sotq.path,
@@ -330,6 +356,17 @@ func (sotq *SenderOrderTokenQuery) WithToken(opts ...func(*TokenQuery)) *SenderO
 	return sotq
 }
 
+// WithFeeTiers tells the query-builder to eager-load the nodes that are connected to
+// the "fee_tiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (sotq *SenderOrderTokenQuery) WithFeeTiers(opts ...func(*SenderFeeTierQuery)) *SenderOrderTokenQuery {
+	query := (&SenderFeeTierClient{config: sotq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sotq.withFeeTiers = query
+	return sotq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +446,10 @@ func (sotq *SenderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		nodes       = []*SenderOrderToken{}
 		withFKs     = sotq.withFKs
 		_spec       = sotq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			sotq.withSender != nil,
 			sotq.withToken != nil,
+			sotq.withFeeTiers != nil,
 		}
 	)
 	if sotq.withSender != nil || sotq.withToken != nil {
@@ -450,6 +488,13 @@ func (sotq *SenderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := sotq.withFeeTiers; query != nil {
+		if err := sotq.loadFeeTiers(ctx, query, nodes,
+			func(n *SenderOrderToken) { n.Edges.FeeTiers = []*SenderFeeTier{} },
+			func(n *SenderOrderToken, e *SenderFeeTier) { n.Edges.FeeTiers = append(n.Edges.FeeTiers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +562,37 @@ func (sotq *SenderOrderTokenQuery) loadToken(ctx context.Context, query *TokenQu
 	}
 	return nil
 }
+func (sotq *SenderOrderTokenQuery) loadFeeTiers(ctx context.Context, query *SenderFeeTierQuery, nodes []*SenderOrderToken, init func(*SenderOrderToken), assign func(*SenderOrderToken, *SenderFeeTier)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SenderOrderToken)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SenderFeeTier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderordertoken.FeeTiersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_order_token_fee_tiers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_order_token_fee_tiers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_order_token_fee_tiers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sotq *SenderOrderTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sotq.querySpec()
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/senderfeetier"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -109,6 +110,21 @@ func (sotu *SenderOrderTokenUpdate) SetToken(t *Token) *SenderOrderTokenUpdate {
 	return sotu.SetTokenID(t.ID)
 }
 
+// AddFeeTierIDs adds the "fee_tiers" edge to the SenderFeeTier entity by IDs.
+func (sotu *SenderOrderTokenUpdate) AddFeeTierIDs(ids ...int) *SenderOrderTokenUpdate {
+	sotu.mutation.AddFeeTierIDs(ids...)
+	return sotu
+}
+
+// AddFeeTiers adds the "fee_tiers" edges to the SenderFeeTier entity.
+func (sotu *SenderOrderTokenUpdate) AddFeeTiers(s ...*SenderFeeTier) *SenderOrderTokenUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sotu.AddFeeTierIDs(ids...)
+}
+
 // Mutation returns the SenderOrderTokenMutation object of the builder.
 func (sotu *SenderOrderTokenUpdate) Mutation() *SenderOrderTokenMutation {
 	return sotu.mutation
@@ -126,6 +142,27 @@ func (sotu *SenderOrderTokenUpdate) ClearToken() *SenderOrderTokenUpdate {
 	return sotu
 }
 
+// ClearFeeTiers clears all "fee_tiers" edges to the SenderFeeTier entity.
+func (sotu *SenderOrderTokenUpdate) ClearFeeTiers() *SenderOrderTokenUpdate {
+	sotu.mutation.ClearFeeTiers()
+	return sotu
+}
+
+// RemoveFeeTierIDs removes the "fee_tiers" edge to SenderFeeTier entities by IDs.
+func (sotu *SenderOrderTokenUpdate) RemoveFeeTierIDs(ids ...int) *SenderOrderTokenUpdate {
+	sotu.mutation.RemoveFeeTierIDs(ids...)
+	return sotu
+}
+
+// RemoveFeeTiers removes "fee_tiers" edges to SenderFeeTier entities.
+func (sotu *SenderOrderTokenUpdate) RemoveFeeTiers(s ...*SenderFeeTier) *SenderOrderTokenUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sotu.RemoveFeeTierIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (sotu *SenderOrderTokenUpdate) Save(ctx context.Context) (int, error) {
 	sotu.defaults()
@@ -268,6 +305,51 @@ func (sotu *SenderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if sotu.mutation.FeeTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sotu.mutation.RemovedFeeTiersIDs(); len(nodes) > 0 && !sotu.mutation.FeeTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sotu.mutation.FeeTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, sotu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{senderordertoken.Label}
@@ -365,6 +447,21 @@ func (sotuo *SenderOrderTokenUpdateOne) SetToken(t *Token) *SenderOrderTokenUpda
 	return sotuo.SetTokenID(t.ID)
 }
 
+// AddFeeTierIDs adds the "fee_tiers" edge to the SenderFeeTier entity by IDs.
+func (sotuo *SenderOrderTokenUpdateOne) AddFeeTierIDs(ids ...int) *SenderOrderTokenUpdateOne {
+	sotuo.mutation.AddFeeTierIDs(ids...)
+	return sotuo
+}
+
+// AddFeeTiers adds the "fee_tiers" edges to the SenderFeeTier entity.
+func (sotuo *SenderOrderTokenUpdateOne) AddFeeTiers(s ...*SenderFeeTier) *SenderOrderTokenUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sotuo.AddFeeTierIDs(ids...)
+}
+
 // Mutation returns the SenderOrderTokenMutation object of the builder.
 func (sotuo *SenderOrderTokenUpdateOne) Mutation() *SenderOrderTokenMutation {
 	return sotuo.mutation
@@ -382,6 +479,27 @@ func (sotuo *SenderOrderTokenUpdateOne) ClearToken() *SenderOrderTokenUpdateOne
 	return sotuo
 }
 
+// ClearFeeTiers clears all "fee_tiers" edges to the SenderFeeTier entity.
+func (sotuo *SenderOrderTokenUpdateOne) ClearFeeTiers() *SenderOrderTokenUpdateOne {
+	sotuo.mutation.ClearFeeTiers()
+	return sotuo
+}
+
+// RemoveFeeTierIDs removes the "fee_tiers" edge to SenderFeeTier entities by IDs.
+func (sotuo *SenderOrderTokenUpdateOne) RemoveFeeTierIDs(ids ...int) *SenderOrderTokenUpdateOne {
+	sotuo.mutation.RemoveFeeTierIDs(ids...)
+	return sotuo
+}
+
+// RemoveFeeTiers removes "fee_tiers" edges to SenderFeeTier entities.
+func (sotuo *SenderOrderTokenUpdateOne) RemoveFeeTiers(s ...*SenderFeeTier) *SenderOrderTokenUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sotuo.RemoveFeeTierIDs(ids...)
+}
+
 // Where appends a list predicates to the SenderOrderTokenUpdate builder.
 func (sotuo *SenderOrderTokenUpdateOne) Where(ps ...predicate.SenderOrderToken) *SenderOrderTokenUpdateOne {
 	sotuo.mutation.Where(ps...)
@@ -554,6 +672,51 @@ func (sotuo *SenderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *Sen
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if sotuo.mutation.FeeTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sotuo.mutation.RemovedFeeTiersIDs(); len(nodes) > 0 && !sotuo.mutation.FeeTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sotuo.mutation.FeeTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderordertoken.FeeTiersTable,
+			Columns: []string{senderordertoken.FeeTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderfeetier.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SenderOrderToken{config: sotuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
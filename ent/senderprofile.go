@@ -11,7 +11,6 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
-	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/ent/user"
 )
@@ -31,6 +30,10 @@ type SenderProfile struct {
 	IsPartner bool `json:"is_partner,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// UnderpaymentPolicy holds the value of the "underpayment_policy" field.
+	UnderpaymentPolicy senderprofile.UnderpaymentPolicy `json:"underpayment_policy,omitempty"`
+	// OverpaymentPolicy holds the value of the "overpayment_policy" field.
+	OverpaymentPolicy senderprofile.OverpaymentPolicy `json:"overpayment_policy,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -44,17 +47,25 @@ type SenderProfile struct {
 type SenderProfileEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
-	// APIKey holds the value of the api_key edge.
-	APIKey *APIKey `json:"api_key,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
 	// PaymentOrders holds the value of the payment_orders edge.
 	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
 	// OrderTokens holds the value of the order_tokens edge.
 	OrderTokens []*SenderOrderToken `json:"order_tokens,omitempty"`
 	// LinkedAddress holds the value of the linked_address edge.
 	LinkedAddress []*LinkedAddress `json:"linked_address,omitempty"`
+	// PaymentOrderBatches holds the value of the payment_order_batches edge.
+	PaymentOrderBatches []*PaymentOrderBatch `json:"payment_order_batches,omitempty"`
+	// TeamMembers holds the value of the team_members edge.
+	TeamMembers []*TeamMember `json:"team_members,omitempty"`
+	// Beneficiaries holds the value of the beneficiaries edge.
+	Beneficiaries []*Beneficiary `json:"beneficiaries,omitempty"`
+	// ScheduledOrders holds the value of the scheduled_orders edge.
+	ScheduledOrders []*ScheduledOrder `json:"scheduled_orders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -68,15 +79,13 @@ func (e SenderProfileEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
-// APIKeyOrErr returns the APIKey value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e SenderProfileEdges) APIKeyOrErr() (*APIKey, error) {
-	if e.APIKey != nil {
-		return e.APIKey, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: apikey.Label}
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
+		return e.APIKeys, nil
 	}
-	return nil, &NotLoadedError{edge: "api_key"}
+	return nil, &NotLoadedError{edge: "api_keys"}
 }
 
 // PaymentOrdersOrErr returns the PaymentOrders value or an error if the edge
@@ -106,6 +115,42 @@ func (e SenderProfileEdges) LinkedAddressOrErr() ([]*LinkedAddress, error) {
 	return nil, &NotLoadedError{edge: "linked_address"}
 }
 
+// PaymentOrderBatchesOrErr returns the PaymentOrderBatches value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) PaymentOrderBatchesOrErr() ([]*PaymentOrderBatch, error) {
+	if e.loadedTypes[5] {
+		return e.PaymentOrderBatches, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_order_batches"}
+}
+
+// TeamMembersOrErr returns the TeamMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) TeamMembersOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[6] {
+		return e.TeamMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "team_members"}
+}
+
+// BeneficiariesOrErr returns the Beneficiaries value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) BeneficiariesOrErr() ([]*Beneficiary, error) {
+	if e.loadedTypes[7] {
+		return e.Beneficiaries, nil
+	}
+	return nil, &NotLoadedError{edge: "beneficiaries"}
+}
+
+// ScheduledOrdersOrErr returns the ScheduledOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) ScheduledOrdersOrErr() ([]*ScheduledOrder, error) {
+	if e.loadedTypes[8] {
+		return e.ScheduledOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_orders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SenderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -115,7 +160,7 @@ func (*SenderProfile) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case senderprofile.FieldIsPartner, senderprofile.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case senderprofile.FieldWebhookURL, senderprofile.FieldProviderID:
+		case senderprofile.FieldWebhookURL, senderprofile.FieldProviderID, senderprofile.FieldUnderpaymentPolicy, senderprofile.FieldOverpaymentPolicy:
 			values[i] = new(sql.NullString)
 		case senderprofile.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -176,6 +221,18 @@ func (sp *SenderProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sp.IsActive = value.Bool
 			}
+		case senderprofile.FieldUnderpaymentPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field underpayment_policy", values[i])
+			} else if value.Valid {
+				sp.UnderpaymentPolicy = senderprofile.UnderpaymentPolicy(value.String)
+			}
+		case senderprofile.FieldOverpaymentPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field overpayment_policy", values[i])
+			} else if value.Valid {
+				sp.OverpaymentPolicy = senderprofile.OverpaymentPolicy(value.String)
+			}
 		case senderprofile.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -207,9 +264,9 @@ func (sp *SenderProfile) QueryUser() *UserQuery {
 	return NewSenderProfileClient(sp.config).QueryUser(sp)
 }
 
-// QueryAPIKey queries the "api_key" edge of the SenderProfile entity.
-func (sp *SenderProfile) QueryAPIKey() *APIKeyQuery {
-	return NewSenderProfileClient(sp.config).QueryAPIKey(sp)
+// QueryAPIKeys queries the "api_keys" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryAPIKeys() *APIKeyQuery {
+	return NewSenderProfileClient(sp.config).QueryAPIKeys(sp)
 }
 
 // QueryPaymentOrders queries the "payment_orders" edge of the SenderProfile entity.
@@ -227,6 +284,26 @@ func (sp *SenderProfile) QueryLinkedAddress() *LinkedAddressQuery {
 	return NewSenderProfileClient(sp.config).QueryLinkedAddress(sp)
 }
 
+// QueryPaymentOrderBatches queries the "payment_order_batches" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryPaymentOrderBatches() *PaymentOrderBatchQuery {
+	return NewSenderProfileClient(sp.config).QueryPaymentOrderBatches(sp)
+}
+
+// QueryTeamMembers queries the "team_members" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryTeamMembers() *TeamMemberQuery {
+	return NewSenderProfileClient(sp.config).QueryTeamMembers(sp)
+}
+
+// QueryBeneficiaries queries the "beneficiaries" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryBeneficiaries() *BeneficiaryQuery {
+	return NewSenderProfileClient(sp.config).QueryBeneficiaries(sp)
+}
+
+// QueryScheduledOrders queries the "scheduled_orders" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryScheduledOrders() *ScheduledOrderQuery {
+	return NewSenderProfileClient(sp.config).QueryScheduledOrders(sp)
+}
+
 // Update returns a builder for updating this SenderProfile.
 // Note that you need to call SenderProfile.Unwrap() before calling this method if this SenderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -265,6 +342,12 @@ func (sp *SenderProfile) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", sp.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("underpayment_policy=")
+	builder.WriteString(fmt.Sprintf("%v", sp.UnderpaymentPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("overpayment_policy=")
+	builder.WriteString(fmt.Sprintf("%v", sp.OverpaymentPolicy))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(sp.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -3,6 +3,7 @@
 package senderprofile
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,18 +26,30 @@ const (
 	FieldIsPartner = "is_partner"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldUnderpaymentPolicy holds the string denoting the underpayment_policy field in the database.
+	FieldUnderpaymentPolicy = "underpayment_policy"
+	// FieldOverpaymentPolicy holds the string denoting the overpayment_policy field in the database.
+	FieldOverpaymentPolicy = "overpayment_policy"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
-	// EdgeAPIKey holds the string denoting the api_key edge name in mutations.
-	EdgeAPIKey = "api_key"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
 	// EdgePaymentOrders holds the string denoting the payment_orders edge name in mutations.
 	EdgePaymentOrders = "payment_orders"
 	// EdgeOrderTokens holds the string denoting the order_tokens edge name in mutations.
 	EdgeOrderTokens = "order_tokens"
 	// EdgeLinkedAddress holds the string denoting the linked_address edge name in mutations.
 	EdgeLinkedAddress = "linked_address"
+	// EdgePaymentOrderBatches holds the string denoting the payment_order_batches edge name in mutations.
+	EdgePaymentOrderBatches = "payment_order_batches"
+	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
+	EdgeTeamMembers = "team_members"
+	// EdgeBeneficiaries holds the string denoting the beneficiaries edge name in mutations.
+	EdgeBeneficiaries = "beneficiaries"
+	// EdgeScheduledOrders holds the string denoting the scheduled_orders edge name in mutations.
+	EdgeScheduledOrders = "scheduled_orders"
 	// Table holds the table name of the senderprofile in the database.
 	Table = "sender_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -46,13 +59,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_sender_profile"
-	// APIKeyTable is the table that holds the api_key relation/edge.
-	APIKeyTable = "api_keys"
-	// APIKeyInverseTable is the table name for the APIKey entity.
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the APIKey entity.
 	// It exists in this package in order to avoid circular dependency with the "apikey" package.
-	APIKeyInverseTable = "api_keys"
-	// APIKeyColumn is the table column denoting the api_key relation/edge.
-	APIKeyColumn = "sender_profile_api_key"
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "sender_profile_api_key"
 	// PaymentOrdersTable is the table that holds the payment_orders relation/edge.
 	PaymentOrdersTable = "payment_orders"
 	// PaymentOrdersInverseTable is the table name for the PaymentOrder entity.
@@ -74,6 +87,34 @@ const (
 	LinkedAddressInverseTable = "linked_addresses"
 	// LinkedAddressColumn is the table column denoting the linked_address relation/edge.
 	LinkedAddressColumn = "sender_profile_linked_address"
+	// PaymentOrderBatchesTable is the table that holds the payment_order_batches relation/edge.
+	PaymentOrderBatchesTable = "payment_order_batches"
+	// PaymentOrderBatchesInverseTable is the table name for the PaymentOrderBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorderbatch" package.
+	PaymentOrderBatchesInverseTable = "payment_order_batches"
+	// PaymentOrderBatchesColumn is the table column denoting the payment_order_batches relation/edge.
+	PaymentOrderBatchesColumn = "sender_profile_payment_order_batches"
+	// TeamMembersTable is the table that holds the team_members relation/edge.
+	TeamMembersTable = "team_members"
+	// TeamMembersInverseTable is the table name for the TeamMember entity.
+	// It exists in this package in order to avoid circular dependency with the "teammember" package.
+	TeamMembersInverseTable = "team_members"
+	// TeamMembersColumn is the table column denoting the team_members relation/edge.
+	TeamMembersColumn = "sender_profile_team_members"
+	// BeneficiariesTable is the table that holds the beneficiaries relation/edge.
+	BeneficiariesTable = "beneficiaries"
+	// BeneficiariesInverseTable is the table name for the Beneficiary entity.
+	// It exists in this package in order to avoid circular dependency with the "beneficiary" package.
+	BeneficiariesInverseTable = "beneficiaries"
+	// BeneficiariesColumn is the table column denoting the beneficiaries relation/edge.
+	BeneficiariesColumn = "sender_profile_beneficiaries"
+	// ScheduledOrdersTable is the table that holds the scheduled_orders relation/edge.
+	ScheduledOrdersTable = "scheduled_orders"
+	// ScheduledOrdersInverseTable is the table name for the ScheduledOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledorder" package.
+	ScheduledOrdersInverseTable = "scheduled_orders"
+	// ScheduledOrdersColumn is the table column denoting the scheduled_orders relation/edge.
+	ScheduledOrdersColumn = "sender_profile_scheduled_orders"
 )
 
 // Columns holds all SQL columns for senderprofile fields.
@@ -84,6 +125,8 @@ var Columns = []string{
 	FieldProviderID,
 	FieldIsPartner,
 	FieldIsActive,
+	FieldUnderpaymentPolicy,
+	FieldOverpaymentPolicy,
 	FieldUpdatedAt,
 }
 
@@ -123,6 +166,58 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// UnderpaymentPolicy defines the type for the "underpayment_policy" enum field.
+type UnderpaymentPolicy string
+
+// UnderpaymentPolicySettle is the default value of the UnderpaymentPolicy enum.
+const DefaultUnderpaymentPolicy = UnderpaymentPolicySettle
+
+// UnderpaymentPolicy values.
+const (
+	UnderpaymentPolicySettle UnderpaymentPolicy = "settle"
+	UnderpaymentPolicyTopUp  UnderpaymentPolicy = "top_up"
+)
+
+func (up UnderpaymentPolicy) String() string {
+	return string(up)
+}
+
+// UnderpaymentPolicyValidator is a validator for the "underpayment_policy" field enum values. It is called by the builders before save.
+func UnderpaymentPolicyValidator(up UnderpaymentPolicy) error {
+	switch up {
+	case UnderpaymentPolicySettle, UnderpaymentPolicyTopUp:
+		return nil
+	default:
+		return fmt.Errorf("senderprofile: invalid enum value for underpayment_policy field: %q", up)
+	}
+}
+
+// OverpaymentPolicy defines the type for the "overpayment_policy" enum field.
+type OverpaymentPolicy string
+
+// OverpaymentPolicySettle is the default value of the OverpaymentPolicy enum.
+const DefaultOverpaymentPolicy = OverpaymentPolicySettle
+
+// OverpaymentPolicy values.
+const (
+	OverpaymentPolicySettle       OverpaymentPolicy = "settle"
+	OverpaymentPolicyRefundExcess OverpaymentPolicy = "refund_excess"
+)
+
+func (op OverpaymentPolicy) String() string {
+	return string(op)
+}
+
+// OverpaymentPolicyValidator is a validator for the "overpayment_policy" field enum values. It is called by the builders before save.
+func OverpaymentPolicyValidator(op OverpaymentPolicy) error {
+	switch op {
+	case OverpaymentPolicySettle, OverpaymentPolicyRefundExcess:
+		return nil
+	default:
+		return fmt.Errorf("senderprofile: invalid enum value for overpayment_policy field: %q", op)
+	}
+}
+
 // OrderOption defines the ordering options for the SenderProfile queries.
 type OrderOption func(*sql.Selector)
 
@@ -151,6 +246,16 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByUnderpaymentPolicy orders the results by the underpayment_policy field.
+func ByUnderpaymentPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnderpaymentPolicy, opts...).ToFunc()
+}
+
+// ByOverpaymentPolicy orders the results by the overpayment_policy field.
+func ByOverpaymentPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverpaymentPolicy, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -163,10 +268,17 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByAPIKeyField orders the results by api_key field.
-func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -211,6 +323,62 @@ func ByLinkedAddress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLinkedAddressStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPaymentOrderBatchesCount orders the results by payment_order_batches count.
+func ByPaymentOrderBatchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentOrderBatchesStep(), opts...)
+	}
+}
+
+// ByPaymentOrderBatches orders the results by payment_order_batches terms.
+func ByPaymentOrderBatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderBatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamMembersCount orders the results by team_members count.
+func ByTeamMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamMembersStep(), opts...)
+	}
+}
+
+// ByTeamMembers orders the results by team_members terms.
+func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBeneficiariesCount orders the results by beneficiaries count.
+func ByBeneficiariesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBeneficiariesStep(), opts...)
+	}
+}
+
+// ByBeneficiaries orders the results by beneficiaries terms.
+func ByBeneficiaries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBeneficiariesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledOrdersCount orders the results by scheduled_orders count.
+func ByScheduledOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledOrdersStep(), opts...)
+	}
+}
+
+// ByScheduledOrders orders the results by scheduled_orders terms.
+func ByScheduledOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -218,11 +386,11 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
 	)
 }
-func newAPIKeyStep() *sqlgraph.Step {
+func newAPIKeysStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(APIKeyInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
 	)
 }
 func newPaymentOrdersStep() *sqlgraph.Step {
@@ -246,3 +414,31 @@ func newLinkedAddressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LinkedAddressTable, LinkedAddressColumn),
 	)
 }
+func newPaymentOrderBatchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderBatchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrderBatchesTable, PaymentOrderBatchesColumn),
+	)
+}
+func newTeamMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamMembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+	)
+}
+func newBeneficiariesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BeneficiariesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BeneficiariesTable, BeneficiariesColumn),
+	)
+}
+func newScheduledOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledOrdersTable, ScheduledOrdersColumn),
+	)
+}
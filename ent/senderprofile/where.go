@@ -251,6 +251,46 @@ func IsActiveNEQ(v bool) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// UnderpaymentPolicyEQ applies the EQ predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyEQ(v UnderpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldUnderpaymentPolicy, v))
+}
+
+// UnderpaymentPolicyNEQ applies the NEQ predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyNEQ(v UnderpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldUnderpaymentPolicy, v))
+}
+
+// UnderpaymentPolicyIn applies the In predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyIn(vs ...UnderpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldUnderpaymentPolicy, vs...))
+}
+
+// UnderpaymentPolicyNotIn applies the NotIn predicate on the "underpayment_policy" field.
+func UnderpaymentPolicyNotIn(vs ...UnderpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldUnderpaymentPolicy, vs...))
+}
+
+// OverpaymentPolicyEQ applies the EQ predicate on the "overpayment_policy" field.
+func OverpaymentPolicyEQ(v OverpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldOverpaymentPolicy, v))
+}
+
+// OverpaymentPolicyNEQ applies the NEQ predicate on the "overpayment_policy" field.
+func OverpaymentPolicyNEQ(v OverpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldOverpaymentPolicy, v))
+}
+
+// OverpaymentPolicyIn applies the In predicate on the "overpayment_policy" field.
+func OverpaymentPolicyIn(vs ...OverpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldOverpaymentPolicy, vs...))
+}
+
+// OverpaymentPolicyNotIn applies the NotIn predicate on the "overpayment_policy" field.
+func OverpaymentPolicyNotIn(vs ...OverpaymentPolicy) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldOverpaymentPolicy, vs...))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldUpdatedAt, v))
@@ -314,21 +354,21 @@ func HasUserWith(preds ...predicate.User) predicate.SenderProfile {
 	})
 }
 
-// HasAPIKey applies the HasEdge predicate on the "api_key" edge.
-func HasAPIKey() predicate.SenderProfile {
+// HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
+func HasAPIKeys() predicate.SenderProfile {
 	return predicate.SenderProfile(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasAPIKeyWith applies the HasEdge predicate on the "api_key" edge with a given conditions (other predicates).
-func HasAPIKeyWith(preds ...predicate.APIKey) predicate.SenderProfile {
+// HasAPIKeysWith applies the HasEdge predicate on the "api_keys" edge with a given conditions (other predicates).
+func HasAPIKeysWith(preds ...predicate.APIKey) predicate.SenderProfile {
 	return predicate.SenderProfile(func(s *sql.Selector) {
-		step := newAPIKeyStep()
+		step := newAPIKeysStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -406,6 +446,98 @@ func HasLinkedAddressWith(preds ...predicate.LinkedAddress) predicate.SenderProf
 	})
 }
 
+// HasPaymentOrderBatches applies the HasEdge predicate on the "payment_order_batches" edge.
+func HasPaymentOrderBatches() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrderBatchesTable, PaymentOrderBatchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderBatchesWith applies the HasEdge predicate on the "payment_order_batches" edge with a given conditions (other predicates).
+func HasPaymentOrderBatchesWith(preds ...predicate.PaymentOrderBatch) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newPaymentOrderBatchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeamMembers applies the HasEdge predicate on the "team_members" edge.
+func HasTeamMembers() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamMembersWith applies the HasEdge predicate on the "team_members" edge with a given conditions (other predicates).
+func HasTeamMembersWith(preds ...predicate.TeamMember) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newTeamMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBeneficiaries applies the HasEdge predicate on the "beneficiaries" edge.
+func HasBeneficiaries() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BeneficiariesTable, BeneficiariesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBeneficiariesWith applies the HasEdge predicate on the "beneficiaries" edge with a given conditions (other predicates).
+func HasBeneficiariesWith(preds ...predicate.Beneficiary) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newBeneficiariesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledOrders applies the HasEdge predicate on the "scheduled_orders" edge.
+func HasScheduledOrders() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledOrdersTable, ScheduledOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledOrdersWith applies the HasEdge predicate on the "scheduled_orders" edge with a given conditions (other predicates).
+func HasScheduledOrdersWith(preds ...predicate.ScheduledOrder) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newScheduledOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SenderProfile) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.AndPredicates(predicates...))
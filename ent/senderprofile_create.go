@@ -14,10 +14,14 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -91,6 +95,34 @@ func (spc *SenderProfileCreate) SetNillableIsActive(b *bool) *SenderProfileCreat
 	return spc
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (spc *SenderProfileCreate) SetUnderpaymentPolicy(sp senderprofile.UnderpaymentPolicy) *SenderProfileCreate {
+	spc.mutation.SetUnderpaymentPolicy(sp)
+	return spc
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableUnderpaymentPolicy(sp *senderprofile.UnderpaymentPolicy) *SenderProfileCreate {
+	if sp != nil {
+		spc.SetUnderpaymentPolicy(*sp)
+	}
+	return spc
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (spc *SenderProfileCreate) SetOverpaymentPolicy(sp senderprofile.OverpaymentPolicy) *SenderProfileCreate {
+	spc.mutation.SetOverpaymentPolicy(sp)
+	return spc
+}
+
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableOverpaymentPolicy(sp *senderprofile.OverpaymentPolicy) *SenderProfileCreate {
+	if sp != nil {
+		spc.SetOverpaymentPolicy(*sp)
+	}
+	return spc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (spc *SenderProfileCreate) SetUpdatedAt(t time.Time) *SenderProfileCreate {
 	spc.mutation.SetUpdatedAt(t)
@@ -130,23 +162,19 @@ func (spc *SenderProfileCreate) SetUser(u *User) *SenderProfileCreate {
 	return spc.SetUserID(u.ID)
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spc *SenderProfileCreate) SetAPIKeyID(id uuid.UUID) *SenderProfileCreate {
-	spc.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (spc *SenderProfileCreate) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddAPIKeyIDs(ids...)
 	return spc
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spc *SenderProfileCreate) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileCreate {
-	if id != nil {
-		spc = spc.SetAPIKeyID(*id)
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (spc *SenderProfileCreate) AddAPIKeys(a ...*APIKey) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return spc
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spc *SenderProfileCreate) SetAPIKey(a *APIKey) *SenderProfileCreate {
-	return spc.SetAPIKeyID(a.ID)
+	return spc.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -194,6 +222,66 @@ func (spc *SenderProfileCreate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spc.AddLinkedAddresIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (spc *SenderProfileCreate) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddPaymentOrderBatchIDs(ids...)
+	return spc
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (spc *SenderProfileCreate) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return spc.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spc *SenderProfileCreate) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddTeamMemberIDs(ids...)
+	return spc
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spc *SenderProfileCreate) AddTeamMembers(t ...*TeamMember) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spc.AddTeamMemberIDs(ids...)
+}
+
+// AddBeneficiaryIDs adds the "beneficiaries" edge to the Beneficiary entity by IDs.
+func (spc *SenderProfileCreate) AddBeneficiaryIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddBeneficiaryIDs(ids...)
+	return spc
+}
+
+// AddBeneficiaries adds the "beneficiaries" edges to the Beneficiary entity.
+func (spc *SenderProfileCreate) AddBeneficiaries(b ...*Beneficiary) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spc.AddBeneficiaryIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (spc *SenderProfileCreate) AddScheduledOrderIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddScheduledOrderIDs(ids...)
+	return spc
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (spc *SenderProfileCreate) AddScheduledOrders(s ...*ScheduledOrder) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return spc.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spc *SenderProfileCreate) Mutation() *SenderProfileMutation {
 	return spc.mutation
@@ -241,6 +329,14 @@ func (spc *SenderProfileCreate) defaults() {
 		v := senderprofile.DefaultIsActive
 		spc.mutation.SetIsActive(v)
 	}
+	if _, ok := spc.mutation.UnderpaymentPolicy(); !ok {
+		v := senderprofile.DefaultUnderpaymentPolicy
+		spc.mutation.SetUnderpaymentPolicy(v)
+	}
+	if _, ok := spc.mutation.OverpaymentPolicy(); !ok {
+		v := senderprofile.DefaultOverpaymentPolicy
+		spc.mutation.SetOverpaymentPolicy(v)
+	}
 	if _, ok := spc.mutation.UpdatedAt(); !ok {
 		v := senderprofile.DefaultUpdatedAt()
 		spc.mutation.SetUpdatedAt(v)
@@ -262,6 +358,22 @@ func (spc *SenderProfileCreate) check() error {
 	if _, ok := spc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SenderProfile.is_active"`)}
 	}
+	if _, ok := spc.mutation.UnderpaymentPolicy(); !ok {
+		return &ValidationError{Name: "underpayment_policy", err: errors.New(`ent: missing required field "SenderProfile.underpayment_policy"`)}
+	}
+	if v, ok := spc.mutation.UnderpaymentPolicy(); ok {
+		if err := senderprofile.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.underpayment_policy": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.OverpaymentPolicy(); !ok {
+		return &ValidationError{Name: "overpayment_policy", err: errors.New(`ent: missing required field "SenderProfile.overpayment_policy"`)}
+	}
+	if v, ok := spc.mutation.OverpaymentPolicy(); ok {
+		if err := senderprofile.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_policy": %w`, err)}
+		}
+	}
 	if _, ok := spc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SenderProfile.updated_at"`)}
 	}
@@ -324,6 +436,14 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := spc.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldUnderpaymentPolicy, field.TypeEnum, value)
+		_node.UnderpaymentPolicy = value
+	}
+	if value, ok := spc.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentPolicy, field.TypeEnum, value)
+		_node.OverpaymentPolicy = value
+	}
 	if value, ok := spc.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -345,12 +465,12 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		_node.user_sender_profile = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := spc.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := spc.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   senderprofile.APIKeyTable,
-			Columns: []string{senderprofile.APIKeyColumn},
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -409,6 +529,70 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := spc.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.BeneficiariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -533,6 +717,30 @@ func (u *SenderProfileUpsert) UpdateIsActive() *SenderProfileUpsert {
 	return u
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *SenderProfileUpsert) SetUnderpaymentPolicy(v senderprofile.UnderpaymentPolicy) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldUnderpaymentPolicy, v)
+	return u
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateUnderpaymentPolicy() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldUnderpaymentPolicy)
+	return u
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *SenderProfileUpsert) SetOverpaymentPolicy(v senderprofile.OverpaymentPolicy) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldOverpaymentPolicy, v)
+	return u
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateOverpaymentPolicy() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldOverpaymentPolicy)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsert) SetUpdatedAt(v time.Time) *SenderProfileUpsert {
 	u.Set(senderprofile.FieldUpdatedAt, v)
@@ -677,6 +885,34 @@ func (u *SenderProfileUpsertOne) UpdateIsActive() *SenderProfileUpsertOne {
 	})
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *SenderProfileUpsertOne) SetUnderpaymentPolicy(v senderprofile.UnderpaymentPolicy) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetUnderpaymentPolicy(v)
+	})
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateUnderpaymentPolicy() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateUnderpaymentPolicy()
+	})
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *SenderProfileUpsertOne) SetOverpaymentPolicy(v senderprofile.OverpaymentPolicy) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOverpaymentPolicy(v)
+	})
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateOverpaymentPolicy() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOverpaymentPolicy()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsertOne) SetUpdatedAt(v time.Time) *SenderProfileUpsertOne {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -990,6 +1226,34 @@ func (u *SenderProfileUpsertBulk) UpdateIsActive() *SenderProfileUpsertBulk {
 	})
 }
 
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (u *SenderProfileUpsertBulk) SetUnderpaymentPolicy(v senderprofile.UnderpaymentPolicy) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetUnderpaymentPolicy(v)
+	})
+}
+
+// UpdateUnderpaymentPolicy sets the "underpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateUnderpaymentPolicy() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateUnderpaymentPolicy()
+	})
+}
+
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (u *SenderProfileUpsertBulk) SetOverpaymentPolicy(v senderprofile.OverpaymentPolicy) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOverpaymentPolicy(v)
+	})
+}
+
+// UpdateOverpaymentPolicy sets the "overpayment_policy" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateOverpaymentPolicy() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOverpaymentPolicy()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsertBulk) SetUpdatedAt(v time.Time) *SenderProfileUpsertBulk {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -14,27 +14,35 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
 // SenderProfileQuery is the builder for querying SenderProfile entities.
 type SenderProfileQuery struct {
 	config
-	ctx               *QueryContext
-	order             []senderprofile.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.SenderProfile
-	withUser          *UserQuery
-	withAPIKey        *APIKeyQuery
-	withPaymentOrders *PaymentOrderQuery
-	withOrderTokens   *SenderOrderTokenQuery
-	withLinkedAddress *LinkedAddressQuery
-	withFKs           bool
+	ctx                     *QueryContext
+	order                   []senderprofile.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.SenderProfile
+	withUser                *UserQuery
+	withAPIKeys             *APIKeyQuery
+	withPaymentOrders       *PaymentOrderQuery
+	withOrderTokens         *SenderOrderTokenQuery
+	withLinkedAddress       *LinkedAddressQuery
+	withPaymentOrderBatches *PaymentOrderBatchQuery
+	withTeamMembers         *TeamMemberQuery
+	withBeneficiaries       *BeneficiaryQuery
+	withScheduledOrders     *ScheduledOrderQuery
+	withFKs                 bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -93,8 +101,8 @@ func (spq *SenderProfileQuery) QueryUser() *UserQuery {
 	return query
 }
 
-// QueryAPIKey chains the current query on the "api_key" edge.
-func (spq *SenderProfileQuery) QueryAPIKey() *APIKeyQuery {
+// QueryAPIKeys chains the current query on the "api_keys" edge.
+func (spq *SenderProfileQuery) QueryAPIKeys() *APIKeyQuery {
 	query := (&APIKeyClient{config: spq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := spq.prepareQuery(ctx); err != nil {
@@ -107,7 +115,7 @@ func (spq *SenderProfileQuery) QueryAPIKey() *APIKeyQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.APIKeysTable, senderprofile.APIKeysColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
 		return fromU, nil
@@ -181,6 +189,94 @@ func (spq *SenderProfileQuery) QueryLinkedAddress() *LinkedAddressQuery {
 	return query
 }
 
+// QueryPaymentOrderBatches chains the current query on the "payment_order_batches" edge.
+func (spq *SenderProfileQuery) QueryPaymentOrderBatches() *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrderBatchesTable, senderprofile.PaymentOrderBatchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamMembers chains the current query on the "team_members" edge.
+func (spq *SenderProfileQuery) QueryTeamMembers() *TeamMemberQuery {
+	query := (&TeamMemberClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamMembersTable, senderprofile.TeamMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBeneficiaries chains the current query on the "beneficiaries" edge.
+func (spq *SenderProfileQuery) QueryBeneficiaries() *BeneficiaryQuery {
+	query := (&BeneficiaryClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(beneficiary.Table, beneficiary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.BeneficiariesTable, senderprofile.BeneficiariesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledOrders chains the current query on the "scheduled_orders" edge.
+func (spq *SenderProfileQuery) QueryScheduledOrders() *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.ScheduledOrdersTable, senderprofile.ScheduledOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SenderProfile entity from the query.
 // Returns a *NotFoundError when no SenderProfile was found.
 func (spq *SenderProfileQuery) First(ctx context.Context) (*SenderProfile, error) {
@@ -368,16 +464,20 @@ func (spq *SenderProfileQuery) Clone() *SenderProfileQuery {
 		return nil
 	}
 	return &SenderProfileQuery{
-		config:            spq.config,
-		ctx:               spq.ctx.Clone(),
-		order:             append([]senderprofile.OrderOption{}, spq.order...),
-		inters:            append([]Interceptor{}, spq.inters...),
-		predicates:        append([]predicate.SenderProfile{}, spq.predicates...),
-		withUser:          spq.withUser.Clone(),
-		withAPIKey:        spq.withAPIKey.Clone(),
-		withPaymentOrders: spq.withPaymentOrders.Clone(),
-		withOrderTokens:   spq.withOrderTokens.Clone(),
-		withLinkedAddress: spq.withLinkedAddress.Clone(),
+		config:                  spq.config,
+		ctx:                     spq.ctx.Clone(),
+		order:                   append([]senderprofile.OrderOption{}, spq.order...),
+		inters:                  append([]Interceptor{}, spq.inters...),
+		predicates:              append([]predicate.SenderProfile{}, spq.predicates...),
+		withUser:                spq.withUser.Clone(),
+		withAPIKeys:             spq.withAPIKeys.Clone(),
+		withPaymentOrders:       spq.withPaymentOrders.Clone(),
+		withOrderTokens:         spq.withOrderTokens.Clone(),
+		withLinkedAddress:       spq.withLinkedAddress.Clone(),
+		withPaymentOrderBatches: spq.withPaymentOrderBatches.Clone(),
+		withTeamMembers:         spq.withTeamMembers.Clone(),
+		withBeneficiaries:       spq.withBeneficiaries.Clone(),
+		withScheduledOrders:     spq.withScheduledOrders.Clone(),
 		// clone intermediate query.
 		sql:  spq.sql.Clone(),
 		path: spq.path,
@@ -395,14 +495,14 @@ func (spq *SenderProfileQuery) WithUser(opts ...func(*UserQuery)) *SenderProfile
 	return spq
 }
 
-// WithAPIKey tells the query-builder to eager-load the nodes that are connected to
-// the "api_key" edge. The optional arguments are used to configure the query builder of the edge.
-func (spq *SenderProfileQuery) WithAPIKey(opts ...func(*APIKeyQuery)) *SenderProfileQuery {
+// WithAPIKeys tells the query-builder to eager-load the nodes that are connected to
+// the "api_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithAPIKeys(opts ...func(*APIKeyQuery)) *SenderProfileQuery {
 	query := (&APIKeyClient{config: spq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	spq.withAPIKey = query
+	spq.withAPIKeys = query
 	return spq
 }
 
@@ -439,6 +539,50 @@ func (spq *SenderProfileQuery) WithLinkedAddress(opts ...func(*LinkedAddressQuer
 	return spq
 }
 
+// WithPaymentOrderBatches tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order_batches" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithPaymentOrderBatches(opts ...func(*PaymentOrderBatchQuery)) *SenderProfileQuery {
+	query := (&PaymentOrderBatchClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withPaymentOrderBatches = query
+	return spq
+}
+
+// WithTeamMembers tells the query-builder to eager-load the nodes that are connected to
+// the "team_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithTeamMembers(opts ...func(*TeamMemberQuery)) *SenderProfileQuery {
+	query := (&TeamMemberClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withTeamMembers = query
+	return spq
+}
+
+// WithBeneficiaries tells the query-builder to eager-load the nodes that are connected to
+// the "beneficiaries" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithBeneficiaries(opts ...func(*BeneficiaryQuery)) *SenderProfileQuery {
+	query := (&BeneficiaryClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withBeneficiaries = query
+	return spq
+}
+
+// WithScheduledOrders tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithScheduledOrders(opts ...func(*ScheduledOrderQuery)) *SenderProfileQuery {
+	query := (&ScheduledOrderClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withScheduledOrders = query
+	return spq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +662,16 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*SenderProfile{}
 		withFKs     = spq.withFKs
 		_spec       = spq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [9]bool{
 			spq.withUser != nil,
-			spq.withAPIKey != nil,
+			spq.withAPIKeys != nil,
 			spq.withPaymentOrders != nil,
 			spq.withOrderTokens != nil,
 			spq.withLinkedAddress != nil,
+			spq.withPaymentOrderBatches != nil,
+			spq.withTeamMembers != nil,
+			spq.withBeneficiaries != nil,
+			spq.withScheduledOrders != nil,
 		}
 	)
 	if spq.withUser != nil {
@@ -556,9 +704,10 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
-	if query := spq.withAPIKey; query != nil {
-		if err := spq.loadAPIKey(ctx, query, nodes, nil,
-			func(n *SenderProfile, e *APIKey) { n.Edges.APIKey = e }); err != nil {
+	if query := spq.withAPIKeys; query != nil {
+		if err := spq.loadAPIKeys(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.APIKeys = []*APIKey{} },
+			func(n *SenderProfile, e *APIKey) { n.Edges.APIKeys = append(n.Edges.APIKeys, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -583,6 +732,38 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := spq.withPaymentOrderBatches; query != nil {
+		if err := spq.loadPaymentOrderBatches(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.PaymentOrderBatches = []*PaymentOrderBatch{} },
+			func(n *SenderProfile, e *PaymentOrderBatch) {
+				n.Edges.PaymentOrderBatches = append(n.Edges.PaymentOrderBatches, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withTeamMembers; query != nil {
+		if err := spq.loadTeamMembers(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.TeamMembers = []*TeamMember{} },
+			func(n *SenderProfile, e *TeamMember) { n.Edges.TeamMembers = append(n.Edges.TeamMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withBeneficiaries; query != nil {
+		if err := spq.loadBeneficiaries(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.Beneficiaries = []*Beneficiary{} },
+			func(n *SenderProfile, e *Beneficiary) { n.Edges.Beneficiaries = append(n.Edges.Beneficiaries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withScheduledOrders; query != nil {
+		if err := spq.loadScheduledOrders(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.ScheduledOrders = []*ScheduledOrder{} },
+			func(n *SenderProfile, e *ScheduledOrder) {
+				n.Edges.ScheduledOrders = append(n.Edges.ScheduledOrders, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -618,16 +799,19 @@ func (spq *SenderProfileQuery) loadUser(ctx context.Context, query *UserQuery, n
 	}
 	return nil
 }
-func (spq *SenderProfileQuery) loadAPIKey(ctx context.Context, query *APIKeyQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *APIKey)) error {
+func (spq *SenderProfileQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *APIKey)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*SenderProfile)
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.APIKey(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(senderprofile.APIKeyColumn), fks...))
+		s.Where(sql.InValues(s.C(senderprofile.APIKeysColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -739,6 +923,130 @@ func (spq *SenderProfileQuery) loadLinkedAddress(ctx context.Context, query *Lin
 	}
 	return nil
 }
+func (spq *SenderProfileQuery) loadPaymentOrderBatches(ctx context.Context, query *PaymentOrderBatchQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *PaymentOrderBatch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.PaymentOrderBatchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_payment_order_batches
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_payment_order_batches" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_payment_order_batches" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadTeamMembers(ctx context.Context, query *TeamMemberQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *TeamMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.TeamMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_team_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_team_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_team_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadBeneficiaries(ctx context.Context, query *BeneficiaryQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *Beneficiary)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Beneficiary(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.BeneficiariesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_beneficiaries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_beneficiaries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_beneficiaries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadScheduledOrders(ctx context.Context, query *ScheduledOrderQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *ScheduledOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScheduledOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.ScheduledOrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_scheduled_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_scheduled_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_scheduled_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (spq *SenderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := spq.querySpec()
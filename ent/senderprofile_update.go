@@ -14,11 +14,15 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/beneficiary"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 )
 
 // SenderProfileUpdate is the builder for updating SenderProfile entities.
@@ -114,29 +118,53 @@ func (spu *SenderProfileUpdate) SetNillableIsActive(b *bool) *SenderProfileUpdat
 	return spu
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (spu *SenderProfileUpdate) SetUpdatedAt(t time.Time) *SenderProfileUpdate {
-	spu.mutation.SetUpdatedAt(t)
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (spu *SenderProfileUpdate) SetUnderpaymentPolicy(sp senderprofile.UnderpaymentPolicy) *SenderProfileUpdate {
+	spu.mutation.SetUnderpaymentPolicy(sp)
+	return spu
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableUnderpaymentPolicy(sp *senderprofile.UnderpaymentPolicy) *SenderProfileUpdate {
+	if sp != nil {
+		spu.SetUnderpaymentPolicy(*sp)
+	}
 	return spu
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spu *SenderProfileUpdate) SetAPIKeyID(id uuid.UUID) *SenderProfileUpdate {
-	spu.mutation.SetAPIKeyID(id)
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (spu *SenderProfileUpdate) SetOverpaymentPolicy(sp senderprofile.OverpaymentPolicy) *SenderProfileUpdate {
+	spu.mutation.SetOverpaymentPolicy(sp)
 	return spu
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spu *SenderProfileUpdate) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileUpdate {
-	if id != nil {
-		spu = spu.SetAPIKeyID(*id)
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableOverpaymentPolicy(sp *senderprofile.OverpaymentPolicy) *SenderProfileUpdate {
+	if sp != nil {
+		spu.SetOverpaymentPolicy(*sp)
 	}
 	return spu
 }
 
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spu *SenderProfileUpdate) SetAPIKey(a *APIKey) *SenderProfileUpdate {
-	return spu.SetAPIKeyID(a.ID)
+// SetUpdatedAt sets the "updated_at" field.
+func (spu *SenderProfileUpdate) SetUpdatedAt(t time.Time) *SenderProfileUpdate {
+	spu.mutation.SetUpdatedAt(t)
+	return spu
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (spu *SenderProfileUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddAPIKeyIDs(ids...)
+	return spu
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (spu *SenderProfileUpdate) AddAPIKeys(a ...*APIKey) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spu.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -184,17 +212,92 @@ func (spu *SenderProfileUpdate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spu.AddLinkedAddresIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (spu *SenderProfileUpdate) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddPaymentOrderBatchIDs(ids...)
+	return spu
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (spu *SenderProfileUpdate) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return spu.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spu *SenderProfileUpdate) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddTeamMemberIDs(ids...)
+	return spu
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spu *SenderProfileUpdate) AddTeamMembers(t ...*TeamMember) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.AddTeamMemberIDs(ids...)
+}
+
+// AddBeneficiaryIDs adds the "beneficiaries" edge to the Beneficiary entity by IDs.
+func (spu *SenderProfileUpdate) AddBeneficiaryIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddBeneficiaryIDs(ids...)
+	return spu
+}
+
+// AddBeneficiaries adds the "beneficiaries" edges to the Beneficiary entity.
+func (spu *SenderProfileUpdate) AddBeneficiaries(b ...*Beneficiary) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spu.AddBeneficiaryIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (spu *SenderProfileUpdate) AddScheduledOrderIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddScheduledOrderIDs(ids...)
+	return spu
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (spu *SenderProfileUpdate) AddScheduledOrders(s ...*ScheduledOrder) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return spu.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spu *SenderProfileUpdate) Mutation() *SenderProfileMutation {
 	return spu.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
-func (spu *SenderProfileUpdate) ClearAPIKey() *SenderProfileUpdate {
-	spu.mutation.ClearAPIKey()
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (spu *SenderProfileUpdate) ClearAPIKeys() *SenderProfileUpdate {
+	spu.mutation.ClearAPIKeys()
 	return spu
 }
 
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (spu *SenderProfileUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveAPIKeyIDs(ids...)
+	return spu
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (spu *SenderProfileUpdate) RemoveAPIKeys(a ...*APIKey) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spu.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
 func (spu *SenderProfileUpdate) ClearPaymentOrders() *SenderProfileUpdate {
 	spu.mutation.ClearPaymentOrders()
@@ -258,6 +361,90 @@ func (spu *SenderProfileUpdate) RemoveLinkedAddress(l ...*LinkedAddress) *Sender
 	return spu.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearPaymentOrderBatches clears all "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (spu *SenderProfileUpdate) ClearPaymentOrderBatches() *SenderProfileUpdate {
+	spu.mutation.ClearPaymentOrderBatches()
+	return spu
+}
+
+// RemovePaymentOrderBatchIDs removes the "payment_order_batches" edge to PaymentOrderBatch entities by IDs.
+func (spu *SenderProfileUpdate) RemovePaymentOrderBatchIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemovePaymentOrderBatchIDs(ids...)
+	return spu
+}
+
+// RemovePaymentOrderBatches removes "payment_order_batches" edges to PaymentOrderBatch entities.
+func (spu *SenderProfileUpdate) RemovePaymentOrderBatches(p ...*PaymentOrderBatch) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return spu.RemovePaymentOrderBatchIDs(ids...)
+}
+
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (spu *SenderProfileUpdate) ClearTeamMembers() *SenderProfileUpdate {
+	spu.mutation.ClearTeamMembers()
+	return spu
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (spu *SenderProfileUpdate) RemoveTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveTeamMemberIDs(ids...)
+	return spu
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (spu *SenderProfileUpdate) RemoveTeamMembers(t ...*TeamMember) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearBeneficiaries clears all "beneficiaries" edges to the Beneficiary entity.
+func (spu *SenderProfileUpdate) ClearBeneficiaries() *SenderProfileUpdate {
+	spu.mutation.ClearBeneficiaries()
+	return spu
+}
+
+// RemoveBeneficiaryIDs removes the "beneficiaries" edge to Beneficiary entities by IDs.
+func (spu *SenderProfileUpdate) RemoveBeneficiaryIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveBeneficiaryIDs(ids...)
+	return spu
+}
+
+// RemoveBeneficiaries removes "beneficiaries" edges to Beneficiary entities.
+func (spu *SenderProfileUpdate) RemoveBeneficiaries(b ...*Beneficiary) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spu.RemoveBeneficiaryIDs(ids...)
+}
+
+// ClearScheduledOrders clears all "scheduled_orders" edges to the ScheduledOrder entity.
+func (spu *SenderProfileUpdate) ClearScheduledOrders() *SenderProfileUpdate {
+	spu.mutation.ClearScheduledOrders()
+	return spu
+}
+
+// RemoveScheduledOrderIDs removes the "scheduled_orders" edge to ScheduledOrder entities by IDs.
+func (spu *SenderProfileUpdate) RemoveScheduledOrderIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveScheduledOrderIDs(ids...)
+	return spu
+}
+
+// RemoveScheduledOrders removes "scheduled_orders" edges to ScheduledOrder entities.
+func (spu *SenderProfileUpdate) RemoveScheduledOrders(s ...*ScheduledOrder) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return spu.RemoveScheduledOrderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (spu *SenderProfileUpdate) Save(ctx context.Context) (int, error) {
 	spu.defaults()
@@ -296,6 +483,16 @@ func (spu *SenderProfileUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (spu *SenderProfileUpdate) check() error {
+	if v, ok := spu.mutation.UnderpaymentPolicy(); ok {
+		if err := senderprofile.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.underpayment_policy": %w`, err)}
+		}
+	}
+	if v, ok := spu.mutation.OverpaymentPolicy(); ok {
+		if err := senderprofile.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_policy": %w`, err)}
+		}
+	}
 	if spu.mutation.UserCleared() && len(spu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SenderProfile.user"`)
 	}
@@ -340,28 +537,50 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := spu.mutation.IsActive(); ok {
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := spu.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldUnderpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := spu.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentPolicy, field.TypeEnum, value)
+	}
 	if value, ok := spu.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if spu.mutation.APIKeyCleared() {
+	if spu.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !spu.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   senderprofile.APIKeyTable,
-			Columns: []string{senderprofile.APIKeyColumn},
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := spu.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := spu.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   senderprofile.APIKeyTable,
-			Columns: []string{senderprofile.APIKeyColumn},
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -507,6 +726,186 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spu.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedPaymentOrderBatchesIDs(); len(nodes) > 0 && !spu.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !spu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.BeneficiariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedBeneficiariesIDs(); len(nodes) > 0 && !spu.mutation.BeneficiariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.BeneficiariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedScheduledOrdersIDs(); len(nodes) > 0 && !spu.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{senderprofile.Label}
@@ -607,29 +1006,53 @@ func (spuo *SenderProfileUpdateOne) SetNillableIsActive(b *bool) *SenderProfileU
 	return spuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (spuo *SenderProfileUpdateOne) SetUpdatedAt(t time.Time) *SenderProfileUpdateOne {
-	spuo.mutation.SetUpdatedAt(t)
+// SetUnderpaymentPolicy sets the "underpayment_policy" field.
+func (spuo *SenderProfileUpdateOne) SetUnderpaymentPolicy(sp senderprofile.UnderpaymentPolicy) *SenderProfileUpdateOne {
+	spuo.mutation.SetUnderpaymentPolicy(sp)
+	return spuo
+}
+
+// SetNillableUnderpaymentPolicy sets the "underpayment_policy" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableUnderpaymentPolicy(sp *senderprofile.UnderpaymentPolicy) *SenderProfileUpdateOne {
+	if sp != nil {
+		spuo.SetUnderpaymentPolicy(*sp)
+	}
 	return spuo
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spuo *SenderProfileUpdateOne) SetAPIKeyID(id uuid.UUID) *SenderProfileUpdateOne {
-	spuo.mutation.SetAPIKeyID(id)
+// SetOverpaymentPolicy sets the "overpayment_policy" field.
+func (spuo *SenderProfileUpdateOne) SetOverpaymentPolicy(sp senderprofile.OverpaymentPolicy) *SenderProfileUpdateOne {
+	spuo.mutation.SetOverpaymentPolicy(sp)
 	return spuo
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spuo *SenderProfileUpdateOne) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileUpdateOne {
-	if id != nil {
-		spuo = spuo.SetAPIKeyID(*id)
+// SetNillableOverpaymentPolicy sets the "overpayment_policy" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableOverpaymentPolicy(sp *senderprofile.OverpaymentPolicy) *SenderProfileUpdateOne {
+	if sp != nil {
+		spuo.SetOverpaymentPolicy(*sp)
 	}
 	return spuo
 }
 
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spuo *SenderProfileUpdateOne) SetAPIKey(a *APIKey) *SenderProfileUpdateOne {
-	return spuo.SetAPIKeyID(a.ID)
+// SetUpdatedAt sets the "updated_at" field.
+func (spuo *SenderProfileUpdateOne) SetUpdatedAt(t time.Time) *SenderProfileUpdateOne {
+	spuo.mutation.SetUpdatedAt(t)
+	return spuo
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddAPIKeyIDs(ids...)
+	return spuo
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (spuo *SenderProfileUpdateOne) AddAPIKeys(a ...*APIKey) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spuo.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -677,17 +1100,92 @@ func (spuo *SenderProfileUpdateOne) AddLinkedAddress(l ...*LinkedAddress) *Sende
 	return spuo.AddLinkedAddresIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddPaymentOrderBatchIDs(ids...)
+	return spuo
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (spuo *SenderProfileUpdateOne) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return spuo.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddTeamMemberIDs(ids...)
+	return spuo
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spuo *SenderProfileUpdateOne) AddTeamMembers(t ...*TeamMember) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.AddTeamMemberIDs(ids...)
+}
+
+// AddBeneficiaryIDs adds the "beneficiaries" edge to the Beneficiary entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddBeneficiaryIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddBeneficiaryIDs(ids...)
+	return spuo
+}
+
+// AddBeneficiaries adds the "beneficiaries" edges to the Beneficiary entity.
+func (spuo *SenderProfileUpdateOne) AddBeneficiaries(b ...*Beneficiary) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spuo.AddBeneficiaryIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddScheduledOrderIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddScheduledOrderIDs(ids...)
+	return spuo
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (spuo *SenderProfileUpdateOne) AddScheduledOrders(s ...*ScheduledOrder) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return spuo.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spuo *SenderProfileUpdateOne) Mutation() *SenderProfileMutation {
 	return spuo.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
-func (spuo *SenderProfileUpdateOne) ClearAPIKey() *SenderProfileUpdateOne {
-	spuo.mutation.ClearAPIKey()
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (spuo *SenderProfileUpdateOne) ClearAPIKeys() *SenderProfileUpdateOne {
+	spuo.mutation.ClearAPIKeys()
 	return spuo
 }
 
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveAPIKeyIDs(ids...)
+	return spuo
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (spuo *SenderProfileUpdateOne) RemoveAPIKeys(a ...*APIKey) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spuo.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
 func (spuo *SenderProfileUpdateOne) ClearPaymentOrders() *SenderProfileUpdateOne {
 	spuo.mutation.ClearPaymentOrders()
@@ -751,6 +1249,90 @@ func (spuo *SenderProfileUpdateOne) RemoveLinkedAddress(l ...*LinkedAddress) *Se
 	return spuo.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearPaymentOrderBatches clears all "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (spuo *SenderProfileUpdateOne) ClearPaymentOrderBatches() *SenderProfileUpdateOne {
+	spuo.mutation.ClearPaymentOrderBatches()
+	return spuo
+}
+
+// RemovePaymentOrderBatchIDs removes the "payment_order_batches" edge to PaymentOrderBatch entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemovePaymentOrderBatchIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemovePaymentOrderBatchIDs(ids...)
+	return spuo
+}
+
+// RemovePaymentOrderBatches removes "payment_order_batches" edges to PaymentOrderBatch entities.
+func (spuo *SenderProfileUpdateOne) RemovePaymentOrderBatches(p ...*PaymentOrderBatch) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return spuo.RemovePaymentOrderBatchIDs(ids...)
+}
+
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (spuo *SenderProfileUpdateOne) ClearTeamMembers() *SenderProfileUpdateOne {
+	spuo.mutation.ClearTeamMembers()
+	return spuo
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveTeamMemberIDs(ids...)
+	return spuo
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (spuo *SenderProfileUpdateOne) RemoveTeamMembers(t ...*TeamMember) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearBeneficiaries clears all "beneficiaries" edges to the Beneficiary entity.
+func (spuo *SenderProfileUpdateOne) ClearBeneficiaries() *SenderProfileUpdateOne {
+	spuo.mutation.ClearBeneficiaries()
+	return spuo
+}
+
+// RemoveBeneficiaryIDs removes the "beneficiaries" edge to Beneficiary entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveBeneficiaryIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveBeneficiaryIDs(ids...)
+	return spuo
+}
+
+// RemoveBeneficiaries removes "beneficiaries" edges to Beneficiary entities.
+func (spuo *SenderProfileUpdateOne) RemoveBeneficiaries(b ...*Beneficiary) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spuo.RemoveBeneficiaryIDs(ids...)
+}
+
+// ClearScheduledOrders clears all "scheduled_orders" edges to the ScheduledOrder entity.
+func (spuo *SenderProfileUpdateOne) ClearScheduledOrders() *SenderProfileUpdateOne {
+	spuo.mutation.ClearScheduledOrders()
+	return spuo
+}
+
+// RemoveScheduledOrderIDs removes the "scheduled_orders" edge to ScheduledOrder entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveScheduledOrderIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveScheduledOrderIDs(ids...)
+	return spuo
+}
+
+// RemoveScheduledOrders removes "scheduled_orders" edges to ScheduledOrder entities.
+func (spuo *SenderProfileUpdateOne) RemoveScheduledOrders(s ...*ScheduledOrder) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return spuo.RemoveScheduledOrderIDs(ids...)
+}
+
 // Where appends a list predicates to the SenderProfileUpdate builder.
 func (spuo *SenderProfileUpdateOne) Where(ps ...predicate.SenderProfile) *SenderProfileUpdateOne {
 	spuo.mutation.Where(ps...)
@@ -802,6 +1384,16 @@ func (spuo *SenderProfileUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (spuo *SenderProfileUpdateOne) check() error {
+	if v, ok := spuo.mutation.UnderpaymentPolicy(); ok {
+		if err := senderprofile.UnderpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "underpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.underpayment_policy": %w`, err)}
+		}
+	}
+	if v, ok := spuo.mutation.OverpaymentPolicy(); ok {
+		if err := senderprofile.OverpaymentPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_policy", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_policy": %w`, err)}
+		}
+	}
 	if spuo.mutation.UserCleared() && len(spuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SenderProfile.user"`)
 	}
@@ -863,28 +1455,50 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 	if value, ok := spuo.mutation.IsActive(); ok {
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := spuo.mutation.UnderpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldUnderpaymentPolicy, field.TypeEnum, value)
+	}
+	if value, ok := spuo.mutation.OverpaymentPolicy(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentPolicy, field.TypeEnum, value)
+	}
 	if value, ok := spuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if spuo.mutation.APIKeyCleared() {
+	if spuo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !spuo.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   senderprofile.APIKeyTable,
-			Columns: []string{senderprofile.APIKeyColumn},
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := spuo.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := spuo.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   senderprofile.APIKeyTable,
-			Columns: []string{senderprofile.APIKeyColumn},
+			Table:   senderprofile.APIKeysTable,
+			Columns: []string{senderprofile.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
@@ -1030,6 +1644,186 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spuo.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedPaymentOrderBatchesIDs(); len(nodes) > 0 && !spuo.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.PaymentOrderBatchesTable,
+			Columns: []string{senderprofile.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !spuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.BeneficiariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedBeneficiariesIDs(); len(nodes) > 0 && !spuo.mutation.BeneficiariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.BeneficiariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BeneficiariesTable,
+			Columns: []string{senderprofile.BeneficiariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(beneficiary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedScheduledOrdersIDs(); len(nodes) > 0 && !spuo.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.ScheduledOrdersTable,
+			Columns: []string{senderprofile.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SenderProfile{config: spuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
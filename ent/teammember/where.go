@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teammember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldEmail, v))
+}
+
+// InviteToken applies equality check predicate on the "invite_token" field. It's identical to InviteTokenEQ.
+func InviteToken(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldInviteToken, v))
+}
+
+// InviteExpiryAt applies equality check predicate on the "invite_expiry_at" field. It's identical to InviteExpiryAtEQ.
+func InviteExpiryAt(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldInviteExpiryAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// InviteTokenEQ applies the EQ predicate on the "invite_token" field.
+func InviteTokenEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldInviteToken, v))
+}
+
+// InviteTokenNEQ applies the NEQ predicate on the "invite_token" field.
+func InviteTokenNEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldInviteToken, v))
+}
+
+// InviteTokenIn applies the In predicate on the "invite_token" field.
+func InviteTokenIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldInviteToken, vs...))
+}
+
+// InviteTokenNotIn applies the NotIn predicate on the "invite_token" field.
+func InviteTokenNotIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldInviteToken, vs...))
+}
+
+// InviteTokenGT applies the GT predicate on the "invite_token" field.
+func InviteTokenGT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldInviteToken, v))
+}
+
+// InviteTokenGTE applies the GTE predicate on the "invite_token" field.
+func InviteTokenGTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldInviteToken, v))
+}
+
+// InviteTokenLT applies the LT predicate on the "invite_token" field.
+func InviteTokenLT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldInviteToken, v))
+}
+
+// InviteTokenLTE applies the LTE predicate on the "invite_token" field.
+func InviteTokenLTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldInviteToken, v))
+}
+
+// InviteTokenContains applies the Contains predicate on the "invite_token" field.
+func InviteTokenContains(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContains(FieldInviteToken, v))
+}
+
+// InviteTokenHasPrefix applies the HasPrefix predicate on the "invite_token" field.
+func InviteTokenHasPrefix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasPrefix(FieldInviteToken, v))
+}
+
+// InviteTokenHasSuffix applies the HasSuffix predicate on the "invite_token" field.
+func InviteTokenHasSuffix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasSuffix(FieldInviteToken, v))
+}
+
+// InviteTokenIsNil applies the IsNil predicate on the "invite_token" field.
+func InviteTokenIsNil() predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIsNull(FieldInviteToken))
+}
+
+// InviteTokenNotNil applies the NotNil predicate on the "invite_token" field.
+func InviteTokenNotNil() predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotNull(FieldInviteToken))
+}
+
+// InviteTokenEqualFold applies the EqualFold predicate on the "invite_token" field.
+func InviteTokenEqualFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEqualFold(FieldInviteToken, v))
+}
+
+// InviteTokenContainsFold applies the ContainsFold predicate on the "invite_token" field.
+func InviteTokenContainsFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContainsFold(FieldInviteToken, v))
+}
+
+// InviteExpiryAtEQ applies the EQ predicate on the "invite_expiry_at" field.
+func InviteExpiryAtEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtNEQ applies the NEQ predicate on the "invite_expiry_at" field.
+func InviteExpiryAtNEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtIn applies the In predicate on the "invite_expiry_at" field.
+func InviteExpiryAtIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldInviteExpiryAt, vs...))
+}
+
+// InviteExpiryAtNotIn applies the NotIn predicate on the "invite_expiry_at" field.
+func InviteExpiryAtNotIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldInviteExpiryAt, vs...))
+}
+
+// InviteExpiryAtGT applies the GT predicate on the "invite_expiry_at" field.
+func InviteExpiryAtGT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtGTE applies the GTE predicate on the "invite_expiry_at" field.
+func InviteExpiryAtGTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtLT applies the LT predicate on the "invite_expiry_at" field.
+func InviteExpiryAtLT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtLTE applies the LTE predicate on the "invite_expiry_at" field.
+func InviteExpiryAtLTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldInviteExpiryAt, v))
+}
+
+// InviteExpiryAtIsNil applies the IsNil predicate on the "invite_expiry_at" field.
+func InviteExpiryAtIsNil() predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIsNull(FieldInviteExpiryAt))
+}
+
+// InviteExpiryAtNotNil applies the NotNil predicate on the "invite_expiry_at" field.
+func InviteExpiryAtNotNil() predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotNull(FieldInviteExpiryAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProviderProfile applies the HasEdge predicate on the "provider_profile" edge.
+func HasProviderProfile() predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderProfileTable, ProviderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderProfileWith applies the HasEdge predicate on the "provider_profile" edge with a given conditions (other predicates).
+func HasProviderProfileWith(preds ...predicate.ProviderProfile) predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := newProviderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.NotPredicates(p))
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamMemberCreate is the builder for creating a TeamMember entity.
+type TeamMemberCreate struct {
+	config
+	mutation *TeamMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tmc *TeamMemberCreate) SetCreatedAt(t time.Time) *TeamMemberCreate {
+	tmc.mutation.SetCreatedAt(t)
+	return tmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableCreatedAt(t *time.Time) *TeamMemberCreate {
+	if t != nil {
+		tmc.SetCreatedAt(*t)
+	}
+	return tmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmc *TeamMemberCreate) SetUpdatedAt(t time.Time) *TeamMemberCreate {
+	tmc.mutation.SetUpdatedAt(t)
+	return tmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableUpdatedAt(t *time.Time) *TeamMemberCreate {
+	if t != nil {
+		tmc.SetUpdatedAt(*t)
+	}
+	return tmc
+}
+
+// SetEmail sets the "email" field.
+func (tmc *TeamMemberCreate) SetEmail(s string) *TeamMemberCreate {
+	tmc.mutation.SetEmail(s)
+	return tmc
+}
+
+// SetRole sets the "role" field.
+func (tmc *TeamMemberCreate) SetRole(t teammember.Role) *TeamMemberCreate {
+	tmc.mutation.SetRole(t)
+	return tmc
+}
+
+// SetStatus sets the "status" field.
+func (tmc *TeamMemberCreate) SetStatus(t teammember.Status) *TeamMemberCreate {
+	tmc.mutation.SetStatus(t)
+	return tmc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableStatus(t *teammember.Status) *TeamMemberCreate {
+	if t != nil {
+		tmc.SetStatus(*t)
+	}
+	return tmc
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (tmc *TeamMemberCreate) SetInviteToken(s string) *TeamMemberCreate {
+	tmc.mutation.SetInviteToken(s)
+	return tmc
+}
+
+// SetNillableInviteToken sets the "invite_token" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableInviteToken(s *string) *TeamMemberCreate {
+	if s != nil {
+		tmc.SetInviteToken(*s)
+	}
+	return tmc
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (tmc *TeamMemberCreate) SetInviteExpiryAt(t time.Time) *TeamMemberCreate {
+	tmc.mutation.SetInviteExpiryAt(t)
+	return tmc
+}
+
+// SetNillableInviteExpiryAt sets the "invite_expiry_at" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableInviteExpiryAt(t *time.Time) *TeamMemberCreate {
+	if t != nil {
+		tmc.SetInviteExpiryAt(*t)
+	}
+	return tmc
+}
+
+// SetID sets the "id" field.
+func (tmc *TeamMemberCreate) SetID(u uuid.UUID) *TeamMemberCreate {
+	tmc.mutation.SetID(u)
+	return tmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableID(u *uuid.UUID) *TeamMemberCreate {
+	if u != nil {
+		tmc.SetID(*u)
+	}
+	return tmc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tmc *TeamMemberCreate) SetUserID(id uuid.UUID) *TeamMemberCreate {
+	tmc.mutation.SetUserID(id)
+	return tmc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableUserID(id *uuid.UUID) *TeamMemberCreate {
+	if id != nil {
+		tmc = tmc.SetUserID(*id)
+	}
+	return tmc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tmc *TeamMemberCreate) SetUser(u *User) *TeamMemberCreate {
+	return tmc.SetUserID(u.ID)
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (tmc *TeamMemberCreate) SetSenderProfileID(id uuid.UUID) *TeamMemberCreate {
+	tmc.mutation.SetSenderProfileID(id)
+	return tmc
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableSenderProfileID(id *uuid.UUID) *TeamMemberCreate {
+	if id != nil {
+		tmc = tmc.SetSenderProfileID(*id)
+	}
+	return tmc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (tmc *TeamMemberCreate) SetSenderProfile(s *SenderProfile) *TeamMemberCreate {
+	return tmc.SetSenderProfileID(s.ID)
+}
+
+// SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
+func (tmc *TeamMemberCreate) SetProviderProfileID(id string) *TeamMemberCreate {
+	tmc.mutation.SetProviderProfileID(id)
+	return tmc
+}
+
+// SetNillableProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (tmc *TeamMemberCreate) SetNillableProviderProfileID(id *string) *TeamMemberCreate {
+	if id != nil {
+		tmc = tmc.SetProviderProfileID(*id)
+	}
+	return tmc
+}
+
+// SetProviderProfile sets the "provider_profile" edge to the ProviderProfile entity.
+func (tmc *TeamMemberCreate) SetProviderProfile(p *ProviderProfile) *TeamMemberCreate {
+	return tmc.SetProviderProfileID(p.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (tmc *TeamMemberCreate) Mutation() *TeamMemberMutation {
+	return tmc.mutation
+}
+
+// Save creates the TeamMember in the database.
+func (tmc *TeamMemberCreate) Save(ctx context.Context) (*TeamMember, error) {
+	if err := tmc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tmc.sqlSave, tmc.mutation, tmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tmc *TeamMemberCreate) SaveX(ctx context.Context) *TeamMember {
+	v, err := tmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmc *TeamMemberCreate) Exec(ctx context.Context) error {
+	_, err := tmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmc *TeamMemberCreate) ExecX(ctx context.Context) {
+	if err := tmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmc *TeamMemberCreate) defaults() error {
+	if _, ok := tmc.mutation.CreatedAt(); !ok {
+		if teammember.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized teammember.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := teammember.DefaultCreatedAt()
+		tmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tmc.mutation.UpdatedAt(); !ok {
+		if teammember.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized teammember.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := teammember.DefaultUpdatedAt()
+		tmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tmc.mutation.Status(); !ok {
+		v := teammember.DefaultStatus
+		tmc.mutation.SetStatus(v)
+	}
+	if _, ok := tmc.mutation.ID(); !ok {
+		if teammember.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized teammember.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := teammember.DefaultID()
+		tmc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmc *TeamMemberCreate) check() error {
+	if _, ok := tmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TeamMember.created_at"`)}
+	}
+	if _, ok := tmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TeamMember.updated_at"`)}
+	}
+	if _, ok := tmc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "TeamMember.email"`)}
+	}
+	if _, ok := tmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TeamMember.role"`)}
+	}
+	if v, ok := tmc.mutation.Role(); ok {
+		if err := teammember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMember.role": %w`, err)}
+		}
+	}
+	if _, ok := tmc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TeamMember.status"`)}
+	}
+	if v, ok := tmc.mutation.Status(); ok {
+		if err := teammember.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamMember.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tmc *TeamMemberCreate) sqlSave(ctx context.Context) (*TeamMember, error) {
+	if err := tmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tmc.mutation.id = &_node.ID
+	tmc.mutation.done = true
+	return _node, nil
+}
+
+func (tmc *TeamMemberCreate) createSpec() (*TeamMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamMember{config: tmc.config}
+		_spec = sqlgraph.NewCreateSpec(teammember.Table, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tmc.conflict
+	if id, ok := tmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tmc.mutation.CreatedAt(); ok {
+		_spec.SetField(teammember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(teammember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tmc.mutation.Email(); ok {
+		_spec.SetField(teammember.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := tmc.mutation.Role(); ok {
+		_spec.SetField(teammember.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := tmc.mutation.Status(); ok {
+		_spec.SetField(teammember.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tmc.mutation.InviteToken(); ok {
+		_spec.SetField(teammember.FieldInviteToken, field.TypeString, value)
+		_node.InviteToken = value
+	}
+	if value, ok := tmc.mutation.InviteExpiryAt(); ok {
+		_spec.SetField(teammember.FieldInviteExpiryAt, field.TypeTime, value)
+		_node.InviteExpiryAt = value
+	}
+	if nodes := tmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.UserTable,
+			Columns: []string{teammember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_team_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tmc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.SenderProfileTable,
+			Columns: []string{teammember.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_team_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tmc.mutation.ProviderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.ProviderProfileTable,
+			Columns: []string{teammember.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_team_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tmc *TeamMemberCreate) OnConflict(opts ...sql.ConflictOption) *TeamMemberUpsertOne {
+	tmc.conflict = opts
+	return &TeamMemberUpsertOne{
+		create: tmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmc *TeamMemberCreate) OnConflictColumns(columns ...string) *TeamMemberUpsertOne {
+	tmc.conflict = append(tmc.conflict, sql.ConflictColumns(columns...))
+	return &TeamMemberUpsertOne{
+		create: tmc,
+	}
+}
+
+type (
+	// TeamMemberUpsertOne is the builder for "upsert"-ing
+	//  one TeamMember node.
+	TeamMemberUpsertOne struct {
+		create *TeamMemberCreate
+	}
+
+	// TeamMemberUpsert is the "OnConflict" setter.
+	TeamMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamMemberUpsert) SetUpdatedAt(v time.Time) *TeamMemberUpsert {
+	u.Set(teammember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateUpdatedAt() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldUpdatedAt)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamMemberUpsert) SetEmail(v string) *TeamMemberUpsert {
+	u.Set(teammember.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateEmail() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldEmail)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *TeamMemberUpsert) SetRole(v teammember.Role) *TeamMemberUpsert {
+	u.Set(teammember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateRole() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldRole)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamMemberUpsert) SetStatus(v teammember.Status) *TeamMemberUpsert {
+	u.Set(teammember.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateStatus() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldStatus)
+	return u
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (u *TeamMemberUpsert) SetInviteToken(v string) *TeamMemberUpsert {
+	u.Set(teammember.FieldInviteToken, v)
+	return u
+}
+
+// UpdateInviteToken sets the "invite_token" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateInviteToken() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldInviteToken)
+	return u
+}
+
+// ClearInviteToken clears the value of the "invite_token" field.
+func (u *TeamMemberUpsert) ClearInviteToken() *TeamMemberUpsert {
+	u.SetNull(teammember.FieldInviteToken)
+	return u
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (u *TeamMemberUpsert) SetInviteExpiryAt(v time.Time) *TeamMemberUpsert {
+	u.Set(teammember.FieldInviteExpiryAt, v)
+	return u
+}
+
+// UpdateInviteExpiryAt sets the "invite_expiry_at" field to the value that was provided on create.
+func (u *TeamMemberUpsert) UpdateInviteExpiryAt() *TeamMemberUpsert {
+	u.SetExcluded(teammember.FieldInviteExpiryAt)
+	return u
+}
+
+// ClearInviteExpiryAt clears the value of the "invite_expiry_at" field.
+func (u *TeamMemberUpsert) ClearInviteExpiryAt() *TeamMemberUpsert {
+	u.SetNull(teammember.FieldInviteExpiryAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teammember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamMemberUpsertOne) UpdateNewValues() *TeamMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teammember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teammember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamMemberUpsertOne) Ignore() *TeamMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamMemberUpsertOne) DoNothing() *TeamMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamMemberCreate.OnConflict
+// documentation for more info.
+func (u *TeamMemberUpsertOne) Update(set func(*TeamMemberUpsert)) *TeamMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamMemberUpsertOne) SetUpdatedAt(v time.Time) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateUpdatedAt() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamMemberUpsertOne) SetEmail(v string) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateEmail() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *TeamMemberUpsertOne) SetRole(v teammember.Role) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateRole() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamMemberUpsertOne) SetStatus(v teammember.Status) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateStatus() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (u *TeamMemberUpsertOne) SetInviteToken(v string) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetInviteToken(v)
+	})
+}
+
+// UpdateInviteToken sets the "invite_token" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateInviteToken() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateInviteToken()
+	})
+}
+
+// ClearInviteToken clears the value of the "invite_token" field.
+func (u *TeamMemberUpsertOne) ClearInviteToken() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.ClearInviteToken()
+	})
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (u *TeamMemberUpsertOne) SetInviteExpiryAt(v time.Time) *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetInviteExpiryAt(v)
+	})
+}
+
+// UpdateInviteExpiryAt sets the "invite_expiry_at" field to the value that was provided on create.
+func (u *TeamMemberUpsertOne) UpdateInviteExpiryAt() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateInviteExpiryAt()
+	})
+}
+
+// ClearInviteExpiryAt clears the value of the "invite_expiry_at" field.
+func (u *TeamMemberUpsertOne) ClearInviteExpiryAt() *TeamMemberUpsertOne {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.ClearInviteExpiryAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamMemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TeamMemberUpsertOne.ID is not supported by MySQL driver. Use TeamMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamMemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamMemberCreateBulk is the builder for creating many TeamMember entities in bulk.
+type TeamMemberCreateBulk struct {
+	config
+	err      error
+	builders []*TeamMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamMember entities in the database.
+func (tmcb *TeamMemberCreateBulk) Save(ctx context.Context) ([]*TeamMember, error) {
+	if tmcb.err != nil {
+		return nil, tmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tmcb.builders))
+	nodes := make([]*TeamMember, len(tmcb.builders))
+	mutators := make([]Mutator, len(tmcb.builders))
+	for i := range tmcb.builders {
+		func(i int, root context.Context) {
+			builder := tmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmcb *TeamMemberCreateBulk) SaveX(ctx context.Context) []*TeamMember {
+	v, err := tmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmcb *TeamMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := tmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmcb *TeamMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := tmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tmcb *TeamMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamMemberUpsertBulk {
+	tmcb.conflict = opts
+	return &TeamMemberUpsertBulk{
+		create: tmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmcb *TeamMemberCreateBulk) OnConflictColumns(columns ...string) *TeamMemberUpsertBulk {
+	tmcb.conflict = append(tmcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamMemberUpsertBulk{
+		create: tmcb,
+	}
+}
+
+// TeamMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamMember nodes.
+type TeamMemberUpsertBulk struct {
+	create *TeamMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teammember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamMemberUpsertBulk) UpdateNewValues() *TeamMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teammember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teammember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamMemberUpsertBulk) Ignore() *TeamMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamMemberUpsertBulk) DoNothing() *TeamMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamMemberUpsertBulk) Update(set func(*TeamMemberUpsert)) *TeamMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamMemberUpsertBulk) SetUpdatedAt(v time.Time) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateUpdatedAt() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamMemberUpsertBulk) SetEmail(v string) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateEmail() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *TeamMemberUpsertBulk) SetRole(v teammember.Role) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateRole() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamMemberUpsertBulk) SetStatus(v teammember.Status) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateStatus() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (u *TeamMemberUpsertBulk) SetInviteToken(v string) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetInviteToken(v)
+	})
+}
+
+// UpdateInviteToken sets the "invite_token" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateInviteToken() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateInviteToken()
+	})
+}
+
+// ClearInviteToken clears the value of the "invite_token" field.
+func (u *TeamMemberUpsertBulk) ClearInviteToken() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.ClearInviteToken()
+	})
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (u *TeamMemberUpsertBulk) SetInviteExpiryAt(v time.Time) *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.SetInviteExpiryAt(v)
+	})
+}
+
+// UpdateInviteExpiryAt sets the "invite_expiry_at" field to the value that was provided on create.
+func (u *TeamMemberUpsertBulk) UpdateInviteExpiryAt() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.UpdateInviteExpiryAt()
+	})
+}
+
+// ClearInviteExpiryAt clears the value of the "invite_expiry_at" field.
+func (u *TeamMemberUpsertBulk) ClearInviteExpiryAt() *TeamMemberUpsertBulk {
+	return u.Update(func(s *TeamMemberUpsert) {
+		s.ClearInviteExpiryAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TeamMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamMemberUpdate is the builder for updating TeamMember entities.
+type TeamMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (tmu *TeamMemberUpdate) Where(ps ...predicate.TeamMember) *TeamMemberUpdate {
+	tmu.mutation.Where(ps...)
+	return tmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmu *TeamMemberUpdate) SetUpdatedAt(t time.Time) *TeamMemberUpdate {
+	tmu.mutation.SetUpdatedAt(t)
+	return tmu
+}
+
+// SetEmail sets the "email" field.
+func (tmu *TeamMemberUpdate) SetEmail(s string) *TeamMemberUpdate {
+	tmu.mutation.SetEmail(s)
+	return tmu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableEmail(s *string) *TeamMemberUpdate {
+	if s != nil {
+		tmu.SetEmail(*s)
+	}
+	return tmu
+}
+
+// SetRole sets the "role" field.
+func (tmu *TeamMemberUpdate) SetRole(t teammember.Role) *TeamMemberUpdate {
+	tmu.mutation.SetRole(t)
+	return tmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableRole(t *teammember.Role) *TeamMemberUpdate {
+	if t != nil {
+		tmu.SetRole(*t)
+	}
+	return tmu
+}
+
+// SetStatus sets the "status" field.
+func (tmu *TeamMemberUpdate) SetStatus(t teammember.Status) *TeamMemberUpdate {
+	tmu.mutation.SetStatus(t)
+	return tmu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableStatus(t *teammember.Status) *TeamMemberUpdate {
+	if t != nil {
+		tmu.SetStatus(*t)
+	}
+	return tmu
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (tmu *TeamMemberUpdate) SetInviteToken(s string) *TeamMemberUpdate {
+	tmu.mutation.SetInviteToken(s)
+	return tmu
+}
+
+// SetNillableInviteToken sets the "invite_token" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableInviteToken(s *string) *TeamMemberUpdate {
+	if s != nil {
+		tmu.SetInviteToken(*s)
+	}
+	return tmu
+}
+
+// ClearInviteToken clears the value of the "invite_token" field.
+func (tmu *TeamMemberUpdate) ClearInviteToken() *TeamMemberUpdate {
+	tmu.mutation.ClearInviteToken()
+	return tmu
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (tmu *TeamMemberUpdate) SetInviteExpiryAt(t time.Time) *TeamMemberUpdate {
+	tmu.mutation.SetInviteExpiryAt(t)
+	return tmu
+}
+
+// SetNillableInviteExpiryAt sets the "invite_expiry_at" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableInviteExpiryAt(t *time.Time) *TeamMemberUpdate {
+	if t != nil {
+		tmu.SetInviteExpiryAt(*t)
+	}
+	return tmu
+}
+
+// ClearInviteExpiryAt clears the value of the "invite_expiry_at" field.
+func (tmu *TeamMemberUpdate) ClearInviteExpiryAt() *TeamMemberUpdate {
+	tmu.mutation.ClearInviteExpiryAt()
+	return tmu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tmu *TeamMemberUpdate) SetUserID(id uuid.UUID) *TeamMemberUpdate {
+	tmu.mutation.SetUserID(id)
+	return tmu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableUserID(id *uuid.UUID) *TeamMemberUpdate {
+	if id != nil {
+		tmu = tmu.SetUserID(*id)
+	}
+	return tmu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tmu *TeamMemberUpdate) SetUser(u *User) *TeamMemberUpdate {
+	return tmu.SetUserID(u.ID)
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (tmu *TeamMemberUpdate) SetSenderProfileID(id uuid.UUID) *TeamMemberUpdate {
+	tmu.mutation.SetSenderProfileID(id)
+	return tmu
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableSenderProfileID(id *uuid.UUID) *TeamMemberUpdate {
+	if id != nil {
+		tmu = tmu.SetSenderProfileID(*id)
+	}
+	return tmu
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (tmu *TeamMemberUpdate) SetSenderProfile(s *SenderProfile) *TeamMemberUpdate {
+	return tmu.SetSenderProfileID(s.ID)
+}
+
+// SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
+func (tmu *TeamMemberUpdate) SetProviderProfileID(id string) *TeamMemberUpdate {
+	tmu.mutation.SetProviderProfileID(id)
+	return tmu
+}
+
+// SetNillableProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableProviderProfileID(id *string) *TeamMemberUpdate {
+	if id != nil {
+		tmu = tmu.SetProviderProfileID(*id)
+	}
+	return tmu
+}
+
+// SetProviderProfile sets the "provider_profile" edge to the ProviderProfile entity.
+func (tmu *TeamMemberUpdate) SetProviderProfile(p *ProviderProfile) *TeamMemberUpdate {
+	return tmu.SetProviderProfileID(p.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (tmu *TeamMemberUpdate) Mutation() *TeamMemberMutation {
+	return tmu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tmu *TeamMemberUpdate) ClearUser() *TeamMemberUpdate {
+	tmu.mutation.ClearUser()
+	return tmu
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (tmu *TeamMemberUpdate) ClearSenderProfile() *TeamMemberUpdate {
+	tmu.mutation.ClearSenderProfile()
+	return tmu
+}
+
+// ClearProviderProfile clears the "provider_profile" edge to the ProviderProfile entity.
+func (tmu *TeamMemberUpdate) ClearProviderProfile() *TeamMemberUpdate {
+	tmu.mutation.ClearProviderProfile()
+	return tmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmu *TeamMemberUpdate) Save(ctx context.Context) (int, error) {
+	if err := tmu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tmu.sqlSave, tmu.mutation, tmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmu *TeamMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmu *TeamMemberUpdate) Exec(ctx context.Context) error {
+	_, err := tmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmu *TeamMemberUpdate) ExecX(ctx context.Context) {
+	if err := tmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmu *TeamMemberUpdate) defaults() error {
+	if _, ok := tmu.mutation.UpdatedAt(); !ok {
+		if teammember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized teammember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := teammember.UpdateDefaultUpdatedAt()
+		tmu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmu *TeamMemberUpdate) check() error {
+	if v, ok := tmu.mutation.Role(); ok {
+		if err := teammember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMember.role": %w`, err)}
+		}
+	}
+	if v, ok := tmu.mutation.Status(); ok {
+		if err := teammember.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamMember.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tmu *TeamMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	if ps := tmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teammember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmu.mutation.Email(); ok {
+		_spec.SetField(teammember.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := tmu.mutation.Role(); ok {
+		_spec.SetField(teammember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := tmu.mutation.Status(); ok {
+		_spec.SetField(teammember.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tmu.mutation.InviteToken(); ok {
+		_spec.SetField(teammember.FieldInviteToken, field.TypeString, value)
+	}
+	if tmu.mutation.InviteTokenCleared() {
+		_spec.ClearField(teammember.FieldInviteToken, field.TypeString)
+	}
+	if value, ok := tmu.mutation.InviteExpiryAt(); ok {
+		_spec.SetField(teammember.FieldInviteExpiryAt, field.TypeTime, value)
+	}
+	if tmu.mutation.InviteExpiryAtCleared() {
+		_spec.ClearField(teammember.FieldInviteExpiryAt, field.TypeTime)
+	}
+	if tmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.UserTable,
+			Columns: []string{teammember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.UserTable,
+			Columns: []string{teammember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmu.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.SenderProfileTable,
+			Columns: []string{teammember.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.SenderProfileTable,
+			Columns: []string{teammember.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmu.mutation.ProviderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.ProviderProfileTable,
+			Columns: []string{teammember.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.ProviderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.ProviderProfileTable,
+			Columns: []string{teammember.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tmu.mutation.done = true
+	return n, nil
+}
+
+// TeamMemberUpdateOne is the builder for updating a single TeamMember entity.
+type TeamMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmuo *TeamMemberUpdateOne) SetUpdatedAt(t time.Time) *TeamMemberUpdateOne {
+	tmuo.mutation.SetUpdatedAt(t)
+	return tmuo
+}
+
+// SetEmail sets the "email" field.
+func (tmuo *TeamMemberUpdateOne) SetEmail(s string) *TeamMemberUpdateOne {
+	tmuo.mutation.SetEmail(s)
+	return tmuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableEmail(s *string) *TeamMemberUpdateOne {
+	if s != nil {
+		tmuo.SetEmail(*s)
+	}
+	return tmuo
+}
+
+// SetRole sets the "role" field.
+func (tmuo *TeamMemberUpdateOne) SetRole(t teammember.Role) *TeamMemberUpdateOne {
+	tmuo.mutation.SetRole(t)
+	return tmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableRole(t *teammember.Role) *TeamMemberUpdateOne {
+	if t != nil {
+		tmuo.SetRole(*t)
+	}
+	return tmuo
+}
+
+// SetStatus sets the "status" field.
+func (tmuo *TeamMemberUpdateOne) SetStatus(t teammember.Status) *TeamMemberUpdateOne {
+	tmuo.mutation.SetStatus(t)
+	return tmuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableStatus(t *teammember.Status) *TeamMemberUpdateOne {
+	if t != nil {
+		tmuo.SetStatus(*t)
+	}
+	return tmuo
+}
+
+// SetInviteToken sets the "invite_token" field.
+func (tmuo *TeamMemberUpdateOne) SetInviteToken(s string) *TeamMemberUpdateOne {
+	tmuo.mutation.SetInviteToken(s)
+	return tmuo
+}
+
+// SetNillableInviteToken sets the "invite_token" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableInviteToken(s *string) *TeamMemberUpdateOne {
+	if s != nil {
+		tmuo.SetInviteToken(*s)
+	}
+	return tmuo
+}
+
+// ClearInviteToken clears the value of the "invite_token" field.
+func (tmuo *TeamMemberUpdateOne) ClearInviteToken() *TeamMemberUpdateOne {
+	tmuo.mutation.ClearInviteToken()
+	return tmuo
+}
+
+// SetInviteExpiryAt sets the "invite_expiry_at" field.
+func (tmuo *TeamMemberUpdateOne) SetInviteExpiryAt(t time.Time) *TeamMemberUpdateOne {
+	tmuo.mutation.SetInviteExpiryAt(t)
+	return tmuo
+}
+
+// SetNillableInviteExpiryAt sets the "invite_expiry_at" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableInviteExpiryAt(t *time.Time) *TeamMemberUpdateOne {
+	if t != nil {
+		tmuo.SetInviteExpiryAt(*t)
+	}
+	return tmuo
+}
+
+// ClearInviteExpiryAt clears the value of the "invite_expiry_at" field.
+func (tmuo *TeamMemberUpdateOne) ClearInviteExpiryAt() *TeamMemberUpdateOne {
+	tmuo.mutation.ClearInviteExpiryAt()
+	return tmuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tmuo *TeamMemberUpdateOne) SetUserID(id uuid.UUID) *TeamMemberUpdateOne {
+	tmuo.mutation.SetUserID(id)
+	return tmuo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableUserID(id *uuid.UUID) *TeamMemberUpdateOne {
+	if id != nil {
+		tmuo = tmuo.SetUserID(*id)
+	}
+	return tmuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tmuo *TeamMemberUpdateOne) SetUser(u *User) *TeamMemberUpdateOne {
+	return tmuo.SetUserID(u.ID)
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (tmuo *TeamMemberUpdateOne) SetSenderProfileID(id uuid.UUID) *TeamMemberUpdateOne {
+	tmuo.mutation.SetSenderProfileID(id)
+	return tmuo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *TeamMemberUpdateOne {
+	if id != nil {
+		tmuo = tmuo.SetSenderProfileID(*id)
+	}
+	return tmuo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (tmuo *TeamMemberUpdateOne) SetSenderProfile(s *SenderProfile) *TeamMemberUpdateOne {
+	return tmuo.SetSenderProfileID(s.ID)
+}
+
+// SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
+func (tmuo *TeamMemberUpdateOne) SetProviderProfileID(id string) *TeamMemberUpdateOne {
+	tmuo.mutation.SetProviderProfileID(id)
+	return tmuo
+}
+
+// SetNillableProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableProviderProfileID(id *string) *TeamMemberUpdateOne {
+	if id != nil {
+		tmuo = tmuo.SetProviderProfileID(*id)
+	}
+	return tmuo
+}
+
+// SetProviderProfile sets the "provider_profile" edge to the ProviderProfile entity.
+func (tmuo *TeamMemberUpdateOne) SetProviderProfile(p *ProviderProfile) *TeamMemberUpdateOne {
+	return tmuo.SetProviderProfileID(p.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (tmuo *TeamMemberUpdateOne) Mutation() *TeamMemberMutation {
+	return tmuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tmuo *TeamMemberUpdateOne) ClearUser() *TeamMemberUpdateOne {
+	tmuo.mutation.ClearUser()
+	return tmuo
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (tmuo *TeamMemberUpdateOne) ClearSenderProfile() *TeamMemberUpdateOne {
+	tmuo.mutation.ClearSenderProfile()
+	return tmuo
+}
+
+// ClearProviderProfile clears the "provider_profile" edge to the ProviderProfile entity.
+func (tmuo *TeamMemberUpdateOne) ClearProviderProfile() *TeamMemberUpdateOne {
+	tmuo.mutation.ClearProviderProfile()
+	return tmuo
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (tmuo *TeamMemberUpdateOne) Where(ps ...predicate.TeamMember) *TeamMemberUpdateOne {
+	tmuo.mutation.Where(ps...)
+	return tmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmuo *TeamMemberUpdateOne) Select(field string, fields ...string) *TeamMemberUpdateOne {
+	tmuo.fields = append([]string{field}, fields...)
+	return tmuo
+}
+
+// Save executes the query and returns the updated TeamMember entity.
+func (tmuo *TeamMemberUpdateOne) Save(ctx context.Context) (*TeamMember, error) {
+	if err := tmuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tmuo.sqlSave, tmuo.mutation, tmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmuo *TeamMemberUpdateOne) SaveX(ctx context.Context) *TeamMember {
+	node, err := tmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmuo *TeamMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmuo *TeamMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := tmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmuo *TeamMemberUpdateOne) defaults() error {
+	if _, ok := tmuo.mutation.UpdatedAt(); !ok {
+		if teammember.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized teammember.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := teammember.UpdateDefaultUpdatedAt()
+		tmuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmuo *TeamMemberUpdateOne) check() error {
+	if v, ok := tmuo.mutation.Role(); ok {
+		if err := teammember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMember.role": %w`, err)}
+		}
+	}
+	if v, ok := tmuo.mutation.Status(); ok {
+		if err := teammember.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamMember.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tmuo *TeamMemberUpdateOne) sqlSave(ctx context.Context) (_node *TeamMember, err error) {
+	if err := tmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	id, ok := tmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teammember.FieldID)
+		for _, f := range fields {
+			if !teammember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teammember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teammember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmuo.mutation.Email(); ok {
+		_spec.SetField(teammember.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := tmuo.mutation.Role(); ok {
+		_spec.SetField(teammember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := tmuo.mutation.Status(); ok {
+		_spec.SetField(teammember.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tmuo.mutation.InviteToken(); ok {
+		_spec.SetField(teammember.FieldInviteToken, field.TypeString, value)
+	}
+	if tmuo.mutation.InviteTokenCleared() {
+		_spec.ClearField(teammember.FieldInviteToken, field.TypeString)
+	}
+	if value, ok := tmuo.mutation.InviteExpiryAt(); ok {
+		_spec.SetField(teammember.FieldInviteExpiryAt, field.TypeTime, value)
+	}
+	if tmuo.mutation.InviteExpiryAtCleared() {
+		_spec.ClearField(teammember.FieldInviteExpiryAt, field.TypeTime)
+	}
+	if tmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.UserTable,
+			Columns: []string{teammember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.UserTable,
+			Columns: []string{teammember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmuo.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.SenderProfileTable,
+			Columns: []string{teammember.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.SenderProfileTable,
+			Columns: []string{teammember.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmuo.mutation.ProviderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.ProviderProfileTable,
+			Columns: []string{teammember.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.ProviderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.ProviderProfileTable,
+			Columns: []string{teammember.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TeamMember{config: tmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tmuo.mutation.done = true
+	return _node, nil
+}
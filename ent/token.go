@@ -47,9 +47,13 @@ type TokenEdges struct {
 	LockPaymentOrders []*LockPaymentOrder `json:"lock_payment_orders,omitempty"`
 	// SenderSettings holds the value of the sender_settings edge.
 	SenderSettings []*SenderOrderToken `json:"sender_settings,omitempty"`
+	// PaymentOrderBatches holds the value of the payment_order_batches edge.
+	PaymentOrderBatches []*PaymentOrderBatch `json:"payment_order_batches,omitempty"`
+	// ScheduledOrders holds the value of the scheduled_orders edge.
+	ScheduledOrders []*ScheduledOrder `json:"scheduled_orders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // NetworkOrErr returns the Network value or an error if the edge
@@ -90,6 +94,24 @@ func (e TokenEdges) SenderSettingsOrErr() ([]*SenderOrderToken, error) {
 	return nil, &NotLoadedError{edge: "sender_settings"}
 }
 
+// PaymentOrderBatchesOrErr returns the PaymentOrderBatches value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenEdges) PaymentOrderBatchesOrErr() ([]*PaymentOrderBatch, error) {
+	if e.loadedTypes[4] {
+		return e.PaymentOrderBatches, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_order_batches"}
+}
+
+// ScheduledOrdersOrErr returns the ScheduledOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenEdges) ScheduledOrdersOrErr() ([]*ScheduledOrder, error) {
+	if e.loadedTypes[5] {
+		return e.ScheduledOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_orders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Token) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -202,6 +224,16 @@ func (t *Token) QuerySenderSettings() *SenderOrderTokenQuery {
 	return NewTokenClient(t.config).QuerySenderSettings(t)
 }
 
+// QueryPaymentOrderBatches queries the "payment_order_batches" edge of the Token entity.
+func (t *Token) QueryPaymentOrderBatches() *PaymentOrderBatchQuery {
+	return NewTokenClient(t.config).QueryPaymentOrderBatches(t)
+}
+
+// QueryScheduledOrders queries the "scheduled_orders" edge of the Token entity.
+func (t *Token) QueryScheduledOrders() *ScheduledOrderQuery {
+	return NewTokenClient(t.config).QueryScheduledOrders(t)
+}
+
 // Update returns a builder for updating this Token.
 // Note that you need to call Token.Unwrap() before calling this method if this Token
 // was returned from a transaction, and the transaction was committed or rolled back.
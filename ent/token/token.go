@@ -34,6 +34,10 @@ const (
 	EdgeLockPaymentOrders = "lock_payment_orders"
 	// EdgeSenderSettings holds the string denoting the sender_settings edge name in mutations.
 	EdgeSenderSettings = "sender_settings"
+	// EdgePaymentOrderBatches holds the string denoting the payment_order_batches edge name in mutations.
+	EdgePaymentOrderBatches = "payment_order_batches"
+	// EdgeScheduledOrders holds the string denoting the scheduled_orders edge name in mutations.
+	EdgeScheduledOrders = "scheduled_orders"
 	// Table holds the table name of the token in the database.
 	Table = "tokens"
 	// NetworkTable is the table that holds the network relation/edge.
@@ -64,6 +68,20 @@ const (
 	SenderSettingsInverseTable = "sender_order_tokens"
 	// SenderSettingsColumn is the table column denoting the sender_settings relation/edge.
 	SenderSettingsColumn = "token_sender_settings"
+	// PaymentOrderBatchesTable is the table that holds the payment_order_batches relation/edge.
+	PaymentOrderBatchesTable = "payment_order_batches"
+	// PaymentOrderBatchesInverseTable is the table name for the PaymentOrderBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorderbatch" package.
+	PaymentOrderBatchesInverseTable = "payment_order_batches"
+	// PaymentOrderBatchesColumn is the table column denoting the payment_order_batches relation/edge.
+	PaymentOrderBatchesColumn = "token_payment_order_batches"
+	// ScheduledOrdersTable is the table that holds the scheduled_orders relation/edge.
+	ScheduledOrdersTable = "scheduled_orders"
+	// ScheduledOrdersInverseTable is the table name for the ScheduledOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledorder" package.
+	ScheduledOrdersInverseTable = "scheduled_orders"
+	// ScheduledOrdersColumn is the table column denoting the scheduled_orders relation/edge.
+	ScheduledOrdersColumn = "token_scheduled_orders"
 )
 
 // Columns holds all SQL columns for token fields.
@@ -199,6 +217,34 @@ func BySenderSettings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSenderSettingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPaymentOrderBatchesCount orders the results by payment_order_batches count.
+func ByPaymentOrderBatchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentOrderBatchesStep(), opts...)
+	}
+}
+
+// ByPaymentOrderBatches orders the results by payment_order_batches terms.
+func ByPaymentOrderBatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderBatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledOrdersCount orders the results by scheduled_orders count.
+func ByScheduledOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledOrdersStep(), opts...)
+	}
+}
+
+// ByScheduledOrders orders the results by scheduled_orders terms.
+func ByScheduledOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newNetworkStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -227,3 +273,17 @@ func newSenderSettingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SenderSettingsTable, SenderSettingsColumn),
 	)
 }
+func newPaymentOrderBatchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderBatchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrderBatchesTable, PaymentOrderBatchesColumn),
+	)
+}
+func newScheduledOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledOrdersTable, ScheduledOrdersColumn),
+	)
+}
@@ -437,6 +437,52 @@ func HasSenderSettingsWith(preds ...predicate.SenderOrderToken) predicate.Token
 	})
 }
 
+// HasPaymentOrderBatches applies the HasEdge predicate on the "payment_order_batches" edge.
+func HasPaymentOrderBatches() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrderBatchesTable, PaymentOrderBatchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderBatchesWith applies the HasEdge predicate on the "payment_order_batches" edge with a given conditions (other predicates).
+func HasPaymentOrderBatchesWith(preds ...predicate.PaymentOrderBatch) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := newPaymentOrderBatchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledOrders applies the HasEdge predicate on the "scheduled_orders" edge.
+func HasScheduledOrders() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledOrdersTable, ScheduledOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledOrdersWith applies the HasEdge predicate on the "scheduled_orders" edge with a given conditions (other predicates).
+func HasScheduledOrdersWith(preds ...predicate.ScheduledOrder) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := newScheduledOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Token) predicate.Token {
 	return predicate.Token(sql.AndPredicates(predicates...))
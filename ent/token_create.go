@@ -15,6 +15,8 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/token"
 )
@@ -143,6 +145,36 @@ func (tc *TokenCreate) AddSenderSettings(s ...*SenderOrderToken) *TokenCreate {
 	return tc.AddSenderSettingIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (tc *TokenCreate) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *TokenCreate {
+	tc.mutation.AddPaymentOrderBatchIDs(ids...)
+	return tc
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (tc *TokenCreate) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *TokenCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tc.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (tc *TokenCreate) AddScheduledOrderIDs(ids ...uuid.UUID) *TokenCreate {
+	tc.mutation.AddScheduledOrderIDs(ids...)
+	return tc
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (tc *TokenCreate) AddScheduledOrders(s ...*ScheduledOrder) *TokenCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tc *TokenCreate) Mutation() *TokenMutation {
 	return tc.mutation
@@ -341,6 +373,38 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,7 +15,9 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/token"
 )
@@ -23,15 +25,17 @@ import (
 // TokenQuery is the builder for querying Token entities.
 type TokenQuery struct {
 	config
-	ctx                   *QueryContext
-	order                 []token.OrderOption
-	inters                []Interceptor
-	predicates            []predicate.Token
-	withNetwork           *NetworkQuery
-	withPaymentOrders     *PaymentOrderQuery
-	withLockPaymentOrders *LockPaymentOrderQuery
-	withSenderSettings    *SenderOrderTokenQuery
-	withFKs               bool
+	ctx                     *QueryContext
+	order                   []token.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Token
+	withNetwork             *NetworkQuery
+	withPaymentOrders       *PaymentOrderQuery
+	withLockPaymentOrders   *LockPaymentOrderQuery
+	withSenderSettings      *SenderOrderTokenQuery
+	withPaymentOrderBatches *PaymentOrderBatchQuery
+	withScheduledOrders     *ScheduledOrderQuery
+	withFKs                 bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +160,50 @@ func (tq *TokenQuery) QuerySenderSettings() *SenderOrderTokenQuery {
 	return query
 }
 
+// QueryPaymentOrderBatches chains the current query on the "payment_order_batches" edge.
+func (tq *TokenQuery) QueryPaymentOrderBatches() *PaymentOrderBatchQuery {
+	query := (&PaymentOrderBatchClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, selector),
+			sqlgraph.To(paymentorderbatch.Table, paymentorderbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.PaymentOrderBatchesTable, token.PaymentOrderBatchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledOrders chains the current query on the "scheduled_orders" edge.
+func (tq *TokenQuery) QueryScheduledOrders() *ScheduledOrderQuery {
+	query := (&ScheduledOrderClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, selector),
+			sqlgraph.To(scheduledorder.Table, scheduledorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.ScheduledOrdersTable, token.ScheduledOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Token entity from the query.
 // Returns a *NotFoundError when no Token was found.
 func (tq *TokenQuery) First(ctx context.Context) (*Token, error) {
@@ -343,15 +391,17 @@ func (tq *TokenQuery) Clone() *TokenQuery {
 		return nil
 	}
 	return &TokenQuery{
-		config:                tq.config,
-		ctx:                   tq.ctx.Clone(),
-		order:                 append([]token.OrderOption{}, tq.order...),
-		inters:                append([]Interceptor{}, tq.inters...),
-		predicates:            append([]predicate.Token{}, tq.predicates...),
-		withNetwork:           tq.withNetwork.Clone(),
-		withPaymentOrders:     tq.withPaymentOrders.Clone(),
-		withLockPaymentOrders: tq.withLockPaymentOrders.Clone(),
-		withSenderSettings:    tq.withSenderSettings.Clone(),
+		config:                  tq.config,
+		ctx:                     tq.ctx.Clone(),
+		order:                   append([]token.OrderOption{}, tq.order...),
+		inters:                  append([]Interceptor{}, tq.inters...),
+		predicates:              append([]predicate.Token{}, tq.predicates...),
+		withNetwork:             tq.withNetwork.Clone(),
+		withPaymentOrders:       tq.withPaymentOrders.Clone(),
+		withLockPaymentOrders:   tq.withLockPaymentOrders.Clone(),
+		withSenderSettings:      tq.withSenderSettings.Clone(),
+		withPaymentOrderBatches: tq.withPaymentOrderBatches.Clone(),
+		withScheduledOrders:     tq.withScheduledOrders.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -402,6 +452,28 @@ func (tq *TokenQuery) WithSenderSettings(opts ...func(*SenderOrderTokenQuery)) *
 	return tq
 }
 
+// WithPaymentOrderBatches tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order_batches" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TokenQuery) WithPaymentOrderBatches(opts ...func(*PaymentOrderBatchQuery)) *TokenQuery {
+	query := (&PaymentOrderBatchClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withPaymentOrderBatches = query
+	return tq
+}
+
+// WithScheduledOrders tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TokenQuery) WithScheduledOrders(opts ...func(*ScheduledOrderQuery)) *TokenQuery {
+	query := (&ScheduledOrderClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withScheduledOrders = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +553,13 @@ func (tq *TokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Token,
 		nodes       = []*Token{}
 		withFKs     = tq.withFKs
 		_spec       = tq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			tq.withNetwork != nil,
 			tq.withPaymentOrders != nil,
 			tq.withLockPaymentOrders != nil,
 			tq.withSenderSettings != nil,
+			tq.withPaymentOrderBatches != nil,
+			tq.withScheduledOrders != nil,
 		}
 	)
 	if tq.withNetwork != nil {
@@ -539,6 +613,22 @@ func (tq *TokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Token,
 			return nil, err
 		}
 	}
+	if query := tq.withPaymentOrderBatches; query != nil {
+		if err := tq.loadPaymentOrderBatches(ctx, query, nodes,
+			func(n *Token) { n.Edges.PaymentOrderBatches = []*PaymentOrderBatch{} },
+			func(n *Token, e *PaymentOrderBatch) {
+				n.Edges.PaymentOrderBatches = append(n.Edges.PaymentOrderBatches, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withScheduledOrders; query != nil {
+		if err := tq.loadScheduledOrders(ctx, query, nodes,
+			func(n *Token) { n.Edges.ScheduledOrders = []*ScheduledOrder{} },
+			func(n *Token, e *ScheduledOrder) { n.Edges.ScheduledOrders = append(n.Edges.ScheduledOrders, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,6 +757,68 @@ func (tq *TokenQuery) loadSenderSettings(ctx context.Context, query *SenderOrder
 	}
 	return nil
 }
+func (tq *TokenQuery) loadPaymentOrderBatches(ctx context.Context, query *PaymentOrderBatchQuery, nodes []*Token, init func(*Token), assign func(*Token, *PaymentOrderBatch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Token)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentOrderBatch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(token.PaymentOrderBatchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.token_payment_order_batches
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "token_payment_order_batches" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_payment_order_batches" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TokenQuery) loadScheduledOrders(ctx context.Context, query *ScheduledOrderQuery, nodes []*Token, init func(*Token), assign func(*Token, *ScheduledOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Token)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScheduledOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(token.ScheduledOrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.token_scheduled_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "token_scheduled_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_scheduled_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -15,7 +15,9 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/token"
 )
@@ -158,6 +160,36 @@ func (tu *TokenUpdate) AddSenderSettings(s ...*SenderOrderToken) *TokenUpdate {
 	return tu.AddSenderSettingIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (tu *TokenUpdate) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.AddPaymentOrderBatchIDs(ids...)
+	return tu
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (tu *TokenUpdate) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *TokenUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (tu *TokenUpdate) AddScheduledOrderIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.AddScheduledOrderIDs(ids...)
+	return tu
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (tu *TokenUpdate) AddScheduledOrders(s ...*ScheduledOrder) *TokenUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tu *TokenUpdate) Mutation() *TokenMutation {
 	return tu.mutation
@@ -232,6 +264,48 @@ func (tu *TokenUpdate) RemoveSenderSettings(s ...*SenderOrderToken) *TokenUpdate
 	return tu.RemoveSenderSettingIDs(ids...)
 }
 
+// ClearPaymentOrderBatches clears all "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (tu *TokenUpdate) ClearPaymentOrderBatches() *TokenUpdate {
+	tu.mutation.ClearPaymentOrderBatches()
+	return tu
+}
+
+// RemovePaymentOrderBatchIDs removes the "payment_order_batches" edge to PaymentOrderBatch entities by IDs.
+func (tu *TokenUpdate) RemovePaymentOrderBatchIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.RemovePaymentOrderBatchIDs(ids...)
+	return tu
+}
+
+// RemovePaymentOrderBatches removes "payment_order_batches" edges to PaymentOrderBatch entities.
+func (tu *TokenUpdate) RemovePaymentOrderBatches(p ...*PaymentOrderBatch) *TokenUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemovePaymentOrderBatchIDs(ids...)
+}
+
+// ClearScheduledOrders clears all "scheduled_orders" edges to the ScheduledOrder entity.
+func (tu *TokenUpdate) ClearScheduledOrders() *TokenUpdate {
+	tu.mutation.ClearScheduledOrders()
+	return tu
+}
+
+// RemoveScheduledOrderIDs removes the "scheduled_orders" edge to ScheduledOrder entities by IDs.
+func (tu *TokenUpdate) RemoveScheduledOrderIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.RemoveScheduledOrderIDs(ids...)
+	return tu
+}
+
+// RemoveScheduledOrders removes "scheduled_orders" edges to ScheduledOrder entities.
+func (tu *TokenUpdate) RemoveScheduledOrders(s ...*ScheduledOrder) *TokenUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveScheduledOrderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TokenUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -480,6 +554,96 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedPaymentOrderBatchesIDs(); len(nodes) > 0 && !tu.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedScheduledOrdersIDs(); len(nodes) > 0 && !tu.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
@@ -625,6 +789,36 @@ func (tuo *TokenUpdateOne) AddSenderSettings(s ...*SenderOrderToken) *TokenUpdat
 	return tuo.AddSenderSettingIDs(ids...)
 }
 
+// AddPaymentOrderBatchIDs adds the "payment_order_batches" edge to the PaymentOrderBatch entity by IDs.
+func (tuo *TokenUpdateOne) AddPaymentOrderBatchIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.AddPaymentOrderBatchIDs(ids...)
+	return tuo
+}
+
+// AddPaymentOrderBatches adds the "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (tuo *TokenUpdateOne) AddPaymentOrderBatches(p ...*PaymentOrderBatch) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddPaymentOrderBatchIDs(ids...)
+}
+
+// AddScheduledOrderIDs adds the "scheduled_orders" edge to the ScheduledOrder entity by IDs.
+func (tuo *TokenUpdateOne) AddScheduledOrderIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.AddScheduledOrderIDs(ids...)
+	return tuo
+}
+
+// AddScheduledOrders adds the "scheduled_orders" edges to the ScheduledOrder entity.
+func (tuo *TokenUpdateOne) AddScheduledOrders(s ...*ScheduledOrder) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddScheduledOrderIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tuo *TokenUpdateOne) Mutation() *TokenMutation {
 	return tuo.mutation
@@ -699,6 +893,48 @@ func (tuo *TokenUpdateOne) RemoveSenderSettings(s ...*SenderOrderToken) *TokenUp
 	return tuo.RemoveSenderSettingIDs(ids...)
 }
 
+// ClearPaymentOrderBatches clears all "payment_order_batches" edges to the PaymentOrderBatch entity.
+func (tuo *TokenUpdateOne) ClearPaymentOrderBatches() *TokenUpdateOne {
+	tuo.mutation.ClearPaymentOrderBatches()
+	return tuo
+}
+
+// RemovePaymentOrderBatchIDs removes the "payment_order_batches" edge to PaymentOrderBatch entities by IDs.
+func (tuo *TokenUpdateOne) RemovePaymentOrderBatchIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.RemovePaymentOrderBatchIDs(ids...)
+	return tuo
+}
+
+// RemovePaymentOrderBatches removes "payment_order_batches" edges to PaymentOrderBatch entities.
+func (tuo *TokenUpdateOne) RemovePaymentOrderBatches(p ...*PaymentOrderBatch) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemovePaymentOrderBatchIDs(ids...)
+}
+
+// ClearScheduledOrders clears all "scheduled_orders" edges to the ScheduledOrder entity.
+func (tuo *TokenUpdateOne) ClearScheduledOrders() *TokenUpdateOne {
+	tuo.mutation.ClearScheduledOrders()
+	return tuo
+}
+
+// RemoveScheduledOrderIDs removes the "scheduled_orders" edge to ScheduledOrder entities by IDs.
+func (tuo *TokenUpdateOne) RemoveScheduledOrderIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.RemoveScheduledOrderIDs(ids...)
+	return tuo
+}
+
+// RemoveScheduledOrders removes "scheduled_orders" edges to ScheduledOrder entities.
+func (tuo *TokenUpdateOne) RemoveScheduledOrders(s ...*ScheduledOrder) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveScheduledOrderIDs(ids...)
+}
+
 // Where appends a list predicates to the TokenUpdate builder.
 func (tuo *TokenUpdateOne) Where(ps ...predicate.Token) *TokenUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -977,6 +1213,96 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedPaymentOrderBatchesIDs(); len(nodes) > 0 && !tuo.mutation.PaymentOrderBatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.PaymentOrderBatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.PaymentOrderBatchesTable,
+			Columns: []string{token.PaymentOrderBatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorderbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedScheduledOrdersIDs(); len(nodes) > 0 && !tuo.mutation.ScheduledOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ScheduledOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.ScheduledOrdersTable,
+			Columns: []string{token.ScheduledOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Token{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
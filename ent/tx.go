@@ -14,6 +14,10 @@ type Tx struct {
 	config
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// Beneficiary is the client for interacting with the Beneficiary builders.
+	Beneficiary *BeneficiaryClient
+	// Dispute is the client for interacting with the Dispute builders.
+	Dispute *DisputeClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -30,22 +34,38 @@ type Tx struct {
 	Network *NetworkClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
+	// PaymentOrderBatch is the client for interacting with the PaymentOrderBatch builders.
+	PaymentOrderBatch *PaymentOrderBatchClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
+	// PaymentOrderSplit is the client for interacting with the PaymentOrderSplit builders.
+	PaymentOrderSplit *PaymentOrderSplitClient
+	// ProviderFiatBalance is the client for interacting with the ProviderFiatBalance builders.
+	ProviderFiatBalance *ProviderFiatBalanceClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
+	// ProviderRateExclusion is the client for interacting with the ProviderRateExclusion builders.
+	ProviderRateExclusion *ProviderRateExclusionClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
+	// ScheduledOrder is the client for interacting with the ScheduledOrder builders.
+	ScheduledOrder *ScheduledOrderClient
+	// ScheduledOrderRun is the client for interacting with the ScheduledOrderRun builders.
+	ScheduledOrderRun *ScheduledOrderRunClient
+	// SenderFeeTier is the client for interacting with the SenderFeeTier builders.
+	SenderFeeTier *SenderFeeTierClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
@@ -188,6 +208,8 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.Beneficiary = NewBeneficiaryClient(tx.config)
+	tx.Dispute = NewDisputeClient(tx.config)
 	tx.FiatCurrency = NewFiatCurrencyClient(tx.config)
 	tx.IdentityVerificationRequest = NewIdentityVerificationRequestClient(tx.config)
 	tx.Institution = NewInstitutionClient(tx.config)
@@ -196,14 +218,22 @@ func (tx *Tx) init() {
 	tx.LockPaymentOrder = NewLockPaymentOrderClient(tx.config)
 	tx.Network = NewNetworkClient(tx.config)
 	tx.PaymentOrder = NewPaymentOrderClient(tx.config)
+	tx.PaymentOrderBatch = NewPaymentOrderBatchClient(tx.config)
 	tx.PaymentOrderRecipient = NewPaymentOrderRecipientClient(tx.config)
+	tx.PaymentOrderSplit = NewPaymentOrderSplitClient(tx.config)
+	tx.ProviderFiatBalance = NewProviderFiatBalanceClient(tx.config)
 	tx.ProviderOrderToken = NewProviderOrderTokenClient(tx.config)
 	tx.ProviderProfile = NewProviderProfileClient(tx.config)
+	tx.ProviderRateExclusion = NewProviderRateExclusionClient(tx.config)
 	tx.ProviderRating = NewProviderRatingClient(tx.config)
 	tx.ProvisionBucket = NewProvisionBucketClient(tx.config)
 	tx.ReceiveAddress = NewReceiveAddressClient(tx.config)
+	tx.ScheduledOrder = NewScheduledOrderClient(tx.config)
+	tx.ScheduledOrderRun = NewScheduledOrderRunClient(tx.config)
+	tx.SenderFeeTier = NewSenderFeeTierClient(tx.config)
 	tx.SenderOrderToken = NewSenderOrderTokenClient(tx.config)
 	tx.SenderProfile = NewSenderProfileClient(tx.config)
+	tx.TeamMember = NewTeamMemberClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.TransactionLog = NewTransactionLogClient(tx.config)
 	tx.User = NewUserClient(tx.config)
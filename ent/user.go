@@ -52,9 +52,11 @@ type UserEdges struct {
 	ProviderProfile *ProviderProfile `json:"provider_profile,omitempty"`
 	// VerificationToken holds the value of the verification_token edge.
 	VerificationToken []*VerificationToken `json:"verification_token,omitempty"`
+	// TeamMemberships holds the value of the team_memberships edge.
+	TeamMemberships []*TeamMember `json:"team_memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // SenderProfileOrErr returns the SenderProfile value or an error if the edge
@@ -88,6 +90,15 @@ func (e UserEdges) VerificationTokenOrErr() ([]*VerificationToken, error) {
 	return nil, &NotLoadedError{edge: "verification_token"}
 }
 
+// TeamMembershipsOrErr returns the TeamMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TeamMembershipsOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[3] {
+		return e.TeamMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "team_memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -204,6 +215,11 @@ func (u *User) QueryVerificationToken() *VerificationTokenQuery {
 	return NewUserClient(u.config).QueryVerificationToken(u)
 }
 
+// QueryTeamMemberships queries the "team_memberships" edge of the User entity.
+func (u *User) QueryTeamMemberships() *TeamMemberQuery {
+	return NewUserClient(u.config).QueryTeamMemberships(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/controllers"
 	"github.com/paycrest/aggregator/controllers/accounts"
+	"github.com/paycrest/aggregator/controllers/ops"
 	"github.com/paycrest/aggregator/controllers/provider"
 	"github.com/paycrest/aggregator/controllers/sender"
 	"github.com/paycrest/aggregator/routers/middleware"
@@ -24,6 +25,7 @@ func RegisterRoutes(route *gin.Engine) {
 	authRoutes(route)
 	senderRoutes(route)
 	providerRoutes(route)
+	opsRoutes(route)
 
 	ctrl := controllers.NewController()
 
@@ -56,6 +58,7 @@ func RegisterRoutes(route *gin.Engine) {
 
 func authRoutes(route *gin.Engine) {
 	authCtrl := accounts.NewAuthController()
+	teamCtrl := accounts.NewTeamController()
 	var profileCtrl accounts.ProfileController
 
 	v1 := route.Group("/v1/")
@@ -68,6 +71,7 @@ func authRoutes(route *gin.Engine) {
 	v1.PATCH("auth/reset-password", middleware.OnlyWebMiddleware, authCtrl.ResetPassword)
 	v1.PATCH("auth/change-password", middleware.JWTMiddleware, authCtrl.ChangePassword)
 	v1.DELETE("auth/delete-account", middleware.JWTMiddleware, authCtrl.DeleteAccount)
+	v1.POST("auth/accept-invite", middleware.OnlyWebMiddleware, teamCtrl.AcceptTeamInvite)
 
 	v1.GET(
 		"settings/provider",
@@ -81,9 +85,74 @@ func authRoutes(route *gin.Engine) {
 		middleware.OnlyWebMiddleware,
 		middleware.JWTMiddleware,
 		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
 		profileCtrl.UpdateProviderProfile,
 	)
 
+	v1.GET(
+		"settings/provider/team",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		teamCtrl.ListTeamMembers,
+	)
+	v1.POST(
+		"settings/provider/team",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.InviteTeamMember,
+	)
+	v1.PATCH(
+		"settings/provider/team/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.UpdateTeamMember,
+	)
+	v1.DELETE(
+		"settings/provider/team/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.RemoveTeamMember,
+	)
+
+	v1.GET(
+		"settings/provider/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		profileCtrl.ListAPIKeys,
+	)
+	v1.POST(
+		"settings/provider/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.CreateAPIKey,
+	)
+	v1.POST(
+		"settings/provider/api-keys/:id/rotate",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.RotateAPIKey,
+	)
+	v1.DELETE(
+		"settings/provider/api-keys/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.RevokeAPIKey,
+	)
+
 	v1.GET(
 		"settings/sender",
 		middleware.OnlyWebMiddleware,
@@ -96,8 +165,73 @@ func authRoutes(route *gin.Engine) {
 		middleware.OnlyWebMiddleware,
 		middleware.JWTMiddleware,
 		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
 		profileCtrl.UpdateSenderProfile,
 	)
+
+	v1.GET(
+		"settings/sender/team",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		teamCtrl.ListTeamMembers,
+	)
+	v1.POST(
+		"settings/sender/team",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.InviteTeamMember,
+	)
+	v1.PATCH(
+		"settings/sender/team/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.UpdateTeamMember,
+	)
+	v1.DELETE(
+		"settings/sender/team/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		teamCtrl.RemoveTeamMember,
+	)
+
+	v1.GET(
+		"settings/sender/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.ListAPIKeys,
+	)
+	v1.POST(
+		"settings/sender/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.CreateAPIKey,
+	)
+	v1.POST(
+		"settings/sender/api-keys/:id/rotate",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.RotateAPIKey,
+	)
+	v1.DELETE(
+		"settings/sender/api-keys/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.IdempotencyMiddleware,
+		profileCtrl.RevokeAPIKey,
+	)
 }
 
 func senderRoutes(route *gin.Engine) {
@@ -106,11 +240,34 @@ func senderRoutes(route *gin.Engine) {
 	v1 := route.Group("/v1/sender/")
 	v1.Use(middleware.DynamicAuthMiddleware)
 	v1.Use(middleware.OnlySenderMiddleware)
+	v1.Use(middleware.IdempotencyMiddleware)
 
 	v1.POST("orders", senderCtrl.InitiatePaymentOrder)
+	v1.GET("orders/export", senderCtrl.ExportPaymentOrders)
+	v1.POST("orders/batch", senderCtrl.CreatePaymentOrderBatch)
+	v1.GET("orders/batch/:id", senderCtrl.GetPaymentOrderBatch)
+	v1.POST("quotes", senderCtrl.CreateRateQuote)
 	v1.GET("orders/:id", senderCtrl.GetPaymentOrderByID)
+	v1.POST("orders/:id/cancel", senderCtrl.CancelPaymentOrder)
+	v1.POST("orders/:id/disputes", senderCtrl.OpenDispute)
+	v1.GET("orders/:id/disputes", senderCtrl.GetPaymentOrderDisputes)
 	v1.GET("orders", senderCtrl.GetPaymentOrders)
 	v1.GET("stats", senderCtrl.Stats)
+	v1.POST("beneficiaries", senderCtrl.CreateBeneficiary)
+	v1.GET("beneficiaries", senderCtrl.GetBeneficiaries)
+	v1.GET("beneficiaries/:id", senderCtrl.GetBeneficiaryByID)
+	v1.PUT("beneficiaries/:id", senderCtrl.UpdateBeneficiary)
+	v1.DELETE("beneficiaries/:id", senderCtrl.DeleteBeneficiary)
+	v1.POST("scheduled-orders", senderCtrl.CreateScheduledOrder)
+	v1.GET("scheduled-orders", senderCtrl.GetScheduledOrders)
+	v1.GET("scheduled-orders/:id", senderCtrl.GetScheduledOrderByID)
+	v1.GET("scheduled-orders/:id/runs", senderCtrl.GetScheduledOrderRuns)
+	v1.POST("scheduled-orders/:id/pause", senderCtrl.PauseScheduledOrder)
+	v1.POST("scheduled-orders/:id/resume", senderCtrl.ResumeScheduledOrder)
+	v1.DELETE("scheduled-orders/:id", senderCtrl.CancelScheduledOrder)
+	v1.POST("sandbox/orders/:id/deposit", senderCtrl.SimulateSandboxDeposit)
+	v1.POST("sandbox/orders/:id/settle", senderCtrl.SimulateSandboxSettlement)
+	v1.POST("sandbox/orders/:id/refund", senderCtrl.SimulateSandboxRefund)
 }
 
 func providerRoutes(route *gin.Engine) {
@@ -119,8 +276,10 @@ func providerRoutes(route *gin.Engine) {
 	v1 := route.Group("/v1/provider/")
 	v1.Use(middleware.DynamicAuthMiddleware)
 	v1.Use(middleware.OnlyProviderMiddleware)
+	v1.Use(middleware.IdempotencyMiddleware)
 
 	v1.GET("orders", providerCtrl.GetLockPaymentOrders)
+	v1.GET("orders/export", providerCtrl.ExportLockPaymentOrders)
 	v1.POST("orders/:id/accept", providerCtrl.AcceptOrder)
 	v1.POST("orders/:id/decline", providerCtrl.DeclineOrder)
 	v1.POST("orders/:id/fulfill", providerCtrl.FulfillOrder)
@@ -128,4 +287,22 @@ func providerRoutes(route *gin.Engine) {
 	v1.GET("rates/:token/:fiat", providerCtrl.GetMarketRate)
 	v1.GET("stats", providerCtrl.Stats)
 	v1.GET("node-info", providerCtrl.NodeInfo)
+	v1.GET("balances", providerCtrl.GetBalances)
+	v1.PUT("balances", providerCtrl.UpdateBalances)
+	v1.GET("trust-score", providerCtrl.GetTrustScore)
+	v1.GET("rate-exclusions", providerCtrl.GetRateExclusions)
+	v1.GET("disputes", providerCtrl.GetDisputes)
+	v1.POST("disputes/:id/evidence", providerCtrl.SubmitDisputeEvidence)
+	v1.GET("stream", providerCtrl.Stream)
+}
+
+func opsRoutes(route *gin.Engine) {
+	opsCtrl := ops.NewOpsController()
+
+	v1 := route.Group("/v1/ops/")
+	v1.Use(middleware.OpsMiddleware)
+
+	v1.GET("disputes", opsCtrl.GetDisputes)
+	v1.POST("disputes/:id/request-evidence", opsCtrl.RequestDisputeEvidence)
+	v1.POST("disputes/:id/resolve", opsCtrl.ResolveDispute)
 }
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -18,16 +19,88 @@ import (
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
 	u "github.com/paycrest/aggregator/utils"
 	"github.com/paycrest/aggregator/utils/crypto"
 	"github.com/paycrest/aggregator/utils/logger"
 	"github.com/paycrest/aggregator/utils/token"
 )
 
+// routePermissions maps routes to the permission they require from a team member role
+// or the scope they require from an API key. Routes not listed here are available to all.
+var routePermissions = map[string]types.TeamPermission{
+	"GET /v1/settings/sender":                        types.PermissionSettingsRead,
+	"PATCH /v1/settings/sender":                      types.PermissionSettingsWrite,
+	"GET /v1/settings/sender/team":                   types.PermissionSettingsRead,
+	"POST /v1/settings/sender/team":                  types.PermissionTeamManage,
+	"PATCH /v1/settings/sender/team/:id":             types.PermissionTeamManage,
+	"DELETE /v1/settings/sender/team/:id":            types.PermissionTeamManage,
+	"GET /v1/settings/provider":                      types.PermissionSettingsRead,
+	"PATCH /v1/settings/provider":                    types.PermissionSettingsWrite,
+	"GET /v1/settings/provider/team":                 types.PermissionSettingsRead,
+	"POST /v1/settings/provider/team":                types.PermissionTeamManage,
+	"PATCH /v1/settings/provider/team/:id":           types.PermissionTeamManage,
+	"DELETE /v1/settings/provider/team/:id":          types.PermissionTeamManage,
+	"GET /v1/settings/sender/api-keys":               types.PermissionSettingsRead,
+	"POST /v1/settings/sender/api-keys":              types.PermissionKeysManage,
+	"POST /v1/settings/sender/api-keys/:id/rotate":   types.PermissionKeysManage,
+	"DELETE /v1/settings/sender/api-keys/:id":        types.PermissionKeysManage,
+	"GET /v1/settings/provider/api-keys":             types.PermissionSettingsRead,
+	"POST /v1/settings/provider/api-keys":            types.PermissionKeysManage,
+	"POST /v1/settings/provider/api-keys/:id/rotate": types.PermissionKeysManage,
+	"DELETE /v1/settings/provider/api-keys/:id":      types.PermissionKeysManage,
+
+	"POST /v1/sender/orders":                      types.PermissionOrdersWrite,
+	"GET /v1/sender/orders":                       types.PermissionOrdersRead,
+	"GET /v1/sender/orders/export":                types.PermissionOrdersExport,
+	"POST /v1/sender/orders/batch":                types.PermissionOrdersWrite,
+	"GET /v1/sender/orders/batch/:id":             types.PermissionOrdersRead,
+	"POST /v1/sender/quotes":                      types.PermissionOrdersWrite,
+	"GET /v1/sender/orders/:id":                   types.PermissionOrdersRead,
+	"POST /v1/sender/orders/:id/cancel":           types.PermissionOrdersWrite,
+	"POST /v1/sender/orders/:id/disputes":         types.PermissionOrdersWrite,
+	"GET /v1/sender/orders/:id/disputes":          types.PermissionOrdersRead,
+	"GET /v1/sender/stats":                        types.PermissionStatsRead,
+	"POST /v1/sender/beneficiaries":               types.PermissionOrdersWrite,
+	"GET /v1/sender/beneficiaries":                types.PermissionOrdersRead,
+	"GET /v1/sender/beneficiaries/:id":            types.PermissionOrdersRead,
+	"PUT /v1/sender/beneficiaries/:id":            types.PermissionOrdersWrite,
+	"DELETE /v1/sender/beneficiaries/:id":         types.PermissionOrdersWrite,
+	"POST /v1/sender/scheduled-orders":            types.PermissionOrdersWrite,
+	"GET /v1/sender/scheduled-orders":             types.PermissionOrdersRead,
+	"GET /v1/sender/scheduled-orders/:id":         types.PermissionOrdersRead,
+	"GET /v1/sender/scheduled-orders/:id/runs":    types.PermissionOrdersRead,
+	"POST /v1/sender/scheduled-orders/:id/pause":  types.PermissionOrdersWrite,
+	"POST /v1/sender/scheduled-orders/:id/resume": types.PermissionOrdersWrite,
+	"DELETE /v1/sender/scheduled-orders/:id":      types.PermissionOrdersWrite,
+	"POST /v1/sender/sandbox/orders/:id/deposit":  types.PermissionOrdersWrite,
+	"POST /v1/sender/sandbox/orders/:id/settle":   types.PermissionOrdersWrite,
+	"POST /v1/sender/sandbox/orders/:id/refund":   types.PermissionOrdersWrite,
+
+	"GET /v1/provider/orders":                 types.PermissionOrdersRead,
+	"GET /v1/provider/orders/export":          types.PermissionOrdersExport,
+	"POST /v1/provider/orders/:id/accept":     types.PermissionOrdersWrite,
+	"POST /v1/provider/orders/:id/decline":    types.PermissionOrdersWrite,
+	"POST /v1/provider/orders/:id/fulfill":    types.PermissionOrdersWrite,
+	"POST /v1/provider/orders/:id/cancel":     types.PermissionOrdersWrite,
+	"GET /v1/provider/rates/:token/:fiat":     types.PermissionOrdersRead,
+	"GET /v1/provider/stats":                  types.PermissionStatsRead,
+	"GET /v1/provider/node-info":              types.PermissionStatsRead,
+	"GET /v1/provider/stream":                 types.PermissionOrdersWrite,
+	"GET /v1/provider/balances":               types.PermissionOrdersRead,
+	"PUT /v1/provider/balances":               types.PermissionOrdersWrite,
+	"GET /v1/provider/trust-score":            types.PermissionStatsRead,
+	"GET /v1/provider/rate-exclusions":        types.PermissionSettingsRead,
+	"GET /v1/provider/disputes":               types.PermissionOrdersRead,
+	"POST /v1/provider/disputes/:id/evidence": types.PermissionOrdersWrite,
+}
+
 // JWTMiddleware is a middleware to handle JWT authentication
 func JWTMiddleware(c *gin.Context) {
 	authHeader := c.GetHeader("Authorization")
@@ -69,35 +142,82 @@ func JWTMiddleware(c *gin.Context) {
 
 	senderAndProvider := strings.Contains(scope, "sender") && strings.Contains(scope, "provider")
 
-	// Set user profiles based on scope
+	// Set user profiles based on scope. Users who don't own a profile act on
+	// the profile of their active team membership with the role they were given.
+	var roles []teammember.Role
 	if scope == "sender" || senderAndProvider {
+		role := teammember.RoleOwner
 		senderProfile, err := storage.Client.SenderProfile.
 			Query().
 			Where(senderprofile.HasUserWith(user.IDEQ(userUUID))).
 			WithOrderTokens().
 			Only(c)
+		if ent.IsNotFound(err) {
+			if member, memberErr := getTeamMembership(c, userUUID, teammember.HasSenderProfile()); memberErr == nil {
+				senderProfile, role, err = member.Edges.SenderProfile, member.Role, nil
+			}
+		}
 		if err != nil {
 			c.Set("sender", nil)
+		} else {
+			c.Set("sender_role", role)
+			roles = append(roles, role)
 		}
 
 		c.Set("sender", senderProfile)
 	}
 
 	if scope == "provider" || senderAndProvider {
+		role := teammember.RoleOwner
 		providerProfile, err := storage.Client.ProviderProfile.
 			Query().
 			Where(providerprofile.HasUserWith(user.IDEQ(userUUID))).
 			Only(c)
+		if ent.IsNotFound(err) {
+			if member, memberErr := getTeamMembership(c, userUUID, teammember.HasProviderProfile()); memberErr == nil {
+				providerProfile, role, err = member.Edges.ProviderProfile, member.Role, nil
+			}
+		}
 		if err != nil && !senderAndProvider {
 			c.Set("provider", nil)
+		} else if err == nil {
+			c.Set("provider_role", role)
+			roles = append(roles, role)
 		}
 
 		c.Set("provider", providerProfile)
 	}
 
+	// Enforce the permissions of the user's role on every profile resolved for the request
+	if permission, ok := routePermissions[c.Request.Method+" "+c.FullPath()]; ok {
+		for _, role := range roles {
+			if !u.HasTeamPermission(role, permission) {
+				u.APIResponse(c, http.StatusForbidden, "error", "You do not have permission to perform this action", nil)
+				c.Abort()
+				return
+			}
+		}
+	}
+
 	c.Next()
 }
 
+// getTeamMembership fetches the active team membership of a user on a profile
+func getTeamMembership(c *gin.Context, userID uuid.UUID, profile predicate.TeamMember) (*ent.TeamMember, error) {
+	return storage.Client.TeamMember.
+		Query().
+		Where(
+			teammember.HasUserWith(user.IDEQ(userID)),
+			teammember.StatusEQ(teammember.StatusActive),
+			profile,
+		).
+		WithSenderProfile(func(spq *ent.SenderProfileQuery) {
+			spq.WithOrderTokens()
+		}).
+		WithProviderProfile().
+		First(c)
+}
+
 type PrivyClaims struct {
 	jwt.MapClaims
 	AppId          string `json:"aud,omitempty"`
@@ -360,6 +480,10 @@ func HMACVerificationMiddleware(c *gin.Context) {
 		return
 	}
 
+	if !authorizeAPIKey(c, apiKey) {
+		return
+	}
+
 	// Remove the timestamp key from the payload
 	delete(payloadData, "timestamp")
 
@@ -432,10 +556,47 @@ func APIKeyMiddleware(c *gin.Context) {
 		return
 	}
 
+	if !authorizeAPIKey(c, apiKeyEnt) {
+		return
+	}
+
 	// Continue to the next middleware
 	c.Next()
 }
 
+// authorizeAPIKey checks that an API key is usable and its scopes allow the route.
+// It aborts the request when the key is not authorized and records when the key was last used.
+func authorizeAPIKey(c *gin.Context, apiKey *ent.APIKey) bool {
+	now := time.Now()
+
+	if !apiKey.RevokedAt.IsZero() || (!apiKey.ExpiresAt.IsZero() && apiKey.ExpiresAt.Before(now)) {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "API key has expired or been revoked", nil)
+		c.Abort()
+		return false
+	}
+
+	// Keys without scopes, such as the primary key of a profile, have full access
+	if permission, ok := routePermissions[c.Request.Method+" "+c.FullPath()]; ok && len(apiKey.Scopes) > 0 {
+		if !u.ContainsString(apiKey.Scopes, string(permission)) {
+			u.APIResponse(c, http.StatusForbidden, "error", "API key does not have the required scope", string(permission))
+			c.Abort()
+			return false
+		}
+	}
+
+	// Orders created with a sandbox key never touch a chain
+	c.Set("sandbox", apiKey.IsSandbox)
+
+	// Avoid a write on every request by recording usage at most once a minute
+	if apiKey.LastUsedAt.Before(now.Add(-time.Minute)) {
+		if err := storage.Client.APIKey.UpdateOneID(apiKey.ID).SetLastUsedAt(now).Exec(c); err != nil {
+			logger.Errorf("error: %v", err)
+		}
+	}
+
+	return true
+}
+
 // DynamicAuthMiddleware is a middleware that dynamically selects the authentication method
 func DynamicAuthMiddleware(c *gin.Context) {
 	// Check the request headers to determine the desired authentication method
@@ -482,6 +643,21 @@ func OnlyProviderMiddleware(c *gin.Context) {
 	c.Next()
 }
 
+// OpsMiddleware is a middleware that authenticates ops with the ops API key in the Authorization header.
+// Ops routes are disabled when no ops API key is configured
+func OpsMiddleware(c *gin.Context) {
+	opsAPIKey := config.AuthConfig().OpsAPIKey
+	authHeader := c.GetHeader("Authorization")
+
+	if opsAPIKey == "" || subtle.ConstantTimeCompare([]byte(authHeader), []byte("Bearer "+opsAPIKey)) != 1 {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Invalid ops API key", nil)
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
 // OnlyWebMiddleware is a middle that checks your Client-Type and allows for auth
 func OnlyWebMiddleware(c *gin.Context) {
 	// Check the request headers to determine the desired authentication method
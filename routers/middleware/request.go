@@ -1,6 +1,12 @@
 package middleware
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -8,7 +14,10 @@ import (
 	ratelimit "github.com/JGLTechnologies/gin-rate-limit"
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/config"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/storage"
 	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
 )
 
 var (
@@ -82,3 +91,159 @@ func RateLimitMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// idempotencyRecord is the stored outcome of a request made with an Idempotency-Key
+type idempotencyRecord struct {
+	RequestHash string `json:"requestHash"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"statusCode"`
+	Body        []byte `json:"body"`
+}
+
+// idempotencyResponseWriter captures the response body so it can be replayed
+type idempotencyResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *idempotencyResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware makes mutating requests safe to retry when the client sends an Idempotency-Key header.
+// Keys are scoped to the authenticated sender or provider profile, and sandbox requests have their own keys.
+// A replay with the same payload returns the original response, while a replay with a different payload
+// is rejected with a conflict.
+func IdempotencyMiddleware(c *gin.Context) {
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" || c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead || c.Request.Method == http.MethodOptions {
+		c.Next()
+		return
+	}
+
+	if len(idempotencyKey) > 255 {
+		u.APIResponse(c, http.StatusBadRequest, "error", "Idempotency-Key must be at most 255 characters", nil)
+		c.Abort()
+		return
+	}
+
+	// Scope the key to the authenticated profile
+	var scope string
+	if sender, ok := c.Get("sender"); ok && sender != nil {
+		if senderProfile, ok := sender.(*ent.SenderProfile); ok && senderProfile != nil {
+			scope = "sender_" + senderProfile.ID.String()
+		}
+	}
+	if scope == "" {
+		if provider, ok := c.Get("provider"); ok && provider != nil {
+			if providerProfile, ok := provider.(*ent.ProviderProfile); ok && providerProfile != nil {
+				scope = "provider_" + providerProfile.ID
+			}
+		}
+	}
+	if scope == "" {
+		c.Next()
+		return
+	}
+
+	// Requests made with a sandbox key never replay live responses, or the other way round
+	if c.GetBool("sandbox") {
+		scope += "_sandbox"
+	}
+
+	// Fingerprint the request
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		u.APIResponse(c, http.StatusBadRequest, "error", "Invalid request body", nil)
+		c.Abort()
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "?" + c.Request.URL.RawQuery + "\n"))
+	hash.Write(body)
+	requestHash := hex.EncodeToString(hash.Sum(nil))
+
+	key := fmt.Sprintf("idempotency_%s_%s", scope, idempotencyKey)
+	validity := config.ServerConfig().IdempotencyKeyValidity
+
+	// Reserve the key for this request
+	pending, _ := json.Marshal(idempotencyRecord{RequestHash: requestHash})
+	reserved, err := storage.RedisClient.SetNX(c, key, pending, validity).Result()
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+		c.Abort()
+		return
+	}
+
+	if !reserved {
+		data, err := storage.RedisClient.Get(c, key).Bytes()
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+			c.Abort()
+			return
+		}
+
+		var record idempotencyRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+			c.Abort()
+			return
+		}
+
+		if record.RequestHash != requestHash {
+			u.APIResponse(c, http.StatusConflict, "error", "Idempotency-Key has already been used with a different request", nil)
+			c.Abort()
+			return
+		}
+
+		if !record.Completed {
+			u.APIResponse(c, http.StatusConflict, "error", "A request with this Idempotency-Key is still being processed", nil)
+			c.Abort()
+			return
+		}
+
+		c.Header("Idempotent-Replayed", "true")
+		c.Data(record.StatusCode, "application/json; charset=utf-8", record.Body)
+		c.Abort()
+		return
+	}
+
+	writer := &idempotencyResponseWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+	c.Writer = writer
+
+	defer func() {
+		// Release the key when the handler panics or fails with a server error so the client can retry
+		if r := recover(); r != nil || writer.Status() >= http.StatusInternalServerError {
+			if err := storage.RedisClient.Del(c, key).Err(); err != nil {
+				logger.Errorf("error: %v", err)
+			}
+			if r != nil {
+				panic(r)
+			}
+			return
+		}
+
+		completed, _ := json.Marshal(idempotencyRecord{
+			RequestHash: requestHash,
+			Completed:   true,
+			StatusCode:  writer.Status(),
+			Body:        writer.body.Bytes(),
+		})
+		if err := storage.RedisClient.Set(c, key, completed, validity).Err(); err != nil {
+			logger.Errorf("error: %v", err)
+		}
+	}()
+
+	c.Next()
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -8,7 +9,11 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	db "github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/utils/test"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -127,6 +132,103 @@ func TestRateLimit(t *testing.T) {
 	})
 }
 
+func TestIdempotency(t *testing.T) {
+	// Set Gin mode for testing
+	gin.SetMode(gin.TestMode)
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
+	senderProfile := &ent.SenderProfile{ID: uuid.New()}
+
+	// Initialize router
+	calls := 0
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(func(c *gin.Context) {
+		c.Set("sender", senderProfile)
+		c.Set("sandbox", c.GetHeader("Sandbox") == "true")
+		c.Next()
+	})
+	router.Use(IdempotencyMiddleware)
+
+	// Add test routes
+	router.POST("/test", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	panics := true
+	router.POST("/panic", func(c *gin.Context) {
+		if panics {
+			panic("handler failed")
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": "recovered"})
+	})
+
+	idempotencyKey := uuid.New().String()
+	headers := map[string]string{"Idempotency-Key": idempotencyKey}
+	defer redisClient.Del(context.Background(), "idempotency_sender_"+senderProfile.ID.String()+"_"+idempotencyKey)
+
+	t.Run("first request is processed", func(t *testing.T) {
+		w, _ := test.PerformRequest(t, "POST", "/test", map[string]interface{}{"amount": 1}, headers, router)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, float64(1), decodeResponseBody(t, w)["call"])
+	})
+
+	t.Run("replay with same body returns original response", func(t *testing.T) {
+		w, _ := test.PerformRequest(t, "POST", "/test", map[string]interface{}{"amount": 1}, headers, router)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, float64(1), decodeResponseBody(t, w)["call"])
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("replay with different body is rejected", func(t *testing.T) {
+		w, _ := test.PerformRequest(t, "POST", "/test", map[string]interface{}{"amount": 2}, headers, router)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("replay with a different query is rejected", func(t *testing.T) {
+		w, _ := test.PerformRequest(t, "POST", "/test?dryRun=true", map[string]interface{}{"amount": 1}, headers, router)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("sandbox requests don't replay live responses", func(t *testing.T) {
+		sandboxHeaders := map[string]string{"Idempotency-Key": idempotencyKey, "Sandbox": "true"}
+		defer redisClient.Del(context.Background(), "idempotency_sender_"+senderProfile.ID.String()+"_sandbox_"+idempotencyKey)
+
+		w, _ := test.PerformRequest(t, "POST", "/test", map[string]interface{}{"amount": 1}, sandboxHeaders, router)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, float64(2), decodeResponseBody(t, w)["call"])
+	})
+
+	t.Run("key is released when the handler panics", func(t *testing.T) {
+		panicKey := uuid.New().String()
+		panicHeaders := map[string]string{"Idempotency-Key": panicKey}
+		defer redisClient.Del(context.Background(), "idempotency_sender_"+senderProfile.ID.String()+"_"+panicKey)
+
+		w, _ := test.PerformRequest(t, "POST", "/panic", map[string]interface{}{"amount": 1}, panicHeaders, router)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		panics = false
+		w, _ = test.PerformRequest(t, "POST", "/panic", map[string]interface{}{"amount": 1}, panicHeaders, router)
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("requests without a key are not deduplicated", func(t *testing.T) {
+		w, _ := test.PerformRequest(t, "POST", "/test", map[string]interface{}{"amount": 1}, nil, router)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 3, calls)
+	})
+}
+
 // Helper function to decode JSON responses
 func decodeResponseBody(t *testing.T, body *httptest.ResponseRecorder) map[string]interface{} {
 	var response map[string]interface{}
@@ -152,7 +152,7 @@ func initAPIKeyCreate(client *ent.Client) (*ent.APIKeyCreate, string, string, er
 	encryptedSecret, _ := crypto.EncryptPlain([]byte(secretKey))
 	encodedSecret := base64.StdEncoding.EncodeToString(encryptedSecret)
 
-	return client.APIKey.Create(), secretKey, encodedSecret, nil
+	return client.APIKey.Create().SetIsPrimary(true), secretKey, encodedSecret, nil
 }
 
 func seedSender(ctx context.Context, client *ent.Client, serial string) (string, string, string, error) {
@@ -262,6 +262,8 @@ func seedProvider(ctx context.Context, client *ent.Client, bucket *ent.Provision
 		SetFloatingConversionRate(decimal.NewFromFloat(1)).
 		SetMinOrderAmount(bucket.MinAmount).
 		SetMaxOrderAmount(bucket.MaxAmount).
+		SetRateSlippage(decimal.NewFromFloat(0.5)).
+		SetRateSlippageType("absolute").
 		SetAddresses(addresses).
 		SetProvider(provider).
 		Save(ctx)
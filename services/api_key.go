@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	"github.com/paycrest/aggregator/utils/crypto"
@@ -20,12 +22,114 @@ func NewAPIKeyService() *APIKeyService {
 	return &APIKeyService{}
 }
 
-// GenerateAPIKey generates a new API key for the user.
+// GenerateAPIKey generates the primary API key for the user.
 func (s *APIKeyService) GenerateAPIKey(
 	ctx context.Context,
 	tx *ent.Tx,
 	sender *ent.SenderProfile,
 	provider *ent.ProviderProfile,
+) (*ent.APIKey, string, error) {
+	client := storage.Client.APIKey
+	if tx != nil {
+		client = tx.APIKey
+	}
+
+	return createAPIKey(ctx, client.Create().SetIsPrimary(true), sender, provider)
+}
+
+// CreateAPIKey creates a named API key limited to the given scopes for a user profile.
+// Orders created with a sandbox key are settled by simulated deposits and provider outcomes.
+func (s *APIKeyService) CreateAPIKey(
+	ctx context.Context,
+	sender *ent.SenderProfile,
+	provider *ent.ProviderProfile,
+	name string,
+	scopes []string,
+	expiresAt *time.Time,
+	sandbox bool,
+) (*ent.APIKey, string, error) {
+	apiKeyCreate := storage.Client.APIKey.
+		Create().
+		SetName(name).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		SetIsSandbox(sandbox)
+
+	return createAPIKey(ctx, apiKeyCreate, sender, provider)
+}
+
+// RotateAPIKey replaces an API key with a new key of the same name and scopes.
+// The old key keeps working until the overlap window has passed.
+func (s *APIKeyService) RotateAPIKey(
+	ctx context.Context,
+	apiKey *ent.APIKey,
+	overlap time.Duration,
+) (*ent.APIKey, string, error) {
+	sender, err := apiKey.QuerySenderProfile().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	provider, err := apiKey.QueryProviderProfile().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	if sender == nil && provider == nil {
+		return nil, "", fmt.Errorf("failed to rotate API key: key has no profile")
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	// Expire the old key at the end of the overlap window unless it expires earlier.
+	// It stops being primary before the new key is created, as a profile can only have one primary key
+	expiresAt := time.Now().Add(overlap)
+	if !apiKey.ExpiresAt.IsZero() && apiKey.ExpiresAt.Before(expiresAt) {
+		expiresAt = apiKey.ExpiresAt
+	}
+
+	_, err = tx.APIKey.
+		UpdateOneID(apiKey.ID).
+		SetIsPrimary(false).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	apiKeyCreate := tx.APIKey.
+		Create().
+		SetName(apiKey.Name).
+		SetScopes(apiKey.Scopes).
+		SetIsPrimary(apiKey.IsPrimary).
+		SetIsSandbox(apiKey.IsSandbox)
+	if !apiKey.ExpiresAt.IsZero() {
+		apiKeyCreate.SetExpiresAt(apiKey.ExpiresAt)
+	}
+
+	newAPIKey, secretKey, err := createAPIKey(ctx, apiKeyCreate, sender, provider)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	return newAPIKey, secretKey, nil
+}
+
+// createAPIKey generates a secret and saves the API key for a user profile.
+func createAPIKey(
+	ctx context.Context,
+	apiKeyCreate *ent.APIKeyCreate,
+	sender *ent.SenderProfile,
+	provider *ent.ProviderProfile,
 ) (*ent.APIKey, string, error) {
 	// Generate a new secret key
 	secretKey, err := token.GeneratePrivateKey()
@@ -37,52 +141,21 @@ func (s *APIKeyService) GenerateAPIKey(
 	encryptedSecret, _ := crypto.EncryptPlain([]byte(secretKey))
 	encodedSecret := base64.StdEncoding.EncodeToString(encryptedSecret)
 
-	var apiKey *ent.APIKey
-
 	if sender != nil {
-		if tx != nil {
-			apiKey, err = tx.APIKey.
-				Create().
-				SetSecret(encodedSecret).
-				SetSenderProfile(sender).
-				Save(ctx)
-			if err != nil {
-				return nil, "", fmt.Errorf("failed to create API key: %w", err)
-			}
-		} else {
-			apiKey, err = storage.Client.APIKey.
-				Create().
-				SetSecret(encodedSecret).
-				SetSenderProfile(sender).
-				Save(ctx)
-			if err != nil {
-				return nil, "", fmt.Errorf("failed to create API key: %w", err)
-			}
-		}
+		apiKeyCreate.SetSenderProfile(sender)
 	} else if provider != nil {
-		if tx != nil {
-			apiKey, err = tx.APIKey.
-				Create().
-				SetSecret(encodedSecret).
-				SetProviderProfile(provider).
-				Save(ctx)
-			if err != nil {
-				return nil, "", fmt.Errorf("failed to create API key: %w", err)
-			}
-		} else {
-			apiKey, err = storage.Client.APIKey.
-				Create().
-				SetSecret(encodedSecret).
-				SetProviderProfile(provider).
-				Save(ctx)
-			if err != nil {
-				return nil, "", fmt.Errorf("failed to create API key: %w", err)
-			}
-		}
+		apiKeyCreate.SetProviderProfile(provider)
 	} else {
 		return nil, "", fmt.Errorf("profile not provided")
 	}
 
+	apiKey, err := apiKeyCreate.
+		SetSecret(encodedSecret).
+		Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+
 	return apiKey, secretKey, nil
 }
 
@@ -95,9 +168,9 @@ func (s *APIKeyService) GetAPIKey(
 	var apiKey *ent.APIKey
 
 	if sender != nil {
-		apiKey, _ = sender.QueryAPIKey().Only(ctx)
+		apiKey, _ = sender.QueryAPIKeys().Where(apikey.IsPrimary(true)).Only(ctx)
 	} else if provider != nil {
-		apiKey, _ = provider.QueryAPIKey().Only(ctx)
+		apiKey, _ = provider.QueryAPIKeys().Where(apikey.IsPrimary(true)).Only(ctx)
 	} else {
 		return nil, fmt.Errorf("profile not provided")
 	}
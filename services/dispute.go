@@ -0,0 +1,466 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// Dispute webhook events sent to senders
+const (
+	DisputeEventOpened            = "dispute.opened"
+	DisputeEventEvidenceSubmitted = "dispute.evidence_submitted"
+	DisputeEventResolved          = "dispute.resolved"
+	DisputeEventRejected          = "dispute.rejected"
+)
+
+var (
+	// ErrOrderNotDisputable is returned when a dispute is opened on an order that has not been paid out
+	ErrOrderNotDisputable = errors.New("only validated or settled orders can be disputed")
+
+	// ErrDisputeAlreadyOpen is returned when a dispute is opened on an order with an unresolved dispute
+	ErrDisputeAlreadyOpen = errors.New("order already has an open dispute")
+
+	// ErrDisputeClosed is returned when a dispute that has been resolved or rejected is updated
+	ErrDisputeClosed = errors.New("dispute has already been resolved or rejected")
+)
+
+// DisputeService handles disputes raised by senders on behalf of recipients who claim non-receipt of a settled order.
+//
+// A dispute starts awaiting evidence from the provider, and is open for review by ops once the provider submits
+// evidence or misses the evidence deadline. Ops may request more evidence before resolving or rejecting the dispute.
+// Disputes resolved with the provider at fault count against the provider's trust score
+type DisputeService struct {
+	emailService      *EmailService
+	streamService     *ProviderStreamService
+	trustScoreService *TrustScoreService
+}
+
+// NewDisputeService creates a new instance of DisputeService.
+func NewDisputeService() *DisputeService {
+	return &DisputeService{
+		emailService:      NewEmailService(SENDGRID_MAIL_PROVIDER),
+		streamService:     NewProviderStreamService(),
+		trustScoreService: NewTrustScoreService(),
+	}
+}
+
+// OpenDispute opens a dispute on each lock payment order that paid out a payment order and asks their providers for evidence.
+// Split recipients and orders split across provision buckets are paid out by several lock orders, so the dispute can be
+// limited to the lock orders of one recipient by their account identifier
+func (s *DisputeService) OpenDispute(ctx context.Context, paymentOrder *ent.PaymentOrder, accountIdentifier, reason string) ([]*ent.Dispute, error) {
+	query := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID),
+			lockpaymentorder.StatusIn(lockpaymentorder.StatusValidated, lockpaymentorder.StatusSettled),
+		)
+	if accountIdentifier != "" {
+		query = query.Where(lockpaymentorder.AccountIdentifierEQ(accountIdentifier))
+	}
+
+	lockOrders, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(lockOrders) == 0 {
+		return nil, ErrOrderNotDisputable
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	created := []*ent.Dispute{}
+	for _, lockOrder := range lockOrders {
+		exists, err := tx.Dispute.
+			Query().
+			Where(
+				dispute.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(lockOrder.ID)),
+				dispute.StatusIn(dispute.StatusOpen, dispute.StatusAwaitingProviderEvidence),
+			).
+			Exist(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		d, err := tx.Dispute.
+			Create().
+			SetLockPaymentOrder(lockOrder).
+			SetPaymentOrder(paymentOrder).
+			SetReason(reason).
+			SetStatus(dispute.StatusAwaitingProviderEvidence).
+			SetEvidenceDueAt(now.Add(orderConf.DisputeEvidenceWindow)).
+			SetResolutionDueAt(now.Add(orderConf.DisputeResolutionWindow)).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			// The unique index on unresolved disputes rejects a dispute opened concurrently on the same lock order
+			if ent.IsConstraintError(err) {
+				return nil, ErrDisputeAlreadyOpen
+			}
+			return nil, err
+		}
+		created = append(created, d)
+	}
+
+	if len(created) == 0 {
+		_ = tx.Rollback()
+		return nil, ErrDisputeAlreadyOpen
+	}
+
+	if err := tx.Commit(); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrDisputeAlreadyOpen
+		}
+		return nil, err
+	}
+
+	disputes := make([]*ent.Dispute, len(created))
+	for i, c := range created {
+		d, err := s.GetDispute(ctx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		disputes[i] = d
+
+		s.notifyProvider(ctx, d,
+			"A dispute was opened on one of your orders",
+			fmt.Sprintf("The recipient of order %s claims they were not paid: %s\n\nPlease submit proof of fulfilment, such as a PSP receipt or reference, by %s.",
+				d.Edges.LockPaymentOrder.ID, d.Reason, d.EvidenceDueAt.UTC().Format(time.RFC1123)),
+		)
+		s.notifySender(ctx, d, DisputeEventOpened)
+	}
+
+	return disputes, nil
+}
+
+// SubmitEvidence adds a provider's proof of fulfilment to a dispute and opens it for review by ops
+func (s *DisputeService) SubmitEvidence(ctx context.Context, d *ent.Dispute, evidence []types.DisputeEvidencePayload) (*ent.Dispute, error) {
+	now := time.Now()
+	submitted := make([]types.DisputeEvidence, 0, len(evidence))
+	for _, e := range evidence {
+		submitted = append(submitted, types.DisputeEvidence{
+			Type:        e.Type,
+			Reference:   e.Reference,
+			URL:         e.URL,
+			Note:        e.Note,
+			SubmittedAt: now,
+		})
+	}
+
+	err := s.transitionDispute(ctx, d.ID, func(du *ent.DisputeUpdate) {
+		du.AppendEvidence(submitted).
+			SetStatus(dispute.StatusOpen)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d, err = s.GetDispute(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyOps(ctx,
+		fmt.Sprintf("Dispute %s is ready for review", d.ID),
+		fmt.Sprintf("The provider submitted evidence for dispute %s on order %s. The dispute must be resolved by %s.",
+			d.ID, d.Edges.LockPaymentOrder.ID, d.ResolutionDueAt.UTC().Format(time.RFC1123)),
+	)
+	s.notifySender(ctx, d, DisputeEventEvidenceSubmitted)
+
+	return d, nil
+}
+
+// RequestEvidence asks the provider for more evidence on an open dispute, restarting the evidence deadline
+func (s *DisputeService) RequestEvidence(ctx context.Context, d *ent.Dispute, note string) (*ent.Dispute, error) {
+	err := s.transitionDispute(ctx, d.ID, func(du *ent.DisputeUpdate) {
+		du.SetStatus(dispute.StatusAwaitingProviderEvidence).
+			SetEvidenceDueAt(time.Now().Add(orderConf.DisputeEvidenceWindow))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d, err = s.GetDispute(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("More evidence is needed for the dispute on order %s.", d.Edges.LockPaymentOrder.ID)
+	if note != "" {
+		body += "\n\n" + note
+	}
+	body += fmt.Sprintf("\n\nPlease submit it by %s.", d.EvidenceDueAt.UTC().Format(time.RFC1123))
+	s.notifyProvider(ctx, d, "More evidence is needed for a dispute", body)
+
+	return d, nil
+}
+
+// ResolveDispute closes a dispute as resolved or rejected with the outcome of the review.
+// Rejected disputes are never held against the provider
+func (s *DisputeService) ResolveDispute(ctx context.Context, d *ent.Dispute, status dispute.Status, providerAtFault bool, resolution string) (*ent.Dispute, error) {
+	if status == dispute.StatusRejected {
+		providerAtFault = false
+	}
+
+	err := s.transitionDispute(ctx, d.ID, func(du *ent.DisputeUpdate) {
+		du.SetStatus(status).
+			SetProviderAtFault(providerAtFault).
+			SetResolution(resolution).
+			SetResolvedAt(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d, err = s.GetDispute(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := DisputeEventResolved
+	if status == dispute.StatusRejected {
+		event = DisputeEventRejected
+	}
+
+	s.notifyProvider(ctx, d,
+		fmt.Sprintf("The dispute on order %s was %s", d.Edges.LockPaymentOrder.ID, status),
+		resolution,
+	)
+	s.notifySender(ctx, d, event)
+
+	if provider := d.Edges.LockPaymentOrder.Edges.Provider; provider != nil && providerAtFault {
+		if err := s.trustScoreService.UpdateTrustScore(ctx, provider.ID); err != nil {
+			logger.Errorf("DisputeService.ResolveDispute: failed to update trust score of provider %s: %v", provider.ID, err)
+		}
+	}
+
+	return d, nil
+}
+
+// HandleDeadlines moves disputes whose provider missed the evidence deadline to review by ops,
+// and escalates disputes that have not been resolved within the resolution deadline to ops
+func (s *DisputeService) HandleDeadlines(ctx context.Context) error {
+	now := time.Now()
+
+	overdue, err := storage.Client.Dispute.
+		Query().
+		Where(
+			dispute.StatusEQ(dispute.StatusAwaitingProviderEvidence),
+			dispute.EvidenceDueAtLT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleDeadlines: %w", err)
+	}
+
+	for _, d := range overdue {
+		// Skip disputes that received evidence, had the deadline restarted or were closed since they were fetched
+		updated, err := storage.Client.Dispute.
+			Update().
+			Where(
+				dispute.IDEQ(d.ID),
+				dispute.StatusEQ(dispute.StatusAwaitingProviderEvidence),
+				dispute.EvidenceDueAtLT(now),
+			).
+			SetStatus(dispute.StatusOpen).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("HandleDeadlines: %v", err)
+			continue
+		}
+		if updated == 0 {
+			continue
+		}
+
+		s.notifyOps(ctx,
+			fmt.Sprintf("Dispute %s is ready for review", d.ID),
+			fmt.Sprintf("The provider did not submit evidence for dispute %s by %s. The dispute must be resolved by %s.",
+				d.ID, d.EvidenceDueAt.UTC().Format(time.RFC1123), d.ResolutionDueAt.UTC().Format(time.RFC1123)),
+		)
+	}
+
+	breached, err := storage.Client.Dispute.
+		Query().
+		Where(
+			dispute.StatusIn(dispute.StatusOpen, dispute.StatusAwaitingProviderEvidence),
+			dispute.ResolutionDueAtLT(now),
+			dispute.EscalatedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleDeadlines: %w", err)
+	}
+
+	for _, d := range breached {
+		updated, err := storage.Client.Dispute.
+			Update().
+			Where(
+				dispute.IDEQ(d.ID),
+				dispute.StatusIn(dispute.StatusOpen, dispute.StatusAwaitingProviderEvidence),
+				dispute.EscalatedAtIsNil(),
+			).
+			SetEscalatedAt(now).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("HandleDeadlines: %v", err)
+			continue
+		}
+		if updated == 0 {
+			continue
+		}
+
+		s.notifyOps(ctx,
+			fmt.Sprintf("Dispute %s is past its resolution deadline", d.ID),
+			fmt.Sprintf("Dispute %s opened on %s was due to be resolved by %s and is still %s.",
+				d.ID, d.CreatedAt.UTC().Format(time.RFC1123), d.ResolutionDueAt.UTC().Format(time.RFC1123),
+				strings.ReplaceAll(string(d.Status), "_", " ")),
+		)
+	}
+
+	return nil
+}
+
+// GetDispute fetches a dispute with the orders needed to respond to and notify its parties
+func (s *DisputeService) GetDispute(ctx context.Context, id uuid.UUID) (*ent.Dispute, error) {
+	return storage.Client.Dispute.
+		Query().
+		Where(dispute.IDEQ(id)).
+		WithLockPaymentOrder(func(lpoq *ent.LockPaymentOrderQuery) {
+			lpoq.WithProvider(func(ppq *ent.ProviderProfileQuery) {
+				ppq.WithUser()
+			})
+		}).
+		WithPaymentOrder(func(poq *ent.PaymentOrderQuery) {
+			poq.WithSenderProfile()
+		}).
+		Only(ctx)
+}
+
+// DisputeResponse builds the response for a dispute on the given order
+func DisputeResponse(d *ent.Dispute, orderID uuid.UUID) types.DisputeResponse {
+	evidence := d.Evidence
+	if evidence == nil {
+		evidence = []types.DisputeEvidence{}
+	}
+
+	response := types.DisputeResponse{
+		ID:              d.ID,
+		OrderID:         orderID,
+		Status:          string(d.Status),
+		Reason:          d.Reason,
+		Evidence:        evidence,
+		ProviderAtFault: d.ProviderAtFault,
+		Resolution:      d.Resolution,
+		EvidenceDueAt:   d.EvidenceDueAt,
+		ResolutionDueAt: d.ResolutionDueAt,
+		ResolvedAt:      d.ResolvedAt,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+	}
+
+	// Identify the recipient of the disputed lock order, as a payment order may be paid out by several
+	if lockOrder := d.Edges.LockPaymentOrder; lockOrder != nil {
+		response.Institution = lockOrder.Institution
+		response.AccountIdentifier = lockOrder.AccountIdentifier
+	}
+
+	return response
+}
+
+// transitionDispute applies an update to a dispute that is still open or awaiting provider evidence.
+// The status is checked in the update itself, so a dispute closed concurrently is never reopened
+func (s *DisputeService) transitionDispute(ctx context.Context, id uuid.UUID, update func(du *ent.DisputeUpdate)) error {
+	du := storage.Client.Dispute.
+		Update().
+		Where(
+			dispute.IDEQ(id),
+			dispute.StatusIn(dispute.StatusOpen, dispute.StatusAwaitingProviderEvidence),
+		)
+	update(du)
+
+	updated, err := du.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrDisputeClosed
+	}
+
+	return nil
+}
+
+// notifyProvider notifies the provider of the disputed order on its provider node and by email
+func (s *DisputeService) notifyProvider(ctx context.Context, d *ent.Dispute, subject, body string) {
+	provider := d.Edges.LockPaymentOrder.Edges.Provider
+	if provider == nil {
+		return
+	}
+
+	err := s.streamService.Notify(ctx, provider, ProviderStreamDispute, map[string]interface{}{
+		"disputeId":       d.ID.String(),
+		"orderId":         d.Edges.LockPaymentOrder.ID.String(),
+		"status":          string(d.Status),
+		"reason":          d.Reason,
+		"evidenceDueAt":   d.EvidenceDueAt,
+		"resolutionDueAt": d.ResolutionDueAt,
+		"resolution":      d.Resolution,
+	})
+	if err != nil {
+		logger.Errorf("failed to notify provider node %s of dispute %s: %v", provider.ID, d.ID, err)
+	}
+
+	if provider.Edges.User == nil {
+		return
+	}
+
+	_, err = s.emailService.SendEmail(ctx, types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   provider.Edges.User.Email,
+		Subject:     subject,
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	})
+	if err != nil {
+		logger.Errorf("failed to email provider %s of dispute %s: %v", provider.ID, d.ID, err)
+	}
+}
+
+// notifySender sends a dispute webhook to the sender of the disputed payment order
+func (s *DisputeService) notifySender(ctx context.Context, d *ent.Dispute, event string) {
+	paymentOrder := d.Edges.PaymentOrder
+	if paymentOrder == nil {
+		return
+	}
+
+	err := utils.SendDisputeWebhook(ctx, paymentOrder.Edges.SenderProfile, event, DisputeResponse(d, paymentOrder.ID))
+	if err != nil {
+		logger.Errorf("failed to send %s webhook for dispute %s: %v", event, d.ID, err)
+	}
+}
+
+// notifyOps emails ops about a dispute that needs their attention
+func (s *DisputeService) notifyOps(ctx context.Context, subject, body string) {
+	_, err := s.emailService.SendOpsEmail(ctx, subject, body)
+	if err != nil {
+		logger.Errorf("failed to email ops: %v", err)
+	}
+}
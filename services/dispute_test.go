@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleDisputeDeadlines(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:dispute_deadlines?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier("localhost").
+		SetChainID(1337).
+		SetRPCEndpoint("ws://localhost:8545").
+		SetIsTestnet(true).
+		SetFee(decimal.NewFromFloat(0.1)).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	token, err := db.Client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7").
+		SetDecimals(6).
+		SetIsEnabled(true).
+		SetNetwork(network).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	createDispute := func(status dispute.Status, evidenceDueAt, resolutionDueAt time.Time) *ent.Dispute {
+		lockOrder, err := db.Client.LockPaymentOrder.
+			Create().
+			SetGatewayID("0xdispute").
+			SetAmount(decimal.NewFromInt(100)).
+			SetRate(decimal.NewFromInt(1500)).
+			SetAmountFulfilled(decimal.NewFromInt(150000)).
+			SetOrderPercent(decimal.NewFromInt(100)).
+			SetBlockNumber(12345).
+			SetInstitution("ABNGNGLA").
+			SetAccountIdentifier("0123456789").
+			SetAccountName("John Doe").
+			SetStatus(lockpaymentorder.StatusSettled).
+			SetToken(token).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		d, err := db.Client.Dispute.
+			Create().
+			SetLockPaymentOrder(lockOrder).
+			SetReason("Recipient did not receive the funds").
+			SetStatus(status).
+			SetEvidenceDueAt(evidenceDueAt).
+			SetResolutionDueAt(resolutionDueAt).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		return d
+	}
+
+	now := time.Now()
+	pending := createDispute(dispute.StatusAwaitingProviderEvidence, now.Add(time.Hour), now.Add(24*time.Hour))
+	missedEvidence := createDispute(dispute.StatusAwaitingProviderEvidence, now.Add(-time.Hour), now.Add(24*time.Hour))
+	missedResolution := createDispute(dispute.StatusOpen, now.Add(-48*time.Hour), now.Add(-time.Hour))
+	resolved := createDispute(dispute.StatusResolved, now.Add(-48*time.Hour), now.Add(-time.Hour))
+
+	service := NewDisputeService()
+
+	err = service.HandleDeadlines(context.Background())
+	assert.NoError(t, err)
+
+	t.Run("keeps disputes within their deadlines", func(t *testing.T) {
+		d, err := db.Client.Dispute.Get(context.Background(), pending.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dispute.StatusAwaitingProviderEvidence, d.Status)
+		assert.Nil(t, d.EscalatedAt)
+	})
+
+	t.Run("moves disputes past the evidence deadline to review", func(t *testing.T) {
+		d, err := db.Client.Dispute.Get(context.Background(), missedEvidence.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dispute.StatusOpen, d.Status)
+		assert.Nil(t, d.EscalatedAt)
+	})
+
+	t.Run("escalates disputes past the resolution deadline once", func(t *testing.T) {
+		d, err := db.Client.Dispute.Get(context.Background(), missedResolution.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, d.EscalatedAt)
+
+		escalatedAt := *d.EscalatedAt
+
+		err = service.HandleDeadlines(context.Background())
+		assert.NoError(t, err)
+
+		d, err = db.Client.Dispute.Get(context.Background(), missedResolution.ID)
+		assert.NoError(t, err)
+		assert.True(t, escalatedAt.Equal(*d.EscalatedAt))
+	})
+
+	t.Run("ignores closed disputes", func(t *testing.T) {
+		d, err := db.Client.Dispute.Get(context.Background(), resolved.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dispute.StatusResolved, d.Status)
+		assert.Nil(t, d.EscalatedAt)
+	})
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	mailgunv3 "github.com/mailgun/mailgun-go/v3"
@@ -90,6 +91,39 @@ func (m *EmailService) SendPasswordResetEmail(ctx context.Context, token, email,
 	return SendTemplateEmail(payload, "d-8b689801cd9947748775ccd1c4cc932e")
 }
 
+// SendTeamInvitationEmail performs the actions for sending a team invitation token to the invited email.
+func (m *EmailService) SendTeamInvitationEmail(ctx context.Context, token, email, inviterName, role string) (types.SendEmailResponse, error) {
+	body := fmt.Sprintf(
+		"%s has invited you to join their team on Paycrest as %s.\n\nUse this token to accept the invitation: %s\n\nThe invitation expires in 7 days.",
+		inviterName, strings.ReplaceAll(role, "_", " "), token,
+	)
+
+	payload := types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   email,
+		Subject:     "You have been invited to a Paycrest team",
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	}
+	return m.SendEmail(ctx, payload)
+}
+
+// SendOpsEmail emails ops about an order or dispute that needs their attention. It does nothing when no ops address is configured.
+func (m *EmailService) SendOpsEmail(ctx context.Context, subject, body string) (types.SendEmailResponse, error) {
+	if notificationConf.OpsEmailAddress == "" {
+		return types.SendEmailResponse{}, nil
+	}
+
+	payload := types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   notificationConf.OpsEmailAddress,
+		Subject:     subject,
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	}
+	return m.SendEmail(ctx, payload)
+}
+
 // sendEmailViaMailgun performs the actions for sending an email.
 func sendEmailViaMailgun(ctx context.Context, content types.SendEmailPayload) (types.SendEmailResponse, error) {
 	// initialize
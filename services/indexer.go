@@ -23,6 +23,8 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	networkent "github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/paymentordersplit"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
@@ -46,6 +48,7 @@ var serverConf = config.ServerConfig()
 type Indexer interface {
 	IndexERC20Transfer(ctx context.Context, client types.RPCClient, order *ent.PaymentOrder, token *ent.Token, startBlock int64) error
 	IndexTRC20Transfer(ctx context.Context, order *ent.PaymentOrder) error
+	IndexBatchTransfer(ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch) error
 	IndexOrderCreated(ctx context.Context, client types.RPCClient, network *ent.Network) error
 	IndexOrderCreatedTron(ctx context.Context, order *ent.PaymentOrder) error
 	IndexOrderSettled(ctx context.Context, client types.RPCClient, network *ent.Network) error
@@ -53,8 +56,10 @@ type Indexer interface {
 	IndexOrderRefunded(ctx context.Context, client types.RPCClient, network *ent.Network) error
 	IndexOrderRefundedTron(ctx context.Context, order *ent.LockPaymentOrder) error
 	HandleReceiveAddressValidity(ctx context.Context, client types.RPCClient, receiveAddress *ent.ReceiveAddress, paymentOrder *ent.PaymentOrder) error
+	HandleBatchReceiveAddressValidity(ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch) error
 	CreateLockPaymentOrder(ctx context.Context, client types.RPCClient, network *ent.Network, event *types.OrderCreatedEvent) error
 	UpdateReceiveAddressStatus(ctx context.Context, client types.RPCClient, receiveAddress *ent.ReceiveAddress, paymentOrder *ent.PaymentOrder, event *types.TokenTransferEvent) (bool, error)
+	UpdateBatchReceiveAddressStatus(ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch, event *types.TokenTransferEvent) (bool, error)
 	UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *types.OrderSettledEvent) error
 	UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event *types.OrderRefundedEvent) error
 }
@@ -406,6 +411,156 @@ func (s *IndexerService) IndexTRC20Transfer(ctx context.Context, order *ent.Paym
 	return nil
 }
 
+// IndexBatchTransfer indexes transfers to the receive address of a payment order batch.
+// The batch and its child orders must be loaded with their token, network and receive address edges.
+func (s *IndexerService) IndexBatchTransfer(ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch) error {
+	var err error
+	token := batch.Edges.Token
+
+	if strings.HasPrefix(token.Edges.Network.Identifier, "tron") {
+		return s.indexBatchTRC20Transfer(ctx, batch)
+	}
+
+	// Connect to RPC endpoint
+	if client == nil {
+		client, err = types.NewEthClient(token.Edges.Network.RPCEndpoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Initialize contract filterer
+	filterer, err := contracts.NewERC20TokenFilterer(common.HexToAddress(token.ContractAddress), client)
+	if err != nil {
+		logger.Errorf("IndexBatchTransfer.NewERC20TokenFilterer(%s): %v", token.Edges.Network.Identifier, err)
+		return err
+	}
+
+	// Fetch current block header
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	toBlock := header.Number.Uint64()
+
+	fromBlock := int64(500)
+	if token.Edges.Network.Identifier != "bnb-smart-chain" {
+		fromBlock = 5000
+	}
+
+	iter, err := filterer.FilterTransfer(&bind.FilterOpts{
+		Start: uint64(int64(toBlock) - fromBlock),
+		End:   &toBlock,
+	}, nil, []common.Address{common.HexToAddress(batch.Edges.ReceiveAddress.Address)})
+	if err != nil {
+		logger.Errorf("IndexBatchTransfer.FilterTransfer(%s): %v", token.Edges.Network.Identifier, err)
+		return err
+	}
+
+	// Iterate over logs
+	for iter.Next() {
+		transferEvent := &types.TokenTransferEvent{
+			BlockNumber: iter.Event.Raw.BlockNumber,
+			TxHash:      iter.Event.Raw.TxHash.Hex(),
+			From:        iter.Event.From.Hex(),
+			To:          iter.Event.To.Hex(),
+			Value:       iter.Event.Value,
+		}
+
+		done, err := s.UpdateBatchReceiveAddressStatus(ctx, client, batch, transferEvent)
+		if err != nil {
+			logger.Errorf("IndexBatchTransfer.UpdateBatchReceiveAddressStatus: %v", err)
+			continue
+		}
+		if done {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// indexBatchTRC20Transfer indexes transfers to the receive address of a payment order batch on Tron network.
+func (s *IndexerService) indexBatchTRC20Transfer(ctx context.Context, batch *ent.PaymentOrderBatch) error {
+	network := batch.Edges.Token.Edges.Network
+	receiveAddress := batch.Edges.ReceiveAddress
+
+	client := fastshot.NewClient(network.RPCEndpoint).
+		Config().SetTimeout(30*time.Second).
+		Header().Add("TRON_PRO_API_KEY", orderConf.TronProApiKey)
+
+	res, err := client.Build().
+		GET(fmt.Sprintf("/v1/accounts/%s/transactions/trc20", receiveAddress.Address)).
+		Retry().Set(3, 1*time.Second).
+		Send()
+	if err != nil {
+		logger.Errorf("IndexBatchTransfer.FetchTransfer: %v", err)
+		return err
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		logger.Errorf("IndexBatchTransfer.ParseJSONResponse: %v %v", err, data)
+		return err
+	}
+
+	if !data["success"].(bool) {
+		return nil
+	}
+
+	for _, event := range data["data"].([]interface{}) {
+		eventData := event.(map[string]interface{})
+
+		if eventData["type"].(string) != "Transfer" || eventData["to"].(string) != receiveAddress.Address {
+			continue
+		}
+
+		value, err := decimal.NewFromString(eventData["value"].(string))
+		if err != nil {
+			logger.Errorf("IndexBatchTransfer.NewFromString: %v", err)
+			return err
+		}
+
+		res, err = client.Build().POST("/wallet/gettransactioninfobyid").
+			Body().AsJSON(map[string]interface{}{"value": eventData["transaction_id"].(string)}).
+			Retry().Set(3, 1*time.Second).
+			Send()
+		if err != nil {
+			logger.Errorf("IndexBatchTransfer.FetchBlockNumber: %v", err)
+			return err
+		}
+
+		txData, err := utils.ParseJSONResponse(res.RawResponse)
+		if err != nil {
+			logger.Errorf("IndexBatchTransfer.ParseJSONResponse: %v %v", err, txData)
+			return err
+		}
+
+		if txData["blockNumber"] == nil {
+			continue
+		}
+
+		transferEvent := &types.TokenTransferEvent{
+			BlockNumber: uint64(txData["blockNumber"].(float64)),
+			TxHash:      eventData["transaction_id"].(string),
+			From:        eventData["from"].(string),
+			To:          eventData["to"].(string),
+			Value:       value.BigInt(),
+		}
+
+		done, err := s.UpdateBatchReceiveAddressStatus(ctx, nil, batch, transferEvent)
+		if err != nil {
+			logger.Errorf("IndexBatchTransfer.UpdateBatchReceiveAddressStatus: %v", err)
+			continue
+		}
+		if done {
+			return nil
+		}
+	}
+
+	return nil
+}
+
 // IndexOrderCreated indexes deposits to the order contract for an EVM network.
 func (s *IndexerService) IndexOrderCreated(ctx context.Context, client types.RPCClient, network *ent.Network) error {
 	var err error
@@ -813,7 +968,14 @@ func (s *IndexerService) HandleReceiveAddressValidity(ctx context.Context, clien
 		validUntilIsFarGone := receiveAddress.ValidUntil.Before(time.Now().Add(-(5 * time.Minute)))
 		isExpired := receiveAddress.ValidUntil.Before(time.Now())
 
-		if validUntilIsFarGone {
+		underpaid := paymentOrder.UnderpaymentPolicy == paymentorder.UnderpaymentPolicyTopUp &&
+			paymentOrder.Status == paymentorder.StatusInitiated &&
+			paymentOrder.AmountPaid.GreaterThan(decimal.Zero)
+
+		if underpaid && isExpired {
+			// The top-up window has closed, return the partial payment to the sender
+			return s.refundUnderpaidOrder(ctx, client, receiveAddress, paymentOrder)
+		} else if validUntilIsFarGone {
 			_, err := receiveAddress.
 				Update().
 				SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
@@ -845,6 +1007,129 @@ func (s *IndexerService) HandleReceiveAddressValidity(ctx context.Context, clien
 	return nil
 }
 
+// refundUnderpaidOrder expires the receive address of an order that was not topped up in time
+// and refunds the amount paid to the return address
+func (s *IndexerService) refundUnderpaidOrder(ctx context.Context, client types.RPCClient, receiveAddress *ent.ReceiveAddress, paymentOrder *ent.PaymentOrder) error {
+	_, err := receiveAddress.
+		Update().
+		SetStatus(receiveaddress.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.db: %v", err)
+	}
+
+	err = s.order.RevertOrder(ctx, client, paymentOrder.ID)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.RevertOrder: %v", err)
+	}
+
+	revertedOrder, err := db.Client.PaymentOrder.Get(ctx, paymentOrder.ID)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.db: %v", err)
+	}
+
+	if revertedOrder.AmountReturned.LessThanOrEqual(paymentOrder.AmountReturned) {
+		// Nothing was returned as the amount paid doesn't cover the network fee or there is no return address,
+		// so the order expires like an unpaid order
+		_, err = revertedOrder.Update().
+			SetStatus(paymentorder.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("refundUnderpaidOrder.db: %v", err)
+		}
+		return nil
+	}
+
+	_, err = revertedOrder.Update().
+		SetStatus(paymentorder.StatusRefunded).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.db: %v", err)
+	}
+
+	paymentOrder, err = db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(paymentOrder.ID)).
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.db: %v", err)
+	}
+
+	err = utils.SendPaymentOrderWebhook(ctx, paymentOrder)
+	if err != nil {
+		return fmt.Errorf("refundUnderpaidOrder.webhook: %v", err)
+	}
+
+	return nil
+}
+
+// HandleBatchReceiveAddressValidity expires a payment order batch whose receive address
+// was not fully funded within its validity period, and returns any partial funding to the sender
+func (s *IndexerService) HandleBatchReceiveAddressValidity(ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch) error {
+	receiveAddress := batch.Edges.ReceiveAddress
+	if receiveAddress == nil || receiveAddress.ValidUntil.IsZero() {
+		return nil
+	}
+
+	if batch.Status != paymentorderbatch.StatusInitiated || receiveAddress.Status == receiveaddress.StatusUsed {
+		return nil
+	}
+
+	if receiveAddress.ValidUntil.After(time.Now()) {
+		return nil
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleBatchReceiveAddressValidity.db: %v", err)
+	}
+
+	_, err = tx.ReceiveAddress.
+		UpdateOneID(receiveAddress.ID).
+		SetStatus(receiveaddress.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("HandleBatchReceiveAddressValidity.db: %v", err)
+	}
+
+	_, err = tx.PaymentOrderBatch.
+		UpdateOneID(batch.ID).
+		SetStatus(paymentorderbatch.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("HandleBatchReceiveAddressValidity.db: %v", err)
+	}
+
+	_, err = tx.PaymentOrder.
+		Update().
+		Where(
+			paymentorder.HasBatchWith(paymentorderbatch.IDEQ(batch.ID)),
+			paymentorder.StatusEQ(paymentorder.StatusInitiated),
+		).
+		SetStatus(paymentorder.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("HandleBatchReceiveAddressValidity.db: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("HandleBatchReceiveAddressValidity.db: %v", err)
+	}
+
+	if batch.AmountPaid.GreaterThan(decimal.Zero) {
+		err = s.order.RevertBatch(ctx, client, batch.ID)
+		if err != nil {
+			return fmt.Errorf("HandleBatchReceiveAddressValidity.RevertBatch: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // CreateLockPaymentOrder saves a lock payment order in the database
 func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client types.RPCClient, network *ent.Network, event *types.OrderCreatedEvent) error {
 	gatewayId := fmt.Sprintf("0x%v", hex.EncodeToString(event.OrderId[:]))
@@ -873,40 +1158,8 @@ func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client type
 		return nil
 	}
 
-	go func() {
-		timeToWait := 2 * time.Second
-
-		time.Sleep(timeToWait)
-		_ = utils.Retry(10, timeToWait, func() error {
-			// Update payment order with the gateway ID
-			paymentOrder, err := db.Client.PaymentOrder.
-				Query().
-				Where(
-					paymentorder.TxHashEQ(event.TxHash),
-				).
-				Only(ctx)
-			if err != nil {
-				if ent.IsNotFound(err) {
-					// Payment order does not exist, retry
-					return fmt.Errorf("trigger retry")
-				} else {
-					return fmt.Errorf("CreateLockPaymentOrder.db: %v", err)
-				}
-			}
-
-			_, err = db.Client.PaymentOrder.
-				Update().
-				Where(paymentorder.IDEQ(paymentOrder.ID)).
-				SetBlockNumber(int64(event.BlockNumber)).
-				SetGatewayID(gatewayId).
-				Save(ctx)
-			if err != nil {
-				return fmt.Errorf("CreateLockPaymentOrder.db: %v", err)
-			}
-
-			return nil
-		})
-	}()
+	// Resolve the payment order the event was created for, if any
+	paymentOrder := s.resolvePaymentOrder(ctx, event, gatewayId)
 
 	// Get token from db
 	token, err := db.Client.Token.
@@ -968,9 +1221,32 @@ func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client type
 		AccountName:       recipient.AccountName,
 		ProviderID:        recipient.ProviderID,
 		Memo:              recipient.Memo,
+		OrderPercent:      decimal.NewFromInt(100),
 		ProvisionBucket:   provisionBucket,
 	}
 
+	// Orders that pay several recipients get a lock order per recipient
+	var splits []*ent.PaymentOrderSplit
+	if paymentOrder != nil {
+		splits, err = db.Client.PaymentOrderSplit.
+			Query().
+			Where(
+				paymentordersplit.HasPaymentOrderWith(paymentorder.IDEQ(paymentOrder.ID)),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("CreateLockPaymentOrder.db: %v", err)
+		}
+	}
+
+	if len(splits) > 0 {
+		err = s.createSplitLockPaymentOrders(ctx, client, lockPaymentOrder, splits, currency)
+		if err != nil {
+			return fmt.Errorf("%s - failed to create split lock payment orders: %w", lockPaymentOrder.GatewayID, err)
+		}
+		return nil
+	}
+
 	// Check if order is private
 	isPrivate := false
 	isTokenNetworkPresent := false
@@ -1044,80 +1320,9 @@ func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client type
 			return fmt.Errorf("%s - failed to split lock payment order: %w", lockPaymentOrder.GatewayID, err)
 		}
 	} else {
-		// Create LockPaymentOrder and recipient in a transaction
-		tx, err := db.Client.Tx(ctx)
-		if err != nil {
-			return fmt.Errorf("%s failed to initiate db transaction %w", lockPaymentOrder.GatewayID, err)
-		}
-
-		var transactionLog *ent.TransactionLog
-		_, err = tx.TransactionLog.
-			Query().
-			Where(
-				transactionlog.StatusEQ(transactionlog.StatusOrderCreated),
-				transactionlog.TxHashEQ(lockPaymentOrder.TxHash),
-				transactionlog.GatewayIDEQ(lockPaymentOrder.GatewayID),
-			).
-			Only(ctx)
-		if err != nil {
-			if !ent.IsNotFound(err) {
-				return fmt.Errorf("%s - failed to fetch transaction Log: %w", lockPaymentOrder.GatewayID, err)
-			} else {
-				transactionLog, err = tx.TransactionLog.
-					Create().
-					SetStatus(transactionlog.StatusOrderCreated).
-					SetTxHash(lockPaymentOrder.TxHash).
-					SetNetwork(network.Identifier).
-					SetGatewayID(lockPaymentOrder.GatewayID).
-					SetMetadata(
-						map[string]interface{}{
-							"Token":           lockPaymentOrder.Token,
-							"GatewayID":       lockPaymentOrder.GatewayID,
-							"Amount":          lockPaymentOrder.Amount,
-							"Rate":            lockPaymentOrder.Rate,
-							"Memo":            lockPaymentOrder.Memo,
-							"ProvisionBucket": lockPaymentOrder.ProvisionBucket,
-							"ProviderID":      lockPaymentOrder.ProviderID,
-						}).
-					Save(ctx)
-				if err != nil {
-					return fmt.Errorf("%s - failed to create transaction Log : %w", lockPaymentOrder.GatewayID, err)
-				}
-			}
-		}
-
-		// Create lock payment order in db
-		orderBuilder := tx.LockPaymentOrder.
-			Create().
-			SetToken(lockPaymentOrder.Token).
-			SetGatewayID(lockPaymentOrder.GatewayID).
-			SetAmount(lockPaymentOrder.Amount).
-			SetRate(lockPaymentOrder.Rate).
-			SetOrderPercent(decimal.NewFromInt(100)).
-			SetBlockNumber(lockPaymentOrder.BlockNumber).
-			SetTxHash(lockPaymentOrder.TxHash).
-			SetInstitution(lockPaymentOrder.Institution).
-			SetAccountIdentifier(lockPaymentOrder.AccountIdentifier).
-			SetAccountName(lockPaymentOrder.AccountName).
-			SetMemo(lockPaymentOrder.Memo).
-			SetProvisionBucket(lockPaymentOrder.ProvisionBucket)
-
-		if lockPaymentOrder.ProviderID != "" {
-			orderBuilder = orderBuilder.SetProviderID(lockPaymentOrder.ProviderID)
-		}
-
-		if transactionLog != nil {
-			orderBuilder = orderBuilder.AddTransactions(transactionLog)
-		}
-
-		orderCreated, err := orderBuilder.Save(ctx)
+		orderCreated, err := s.saveLockPaymentOrder(ctx, lockPaymentOrder)
 		if err != nil {
-			return fmt.Errorf("%s - failed to create lock payment order: %w", lockPaymentOrder.GatewayID, err)
-		}
-
-		// Commit the transaction
-		if err := tx.Commit(); err != nil {
-			return fmt.Errorf("%s - failed to create lock payment order: %w", lockPaymentOrder.GatewayID, err)
+			return err
 		}
 
 		// Check AML compliance
@@ -1151,13 +1356,237 @@ func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client type
 			return nil
 		} else {
 			lockPaymentOrder.ID = orderCreated.ID
-			_ = s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
+			s.assignLockPaymentOrder(ctx, lockPaymentOrder)
 		}
 	}
 
 	return nil
 }
 
+// saveLockPaymentOrder creates a lock payment order along with the transaction log of the order's creation
+func (s *IndexerService) saveLockPaymentOrder(ctx context.Context, lockPaymentOrder types.LockPaymentOrderFields) (*ent.LockPaymentOrder, error) {
+	// Create LockPaymentOrder and recipient in a transaction
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed to initiate db transaction %w", lockPaymentOrder.GatewayID, err)
+	}
+
+	var transactionLog *ent.TransactionLog
+	_, err = tx.TransactionLog.
+		Query().
+		Where(
+			transactionlog.StatusEQ(transactionlog.StatusOrderCreated),
+			transactionlog.TxHashEQ(lockPaymentOrder.TxHash),
+			transactionlog.GatewayIDEQ(lockPaymentOrder.GatewayID),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%s - failed to fetch transaction Log: %w", lockPaymentOrder.GatewayID, err)
+		} else {
+			transactionLog, err = tx.TransactionLog.
+				Create().
+				SetStatus(transactionlog.StatusOrderCreated).
+				SetTxHash(lockPaymentOrder.TxHash).
+				SetNetwork(lockPaymentOrder.Network.Identifier).
+				SetGatewayID(lockPaymentOrder.GatewayID).
+				SetMetadata(
+					map[string]interface{}{
+						"Token":           lockPaymentOrder.Token,
+						"GatewayID":       lockPaymentOrder.GatewayID,
+						"Amount":          lockPaymentOrder.Amount,
+						"Rate":            lockPaymentOrder.Rate,
+						"Memo":            lockPaymentOrder.Memo,
+						"ProvisionBucket": lockPaymentOrder.ProvisionBucket,
+						"ProviderID":      lockPaymentOrder.ProviderID,
+					}).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("%s - failed to create transaction Log : %w", lockPaymentOrder.GatewayID, err)
+			}
+		}
+	}
+
+	// Create lock payment order in db
+	orderBuilder := tx.LockPaymentOrder.
+		Create().
+		SetToken(lockPaymentOrder.Token).
+		SetGatewayID(lockPaymentOrder.GatewayID).
+		SetAmount(lockPaymentOrder.Amount).
+		SetRate(lockPaymentOrder.Rate).
+		SetAmountFulfilled(decimal.Zero).
+		SetOrderPercent(lockPaymentOrder.OrderPercent).
+		SetBlockNumber(lockPaymentOrder.BlockNumber).
+		SetTxHash(lockPaymentOrder.TxHash).
+		SetInstitution(lockPaymentOrder.Institution).
+		SetAccountIdentifier(lockPaymentOrder.AccountIdentifier).
+		SetAccountName(lockPaymentOrder.AccountName).
+		SetMemo(lockPaymentOrder.Memo).
+		SetProvisionBucket(lockPaymentOrder.ProvisionBucket)
+
+	if lockPaymentOrder.ProviderID != "" {
+		orderBuilder = orderBuilder.SetProviderID(lockPaymentOrder.ProviderID)
+	}
+
+	if transactionLog != nil {
+		orderBuilder = orderBuilder.AddTransactions(transactionLog)
+	}
+
+	orderCreated, err := orderBuilder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s - failed to create lock payment order: %w", lockPaymentOrder.GatewayID, err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s - failed to create lock payment order: %w", lockPaymentOrder.GatewayID, err)
+	}
+
+	return orderCreated, nil
+}
+
+// assignLockPaymentOrder assigns a created lock payment order to a provider. An order that can't be assigned
+// stays pending and is refunded once the refund timeout passes
+func (s *IndexerService) assignLockPaymentOrder(ctx context.Context, lockPaymentOrder types.LockPaymentOrderFields) {
+	err := s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
+	if err != nil {
+		logger.Errorf("%s - failed to assign lock payment order %s: %v", lockPaymentOrder.GatewayID, lockPaymentOrder.ID, err)
+	}
+}
+
+// resolvePaymentOrder returns the payment order an OrderCreated event was created for and saves the gateway ID on it.
+// It returns nil for orders not created through the aggregator, and for orders whose transaction hash CreateOrder
+// has not saved yet, in which case the gateway ID is saved in the background once it has
+func (s *IndexerService) resolvePaymentOrder(ctx context.Context, event *types.OrderCreatedEvent, gatewayId string) *ent.PaymentOrder {
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.Or(
+				paymentorder.TxHashEQ(event.TxHash),
+				paymentorder.GatewayIDEQ(gatewayId),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("resolvePaymentOrder: %v", err)
+			return nil
+		}
+
+		go func() {
+			timeToWait := 2 * time.Second
+
+			time.Sleep(timeToWait)
+			_ = utils.Retry(10, timeToWait, func() error {
+				// Update payment order with the gateway ID
+				paymentOrder, err := db.Client.PaymentOrder.
+					Query().
+					Where(
+						paymentorder.TxHashEQ(event.TxHash),
+					).
+					Only(ctx)
+				if err != nil {
+					if ent.IsNotFound(err) {
+						// Payment order does not exist, retry
+						return fmt.Errorf("trigger retry")
+					} else {
+						return fmt.Errorf("CreateLockPaymentOrder.db: %v", err)
+					}
+				}
+
+				_, err = db.Client.PaymentOrder.
+					Update().
+					Where(paymentorder.IDEQ(paymentOrder.ID)).
+					SetBlockNumber(int64(event.BlockNumber)).
+					SetGatewayID(gatewayId).
+					Save(ctx)
+				if err != nil {
+					return fmt.Errorf("CreateLockPaymentOrder.db: %v", err)
+				}
+
+				return nil
+			})
+		}()
+
+		return nil
+	}
+
+	// Update payment order with the gateway ID
+	paymentOrder, err := order.Update().
+		SetBlockNumber(int64(event.BlockNumber)).
+		SetGatewayID(gatewayId).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("resolvePaymentOrder: %v", err)
+		return order
+	}
+
+	return paymentOrder
+}
+
+// createSplitLockPaymentOrders creates a lock payment order for each recipient of a split payment order.
+// Each lock order settles the recipient's percent of the order, and the main recipient is paid what is left after the splits
+func (s *IndexerService) createSplitLockPaymentOrders(ctx context.Context, client types.RPCClient, lockPaymentOrder types.LockPaymentOrderFields, splits []*ent.PaymentOrderSplit, currency *ent.FiatCurrency) error {
+	// Check AML compliance
+	if serverConf.Environment == "production" && !strings.HasPrefix(lockPaymentOrder.Network.Identifier, "tron") {
+		ok, err := s.checkAMLCompliance(lockPaymentOrder.Network.RPCEndpoint, lockPaymentOrder.TxHash)
+		if err != nil {
+			logger.Errorf("createSplitLockPaymentOrders.checkAMLCompliance: %v", err)
+		}
+
+		if !ok && err == nil {
+			err := s.handleCancellation(ctx, client, nil, &lockPaymentOrder, "AML compliance check failed")
+			if err != nil {
+				return fmt.Errorf("checkAMLCompliance.RefundOrder: %w", err)
+			}
+			return nil
+		}
+	}
+
+	// Each split gets its percent of the amount deposited on chain, which may differ from the quoted amount,
+	// and the main recipient gets what is left
+	mainRecipient := lockPaymentOrder
+	recipients := []types.LockPaymentOrderFields{}
+	for _, split := range splits {
+		recipient := lockPaymentOrder
+		recipient.Institution = split.Institution
+		recipient.AccountIdentifier = split.AccountIdentifier
+		recipient.AccountName = split.AccountName
+		recipient.Memo = split.Memo
+		recipient.Amount = lockPaymentOrder.Amount.Mul(split.Percent).Div(decimal.NewFromInt(100))
+		recipient.OrderPercent = split.Percent
+		recipients = append(recipients, recipient)
+
+		mainRecipient.Amount = mainRecipient.Amount.Sub(recipient.Amount)
+		mainRecipient.OrderPercent = mainRecipient.OrderPercent.Sub(split.Percent)
+	}
+	recipients = append([]types.LockPaymentOrderFields{mainRecipient}, recipients...)
+
+	for _, recipient := range recipients {
+		provisionBucket, err := s.getProvisionBucket(ctx, recipient.Amount.Mul(recipient.Rate), currency)
+		if err != nil {
+			// Split the recipient's share into multiple orders when no bucket can hold it
+			err = s.splitLockPaymentOrder(ctx, client, recipient, currency)
+			if err != nil {
+				return fmt.Errorf("failed to split lock payment order: %w", err)
+			}
+			continue
+		}
+		recipient.ProvisionBucket = provisionBucket
+
+		orderCreated, err := s.saveLockPaymentOrder(ctx, recipient)
+		if err != nil {
+			return err
+		}
+
+		// Assign the lock payment order to a provider
+		recipient.ID = orderCreated.ID
+		s.assignLockPaymentOrder(ctx, recipient)
+	}
+
+	return nil
+}
+
 // handleCancellation handles the cancellation of a lock payment order
 func (s *IndexerService) handleCancellation(ctx context.Context, client types.RPCClient, createdLockPaymentOrder *ent.LockPaymentOrder, lockPaymentOrder *types.LockPaymentOrderFields, cancellationReason string) error {
 	// lockPaymentOrder and createdLockPaymentOrder are mutually exclusive
@@ -1172,6 +1601,7 @@ func (s *IndexerService) handleCancellation(ctx context.Context, client types.RP
 			SetGatewayID(lockPaymentOrder.GatewayID).
 			SetAmount(lockPaymentOrder.Amount).
 			SetRate(lockPaymentOrder.Rate).
+			SetAmountFulfilled(decimal.Zero).
 			SetOrderPercent(decimal.NewFromInt(100)).
 			SetBlockNumber(lockPaymentOrder.BlockNumber).
 			SetTxHash(lockPaymentOrder.TxHash).
@@ -1303,6 +1733,25 @@ func (s *IndexerService) UpdateOrderStatusRefunded(ctx context.Context, network
 		}
 	}
 
+	// Providers still holding the order are notified once the refund is recorded
+	lockOrders, err := tx.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.GatewayIDEQ(gatewayId),
+			lockpaymentorder.StatusNEQ(lockpaymentorder.StatusRefunded),
+			lockpaymentorder.HasProvider(),
+			lockpaymentorder.HasTokenWith(
+				token.HasNetworkWith(
+					networkent.IdentifierEQ(network.Identifier),
+				),
+			),
+		).
+		WithProvider().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("UpdateOrderStatusRefunded.fetchLockOrders: %v", err)
+	}
+
 	// Aggregator side status update
 	lockPaymentOrderUpdate := tx.LockPaymentOrder.
 		Update().
@@ -1361,6 +1810,11 @@ func (s *IndexerService) UpdateOrderStatusRefunded(ctx context.Context, network
 		return fmt.Errorf("UpdateOrderStatusRefunded.commit %v", err)
 	}
 
+	streamService := NewProviderStreamService()
+	for _, lockOrder := range lockOrders {
+		streamService.NotifyOrderCancelled(ctx, lockOrder.Edges.Provider, lockOrder.ID, "refunded")
+	}
+
 	if paymentOrderExists && paymentOrder.Status != paymentorder.StatusRefunded {
 		paymentOrder.Status = paymentorder.StatusRefunded
 		paymentOrder.TxHash = log.TxHash
@@ -1576,12 +2030,34 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 			return false, nil
 		}
 
+		// Top-ups make several deposits to the same receive address, so check the deposits logged for the order
+		deposited, err := paymentOrder.
+			QueryTransactions().
+			Where(
+				transactionlog.StatusEQ(transactionlog.StatusCryptoDeposited),
+				transactionlog.TxHashEQ(event.TxHash),
+			).
+			Exist(ctx)
+		if err != nil {
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
+		if deposited {
+			return false, nil
+		}
+
 		// This is a transfer to the receive address to create an order on-chain
-		// Compare the transferred value with the expected order amount + fees
+		// Compare the total transferred value with the expected order amount + fees
 		fees := paymentOrder.NetworkFee.Add(paymentOrder.SenderFee).Add(paymentOrder.ProtocolFee)
 		orderAmountWithFees := paymentOrder.Amount.Add(fees).Round(int32(paymentOrder.Edges.Token.Decimals))
 		orderAmountWithFeesInSubunit := utils.ToSubunit(orderAmountWithFees, paymentOrder.Edges.Token.Decimals)
-		comparisonResult := event.Value.Cmp(orderAmountWithFeesInSubunit)
+		amountPaid := paymentOrder.AmountPaid.Add(utils.FromSubunit(event.Value, paymentOrder.Edges.Token.Decimals))
+		comparisonResult := utils.ToSubunit(amountPaid, paymentOrder.Edges.Token.Decimals).Cmp(orderAmountWithFeesInSubunit)
+
+		// Underpaid orders on the top-up policy wait for the rest of the payment instead of settling what was received
+		awaitingTopUp := comparisonResult < 0 && paymentOrder.UnderpaymentPolicy == paymentorder.UnderpaymentPolicyTopUp
+
+		// Overpaid orders on the refund policy settle the order amount and return the excess
+		refundExcess := comparisonResult > 0 && paymentOrder.OverpaymentPolicy == paymentorder.OverpaymentPolicyRefundExcess
 
 		tx, err := db.Client.Tx(ctx)
 		if err != nil {
@@ -1594,9 +2070,9 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 		}
 
 		orderRecipient := paymentOrder.Edges.Recipient
-		if comparisonResult != 0 {
+		if comparisonResult != 0 && !awaitingTopUp && !refundExcess {
 			// Update the order amount will be updated to whatever amount was sent to the receive address
-			newOrderAmount := utils.FromSubunit(event.Value, paymentOrder.Edges.Token.Decimals).Sub(fees.Round(int32(4)))
+			newOrderAmount := amountPaid.Sub(fees.Round(int32(4)))
 			paymentOrderUpdate = paymentOrderUpdate.SetAmount(newOrderAmount.Round(int32(4)))
 
 			// Update the rate with the current rate if order is older than 30 mins for a P2P order from the sender dashboard
@@ -1617,7 +2093,7 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 				}
 
-				rate, err := s.priorityQueue.GetProviderRate(ctx, providerProfile, paymentOrder.Edges.Token.Symbol)
+				rate, err := s.priorityQueue.GetProviderRate(ctx, providerProfile, paymentOrder.Edges.Token.Symbol, paymentOrder.Amount)
 				if err != nil {
 					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 				}
@@ -1659,8 +2135,17 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 			}
 		}
 
-		if comparisonResult == 0 {
-			// Transfer value equals order amount with fees
+		if awaitingTopUp {
+			err = s.handleUnderpayment(ctx, receiveAddress, paymentOrder)
+			if err != nil {
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.handleUnderpayment: %v", err)
+			}
+
+			return false, nil
+		}
+
+		if comparisonResult >= 0 {
+			// Transfer value covers the order amount with fees
 			_, err = receiveAddress.
 				Update().
 				SetStatus(receiveaddress.StatusUsed).
@@ -1677,6 +2162,13 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 				return true, fmt.Errorf("UpdateReceiveAddressStatus.CreateOrder: %v", err)
 			}
 
+			if refundExcess {
+				err = s.order.RevertOrder(ctx, client, paymentOrder.ID)
+				if err != nil {
+					return true, fmt.Errorf("UpdateReceiveAddressStatus.RevertOrder: %v", err)
+				}
+			}
+
 			return true, nil
 		}
 
@@ -1689,6 +2181,194 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 	return false, nil
 }
 
+// handleUnderpayment opens the top-up window of an underpaid order on its first deposit
+// and notifies the sender of the amount still outstanding
+func (s *IndexerService) handleUnderpayment(ctx context.Context, receiveAddress *ent.ReceiveAddress, paymentOrder *ent.PaymentOrder) error {
+	if paymentOrder.AmountPaid.IsZero() {
+		validUntil := time.Now().Add(orderConf.UnderpaymentTopUpWindow)
+		if validUntil.After(receiveAddress.ValidUntil) {
+			_, err := receiveAddress.
+				Update().
+				SetValidUntil(validUntil).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("db: %v", err)
+			}
+		}
+	}
+
+	// Refetch the order with the deposit applied
+	paymentOrder, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(paymentOrder.ID)).
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("db: %v", err)
+	}
+
+	err = utils.SendPaymentOrderEventWebhook(ctx, paymentOrder, "payment_order.underpaid")
+	if err != nil {
+		return fmt.Errorf("webhook: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateBatchReceiveAddressStatus records a deposit to the receive address of a payment order batch.
+// Once the batch is fully funded, every child order is created on-chain. if `done` is true,
+// the indexing process is complete for the given batch
+func (s *IndexerService) UpdateBatchReceiveAddressStatus(
+	ctx context.Context, client types.RPCClient, batch *ent.PaymentOrderBatch, event *types.TokenTransferEvent,
+) (done bool, err error) {
+	receiveAddress := batch.Edges.ReceiveAddress
+	token := batch.Edges.Token
+
+	if event.To != receiveAddress.Address || batch.Status != paymentorderbatch.StatusInitiated {
+		return false, nil
+	}
+
+	// Check whether this transfer has already been indexed
+	indexed, err := db.Client.TransactionLog.
+		Query().
+		Where(
+			transactionlog.TxHashEQ(event.TxHash),
+			transactionlog.StatusEQ(transactionlog.StatusCryptoDeposited),
+		).
+		Exist(ctx)
+	if err != nil {
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+	if indexed {
+		return false, nil
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+
+	_, err = tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusCryptoDeposited).
+		SetTxHash(event.TxHash).
+		SetNetwork(token.Edges.Network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"BatchID":         batch.ID.String(),
+			"transactionData": event,
+		}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.transactionlog: %v", err)
+	}
+
+	batchUpdate := tx.PaymentOrderBatch.
+		UpdateOneID(batch.ID).
+		SetFromAddress(event.From).
+		SetTxHash(event.TxHash).
+		AddAmountPaid(utils.FromSubunit(event.Value, token.Decimals))
+	if batch.ReturnAddress == "" {
+		batchUpdate = batchUpdate.SetReturnAddress(event.From)
+	}
+
+	updatedBatch, err := batchUpdate.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+	batch.AmountPaid = updatedBatch.AmountPaid
+	batch.ReturnAddress = updatedBatch.ReturnAddress
+
+	if batch.AmountPaid.LessThan(batch.Amount.Round(int32(token.Decimals))) {
+		// Batch is not fully funded yet, wait for more deposits
+		if err := tx.Commit(); err != nil {
+			return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+		}
+		return false, nil
+	}
+
+	_, err = tx.PaymentOrderBatch.
+		UpdateOneID(batch.ID).
+		SetStatus(paymentorderbatch.StatusFunded).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+
+	_, err = tx.ReceiveAddress.
+		UpdateOneID(receiveAddress.ID).
+		SetStatus(receiveaddress.StatusUsed).
+		SetLastUsed(time.Now()).
+		SetTxHash(event.TxHash).
+		SetLastIndexedBlock(int64(event.BlockNumber)).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+
+	// Mark every child order as paid from the batch deposit
+	for _, order := range batch.Edges.PaymentOrders {
+		transactionLog, err := tx.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDeposited).
+			SetTxHash(event.TxHash).
+			SetNetwork(token.Edges.Network.Identifier).
+			SetMetadata(map[string]interface{}{
+				"BatchID":         batch.ID.String(),
+				"transactionData": event,
+			}).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.transactionlog: %v", err)
+		}
+
+		orderUpdate := tx.PaymentOrder.
+			UpdateOneID(order.ID).
+			SetAmountPaid(order.Amount.Add(order.SenderFee).Add(order.ProtocolFee).Add(order.NetworkFee)).
+			SetFromAddress(event.From).
+			SetTxHash(event.TxHash).
+			SetBlockNumber(int64(event.BlockNumber)).
+			AddTransactions(transactionLog)
+		if order.ReturnAddress == "" {
+			orderUpdate = orderUpdate.SetReturnAddress(batch.ReturnAddress)
+		}
+
+		_, err = orderUpdate.Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return true, fmt.Errorf("UpdateBatchReceiveAddressStatus.db: %v", err)
+	}
+	batch.Status = paymentorderbatch.StatusFunded
+
+	// Create the child orders on-chain one after the other, as they share the same smart account
+	for _, order := range batch.Edges.PaymentOrders {
+		err = s.order.CreateOrder(ctx, client, order.ID)
+		if err != nil {
+			logger.Errorf("UpdateBatchReceiveAddressStatus.CreateOrder(%s): %v", order.ID, err)
+			continue
+		}
+	}
+
+	// Return any amount paid over the batch amount to the sender
+	if batch.AmountPaid.GreaterThan(batch.Amount) {
+		err = s.order.RevertBatch(ctx, client, batch.ID)
+		if err != nil {
+			logger.Errorf("UpdateBatchReceiveAddressStatus.RevertBatch: %v", err)
+		}
+	}
+
+	return true, nil
+}
+
 // fetchLatestOrderEvents fetches the latest events of the given order from the Tron network.
 func (s *IndexerService) fetchLatestOrderEvents(rpcEndpoint, network, txHash string) ([]interface{}, error) {
 	var err error
@@ -1801,13 +2481,14 @@ func (s *IndexerService) splitLockPaymentOrder(ctx context.Context, client types
 
 		for i := int64(0); i < trips; i++ {
 			ratio := bucket.MaxAmount.Div(amountToSplit)
-			orderPercent := ratio.Mul(decimal.NewFromInt(100))
+			orderPercent := ratio.Mul(lockPaymentOrder.OrderPercent)
 			lockOrder := tx.LockPaymentOrder.
 				Create().
 				SetToken(lockPaymentOrder.Token).
 				SetGatewayID(lockPaymentOrder.GatewayID).
 				SetAmount(bucket.MaxAmount.Div(lockPaymentOrder.Rate)).
 				SetRate(lockPaymentOrder.Rate).
+				SetAmountFulfilled(decimal.Zero).
 				SetOrderPercent(orderPercent).
 				SetBlockNumber(lockPaymentOrder.BlockNumber).
 				SetTxHash(lockPaymentOrder.TxHash).
@@ -1855,7 +2536,7 @@ func (s *IndexerService) splitLockPaymentOrder(ctx context.Context, client types
 		// Assign the lock payment orders to providers
 		for _, order := range ordersCreated {
 			lockPaymentOrder.ID = order.ID
-			_ = s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
+			s.assignLockPaymentOrder(ctx, lockPaymentOrder)
 		}
 
 		amountToSplit = amountToSplit.Sub(bucket.MaxAmount)
@@ -1875,6 +2556,7 @@ func (s *IndexerService) splitLockPaymentOrder(ctx context.Context, client types
 			SetGatewayID(lockPaymentOrder.GatewayID).
 			SetAmount(amountToSplit.Div(lockPaymentOrder.Rate)).
 			SetRate(lockPaymentOrder.Rate).
+			SetAmountFulfilled(decimal.Zero).
 			SetBlockNumber(lockPaymentOrder.BlockNumber).
 			SetTxHash(lockPaymentOrder.TxHash).
 			SetInstitution(lockPaymentOrder.Institution).
@@ -1896,7 +2578,7 @@ func (s *IndexerService) splitLockPaymentOrder(ctx context.Context, client types
 		if !isRefunded {
 			// Assign the lock payment order to a provider
 			lockPaymentOrder.ID = orderCreated.ID
-			_ = s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
+			s.assignLockPaymentOrder(ctx, lockPaymentOrder)
 		}
 	} else {
 		// TODO: figure out how to handle this case, currently it recursively splits the amount
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/enttest"
@@ -201,6 +202,243 @@ func TestIndexer(t *testing.T) {
 	assert.Equal(t, receiveaddress.StatusUsed, receiveAddress.Status)
 }
 
+// revertOrderService records the amount returned by RevertOrder the way the chain order services do
+type revertOrderService struct {
+	test.MockOrderService
+}
+
+// RevertOrder returns the amount paid less the network fee for orders not yet created on-chain
+func (m *revertOrderService) RevertOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	order, err := db.Client.PaymentOrder.Get(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	refundAmount := order.AmountPaid.Sub(order.AmountReturned).Sub(order.NetworkFee)
+	if refundAmount.LessThanOrEqual(decimal.Zero) || order.ReturnAddress == "" {
+		return nil
+	}
+
+	_, err = order.Update().
+		SetAmountReturned(order.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	return err
+}
+
+func TestUpdateReceiveAddressStatus(t *testing.T) {
+	ctx := context.Background()
+
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:ent_policies?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(nil)
+	assert.NoError(t, err)
+
+	senderProfile, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"webhook_url": "",
+	})
+	assert.NoError(t, err)
+
+	indexer := NewIndexerService(&revertOrderService{}).(*IndexerService)
+
+	// createOrder creates an order of 10 tokens (plus the network fee) with the given policies
+	createOrder := func(underpaymentPolicy paymentorder.UnderpaymentPolicy, overpaymentPolicy paymentorder.OverpaymentPolicy) (*ent.ReceiveAddress, *ent.PaymentOrder) {
+		id := rand.Intn(1000000)
+		receiveAddress, err := db.Client.ReceiveAddress.
+			Create().
+			SetAddress(fmt.Sprintf("0x%040d", id)).
+			SetSalt([]byte(fmt.Sprintf("salt-%d", id))).
+			SetStatus(receiveaddress.StatusUnused).
+			SetValidUntil(time.Now().Add(time.Minute)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err := db.Client.PaymentOrder.
+			Create().
+			SetSenderProfile(senderProfile).
+			SetAmount(decimal.NewFromInt(10)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetSenderFee(decimal.Zero).
+			SetNetworkFee(token.Edges.Network.Fee).
+			SetProtocolFee(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetRate(decimal.NewFromInt(750)).
+			SetToken(token).
+			SetReceiveAddress(receiveAddress).
+			SetReceiveAddressText(receiveAddress.Address).
+			SetFeePercent(decimal.Zero).
+			SetUnderpaymentPolicy(underpaymentPolicy).
+			SetOverpaymentPolicy(overpaymentPolicy).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = db.Client.PaymentOrderRecipient.
+			Create().
+			SetInstitution("ABNGNGLA").
+			SetAccountIdentifier("1234567890").
+			SetAccountName("John Doe").
+			SetPaymentOrder(order).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err = db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithRecipient().
+			Only(ctx)
+		assert.NoError(t, err)
+
+		return receiveAddress, order
+	}
+
+	// refetch reloads the order and its receive address after indexing
+	refetch := func(order *ent.PaymentOrder) (*ent.ReceiveAddress, *ent.PaymentOrder) {
+		order, err := db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithRecipient().
+			WithReceiveAddress().
+			Only(ctx)
+		assert.NoError(t, err)
+
+		return order.Edges.ReceiveAddress, order
+	}
+
+	transfer := func(receiveAddress *ent.ReceiveAddress, amount float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 1,
+			TxHash:      fmt.Sprintf("0x%064d", rand.Intn(1000000000)),
+			From:        "0x1234567890123456789012345678901234567890",
+			To:          receiveAddress.Address,
+			Value:       utils.ToSubunit(decimal.NewFromFloat(amount), token.Decimals),
+		}
+	}
+
+	t.Run("underpayment with top-up waits for the rest of the payment", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicyTopUp, paymentorder.OverpaymentPolicySettle)
+
+		event := transfer(receiveAddress, 4)
+		done, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, event)
+		assert.NoError(t, err)
+		assert.False(t, done)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusUnused, receiveAddress.Status)
+		assert.Equal(t, paymentorder.StatusInitiated, order.Status)
+		assert.True(t, order.Amount.Equal(decimal.NewFromInt(10)))
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromInt(4)))
+		assert.True(t, receiveAddress.ValidUntil.After(time.Now().Add(orderConf.UnderpaymentTopUpWindow-time.Minute)))
+
+		// The same deposit is not counted twice
+		done, err = indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, event)
+		assert.NoError(t, err)
+		assert.False(t, done)
+
+		_, order = refetch(order)
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromInt(4)))
+
+		// Topping up the outstanding amount creates the order
+		done, err = indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, 6.1))
+		assert.NoError(t, err)
+		assert.True(t, done)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusUsed, receiveAddress.Status)
+		assert.True(t, order.Amount.Equal(decimal.NewFromInt(10)))
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.1)))
+	})
+
+	t.Run("underpaid order is refunded when the top-up window closes", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicyTopUp, paymentorder.OverpaymentPolicySettle)
+
+		_, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, 4))
+		assert.NoError(t, err)
+
+		receiveAddress, order = refetch(order)
+		receiveAddress, err = receiveAddress.Update().SetValidUntil(time.Now().Add(-time.Minute)).Save(ctx)
+		assert.NoError(t, err)
+
+		err = indexer.HandleReceiveAddressValidity(ctx, nil, receiveAddress, order)
+		assert.NoError(t, err)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusExpired, receiveAddress.Status)
+		assert.Equal(t, paymentorder.StatusRefunded, order.Status)
+		assert.True(t, order.AmountReturned.Equal(decimal.NewFromInt(4).Sub(order.NetworkFee)))
+	})
+
+	t.Run("underpaid order expires when the amount paid doesn't cover the network fee", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicyTopUp, paymentorder.OverpaymentPolicySettle)
+
+		_, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, order.NetworkFee.InexactFloat64()))
+		assert.NoError(t, err)
+
+		receiveAddress, order = refetch(order)
+		receiveAddress, err = receiveAddress.Update().SetValidUntil(time.Now().Add(-time.Minute)).Save(ctx)
+		assert.NoError(t, err)
+
+		err = indexer.HandleReceiveAddressValidity(ctx, nil, receiveAddress, order)
+		assert.NoError(t, err)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusExpired, receiveAddress.Status)
+		assert.Equal(t, paymentorder.StatusExpired, order.Status)
+		assert.True(t, order.AmountReturned.IsZero())
+	})
+
+	t.Run("underpayment with settle policy settles the amount received", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicySettle, paymentorder.OverpaymentPolicySettle)
+
+		done, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, 5.1))
+		assert.NoError(t, err)
+		assert.True(t, done)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusUsed, receiveAddress.Status)
+		assert.True(t, order.Amount.Equal(decimal.NewFromInt(5)))
+	})
+
+	t.Run("overpayment with refund policy keeps the order amount", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicySettle, paymentorder.OverpaymentPolicyRefundExcess)
+
+		done, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, 15.1))
+		assert.NoError(t, err)
+		assert.True(t, done)
+
+		receiveAddress, order = refetch(order)
+		assert.Equal(t, receiveaddress.StatusUsed, receiveAddress.Status)
+		assert.True(t, order.Amount.Equal(decimal.NewFromInt(10)))
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(15.1)))
+	})
+
+	t.Run("overpayment with settle policy settles the full amount", func(t *testing.T) {
+		receiveAddress, order := createOrder(paymentorder.UnderpaymentPolicySettle, paymentorder.OverpaymentPolicySettle)
+
+		done, err := indexer.UpdateReceiveAddressStatus(ctx, nil, receiveAddress, order, transfer(receiveAddress, 15.1))
+		assert.NoError(t, err)
+		assert.True(t, done)
+
+		_, order = refetch(order)
+		assert.True(t, order.Amount.Equal(decimal.NewFromInt(15)))
+	})
+}
+
 func TestAMLCompliance(t *testing.T) {
 	// Test Blocked Transaction
 	ok, err := testCtx.indexer.checkAMLCompliance("wss://ws-rpc.shield3.com?apiKey=gpqwyjnJ9y86bL1AfLQk1ZLu0vBev1F4aYaucJk9&networkId=sepolia", "0x352baede033033c359cbd2d404a6d980b29a6b993542fcae6536028b1823ac54")
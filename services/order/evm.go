@@ -25,6 +25,7 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	networkent "github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -60,6 +61,9 @@ func (s *OrderEVM) CreateOrder(ctx context.Context, client types.RPCClient, orde
 		WithRecipient().
 		WithReceiveAddress().
 		WithLinkedAddress().
+		WithBatch(func(bq *ent.PaymentOrderBatchQuery) {
+			bq.WithReceiveAddress()
+		}).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("%s - CreateOrder.fetchOrder: %w", orderIDPrefix, err)
@@ -70,6 +74,10 @@ func (s *OrderEVM) CreateOrder(ctx context.Context, client types.RPCClient, orde
 	if order.Edges.ReceiveAddress != nil {
 		salt = order.Edges.ReceiveAddress.Salt
 		address = order.Edges.ReceiveAddress.Address
+	} else if order.Edges.Batch != nil && order.Edges.Batch.Edges.ReceiveAddress != nil {
+		// Batched orders are funded through the batch's receive address
+		salt = order.Edges.Batch.Edges.ReceiveAddress.Salt
+		address = order.Edges.Batch.Edges.ReceiveAddress.Address
 	} else if order.Edges.LinkedAddress != nil {
 		salt = order.Edges.LinkedAddress.Salt
 		address = order.Edges.LinkedAddress.Address
@@ -258,6 +266,142 @@ func (s *OrderEVM) RefundOrder(ctx context.Context, client types.RPCClient, netw
 	return nil
 }
 
+// RevertOrder returns the amount paid into a payment order's receive address to the return address.
+// For orders already created on-chain, this is the overpaid excess
+func (s *OrderEVM) RevertOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	orderIDPrefix := strings.Split(orderID.String(), "-")[0]
+
+	// Fetch payment order from db
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertOrder.fetchOrder: %w", orderIDPrefix, err)
+	}
+
+	// Network fee is deducted from the amount returned
+	refundAmount := order.AmountPaid.Sub(order.AmountReturned).Sub(order.NetworkFee)
+	if order.Status == paymentorder.StatusPending || order.Status == paymentorder.StatusSettled {
+		// The order was created on-chain, so only the excess over the order amount and fees is left
+		refundAmount = refundAmount.Sub(order.Amount.Add(order.SenderFee).Add(order.ProtocolFee).Add(order.NetworkFee))
+	}
+	if refundAmount.LessThanOrEqual(decimal.Zero) || order.ReturnAddress == "" || order.Edges.ReceiveAddress == nil {
+		return nil
+	}
+
+	txHash, err := s.transferFromReceiveAddress(ctx, order.Edges.Token, order.Edges.ReceiveAddress, order.ReturnAddress, refundAmount)
+	if err != nil {
+		return fmt.Errorf("%s - RevertOrder.%w", orderIDPrefix, err)
+	}
+
+	// Update payment order with the returned amount
+	_, err = order.Update().
+		SetAmountReturned(order.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertOrder.updateAmountReturned(%v): %w", orderIDPrefix, txHash, err)
+	}
+
+	return nil
+}
+
+// RevertBatch returns the amount paid into a payment order batch's receive address to the return address.
+// For funded batches, this is the overpaid excess
+func (s *OrderEVM) RevertBatch(ctx context.Context, client types.RPCClient, batchID uuid.UUID) error {
+	batchIDPrefix := strings.Split(batchID.String(), "-")[0]
+
+	// Fetch payment order batch from db
+	batch, err := db.Client.PaymentOrderBatch.
+		Query().
+		Where(paymentorderbatch.IDEQ(batchID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertBatch.fetchBatch: %w", batchIDPrefix, err)
+	}
+
+	refundAmount := utils.BatchRefundAmount(batch)
+	if refundAmount.LessThanOrEqual(decimal.Zero) || batch.ReturnAddress == "" || batch.Edges.ReceiveAddress == nil {
+		return nil
+	}
+
+	txHash, err := s.transferFromReceiveAddress(ctx, batch.Edges.Token, batch.Edges.ReceiveAddress, batch.ReturnAddress, refundAmount)
+	if err != nil {
+		return fmt.Errorf("%s - RevertBatch.%w", batchIDPrefix, err)
+	}
+
+	// Update payment order batch with the returned amount
+	_, err = batch.Update().
+		SetAmountReturned(batch.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertBatch.updateAmountReturned(%v): %w", batchIDPrefix, txHash, err)
+	}
+
+	return nil
+}
+
+// transferFromReceiveAddress transfers an amount of token held by a receive address to another address
+// with a sponsored user operation from the receive address's smart account
+func (s *OrderEVM) transferFromReceiveAddress(ctx context.Context, token *ent.Token, receiveAddress *ent.ReceiveAddress, to string, amount decimal.Decimal) (string, error) {
+	saltDecrypted, err := cryptoUtils.DecryptPlain(receiveAddress.Salt)
+	if err != nil {
+		return "", fmt.Errorf("DecryptPlain: %w", err)
+	}
+
+	// Initialize user operation with defaults
+	userOperation, err := utils.InitializeUserOperation(
+		ctx, nil, token.Edges.Network.RPCEndpoint, receiveAddress.Address, string(saltDecrypted),
+	)
+	if err != nil {
+		return "", fmt.Errorf("InitializeUserOperation: %w", err)
+	}
+
+	// Create calldata
+	calldata, err := s.executeBatchTransferCallData(
+		token,
+		common.HexToAddress(to),
+		utils.ToSubunit(amount, token.Decimals),
+	)
+	if err != nil {
+		return "", fmt.Errorf("executeBatchTransferCallData: %w", err)
+	}
+	userOperation.CallData = calldata
+
+	// Sponsor user operation.
+	// This will populate the following fields in userOperation: PaymasterAndData, PreVerificationGas, VerificationGasLimit, CallGasLimit
+	if serverConf.Environment != "production" {
+		err = utils.SponsorUserOperation(userOperation, "erc20", token.ContractAddress, token.Edges.Network.ChainID)
+	} else {
+		err = utils.SponsorUserOperation(userOperation, "sponsored", "", token.Edges.Network.ChainID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("SponsorUserOperation: %w", err)
+	}
+
+	// Sign user operation
+	err = utils.SignUserOperation(userOperation, token.Edges.Network.ChainID)
+	if err != nil {
+		return "", fmt.Errorf("SignUserOperation: %w", err)
+	}
+
+	// Send user operation
+	txHash, _, _, err := utils.SendUserOperation(userOperation, token.Edges.Network.ChainID)
+	if err != nil {
+		return "", fmt.Errorf("SendUserOperation: %w", err)
+	}
+
+	return txHash, nil
+}
+
 // SettleOrder settles a payment order on-chain.
 func (s *OrderEVM) SettleOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
 	var err error
@@ -332,9 +476,9 @@ func (s *OrderEVM) SettleOrder(ctx context.Context, client types.RPCClient, orde
 }
 
 // executeBatchTransferCallData creates the transfer calldata for the execute batch method in the smart account.
-func (s *OrderEVM) executeBatchTransferCallData(order *ent.PaymentOrder, to common.Address, amount *big.Int) ([]byte, error) {
+func (s *OrderEVM) executeBatchTransferCallData(token *ent.Token, to common.Address, amount *big.Int) ([]byte, error) {
 	// Fetch paymaster account
-	paymasterAccount, err := utils.GetPaymasterAccount(order.Edges.Token.Edges.Network.ChainID)
+	paymasterAccount, err := utils.GetPaymasterAccount(token.Edges.Network.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get paymaster account: %w", err)
 	}
@@ -346,7 +490,7 @@ func (s *OrderEVM) executeBatchTransferCallData(order *ent.PaymentOrder, to comm
 	// Create approve data for paymaster contract
 	approvePaymasterData, err := s.approveCallData(
 		common.HexToAddress(paymasterAccount),
-		big.NewInt(0).Add(amount, order.Edges.Token.Edges.Network.Fee.BigInt()),
+		big.NewInt(0).Add(amount, token.Edges.Network.Fee.BigInt()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create paymaster approve calldata : %w", err)
@@ -366,8 +510,8 @@ func (s *OrderEVM) executeBatchTransferCallData(order *ent.PaymentOrder, to comm
 	executeBatchCallData, err := simpleAccountABI.Pack(
 		"executeBatch",
 		[]common.Address{
-			common.HexToAddress(order.Edges.Token.ContractAddress),
-			common.HexToAddress(order.Edges.Token.ContractAddress),
+			common.HexToAddress(token.ContractAddress),
+			common.HexToAddress(token.ContractAddress),
 		},
 		[][]byte{approvePaymasterData, transferData},
 	)
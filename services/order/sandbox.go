@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/shopspring/decimal"
+)
+
+// OrderSandbox simulates the on-chain interactions for sandbox payment orders.
+// It records the same state changes and webhooks as the chain services without sending any transactions.
+type OrderSandbox struct{}
+
+// NewOrderSandbox creates a new instance of OrderSandbox.
+func NewOrderSandbox() types.OrderService {
+	return &OrderSandbox{}
+}
+
+// CreateOrder simulates creating a payment order on-chain.
+func (s *OrderSandbox) CreateOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	orderIDPrefix := strings.Split(orderID.String(), "-")[0]
+
+	// Fetch payment order from db
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			paymentorder.IsSandboxEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.fetchOrder: %w", orderIDPrefix, err)
+	}
+
+	txHash, err := RandomTxHash()
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.txHash: %w", orderIDPrefix, err)
+	}
+
+	gatewayOrderId, err := RandomTxHash()
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.gatewayId: %w", orderIDPrefix, err)
+	}
+
+	// Update payment order with txHash
+	_, err = order.Update().
+		SetTxHash(txHash).
+		SetBlockNumber(order.BlockNumber + 1).
+		SetGatewayID(gatewayOrderId).
+		SetStatus(paymentorder.StatusPending).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.updateTxHash: %w", orderIDPrefix, err)
+	}
+
+	// Refetch payment order
+	paymentOrder, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.refetchOrder: %w", orderIDPrefix, err)
+	}
+
+	// Send webhook notifcation to sender
+	err = utils.SendPaymentOrderWebhook(ctx, paymentOrder)
+	if err != nil {
+		return fmt.Errorf("%s - CreateOrder.webhook: %w", orderIDPrefix, err)
+	}
+
+	return nil
+}
+
+// RefundOrder is a no-op as sandbox orders are never assigned to provider lock orders.
+func (s *OrderSandbox) RefundOrder(ctx context.Context, client types.RPCClient, network *ent.Network, orderID string) error {
+	return nil
+}
+
+// RevertOrder simulates returning the amount paid into a payment order's receive address to the return address.
+func (s *OrderSandbox) RevertOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	orderIDPrefix := strings.Split(orderID.String(), "-")[0]
+
+	// Fetch payment order from db
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			paymentorder.IsSandboxEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertOrder.fetchOrder: %w", orderIDPrefix, err)
+	}
+
+	// Network fee is deducted from the amount returned
+	refundAmount := order.AmountPaid.Sub(order.AmountReturned).Sub(order.NetworkFee)
+	if order.Status == paymentorder.StatusPending || order.Status == paymentorder.StatusSettled {
+		// The order was created on-chain, so only the excess over the order amount and fees is left
+		refundAmount = refundAmount.Sub(order.Amount.Add(order.SenderFee).Add(order.ProtocolFee).Add(order.NetworkFee))
+	}
+	if refundAmount.LessThanOrEqual(decimal.Zero) || order.ReturnAddress == "" {
+		return nil
+	}
+
+	// Update payment order with the returned amount
+	_, err = order.Update().
+		SetAmountReturned(order.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertOrder.updateAmountReturned: %w", orderIDPrefix, err)
+	}
+
+	return nil
+}
+
+// RevertBatch simulates returning the amount paid into a payment order batch's receive address to the return address.
+func (s *OrderSandbox) RevertBatch(ctx context.Context, client types.RPCClient, batchID uuid.UUID) error {
+	batchIDPrefix := strings.Split(batchID.String(), "-")[0]
+
+	// Fetch payment order batch from db
+	batch, err := db.Client.PaymentOrderBatch.
+		Query().
+		Where(paymentorderbatch.IDEQ(batchID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertBatch.fetchBatch: %w", batchIDPrefix, err)
+	}
+
+	refundAmount := utils.BatchRefundAmount(batch)
+	if refundAmount.LessThanOrEqual(decimal.Zero) || batch.ReturnAddress == "" {
+		return nil
+	}
+
+	// Update payment order batch with the returned amount
+	_, err = batch.Update().
+		SetAmountReturned(batch.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - RevertBatch.updateAmountReturned: %w", batchIDPrefix, err)
+	}
+
+	return nil
+}
+
+// SettleOrder is a no-op as sandbox orders are never assigned to provider lock orders.
+func (s *OrderSandbox) SettleOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	return nil
+}
+
+// RandomTxHash generates a random 32 byte hex string in the format of a transaction hash
+func RandomTxHash() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(b), nil
+}
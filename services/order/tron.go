@@ -32,6 +32,7 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	networkent "github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/token"
@@ -77,13 +78,25 @@ func (s *OrderTron) CreateOrder(ctx context.Context, client types.RPCClient, ord
 		WithSenderProfile().
 		WithRecipient().
 		WithReceiveAddress().
+		WithBatch(func(bq *ent.PaymentOrderBatchQuery) {
+			bq.WithReceiveAddress()
+		}).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("%s - Tron.CreateOrder.fetchOrder: %w", orderIDPrefix, err)
 	}
 
+	// Batched orders are funded through the batch's receive address
+	receiveAddress := order.Edges.ReceiveAddress
+	if receiveAddress == nil && order.Edges.Batch != nil {
+		receiveAddress = order.Edges.Batch.Edges.ReceiveAddress
+	}
+	if receiveAddress == nil {
+		return fmt.Errorf("%s - Tron.CreateOrder: receive address not found", orderIDPrefix)
+	}
+
 	// Create wallet
-	saltDecrypted, err := cryptoUtils.DecryptPlain(order.Edges.ReceiveAddress.Salt)
+	saltDecrypted, err := cryptoUtils.DecryptPlain(receiveAddress.Salt)
 	if err != nil {
 		return fmt.Errorf("%s - Tron.CreateOrder.DecryptPlain: %w", orderIDPrefix, err)
 	}
@@ -177,7 +190,7 @@ func (s *OrderTron) CreateOrder(ctx context.Context, client types.RPCClient, ord
 
 	// Update payment order
 	_, err = order.Update().
-		SetBlockNumber(order.Edges.ReceiveAddress.LastIndexedBlock).
+		SetBlockNumber(receiveAddress.LastIndexedBlock).
 		SetTxHash(txHash).
 		SetStatus(paymentorder.StatusPending).
 		Save(ctx)
@@ -303,6 +316,138 @@ func (s *OrderTron) RefundOrder(ctx context.Context, client types.RPCClient, net
 	return nil
 }
 
+// RevertOrder returns the amount paid into a payment order's receive address to the return address.
+// For orders already created on-chain, this is the overpaid excess
+func (s *OrderTron) RevertOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	orderIDPrefix := strings.Split(orderID.String(), "-")[0]
+
+	// Fetch payment order from db
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertOrder.fetchOrder: %w", orderIDPrefix, err)
+	}
+
+	// Network fee is deducted from the amount returned
+	refundAmount := order.AmountPaid.Sub(order.AmountReturned).Sub(order.NetworkFee)
+	if order.Status == paymentorder.StatusPending || order.Status == paymentorder.StatusSettled {
+		// The order was created on-chain, so only the excess over the order amount and fees is left
+		refundAmount = refundAmount.Sub(order.Amount.Add(order.SenderFee).Add(order.ProtocolFee).Add(order.NetworkFee))
+	}
+	if refundAmount.LessThanOrEqual(decimal.Zero) || order.ReturnAddress == "" || order.Edges.ReceiveAddress == nil {
+		return nil
+	}
+
+	txHash, err := s.transferFromReceiveAddress(order.Edges.Token, order.Edges.ReceiveAddress, order.ReturnAddress, refundAmount)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertOrder.%w", orderIDPrefix, err)
+	}
+
+	// Update payment order with the returned amount
+	_, err = order.Update().
+		SetAmountReturned(order.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertOrder.updateAmountReturned(%v): %w", orderIDPrefix, txHash, err)
+	}
+
+	return nil
+}
+
+// RevertBatch returns the amount paid into a payment order batch's receive address to the return address.
+// For funded batches, this is the overpaid excess
+func (s *OrderTron) RevertBatch(ctx context.Context, client types.RPCClient, batchID uuid.UUID) error {
+	batchIDPrefix := strings.Split(batchID.String(), "-")[0]
+
+	// Fetch payment order batch from db
+	batch, err := db.Client.PaymentOrderBatch.
+		Query().
+		Where(paymentorderbatch.IDEQ(batchID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertBatch.fetchBatch: %w", batchIDPrefix, err)
+	}
+
+	refundAmount := utils.BatchRefundAmount(batch)
+	if refundAmount.LessThanOrEqual(decimal.Zero) || batch.ReturnAddress == "" || batch.Edges.ReceiveAddress == nil {
+		return nil
+	}
+
+	txHash, err := s.transferFromReceiveAddress(batch.Edges.Token, batch.Edges.ReceiveAddress, batch.ReturnAddress, refundAmount)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertBatch.%w", batchIDPrefix, err)
+	}
+
+	// Update payment order batch with the returned amount
+	_, err = batch.Update().
+		SetAmountReturned(batch.AmountReturned.Add(refundAmount)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Tron.RevertBatch.updateAmountReturned(%v): %w", batchIDPrefix, txHash, err)
+	}
+
+	return nil
+}
+
+// transferFromReceiveAddress transfers an amount of token held by a receive address to another address,
+// funding the receive address with TRX for gas from the master wallet when needed
+func (s *OrderTron) transferFromReceiveAddress(token *ent.Token, receiveAddress *ent.ReceiveAddress, to string, amount decimal.Decimal) (string, error) {
+	// Create wallet
+	saltDecrypted, err := cryptoUtils.DecryptPlain(receiveAddress.Salt)
+	if err != nil {
+		return "", fmt.Errorf("DecryptPlain: %w", err)
+	}
+
+	wallet, err := tronWallet.CreateTronWallet(s.getNode(), string(saltDecrypted))
+	if err != nil {
+		return "", fmt.Errorf("CreateTronWallet: %w", err)
+	}
+
+	// Transfer TRX from master wallet to receive address for gas
+	masterWallet, err := cryptoUtils.GenerateTronAccountFromIndex(0)
+	if err != nil {
+		return "", fmt.Errorf("GenerateTronAccountFromIndex: %w", err)
+	}
+
+	balance, err := wallet.Balance()
+	if err != nil {
+		balance = 0
+	}
+
+	if balance < 30000000 {
+		_, err = masterWallet.Transfer(wallet.AddressBase58, 30000000)
+		if err != nil {
+			return "", fmt.Errorf("Transfer: %w", err)
+		}
+		time.Sleep(5 * time.Second) // wait for wallet to be pre-funded with gas
+	}
+
+	// Transfer amount from receive address to the recipient
+	txHash, err := wallet.TransferTRC20(
+		&tronWallet.Token{
+			ContractAddress: enums.ContractAddress(token.ContractAddress),
+		},
+		to,
+		utils.ToSubunit(amount, token.Decimals).Int64(),
+		30000000,
+	)
+	if err != nil {
+		return "", fmt.Errorf("TransferTRC20: %w", err)
+	}
+
+	return txHash, nil
+}
+
 // SettleOrder settles a payment order on-chain.
 func (s *OrderTron) SettleOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
 	var err error
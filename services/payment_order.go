@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/senderordertoken"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	tokenEnt "github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/ent/transactionlog"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/shopspring/decimal"
+)
+
+// OrderValidationError is returned when a payment order fails validation.
+// Field names the invalid field of the order, if any
+type OrderValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *OrderValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// PaymentOrderParams holds the details of a validated payment order to create
+type PaymentOrderParams struct {
+	Sender             *ent.SenderProfile
+	Token              *ent.Token
+	Amount             decimal.Decimal
+	Rate               decimal.Decimal
+	Recipient          types.PaymentOrderRecipient
+	Splits             []types.PaymentOrderSplitPayload
+	SplitAmounts       []decimal.Decimal
+	FeePercent         decimal.Decimal
+	FeeAddress         string
+	FeeTier            *ent.SenderFeeTier
+	ReturnAddress      string
+	Reference          string
+	UnderpaymentPolicy paymentorder.UnderpaymentPolicy
+	OverpaymentPolicy  paymentorder.OverpaymentPolicy
+	IsSandbox          bool
+	// Batch is set for orders funded through the receive address of a batch
+	Batch *ent.PaymentOrderBatch
+	// Metadata is added to the metadata of the order's transaction log
+	Metadata map[string]interface{}
+}
+
+// PaymentOrderService validates and creates the payment orders initiated by senders and their scheduled orders
+type PaymentOrderService struct {
+	receiveAddressService *ReceiveAddressService
+}
+
+// NewPaymentOrderService creates a new instance of PaymentOrderService.
+func NewPaymentOrderService() *PaymentOrderService {
+	return &PaymentOrderService{
+		receiveAddressService: NewReceiveAddressService(),
+	}
+}
+
+// GetSenderOrderToken returns the sender's configuration of a token, which needs a fee and refund address to place orders
+func (s *PaymentOrderService) GetSenderOrderToken(ctx context.Context, sender *ent.SenderProfile, token *ent.Token) (*ent.SenderOrderToken, error) {
+	senderOrderToken, err := storage.Client.SenderOrderToken.
+		Query().
+		Where(
+			senderordertoken.HasTokenWith(tokenEnt.IDEQ(token.ID)),
+			senderordertoken.HasSenderWith(senderprofile.IDEQ(sender.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &OrderValidationError{Field: "Token", Message: "Provided token is not configured"}
+		}
+		return nil, err
+	}
+
+	if senderOrderToken.FeeAddress == "" || senderOrderToken.RefundAddress == "" {
+		return nil, &OrderValidationError{Field: "Token", Message: "Fee address or refund address is not configured"}
+	}
+
+	return senderOrderToken, nil
+}
+
+// ValidateOrder validates the rate and recipient of a payment order. Orders to a specific provider must be
+// on a network the provider supports and, for private providers, within the provider's order amount range
+func (s *PaymentOrderService) ValidateOrder(ctx context.Context, token *ent.Token, amount, rate decimal.Decimal, recipient types.PaymentOrderRecipient) error {
+	if !rate.IsPositive() {
+		return &OrderValidationError{Field: "Rate", Message: "Rate must be greater than zero"}
+	}
+
+	institutionExists, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(recipient.Institution)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to validate institution: %w", err)
+	}
+	if !institutionExists {
+		return &OrderValidationError{Field: "Recipient", Message: "Invalid institution code provided"}
+	}
+
+	if recipient.ProviderID == "" {
+		return nil
+	}
+
+	providerProfile, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDEQ(recipient.ProviderID)).
+		WithOrderTokens().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &OrderValidationError{Message: "Provider not found"}
+		}
+		return fmt.Errorf("failed to fetch provider profile: %w", err)
+	}
+
+	// Find the provider's configuration of the token on the order's network
+	var orderToken *ent.ProviderOrderToken
+out:
+	for _, providerOrderToken := range providerProfile.Edges.OrderTokens {
+		if providerOrderToken.Symbol != token.Symbol {
+			continue
+		}
+		for _, address := range providerOrderToken.Addresses {
+			if address.Network == token.Edges.Network.Identifier {
+				orderToken = providerOrderToken
+				break out
+			}
+		}
+	}
+
+	if orderToken == nil {
+		return &OrderValidationError{Message: "The selected network is not supported by the specified provider"}
+	}
+
+	// Validate amount for private orders
+	if providerProfile.VisibilityMode == providerprofile.VisibilityModePrivate {
+		if amount.LessThan(orderToken.MinOrderAmount) {
+			return &OrderValidationError{Message: "The amount is below the minimum order amount for the specified provider"}
+		} else if amount.GreaterThan(orderToken.MaxOrderAmount) {
+			return &OrderValidationError{Message: "The amount is beyond the maximum order amount for the specified provider"}
+		}
+	}
+
+	return nil
+}
+
+// CreateReceiveAddress generates a new receive address for the given network and saves it using the given client
+func (s *PaymentOrderService) CreateReceiveAddress(ctx context.Context, client *ent.Client, networkIdentifier string, sandbox bool) (*ent.ReceiveAddress, error) {
+	var address string
+	var salt []byte
+	var err error
+
+	if sandbox {
+		address, salt, err = s.receiveAddressService.CreateSandboxAddress(ctx, networkIdentifier)
+	} else if strings.HasPrefix(networkIdentifier, "tron") {
+		address, salt, err = s.receiveAddressService.CreateTronAddress(ctx)
+	} else {
+		address, salt, err = s.receiveAddressService.CreateSmartAddress(ctx, nil, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ReceiveAddress.
+		Create().
+		SetAddress(address).
+		SetSalt(salt).
+		SetStatus(receiveaddress.StatusUnused).
+		SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
+		Save(ctx)
+}
+
+// CreatePaymentOrder creates a payment order with its transaction log, recipient and splits in the given transaction.
+// Orders of a batch are funded through the batch's receive address instead of their own.
+// The caller commits the transaction, or rolls it back on error
+func (s *PaymentOrderService) CreatePaymentOrder(ctx context.Context, tx *ent.Tx, params PaymentOrderParams, receiveAddress *ent.ReceiveAddress) (*ent.PaymentOrder, error) {
+	metadata := map[string]interface{}{
+		"ReceiveAddress": receiveAddress.Address,
+		"SenderID":       params.Sender.ID.String(),
+	}
+	for key, value := range params.Metadata {
+		metadata[key] = value
+	}
+
+	transactionLog, err := tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusOrderInitiated).
+		SetMetadata(metadata).
+		SetNetwork(params.Token.Edges.Network.Identifier).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction log: %w", err)
+	}
+
+	var feeTierName string
+	if params.FeeTier != nil {
+		feeTierName = params.FeeTier.Name
+	}
+
+	paymentOrderCreate := tx.PaymentOrder.
+		Create()
+	if params.Batch != nil {
+		paymentOrderCreate.SetBatch(params.Batch)
+	} else {
+		paymentOrderCreate.SetReceiveAddress(receiveAddress)
+	}
+
+	paymentOrder, err := paymentOrderCreate.
+		SetSenderProfile(params.Sender).
+		SetAmount(params.Amount).
+		SetAmountPaid(decimal.NewFromInt(0)).
+		SetAmountReturned(decimal.NewFromInt(0)).
+		SetPercentSettled(decimal.NewFromInt(0)).
+		SetNetworkFee(params.Token.Edges.Network.Fee).
+		SetProtocolFee(decimal.NewFromInt(0)).
+		SetSenderFee(utils.SenderFee(params.Amount, params.FeePercent, params.FeeTier)).
+		SetToken(params.Token).
+		SetRate(params.Rate).
+		SetReceiveAddressText(receiveAddress.Address).
+		SetFeePercent(params.FeePercent).
+		SetFeeAddress(params.FeeAddress).
+		SetReturnAddress(params.ReturnAddress).
+		SetReference(params.Reference).
+		SetFeeTier(feeTierName).
+		SetUnderpaymentPolicy(params.UnderpaymentPolicy).
+		SetOverpaymentPolicy(params.OverpaymentPolicy).
+		SetIsSandbox(params.IsSandbox).
+		AddTransactions(transactionLog).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment order: %w", err)
+	}
+
+	_, err = tx.PaymentOrderRecipient.
+		Create().
+		SetInstitution(params.Recipient.Institution).
+		SetAccountIdentifier(params.Recipient.AccountIdentifier).
+		SetAccountName(params.Recipient.AccountName).
+		SetProviderID(params.Recipient.ProviderID).
+		SetMemo(params.Recipient.Memo).
+		SetPaymentOrder(paymentOrder).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment order recipient: %w", err)
+	}
+
+	if len(params.Splits) > 0 {
+		builders := make([]*ent.PaymentOrderSplitCreate, len(params.Splits))
+		for i, split := range params.Splits {
+			builders[i] = tx.PaymentOrderSplit.
+				Create().
+				SetInstitution(split.Institution).
+				SetAccountIdentifier(split.AccountIdentifier).
+				SetAccountName(split.AccountName).
+				SetMemo(split.Memo).
+				SetAmount(params.SplitAmounts[i]).
+				SetPercent(params.SplitAmounts[i].Div(params.Amount).Mul(decimal.NewFromInt(100))).
+				SetPaymentOrder(paymentOrder)
+		}
+
+		_, err = tx.PaymentOrderSplit.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create payment order splits: %w", err)
+		}
+	}
+
+	return paymentOrder, nil
+}
@@ -2,31 +2,38 @@ package services
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
-	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	"github.com/paycrest/aggregator/utils"
-	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
 	"github.com/paycrest/aggregator/utils/logger"
-	tokenUtils "github.com/paycrest/aggregator/utils/token"
 	"github.com/shopspring/decimal"
 )
 
-type PriorityQueueService struct{}
+type PriorityQueueService struct {
+	balanceService       *ProviderBalanceService
+	rateExclusionService *RateExclusionService
+}
 
 // NewPriorityQueueService creates a new instance of PriorityQueueService
 func NewPriorityQueueService() *PriorityQueueService {
-	return &PriorityQueueService{}
+	return &PriorityQueueService{
+		balanceService:       NewProviderBalanceService(),
+		rateExclusionService: NewRateExclusionService(),
+	}
 }
 
 // ProcessBucketQueues creates a priority queue for each bucket and saves it to redis
@@ -40,6 +47,12 @@ func (s *PriorityQueueService) ProcessBucketQueues() error {
 		return fmt.Errorf("ProcessBucketQueues.GetProvisionBuckets: %w", err)
 	}
 
+	// Tokens of providers that left the queues are no longer excluded
+	err = s.rateExclusionService.PruneExclusions(ctx)
+	if err != nil {
+		logger.Errorf("failed to prune rate exclusions: %v", err)
+	}
+
 	for _, bucket := range buckets {
 		go s.CreatePriorityQueueForBucket(ctx, bucket)
 	}
@@ -53,18 +66,13 @@ func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context) ([]*ent.
 		Query().
 		Select(provisionbucket.FieldMinAmount, provisionbucket.FieldMaxAmount).
 		WithProviderProfiles(func(ppq *ent.ProviderProfileQuery) {
-			// ppq.WithProviderRating(func(prq *ent.ProviderRatingQuery) {
-			// 	prq.Select(providerrating.FieldTrustScore)
-			// })
+			ppq.WithProviderRating(func(prq *ent.ProviderRatingQuery) {
+				prq.Select(providerrating.FieldTrustScore)
+			})
 			ppq.Select(providerprofile.FieldID)
 
 			// Filter only providers that are always available
-			ppq.Where(
-				providerprofile.IsAvailable(true),
-				providerprofile.IsActive(true),
-				providerprofile.IsKybVerified(true),
-				providerprofile.VisibilityModeEQ(providerprofile.VisibilityModePublic),
-			)
+			ppq.Where(queuedProvider())
 		}).
 		WithCurrency().
 		All(ctx)
@@ -75,8 +83,18 @@ func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context) ([]*ent.
 	return buckets, nil
 }
 
-// GetProviderRate returns the rate for a provider
-func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *ent.ProviderProfile, token string) (decimal.Decimal, error) {
+// queuedProvider matches the providers considered for the bucket queues
+func queuedProvider() predicate.ProviderProfile {
+	return providerprofile.And(
+		providerprofile.IsAvailable(true),
+		providerprofile.IsActive(true),
+		providerprofile.IsKybVerified(true),
+		providerprofile.VisibilityModeEQ(providerprofile.VisibilityModePublic),
+	)
+}
+
+// GetProviderRate returns the rate for a provider for an order of the given token amount
+func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *ent.ProviderProfile, token string, amount decimal.Decimal) (decimal.Decimal, error) {
 	// Fetch the token config for the provider
 	tokenConfig, err := storage.Client.ProviderOrderToken.
 		Query().
@@ -91,26 +109,79 @@ func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *en
 			providerordertoken.FieldConversionRateType,
 			providerordertoken.FieldFixedConversionRate,
 			providerordertoken.FieldFloatingConversionRate,
+			providerordertoken.FieldRateBands,
 		).
 		First(ctx)
 	if err != nil {
 		return decimal.Decimal{}, err
 	}
 
-	var rate decimal.Decimal
+	return ProviderTokenRate(tokenConfig, tokenConfig.Edges.Provider.Edges.Currency.MarketRate, amount), nil
+}
+
+// ProviderTokenRate returns the rate a provider token offers for an order of the given token amount,
+// using the rate band covering the amount if there is one
+func ProviderTokenRate(token *ent.ProviderOrderToken, marketRate, amount decimal.Decimal) decimal.Decimal {
+	if band, ok := RateBand(token, amount); ok {
+		return conversionRate(token.ConversionRateType, band.FixedConversionRate, band.FloatingConversionRate, marketRate)
+	}
 
-	if tokenConfig.ConversionRateType == providerordertoken.ConversionRateTypeFixed {
-		rate = tokenConfig.FixedConversionRate
-	} else {
-		// Handle floating rate case
-		marketRate := tokenConfig.Edges.Provider.Edges.Currency.MarketRate
-		floatingRate := tokenConfig.FloatingConversionRate // in percentage
+	return conversionRate(token.ConversionRateType, token.FixedConversionRate, token.FloatingConversionRate, marketRate)
+}
 
-		// Calculate the floating rate based on the market rate
-		rate = marketRate.Add(floatingRate).RoundBank(2)
+// RateBand returns the rate band of a provider token covering the given token amount. Bands are kept sorted
+// by their minimum amount, so an amount on the boundary of two bands falls in the lower one
+func RateBand(token *ent.ProviderOrderToken, amount decimal.Decimal) (types.ProviderRateBand, bool) {
+	for _, band := range token.RateBands {
+		if amount.GreaterThanOrEqual(band.MinAmount) && amount.LessThanOrEqual(band.MaxAmount) {
+			return band, true
+		}
 	}
 
-	return rate, nil
+	return types.ProviderRateBand{}, false
+}
+
+// ProviderTokenFiatRange returns the smallest and largest fiat amounts a provider token covers over its order amount
+// range, pricing each rate band at its own rate and the rest of the range at the token rate
+func ProviderTokenFiatRange(token *ent.ProviderOrderToken, marketRate decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	rate := conversionRate(token.ConversionRateType, token.FixedConversionRate, token.FloatingConversionRate, marketRate)
+
+	// Split the order amount range into the bands and the gaps around them
+	type segment struct {
+		minAmount, maxAmount, rate decimal.Decimal
+	}
+	var segments []segment
+	from := token.MinOrderAmount
+	for _, band := range token.RateBands {
+		if band.MinAmount.GreaterThan(from) {
+			segments = append(segments, segment{from, band.MinAmount, rate})
+		}
+		bandRate := conversionRate(token.ConversionRateType, band.FixedConversionRate, band.FloatingConversionRate, marketRate)
+		segments = append(segments, segment{band.MinAmount, band.MaxAmount, bandRate})
+		from = band.MaxAmount
+	}
+	if len(segments) == 0 || token.MaxOrderAmount.GreaterThan(from) {
+		segments = append(segments, segment{from, token.MaxOrderAmount, rate})
+	}
+
+	minFiat := segments[0].minAmount.Mul(segments[0].rate)
+	maxFiat := segments[0].maxAmount.Mul(segments[0].rate)
+	for _, s := range segments[1:] {
+		minFiat = decimal.Min(minFiat, s.minAmount.Mul(s.rate))
+		maxFiat = decimal.Max(maxFiat, s.maxAmount.Mul(s.rate))
+	}
+
+	return minFiat, maxFiat
+}
+
+// conversionRate returns a fixed rate, or the market rate offset by a floating rate
+func conversionRate(rateType providerordertoken.ConversionRateType, fixedRate, floatingRate, marketRate decimal.Decimal) decimal.Decimal {
+	if rateType == providerordertoken.ConversionRateTypeFixed {
+		return fixedRate
+	}
+
+	// Calculate the floating rate (in percentage) based on the market rate
+	return marketRate.Add(floatingRate).RoundBank(2)
 }
 
 // deleteQueue deletes existing circular queue
@@ -127,11 +198,9 @@ func (s *PriorityQueueService) deleteQueue(ctx context.Context, key string) erro
 func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context, bucket *ent.ProvisionBucket) {
 	// Create a slice to store the provider profiles sorted by trust score
 	providers := bucket.Edges.ProviderProfiles
-	// sort.SliceStable(providers, func(i, j int) bool {
-	// 	trustScoreI, _ := providers[i].Edges.ProviderRating.TrustScore.Float64()
-	// 	trustScoreJ, _ := providers[j].Edges.ProviderRating.TrustScore.Float64()
-	// 	return trustScoreI > trustScoreJ // Sort in descending order
-	// })
+	sort.SliceStable(providers, func(i, j int) bool {
+		return trustScore(providers[i]).GreaterThan(trustScore(providers[j])) // Sort in descending order
+	})
 
 	redisKey := fmt.Sprintf("bucket_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
 
@@ -159,12 +228,35 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 	}
 
 	for _, provider := range providers {
+		// Skip providers whose fiat balance can't cover the smallest order in the bucket
+		covered, err := s.balanceService.CanCover(ctx, provider.ID, bucket.Edges.Currency.Code, bucket.MinAmount)
+		if err != nil {
+			if err != context.Canceled {
+				logger.Errorf("failed to get fiat balance for provider %s: %v", provider.ID, err)
+			}
+			continue
+		}
+		if !covered {
+			continue
+		}
+
 		tokens, err := storage.Client.ProviderOrderToken.
 			Query().
 			Where(
 				providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
 			).
-			Select(providerordertoken.FieldSymbol, providerordertoken.FieldMinOrderAmount, providerordertoken.FieldMaxOrderAmount).
+			Select(
+				providerordertoken.FieldSymbol,
+				providerordertoken.FieldConversionRateType,
+				providerordertoken.FieldFixedConversionRate,
+				providerordertoken.FieldFloatingConversionRate,
+				providerordertoken.FieldMinOrderAmount,
+				providerordertoken.FieldMaxOrderAmount,
+				providerordertoken.FieldRateSlippage,
+				providerordertoken.FieldRateSlippageType,
+				providerordertoken.FieldRateBands,
+			).
+			WithRateExclusion().
 			All(ctx)
 		if err != nil {
 			if err != context.Canceled {
@@ -175,25 +267,44 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 
 		for _, token := range tokens {
 			providerID := provider.ID
-			rate, err := s.GetProviderRate(ctx, provider, token.Symbol)
-			if err != nil {
-				if err != context.Canceled {
-					logger.Errorf("failed to get %s rate for provider %s: %v", token.Symbol, providerID, err)
-				}
-				continue
+			marketRate := bucket.Edges.Currency.MarketRate
+			rate := conversionRate(token.ConversionRateType, token.FixedConversionRate, token.FloatingConversionRate, marketRate)
+
+			// Check provider's rates against the market rate to ensure none is too far off
+			bandRates := make([]decimal.Decimal, len(token.RateBands))
+			for i, band := range token.RateBands {
+				bandRates[i] = conversionRate(token.ConversionRateType, band.FixedConversionRate, band.FloatingConversionRate, marketRate)
 			}
 
-			// Check provider's rate against the market rate to ensure it's not too far off
-			percentDeviation := utils.AbsPercentageDeviation(bucket.Edges.Currency.MarketRate, rate)
+			staleRate, percentDeviation := rate, utils.AbsPercentageDeviation(marketRate, rate)
+			for _, bandRate := range bandRates {
+				if bandDeviation := utils.AbsPercentageDeviation(marketRate, bandRate); bandDeviation.GreaterThan(percentDeviation) {
+					staleRate, percentDeviation = bandRate, bandDeviation
+				}
+			}
 
 			if serverConf.Environment == "production" && percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
-				// Skip this provider if the rate is too far off
-				// TODO: add a logic to notify the provider(s) to update his rate since it's stale. could be a cron job
+				// Skip this provider if a rate is too far off, recording the exclusion so the provider is notified
+				err = s.rateExclusionService.RecordStaleRate(ctx, token.ID, staleRate, marketRate, percentDeviation)
+				if err != nil && err != context.Canceled {
+					logger.Errorf("failed to record stale %s rate for provider %s: %v", token.Symbol, providerID, err)
+				}
 				continue
 			}
 
-			// Serialize the provider ID, token, rate, min and max order amount into a single string
-			data := fmt.Sprintf("%s:%s:%s:%s:%s", providerID, token.Symbol, rate, token.MinOrderAmount, token.MaxOrderAmount)
+			if token.Edges.RateExclusion != nil {
+				err = s.rateExclusionService.ClearExclusion(ctx, token.ID)
+				if err != nil && err != context.Canceled {
+					logger.Errorf("failed to clear %s rate exclusion for provider %s: %v", token.Symbol, providerID, err)
+				}
+			}
+
+			// Serialize the provider ID, token, rate, min and max order amount, slippage and rate bands into a single string
+			bands := make([]string, len(token.RateBands))
+			for i, band := range token.RateBands {
+				bands[i] = fmt.Sprintf("%s/%s/%s/%s", band.MinAmount, band.MaxAmount, bandRates[i], RateSlippage(token, bandRates[i]))
+			}
+			data := fmt.Sprintf("%s:%s:%s:%s:%s:%s:%s", providerID, token.Symbol, rate, token.MinOrderAmount, token.MaxOrderAmount, RateSlippage(token, rate), strings.Join(bands, ","))
 
 			// Enqueue the serialized data into the circular queue
 			err = storage.RedisClient.RPush(ctx, redisKey, data).Err()
@@ -228,7 +339,7 @@ func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order
 			// TODO: check for provider's minimum and maximum rate for negotiation
 			// Update the rate with the current rate if order was last updated more than 10 mins ago
 			if order.UpdatedAt.Before(time.Now().Add(-10 * time.Minute)) {
-				order.Rate, err = s.GetProviderRate(ctx, provider, order.Token.Symbol)
+				order.Rate, err = s.GetProviderRate(ctx, provider, order.Token.Symbol, order.Amount)
 				if err != nil {
 					logger.Errorf("%s - failed to get rate for provider %s: %v", orderIDPrefix, order.ProviderID, err)
 				}
@@ -241,11 +352,24 @@ func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order
 					logger.Errorf("%s - failed to update rate for provider %s: %v", orderIDPrefix, order.ProviderID, err)
 				}
 			}
-			err = s.sendOrderRequest(ctx, order)
-			if err == nil {
-				return nil
+
+			// Only send the order request if the provider is open, can pay out to the institution
+			// and its fiat balance can cover the order
+			if !utils.IsProviderOpen(provider, time.Now()) {
+				logger.Errorf("%s - provider %s is outside its operating hours", orderIDPrefix, order.ProviderID)
+			} else if !utils.SupportsInstitution(provider, order.Institution) {
+				logger.Errorf("%s - provider %s does not support institution %s", orderIDPrefix, order.ProviderID, order.Institution)
+			} else if covered, err := s.canCoverOrder(ctx, order); err != nil {
+				logger.Errorf("%s - failed to check fiat balance for provider %s: %v", orderIDPrefix, order.ProviderID, err)
+			} else if !covered {
+				logger.Errorf("%s - provider %s has insufficient fiat balance", orderIDPrefix, order.ProviderID)
+			} else {
+				err = s.sendOrderRequest(ctx, order)
+				if err == nil {
+					return nil
+				}
+				logger.Errorf("%s - failed to send order request to specific provider %s: %v", orderIDPrefix, order.ProviderID, err)
 			}
-			logger.Errorf("%s - failed to send order request to specific provider %s: %v", orderIDPrefix, order.ProviderID, err)
 		} else {
 			logger.Errorf("%s - failed to get provider: %v", orderIDPrefix, err)
 		}
@@ -295,6 +419,24 @@ func (s *PriorityQueueService) sendOrderRequest(ctx context.Context, order types
 		return err
 	}
 
+	// Reserve the provider's fiat balance while the order request is outstanding
+	currencyCode, err := s.orderCurrencyCode(ctx, order)
+	if err != nil {
+		logger.Errorf("failed to get currency for order request: %v", err)
+		return err
+	}
+
+	err = s.balanceService.ReserveBalance(ctx, order.ProviderID, currencyCode, order.ID, order.Amount.Mul(order.Rate).RoundBank(0))
+	if err != nil {
+		logger.Errorf("failed to reserve fiat balance for order request: %v", err)
+
+		// Concurrent order requests may have used up the balance since it was checked, so unassign the order
+		if delErr := storage.RedisClient.Del(ctx, orderKey).Err(); delErr != nil {
+			logger.Errorf("failed to remove order request: %v", delErr)
+		}
+		return err
+	}
+
 	// Notify the provider
 	orderRequestData["orderId"] = order.ID
 	if err := s.notifyProvider(ctx, orderRequestData); err != nil {
@@ -302,10 +444,12 @@ func (s *PriorityQueueService) sendOrderRequest(ctx context.Context, order types
 		return err
 	}
 
+	NewTrustScoreService().RecordOrderEvent(ctx, order.ProviderID, ProviderOrderRequested)
+
 	return nil
 }
 
-// notifyProvider sends an order request notification to a provider
+// notifyProvider sends an order request notification to a provider over the provider stream or HTTP push
 // TODO: ideally notifications should be moved to a notification service
 func (s *PriorityQueueService) notifyProvider(ctx context.Context, orderRequestData map[string]interface{}) error {
 	// TODO: can we add mode and host identifier to redis during priority queue creation?
@@ -317,38 +461,16 @@ func (s *PriorityQueueService) notifyProvider(ctx context.Context, orderRequestD
 		Where(
 			providerprofile.IDEQ(providerID),
 		).
-		WithAPIKey().
 		Only(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Compute HMAC
-	decodedSecret, err := base64.StdEncoding.DecodeString(provider.Edges.APIKey.Secret)
-	if err != nil {
-		return err
-	}
-	decryptedSecret, err := cryptoUtils.DecryptPlain(decodedSecret)
-	if err != nil {
-		return err
-	}
-
-	signature := tokenUtils.GenerateHMACSignature(orderRequestData, string(decryptedSecret))
-
-	// Send POST request to the provider's node
-	res, err := fastshot.NewClient(provider.HostIdentifier).
-		Config().SetTimeout(30*time.Second).
-		Header().Add("X-Request-Signature", signature).
-		Build().POST("/new_order").
-		Body().AsJSON(orderRequestData).
-		Send()
-	if err != nil {
-		return err
-	}
-
-	data, err := utils.ParseJSONResponse(res.RawResponse)
-	if err != nil {
+	data, err := NewProviderStreamService().Send(ctx, provider, ProviderStreamNewOrder, orderRequestData, 30*time.Second)
+	if errors.Is(err, ErrProviderResponse) {
 		logger.Errorf("PriorityQueueService.notifyProvider: %v %v", err, data)
+	} else if err != nil {
+		return err
 	}
 
 	return nil
@@ -376,9 +498,9 @@ func (s *PriorityQueueService) matchRate(ctx context.Context, redisKey string, o
 		// 	providerData = partnerProviders[randomIndex]
 		// }
 
-		// Extract the rate from the data (assuming it's in the format "providerID:token:rate:minAmount:maxAmount")
+		// Extract the rate from the data (assuming it's in the format "providerID:token:rate:minAmount:maxAmount:slippage:rateBands")
 		parts := strings.Split(providerData, ":")
-		if len(parts) != 5 {
+		if len(parts) != 7 {
 			logger.Errorf("%s - invalid data format at index %d: %s", orderIDPrefix, index, providerData)
 			continue // Skip this entry due to invalid format
 		}
@@ -410,14 +532,34 @@ func (s *PriorityQueueService) matchRate(ctx context.Context, redisKey string, o
 			continue
 		}
 
-		// Fetch and check provider for rate match
-		rate, err := decimal.NewFromString(parts[2])
+		// Fetch and check provider for rate match, using the rate band for the order amount
+		rate, slippage, err := utils.QueueEntryRate(parts, order.Amount)
 		if err != nil {
 			continue
 		}
 
-		// TODO: make the slippage of 0.5 configurable by provider
-		if rate.Sub(order.Rate).Abs().LessThanOrEqual(decimal.NewFromFloat(0.5)) {
+		if rate.Sub(order.Rate).Abs().LessThanOrEqual(slippage) {
+			// Skip entry if provider is outside its operating hours, as the queue may predate its closing,
+			// or can't pay out to the recipient's institution
+			eligible, err := s.isProviderEligible(ctx, order)
+			if err != nil {
+				logger.Errorf("%s - failed to check eligibility of provider %s: %v", orderIDPrefix, order.ProviderID, err)
+				continue
+			}
+			if !eligible {
+				continue
+			}
+
+			// Skip entry if provider's fiat balance can't cover the order
+			covered, err := s.canCoverOrder(ctx, order)
+			if err != nil {
+				logger.Errorf("%s - failed to check fiat balance for provider %s: %v", orderIDPrefix, order.ProviderID, err)
+				continue
+			}
+			if !covered {
+				continue
+			}
+
 			// Found a match for the rate
 			if index == 0 {
 				// Match found at index 0, perform LPOP to dequeue
@@ -457,3 +599,75 @@ func (s *PriorityQueueService) matchRate(ctx context.Context, redisKey string, o
 
 	return nil
 }
+
+// trustScore returns a provider's trust score, or the default score for providers without a rating
+func trustScore(provider *ent.ProviderProfile) decimal.Decimal {
+	if provider.Edges.ProviderRating == nil {
+		return DefaultTrustScore
+	}
+
+	return provider.Edges.ProviderRating.TrustScore
+}
+
+// RateSlippage returns the absolute rate difference a provider tolerates for a token at its current rate
+func RateSlippage(token *ent.ProviderOrderToken, rate decimal.Decimal) decimal.Decimal {
+	if token.RateSlippageType == providerordertoken.RateSlippageTypePercentage {
+		return rate.Mul(token.RateSlippage).Div(decimal.NewFromInt(100))
+	}
+
+	return token.RateSlippage
+}
+
+// isProviderEligible checks if the provider assigned to an order is within its operating hours
+// and can pay out to the recipient's institution
+func (s *PriorityQueueService) isProviderEligible(ctx context.Context, order types.LockPaymentOrderFields) (bool, error) {
+	provider, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDEQ(order.ProviderID)).
+		Select(
+			providerprofile.FieldTimezone,
+			providerprofile.FieldOperatingHours,
+			providerprofile.FieldHolidays,
+			providerprofile.FieldSupportedInstitutions,
+			providerprofile.FieldUnsupportedInstitutions,
+		).
+		Only(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return utils.IsProviderOpen(provider, time.Now()) && utils.SupportsInstitution(provider, order.Institution), nil
+}
+
+// canCoverOrder checks whether the provider assigned to an order has enough fiat balance to fulfil it
+func (s *PriorityQueueService) canCoverOrder(ctx context.Context, order types.LockPaymentOrderFields) (bool, error) {
+	currencyCode, err := s.orderCurrencyCode(ctx, order)
+	if err != nil {
+		return false, err
+	}
+
+	return s.balanceService.CanCover(ctx, order.ProviderID, currencyCode, order.Amount.Mul(order.Rate).RoundBank(0))
+}
+
+// orderCurrencyCode returns the fiat currency an order is paid out in.
+// Orders for private providers may have no provision bucket, so the recipient's institution is used instead
+func (s *PriorityQueueService) orderCurrencyCode(ctx context.Context, order types.LockPaymentOrderFields) (string, error) {
+	if order.ProvisionBucket != nil && order.ProvisionBucket.Edges.Currency != nil {
+		return order.ProvisionBucket.Edges.Currency.Code, nil
+	}
+
+	inst, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(order.Institution)).
+		WithFiatCurrency().
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if inst.Edges.FiatCurrency == nil {
+		return "", fmt.Errorf("institution %s has no currency", order.Institution)
+	}
+
+	return inst.Edges.FiatCurrency.Code, nil
+}
@@ -8,12 +8,15 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/jarcoal/httpmock"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/enttest"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	db "github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
@@ -298,7 +301,7 @@ func TestPriorityQueueTest(t *testing.T) {
 	})
 
 	t.Run("TestGetProviderRate", func(t *testing.T) {
-		rate, err := service.GetProviderRate(context.Background(), testCtxForPQ.publicProviderProfile, testCtxForPQ.token.Symbol)
+		rate, err := service.GetProviderRate(context.Background(), testCtxForPQ.publicProviderProfile, testCtxForPQ.token.Symbol, decimal.NewFromInt(100))
 		assert.NoError(t, err)
 		_rate, ok := rate.Float64()
 		assert.True(t, ok)
@@ -390,6 +393,90 @@ func TestPriorityQueueTest(t *testing.T) {
 		})
 	})
 
+	t.Run("TestProviderFiatBalance", func(t *testing.T) {
+		ctx := context.Background()
+		balanceService := NewProviderBalanceService()
+		providerID := testCtxForPQ.publicProviderProfile.ID
+		currencyCode := testCtxForPQ.currency.Code
+
+		_, err := balanceService.UpdateBalance(ctx, providerID, currencyCode, decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+
+		// Reserve part of the balance for an outstanding order request
+		orderID := uuid.New()
+		orderKey := fmt.Sprintf("order_request_%s", orderID)
+		err = db.RedisClient.HSet(ctx, orderKey, "providerId", providerID).Err()
+		assert.NoError(t, err)
+
+		err = balanceService.ReserveBalance(ctx, providerID, currencyCode, orderID, decimal.NewFromInt(400))
+		assert.NoError(t, err)
+
+		available, reported, err := balanceService.GetAvailableBalance(ctx, providerID, currencyCode)
+		assert.NoError(t, err)
+		assert.True(t, reported)
+		assert.True(t, available.Equal(decimal.NewFromInt(600)))
+
+		covered, err := balanceService.CanCover(ctx, providerID, currencyCode, decimal.NewFromInt(601))
+		assert.NoError(t, err)
+		assert.False(t, covered)
+
+		// Reservations beyond the available balance are rejected
+		otherOrderID := uuid.New()
+		err = db.RedisClient.HSet(ctx, fmt.Sprintf("order_request_%s", otherOrderID), "providerId", providerID).Err()
+		assert.NoError(t, err)
+
+		err = balanceService.ReserveBalance(ctx, providerID, currencyCode, otherOrderID, decimal.NewFromInt(601))
+		assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+		// Accepting the order request spends the reserved balance
+		err = balanceService.DeductReservedBalance(ctx, providerID, orderID)
+		assert.NoError(t, err)
+
+		available, _, err = balanceService.GetAvailableBalance(ctx, providerID, currencyCode)
+		assert.NoError(t, err)
+		assert.True(t, available.Equal(decimal.NewFromInt(600)))
+
+		// Reservations are released once the order request expires
+		orderID = uuid.New()
+		err = balanceService.ReserveBalance(ctx, providerID, currencyCode, orderID, decimal.NewFromInt(600))
+		assert.NoError(t, err)
+
+		available, _, err = balanceService.GetAvailableBalance(ctx, providerID, currencyCode)
+		assert.NoError(t, err)
+		assert.True(t, available.Equal(decimal.NewFromInt(600)))
+
+		// Concurrent reservations can't reserve more than the available balance
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		reservations := 0
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				orderID := uuid.New()
+				if err := db.RedisClient.HSet(ctx, fmt.Sprintf("order_request_%s", orderID), "providerId", providerID).Err(); err != nil {
+					return
+				}
+				if err := balanceService.ReserveBalance(ctx, providerID, currencyCode, orderID, decimal.NewFromInt(200)); err == nil {
+					mu.Lock()
+					reservations++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, reservations, 3)
+		available, _, err = balanceService.GetAvailableBalance(ctx, providerID, currencyCode)
+		assert.NoError(t, err)
+		assert.False(t, available.IsNegative())
+
+		// Providers without a reported balance are not limited
+		covered, err = balanceService.CanCover(ctx, testCtxForPQ.privateProviderProfile.ID, currencyCode, decimal.NewFromInt(1000000))
+		assert.NoError(t, err)
+		assert.True(t, covered)
+	})
+
 	// TODO: move these tests to tasks_test.go
 	// t.Run("TestNoErrorFunctions", func(t *testing.T) {
 
@@ -465,3 +552,86 @@ func TestPriorityQueueTest(t *testing.T) {
 	// 	})
 	// })
 }
+
+func TestRateSlippage(t *testing.T) {
+	rate := decimal.NewFromInt(1500)
+
+	t.Run("absolute", func(t *testing.T) {
+		slippage := RateSlippage(&ent.ProviderOrderToken{
+			RateSlippage:     decimal.NewFromFloat(0.5),
+			RateSlippageType: providerordertoken.RateSlippageTypeAbsolute,
+		}, rate)
+		assert.True(t, slippage.Equal(decimal.NewFromFloat(0.5)), "expected slippage of 0.5, got %s", slippage)
+	})
+
+	t.Run("percentage", func(t *testing.T) {
+		slippage := RateSlippage(&ent.ProviderOrderToken{
+			RateSlippage:     decimal.NewFromFloat(0.2),
+			RateSlippageType: providerordertoken.RateSlippageTypePercentage,
+		}, rate)
+		assert.True(t, slippage.Equal(decimal.NewFromInt(3)), "expected slippage of 3, got %s", slippage)
+	})
+}
+
+func TestProviderTokenRate(t *testing.T) {
+	marketRate := decimal.NewFromInt(1500)
+	token := &ent.ProviderOrderToken{
+		ConversionRateType:     providerordertoken.ConversionRateTypeFloating,
+		FloatingConversionRate: decimal.NewFromInt(-10),
+		RateBands: []types.ProviderRateBand{
+			{MinAmount: decimal.Zero, MaxAmount: decimal.NewFromInt(500), FloatingConversionRate: decimal.NewFromInt(-5)},
+			{MinAmount: decimal.NewFromInt(500), MaxAmount: decimal.NewFromInt(5000), FloatingConversionRate: decimal.NewFromInt(2)},
+		},
+	}
+
+	tests := []struct {
+		amount   decimal.Decimal
+		expected decimal.Decimal
+	}{
+		{decimal.NewFromInt(100), decimal.NewFromInt(1495)},
+		{decimal.NewFromInt(500), decimal.NewFromInt(1495)}, // boundaries fall in the lower band
+		{decimal.NewFromInt(1000), decimal.NewFromInt(1502)},
+		{decimal.NewFromInt(10000), decimal.NewFromInt(1490)}, // outside the bands
+	}
+
+	for _, tt := range tests {
+		rate := ProviderTokenRate(token, marketRate, tt.amount)
+		assert.True(t, rate.Equal(tt.expected), "expected rate of %s for %s, got %s", tt.expected, tt.amount, rate)
+	}
+
+	token.ConversionRateType = providerordertoken.ConversionRateTypeFixed
+	token.RateBands[1].FixedConversionRate = decimal.NewFromInt(1510)
+	rate := ProviderTokenRate(token, marketRate, decimal.NewFromInt(1000))
+	assert.True(t, rate.Equal(decimal.NewFromInt(1510)), "expected rate of 1510, got %s", rate)
+}
+
+func TestProviderTokenFiatRange(t *testing.T) {
+	marketRate := decimal.NewFromInt(1500)
+	token := &ent.ProviderOrderToken{
+		ConversionRateType:  providerordertoken.ConversionRateTypeFixed,
+		FixedConversionRate: decimal.NewFromInt(1500),
+		MinOrderAmount:      decimal.NewFromInt(10),
+		MaxOrderAmount:      decimal.NewFromInt(1000),
+	}
+
+	minFiat, maxFiat := ProviderTokenFiatRange(token, marketRate)
+	assert.True(t, minFiat.Equal(decimal.NewFromInt(15000)), "expected min of 15000, got %s", minFiat)
+	assert.True(t, maxFiat.Equal(decimal.NewFromInt(1500000)), "expected max of 1500000, got %s", maxFiat)
+
+	// Bands covering either end of the range replace the token rate there
+	token.RateBands = []types.ProviderRateBand{
+		{MinAmount: decimal.NewFromInt(10), MaxAmount: decimal.NewFromInt(100), FixedConversionRate: decimal.NewFromInt(1400)},
+		{MinAmount: decimal.NewFromInt(500), MaxAmount: decimal.NewFromInt(1000), FixedConversionRate: decimal.NewFromInt(1600)},
+	}
+
+	minFiat, maxFiat = ProviderTokenFiatRange(token, marketRate)
+	assert.True(t, minFiat.Equal(decimal.NewFromInt(14000)), "expected min of 14000, got %s", minFiat)
+	assert.True(t, maxFiat.Equal(decimal.NewFromInt(1600000)), "expected max of 1600000, got %s", maxFiat)
+
+	// The token rate still applies in the gaps between bands
+	token.RateBands[1].FixedConversionRate = decimal.NewFromInt(1)
+
+	minFiat, maxFiat = ProviderTokenFiatRange(token, marketRate)
+	assert.True(t, minFiat.Equal(decimal.NewFromInt(500)), "expected min of 500, got %s", minFiat)
+	assert.True(t, maxFiat.Equal(decimal.NewFromInt(750000)), "expected max of 750000, got %s", maxFiat)
+}
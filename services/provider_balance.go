@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerfiatbalance"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderBalanceService tracks the fiat balances reported by providers and the amounts
+// reserved for their outstanding order requests.
+//
+// Reservations are kept in Redis alongside the order requests they belong to, so a reservation
+// is released as soon as its order request is accepted, declined, reassigned or expires
+type ProviderBalanceService struct{}
+
+// NewProviderBalanceService creates a new instance of ProviderBalanceService.
+func NewProviderBalanceService() *ProviderBalanceService {
+	return &ProviderBalanceService{}
+}
+
+// UpdateBalance sets the fiat balance a provider has available for a currency.
+// It returns a not found error when the currency is not enabled
+func (s *ProviderBalanceService) UpdateBalance(ctx context.Context, providerID string, currencyCode string, available decimal.Decimal) (*ent.ProviderFiatBalance, error) {
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(
+			fiatcurrency.CodeEQ(strings.ToUpper(currencyCode)),
+			fiatcurrency.IsEnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := storage.Client.ProviderFiatBalance.
+		Query().
+		Where(
+			providerfiatbalance.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerfiatbalance.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+
+		balance, err = storage.Client.ProviderFiatBalance.
+			Create().
+			SetProviderID(providerID).
+			SetCurrency(currency).
+			SetAvailableBalance(available).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		balance, err = balance.Update().
+			SetAvailableBalance(available).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	balance.Edges.Currency = currency
+	return balance, nil
+}
+
+// ClearBalance sets a provider's reported fiat balance for a currency to zero, e.g. when it runs out of float.
+// Providers that have not reported a balance for the currency are left unlimited
+func (s *ProviderBalanceService) ClearBalance(ctx context.Context, providerID string, currencyCode string) error {
+	return storage.Client.ProviderFiatBalance.
+		Update().
+		Where(
+			providerfiatbalance.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerfiatbalance.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)),
+		).
+		SetAvailableBalance(decimal.Zero).
+		Exec(ctx)
+}
+
+// ErrInsufficientBalance is returned when a provider's available fiat balance can't cover a reservation
+var ErrInsufficientBalance = errors.New("insufficient fiat balance")
+
+// maxReservationAttempts is the number of times a reservation is retried when concurrent reservations
+// change the provider's reserved balance
+const maxReservationAttempts = 5
+
+// GetAvailableBalance returns a provider's reported fiat balance for a currency less the amount reserved
+// for its outstanding order requests. reported is false when the provider has not reported a balance
+// for the currency, in which case its liquidity is not limited
+func (s *ProviderBalanceService) GetAvailableBalance(ctx context.Context, providerID string, currencyCode string) (available decimal.Decimal, reported bool, err error) {
+	balance, reported, err := s.reportedBalance(ctx, providerID, currencyCode)
+	if err != nil || !reported {
+		return decimal.Zero, reported, err
+	}
+
+	reserved, stale, err := s.reservedBalance(ctx, storage.RedisClient, providerID, currencyCode, "")
+	if err != nil {
+		return decimal.Zero, true, err
+	}
+
+	if len(stale) > 0 {
+		if err := storage.RedisClient.HDel(ctx, providerReservationsKey(providerID), stale...).Err(); err != nil {
+			return decimal.Zero, true, err
+		}
+	}
+
+	return balance.Sub(reserved), true, nil
+}
+
+// CanCover checks whether a provider has enough fiat balance available to fulfil an amount
+func (s *ProviderBalanceService) CanCover(ctx context.Context, providerID string, currencyCode string, amount decimal.Decimal) (bool, error) {
+	available, reported, err := s.GetAvailableBalance(ctx, providerID, currencyCode)
+	if err != nil {
+		return false, err
+	}
+
+	return !reported || available.GreaterThanOrEqual(amount), nil
+}
+
+// ReserveBalance reserves part of a provider's fiat balance for an order request.
+// The available balance is checked and reserved in a single Redis transaction, so concurrent order requests
+// can't reserve more than the provider has. It returns ErrInsufficientBalance when the balance can't cover the amount
+func (s *ProviderBalanceService) ReserveBalance(ctx context.Context, providerID string, currencyCode string, orderID uuid.UUID, amount decimal.Decimal) error {
+	key := providerReservationsKey(providerID)
+
+	balance, reported, err := s.reportedBalance(ctx, providerID, currencyCode)
+	if err != nil {
+		return err
+	}
+
+	reserve := func(tx *redis.Tx) error {
+		// A previous reservation of the order is replaced, so it is not counted
+		reserved, stale, err := s.reservedBalance(ctx, tx, providerID, currencyCode, orderID.String())
+		if err != nil {
+			return err
+		}
+
+		if reported && balance.Sub(reserved).LessThan(amount) {
+			return ErrInsufficientBalance
+		}
+
+		// The transaction fails if another reservation changed the provider's reservations since they were read
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if len(stale) > 0 {
+				pipe.HDel(ctx, key, stale...)
+			}
+			pipe.HSet(ctx, key, orderID.String(), fmt.Sprintf("%s:%s", currencyCode, amount))
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < maxReservationAttempts; i++ {
+		err = storage.RedisClient.Watch(ctx, reserve, key)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+
+	return fmt.Errorf("failed to reserve fiat balance: %w", err)
+}
+
+// ReleaseBalance releases the fiat balance reserved for an order request
+func (s *ProviderBalanceService) ReleaseBalance(ctx context.Context, providerID string, orderID uuid.UUID) error {
+	return storage.RedisClient.HDel(ctx, providerReservationsKey(providerID), orderID.String()).Err()
+}
+
+// DeductReservedBalance releases the fiat balance reserved for an accepted order request and deducts it from
+// the provider's reported balance, as the provider is now paying out the order
+func (s *ProviderBalanceService) DeductReservedBalance(ctx context.Context, providerID string, orderID uuid.UUID) error {
+	reservation, err := storage.RedisClient.HGet(ctx, providerReservationsKey(providerID), orderID.String()).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.ReleaseBalance(ctx, providerID, orderID); err != nil {
+		return err
+	}
+
+	currencyCode, amount, err := parseReservation(reservation)
+	if err != nil {
+		return err
+	}
+
+	// Decrement in SQL so concurrent balance updates are not overwritten
+	updated, err := storage.Client.ProviderFiatBalance.
+		Update().
+		Where(
+			providerfiatbalance.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerfiatbalance.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)),
+			providerfiatbalance.AvailableBalanceGTE(amount),
+		).
+		AddAvailableBalance(amount.Neg()).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	// The reported balance is lower than the reserved amount, so floor it at zero
+	return storage.Client.ProviderFiatBalance.
+		Update().
+		Where(
+			providerfiatbalance.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerfiatbalance.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)),
+			providerfiatbalance.AvailableBalanceLT(amount),
+		).
+		SetAvailableBalance(decimal.Zero).
+		Exec(ctx)
+}
+
+// reportedBalance returns the fiat balance a provider reported for a currency.
+// reported is false when the provider has not reported a balance for the currency
+func (s *ProviderBalanceService) reportedBalance(ctx context.Context, providerID string, currencyCode string) (decimal.Decimal, bool, error) {
+	balance, err := storage.Client.ProviderFiatBalance.
+		Query().
+		Where(
+			providerfiatbalance.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerfiatbalance.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return decimal.Zero, false, nil
+		}
+		return decimal.Zero, false, err
+	}
+
+	return balance.AvailableBalance, true, nil
+}
+
+// reservedBalance sums the fiat balance reserved for a provider's outstanding order requests in a currency,
+// skipping the reservation of excludeOrderID. It also returns the stale reservations, whose order request
+// is no longer assigned to the provider, for the caller to clean up
+func (s *ProviderBalanceService) reservedBalance(ctx context.Context, rdb redis.Cmdable, providerID string, currencyCode string, excludeOrderID string) (decimal.Decimal, []string, error) {
+	reservations, err := rdb.HGetAll(ctx, providerReservationsKey(providerID)).Result()
+	if err != nil {
+		return decimal.Zero, nil, err
+	}
+
+	reserved := decimal.Zero
+	var stale []string
+	for orderID, reservation := range reservations {
+		if orderID == excludeOrderID {
+			continue
+		}
+
+		assignedTo, err := rdb.HGet(ctx, fmt.Sprintf("order_request_%s", orderID), "providerId").Result()
+		if err != nil && err != redis.Nil {
+			return decimal.Zero, nil, err
+		}
+
+		if assignedTo != providerID {
+			// The order request has expired or was reassigned
+			stale = append(stale, orderID)
+			continue
+		}
+
+		code, amount, err := parseReservation(reservation)
+		if err != nil || code != currencyCode {
+			continue
+		}
+
+		reserved = reserved.Add(amount)
+	}
+
+	return reserved, stale, nil
+}
+
+// parseReservation parses a reservation in the format "currencyCode:amount"
+func parseReservation(reservation string) (string, decimal.Decimal, error) {
+	parts := strings.Split(reservation, ":")
+	if len(parts) != 2 {
+		return "", decimal.Zero, fmt.Errorf("invalid reservation format: %s", reservation)
+	}
+
+	amount, err := decimal.NewFromString(parts[1])
+	if err != nil {
+		return "", decimal.Zero, fmt.Errorf("invalid reservation amount: %w", err)
+	}
+
+	return parts[0], amount, nil
+}
+
+// providerReservationsKey is the Redis hash of a provider's reserved fiat balances keyed by order ID
+func providerReservationsKey(providerID string) string {
+	return fmt.Sprintf("provider_balance_reservations_%s", providerID)
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
+	"github.com/paycrest/aggregator/utils/logger"
+	tokenUtils "github.com/paycrest/aggregator/utils/token"
+)
+
+// Provider stream message types
+const (
+	ProviderStreamNewOrder       = "new_order"
+	ProviderStreamTxStatus       = "tx_status"
+	ProviderStreamOrderCancelled = "order_cancelled"
+	ProviderStreamAck            = "ack"
+	ProviderStreamBalance        = "balance"
+	ProviderStreamRateExcluded   = "rate_excluded"
+	ProviderStreamDispute        = "dispute"
+)
+
+const (
+	// Time allowed for a provider node to respond to a ping
+	providerStreamPongWait = 60 * time.Second
+
+	// Interval between pings, must be less than the pong wait
+	providerStreamPingPeriod = 30 * time.Second
+
+	// Time allowed to write a message to a provider node
+	providerStreamWriteWait = 10 * time.Second
+
+	// Maximum size of a message from a provider node
+	providerStreamMaxMessageSize = 64 * 1024
+)
+
+// providerPushPaths are the provider node endpoints used when a message can't be delivered over the stream.
+// Messages without a path are only delivered over the stream
+var providerPushPaths = map[string]string{
+	ProviderStreamNewOrder:     "/new_order",
+	ProviderStreamTxStatus:     "/tx_status",
+	ProviderStreamRateExcluded: "/rate_excluded",
+}
+
+// ErrProviderResponse is returned when a provider node responds to a message with an error or an invalid response
+var ErrProviderResponse = errors.New("invalid provider response")
+
+// ProviderStreamService delivers messages to provider nodes over the provider stream,
+// falling back to an HTTP push to the provider's host identifier.
+//
+// Stream connections may be held by any aggregator instance, so messages and acks are relayed through Redis
+type ProviderStreamService struct{}
+
+// NewProviderStreamService creates a new instance of ProviderStreamService.
+func NewProviderStreamService() *ProviderStreamService {
+	return &ProviderStreamService{}
+}
+
+// Send delivers a signed message to a provider node and returns the data of the node's response.
+// The message is sent over the provider stream when the node is connected, otherwise it is pushed to the node over HTTP.
+// A connected node that doesn't ack within the timeout may still act on the message, so it is not pushed again
+func (s *ProviderStreamService) Send(ctx context.Context, provider *ent.ProviderProfile, messageType string, payload map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	signature, err := providerSignature(ctx, provider, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	message := types.ProviderStreamMessage{
+		ID:        uuid.New().String(),
+		Type:      messageType,
+		Data:      payload,
+		Signature: signature,
+	}
+
+	data, streamed, err := s.stream(ctx, provider, message, timeout)
+	if streamed {
+		return data, err
+	}
+	if err != nil {
+		logger.Errorf("ProviderStreamService.Send: %v", err)
+	}
+
+	return s.push(provider, message, timeout)
+}
+
+// Notify delivers a message to a provider node over the provider stream without waiting for an ack.
+// Nodes that are not connected to the stream are not notified
+func (s *ProviderStreamService) Notify(ctx context.Context, provider *ent.ProviderProfile, messageType string, payload map[string]interface{}) error {
+	signature, err := providerSignature(ctx, provider, payload)
+	if err != nil {
+		return err
+	}
+
+	messageJSON, err := json.Marshal(types.ProviderStreamMessage{
+		ID:        uuid.New().String(),
+		Type:      messageType,
+		Data:      payload,
+		Signature: signature,
+	})
+	if err != nil {
+		return err
+	}
+
+	return storage.RedisClient.Publish(ctx, providerStreamChannel(provider.ID), messageJSON).Err()
+}
+
+// NotifyOrderCancelled notifies a provider node that an order it was assigned has been withdrawn
+func (s *ProviderStreamService) NotifyOrderCancelled(ctx context.Context, provider *ent.ProviderProfile, orderID uuid.UUID, reason string) {
+	err := s.Notify(ctx, provider, ProviderStreamOrderCancelled, map[string]interface{}{
+		"orderId": orderID.String(),
+		"reason":  reason,
+	})
+	if err != nil {
+		logger.Errorf("ProviderStreamService.NotifyOrderCancelled: %v", err)
+	}
+}
+
+// stream sends a message over the provider stream and waits for the node's ack.
+// streamed is false when the message wasn't published to any stream connection
+func (s *ProviderStreamService) stream(ctx context.Context, provider *ent.ProviderProfile, message types.ProviderStreamMessage, timeout time.Duration) (data map[string]interface{}, streamed bool, err error) {
+	messageJSON, err := json.Marshal(message)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Subscribe to the ack before publishing so it can't be missed
+	ackSub := storage.RedisClient.Subscribe(ctx, providerStreamAckChannel(message.ID))
+	defer ackSub.Close()
+
+	if _, err := ackSub.Receive(ctx); err != nil {
+		return nil, false, fmt.Errorf("failed to subscribe to ack: %w", err)
+	}
+
+	receivers, err := storage.RedisClient.Publish(ctx, providerStreamChannel(provider.ID), messageJSON).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to publish message: %w", err)
+	}
+	if receivers == 0 {
+		return nil, false, nil
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case msg := <-ackSub.Channel():
+		var ack types.ProviderStreamMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &ack); err != nil {
+			return nil, true, fmt.Errorf("%w: %v", ErrProviderResponse, err)
+		}
+		if ack.Error != "" {
+			return ack.Data, true, fmt.Errorf("%w: %s", ErrProviderResponse, ack.Error)
+		}
+		return ack.Data, true, nil
+	case <-timer.C:
+		return nil, true, fmt.Errorf("provider %s did not ack %s message %s", provider.ID, message.Type, message.ID)
+	case <-ctx.Done():
+		return nil, true, ctx.Err()
+	}
+}
+
+// push sends a message to the provider node's host identifier over HTTP
+func (s *ProviderStreamService) push(provider *ent.ProviderProfile, message types.ProviderStreamMessage, timeout time.Duration) (map[string]interface{}, error) {
+	path, ok := providerPushPaths[message.Type]
+	if !ok {
+		return nil, nil
+	}
+
+	if provider.HostIdentifier == "" {
+		return nil, fmt.Errorf("provider %s is not connected to the stream and has no host identifier", provider.ID)
+	}
+
+	res, err := fastshot.NewClient(provider.HostIdentifier).
+		Config().SetTimeout(timeout).
+		Header().Add("X-Request-Signature", message.Signature).
+		Build().POST(path).
+		Body().AsJSON(message.Data).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return data, fmt.Errorf("%w: %v", ErrProviderResponse, err)
+	}
+
+	return data, nil
+}
+
+// ServeConn relays messages for a provider to a stream connection and publishes the node's acks.
+// It blocks until the connection is closed or the context is done. A provider may hold several
+// connections, in which case every connection receives each message and the first ack is used
+func (s *ProviderStreamService) ServeConn(ctx context.Context, provider *ent.ProviderProfile, conn *websocket.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sub := storage.RedisClient.Subscribe(ctx, providerStreamChannel(provider.ID))
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		logger.Errorf("ProviderStreamService.ServeConn: %v", err)
+		return
+	}
+
+	// Read acks from the node
+	conn.SetReadLimit(providerStreamMaxMessageSize)
+	_ = conn.SetReadDeadline(time.Now().Add(providerStreamPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(providerStreamPongWait))
+	})
+
+	go func() {
+		defer cancel()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+					logger.Errorf("ProviderStreamService.ServeConn: %v", err)
+				}
+				return
+			}
+
+			var message types.ProviderStreamMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				logger.Errorf("ProviderStreamService.ServeConn: invalid message from provider %s", provider.ID)
+				continue
+			}
+
+			if message.Type == ProviderStreamBalance {
+				s.updateBalance(ctx, provider, message)
+				continue
+			}
+
+			if message.Type != ProviderStreamAck || message.ID == "" {
+				logger.Errorf("ProviderStreamService.ServeConn: invalid message from provider %s", provider.ID)
+				continue
+			}
+
+			if err := storage.RedisClient.Publish(ctx, providerStreamAckChannel(message.ID), data).Err(); err != nil {
+				logger.Errorf("ProviderStreamService.ServeConn: %v", err)
+			}
+		}
+	}()
+
+	// Write messages to the node
+	ticker := time.NewTicker(providerStreamPingPeriod)
+	defer ticker.Stop()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(providerStreamWriteWait))
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg.Payload)); err != nil {
+				logger.Errorf("ProviderStreamService.ServeConn: %v", err)
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(providerStreamWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			_ = conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+				time.Now().Add(providerStreamWriteWait),
+			)
+			return
+		}
+	}
+}
+
+// updateBalance records a fiat balance reported by a provider node over the stream
+func (s *ProviderStreamService) updateBalance(ctx context.Context, provider *ent.ProviderProfile, message types.ProviderStreamMessage) {
+	var balance types.ProviderBalancePayload
+	data, err := json.Marshal(message.Data)
+	if err == nil {
+		err = json.Unmarshal(data, &balance)
+	}
+	if err != nil || balance.Currency == "" || balance.Available.IsNegative() {
+		logger.Errorf("ProviderStreamService.updateBalance: invalid balance from provider %s", provider.ID)
+		return
+	}
+
+	_, err = NewProviderBalanceService().UpdateBalance(ctx, provider.ID, balance.Currency, balance.Available)
+	if err != nil {
+		logger.Errorf("ProviderStreamService.updateBalance: %v", err)
+	}
+}
+
+// providerSignature signs a payload with the provider's primary API key
+func providerSignature(ctx context.Context, provider *ent.ProviderProfile, payload map[string]interface{}) (string, error) {
+	apiKey, err := provider.QueryAPIKeys().Where(apikey.IsPrimary(true)).Only(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	decodedSecret, err := base64.StdEncoding.DecodeString(apiKey.Secret)
+	if err != nil {
+		return "", err
+	}
+
+	decryptedSecret, err := cryptoUtils.DecryptPlain(decodedSecret)
+	if err != nil {
+		return "", err
+	}
+
+	return tokenUtils.GenerateHMACSignature(payload, string(decryptedSecret)), nil
+}
+
+// providerStreamChannel is the Redis channel for messages to a provider's stream connections
+func providerStreamChannel(providerID string) string {
+	return fmt.Sprintf("provider_stream_%s", providerID)
+}
+
+// providerStreamAckChannel is the Redis channel for the ack of a provider stream message
+func providerStreamAckChannel(messageID string) string {
+	return fmt.Sprintf("provider_stream_ack_%s", messageID)
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent/enttest"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderStream(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:provider_stream?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	// Setup test data
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "provider",
+		"email": "provider.stream@test.com",
+	})
+	assert.NoError(t, err)
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"currency_id": currency.ID,
+	})
+	assert.NoError(t, err)
+
+	// Messages are signed with the primary API key
+	_, _, err = NewAPIKeyService().GenerateAPIKey(context.Background(), nil, nil, provider)
+	assert.NoError(t, err)
+
+	pushes := 0
+	httpmock.RegisterResponder("POST", "https://example.com/tx_status",
+		func(r *http.Request) (*http.Response, error) {
+			pushes++
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"status": "success",
+				"data":   map[string]interface{}{"via": "push"},
+			})
+		},
+	)
+
+	// connectNode simulates a provider node connected to the stream, acking each message when ack is true.
+	// It returns the messages the node received and a function to disconnect it
+	connectNode := func(t *testing.T, ack bool) (<-chan types.ProviderStreamMessage, func()) {
+		sub := redisClient.Subscribe(context.Background(), providerStreamChannel(provider.ID))
+		_, err := sub.Receive(context.Background())
+		assert.NoError(t, err)
+
+		received := make(chan types.ProviderStreamMessage, 10)
+		go func() {
+			for msg := range sub.Channel() {
+				var message types.ProviderStreamMessage
+				if err := json.Unmarshal([]byte(msg.Payload), &message); err != nil {
+					continue
+				}
+				received <- message
+
+				if ack {
+					ackJSON, _ := json.Marshal(types.ProviderStreamMessage{
+						ID:   message.ID,
+						Type: ProviderStreamAck,
+						Data: map[string]interface{}{"via": "stream"},
+					})
+					redisClient.Publish(context.Background(), providerStreamAckChannel(message.ID), ackJSON)
+				}
+			}
+		}()
+
+		return received, func() { _ = sub.Close() }
+	}
+
+	service := NewProviderStreamService()
+	payload := map[string]interface{}{"orderId": "order-1"}
+
+	t.Run("Send", func(t *testing.T) {
+		t.Run("pushes the message over HTTP when the node is not connected", func(t *testing.T) {
+			data, err := service.Send(context.Background(), provider, ProviderStreamTxStatus, payload, time.Second)
+			assert.NoError(t, err)
+			assert.Equal(t, "push", data["data"].(map[string]interface{})["via"])
+			assert.Equal(t, 1, pushes)
+		})
+
+		t.Run("returns the ack of a connected node", func(t *testing.T) {
+			received, disconnect := connectNode(t, true)
+			defer disconnect()
+
+			data, err := service.Send(context.Background(), provider, ProviderStreamTxStatus, payload, 5*time.Second)
+			assert.NoError(t, err)
+			assert.Equal(t, "stream", data["via"])
+			assert.Equal(t, 1, pushes)
+
+			select {
+			case message := <-received:
+				assert.Equal(t, ProviderStreamTxStatus, message.Type)
+				assert.Equal(t, "order-1", message.Data["orderId"])
+				assert.NotEmpty(t, message.Signature)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the node did not receive the message")
+			}
+		})
+
+		t.Run("doesn't push a message a connected node didn't ack", func(t *testing.T) {
+			received, disconnect := connectNode(t, false)
+			defer disconnect()
+
+			_, err := service.Send(context.Background(), provider, ProviderStreamTxStatus, payload, 500*time.Millisecond)
+			assert.Error(t, err)
+			assert.Len(t, received, 1)
+			assert.Equal(t, 1, pushes, "the node may still act on the streamed message")
+		})
+	})
+
+	t.Run("Notify", func(t *testing.T) {
+		t.Run("delivers the message without waiting for an ack", func(t *testing.T) {
+			received, disconnect := connectNode(t, false)
+			defer disconnect()
+
+			err := service.Notify(context.Background(), provider, ProviderStreamOrderCancelled, payload)
+			assert.NoError(t, err)
+
+			select {
+			case message := <-received:
+				assert.Equal(t, ProviderStreamOrderCancelled, message.Type)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the node did not receive the message")
+			}
+			assert.Equal(t, 1, pushes)
+		})
+	})
+}
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// RateExclusionService records provider tokens excluded from the bucket queues because their rate is stale,
+// notifies the providers and adjusts their floating rates if they opted in
+type RateExclusionService struct {
+	emailService  *EmailService
+	streamService *ProviderStreamService
+}
+
+// NewRateExclusionService creates a new instance of RateExclusionService.
+func NewRateExclusionService() *RateExclusionService {
+	return &RateExclusionService{
+		emailService:  NewEmailService(SENDGRID_MAIL_PROVIDER),
+		streamService: NewProviderStreamService(),
+	}
+}
+
+// RecordStaleRate records that a provider token was excluded from the queue because its rate deviates too far from the market rate.
+// An existing exclusion is updated, keeping the time the token was first excluded
+func (s *RateExclusionService) RecordStaleRate(ctx context.Context, tokenID int, rate, marketRate, deviation decimal.Decimal) error {
+	exclusion, err := storage.Client.ProviderRateExclusion.
+		Query().
+		Where(providerrateexclusion.HasOrderTokenWith(providerordertoken.IDEQ(tokenID))).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return err
+		}
+
+		return storage.Client.ProviderRateExclusion.
+			Create().
+			SetOrderTokenID(tokenID).
+			SetReason(providerrateexclusion.ReasonStaleRate).
+			SetRate(rate).
+			SetMarketRate(marketRate).
+			SetDeviation(deviation).
+			Exec(ctx)
+	}
+
+	return exclusion.Update().
+		SetRate(rate).
+		SetMarketRate(marketRate).
+		SetDeviation(deviation).
+		Exec(ctx)
+}
+
+// ClearExclusion removes the exclusion of a provider token once it is back in the queue
+func (s *RateExclusionService) ClearExclusion(ctx context.Context, tokenID int) error {
+	_, err := storage.Client.ProviderRateExclusion.
+		Delete().
+		Where(providerrateexclusion.HasOrderTokenWith(providerordertoken.IDEQ(tokenID))).
+		Exec(ctx)
+	return err
+}
+
+// PruneExclusions removes the exclusions of provider tokens that are no longer considered for the bucket queues,
+// such as those of providers that are unavailable or no longer in any bucket
+func (s *RateExclusionService) PruneExclusions(ctx context.Context) error {
+	_, err := storage.Client.ProviderRateExclusion.
+		Delete().
+		Where(providerrateexclusion.HasOrderTokenWith(
+			providerordertoken.Not(providerordertoken.HasProviderWith(queuedProvider(), providerprofile.HasProvisionBuckets())),
+		)).
+		Exec(ctx)
+	return err
+}
+
+// NotifyExclusions notifies providers of the exclusions they have not been notified of, by email and
+// on their provider node. An exclusion is retried until at least one of the notifications is delivered
+func (s *RateExclusionService) NotifyExclusions(ctx context.Context) error {
+	exclusions, err := storage.Client.ProviderRateExclusion.
+		Query().
+		Where(providerrateexclusion.NotifiedAtIsNil()).
+		WithOrderToken(func(potq *ent.ProviderOrderTokenQuery) {
+			potq.WithProvider(func(ppq *ent.ProviderProfileQuery) {
+				ppq.WithUser().WithCurrency()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("NotifyExclusions: %w", err)
+	}
+
+	for _, exclusion := range exclusions {
+		token := exclusion.Edges.OrderToken
+		provider := token.Edges.Provider
+
+		subject := fmt.Sprintf("Your %s/%s rate is no longer receiving orders", token.Symbol, provider.Edges.Currency.Code)
+		body := fmt.Sprintf(
+			"Your %s rate of %s %s deviates %s%% from the market rate of %s %s, more than the allowed %s%%. Orders will not be routed to you for %s until you update your rate.",
+			token.Symbol, exclusion.Rate, provider.Edges.Currency.Code,
+			exclusion.Deviation.Round(2), exclusion.MarketRate, provider.Edges.Currency.Code,
+			orderConf.PercentDeviationFromMarketRate, token.Symbol,
+		)
+		if provider.AutoAdjustStaleRates && token.ConversionRateType == providerordertoken.ConversionRateTypeFloating {
+			body += fmt.Sprintf("\n\nYour floating rate will be adjusted automatically if it is not updated within %s.", orderConf.StaleRateGracePeriod)
+		}
+
+		if s.notify(ctx, provider, subject, body, exclusion, token) {
+			_, err = exclusion.Update().
+				SetNotifiedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				logger.Errorf("NotifyExclusions: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// AdjustStaleRates brings the floating rates of providers that opted in back within the allowed deviation
+// from the market rate once their exclusion has outlasted the grace period. Fixed rates are never adjusted
+func (s *RateExclusionService) AdjustStaleRates(ctx context.Context) error {
+	exclusions, err := storage.Client.ProviderRateExclusion.
+		Query().
+		Where(
+			providerrateexclusion.AdjustedAtIsNil(),
+			providerrateexclusion.CreatedAtLTE(time.Now().Add(-orderConf.StaleRateGracePeriod)),
+			providerrateexclusion.HasOrderTokenWith(
+				providerordertoken.ConversionRateTypeEQ(providerordertoken.ConversionRateTypeFloating),
+				providerordertoken.HasProviderWith(providerprofile.AutoAdjustStaleRates(true)),
+			),
+		).
+		WithOrderToken(func(potq *ent.ProviderOrderTokenQuery) {
+			potq.WithProvider(func(ppq *ent.ProviderProfileQuery) {
+				ppq.WithUser().WithCurrency()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("AdjustStaleRates: %w", err)
+	}
+
+	for _, exclusion := range exclusions {
+		token := exclusion.Edges.OrderToken
+		provider := token.Edges.Provider
+		marketRate := provider.Edges.Currency.MarketRate
+
+		floatingRate := AdjustFloatingRate(token.FloatingConversionRate, marketRate, orderConf.PercentDeviationFromMarketRate)
+
+		// Rate bands are adjusted the same way, as any stale band keeps the token out of the queue
+		rateBands := make([]types.ProviderRateBand, len(token.RateBands))
+		for i, band := range token.RateBands {
+			band.FloatingConversionRate = AdjustFloatingRate(band.FloatingConversionRate, marketRate, orderConf.PercentDeviationFromMarketRate)
+			rateBands[i] = band
+		}
+
+		_, err := token.Update().
+			SetFloatingConversionRate(floatingRate).
+			SetRateBands(rateBands).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("AdjustStaleRates: %v", err)
+			continue
+		}
+
+		exclusion, err = exclusion.Update().
+			SetAdjustedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("AdjustStaleRates: %v", err)
+			continue
+		}
+
+		subject := fmt.Sprintf("Your %s/%s floating rate was adjusted", token.Symbol, provider.Edges.Currency.Code)
+		body := fmt.Sprintf(
+			"Your %s floating rate was not updated within %s of being excluded from the order queue, so it was adjusted from %s to %s to bring it within %s%% of the market rate of %s %s.",
+			token.Symbol, orderConf.StaleRateGracePeriod, token.FloatingConversionRate, floatingRate,
+			orderConf.PercentDeviationFromMarketRate, marketRate, provider.Edges.Currency.Code,
+		)
+		s.notify(ctx, provider, subject, body, exclusion, token)
+	}
+
+	return nil
+}
+
+// AdjustFloatingRate returns the floating rate closest to the given one that keeps the provider's rate
+// within the maximum percentage deviation from the market rate
+func AdjustFloatingRate(floatingRate, marketRate, maxDeviation decimal.Decimal) decimal.Decimal {
+	// Truncate so rounding can't push the rate back over the limit
+	maxOffset := marketRate.Mul(maxDeviation).Div(decimal.NewFromInt(100)).Truncate(2)
+
+	if floatingRate.GreaterThan(maxOffset) {
+		return maxOffset
+	}
+	if floatingRate.LessThan(maxOffset.Neg()) {
+		return maxOffset.Neg()
+	}
+	return floatingRate
+}
+
+// ExclusionResponse builds the response for a provider token excluded from the queue
+func ExclusionResponse(exclusion *ent.ProviderRateExclusion, token *ent.ProviderOrderToken, currencyCode string) types.ProviderRateExclusionResponse {
+	return types.ProviderRateExclusionResponse{
+		Token:        token.Symbol,
+		Currency:     currencyCode,
+		Reason:       string(exclusion.Reason),
+		Rate:         exclusion.Rate,
+		MarketRate:   exclusion.MarketRate,
+		Deviation:    exclusion.Deviation.Round(2),
+		MaxDeviation: orderConf.PercentDeviationFromMarketRate,
+		ExcludedAt:   exclusion.CreatedAt,
+		NotifiedAt:   exclusion.NotifiedAt,
+		AdjustedAt:   exclusion.AdjustedAt,
+	}
+}
+
+// notify emails the provider and sends the exclusion to its provider node over the stream or its host identifier.
+// It returns whether either notification was delivered
+func (s *RateExclusionService) notify(ctx context.Context, provider *ent.ProviderProfile, subject, body string, exclusion *ent.ProviderRateExclusion, token *ent.ProviderOrderToken) bool {
+	delivered := false
+
+	if provider.Edges.User != nil {
+		_, err := s.emailService.SendEmail(ctx, types.SendEmailPayload{
+			FromAddress: _DefaultFromAddress,
+			ToAddress:   provider.Edges.User.Email,
+			Subject:     subject,
+			Body:        body,
+			HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+		})
+		if err != nil {
+			logger.Errorf("failed to email provider %s of rate exclusion: %v", provider.ID, err)
+		} else {
+			delivered = true
+		}
+	}
+
+	res := ExclusionResponse(exclusion, token, provider.Edges.Currency.Code)
+	_, err := s.streamService.Send(ctx, provider, ProviderStreamRateExcluded, map[string]interface{}{
+		"token":        res.Token,
+		"currency":     res.Currency,
+		"reason":       res.Reason,
+		"rate":         res.Rate.String(),
+		"marketRate":   res.MarketRate.String(),
+		"deviation":    res.Deviation.String(),
+		"maxDeviation": res.MaxDeviation.String(),
+		"excludedAt":   res.ExcludedAt,
+		"adjustedAt":   res.AdjustedAt,
+	}, 10*time.Second)
+	if err != nil {
+		logger.Errorf("failed to notify provider node %s of rate exclusion: %v", provider.ID, err)
+	} else {
+		delivered = true
+	}
+
+	return delivered
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerrateexclusion"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateExclusions(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:rate_exclusions?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	emails := 0
+	httpmock.RegisterResponder("POST", sendGridEndpoint,
+		func(r *http.Request) (*http.Response, error) {
+			emails++
+			resp := httpmock.NewBytesResponse(202, nil)
+			resp.Header.Set("X-Message-Id", "thisisatestid")
+			return resp, nil
+		},
+	)
+
+	pushes := 0
+	httpmock.RegisterResponder("POST", "https://example.com/rate_excluded",
+		func(r *http.Request) (*http.Response, error) {
+			pushes++
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"status": "success"})
+		},
+	)
+
+	// Keep the configured deviation and grace period predictable
+	maxDeviation, gracePeriod := orderConf.PercentDeviationFromMarketRate, orderConf.StaleRateGracePeriod
+	orderConf.PercentDeviationFromMarketRate = decimal.NewFromInt(10)
+	orderConf.StaleRateGracePeriod = time.Hour
+	defer func() {
+		orderConf.PercentDeviationFromMarketRate, orderConf.StaleRateGracePeriod = maxDeviation, gracePeriod
+	}()
+
+	// Setup test data
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	createProvider := func(email string) *ent.ProviderProfile {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"scope": "provider",
+			"email": email,
+		})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		// Messages to the provider node are signed with the primary API key
+		_, _, err = NewAPIKeyService().GenerateAPIKey(context.Background(), nil, nil, provider)
+		assert.NoError(t, err)
+
+		_, err = test.CreateTestProvisionBucket(map[string]interface{}{
+			"provider_id": provider.ID,
+			"min_amount":  decimal.NewFromInt(1),
+			"max_amount":  decimal.NewFromInt(10000),
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		provider, err = provider.Update().
+			SetIsActive(true).
+			SetIsAvailable(true).
+			SetIsKybVerified(true).
+			SetAutoAdjustStaleRates(true).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		return provider
+	}
+
+	provider := createProvider("provider.rate.exclusions@test.com")
+
+	floatingToken, err := test.AddProviderOrderTokenToProvider(map[string]interface{}{
+		"conversion_rate_type":     "floating",
+		"floating_conversion_rate": decimal.NewFromInt(200),
+		"tokenSymbol":              "USDT",
+		"provider":                 provider,
+	})
+	assert.NoError(t, err)
+
+	floatingToken, err = floatingToken.Update().
+		SetRateBands([]types.ProviderRateBand{
+			{MinAmount: decimal.NewFromInt(1), MaxAmount: decimal.NewFromInt(100), FloatingConversionRate: decimal.NewFromInt(-150)},
+		}).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	fixedToken, err := test.AddProviderOrderTokenToProvider(map[string]interface{}{
+		"fixed_conversion_rate": decimal.NewFromInt(1500),
+		"tokenSymbol":           "USDC",
+		"provider":              provider,
+	})
+	assert.NoError(t, err)
+
+	service := NewRateExclusionService()
+	marketRate := currency.MarketRate
+
+	exclusionOf := func(token *ent.ProviderOrderToken) (*ent.ProviderRateExclusion, error) {
+		return db.Client.ProviderRateExclusion.
+			Query().
+			Where(providerrateexclusion.HasOrderTokenWith(providerordertoken.IDEQ(token.ID))).
+			Only(context.Background())
+	}
+
+	t.Run("RecordStaleRate", func(t *testing.T) {
+		t.Run("excludes the token", func(t *testing.T) {
+			rate := marketRate.Add(floatingToken.FloatingConversionRate)
+			err := service.RecordStaleRate(context.Background(), floatingToken.ID, rate, marketRate, utils.AbsPercentageDeviation(marketRate, rate))
+			assert.NoError(t, err)
+
+			exclusion, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+			assert.Equal(t, providerrateexclusion.ReasonStaleRate, exclusion.Reason)
+			assert.True(t, exclusion.Rate.Equal(rate))
+		})
+
+		t.Run("updates an existing exclusion", func(t *testing.T) {
+			before, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+
+			rate := marketRate.Add(decimal.NewFromInt(250))
+			err = service.RecordStaleRate(context.Background(), floatingToken.ID, rate, marketRate, utils.AbsPercentageDeviation(marketRate, rate))
+			assert.NoError(t, err)
+
+			exclusion, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+			assert.Equal(t, before.ID, exclusion.ID)
+			assert.True(t, exclusion.Rate.Equal(rate))
+			assert.True(t, exclusion.CreatedAt.Equal(before.CreatedAt), "the token keeps the time it was first excluded")
+		})
+	})
+
+	t.Run("NotifyExclusions", func(t *testing.T) {
+		t.Run("notifies the provider by email and on its node", func(t *testing.T) {
+			err := service.NotifyExclusions(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, emails)
+			assert.Equal(t, 1, pushes)
+
+			exclusion, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+			assert.NotNil(t, exclusion.NotifiedAt)
+		})
+
+		t.Run("doesn't notify the provider again", func(t *testing.T) {
+			err := service.NotifyExclusions(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, emails)
+			assert.Equal(t, 1, pushes)
+		})
+	})
+
+	t.Run("AdjustStaleRates", func(t *testing.T) {
+		rate := fixedToken.FixedConversionRate
+		err := service.RecordStaleRate(context.Background(), fixedToken.ID, rate, marketRate, utils.AbsPercentageDeviation(marketRate, rate))
+		assert.NoError(t, err)
+
+		t.Run("waits for the grace period", func(t *testing.T) {
+			err := service.AdjustStaleRates(context.Background())
+			assert.NoError(t, err)
+
+			exclusion, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+			assert.Nil(t, exclusion.AdjustedAt)
+		})
+
+		// Both tokens have now been excluded for longer than the grace period
+		orderConf.StaleRateGracePeriod = 0
+
+		err = service.AdjustStaleRates(context.Background())
+		assert.NoError(t, err)
+
+		t.Run("adjusts floating rates and their bands", func(t *testing.T) {
+			token, err := db.Client.ProviderOrderToken.Get(context.Background(), floatingToken.ID)
+			assert.NoError(t, err)
+
+			maxOffset := marketRate.Mul(orderConf.PercentDeviationFromMarketRate).Div(decimal.NewFromInt(100)).Truncate(2)
+			assert.True(t, token.FloatingConversionRate.Equal(maxOffset), token.FloatingConversionRate.String())
+			assert.True(t, token.RateBands[0].FloatingConversionRate.Equal(maxOffset.Neg()), token.RateBands[0].FloatingConversionRate.String())
+
+			exclusion, err := exclusionOf(floatingToken)
+			assert.NoError(t, err)
+			assert.NotNil(t, exclusion.AdjustedAt)
+		})
+
+		t.Run("never adjusts fixed rates", func(t *testing.T) {
+			token, err := db.Client.ProviderOrderToken.Get(context.Background(), fixedToken.ID)
+			assert.NoError(t, err)
+			assert.True(t, token.FixedConversionRate.Equal(fixedToken.FixedConversionRate))
+
+			exclusion, err := exclusionOf(fixedToken)
+			assert.NoError(t, err)
+			assert.Nil(t, exclusion.AdjustedAt)
+		})
+
+		t.Run("adjusts a rate once", func(t *testing.T) {
+			notifications := emails
+
+			err := service.AdjustStaleRates(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, notifications, emails)
+		})
+	})
+
+	t.Run("PruneExclusions", func(t *testing.T) {
+		t.Run("keeps the exclusions of queued providers", func(t *testing.T) {
+			err := service.PruneExclusions(context.Background())
+			assert.NoError(t, err)
+
+			count, err := db.Client.ProviderRateExclusion.Query().Count(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 2, count)
+		})
+
+		t.Run("removes the exclusions of unavailable providers", func(t *testing.T) {
+			_, err := provider.Update().
+				SetIsAvailable(false).
+				Save(context.Background())
+			assert.NoError(t, err)
+
+			err = service.PruneExclusions(context.Background())
+			assert.NoError(t, err)
+
+			count, err := db.Client.ProviderRateExclusion.Query().Count(context.Background())
+			assert.NoError(t, err)
+			assert.Zero(t, count)
+		})
+	})
+}
+
+func TestAdjustFloatingRate(t *testing.T) {
+	marketRate := decimal.NewFromInt(1500)
+	maxDeviation := decimal.NewFromInt(10)
+
+	tests := []struct {
+		name         string
+		floatingRate decimal.Decimal
+		expected     decimal.Decimal
+	}{
+		{"within deviation", decimal.NewFromInt(20), decimal.NewFromInt(20)},
+		{"above deviation", decimal.NewFromInt(200), decimal.NewFromInt(150)},
+		{"below deviation", decimal.NewFromInt(-200), decimal.NewFromInt(-150)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adjusted := AdjustFloatingRate(tt.floatingRate, marketRate, maxDeviation)
+			assert.True(t, adjusted.Equal(tt.expected), adjusted.String())
+
+			rate := marketRate.Add(adjusted).RoundBank(2)
+			assert.False(t, utils.AbsPercentageDeviation(marketRate, rate).GreaterThan(maxDeviation))
+		})
+	}
+}
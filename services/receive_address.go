@@ -5,9 +5,11 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/paycrest/aggregator/services/contracts"
 	"github.com/paycrest/aggregator/types"
 	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
@@ -94,3 +96,25 @@ func (s *ReceiveAddressService) CreateTronAddress(ctx context.Context) (string,
 
 	return wallet.AddressBase58, privateKeyEncrypted, nil
 }
+
+// CreateSandboxAddress generates a receive address for sandbox orders without connecting to a chain.
+// Sandbox deposits are simulated, so the address is never deployed or swept.
+func (s *ReceiveAddressService) CreateSandboxAddress(ctx context.Context, networkIdentifier string) (string, []byte, error) {
+	if strings.HasPrefix(networkIdentifier, "tron") {
+		return s.CreateTronAddress(ctx)
+	}
+
+	nonce := make([]byte, 32)
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Encrypt salt
+	saltEncrypted, err := cryptoUtils.EncryptPlain([]byte(new(big.Int).SetBytes(nonce).String()))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt salt: %w", err)
+	}
+
+	return common.BytesToAddress(crypto.Keccak256(nonce)).Hex(), saltEncrypted, nil
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/transactionlog"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// Provider order request events counted towards trust scores
+const (
+	ProviderOrderRequested = "requested"
+	ProviderOrderAccepted  = "accepted"
+	ProviderOrderCancelled = "cancelled"
+)
+
+const (
+	// Trust scores are computed from the order history of the last 30 days
+	trustScoreWindow = 30 * 24 * time.Hour
+
+	// Average fulfillment times up to the target score 100, falling to 0 at the limit
+	fulfillmentTimeTarget = 2 * time.Minute
+	fulfillmentTimeLimit  = 30 * time.Minute
+)
+
+// DefaultTrustScore is the score of a component without history and of providers without a rating,
+// so new providers are neither favoured nor penalised
+var DefaultTrustScore = decimal.NewFromInt(50)
+
+// TrustScoreWeights are the weights of the trust score components, summing to 1
+var TrustScoreWeights = struct {
+	Acceptance      decimal.Decimal
+	Cancellation    decimal.Decimal
+	Validation      decimal.Decimal
+	FulfillmentTime decimal.Decimal
+	Disputes        decimal.Decimal
+}{
+	Acceptance:      decimal.NewFromFloat(0.2),
+	Cancellation:    decimal.NewFromFloat(0.25),
+	Validation:      decimal.NewFromFloat(0.15),
+	FulfillmentTime: decimal.NewFromFloat(0.2),
+	Disputes:        decimal.NewFromFloat(0.2),
+}
+
+// TrustScoreService computes provider trust scores from their lock order history
+type TrustScoreService struct{}
+
+// NewTrustScoreService creates a new instance of TrustScoreService.
+func NewTrustScoreService() *TrustScoreService {
+	return &TrustScoreService{}
+}
+
+// RecordOrderEvent counts an order request event for a provider's trust score.
+// Events are counted per day and expire once they fall out of the scoring window
+func (s *TrustScoreService) RecordOrderEvent(ctx context.Context, providerID string, event string) {
+	key := providerOrderEventsKey(providerID, time.Now())
+
+	err := storage.RedisClient.HIncrBy(ctx, key, event, 1).Err()
+	if err == nil {
+		err = storage.RedisClient.Expire(ctx, key, trustScoreWindow+24*time.Hour).Err()
+	}
+	if err != nil {
+		logger.Errorf("TrustScoreService.RecordOrderEvent: %v", err)
+	}
+}
+
+// ComputeTrustScores computes the trust scores of active providers and saves them to their ratings
+func (s *TrustScoreService) ComputeTrustScores() error {
+	ctx := context.Background()
+
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IsActive(true)).
+		WithProviderRating().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ComputeTrustScores: %w", err)
+	}
+
+	for _, provider := range providers {
+		if err := s.saveTrustScore(ctx, provider); err != nil {
+			logger.Errorf("ComputeTrustScores: provider %s: %v", provider.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateTrustScore recomputes a provider's trust score and saves it to its rating, e.g. after a dispute is resolved
+func (s *TrustScoreService) UpdateTrustScore(ctx context.Context, providerID string) error {
+	provider, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDEQ(providerID)).
+		WithProviderRating().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.saveTrustScore(ctx, provider)
+}
+
+// saveTrustScore computes a provider's trust score and creates or updates its rating.
+// The provider must be loaded with its rating
+func (s *TrustScoreService) saveTrustScore(ctx context.Context, provider *ent.ProviderProfile) error {
+	rating, err := s.ComputeTrustScore(ctx, provider.ID)
+	if err != nil {
+		return err
+	}
+
+	if provider.Edges.ProviderRating == nil {
+		_, err = storage.Client.ProviderRating.
+			Create().
+			SetProviderProfileID(provider.ID).
+			SetTrustScore(rating.TrustScore).
+			SetAcceptanceRate(rating.AcceptanceRate).
+			SetAcceptanceScore(rating.AcceptanceScore).
+			SetCancellationRate(rating.CancellationRate).
+			SetCancellationScore(rating.CancellationScore).
+			SetValidationFailureRate(rating.ValidationFailureRate).
+			SetValidationScore(rating.ValidationScore).
+			SetAverageFulfillmentTime(rating.AverageFulfillmentTime).
+			SetFulfillmentTimeScore(rating.FulfillmentTimeScore).
+			SetDisputeRate(rating.DisputeRate).
+			SetDisputeScore(rating.DisputeScore).
+			Save(ctx)
+	} else {
+		_, err = provider.Edges.ProviderRating.
+			Update().
+			SetTrustScore(rating.TrustScore).
+			SetAcceptanceRate(rating.AcceptanceRate).
+			SetAcceptanceScore(rating.AcceptanceScore).
+			SetCancellationRate(rating.CancellationRate).
+			SetCancellationScore(rating.CancellationScore).
+			SetValidationFailureRate(rating.ValidationFailureRate).
+			SetValidationScore(rating.ValidationScore).
+			SetAverageFulfillmentTime(rating.AverageFulfillmentTime).
+			SetFulfillmentTimeScore(rating.FulfillmentTimeScore).
+			SetDisputeRate(rating.DisputeRate).
+			SetDisputeScore(rating.DisputeScore).
+			Save(ctx)
+	}
+
+	return err
+}
+
+// ComputeTrustScore computes a provider's trust score and its breakdown from the scoring window.
+// Rates are percentages and each component is scored out of 100
+func (s *TrustScoreService) ComputeTrustScore(ctx context.Context, providerID string) (*ent.ProviderRating, error) {
+	since := time.Now().Add(-trustScoreWindow)
+	hundred := decimal.NewFromInt(100)
+	rating := &ent.ProviderRating{
+		AcceptanceScore:      DefaultTrustScore,
+		CancellationScore:    DefaultTrustScore,
+		ValidationScore:      DefaultTrustScore,
+		FulfillmentTimeScore: DefaultTrustScore,
+		DisputeScore:         decimal.NewFromInt(100),
+	}
+
+	// Acceptance and cancellation rates of order requests
+	events, err := s.orderEvents(ctx, providerID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if events[ProviderOrderRequested] > 0 {
+		rating.AcceptanceRate = percentage(events[ProviderOrderAccepted], events[ProviderOrderRequested])
+		rating.AcceptanceScore = decimal.Min(rating.AcceptanceRate, hundred)
+	}
+
+	if events[ProviderOrderAccepted] > 0 {
+		rating.CancellationRate = percentage(events[ProviderOrderCancelled], events[ProviderOrderAccepted])
+		rating.CancellationScore = decimal.Max(hundred.Sub(rating.CancellationRate), decimal.Zero)
+	}
+
+	// Validation failures of fulfillments
+	failed, err := storage.Client.LockOrderFulfillment.
+		Query().
+		Where(
+			lockorderfulfillment.HasOrderWith(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(providerID))),
+			lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusFailed),
+			lockorderfulfillment.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded, err := storage.Client.LockOrderFulfillment.
+		Query().
+		Where(
+			lockorderfulfillment.HasOrderWith(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(providerID))),
+			lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusSuccess),
+			lockorderfulfillment.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if failed+succeeded > 0 {
+		rating.ValidationFailureRate = percentage(int64(failed), int64(failed+succeeded))
+		rating.ValidationScore = hundred.Sub(rating.ValidationFailureRate)
+	}
+
+	// Disputes resolved against the provider, relative to its successful fulfillments.
+	// Providers start with a full score as disputes are rare
+	disputes, err := storage.Client.Dispute.
+		Query().
+		Where(
+			dispute.HasLockPaymentOrderWith(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(providerID))),
+			dispute.StatusEQ(dispute.StatusResolved),
+			dispute.ProviderAtFault(true),
+			dispute.ResolvedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if disputes > 0 {
+		rating.DisputeRate = percentage(int64(disputes), int64(max(succeeded, disputes)))
+		rating.DisputeScore = hundred.Sub(rating.DisputeRate)
+	}
+
+	// Time from accepting an order to its successful fulfillment
+	averageFulfillmentTime, ok, err := s.averageFulfillmentTime(ctx, providerID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		rating.AverageFulfillmentTime = decimal.NewFromFloat(averageFulfillmentTime.Seconds()).Round(0)
+		rating.FulfillmentTimeScore = FulfillmentTimeScore(averageFulfillmentTime)
+	}
+
+	rating.TrustScore = rating.AcceptanceScore.Mul(TrustScoreWeights.Acceptance).
+		Add(rating.CancellationScore.Mul(TrustScoreWeights.Cancellation)).
+		Add(rating.ValidationScore.Mul(TrustScoreWeights.Validation)).
+		Add(rating.FulfillmentTimeScore.Mul(TrustScoreWeights.FulfillmentTime)).
+		Add(rating.DisputeScore.Mul(TrustScoreWeights.Disputes)).
+		Round(2)
+
+	return rating, nil
+}
+
+// FulfillmentTimeScore scores an average fulfillment time out of 100
+func FulfillmentTimeScore(averageFulfillmentTime time.Duration) decimal.Decimal {
+	if averageFulfillmentTime <= fulfillmentTimeTarget {
+		return decimal.NewFromInt(100)
+	}
+	if averageFulfillmentTime >= fulfillmentTimeLimit {
+		return decimal.Zero
+	}
+
+	return decimal.NewFromInt(int64(fulfillmentTimeLimit - averageFulfillmentTime)).
+		Div(decimal.NewFromInt(int64(fulfillmentTimeLimit - fulfillmentTimeTarget))).
+		Mul(decimal.NewFromInt(100)).
+		Round(2)
+}
+
+// averageFulfillmentTime returns the average time the provider took from accepting an order to its successful fulfillment.
+// ok is false when the provider has no fulfilled orders in the scoring window
+func (s *TrustScoreService) averageFulfillmentTime(ctx context.Context, providerID string, since time.Time) (average time.Duration, ok bool, err error) {
+	orders, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(providerID)),
+			lockpaymentorder.HasFulfillmentsWith(
+				lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusSuccess),
+				lockorderfulfillment.CreatedAtGTE(since),
+			),
+		).
+		WithFulfillments(func(lofq *ent.LockOrderFulfillmentQuery) {
+			lofq.Where(lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusSuccess))
+		}).
+		WithTransactions(func(tlq *ent.TransactionLogQuery) {
+			tlq.Where(transactionlog.StatusEQ(transactionlog.StatusOrderProcessing))
+		}).
+		All(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var total time.Duration
+	count := 0
+	for _, order := range orders {
+		// The order is accepted when its processing transaction is logged by the provider
+		var acceptedAt time.Time
+		for _, log := range order.Edges.Transactions {
+			if log.Metadata["ProviderId"] == providerID {
+				acceptedAt = log.CreatedAt
+				break
+			}
+		}
+		if acceptedAt.IsZero() || len(order.Edges.Fulfillments) == 0 {
+			continue
+		}
+
+		fulfilledAt := order.Edges.Fulfillments[0].CreatedAt
+		for _, fulfillment := range order.Edges.Fulfillments[1:] {
+			if fulfillment.CreatedAt.Before(fulfilledAt) {
+				fulfilledAt = fulfillment.CreatedAt
+			}
+		}
+
+		if fulfilledAt.After(acceptedAt) {
+			total += fulfilledAt.Sub(acceptedAt)
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0, false, nil
+	}
+
+	return total / time.Duration(count), true, nil
+}
+
+// orderEvents sums a provider's order request events since a time
+func (s *TrustScoreService) orderEvents(ctx context.Context, providerID string, since time.Time) (map[string]int64, error) {
+	events := map[string]int64{}
+
+	for day := since.UTC(); !day.After(time.Now().UTC()); day = day.Add(24 * time.Hour) {
+		counts, err := storage.RedisClient.HGetAll(ctx, providerOrderEventsKey(providerID, day)).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for event, count := range counts {
+			n, err := strconv.ParseInt(count, 10, 64)
+			if err != nil {
+				continue
+			}
+			events[event] += n
+		}
+	}
+
+	return events, nil
+}
+
+// percentage returns part as a percentage of total
+func percentage(part, total int64) decimal.Decimal {
+	return decimal.NewFromInt(part).Div(decimal.NewFromInt(total)).Mul(decimal.NewFromInt(100)).Round(2)
+}
+
+// providerOrderEventsKey is the Redis hash of a provider's order request event counts for a day
+func providerOrderEventsKey(providerID string, day time.Time) string {
+	return fmt.Sprintf("provider_order_events_%s_%s", providerID, day.UTC().Format("20060102"))
+}
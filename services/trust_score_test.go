@@ -0,0 +1,29 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFulfillmentTimeScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		average  time.Duration
+		expected decimal.Decimal
+	}{
+		{"within target", time.Minute, decimal.NewFromInt(100)},
+		{"at target", 2 * time.Minute, decimal.NewFromInt(100)},
+		{"halfway", 16 * time.Minute, decimal.NewFromInt(50)},
+		{"at limit", 30 * time.Minute, decimal.Zero},
+		{"over limit", time.Hour, decimal.Zero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, FulfillmentTimeScore(tt.average).Equal(tt.expected), FulfillmentTimeScore(tt.average).String())
+		})
+	}
+}
@@ -2,7 +2,7 @@ package tasks
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -16,14 +16,18 @@ import (
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	networkent "github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	tokenent "github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
@@ -33,9 +37,7 @@ import (
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	"github.com/paycrest/aggregator/utils"
-	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
 	"github.com/paycrest/aggregator/utils/logger"
-	tokenUtils "github.com/paycrest/aggregator/utils/token"
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 )
@@ -107,6 +109,7 @@ func RetryStaleUserOperations() error {
 				))
 		}).
 		Where(
+			paymentorder.IsSandboxEQ(false),
 			paymentorder.Or(
 				paymentorder.UpdatedAtGTE(time.Now().Add(-10*time.Minute)),
 				paymentorder.HasRecipientWith(
@@ -141,6 +144,63 @@ func RetryStaleUserOperations() error {
 		}
 	}(ctx)
 
+	// Return the excess of overpaid orders that were created on-chain, and the amount paid into expired orders
+	// whose refund failed. RevertOrder deducts the network fee from the amount returned
+	overpaidOrders, err := storage.Client.PaymentOrder.
+		Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.Or(
+				sql.And(
+					sql.In(s.C(paymentorder.FieldStatus), paymentorder.StatusPending, paymentorder.StatusSettled),
+					sql.ExprP(fmt.Sprintf("%s > %s + %s + %s + %s + 2 * %s",
+						s.C(paymentorder.FieldAmountPaid),
+						s.C(paymentorder.FieldAmountReturned),
+						s.C(paymentorder.FieldAmount),
+						s.C(paymentorder.FieldSenderFee),
+						s.C(paymentorder.FieldProtocolFee),
+						s.C(paymentorder.FieldNetworkFee),
+					)),
+				),
+				sql.And(
+					sql.EQ(s.C(paymentorder.FieldStatus), paymentorder.StatusExpired),
+					sql.ExprP(fmt.Sprintf("%s > %s + %s",
+						s.C(paymentorder.FieldAmountPaid),
+						s.C(paymentorder.FieldAmountReturned),
+						s.C(paymentorder.FieldNetworkFee),
+					)),
+				),
+			))
+		}).
+		Where(
+			paymentorder.IsSandboxEQ(false),
+			paymentorder.ReturnAddressNEQ(""),
+			paymentorder.UpdatedAtLT(time.Now().Add(-5*time.Minute)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("RetryStaleUserOperations: %w", err)
+	}
+
+	wg.Add(1)
+	go func(ctx context.Context) {
+		defer wg.Done()
+		for _, order := range overpaidOrders {
+			var service types.OrderService
+			if strings.HasPrefix(order.Edges.Token.Edges.Network.Identifier, "tron") {
+				service = orderService.NewOrderTron()
+			} else {
+				service = orderService.NewOrderEVM()
+			}
+			err := service.RevertOrder(ctx, rpcClients[order.Edges.Token.Edges.Network.Identifier], order.ID)
+			if err != nil {
+				logger.Errorf("RetryStaleUserOperations.RevertOrder: %v", err)
+			}
+		}
+	}(ctx)
+
 	// Settle order process
 	lockOrders, err := storage.Client.LockPaymentOrder.
 		Query().
@@ -181,6 +241,7 @@ func RetryStaleUserOperations() error {
 		Query().
 		Where(
 			lockpaymentorder.GatewayIDNEQ(""),
+			lockpaymentorder.AmountFulfilledEQ(decimal.Zero),
 			lockpaymentorder.Or(
 				lockpaymentorder.And(
 					lockpaymentorder.Or(
@@ -289,6 +350,7 @@ func IndexBlockchainEvents() error {
 			Query().
 			Where(
 				paymentorder.StatusEQ(paymentorder.StatusInitiated),
+				paymentorder.IsSandboxEQ(false),
 				paymentorder.HasReceiveAddressWith(
 					receiveaddress.StatusEQ(receiveaddress.StatusUnused),
 					receiveaddress.ValidUntilGT(time.Now()),
@@ -321,6 +383,44 @@ func IndexBlockchainEvents() error {
 		}
 	}(ctx)
 
+	// Index transfers to payment order batch receive addresses
+	wg.Add(1)
+	go func(ctx context.Context) {
+		defer wg.Done()
+		batches, err := storage.Client.PaymentOrderBatch.
+			Query().
+			Where(
+				paymentorderbatch.StatusEQ(paymentorderbatch.StatusInitiated),
+				paymentorderbatch.HasReceiveAddressWith(
+					receiveaddress.StatusEQ(receiveaddress.StatusUnused),
+					receiveaddress.ValidUntilGT(time.Now()),
+				),
+			).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithPaymentOrders().
+			All(ctx)
+		if err != nil && err != context.Canceled {
+			logger.Errorf("IndexBlockchainEvents: %v", err)
+		}
+
+		for _, batch := range batches {
+			var indexerService services.Indexer
+			if strings.HasPrefix(batch.Edges.Token.Edges.Network.Identifier, "tron") {
+				indexerService = services.NewIndexerService(orderService.NewOrderTron())
+			} else {
+				indexerService = services.NewIndexerService(orderService.NewOrderEVM())
+			}
+
+			err := indexerService.IndexBatchTransfer(ctx, rpcClients[batch.Edges.Token.Edges.Network.Identifier], batch)
+			if err != nil {
+				continue
+			}
+		}
+	}(ctx)
+
 	// Index OrderCreated events
 	wg.Add(1)
 	go func(ctx context.Context) {
@@ -346,6 +446,7 @@ func IndexBlockchainEvents() error {
 						))
 					}).
 					Where(
+						paymentorder.IsSandboxEQ(false),
 						paymentorder.HasTokenWith(
 							tokenent.HasNetworkWith(networkent.IDEQ(network.ID)),
 						),
@@ -649,9 +750,7 @@ func SyncLockOrderFulfillments() {
 		WithToken(func(tq *ent.TokenQuery) {
 			tq.WithNetwork()
 		}).
-		WithProvider(func(pq *ent.ProviderProfileQuery) {
-			pq.WithAPIKey()
-		}).
+		WithProvider().
 		WithFulfillments().
 		WithProvisionBucket(func(pb *ent.ProvisionBucketQuery) {
 			pb.WithCurrency()
@@ -661,39 +760,20 @@ func SyncLockOrderFulfillments() {
 		return
 	}
 
+	streamService := services.NewProviderStreamService()
 	for _, order := range lockOrders {
 		if len(order.Edges.Fulfillments) == 0 {
-			// Compute HMAC
-			decodedSecret, err := base64.StdEncoding.DecodeString(order.Edges.Provider.Edges.APIKey.Secret)
-			if err != nil {
-				logger.Errorf("SyncLockOrderFulfillments: %v", err)
-				return
-			}
-			decryptedSecret, err := cryptoUtils.DecryptPlain(decodedSecret)
-			if err != nil {
-				logger.Errorf("SyncLockOrderFulfillments: %v", err)
-				return
-			}
-
 			payload := map[string]interface{}{
 				"orderId":  order.ID.String(),
 				"currency": order.Edges.ProvisionBucket.Edges.Currency.Code,
 			}
-			signature := tokenUtils.GenerateHMACSignature(payload, string(decryptedSecret))
-
-			// Send POST request to the provider's node
-			res, err := fastshot.NewClient(order.Edges.Provider.HostIdentifier).
-				Config().SetTimeout(10*time.Second).
-				Header().Add("X-Request-Signature", signature).
-				Build().POST("/tx_status").
-				Body().AsJSON(payload).
-				Send()
-			if err != nil {
+
+			// Query the provider's node over the provider stream or HTTP push
+			data, err := streamService.Send(ctx, order.Edges.Provider, services.ProviderStreamTxStatus, payload, 10*time.Second)
+			if err != nil && !errors.Is(err, services.ErrProviderResponse) {
 				logger.Errorf("SyncLockOrderFulfillments: %v %v", err, payload)
 				continue
 			}
-
-			data, err := utils.ParseJSONResponse(res.RawResponse)
 			if err != nil {
 				if order.Status == lockpaymentorder.StatusProcessing && order.UpdatedAt.Add(orderConf.OrderFulfillmentValidity*2).Before(time.Now()) {
 					logger.Errorf("SyncLockOrderFulfillments.StuckProcessing: %v %v", err, payload)
@@ -703,7 +783,9 @@ func SyncLockOrderFulfillments() {
 						Exec(ctx)
 					if err != nil {
 						logger.Errorf("SyncLockOrderFulfillments.DeleteOrder: %v", err)
+						continue
 					}
+					streamService.NotifyOrderCancelled(ctx, order.Edges.Provider, order.ID, "expired")
 					continue
 				}
 				continue
@@ -712,6 +794,11 @@ func SyncLockOrderFulfillments() {
 			status := data["data"].(map[string]interface{})["status"].(string)
 			psp := data["data"].(map[string]interface{})["psp"].(string)
 			txId := data["data"].(map[string]interface{})["txId"].(string)
+			amount, err := fulfillmentAmount(data["data"].(map[string]interface{}), order)
+			if err != nil {
+				logger.Errorf("SyncLockOrderFulfillments: %v %v", err, payload)
+				continue
+			}
 
 			if status == "failed" {
 				_, err = storage.Client.LockOrderFulfillment.
@@ -719,6 +806,7 @@ func SyncLockOrderFulfillments() {
 					SetOrderID(order.ID).
 					SetPsp(psp).
 					SetTxID(txId).
+					SetAmount(amount).
 					SetValidationStatus(lockorderfulfillment.ValidationStatusFailed).
 					SetValidationError(data["data"].(map[string]interface{})["error"].(string)).
 					Save(ctx)
@@ -734,54 +822,26 @@ func SyncLockOrderFulfillments() {
 				}
 
 			} else if status == "success" {
-				_, err = storage.Client.LockOrderFulfillment.
+				fulfillment, err := storage.Client.LockOrderFulfillment.
 					Create().
 					SetOrderID(order.ID).
 					SetPsp(psp).
 					SetTxID(txId).
-					SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess).
-					Save(ctx)
-				if err != nil {
-					continue
-				}
-
-				transactionLog, err := storage.Client.TransactionLog.
-					Create().
-					SetStatus(transactionlog.StatusOrderValidated).
-					SetNetwork(order.Edges.Token.Edges.Network.Identifier).
-					SetMetadata(map[string]interface{}{
-						"TransactionID": txId,
-						"PSP":           psp,
-					}).
+					SetAmount(amount).
 					Save(ctx)
 				if err != nil {
 					continue
 				}
 
-				_, err = storage.Client.LockPaymentOrder.
-					UpdateOneID(order.ID).
-					SetStatus(lockpaymentorder.StatusValidated).
-					AddTransactions(transactionLog).
-					Save(ctx)
+				err = addFulfilledAmount(ctx, order, fulfillment)
 				if err != nil {
+					logger.Errorf("SyncLockOrderFulfillments: %v", err)
 					continue
 				}
 			}
 		} else {
 			for _, fulfillment := range order.Edges.Fulfillments {
 				if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusPending {
-					// Compute HMAC
-					decodedSecret, err := base64.StdEncoding.DecodeString(order.Edges.Provider.Edges.APIKey.Secret)
-					if err != nil {
-						logger.Errorf("SyncLockOrderFulfillments: %v", err)
-						return
-					}
-					decryptedSecret, err := cryptoUtils.DecryptPlain(decodedSecret)
-					if err != nil {
-						logger.Errorf("SyncLockOrderFulfillments: %v", err)
-						return
-					}
-
 					payload := map[string]interface{}{
 						"orderId":  order.ID.String(),
 						"currency": order.Edges.ProvisionBucket.Edges.Currency.Code,
@@ -789,20 +849,8 @@ func SyncLockOrderFulfillments() {
 						"txId":     fulfillment.TxID,
 					}
 
-					signature := tokenUtils.GenerateHMACSignature(payload, string(decryptedSecret))
-
-					// Send POST request to the provider's node
-					res, err := fastshot.NewClient(order.Edges.Provider.HostIdentifier).
-						Config().SetTimeout(30*time.Second).
-						Header().Add("X-Request-Signature", signature).
-						Build().POST("/tx_status").
-						Body().AsJSON(payload).
-						Send()
-					if err != nil {
-						continue
-					}
-
-					data, err := utils.ParseJSONResponse(res.RawResponse)
+					// Query the provider's node over the provider stream or HTTP push
+					data, err := streamService.Send(ctx, order.Edges.Provider, services.ProviderStreamTxStatus, payload, 30*time.Second)
 					if err != nil {
 						logger.Errorf("SyncLockOrderFulfillments: %v %v", err, payload)
 						continue
@@ -829,40 +877,19 @@ func SyncLockOrderFulfillments() {
 						}
 
 					} else if status == "success" {
-						_, err = storage.Client.LockOrderFulfillment.
-							UpdateOneID(fulfillment.ID).
-							SetTxID(fulfillment.TxID).
-							SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess).
-							Save(ctx)
+						err = addFulfilledAmount(ctx, order, fulfillment)
 						if err != nil {
+							logger.Errorf("SyncLockOrderFulfillments: %v", err)
 							continue
 						}
-
-						transactionLog, err := storage.Client.TransactionLog.
-							Create().
-							SetStatus(transactionlog.StatusOrderValidated).
-							SetNetwork(order.Edges.Token.Edges.Network.Identifier).
-							SetMetadata(map[string]interface{}{
-								"TransactionID": fulfillment.TxID,
-								"PSP":           fulfillment.Psp,
-							}).
-							Save(ctx)
-						if err != nil {
-							continue
-						}
-
-						_, err = storage.Client.LockPaymentOrder.
-							UpdateOneID(order.ID).
-							SetStatus(lockpaymentorder.StatusValidated).
-							AddTransactions(transactionLog).
-							Save(ctx)
-						if err != nil {
-							continue
+						if order.Status == lockpaymentorder.StatusValidated {
+							break
 						}
 					}
 
 				} else if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusFailed {
-					if order.Edges.Provider.VisibilityMode != providerprofile.VisibilityModePrivate {
+					// A partially paid out order stays with its provider to pay out the rest
+					if order.Edges.Provider.VisibilityMode != providerprofile.VisibilityModePrivate && order.AmountFulfilled.IsZero() {
 						lockPaymentOrder := types.LockPaymentOrderFields{
 							ID:                order.ID,
 							Token:             order.Edges.Token,
@@ -883,34 +910,121 @@ func SyncLockOrderFulfillments() {
 							logger.Errorf("SyncLockOrderFulfillments.AssignLockPaymentOrder: %v", err)
 						}
 					}
-				} else if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess {
-					transactionLog, err := storage.Client.TransactionLog.
-						Create().
-						SetStatus(transactionlog.StatusOrderValidated).
-						SetNetwork(order.Edges.Token.Edges.Network.Identifier).
-						SetMetadata(map[string]interface{}{
-							"TransactionID": fulfillment.TxID,
-							"PSP":           fulfillment.Psp,
-						}).
-						Save(ctx)
-					if err != nil {
-						continue
-					}
-
-					_, err = storage.Client.LockPaymentOrder.
-						UpdateOneID(order.ID).
-						SetStatus(lockpaymentorder.StatusValidated).
-						AddTransactions(transactionLog).
-						Save(ctx)
+				} else if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess && utils.IsLockOrderFulfilled(order) {
+					err := validateLockOrder(ctx, storage.Client, order, fulfillment)
 					if err != nil {
+						logger.Errorf("SyncLockOrderFulfillments: %v", err)
 						continue
 					}
+					break
 				}
 			}
 		}
 	}
 }
 
+// fulfillmentAmount returns the fiat amount of a fulfillment reported by a provider node.
+// Nodes that don't report it are taken to have paid out the whole order, which is only assumed
+// for orders without fulfillments, as a partial payout would otherwise mark the order fully paid
+func fulfillmentAmount(data map[string]interface{}, order *ent.LockPaymentOrder) (decimal.Decimal, error) {
+	if amountValue, ok := data["amount"]; ok && amountValue != nil {
+		amount, err := decimal.NewFromString(fmt.Sprint(amountValue))
+		if err != nil || !amount.IsPositive() {
+			return decimal.Zero, fmt.Errorf("invalid fulfillment amount: %v", amountValue)
+		}
+		return amount, nil
+	}
+
+	if len(order.Edges.Fulfillments) > 0 || order.AmountFulfilled.IsPositive() {
+		return decimal.Zero, fmt.Errorf("fulfillment amount is required for partially fulfilled orders")
+	}
+
+	return utils.UnfulfilledAmount(order), nil
+}
+
+// addFulfilledAmount validates a fulfillment, adds it to the amount paid out for its lock order and validates
+// the order once its fiat amount is covered, all in one transaction. The amount is only added by the update
+// that validates the fulfillment, so a fulfillment validated concurrently is never counted twice
+func addFulfilledAmount(ctx context.Context, order *ent.LockPaymentOrder, fulfillment *ent.LockOrderFulfillment) error {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	validated, err := tx.LockOrderFulfillment.
+		Update().
+		Where(
+			lockorderfulfillment.IDEQ(fulfillment.ID),
+			lockorderfulfillment.ValidationStatusNEQ(lockorderfulfillment.ValidationStatusSuccess),
+		).
+		SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to validate fulfillment: %w", err)
+	}
+	if validated != 1 {
+		// The fulfillment has already been validated and counted
+		_ = tx.Rollback()
+		return nil
+	}
+
+	updatedOrder, err := tx.LockPaymentOrder.
+		UpdateOneID(order.ID).
+		AddAmountFulfilled(fulfillment.Amount).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to add fulfilled amount: %w", err)
+	}
+
+	amountFulfilled := order.AmountFulfilled
+	order.AmountFulfilled = updatedOrder.AmountFulfilled
+
+	if utils.IsLockOrderFulfilled(order) {
+		if err := validateLockOrder(ctx, tx.Client(), order, fulfillment); err != nil {
+			_ = tx.Rollback()
+			order.AmountFulfilled = amountFulfilled
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		order.AmountFulfilled = amountFulfilled
+		return err
+	}
+
+	return nil
+}
+
+// validateLockOrder marks a lock order whose fulfillments cover its fiat amount as validated using the given client
+func validateLockOrder(ctx context.Context, client *ent.Client, order *ent.LockPaymentOrder, fulfillment *ent.LockOrderFulfillment) error {
+	transactionLog, err := client.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusOrderValidated).
+		SetNetwork(order.Edges.Token.Edges.Network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"TransactionID": fulfillment.TxID,
+			"PSP":           fulfillment.Psp,
+		}).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction log: %w", err)
+	}
+
+	_, err = client.LockPaymentOrder.
+		UpdateOneID(order.ID).
+		SetStatus(lockpaymentorder.StatusValidated).
+		AddTransactions(transactionLog).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to validate order: %w", err)
+	}
+	order.Status = lockpaymentorder.StatusValidated
+
+	return nil
+}
+
 // ReassignStaleOrderRequest reassigns expired order requests to providers
 func ReassignStaleOrderRequest(ctx context.Context, orderRequestChan <-chan *redis.Message) {
 	for msg := range orderRequestChan {
@@ -1034,7 +1148,9 @@ func HandleReceiveAddressValidity() error {
 
 	var indexerService services.Indexer
 	for _, address := range addresses {
-		if strings.HasPrefix(address.Edges.PaymentOrder.Edges.Token.Edges.Network.Identifier, "tron") {
+		if address.Edges.PaymentOrder.IsSandbox {
+			indexerService = services.NewIndexerService(orderService.NewOrderSandbox())
+		} else if strings.HasPrefix(address.Edges.PaymentOrder.Edges.Token.Edges.Network.Identifier, "tron") {
 			indexerService = services.NewIndexerService(orderService.NewOrderTron())
 		} else {
 			indexerService = services.NewIndexerService(orderService.NewOrderEVM())
@@ -1046,6 +1162,39 @@ func HandleReceiveAddressValidity() error {
 		}
 	}
 
+	// Expire payment order batches that were not funded in time
+	batches, err := storage.Client.PaymentOrderBatch.
+		Query().
+		Where(
+			paymentorderbatch.StatusEQ(paymentorderbatch.StatusInitiated),
+			paymentorderbatch.HasReceiveAddressWith(
+				receiveaddress.ValidUntilLTE(time.Now()),
+				receiveaddress.StatusNEQ(receiveaddress.StatusUsed),
+			),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleReceiveAddressValidity: %w", err)
+	}
+
+	for _, batch := range batches {
+		if strings.HasPrefix(batch.Edges.Token.Edges.Network.Identifier, "tron") {
+			indexerService = services.NewIndexerService(orderService.NewOrderTron())
+		} else {
+			indexerService = services.NewIndexerService(orderService.NewOrderEVM())
+		}
+
+		err := indexerService.HandleBatchReceiveAddressValidity(ctx, rpcClients[batch.Edges.Token.Edges.Network.Identifier], batch)
+		if err != nil {
+			logger.Errorf("HandleReceiveAddressValidity: %v", err)
+			continue
+		}
+	}
+
 	return nil
 }
 
@@ -1311,10 +1460,344 @@ func RetryFailedWebhookNotifications() error {
 	return nil
 }
 
+// SyncProviderAvailability switches providers with operating hours on and off as they open and close,
+// and rebuilds the bucket queues when any provider's availability changes.
+// Availability only changes when a provider opens or closes, so providers can still switch off manually during their hours
+func SyncProviderAvailability() error {
+	ctx := context.Background()
+
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.OperatingHoursNotNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("SyncProviderAvailability: %w", err)
+	}
+
+	// Look back over two runs so a transition isn't missed when a run is delayed
+	now := time.Now()
+	previous := now.Add(-2 * time.Minute)
+
+	changed := false
+	for _, provider := range providers {
+		isOpen := utils.IsProviderOpen(provider, now)
+		if isOpen == utils.IsProviderOpen(provider, previous) || isOpen == provider.IsAvailable {
+			continue
+		}
+
+		_, err := provider.Update().
+			SetIsAvailable(isOpen).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("SyncProviderAvailability: %v", err)
+			continue
+		}
+
+		changed = true
+	}
+
+	if changed {
+		err = services.NewPriorityQueueService().ProcessBucketQueues()
+		if err != nil {
+			return fmt.Errorf("SyncProviderAvailability: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// HandleStaleProviderRates notifies providers whose rates were excluded from the bucket queues for deviating
+// too far from the market rate, and adjusts the floating rates of providers that opted in once the grace period has passed
+func HandleStaleProviderRates() error {
+	ctx := context.Background()
+	rateExclusionService := services.NewRateExclusionService()
+
+	err := rateExclusionService.NotifyExclusions(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleStaleProviderRates: %w", err)
+	}
+
+	err = rateExclusionService.AdjustStaleRates(ctx)
+	if err != nil {
+		return fmt.Errorf("HandleStaleProviderRates: %w", err)
+	}
+
+	return nil
+}
+
+// HandleDisputeDeadlines enforces the evidence and resolution deadlines of open disputes
+func HandleDisputeDeadlines() error {
+	err := services.NewDisputeService().HandleDeadlines(context.Background())
+	if err != nil {
+		return fmt.Errorf("HandleDisputeDeadlines: %w", err)
+	}
+
+	return nil
+}
+
+// HandlePartialFulfillments escalates lock orders that were partially paid out but not fully covered within
+// the partial fulfillment timeout to ops. These orders are neither refunded nor reassigned, so ops settle the paid
+// out portion and refund the rest with the sender
+func HandlePartialFulfillments() error {
+	ctx := context.Background()
+	now := time.Now()
+
+	lockOrders, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.AmountFulfilledGT(decimal.Zero),
+			lockpaymentorder.StatusIn(
+				lockpaymentorder.StatusPending,
+				lockpaymentorder.StatusProcessing,
+				lockpaymentorder.StatusCancelled,
+				lockpaymentorder.StatusFulfilled,
+			),
+			lockpaymentorder.UpdatedAtLT(now.Add(-orderConf.PartialFulfillmentTimeout)),
+			lockpaymentorder.EscalatedAtIsNil(),
+		).
+		WithToken().
+		WithProvider().
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("HandlePartialFulfillments: %w", err)
+	}
+
+	emailService := services.NewEmailService(services.SENDGRID_MAIL_PROVIDER)
+	for _, order := range lockOrders {
+		if utils.IsLockOrderFulfilled(order) {
+			continue
+		}
+
+		_, err := order.Update().
+			SetEscalatedAt(now).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("HandlePartialFulfillments: %v", err)
+			continue
+		}
+
+		currency := ""
+		if order.Edges.ProvisionBucket != nil {
+			currency = order.Edges.ProvisionBucket.Edges.Currency.Code
+		}
+
+		providerID := ""
+		if order.Edges.Provider != nil {
+			providerID = order.Edges.Provider.ID
+		}
+
+		_, err = emailService.SendOpsEmail(ctx,
+			fmt.Sprintf("Lock order %s is partially fulfilled", order.ID),
+			fmt.Sprintf("Lock order %s (gateway ID %s) assigned to provider %s has paid out %s %s of %s %s and has not been updated since %s.\nSettle the paid out portion and refund the unfulfilled %s %s to the sender.",
+				order.ID, order.GatewayID, providerID,
+				order.AmountFulfilled, currency, utils.LockOrderFiatAmount(order), currency,
+				order.UpdatedAt.UTC().Format(time.RFC1123),
+				utils.UnfulfilledAmount(order), currency),
+		)
+		if err != nil {
+			logger.Errorf("HandlePartialFulfillments.SendOpsEmail: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ProcessScheduledOrders creates the payment orders of scheduled orders that are due and notifies their senders
+func ProcessScheduledOrders() error {
+	ctx := context.Background()
+
+	scheduledOrders, err := storage.Client.ScheduledOrder.
+		Query().
+		Where(
+			scheduledorder.StatusEQ(scheduledorder.StatusActive),
+			scheduledorder.NextRunAtLTE(time.Now()),
+		).
+		WithSenderProfile().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ProcessScheduledOrders: %w", err)
+	}
+
+	for _, scheduledOrder := range scheduledOrders {
+		// Claim the run by advancing the schedule, so that only one instance creates its order.
+		// The schedule advances whether or not the run succeeds, missed runs are not caught up
+		now := time.Now()
+		claim := storage.Client.ScheduledOrder.
+			Update().
+			Where(
+				scheduledorder.IDEQ(scheduledOrder.ID),
+				scheduledorder.StatusEQ(scheduledorder.StatusActive),
+				scheduledorder.NextRunAtEQ(scheduledOrder.NextRunAt),
+			).
+			SetLastRunAt(now)
+
+		nextRunAt, err := utils.NextScheduledRun(scheduledOrder.CronExpression, scheduledOrder.StartAt, scheduledOrder.EndAt, now)
+		if err != nil || nextRunAt.IsZero() {
+			claim.
+				ClearNextRunAt().
+				SetStatus(scheduledorder.StatusCompleted)
+		} else {
+			claim.SetNextRunAt(nextRunAt)
+		}
+
+		claimed, err := claim.Save(ctx)
+		if err != nil {
+			logger.Errorf("ProcessScheduledOrders: %v", err)
+			continue
+		}
+		if claimed == 0 {
+			// Another instance has already run it
+			continue
+		}
+
+		paymentOrder, runErr := createScheduledPaymentOrder(ctx, scheduledOrder)
+
+		// Record the run
+		run := storage.Client.ScheduledOrderRun.
+			Create().
+			SetScheduledOrder(scheduledOrder).
+			SetScheduledFor(scheduledOrder.NextRunAt)
+
+		if runErr != nil {
+			logger.Errorf("ProcessScheduledOrders: %v", runErr)
+			run.
+				SetStatus(scheduledorderrun.StatusFailed).
+				SetError(runErr.Error())
+		} else {
+			run.
+				SetStatus(scheduledorderrun.StatusSucceeded).
+				SetPaymentOrder(paymentOrder)
+		}
+
+		_, err = run.Save(ctx)
+		if err != nil {
+			logger.Errorf("ProcessScheduledOrders: %v", err)
+		}
+
+		// Notify the sender of the receive address to fund
+		if runErr == nil {
+			paymentOrder.Edges.SenderProfile = scheduledOrder.Edges.SenderProfile
+			err = utils.SendPaymentOrderEventWebhook(ctx, paymentOrder, "payment_order.scheduled")
+			if err != nil {
+				logger.Errorf("ProcessScheduledOrders.webhook: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// createScheduledPaymentOrder creates a payment order and its receive address from a scheduled order
+func createScheduledPaymentOrder(ctx context.Context, scheduledOrder *ent.ScheduledOrder) (*ent.PaymentOrder, error) {
+	sender := scheduledOrder.Edges.SenderProfile
+	token := scheduledOrder.Edges.Token
+
+	if !sender.IsActive && !serverConf.Debug {
+		return nil, fmt.Errorf("sender account is not active")
+	}
+
+	if !token.IsEnabled {
+		return nil, fmt.Errorf("token %s is no longer supported on %s", token.Symbol, token.Edges.Network.Identifier)
+	}
+
+	paymentOrderService := services.NewPaymentOrderService()
+
+	senderOrderToken, err := paymentOrderService.GetSenderOrderToken(ctx, sender, token)
+	if err != nil {
+		return nil, err
+	}
+
+	feePercent := senderOrderToken.FeePercent
+	feeTier, err := utils.GetSenderFeeTier(ctx, sender, senderOrderToken, token.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if feeTier != nil {
+		feePercent = feeTier.FeePercent
+	}
+
+	// Use the market rate at the time of the run unless the rate is fixed
+	rate := scheduledOrder.Rate
+	if rate.IsZero() {
+		institution, err := storage.Client.Institution.
+			Query().
+			Where(institution.CodeEQ(scheduledOrder.Institution)).
+			WithFiatCurrency().
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("institution %s is not supported", scheduledOrder.Institution)
+		}
+
+		currency := institution.Edges.FiatCurrency
+		rate, err = utils.GetTokenRateFromQueue(token.Symbol, scheduledOrder.Amount, currency.Code, currency.MarketRate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch rate: %w", err)
+		}
+	}
+
+	recipient := types.PaymentOrderRecipient{
+		Institution:       scheduledOrder.Institution,
+		AccountIdentifier: scheduledOrder.AccountIdentifier,
+		AccountName:       scheduledOrder.AccountName,
+		Memo:              scheduledOrder.Memo,
+	}
+
+	err = paymentOrderService.ValidateOrder(ctx, token, scheduledOrder.Amount, rate, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the receive address, payment order and recipient in a transaction
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receiveAddress, err := paymentOrderService.CreateReceiveAddress(ctx, tx.Client(), token.Edges.Network.Identifier, false)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to create receive address: %w", err)
+	}
+
+	paymentOrder, err := paymentOrderService.CreatePaymentOrder(ctx, tx, services.PaymentOrderParams{
+		Sender:             sender,
+		Token:              token,
+		Amount:             scheduledOrder.Amount,
+		Rate:               rate,
+		Recipient:          recipient,
+		FeePercent:         feePercent,
+		FeeAddress:         senderOrderToken.FeeAddress,
+		FeeTier:            feeTier,
+		ReturnAddress:      senderOrderToken.RefundAddress,
+		UnderpaymentPolicy: paymentorder.UnderpaymentPolicy(sender.UnderpaymentPolicy),
+		OverpaymentPolicy:  paymentorder.OverpaymentPolicy(sender.OverpaymentPolicy),
+		Metadata: map[string]interface{}{
+			"ScheduledOrderID": scheduledOrder.ID.String(),
+		},
+	}, receiveAddress)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return paymentOrder, nil
+}
+
 // StartCronJobs starts cron jobs
 func StartCronJobs() {
 	scheduler := gocron.NewScheduler(time.UTC)
 	priorityQueue := services.NewPriorityQueueService()
+	trustScore := services.NewTrustScoreService()
 
 	err := ComputeMarketRate()
 	if err != nil {
@@ -1362,6 +1845,42 @@ func StartCronJobs() {
 		logger.Errorf("StartCronJobs: %v", err)
 	}
 
+	// Create the payment orders of due scheduled orders every 1 minute
+	_, err = scheduler.Every(1).Minute().Do(ProcessScheduledOrders)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Sync provider availability with operating hours every minute
+	_, err = scheduler.Every(1).Minute().Do(SyncProviderAvailability)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Notify providers of stale rates and adjust them every 5 minutes
+	_, err = scheduler.Every(5).Minutes().Do(HandleStaleProviderRates)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Handle dispute deadlines every 15 minutes
+	_, err = scheduler.Every(15).Minutes().Do(HandleDisputeDeadlines)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Escalate stale partially fulfilled orders every 15 minutes
+	_, err = scheduler.Every(15).Minutes().Do(HandlePartialFulfillments)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Compute provider trust scores every hour
+	_, err = scheduler.Every(1).Hour().Do(trustScore.ComputeTrustScores)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
 	// Retry stale user operations every 2 minutes
 	_, err = scheduler.Every(2).Minutes().Do(RetryStaleUserOperations)
 	if err != nil {
@@ -15,8 +15,13 @@ import (
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/scheduledorder"
+	"github.com/paycrest/aggregator/ent/scheduledorderrun"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/shopspring/decimal"
 )
@@ -98,6 +103,8 @@ type OrderRefundedEvent struct {
 type OrderService interface {
 	CreateOrder(ctx context.Context, client RPCClient, orderID uuid.UUID) error
 	RefundOrder(ctx context.Context, client RPCClient, network *ent.Network, orderID string) error
+	RevertOrder(ctx context.Context, client RPCClient, orderID uuid.UUID) error
+	RevertBatch(ctx context.Context, client RPCClient, batchID uuid.UUID) error
 	SettleOrder(ctx context.Context, client RPCClient, orderID uuid.UUID) error
 }
 
@@ -158,15 +165,88 @@ type AcceptOrderResponse struct {
 type FulfillLockOrderPayload struct {
 	PSP              string                                `json:"psp" binding:"required"`
 	TxID             string                                `json:"txId"`
+	Amount           decimal.Decimal                       `json:"amount"`
 	ValidationStatus lockorderfulfillment.ValidationStatus `json:"validationStatus"`
 	ValidationError  string                                `json:"validationError"`
 }
 
+// FulfillLockOrderResponse is the response for a validated fulfillment that does not yet cover the order
+type FulfillLockOrderResponse struct {
+	AmountFulfilled   decimal.Decimal `json:"amountFulfilled"`
+	UnfulfilledAmount decimal.Decimal `json:"unfulfilledAmount"`
+}
+
 // CancelLockOrderPayload is the payload for the cancel order endpoint
 type CancelLockOrderPayload struct {
 	Reason string `json:"reason" binding:"required"`
 }
 
+// ProviderBalancePayload is a fiat balance reported by a provider
+type ProviderBalancePayload struct {
+	Currency  string          `json:"currency" binding:"required"`
+	Available decimal.Decimal `json:"available"`
+}
+
+// UpdateProviderBalancesPayload is the payload for the update provider balances endpoint
+type UpdateProviderBalancesPayload struct {
+	Balances []ProviderBalancePayload `json:"balances" binding:"required,min=1,dive"`
+}
+
+// ProviderBalanceResponse is the response for a provider's fiat balance in a currency.
+// Reserved is the part of the balance held for outstanding order requests
+type ProviderBalanceResponse struct {
+	Currency  string          `json:"currency"`
+	Balance   decimal.Decimal `json:"balance"`
+	Reserved  decimal.Decimal `json:"reserved"`
+	Available decimal.Decimal `json:"available"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// ProviderRateExclusionResponse is the response for a provider token excluded from the order queue
+type ProviderRateExclusionResponse struct {
+	Token        string          `json:"token"`
+	Currency     string          `json:"currency"`
+	Reason       string          `json:"reason"`
+	Rate         decimal.Decimal `json:"rate"`
+	MarketRate   decimal.Decimal `json:"marketRate"`
+	Deviation    decimal.Decimal `json:"deviation"`
+	MaxDeviation decimal.Decimal `json:"maxDeviation"`
+	ExcludedAt   time.Time       `json:"excludedAt"`
+	NotifiedAt   *time.Time      `json:"notifiedAt"`
+	AdjustedAt   *time.Time      `json:"adjustedAt"`
+}
+
+// TrustScoreComponent is a component of a provider's trust score.
+// Value is the measured rate as a percentage, or the average fulfillment time in seconds
+type TrustScoreComponent struct {
+	Value  decimal.Decimal `json:"value"`
+	Score  decimal.Decimal `json:"score"`
+	Weight decimal.Decimal `json:"weight"`
+}
+
+// ProviderTrustScoreResponse is the response for a provider's trust score and its breakdown
+type ProviderTrustScoreResponse struct {
+	TrustScore      decimal.Decimal     `json:"trustScore"`
+	Acceptance      TrustScoreComponent `json:"acceptance"`
+	Cancellation    TrustScoreComponent `json:"cancellation"`
+	Validation      TrustScoreComponent `json:"validation"`
+	FulfillmentTime TrustScoreComponent `json:"fulfillmentTime"`
+	Disputes        TrustScoreComponent `json:"disputes"`
+	UpdatedAt       time.Time           `json:"updatedAt"`
+}
+
+// ProviderStreamMessage is a message exchanged with a provider node over the provider stream.
+// Order requests, tx_status queries and cancellation notices are sent by the aggregator,
+// and the node replies to each with an ack of the same ID. The node may also report its fiat
+// balances with a balance message whose data is a ProviderBalancePayload.
+type ProviderStreamMessage struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Signature string                 `json:"signature,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
 // LoginPayload is the payload for the login endpoint
 type LoginPayload struct {
 	Email    string `json:"email" binding:"required,email"`
@@ -197,13 +277,29 @@ type SenderOrderTokenPayload struct {
 	Symbol     string                      `json:"symbol" binding:"required"`
 	FeePercent decimal.Decimal             `json:"feePercent" binding:"required"`
 	Addresses  []SenderOrderAddressPayload `json:"addresses"`
+	FeeTiers   []SenderFeeTierPayload      `json:"feeTiers" binding:"omitempty,dive"`
+}
+
+// SenderFeeTierPayload defines a fee tier of a sender token.
+// MinVolume is the rolling 30-day volume in token units from which the tier applies, and
+// tiers with a start or end date are promotional tiers that only apply within that period
+type SenderFeeTierPayload struct {
+	Name       string          `json:"name" binding:"required,max=50"`
+	MinVolume  decimal.Decimal `json:"minVolume"`
+	FeePercent decimal.Decimal `json:"feePercent"`
+	MinFee     decimal.Decimal `json:"minFee"`
+	MaxFee     decimal.Decimal `json:"maxFee"`
+	StartsAt   time.Time       `json:"startsAt"`
+	EndsAt     time.Time       `json:"endsAt"`
 }
 
 // SenderProfilePayload is the payload for the sender profile endpoint
 type SenderProfilePayload struct {
-	WebhookURL      string                    `json:"webhookURL"`
-	DomainWhitelist []string                  `json:"domainWhitelist"`
-	Tokens          []SenderOrderTokenPayload `json:"tokens"`
+	WebhookURL         string                           `json:"webhookURL"`
+	DomainWhitelist    []string                         `json:"domainWhitelist"`
+	Tokens             []SenderOrderTokenPayload        `json:"tokens"`
+	UnderpaymentPolicy senderprofile.UnderpaymentPolicy `json:"underpaymentPolicy" binding:"omitempty,oneof=settle top_up"`
+	OverpaymentPolicy  senderprofile.OverpaymentPolicy  `json:"overpaymentPolicy" binding:"omitempty,oneof=settle refund_excess"`
 }
 
 // ProviderOrderTokenPayload defines the provider setting for a token
@@ -214,75 +310,123 @@ type ProviderOrderTokenPayload struct {
 	FloatingConversionRate decimal.Decimal                       `json:"floatingConversionRate" binding:"required"`
 	MaxOrderAmount         decimal.Decimal                       `json:"maxOrderAmount" binding:"required"`
 	MinOrderAmount         decimal.Decimal                       `json:"minOrderAmount" binding:"required"`
+	RateSlippage           decimal.Decimal                       `json:"rateSlippage"`
+	RateSlippageType       providerordertoken.RateSlippageType   `json:"rateSlippageType"`
+	RateBands              []ProviderRateBand                    `json:"rateBands"`
 	Addresses              []struct {
 		Address string `json:"address"`
 		Network string `json:"network"`
 	} `json:"addresses"`
 }
 
+// ProviderRateBand is a rate a provider offers for orders with a token amount between MinAmount and MaxAmount.
+// The band rate is fixed or floating like the rate of its token
+type ProviderRateBand = struct {
+	MinAmount              decimal.Decimal `json:"minAmount"`
+	MaxAmount              decimal.Decimal `json:"maxAmount"`
+	FixedConversionRate    decimal.Decimal `json:"fixedConversionRate"`
+	FloatingConversionRate decimal.Decimal `json:"floatingConversionRate"`
+}
+
+// ProviderOperatingHours is a window of a provider's weekly operating hours.
+// Day is the weekday starting from 0 for Sunday, and Open and Close are times in the format HH:MM
+type ProviderOperatingHours = struct {
+	Day   int    `json:"day"`
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
 // ProviderProfilePayload is the payload for the provider profile endpoint
 type ProviderProfilePayload struct {
-	TradingName          string                      `json:"tradingName"`
-	Currency             string                      `json:"currency"`
-	HostIdentifier       string                      `json:"hostIdentifier"`
-	IsAvailable          bool                        `json:"isAvailable"`
-	Tokens               []ProviderOrderTokenPayload `json:"tokens"`
-	VisibilityMode       string                      `json:"visibilityMode"`
-	Address              string                      `json:"address"`
-	MobileNumber         string                      `json:"mobileNumber"`
-	DateOfBirth          time.Time                   `json:"dateOfBirth"`
-	BusinessName         string                      `json:"businessName"`
-	IdentityDocumentType string                      `json:"identityType"`
-	IdentityDocument     string                      `json:"identityDocument"`
-	BusinessDocument     string                      `json:"businessDocument"`
+	TradingName             string                      `json:"tradingName"`
+	Currency                string                      `json:"currency"`
+	HostIdentifier          string                      `json:"hostIdentifier"`
+	IsAvailable             bool                        `json:"isAvailable"`
+	Timezone                string                      `json:"timezone"`
+	OperatingHours          []ProviderOperatingHours    `json:"operatingHours"`
+	Holidays                []string                    `json:"holidays"`
+	SupportedInstitutions   []string                    `json:"supportedInstitutions"`
+	UnsupportedInstitutions []string                    `json:"unsupportedInstitutions"`
+	AutoAdjustStaleRates    bool                        `json:"autoAdjustStaleRates"`
+	Tokens                  []ProviderOrderTokenPayload `json:"tokens"`
+	VisibilityMode          string                      `json:"visibilityMode"`
+	Address                 string                      `json:"address"`
+	MobileNumber            string                      `json:"mobileNumber"`
+	DateOfBirth             time.Time                   `json:"dateOfBirth"`
+	BusinessName            string                      `json:"businessName"`
+	IdentityDocumentType    string                      `json:"identityType"`
+	IdentityDocument        string                      `json:"identityDocument"`
+	BusinessDocument        string                      `json:"businessDocument"`
 }
 
 // ProviderProfileResponse is the response for the provider profile endpoint
 type ProviderProfileResponse struct {
-	ID                   string                               `json:"id"`
-	FirstName            string                               `json:"firstName"`
-	LastName             string                               `json:"lastName"`
-	Email                string                               `json:"email"`
-	TradingName          string                               `json:"tradingName"`
-	Currency             string                               `json:"currency"`
-	HostIdentifier       string                               `json:"hostIdentifier"`
-	IsAvailable          bool                                 `json:"isAvailable"`
-	Tokens               []ProviderOrderTokenPayload          `json:"tokens"`
-	APIKey               APIKeyResponse                       `json:"apiKey"`
-	IsActive             bool                                 `json:"isActive"`
-	Address              string                               `json:"address"`
-	MobileNumber         string                               `json:"mobileNumber"`
-	VisibilityMode       providerprofile.VisibilityMode       `json:"visibilityMode"`
-	DateOfBirth          time.Time                            `json:"dateOfBirth"`
-	BusinessName         string                               `json:"businessName"`
-	IdentityDocumentType providerprofile.IdentityDocumentType `json:"identityType"`
-	IdentityDocument     string                               `json:"identityDocument"`
-	BusinessDocument     string                               `json:"businessDocument"`
-	IsKybVerified        bool                                 `json:"isKybVerified"`
+	ID                      string                               `json:"id"`
+	FirstName               string                               `json:"firstName"`
+	LastName                string                               `json:"lastName"`
+	Email                   string                               `json:"email"`
+	TradingName             string                               `json:"tradingName"`
+	Currency                string                               `json:"currency"`
+	HostIdentifier          string                               `json:"hostIdentifier"`
+	IsAvailable             bool                                 `json:"isAvailable"`
+	Timezone                string                               `json:"timezone"`
+	OperatingHours          []ProviderOperatingHours             `json:"operatingHours"`
+	Holidays                []string                             `json:"holidays"`
+	SupportedInstitutions   []string                             `json:"supportedInstitutions"`
+	UnsupportedInstitutions []string                             `json:"unsupportedInstitutions"`
+	AutoAdjustStaleRates    bool                                 `json:"autoAdjustStaleRates"`
+	Tokens                  []ProviderOrderTokenPayload          `json:"tokens"`
+	APIKey                  APIKeyResponse                       `json:"apiKey"`
+	IsActive                bool                                 `json:"isActive"`
+	Address                 string                               `json:"address"`
+	MobileNumber            string                               `json:"mobileNumber"`
+	VisibilityMode          providerprofile.VisibilityMode       `json:"visibilityMode"`
+	DateOfBirth             time.Time                            `json:"dateOfBirth"`
+	BusinessName            string                               `json:"businessName"`
+	IdentityDocumentType    providerprofile.IdentityDocumentType `json:"identityType"`
+	IdentityDocument        string                               `json:"identityDocument"`
+	BusinessDocument        string                               `json:"businessDocument"`
+	IsKybVerified           bool                                 `json:"isKybVerified"`
+	Role                    teammember.Role                      `json:"role"`
 }
 
 // SenderOrderTokenResponse defines the provider setting for a token
 type SenderOrderTokenResponse struct {
-	Symbol        string          `json:"symbol" binding:"required"`
-	FeePercent    decimal.Decimal `json:"feePercent" binding:"required"`
-	Network       string          `json:"network" binding:"required"`
-	FeeAddress    string          `json:"feeAddress" binding:"required"`
-	RefundAddress string          `json:"refundAddress" binding:"required"`
+	Symbol        string                  `json:"symbol" binding:"required"`
+	FeePercent    decimal.Decimal         `json:"feePercent" binding:"required"`
+	Network       string                  `json:"network" binding:"required"`
+	FeeAddress    string                  `json:"feeAddress" binding:"required"`
+	RefundAddress string                  `json:"refundAddress" binding:"required"`
+	FeeTiers      []SenderFeeTierResponse `json:"feeTiers"`
+}
+
+// SenderFeeTierResponse is the response type for a fee tier of a sender token
+type SenderFeeTierResponse struct {
+	Name       string          `json:"name"`
+	MinVolume  decimal.Decimal `json:"minVolume"`
+	FeePercent decimal.Decimal `json:"feePercent"`
+	MinFee     decimal.Decimal `json:"minFee"`
+	MaxFee     decimal.Decimal `json:"maxFee"`
+	StartsAt   *time.Time      `json:"startsAt,omitempty"`
+	EndsAt     *time.Time      `json:"endsAt,omitempty"`
 }
 
 // SenderProfileResponse is the response for the sender profile endpoint
 type SenderProfileResponse struct {
-	ID               uuid.UUID                  `json:"id"`
-	FirstName        string                     `json:"firstName"`
-	LastName         string                     `json:"lastName"`
-	Email            string                     `json:"email"`
-	WebhookURL       string                     `json:"webhookUrl"`
-	DomainWhitelist  []string                   `json:"domainWhitelist"`
-	Tokens           []SenderOrderTokenResponse `json:"tokens"`
-	APIKey           APIKeyResponse             `json:"apiKey"`
-	ProviderID       string                     `json:"providerId"`
-	ProviderCurrency string                     `json:"providerCurrency"`
-	IsActive         bool                       `json:"isActive"`
+	ID                 uuid.UUID                        `json:"id"`
+	FirstName          string                           `json:"firstName"`
+	LastName           string                           `json:"lastName"`
+	Email              string                           `json:"email"`
+	WebhookURL         string                           `json:"webhookUrl"`
+	DomainWhitelist    []string                         `json:"domainWhitelist"`
+	Tokens             []SenderOrderTokenResponse       `json:"tokens"`
+	APIKey             APIKeyResponse                   `json:"apiKey"`
+	ProviderID         string                           `json:"providerId"`
+	ProviderCurrency   string                           `json:"providerCurrency"`
+	IsActive           bool                             `json:"isActive"`
+	UnderpaymentPolicy senderprofile.UnderpaymentPolicy `json:"underpaymentPolicy"`
+	OverpaymentPolicy  senderprofile.OverpaymentPolicy  `json:"overpaymentPolicy"`
+	Role               teammember.Role                  `json:"role"`
 }
 
 // RefreshResponse is the response for the refresh endpoint
@@ -296,6 +440,34 @@ type APIKeyResponse struct {
 	Secret string    `json:"secret"`
 }
 
+// APIKeyPayload is the payload for the create API key endpoint
+type APIKeyPayload struct {
+	Name      string     `json:"name" binding:"required,max=80"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=orders:read orders:write orders:export stats:read settings:read"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Sandbox   bool       `json:"sandbox"`
+}
+
+// RotateAPIKeyPayload is the payload for the rotate API key endpoint
+type RotateAPIKeyPayload struct {
+	OverlapHours *int `json:"overlapHours" binding:"omitempty,min=0,max=168"`
+}
+
+// APIKeyDetailsResponse is the response type for an API key in the API keys endpoints.
+// The secret is only returned when a key is created or rotated.
+type APIKeyDetailsResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	IsPrimary  bool       `json:"isPrimary"`
+	IsSandbox  bool       `json:"isSandbox"`
+	Secret     string     `json:"secret,omitempty"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
 // ERC20Transfer is the Transfer event of an ERC20 smart contract
 type ERC20Transfer struct {
 	From  common.Address
@@ -318,6 +490,7 @@ type LockPaymentOrderFields struct {
 	AccountName       string
 	ProviderID        string
 	Memo              string
+	OrderPercent      decimal.Decimal
 	ProvisionBucket   *ent.ProvisionBucket
 	UpdatedAt         time.Time
 	CreatedAt         time.Time
@@ -390,17 +563,182 @@ type PaymentOrderRecipient struct {
 	Nonce             string `json:"nonce"`
 }
 
+// BeneficiaryPayload is the payload for saving a recipient to a sender's address book
+type BeneficiaryPayload struct {
+	Institution       string `json:"institution" binding:"required"`
+	AccountIdentifier string `json:"accountIdentifier" binding:"required"`
+	AccountName       string `json:"accountName" binding:"required"`
+	Memo              string `json:"memo"`
+}
+
+// BeneficiaryResponse is the response for a saved beneficiary
+type BeneficiaryResponse struct {
+	ID                  uuid.UUID  `json:"id"`
+	Institution         string     `json:"institution"`
+	AccountIdentifier   string     `json:"accountIdentifier"`
+	AccountName         string     `json:"accountName"`
+	Memo                string     `json:"memo"`
+	VerifiedAccountName string     `json:"verifiedAccountName,omitempty"`
+	VerifiedAt          *time.Time `json:"verifiedAt,omitempty"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+// PaymentOrderSplitPayload describes an additional recipient paid a share of a payment order.
+// The share is either a percent of the order amount or a fixed fiat amount in the recipient's currency,
+// and the main recipient is paid the remainder
+type PaymentOrderSplitPayload struct {
+	Institution       string          `json:"institution" binding:"required"`
+	AccountIdentifier string          `json:"accountIdentifier" binding:"required"`
+	AccountName       string          `json:"accountName" binding:"required"`
+	Memo              string          `json:"memo"`
+	Percent           decimal.Decimal `json:"percent"`
+	FiatAmount        decimal.Decimal `json:"fiatAmount"`
+}
+
+// PaymentOrderRecipientSettlement describes the share of a split payment order paid to a recipient and its settlement status
+type PaymentOrderRecipientSettlement struct {
+	Institution       string                  `json:"institution"`
+	AccountIdentifier string                  `json:"accountIdentifier"`
+	AccountName       string                  `json:"accountName"`
+	Amount            decimal.Decimal         `json:"amount"`
+	Percent           decimal.Decimal         `json:"percent"`
+	Status            lockpaymentorder.Status `json:"status"`
+}
+
 // NewPaymentOrderPayload is the payload for the create payment order endpoint
 type NewPaymentOrderPayload struct {
-	Amount        decimal.Decimal       `json:"amount" binding:"required"`
-	Token         string                `json:"token" binding:"required"`
-	Rate          decimal.Decimal       `json:"rate" binding:"required"`
-	Network       string                `json:"network" binding:"required"`
-	Recipient     PaymentOrderRecipient `json:"recipient" binding:"required"`
-	Reference     string                `json:"reference"`
-	ReturnAddress string                `json:"returnAddress"`
-	FeePercent    decimal.Decimal       `json:"feePercent"`
-	FeeAddress    string                `json:"feeAddress"`
+	Amount             decimal.Decimal                 `json:"amount" binding:"required"`
+	Token              string                          `json:"token" binding:"required"`
+	Rate               decimal.Decimal                 `json:"rate"`
+	QuoteID            string                          `json:"quoteId"`
+	Network            string                          `json:"network" binding:"required"`
+	Recipient          *PaymentOrderRecipient          `json:"recipient" binding:"required_without=BeneficiaryID,excluded_with=BeneficiaryID"`
+	BeneficiaryID      string                          `json:"beneficiaryId" binding:"omitempty,uuid"`
+	Splits             []PaymentOrderSplitPayload      `json:"splits" binding:"omitempty,max=10,dive"`
+	Reference          string                          `json:"reference"`
+	ReturnAddress      string                          `json:"returnAddress"`
+	FeePercent         decimal.Decimal                 `json:"feePercent"`
+	FeeAddress         string                          `json:"feeAddress"`
+	UnderpaymentPolicy paymentorder.UnderpaymentPolicy `json:"underpaymentPolicy" binding:"omitempty,oneof=settle top_up"`
+	OverpaymentPolicy  paymentorder.OverpaymentPolicy  `json:"overpaymentPolicy" binding:"omitempty,oneof=settle refund_excess"`
+}
+
+// NewPaymentOrderBatchItem is a single payout within a batch payment order payload
+type NewPaymentOrderBatchItem struct {
+	Amount    decimal.Decimal       `json:"amount" binding:"required"`
+	Rate      decimal.Decimal       `json:"rate" binding:"required"`
+	Recipient PaymentOrderRecipient `json:"recipient" binding:"required"`
+	Reference string                `json:"reference"`
+}
+
+// NewPaymentOrderBatchPayload is the payload for the create batch payment order endpoint
+type NewPaymentOrderBatchPayload struct {
+	Token         string                     `json:"token" binding:"required"`
+	Network       string                     `json:"network" binding:"required"`
+	ReturnAddress string                     `json:"returnAddress"`
+	Reference     string                     `json:"reference"`
+	Orders        []NewPaymentOrderBatchItem `json:"orders" binding:"required,min=1,max=500,dive"`
+}
+
+// ScheduledOrderPayload is the payload for the create scheduled order endpoint.
+// An empty cron expression schedules a one-off order at the start time
+type ScheduledOrderPayload struct {
+	Amount         decimal.Decimal        `json:"amount" binding:"required"`
+	Token          string                 `json:"token" binding:"required"`
+	Network        string                 `json:"network" binding:"required"`
+	Rate           decimal.Decimal        `json:"rate"`
+	Recipient      *PaymentOrderRecipient `json:"recipient" binding:"required_without=BeneficiaryID,excluded_with=BeneficiaryID"`
+	BeneficiaryID  string                 `json:"beneficiaryId" binding:"omitempty,uuid"`
+	CronExpression string                 `json:"cronExpression"`
+	StartAt        *time.Time             `json:"startAt"`
+	EndAt          *time.Time             `json:"endAt"`
+}
+
+// ScheduledOrderResponse is the response for a scheduled order
+type ScheduledOrderResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	Amount         decimal.Decimal       `json:"amount"`
+	Token          string                `json:"token"`
+	Network        string                `json:"network"`
+	Rate           decimal.Decimal       `json:"rate"`
+	Recipient      PaymentOrderRecipient `json:"recipient"`
+	CronExpression string                `json:"cronExpression,omitempty"`
+	StartAt        time.Time             `json:"startAt"`
+	EndAt          *time.Time            `json:"endAt,omitempty"`
+	NextRunAt      *time.Time            `json:"nextRunAt,omitempty"`
+	LastRunAt      *time.Time            `json:"lastRunAt,omitempty"`
+	Status         scheduledorder.Status `json:"status"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// ScheduledOrderRunResponse is the response for a run of a scheduled order
+type ScheduledOrderRunResponse struct {
+	ID             uuid.UUID                `json:"id"`
+	ScheduledFor   time.Time                `json:"scheduledFor"`
+	Status         scheduledorderrun.Status `json:"status"`
+	Error          string                   `json:"error,omitempty"`
+	PaymentOrderID *uuid.UUID               `json:"paymentOrderId,omitempty"`
+	CreatedAt      time.Time                `json:"createdAt"`
+}
+
+// ScheduledOrderRunList is the struct for a list of the runs of a scheduled order
+type ScheduledOrderRunList struct {
+	TotalRecords int                         `json:"total"`
+	Page         int                         `json:"page"`
+	PageSize     int                         `json:"pageSize"`
+	Runs         []ScheduledOrderRunResponse `json:"runs"`
+}
+
+// SandboxDepositPayload is the payload for the simulated deposit endpoint of a sandbox order.
+// The amount defaults to the outstanding order amount with fees.
+type SandboxDepositPayload struct {
+	Amount      decimal.Decimal `json:"amount"`
+	FromAddress string          `json:"fromAddress"`
+}
+
+// SandboxSettlePayload is the payload for the simulated settlement endpoint of a sandbox order
+type SandboxSettlePayload struct {
+	Percent decimal.Decimal `json:"percent"`
+}
+
+// SandboxOrderResponse is the response for the simulated endpoints of a sandbox order
+type SandboxOrderResponse struct {
+	ID             uuid.UUID           `json:"id"`
+	AmountPaid     decimal.Decimal     `json:"amountPaid"`
+	AmountReturned decimal.Decimal     `json:"amountReturned"`
+	PercentSettled decimal.Decimal     `json:"percentSettled"`
+	TxHash         string              `json:"txHash"`
+	GatewayID      string              `json:"gatewayId"`
+	Status         paymentorder.Status `json:"status"`
+}
+
+// NewRateQuotePayload is the payload for the create rate quote endpoint
+type NewRateQuotePayload struct {
+	Amount   decimal.Decimal `json:"amount" binding:"required"`
+	Token    string          `json:"token" binding:"required"`
+	Network  string          `json:"network" binding:"required"`
+	Currency string          `json:"currency" binding:"required"`
+}
+
+// RateQuote is a rate quote issued to a sender
+type RateQuote struct {
+	ID          uuid.UUID       `json:"id"`
+	SenderID    uuid.UUID       `json:"senderId"`
+	Amount      decimal.Decimal `json:"amount"`
+	Token       string          `json:"token"`
+	Network     string          `json:"network"`
+	Currency    string          `json:"currency"`
+	Rate        decimal.Decimal `json:"rate"`
+	ProviderID  string          `json:"providerId,omitempty"`
+	FiatAmount  decimal.Decimal `json:"fiatAmount"`
+	SenderFee   decimal.Decimal `json:"senderFee"`
+	FeeTier     string          `json:"feeTier,omitempty"`
+	NetworkFee  decimal.Decimal `json:"networkFee"`
+	ProtocolFee decimal.Decimal `json:"protocolFee"`
+	TotalAmount decimal.Decimal `json:"totalAmount"`
+	ExpiresAt   time.Time       `json:"expiresAt"`
 }
 
 // ReceiveAddressResponse is the response type for a receive address
@@ -412,56 +750,103 @@ type ReceiveAddressResponse struct {
 	ReceiveAddress string          `json:"receiveAddress"`
 	ValidUntil     time.Time       `json:"validUntil"`
 	SenderFee      decimal.Decimal `json:"senderFee"`
+	FeeTier        string          `json:"feeTier,omitempty"`
 	TransactionFee decimal.Decimal `json:"transactionFee"`
 	Reference      string          `json:"reference"`
 }
 
+// PaymentOrderBatchItemResponse is the response type for a payment order within a batch
+type PaymentOrderBatchItemResponse struct {
+	ID        uuid.UUID           `json:"id"`
+	Amount    decimal.Decimal     `json:"amount"`
+	SenderFee decimal.Decimal     `json:"senderFee"`
+	FeeTier   string              `json:"feeTier,omitempty"`
+	Reference string              `json:"reference"`
+	Status    paymentorder.Status `json:"status"`
+	TxHash    string              `json:"txHash,omitempty"`
+}
+
+// PaymentOrderBatchResponse is the response type for a batch payment order
+type PaymentOrderBatchResponse struct {
+	ID             uuid.UUID                       `json:"id"`
+	Amount         decimal.Decimal                 `json:"amount"`
+	AmountPaid     decimal.Decimal                 `json:"amountPaid"`
+	AmountReturned decimal.Decimal                 `json:"amountReturned"`
+	Token          string                          `json:"token"`
+	Network        string                          `json:"network"`
+	ReceiveAddress string                          `json:"receiveAddress"`
+	ValidUntil     time.Time                       `json:"validUntil"`
+	SenderFee      decimal.Decimal                 `json:"senderFee"`
+	TransactionFee decimal.Decimal                 `json:"transactionFee"`
+	Reference      string                          `json:"reference"`
+	Status         paymentorderbatch.Status        `json:"status"`
+	TotalOrders    int                             `json:"totalOrders"`
+	Progress       map[paymentorder.Status]int     `json:"progress"`
+	Orders         []PaymentOrderBatchItemResponse `json:"orders"`
+	CreatedAt      time.Time                       `json:"createdAt"`
+	UpdatedAt      time.Time                       `json:"updatedAt"`
+}
+
+// CancelPaymentOrderResponse is the response type for a cancelled payment order
+type CancelPaymentOrderResponse struct {
+	ID         uuid.UUID           `json:"id"`
+	Status     paymentorder.Status `json:"status"`
+	AmountPaid decimal.Decimal     `json:"amountPaid"`
+}
+
 // PaymentOrderResponse is the response type for a payment order
 type PaymentOrderResponse struct {
-	ID             uuid.UUID             `json:"id"`
-	Amount         decimal.Decimal       `json:"amount"`
-	AmountPaid     decimal.Decimal       `json:"amountPaid"`
-	AmountReturned decimal.Decimal       `json:"amountReturned"`
-	Token          string                `json:"token"`
-	SenderFee      decimal.Decimal       `json:"senderFee"`
-	TransactionFee decimal.Decimal       `json:"transactionFee"`
-	Rate           decimal.Decimal       `json:"rate"`
-	Network        string                `json:"network"`
-	GatewayID      string                `json:"gatewayId"`
-	Recipient      PaymentOrderRecipient `json:"recipient"`
-	FromAddress    string                `json:"fromAddress"`
-	ReturnAddress  string                `json:"returnAddress"`
-	ReceiveAddress string                `json:"receiveAddress"`
-	FeeAddress     string                `json:"feeAddress"`
-	Reference      string                `json:"reference"`
-	CreatedAt      time.Time             `json:"createdAt"`
-	UpdatedAt      time.Time             `json:"updatedAt"`
-	TxHash         string                `json:"txHash"`
-	Status         paymentorder.Status   `json:"status"`
-	Transactions   []TransactionLog      `json:"transactionLogs"`
+	ID                 uuid.UUID                         `json:"id"`
+	Amount             decimal.Decimal                   `json:"amount"`
+	AmountPaid         decimal.Decimal                   `json:"amountPaid"`
+	AmountReturned     decimal.Decimal                   `json:"amountReturned"`
+	Token              string                            `json:"token"`
+	SenderFee          decimal.Decimal                   `json:"senderFee"`
+	FeeTier            string                            `json:"feeTier,omitempty"`
+	TransactionFee     decimal.Decimal                   `json:"transactionFee"`
+	Rate               decimal.Decimal                   `json:"rate"`
+	Network            string                            `json:"network"`
+	GatewayID          string                            `json:"gatewayId"`
+	Recipient          PaymentOrderRecipient             `json:"recipient"`
+	Recipients         []PaymentOrderRecipientSettlement `json:"recipients,omitempty"`
+	FromAddress        string                            `json:"fromAddress"`
+	ReturnAddress      string                            `json:"returnAddress"`
+	ReceiveAddress     string                            `json:"receiveAddress"`
+	FeeAddress         string                            `json:"feeAddress"`
+	Reference          string                            `json:"reference"`
+	CreatedAt          time.Time                         `json:"createdAt"`
+	UpdatedAt          time.Time                         `json:"updatedAt"`
+	TxHash             string                            `json:"txHash"`
+	Status             paymentorder.Status               `json:"status"`
+	UnderpaymentPolicy paymentorder.UnderpaymentPolicy   `json:"underpaymentPolicy"`
+	OverpaymentPolicy  paymentorder.OverpaymentPolicy    `json:"overpaymentPolicy"`
+	Transactions       []TransactionLog                  `json:"transactionLogs"`
 }
 
 // PaymentOrderWebhookData is the data type for a payment order webhook
 type PaymentOrderWebhookData struct {
-	ID             uuid.UUID             `json:"id"`
-	Amount         decimal.Decimal       `json:"amount"`
-	AmountPaid     decimal.Decimal       `json:"amountPaid"`
-	AmountReturned decimal.Decimal       `json:"amountReturned"`
-	PercentSettled decimal.Decimal       `json:"percentSettled"`
-	SenderFee      decimal.Decimal       `json:"senderFee"`
-	NetworkFee     decimal.Decimal       `json:"networkFee"`
-	Rate           decimal.Decimal       `json:"rate"`
-	Network        string                `json:"network"`
-	GatewayID      string                `json:"gatewayId"`
-	SenderID       uuid.UUID             `json:"senderId"`
-	Recipient      PaymentOrderRecipient `json:"recipient"`
-	FromAddress    string                `json:"fromAddress"`
-	ReturnAddress  string                `json:"returnAddress"`
-	Reference      string                `json:"reference"`
-	UpdatedAt      time.Time             `json:"updatedAt"`
-	CreatedAt      time.Time             `json:"createdAt"`
-	TxHash         string                `json:"txHash"`
-	Status         paymentorder.Status   `json:"status"`
+	ID             uuid.UUID                         `json:"id"`
+	Amount         decimal.Decimal                   `json:"amount"`
+	AmountPaid     decimal.Decimal                   `json:"amountPaid"`
+	AmountReturned decimal.Decimal                   `json:"amountReturned"`
+	PercentSettled decimal.Decimal                   `json:"percentSettled"`
+	SenderFee      decimal.Decimal                   `json:"senderFee"`
+	FeeTier        string                            `json:"feeTier,omitempty"`
+	NetworkFee     decimal.Decimal                   `json:"networkFee"`
+	Rate           decimal.Decimal                   `json:"rate"`
+	Network        string                            `json:"network"`
+	GatewayID      string                            `json:"gatewayId"`
+	SenderID       uuid.UUID                         `json:"senderId"`
+	Recipient      PaymentOrderRecipient             `json:"recipient"`
+	Recipients     []PaymentOrderRecipientSettlement `json:"recipients,omitempty"`
+	FromAddress    string                            `json:"fromAddress"`
+	ReturnAddress  string                            `json:"returnAddress"`
+	Reference      string                            `json:"reference"`
+	ReceiveAddress string                            `json:"receiveAddress,omitempty"`
+	UpdatedAt      time.Time                         `json:"updatedAt"`
+	CreatedAt      time.Time                         `json:"createdAt"`
+	TxHash         string                            `json:"txHash"`
+	Status         paymentorder.Status               `json:"status"`
 }
 
 // PaymentOrderWebhookPayload is the request type for a payment order webhook
@@ -470,6 +855,79 @@ type PaymentOrderWebhookPayload struct {
 	Data  PaymentOrderWebhookData `json:"data"`
 }
 
+// DisputeEvidence is a proof of fulfilment submitted by a provider for a dispute, e.g a PSP receipt or reference
+type DisputeEvidence = struct {
+	Type        string    `json:"type"`
+	Reference   string    `json:"reference"`
+	URL         string    `json:"url"`
+	Note        string    `json:"note"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+// OpenDisputePayload is the payload for opening a dispute on a settled order
+type OpenDisputePayload struct {
+	Reason            string `json:"reason" binding:"required"`
+	AccountIdentifier string `json:"accountIdentifier"`
+}
+
+// DisputeEvidencePayload is a proof of fulfilment in the submit dispute evidence payload
+type DisputeEvidencePayload struct {
+	Type      string `json:"type" binding:"required,oneof=receipt reference other"`
+	Reference string `json:"reference"`
+	URL       string `json:"url" binding:"omitempty,url"`
+	Note      string `json:"note"`
+}
+
+// SubmitDisputeEvidencePayload is the payload for a provider to submit evidence for a dispute
+type SubmitDisputeEvidencePayload struct {
+	Evidence []DisputeEvidencePayload `json:"evidence" binding:"required,min=1,dive"`
+}
+
+// ResolveDisputePayload is the payload for ops to resolve or reject a dispute
+type ResolveDisputePayload struct {
+	Status          string `json:"status" binding:"required,oneof=resolved rejected"`
+	ProviderAtFault bool   `json:"providerAtFault"`
+	Resolution      string `json:"resolution" binding:"required"`
+}
+
+// DisputeResponse is the response for a dispute.
+// OrderID is the payment order for senders and the lock payment order for providers and ops
+type DisputeResponse struct {
+	ID                uuid.UUID         `json:"id"`
+	OrderID           uuid.UUID         `json:"orderId"`
+	Institution       string            `json:"institution"`
+	AccountIdentifier string            `json:"accountIdentifier"`
+	Status            string            `json:"status"`
+	Reason            string            `json:"reason"`
+	Evidence          []DisputeEvidence `json:"evidence"`
+	ProviderAtFault   *bool             `json:"providerAtFault"`
+	Resolution        string            `json:"resolution"`
+	EvidenceDueAt     *time.Time        `json:"evidenceDueAt"`
+	ResolutionDueAt   time.Time         `json:"resolutionDueAt"`
+	ResolvedAt        *time.Time        `json:"resolvedAt"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+}
+
+// RequestDisputeEvidencePayload is the payload for ops to request more evidence for a dispute
+type RequestDisputeEvidencePayload struct {
+	Note string `json:"note"`
+}
+
+// DisputeList is the struct for a list of disputes
+type DisputeList struct {
+	TotalRecords int               `json:"total"`
+	Page         int               `json:"page"`
+	PageSize     int               `json:"pageSize"`
+	Disputes     []DisputeResponse `json:"disputes"`
+}
+
+// DisputeWebhookPayload is the request type for a dispute webhook
+type DisputeWebhookPayload struct {
+	Event string          `json:"event"`
+	Data  DisputeResponse `json:"data"`
+}
+
 // ConfirmEmailPayload is the payload for the confirmEmail endpoint
 type ConfirmEmailPayload struct {
 	Token string `json:"token" binding:"required"`
@@ -549,6 +1007,7 @@ type ProviderLockOrderList struct {
 	TotalRecords int                        `json:"total"`
 	Page         int                        `json:"page"`
 	PageSize     int                        `json:"pageSize"`
+	NextCursor   string                     `json:"nextCursor,omitempty"`
 	Orders       []LockPaymentOrderResponse `json:"orders"`
 }
 
@@ -557,27 +1016,142 @@ type SenderPaymentOrderList struct {
 	TotalRecords int                    `json:"total"`
 	Page         int                    `json:"page"`
 	PageSize     int                    `json:"pageSize"`
+	NextCursor   string                 `json:"nextCursor,omitempty"`
 	Orders       []PaymentOrderResponse `json:"orders"`
 }
 
+// PaginationCursor is the decoded position of an opaque keyset pagination cursor
+type PaginationCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// OrderListFilters holds the filters parsed from the query params of an order listing request
+type OrderListFilters struct {
+	Statuses          []string
+	Token             string
+	Network           string
+	Reference         string
+	Institution       string
+	AccountIdentifier string
+	From              time.Time
+	To                time.Time
+	MinAmount         decimal.NullDecimal
+	MaxAmount         decimal.NullDecimal
+	Cursor            *PaginationCursor
+}
+
 // ChangePasswordPayload is the payload for the change password endpoint
 type ChangePasswordPayload struct {
 	OldPassword string `json:"oldPassword" binding:"required,min=6,max=20"`
 	NewPassword string `json:"newPassword" binding:"required,min=6,max=20"`
 }
 
+// TeamPermission is an action a team member role may be allowed to perform
+type TeamPermission string
+
+const (
+	PermissionOrdersRead    TeamPermission = "orders:read"
+	PermissionOrdersWrite   TeamPermission = "orders:write"
+	PermissionOrdersExport  TeamPermission = "orders:export"
+	PermissionStatsRead     TeamPermission = "stats:read"
+	PermissionSettingsRead  TeamPermission = "settings:read"
+	PermissionSettingsWrite TeamPermission = "settings:write"
+	PermissionKeysManage    TeamPermission = "keys:manage"
+	PermissionTeamManage    TeamPermission = "team:manage"
+)
+
+// InviteTeamMemberPayload is the payload for the invite team member endpoint
+type InviteTeamMemberPayload struct {
+	Email string          `json:"email" binding:"required,email"`
+	Role  teammember.Role `json:"role" binding:"required,oneof=admin developer finance read_only"`
+}
+
+// UpdateTeamMemberPayload is the payload for the update team member endpoint
+type UpdateTeamMemberPayload struct {
+	Role teammember.Role `json:"role" binding:"required,oneof=admin developer finance read_only"`
+}
+
+// AcceptTeamInvitePayload is the payload for the accept team invite endpoint.
+// The names and password are only required when the invited email has no account yet.
+type AcceptTeamInvitePayload struct {
+	Token     string `json:"token" binding:"required"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `json:"password" binding:"omitempty,min=6,max=20"`
+}
+
+// TeamMemberResponse is the response type for a team member
+type TeamMemberResponse struct {
+	ID        uuid.UUID         `json:"id"`
+	Email     string            `json:"email"`
+	FirstName string            `json:"firstName,omitempty"`
+	LastName  string            `json:"lastName,omitempty"`
+	Role      teammember.Role   `json:"role"`
+	Status    teammember.Status `json:"status"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
 // SenderStatsResponse is the response for the sender stats endpoint
 type SenderStatsResponse struct {
-	TotalOrders      int             `json:"totalOrders"`
-	TotalOrderVolume decimal.Decimal `json:"totalOrderVolume"`
-	TotalFeeEarnings decimal.Decimal `json:"totalFeeEarnings"`
+	TotalOrders      int                  `json:"totalOrders"`
+	TotalOrderVolume decimal.Decimal      `json:"totalOrderVolume"`
+	TotalFeeEarnings decimal.Decimal      `json:"totalFeeEarnings"`
+	Series           []StatsSeriesEntry   `json:"series,omitempty"`
+	SettlementTime   *SettlementTimeStats `json:"settlementTime,omitempty"`
 }
 
 // ProviderStatsResponse is the response for the provider stats endpoint
 type ProviderStatsResponse struct {
-	TotalOrders       int             `json:"totalOrders"`
-	TotalFiatVolume   decimal.Decimal `json:"totalFiatVolume"`
-	TotalCryptoVolume decimal.Decimal `json:"totalCryptoVolume"`
+	TotalOrders       int                  `json:"totalOrders"`
+	TotalFiatVolume   decimal.Decimal      `json:"totalFiatVolume"`
+	TotalCryptoVolume decimal.Decimal      `json:"totalCryptoVolume"`
+	Series            []StatsSeriesEntry   `json:"series,omitempty"`
+	SettlementTime    *SettlementTimeStats `json:"settlementTime,omitempty"`
+}
+
+// StatsQuery holds the time bucket, group-by dimensions and date range of a stats request
+type StatsQuery struct {
+	Bucket  string
+	GroupBy []string
+	From    time.Time
+	To      time.Time
+}
+
+// StatsRecord is a group of orders as seen by the stats aggregation.
+// The volumes are of the group's settled orders, and the settlement time is set for a single settled order
+type StatsRecord struct {
+	CreatedAt      time.Time
+	Token          string
+	Network        string
+	Currency       string
+	Status         string
+	Orders         int
+	Amount         decimal.Decimal
+	FiatAmount     decimal.Decimal
+	Fee            decimal.Decimal
+	SettlementTime time.Duration
+}
+
+// SettlementTimeStats summarizes how long settled orders took to settle
+type SettlementTimeStats struct {
+	SettledOrders int     `json:"settledOrders"`
+	MedianSeconds float64 `json:"medianSeconds"`
+	P95Seconds    float64 `json:"p95Seconds"`
+}
+
+// StatsSeriesEntry is the aggregate of the orders within a time bucket and group
+type StatsSeriesEntry struct {
+	Period         string               `json:"period,omitempty"`
+	Token          string               `json:"token,omitempty"`
+	Network        string               `json:"network,omitempty"`
+	Currency       string               `json:"currency,omitempty"`
+	Status         string               `json:"status,omitempty"`
+	TotalOrders    int                  `json:"totalOrders"`
+	CryptoVolume   decimal.Decimal      `json:"cryptoVolume"`
+	FiatVolume     decimal.Decimal      `json:"fiatVolume"`
+	FeeEarnings    decimal.Decimal      `json:"feeEarnings"`
+	SettlementTime *SettlementTimeStats `json:"settlementTime,omitempty"`
 }
 
 // VerifyAccountRequest is the request for account verification of an institution
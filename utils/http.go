@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,10 +10,13 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -110,8 +115,267 @@ func Paginate(ctx *gin.Context) (page int, offset int, limit int) {
 	return page, offset, pageSize
 }
 
+// EncodeCursor returns an opaque pagination cursor pointing at the given record
+func EncodeCursor(createdAt time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%s|%s", createdAt.UTC().Format(time.RFC3339Nano), id.String())),
+	)
+}
+
+// DecodeCursor parses an opaque pagination cursor created by EncodeCursor
+func DecodeCursor(cursor string) (*types.PaginationCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	parts := strings.SplitN(string(decoded), "|", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	return &types.PaginationCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+// parseDateQuery parses a date query param given either as RFC3339 or YYYY-MM-DD
+func parseDateQuery(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// ParseOrderListFilters parses the filter and cursor query params shared by the order listing endpoints
+func ParseOrderListFilters(ctx *gin.Context) (*types.OrderListFilters, error) {
+	filters := &types.OrderListFilters{
+		Token:             ctx.Query("token"),
+		Network:           ctx.Query("network"),
+		Reference:         ctx.Query("reference"),
+		Institution:       ctx.Query("institution"),
+		AccountIdentifier: ctx.Query("accountIdentifier"),
+	}
+
+	// Multiple statuses are comma separated e.g ?status=pending,settled
+	if status := ctx.Query("status"); status != "" {
+		for _, s := range strings.Split(status, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				filters.Statuses = append(filters.Statuses, s)
+			}
+		}
+	}
+
+	if from := ctx.Query("from"); from != "" {
+		t, err := parseDateQuery(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date")
+		}
+		filters.From = t
+	}
+
+	if to := ctx.Query("to"); to != "" {
+		t, err := parseDateQuery(to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date")
+		}
+		// A plain date includes the whole day
+		if !strings.Contains(to, "T") {
+			t = t.Add(24*time.Hour - time.Nanosecond)
+		}
+		filters.To = t
+	}
+
+	if !filters.From.IsZero() && !filters.To.IsZero() && filters.From.After(filters.To) {
+		return nil, fmt.Errorf("from date must be before to date")
+	}
+
+	if minAmount := ctx.Query("minAmount"); minAmount != "" {
+		amount, err := decimal.NewFromString(minAmount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minAmount")
+		}
+		filters.MinAmount = decimal.NewNullDecimal(amount)
+	}
+
+	if maxAmount := ctx.Query("maxAmount"); maxAmount != "" {
+		amount, err := decimal.NewFromString(maxAmount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxAmount")
+		}
+		filters.MaxAmount = decimal.NewNullDecimal(amount)
+	}
+
+	if filters.MinAmount.Valid && filters.MaxAmount.Valid && filters.MinAmount.Decimal.GreaterThan(filters.MaxAmount.Decimal) {
+		return nil, fmt.Errorf("minAmount must not be greater than maxAmount")
+	}
+
+	if cursor := ctx.Query("cursor"); cursor != "" {
+		c, err := DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		filters.Cursor = c
+	}
+
+	return filters, nil
+}
+
+// ParseStatsQuery parses the time bucket, group-by dimensions and date range of the stats endpoints.
+// The range defaults to the last 30 days, or the last 12 weeks or months for larger buckets
+func ParseStatsQuery(ctx *gin.Context) (*types.StatsQuery, error) {
+	query := &types.StatsQuery{
+		Bucket: ctx.Query("bucket"),
+		To:     time.Now(),
+	}
+
+	switch query.Bucket {
+	case "", "day", "week", "month":
+	default:
+		return nil, fmt.Errorf("invalid bucket")
+	}
+
+	// Multiple dimensions are comma separated e.g ?groupBy=token,network
+	if groupBy := ctx.Query("groupBy"); groupBy != "" {
+		for _, dimension := range strings.Split(groupBy, ",") {
+			dimension = strings.TrimSpace(dimension)
+			switch dimension {
+			case "token", "network", "currency", "status":
+				query.GroupBy = append(query.GroupBy, dimension)
+			default:
+				return nil, fmt.Errorf("invalid groupBy dimension: %s", dimension)
+			}
+		}
+	}
+
+	if to := ctx.Query("to"); to != "" {
+		t, err := parseDateQuery(to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date")
+		}
+		// A plain date includes the whole day
+		if !strings.Contains(to, "T") {
+			t = t.Add(24*time.Hour - time.Nanosecond)
+		}
+		query.To = t
+	}
+
+	switch query.Bucket {
+	case "week":
+		query.From = query.To.AddDate(0, 0, -12*7)
+	case "month":
+		query.From = query.To.AddDate(0, -12, 0)
+	default:
+		query.From = query.To.AddDate(0, 0, -30)
+	}
+
+	if from := ctx.Query("from"); from != "" {
+		t, err := parseDateQuery(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date")
+		}
+		query.From = t
+	}
+
+	if query.From.After(query.To) {
+		return nil, fmt.Errorf("from date must be before to date")
+	}
+
+	return query, nil
+}
+
 // IsURL checks if a string is a valid URL
 func IsURL(s string) bool {
 	_, err := url.ParseRequestURI(s)
 	return err == nil
 }
+
+// ExportWriter streams export rows to the client as CSV or NDJSON
+type ExportWriter struct {
+	ctx     *gin.Context
+	format  string
+	columns []string
+	csv     *csv.Writer
+}
+
+// NewExportWriter writes the response headers of an export and returns a writer for its rows.
+// Supported formats are "csv" and "ndjson"
+func NewExportWriter(ctx *gin.Context, format string, filename string, columns []string) (*ExportWriter, error) {
+	w := &ExportWriter{
+		ctx:     ctx,
+		format:  format,
+		columns: columns,
+	}
+
+	switch format {
+	case "csv":
+		ctx.Header("Content-Type", "text/csv")
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", filename))
+		ctx.Status(http.StatusOK)
+
+		w.csv = csv.NewWriter(ctx.Writer)
+		if err := w.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	case "ndjson":
+		ctx.Header("Content-Type", "application/x-ndjson")
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.ndjson", filename))
+		ctx.Status(http.StatusOK)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	return w, nil
+}
+
+// WriteRow writes a single row with values in the same order as the columns
+func (w *ExportWriter) WriteRow(values []interface{}) error {
+	if w.csv != nil {
+		record := make([]string, len(values))
+		for i, value := range values {
+			switch v := value.(type) {
+			case []string:
+				record[i] = strings.Join(v, ";")
+			case time.Time:
+				record[i] = v.Format(time.RFC3339)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		return w.csv.Write(record)
+	}
+
+	row := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		row[w.columns[i]] = value
+	}
+
+	line, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.ctx.Writer.Write(append(line, '\n'))
+	return err
+}
+
+// Flush sends the rows written so far to the client
+func (w *ExportWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	w.ctx.Writer.Flush()
+	return nil
+}
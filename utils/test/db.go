@@ -233,6 +233,7 @@ func CreateTestLockPaymentOrder(overrides map[string]interface{}) (*ent.LockPaym
 		SetGatewayID(payload["gateway_id"].(string)).
 		SetAmount(decimal.NewFromFloat(payload["amount"].(float64))).
 		SetRate(decimal.NewFromFloat(payload["rate"].(float64))).
+		SetAmountFulfilled(decimal.Zero).
 		SetStatus(lockpaymentorder.Status(payload["status"].(string))).
 		SetOrderPercent(decimal.NewFromFloat(100.0)).
 		SetBlockNumber(int64(payload["block_number"].(int))).
@@ -344,6 +345,7 @@ func CreateTestLockOrderFulfillment(overrides map[string]interface{}) (*ent.Lock
 	// Default payload
 	payload := map[string]interface{}{
 		"tx_id":             "0x123...",
+		"amount":            nil,
 		"validation_status": "pending",
 		"validation_errors": []string{},
 		"orderId":           nil,
@@ -360,13 +362,35 @@ func CreateTestLockOrderFulfillment(overrides map[string]interface{}) (*ent.Lock
 		payload["orderId"] = order.ID.String()
 	}
 
+	// Fulfillments cover the whole order unless an amount is given
+	order, err := db.Client.LockPaymentOrder.Get(context.Background(), payload["orderId"].(uuid.UUID))
+	if err != nil {
+		return nil, err
+	}
+
+	amount := order.Amount.Mul(order.Rate)
+	if payload["amount"] != nil {
+		amount = decimal.NewFromFloat(payload["amount"].(float64))
+	}
+
 	// Create LockOrderFulfillment
 	fulfillment, err := db.Client.LockOrderFulfillment.
 		Create().
 		SetTxID(payload["tx_id"].(string)).
-		SetOrderID(payload["orderId"].(uuid.UUID)).
+		SetAmount(amount).
+		SetOrderID(order.ID).
 		SetValidationStatus(lockorderfulfillment.ValidationStatus(payload["validation_status"].(string))).
 		Save(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// Validated fulfillments count towards the amount paid out for the order
+	if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess {
+		_, err = order.Update().
+			AddAmountFulfilled(amount).
+			Save(context.Background())
+	}
 
 	return fulfillment, err
 }
@@ -492,6 +516,8 @@ func AddProviderOrderTokenToProvider(overrides map[string]interface{}) (*ent.Pro
 		"floating_conversion_rate": decimal.NewFromFloat(1.0),
 		"max_order_amount":         decimal.NewFromFloat(1.0),
 		"min_order_amount":         decimal.NewFromFloat(1.0),
+		"rate_slippage":            decimal.NewFromFloat(0.5),
+		"rate_slippage_type":       "absolute",
 		"tokenSymbol":              "",
 		"provider":                 nil,
 		"addresses": []map[string]string{
@@ -534,6 +560,8 @@ func AddProviderOrderTokenToProvider(overrides map[string]interface{}) (*ent.Pro
 		SetConversionRateType(providerordertoken.ConversionRateType(payload["conversion_rate_type"].(string))).
 		SetFixedConversionRate(payload["fixed_conversion_rate"].(decimal.Decimal)).
 		SetFloatingConversionRate(payload["floating_conversion_rate"].(decimal.Decimal)).
+		SetRateSlippage(payload["rate_slippage"].(decimal.Decimal)).
+		SetRateSlippageType(providerordertoken.RateSlippageType(payload["rate_slippage_type"].(string))).
 		SetAddresses(addresses).
 		Save(context.Background())
 
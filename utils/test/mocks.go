@@ -24,6 +24,16 @@ func (m *MockOrderService) RefundOrder(ctx context.Context, client types.RPCClie
 	return nil
 }
 
+// RevertOrder mocks the RevertOrder method
+func (m *MockOrderService) RevertOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
+	return nil
+}
+
+// RevertBatch mocks the RevertBatch method
+func (m *MockOrderService) RevertBatch(ctx context.Context, client types.RPCClient, batchID uuid.UUID) error {
+	return nil
+}
+
 // SettleOrder mocks the SettleOrder method
 func (m *MockOrderService) SettleOrder(ctx context.Context, client types.RPCClient, orderID uuid.UUID) error {
 	return nil
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"regexp"
@@ -13,18 +14,31 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/anaskhan96/base58check"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	institutionEnt "github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorderbatch"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
+	tokenEnt "github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
 	tokenUtils "github.com/paycrest/aggregator/utils/token"
+	"github.com/robfig/cron/v3"
 	"github.com/shopspring/decimal"
 )
 
@@ -170,6 +184,486 @@ func Median(data []decimal.Decimal) decimal.Decimal {
 	return result
 }
 
+// Percentile returns the p-th percentile (0-100) of a float slice, interpolating between the closest ranks
+func Percentile(data []float64, p float64) float64 {
+	l := len(data)
+	if l == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, l)
+	copy(sorted, data)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(l-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// StatsAggregator groups orders into time buckets and the group-by dimensions of a stats query
+type StatsAggregator struct {
+	query           *types.StatsQuery
+	entries         map[string]*types.StatsSeriesEntry
+	settlementTimes map[string][]float64
+	allSettlements  []float64
+}
+
+// NewStatsAggregator returns an empty aggregator for the given stats query
+func NewStatsAggregator(query *types.StatsQuery) *StatsAggregator {
+	return &StatsAggregator{
+		query:           query,
+		entries:         make(map[string]*types.StatsSeriesEntry),
+		settlementTimes: make(map[string][]float64),
+	}
+}
+
+// bucketStart truncates a timestamp to the start of its day, week (Monday) or month in UTC
+func bucketStart(t time.Time, bucket string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch bucket {
+	case "week":
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// Add records a group of orders in its bucket and group, or the settlement time of a single settled order.
+// A zero settlement time means the record has no settlement time
+func (a *StatsAggregator) Add(record types.StatsRecord) {
+	entry := types.StatsSeriesEntry{}
+	if a.query.Bucket != "" {
+		entry.Period = bucketStart(record.CreatedAt, a.query.Bucket).Format("2006-01-02")
+	}
+
+	for _, dimension := range a.query.GroupBy {
+		switch dimension {
+		case "token":
+			entry.Token = record.Token
+		case "network":
+			entry.Network = record.Network
+		case "currency":
+			entry.Currency = record.Currency
+		case "status":
+			entry.Status = record.Status
+		}
+	}
+
+	key := strings.Join([]string{entry.Period, entry.Token, entry.Network, entry.Currency, entry.Status}, "|")
+	existing, ok := a.entries[key]
+	if !ok {
+		existing = &entry
+		a.entries[key] = existing
+	}
+
+	existing.TotalOrders += record.Orders
+	existing.CryptoVolume = existing.CryptoVolume.Add(record.Amount)
+	existing.FiatVolume = existing.FiatVolume.Add(record.FiatAmount)
+	existing.FeeEarnings = existing.FeeEarnings.Add(record.Fee)
+
+	if record.SettlementTime > 0 {
+		a.settlementTimes[key] = append(a.settlementTimes[key], record.SettlementTime.Seconds())
+		a.allSettlements = append(a.allSettlements, record.SettlementTime.Seconds())
+	}
+}
+
+// settlementTimeStats summarizes a list of settlement times in seconds
+func settlementTimeStats(seconds []float64) *types.SettlementTimeStats {
+	if len(seconds) == 0 {
+		return nil
+	}
+
+	return &types.SettlementTimeStats{
+		SettledOrders: len(seconds),
+		MedianSeconds: Percentile(seconds, 50),
+		P95Seconds:    Percentile(seconds, 95),
+	}
+}
+
+// Series returns the aggregated entries ordered by period and then by group
+func (a *StatsAggregator) Series() []types.StatsSeriesEntry {
+	keys := make([]string, 0, len(a.entries))
+	for key := range a.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	series := make([]types.StatsSeriesEntry, 0, len(keys))
+	for _, key := range keys {
+		entry := *a.entries[key]
+		entry.SettlementTime = settlementTimeStats(a.settlementTimes[key])
+		series = append(series, entry)
+	}
+
+	return series
+}
+
+// SettlementTime returns the median and p95 settlement time across all aggregated orders
+func (a *StatsAggregator) SettlementTime() *types.SettlementTimeStats {
+	stats := settlementTimeStats(a.allSettlements)
+	if stats == nil {
+		return &types.SettlementTimeStats{}
+	}
+	return stats
+}
+
+// StatsDay groups a stats query by the UTC day of a timestamp column and selects the day as "day"
+func StatsDay(column string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		expr := fmt.Sprintf("DATE(%s)", s.C(column))
+		if s.Dialect() == dialect.Postgres {
+			expr = fmt.Sprintf("DATE(%s AT TIME ZONE 'UTC')", s.C(column))
+		}
+		s.GroupBy(expr)
+		return sql.As(expr, "day")
+	}
+}
+
+// StatsSettledSum sums the product of numeric columns over the settled orders of a stats group and selects it as name,
+// so volumes only count settled orders like the stats totals
+func StatsSettledSum(name string, settledStatus string, columns ...string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		qualified := make([]string, len(columns))
+		for i, column := range columns {
+			qualified[i] = s.C(column)
+		}
+		return sql.As(fmt.Sprintf("COALESCE(SUM(CASE WHEN %s = '%s' THEN %s ELSE 0 END), 0)",
+			s.C("status"), settledStatus, strings.Join(qualified, " * ")), name)
+	}
+}
+
+// ParseStatsDay parses a day selected by StatsDay, which drivers return as a date or a timestamp
+func ParseStatsDay(day string) (time.Time, error) {
+	if len(day) < 10 {
+		return time.Time{}, fmt.Errorf("invalid stats day: %s", day)
+	}
+	return time.Parse("2006-01-02", day[:10])
+}
+
+// GetTokensByID returns every token with its network keyed by token ID
+func GetTokensByID(ctx context.Context) (map[int]*ent.Token, error) {
+	tokens, err := storage.Client.Token.
+		Query().
+		WithNetwork().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensByID := make(map[int]*ent.Token, len(tokens))
+	for _, token := range tokens {
+		tokensByID[token.ID] = token
+	}
+
+	return tokensByID, nil
+}
+
+// SettlementTime returns how long an order took to settle based on its transaction logs.
+// It is measured from the crypto deposit (or order creation) to the settlement, and is zero for unsettled orders
+func SettlementTime(createdAt time.Time, logs []*ent.TransactionLog) time.Duration {
+	var start, settledAt time.Time
+	for _, log := range logs {
+		switch log.Status {
+		case transactionlog.StatusCryptoDeposited:
+			start = log.CreatedAt
+		case transactionlog.StatusOrderCreated:
+			if start.IsZero() {
+				start = log.CreatedAt
+			}
+		case transactionlog.StatusOrderSettled:
+			settledAt = log.CreatedAt
+		}
+	}
+
+	if settledAt.IsZero() {
+		return 0
+	}
+	if start.IsZero() {
+		start = createdAt
+	}
+
+	return settledAt.Sub(start)
+}
+
+// GetInstitutionCurrencies returns the fiat currency code of every institution keyed by institution code
+func GetInstitutionCurrencies(ctx context.Context) (map[string]string, error) {
+	institutions, err := storage.Client.Institution.
+		Query().
+		WithFiatCurrency().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make(map[string]string, len(institutions))
+	for _, institution := range institutions {
+		if institution.Edges.FiatCurrency != nil {
+			currencies[institution.Code] = institution.Edges.FiatCurrency.Code
+		}
+	}
+
+	return currencies, nil
+}
+
+// SelectSenderFeeTier returns the fee tier that applies to a sender's rolling 30-day volume, or nil if none does.
+// Promotional tiers running at the given time take precedence over standing tiers, and among
+// the eligible tiers the one with the highest volume threshold wins
+func SelectSenderFeeTier(tiers []*ent.SenderFeeTier, volume decimal.Decimal, at time.Time) *ent.SenderFeeTier {
+	var selected *ent.SenderFeeTier
+	selectedIsPromo := false
+
+	for _, tier := range tiers {
+		if tier.MinVolume.GreaterThan(volume) {
+			continue
+		}
+
+		isPromo := !tier.StartsAt.IsZero() || !tier.EndsAt.IsZero()
+		if isPromo && ((!tier.StartsAt.IsZero() && at.Before(tier.StartsAt)) || (!tier.EndsAt.IsZero() && !at.Before(tier.EndsAt))) {
+			continue
+		}
+
+		if selected != nil {
+			if selectedIsPromo && !isPromo {
+				continue
+			}
+			if selectedIsPromo == isPromo && !tier.MinVolume.GreaterThan(selected.MinVolume) {
+				continue
+			}
+		}
+
+		selected = tier
+		selectedIsPromo = isPromo
+	}
+
+	return selected
+}
+
+// GetSenderFeeTier returns the fee tier of a sender token that applies to the sender's rolling 30-day volume
+// in the token across networks. It returns nil when no tier applies and the token's flat fee percent is charged
+func GetSenderFeeTier(ctx context.Context, sender *ent.SenderProfile, senderOrderToken *ent.SenderOrderToken, symbol string) (*ent.SenderFeeTier, error) {
+	tiers, err := senderOrderToken.QueryFeeTiers().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fee tiers: %w", err)
+	}
+
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+
+	var v []struct {
+		Sum decimal.NullDecimal
+	}
+	err = storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+			paymentorder.IsSandboxEQ(false),
+			paymentorder.HasTokenWith(tokenEnt.SymbolEQ(symbol)),
+			paymentorder.StatusIn(paymentorder.StatusPending, paymentorder.StatusSettled),
+			paymentorder.CreatedAtGTE(time.Now().AddDate(0, 0, -30)),
+		).
+		Aggregate(
+			ent.Sum(paymentorder.FieldAmount),
+		).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch 30-day volume: %w", err)
+	}
+
+	// The sum is null when the sender has no orders in the window
+	return SelectSenderFeeTier(tiers, v[0].Sum.Decimal, time.Now()), nil
+}
+
+// SenderFee computes the sender fee of an amount at the given percent, kept within the fee caps of the tier if any.
+// A zero cap means the tier has no cap on that side
+func SenderFee(amount, feePercent decimal.Decimal, tier *ent.SenderFeeTier) decimal.Decimal {
+	fee := feePercent.Mul(amount).Div(decimal.NewFromInt(100)).Round(4)
+	if tier == nil {
+		return fee
+	}
+
+	if tier.MinFee.GreaterThan(decimal.Zero) && fee.LessThan(tier.MinFee) {
+		fee = tier.MinFee
+	}
+	if tier.MaxFee.GreaterThan(decimal.Zero) && fee.GreaterThan(tier.MaxFee) {
+		fee = tier.MaxFee
+	}
+
+	return fee
+}
+
+// SplitOrderAmount returns the token amount paid to each split recipient of an order. A split is paid either a percent
+// of the order amount or a fixed fiat amount at the order rate, and the splits must leave a share for the main recipient
+func SplitOrderAmount(amount, rate decimal.Decimal, splits []types.PaymentOrderSplitPayload) ([]decimal.Decimal, error) {
+	amounts := make([]decimal.Decimal, len(splits))
+	total := decimal.Zero
+
+	for i, split := range splits {
+		if split.Percent.IsNegative() || split.FiatAmount.IsNegative() || split.Percent.IsPositive() == split.FiatAmount.IsPositive() {
+			return nil, fmt.Errorf("split %d must have either a percent or a fiat amount", i+1)
+		}
+
+		if split.Percent.IsPositive() {
+			amounts[i] = amount.Mul(split.Percent).Div(decimal.NewFromInt(100))
+		} else {
+			if !rate.IsPositive() {
+				return nil, fmt.Errorf("rate is required for fiat amount splits")
+			}
+			amounts[i] = split.FiatAmount.Div(rate)
+		}
+
+		total = total.Add(amounts[i])
+	}
+
+	if !total.LessThan(amount) {
+		return nil, fmt.Errorf("splits must leave a share of the amount for the recipient")
+	}
+
+	return amounts, nil
+}
+
+// GetPaymentOrderRecipientSettlements returns the share and settlement status of each recipient of a split payment order,
+// starting with the main recipient. It returns nil for orders with a single recipient
+func GetPaymentOrderRecipientSettlements(ctx context.Context, paymentOrder *ent.PaymentOrder, recipient *ent.PaymentOrderRecipient) ([]types.PaymentOrderRecipientSettlement, error) {
+	splits, err := paymentOrder.QuerySplits().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(splits) == 0 {
+		return nil, nil
+	}
+
+	var lockOrders []*ent.LockPaymentOrder
+	if paymentOrder.GatewayID != "" {
+		lockOrders, err = storage.Client.LockPaymentOrder.
+			Query().
+			Where(lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The main recipient is paid what is left after the splits
+	settlements := make([]types.PaymentOrderRecipientSettlement, 1, len(splits)+1)
+	settlements[0] = types.PaymentOrderRecipientSettlement{
+		Institution:       recipient.Institution,
+		AccountIdentifier: recipient.AccountIdentifier,
+		AccountName:       recipient.AccountName,
+		Amount:            paymentOrder.Amount,
+		Percent:           decimal.NewFromInt(100),
+	}
+
+	for _, split := range splits {
+		settlements[0].Amount = settlements[0].Amount.Sub(split.Amount)
+		settlements[0].Percent = settlements[0].Percent.Sub(split.Percent)
+		settlements = append(settlements, types.PaymentOrderRecipientSettlement{
+			Institution:       split.Institution,
+			AccountIdentifier: split.AccountIdentifier,
+			AccountName:       split.AccountName,
+			Amount:            split.Amount,
+			Percent:           split.Percent,
+		})
+	}
+
+	for i := range settlements {
+		settlements[i].Status = recipientSettlementStatus(lockOrders, settlements[i].Institution, settlements[i].AccountIdentifier)
+	}
+
+	return settlements, nil
+}
+
+// recipientSettlementStatus aggregates the status of the lock orders paying out to a recipient of a split payment order
+func recipientSettlementStatus(lockOrders []*ent.LockPaymentOrder, institution, accountIdentifier string) lockpaymentorder.Status {
+	status := lockpaymentorder.StatusPending
+	total, settled := 0, 0
+
+	for _, order := range lockOrders {
+		if order.Institution != institution || order.AccountIdentifier != accountIdentifier {
+			continue
+		}
+		total++
+
+		switch order.Status {
+		case lockpaymentorder.StatusRefunded:
+			return lockpaymentorder.StatusRefunded
+		case lockpaymentorder.StatusCancelled:
+			status = lockpaymentorder.StatusCancelled
+		case lockpaymentorder.StatusSettled:
+			settled++
+		case lockpaymentorder.StatusPending:
+		default:
+			if status != lockpaymentorder.StatusCancelled {
+				status = lockpaymentorder.StatusProcessing
+			}
+		}
+	}
+
+	if total > 0 && settled == total {
+		return lockpaymentorder.StatusSettled
+	} else if settled > 0 && status == lockpaymentorder.StatusPending {
+		return lockpaymentorder.StatusProcessing
+	}
+
+	return status
+}
+
+// LockOrderFiatAmount returns the fiat amount a provider pays out to fulfill a lock order
+func LockOrderFiatAmount(order *ent.LockPaymentOrder) decimal.Decimal {
+	// Truncate to the cent so a payout rounded down by the bank still covers the order
+	return order.Amount.Mul(order.Rate).Truncate(2)
+}
+
+// UnfulfilledAmount returns the fiat amount of a lock order not yet covered by validated fulfillments
+func UnfulfilledAmount(order *ent.LockPaymentOrder) decimal.Decimal {
+	return decimal.Max(LockOrderFiatAmount(order).Sub(order.AmountFulfilled), decimal.Zero)
+}
+
+// ClaimableFulfillmentAmount returns the fiat amount of a lock order not yet covered by validated fulfillments
+// or claimed by fulfillments awaiting validation
+func ClaimableFulfillmentAmount(ctx context.Context, order *ent.LockPaymentOrder) (decimal.Decimal, error) {
+	pendingFulfillments, err := storage.Client.LockOrderFulfillment.
+		Query().
+		Where(
+			lockorderfulfillment.HasOrderWith(lockpaymentorder.IDEQ(order.ID)),
+			lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to fetch pending fulfillments: %w", err)
+	}
+
+	claimableAmount := UnfulfilledAmount(order)
+	for _, fulfillment := range pendingFulfillments {
+		claimableAmount = claimableAmount.Sub(fulfillment.Amount)
+	}
+
+	return decimal.Max(claimableAmount, decimal.Zero), nil
+}
+
+// IsLockOrderFulfilled reports whether the validated fulfillments of a lock order cover its fiat amount
+func IsLockOrderFulfilled(order *ent.LockPaymentOrder) bool {
+	return order.AmountFulfilled.GreaterThanOrEqual(LockOrderFiatAmount(order))
+}
+
+// BatchRefundAmount returns the amount left in the receive address of a payment order batch to return to the sender,
+// less the network fee. Funded batches only have the excess over the batch amount left
+func BatchRefundAmount(batch *ent.PaymentOrderBatch) decimal.Decimal {
+	refundAmount := batch.AmountPaid.Sub(batch.AmountReturned).Sub(batch.Edges.Token.Edges.Network.Fee)
+	if batch.Status == paymentorderbatch.StatusFunded {
+		refundAmount = refundAmount.Sub(batch.Amount)
+	}
+	return refundAmount
+}
+
 // AbsPercentageDeviation returns the absolute percentage deviation between two values
 func AbsPercentageDeviation(trueValue, measuredValue decimal.Decimal) decimal.Decimal {
 	if trueValue.IsZero() {
@@ -182,18 +676,6 @@ func AbsPercentageDeviation(trueValue, measuredValue decimal.Decimal) decimal.De
 
 // SendPaymentOrderWebhook notifies a sender when the status of a payment order changes
 func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder) error {
-	var err error
-
-	profile := paymentOrder.Edges.SenderProfile
-	if profile == nil {
-		return nil
-	}
-
-	// If webhook URL is empty, return
-	if profile.WebhookURL == "" {
-		return nil
-	}
-
 	// Determine the event
 	var event string
 
@@ -210,6 +692,24 @@ func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder
 		return nil
 	}
 
+	return SendPaymentOrderEventWebhook(ctx, paymentOrder, event)
+}
+
+// SendPaymentOrderEventWebhook notifies a sender of the given payment order event,
+// e.g payment_order.underpaid which is not tied to a status change
+func SendPaymentOrderEventWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder, event string) error {
+	var err error
+
+	profile := paymentOrder.Edges.SenderProfile
+	if profile == nil {
+		return nil
+	}
+
+	// If webhook URL is empty, return
+	if profile.WebhookURL == "" {
+		return nil
+	}
+
 	// Fetch the recipient
 	recipient, err := paymentOrder.QueryRecipient().Only(ctx)
 	if err != nil {
@@ -234,6 +734,11 @@ func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder
 		return err
 	}
 
+	recipients, err := GetPaymentOrderRecipientSettlements(ctx, paymentOrder, recipient)
+	if err != nil {
+		return err
+	}
+
 	// Create the payload
 	payloadStruct := types.PaymentOrderWebhookPayload{
 		Event: event,
@@ -244,6 +749,7 @@ func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder
 			AmountReturned: paymentOrder.AmountReturned,
 			PercentSettled: paymentOrder.PercentSettled,
 			SenderFee:      paymentOrder.SenderFee,
+			FeeTier:        paymentOrder.FeeTier,
 			NetworkFee:     paymentOrder.NetworkFee,
 			Rate:           paymentOrder.Rate,
 			Network:        token.Edges.Network.Identifier,
@@ -257,20 +763,38 @@ func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder
 				ProviderID:        recipient.ProviderID,
 				Memo:              recipient.Memo,
 			},
-			FromAddress:   paymentOrder.FromAddress,
-			ReturnAddress: paymentOrder.ReturnAddress,
-			Reference:     paymentOrder.Reference,
-			UpdatedAt:     paymentOrder.UpdatedAt,
-			CreatedAt:     paymentOrder.CreatedAt,
-			TxHash:        paymentOrder.TxHash,
-			Status:        paymentOrder.Status,
+			Recipients:     recipients,
+			FromAddress:    paymentOrder.FromAddress,
+			ReturnAddress:  paymentOrder.ReturnAddress,
+			Reference:      paymentOrder.Reference,
+			ReceiveAddress: paymentOrder.ReceiveAddressText,
+			UpdatedAt:      paymentOrder.UpdatedAt,
+			CreatedAt:      paymentOrder.CreatedAt,
+			TxHash:         paymentOrder.TxHash,
+			Status:         paymentOrder.Status,
 		},
 	}
 
-	payload := StructToMap(payloadStruct)
+	return sendSenderWebhook(ctx, profile, StructToMap(payloadStruct))
+}
+
+// SendDisputeWebhook notifies a sender of a dispute event on one of its payment orders
+func SendDisputeWebhook(ctx context.Context, profile *ent.SenderProfile, event string, dispute types.DisputeResponse) error {
+	if profile == nil || profile.WebhookURL == "" {
+		return nil
+	}
+
+	return sendSenderWebhook(ctx, profile, StructToMap(types.DisputeWebhookPayload{
+		Event: event,
+		Data:  dispute,
+	}))
+}
 
+// sendSenderWebhook signs a webhook payload with the sender's primary API key and sends it to the sender's webhook URL.
+// Failed webhooks are logged for retry
+func sendSenderWebhook(ctx context.Context, profile *ent.SenderProfile, payload map[string]interface{}) error {
 	// Compute HMAC signature
-	apiKey, err := profile.QueryAPIKey().Only(ctx)
+	apiKey, err := profile.QueryAPIKeys().Where(apikey.IsPrimary(true)).Only(ctx)
 	if err != nil {
 		return err
 	}
@@ -488,15 +1012,23 @@ func IsBase64(s string) bool {
 
 // GetTokenRateFromQueue gets the rate of a token from the priority queue
 func GetTokenRateFromQueue(tokenSymbol string, orderAmount decimal.Decimal, fiatCurrency string, marketRate decimal.Decimal) (decimal.Decimal, error) {
+	rate, _, err := GetTokenRateQuoteFromQueue(tokenSymbol, orderAmount, fiatCurrency, marketRate)
+	return rate, err
+}
+
+// GetTokenRateQuoteFromQueue gets the rate of a token from the priority queue and the provider offering it.
+// The provider ID is empty when no provider matches and the market rate is returned
+func GetTokenRateQuoteFromQueue(tokenSymbol string, orderAmount decimal.Decimal, fiatCurrency string, marketRate decimal.Decimal) (decimal.Decimal, string, error) {
 	ctx := context.Background()
 
 	// Get rate from priority queue
 	keys, _, err := storage.RedisClient.Scan(ctx, uint64(0), "bucket_"+fiatCurrency+"_*_*", 100).Result()
 	if err != nil {
-		return decimal.Decimal{}, err
+		return decimal.Decimal{}, "", err
 	}
 
 	rateResponse := marketRate
+	providerID := ""
 	highestMaxAmount := decimal.NewFromInt(0)
 
 	// Scan through the buckets to find a suitable rate
@@ -512,23 +1044,308 @@ func GetTokenRateFromQueue(tokenSymbol string, orderAmount decimal.Decimal, fiat
 				break
 			}
 
-			if strings.Split(providerData, ":")[1] == tokenSymbol {
-				// Get fiat equivalent of the token amount
-				rate, _ := decimal.NewFromString(strings.Split(providerData, ":")[2])
-				fiatAmount := orderAmount.Mul(rate)
-
-				// Check if fiat amount is within the bucket range and set the rate
-				if fiatAmount.GreaterThanOrEqual(minAmount) && fiatAmount.LessThanOrEqual(maxAmount) {
-					rateResponse = rate
-					break
-				} else if maxAmount.GreaterThan(highestMaxAmount) {
-					// Get the highest max amount
-					highestMaxAmount = maxAmount
-					rateResponse = rate
-				}
+			parts := strings.Split(providerData, ":")
+			if len(parts) != 7 {
+				continue
+			}
+
+			// Skip entry if token doesn't match
+			if parts[1] != tokenSymbol {
+				continue
+			}
+
+			// Skip entry if order amount is not within provider's min and max order amount
+			minOrderAmount, err := decimal.NewFromString(parts[3])
+			if err != nil {
+				continue
+			}
+
+			maxOrderAmount, err := decimal.NewFromString(parts[4])
+			if err != nil {
+				continue
 			}
+
+			if orderAmount.LessThan(minOrderAmount) || orderAmount.GreaterThan(maxOrderAmount) {
+				continue
+			}
+
+			// Get fiat equivalent of the token amount at the provider's rate for the amount
+			rate, _, err := QueueEntryRate(parts, orderAmount)
+			if err != nil {
+				continue
+			}
+			fiatAmount := orderAmount.Mul(rate)
+
+			// Check if fiat amount is within the bucket range and set the rate
+			if fiatAmount.GreaterThanOrEqual(minAmount) && fiatAmount.LessThanOrEqual(maxAmount) {
+				rateResponse = rate
+				providerID = parts[0]
+				break
+			} else if maxAmount.GreaterThan(highestMaxAmount) {
+				// Get the highest max amount
+				highestMaxAmount = maxAmount
+				rateResponse = rate
+				providerID = parts[0]
+			}
+		}
+	}
+
+	return rateResponse, providerID, nil
+}
+
+// GetProviderRateFromQueue gets a provider's current rate and slippage for an order of a token from the priority queues.
+// found is false when the provider has no queue entry for the token that covers the order amount
+func GetProviderRateFromQueue(providerID string, tokenSymbol string, orderAmount decimal.Decimal, fiatCurrency string) (rate decimal.Decimal, slippage decimal.Decimal, found bool, err error) {
+	ctx := context.Background()
+
+	keys, _, err := storage.RedisClient.Scan(ctx, uint64(0), "bucket_"+fiatCurrency+"_*_*", 100).Result()
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, false, err
+	}
+
+	for _, key := range keys {
+		entries, err := storage.RedisClient.LRange(ctx, key, 0, -1).Result()
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, false, err
 		}
+
+		for _, providerData := range entries {
+			parts := strings.Split(providerData, ":")
+			if len(parts) != 7 || parts[0] != providerID || parts[1] != tokenSymbol {
+				continue
+			}
+
+			minOrderAmount, err := decimal.NewFromString(parts[3])
+			if err != nil {
+				continue
+			}
+
+			maxOrderAmount, err := decimal.NewFromString(parts[4])
+			if err != nil {
+				continue
+			}
+
+			if orderAmount.LessThan(minOrderAmount) || orderAmount.GreaterThan(maxOrderAmount) {
+				continue
+			}
+
+			rate, slippage, err := QueueEntryRate(parts, orderAmount)
+			if err != nil {
+				continue
+			}
+
+			return rate, slippage, true, nil
+		}
+	}
+
+	return decimal.Decimal{}, decimal.Decimal{}, false, nil
+}
+
+// teamRolePermissions maps each team member role to the permissions it grants
+var teamRolePermissions = map[teammember.Role][]types.TeamPermission{
+	teammember.RoleAdmin: {
+		types.PermissionOrdersRead, types.PermissionOrdersWrite, types.PermissionOrdersExport,
+		types.PermissionStatsRead, types.PermissionSettingsRead, types.PermissionSettingsWrite,
+		types.PermissionKeysManage, types.PermissionTeamManage,
+	},
+	teammember.RoleDeveloper: {
+		types.PermissionOrdersRead, types.PermissionOrdersWrite, types.PermissionStatsRead,
+		types.PermissionSettingsRead, types.PermissionSettingsWrite, types.PermissionKeysManage,
+	},
+	teammember.RoleFinance: {
+		types.PermissionOrdersRead, types.PermissionOrdersExport, types.PermissionStatsRead,
+		types.PermissionSettingsRead,
+	},
+	teammember.RoleReadOnly: {
+		types.PermissionOrdersRead, types.PermissionStatsRead, types.PermissionSettingsRead,
+	},
+}
+
+// QueueEntryRate returns the rate and slippage of a bucket queue entry, split from the format
+// "providerID:token:rate:minAmount:maxAmount:slippage:rateBands", for an order of the given token amount.
+// Rate bands are comma-separated "minAmount/maxAmount/rate/slippage" values, and the entry's own rate
+// and slippage apply to amounts outside the bands
+func QueueEntryRate(parts []string, amount decimal.Decimal) (decimal.Decimal, decimal.Decimal, error) {
+	rate, slippage := parts[2], parts[5]
+
+	if parts[6] != "" {
+		for _, band := range strings.Split(parts[6], ",") {
+			bandParts := strings.Split(band, "/")
+			if len(bandParts) != 4 {
+				return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("invalid rate band format: %s", band)
+			}
+
+			minAmount, err := decimal.NewFromString(bandParts[0])
+			if err != nil {
+				return decimal.Decimal{}, decimal.Decimal{}, err
+			}
+			maxAmount, err := decimal.NewFromString(bandParts[1])
+			if err != nil {
+				return decimal.Decimal{}, decimal.Decimal{}, err
+			}
+
+			if amount.GreaterThanOrEqual(minAmount) && amount.LessThanOrEqual(maxAmount) {
+				rate, slippage = bandParts[2], bandParts[3]
+				break
+			}
+		}
+	}
+
+	rateValue, err := decimal.NewFromString(rate)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	slippageValue, err := decimal.NewFromString(slippage)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+
+	return rateValue, slippageValue, nil
+}
+
+// HasTeamPermission checks if a team member role grants a permission.
+// The owner of a profile is granted every permission.
+func HasTeamPermission(role teammember.Role, permission types.TeamPermission) bool {
+	if role == teammember.RoleOwner {
+		return true
+	}
+
+	for _, p := range teamRolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyAccountName fetches the name on an institution account from the available provider nodes of its currency
+func VerifyAccountName(ctx context.Context, currencyCode string, payload types.VerifyAccountRequest) (string, error) {
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.HasCurrencyWith(
+				fiatcurrency.CodeEQ(currencyCode),
+			),
+			providerprofile.HostIdentifierNotNil(),
+			providerprofile.IsActiveEQ(true),
+			providerprofile.IsAvailableEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch providers: %w", err)
+	}
+
+	err = fmt.Errorf("no provider available for %s", currencyCode)
+	for _, provider := range providers {
+		var res fastshot.Response
+		res, err = fastshot.NewClient(provider.HostIdentifier).
+			Config().SetTimeout(30 * time.Second).
+			Build().POST("/verify_account").
+			Body().AsJSON(payload).
+			Send()
+		if err != nil {
+			continue
+		}
+
+		var data map[string]interface{}
+		data, err = ParseJSONResponse(res.RawResponse)
+		if err != nil {
+			continue
+		}
+
+		accountName, ok := data["data"].(string)
+		if !ok {
+			err = fmt.Errorf("unexpected response from provider %s: %v", provider.ID, data)
+			continue
+		}
+
+		return accountName, nil
+	}
+
+	return "", err
+}
+
+// NextScheduledRun returns the next run of a scheduled order after its last run, or the zero time when it has none left.
+// One-off orders run once at their start time and recurring orders follow a standard five-field cron expression in UTC
+func NextScheduledRun(cronExpression string, startAt, endAt, lastRunAt time.Time) (time.Time, error) {
+	if cronExpression == "" {
+		if lastRunAt.IsZero() {
+			return startAt, nil
+		}
+		return time.Time{}, nil
+	}
+
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	// The start time is itself a run when it matches the schedule
+	from := lastRunAt
+	if from.Before(startAt) {
+		from = startAt.Add(-time.Second)
+	}
+
+	next := schedule.Next(from.UTC())
+	if !endAt.IsZero() && next.After(endAt) {
+		return time.Time{}, nil
+	}
+
+	return next, nil
+}
+
+// IsProviderOpen checks if a time falls within a provider's operating hours in the provider's timezone.
+// Providers without operating hours are always open and providers are closed on their holidays.
+// Hours that close at or before they open run past midnight into the next day
+func IsProviderOpen(provider *ent.ProviderProfile, t time.Time) bool {
+	if len(provider.OperatingHours) == 0 {
+		return true
+	}
+
+	location, err := time.LoadLocation(provider.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := t.In(location)
+
+	if ContainsString(provider.Holidays, local.Format("2006-01-02")) {
+		return false
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	weekday := int(local.Weekday())
+
+	for _, hours := range provider.OperatingHours {
+		openTime, err := time.Parse("15:04", hours.Open)
+		if err != nil {
+			continue
+		}
+		closeTime, err := time.Parse("15:04", hours.Close)
+		if err != nil {
+			continue
+		}
+		openMinute := openTime.Hour()*60 + openTime.Minute()
+		closeMinute := closeTime.Hour()*60 + closeTime.Minute()
+
+		if openMinute < closeMinute {
+			if hours.Day == weekday && minute >= openMinute && minute < closeMinute {
+				return true
+			}
+		} else if (hours.Day == weekday && minute >= openMinute) || ((hours.Day+1)%7 == weekday && minute < closeMinute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsInstitution checks if a provider can pay out to an institution.
+// Providers that declare supported institutions only pay out to those, and never to their unsupported institutions
+func SupportsInstitution(provider *ent.ProviderProfile, institutionCode string) bool {
+	if ContainsString(provider.UnsupportedInstitutions, institutionCode) {
+		return false
 	}
 
-	return rateResponse, nil
+	return len(provider.SupportedInstitutions) == 0 || ContainsString(provider.SupportedInstitutions, institutionCode)
 }
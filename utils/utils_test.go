@@ -1,9 +1,20 @@
 package utils
 
 import (
+	"context"
 	"math/big"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
@@ -87,4 +98,349 @@ func TestUtils(t *testing.T) {
 		assert := assert.New(t)
 		assert.True(median.Equal(decimal.NewFromInt(2)), "Median calculation is incorrect")
 	})
+
+	t.Run("Percentile", func(t *testing.T) {
+		data := []float64{50, 10, 40, 20, 30}
+
+		assert.Equal(t, 30.0, Percentile(data, 50))
+		assert.InDelta(t, 48.0, Percentile(data, 95), 0.0001)
+		assert.Equal(t, 0.0, Percentile([]float64{}, 95))
+		assert.Equal(t, []float64{50, 10, 40, 20, 30}, data, "input should not be reordered")
+	})
+
+	t.Run("StatsAggregator", func(t *testing.T) {
+		aggregator := NewStatsAggregator(&types.StatsQuery{
+			Bucket:  "week",
+			GroupBy: []string{"token"},
+		})
+
+		// 2025-03-05 and 2025-03-09 fall in the week starting Monday 2025-03-03
+		aggregator.Add(types.StatsRecord{
+			CreatedAt:      time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC),
+			Token:          "USDT",
+			Network:        "base",
+			Orders:         1,
+			Amount:         decimal.NewFromInt(10),
+			Fee:            decimal.NewFromInt(1),
+			SettlementTime: 30 * time.Second,
+		})
+		aggregator.Add(types.StatsRecord{
+			CreatedAt:      time.Date(2025, 3, 9, 23, 0, 0, 0, time.UTC),
+			Token:          "USDT",
+			Network:        "tron-shasta",
+			Orders:         1,
+			Amount:         decimal.NewFromInt(5),
+			SettlementTime: 90 * time.Second,
+		})
+		aggregator.Add(types.StatsRecord{
+			CreatedAt: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+			Token:     "USDC",
+			Orders:    3,
+			Amount:    decimal.NewFromInt(7),
+		})
+
+		series := aggregator.Series()
+		assert.Len(t, series, 2)
+
+		assert.Equal(t, "2025-03-03", series[0].Period)
+		assert.Equal(t, "USDT", series[0].Token)
+		assert.Empty(t, series[0].Network)
+		assert.Equal(t, 2, series[0].TotalOrders)
+		assert.True(t, series[0].CryptoVolume.Equal(decimal.NewFromInt(15)))
+		assert.True(t, series[0].FeeEarnings.Equal(decimal.NewFromInt(1)))
+		assert.Equal(t, 60.0, series[0].SettlementTime.MedianSeconds)
+
+		assert.Equal(t, "2025-03-10", series[1].Period)
+		assert.Equal(t, "USDC", series[1].Token)
+		assert.Equal(t, 3, series[1].TotalOrders)
+		assert.Nil(t, series[1].SettlementTime)
+
+		settlementTime := aggregator.SettlementTime()
+		assert.Equal(t, 2, settlementTime.SettledOrders)
+		assert.InDelta(t, 87.0, settlementTime.P95Seconds, 0.0001)
+	})
+
+	t.Run("SelectSenderFeeTier", func(t *testing.T) {
+		now := time.Now()
+		standard := &ent.SenderFeeTier{Name: "standard", FeePercent: decimal.NewFromInt(1)}
+		volume := &ent.SenderFeeTier{Name: "volume", MinVolume: decimal.NewFromInt(10000), FeePercent: decimal.NewFromFloat(0.5)}
+		promo := &ent.SenderFeeTier{Name: "promo", FeePercent: decimal.Zero, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+		expiredPromo := &ent.SenderFeeTier{Name: "expired", FeePercent: decimal.Zero, EndsAt: now.Add(-time.Hour)}
+
+		tiers := []*ent.SenderFeeTier{standard, volume, expiredPromo}
+		assert.Equal(t, standard, SelectSenderFeeTier(tiers, decimal.NewFromInt(500), now))
+		assert.Equal(t, volume, SelectSenderFeeTier(tiers, decimal.NewFromInt(10000), now))
+		assert.Nil(t, SelectSenderFeeTier([]*ent.SenderFeeTier{volume}, decimal.NewFromInt(500), now))
+
+		// Running promotions take precedence over volume tiers
+		tiers = append(tiers, promo)
+		assert.Equal(t, promo, SelectSenderFeeTier(tiers, decimal.NewFromInt(20000), now))
+		assert.Equal(t, volume, SelectSenderFeeTier(tiers, decimal.NewFromInt(20000), now.Add(2*time.Hour)))
+	})
+
+	t.Run("SenderFee", func(t *testing.T) {
+		tier := &ent.SenderFeeTier{MinFee: decimal.NewFromInt(1), MaxFee: decimal.NewFromInt(5)}
+
+		assert.True(t, SenderFee(decimal.NewFromInt(200), decimal.NewFromInt(1), nil).Equal(decimal.NewFromInt(2)))
+		assert.True(t, SenderFee(decimal.NewFromInt(200), decimal.NewFromInt(1), tier).Equal(decimal.NewFromInt(2)))
+		assert.True(t, SenderFee(decimal.NewFromInt(20), decimal.NewFromInt(1), tier).Equal(decimal.NewFromInt(1)))
+		assert.True(t, SenderFee(decimal.NewFromInt(2000), decimal.NewFromInt(1), tier).Equal(decimal.NewFromInt(5)))
+		assert.True(t, SenderFee(decimal.NewFromInt(2000), decimal.NewFromInt(1), &ent.SenderFeeTier{}).Equal(decimal.NewFromInt(20)))
+	})
+
+	t.Run("SplitOrderAmount", func(t *testing.T) {
+		splits := []types.PaymentOrderSplitPayload{
+			{Percent: decimal.NewFromInt(10)},
+			{FiatAmount: decimal.NewFromInt(5000)},
+		}
+
+		amounts, err := SplitOrderAmount(decimal.NewFromInt(100), decimal.NewFromInt(1000), splits)
+		assert.NoError(t, err)
+		assert.True(t, amounts[0].Equal(decimal.NewFromInt(10)))
+		assert.True(t, amounts[1].Equal(decimal.NewFromInt(5)))
+
+		// Splits must leave a share for the main recipient
+		_, err = SplitOrderAmount(decimal.NewFromInt(5), decimal.NewFromInt(1000), splits)
+		assert.Error(t, err)
+
+		// A split has either a percent or a fiat amount
+		_, err = SplitOrderAmount(decimal.NewFromInt(100), decimal.NewFromInt(1000), []types.PaymentOrderSplitPayload{
+			{Percent: decimal.NewFromInt(10), FiatAmount: decimal.NewFromInt(5000)},
+		})
+		assert.Error(t, err)
+		_, err = SplitOrderAmount(decimal.NewFromInt(100), decimal.NewFromInt(1000), []types.PaymentOrderSplitPayload{{}})
+		assert.Error(t, err)
+	})
+
+	t.Run("recipientSettlementStatus", func(t *testing.T) {
+		lockOrders := []*ent.LockPaymentOrder{
+			{Institution: "ABNGNGLA", AccountIdentifier: "1", Status: lockpaymentorder.StatusSettled},
+			{Institution: "ABNGNGLA", AccountIdentifier: "1", Status: lockpaymentorder.StatusSettled},
+			{Institution: "ABNGNGLA", AccountIdentifier: "2", Status: lockpaymentorder.StatusSettled},
+			{Institution: "ABNGNGLA", AccountIdentifier: "2", Status: lockpaymentorder.StatusPending},
+		}
+
+		assert.Equal(t, lockpaymentorder.StatusSettled, recipientSettlementStatus(lockOrders, "ABNGNGLA", "1"))
+		assert.Equal(t, lockpaymentorder.StatusProcessing, recipientSettlementStatus(lockOrders, "ABNGNGLA", "2"))
+		assert.Equal(t, lockpaymentorder.StatusPending, recipientSettlementStatus(lockOrders, "ABNGNGLA", "3"))
+	})
+
+	t.Run("IsLockOrderFulfilled", func(t *testing.T) {
+		order := &ent.LockPaymentOrder{
+			Amount:          decimal.NewFromFloat(100.5),
+			Rate:            decimal.NewFromFloat(1482.333),
+			AmountFulfilled: decimal.NewFromInt(100000),
+		}
+
+		assert.Equal(t, "148974.46", LockOrderFiatAmount(order).String())
+		assert.Equal(t, "48974.46", UnfulfilledAmount(order).String())
+		assert.False(t, IsLockOrderFulfilled(order))
+
+		order.AmountFulfilled = order.AmountFulfilled.Add(decimal.NewFromFloat(48974.46))
+		assert.True(t, UnfulfilledAmount(order).IsZero())
+		assert.True(t, IsLockOrderFulfilled(order))
+	})
+
+	t.Run("GetTokenRateFromQueue", func(t *testing.T) {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr: "localhost:6379",
+		})
+		defer redisClient.Close()
+
+		db.RedisClient = redisClient
+
+		ctx := context.Background()
+		bucketKey := "bucket_TST_0_10000"
+		defer redisClient.Del(ctx, bucketKey)
+
+		err := redisClient.RPush(ctx, bucketKey,
+			"provider1:USDT:950:50:100:0.5:",
+			"provider2:USDT:900:0.5:500:0.5:",
+			"provider3:USDC:800:0.5:500:0.5:",
+		).Err()
+		assert.NoError(t, err)
+
+		// Skips providers whose min and max order amount don't cover the order
+		rate, err := GetTokenRateFromQueue("USDT", decimal.NewFromInt(10), "TST", decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(900)), "expected rate of 900, got %s", rate)
+
+		// Falls back to the market rate when no provider matches
+		rate, err = GetTokenRateFromQueue("DAI", decimal.NewFromInt(10), "TST", decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(1000)), "expected rate of 1000, got %s", rate)
+
+		// Returns the provider offering the rate
+		rate, providerID, err := GetTokenRateQuoteFromQueue("USDT", decimal.NewFromInt(10), "TST", decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(900)), "expected rate of 900, got %s", rate)
+		assert.Equal(t, "provider2", providerID)
+
+		_, providerID, err = GetTokenRateQuoteFromQueue("DAI", decimal.NewFromInt(10), "TST", decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+		assert.Empty(t, providerID)
+	})
+
+	t.Run("GetProviderRateFromQueue", func(t *testing.T) {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr: "localhost:6379",
+		})
+		defer redisClient.Close()
+
+		db.RedisClient = redisClient
+
+		ctx := context.Background()
+		bucketKey := "bucket_TST_0_10000"
+		defer redisClient.Del(ctx, bucketKey)
+
+		err := redisClient.RPush(ctx, bucketKey,
+			"provider1:USDT:950:50:100:0.5:",
+			"provider2:USDT:900:0.5:500:0.5:0.5/5/905/1",
+		).Err()
+		assert.NoError(t, err)
+
+		// Uses the rate band for the order amount
+		rate, slippage, found, err := GetProviderRateFromQueue("provider2", "USDT", decimal.NewFromInt(2), "TST")
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.True(t, rate.Equal(decimal.NewFromInt(905)), "expected rate of 905, got %s", rate)
+		assert.True(t, slippage.Equal(decimal.NewFromInt(1)), "expected slippage of 1, got %s", slippage)
+
+		// Skips entries whose min and max order amount don't cover the order
+		_, _, found, err = GetProviderRateFromQueue("provider1", "USDT", decimal.NewFromInt(10), "TST")
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("QueueEntryRate", func(t *testing.T) {
+		parts := strings.Split("provider1:USDT:1490:0.5:10000:0.5:0.5/500/1495/0.5,500/5000/1502/1", ":")
+
+		rate, slippage, err := QueueEntryRate(parts, decimal.NewFromInt(1000))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(1502)), "expected rate of 1502, got %s", rate)
+		assert.True(t, slippage.Equal(decimal.NewFromInt(1)), "expected slippage of 1, got %s", slippage)
+
+		rate, _, err = QueueEntryRate(parts, decimal.NewFromInt(500))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(1495)), "expected rate of 1495, got %s", rate)
+
+		// Amounts outside the bands get the entry's rate
+		rate, slippage, err = QueueEntryRate(parts, decimal.NewFromInt(8000))
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(1490)), "expected rate of 1490, got %s", rate)
+		assert.True(t, slippage.Equal(decimal.NewFromFloat(0.5)), "expected slippage of 0.5, got %s", slippage)
+
+		_, _, err = QueueEntryRate(strings.Split("provider1:USDT:1490:0.5:10000:0.5:0.5/500", ":"), decimal.NewFromInt(100))
+		assert.Error(t, err)
+	})
+
+	t.Run("DecodeCursor", func(t *testing.T) {
+		createdAt := time.Date(2024, 5, 1, 10, 30, 0, 123456000, time.UTC)
+		id := uuid.New()
+
+		cursor, err := DecodeCursor(EncodeCursor(createdAt, id))
+		assert.NoError(t, err)
+		assert.True(t, cursor.CreatedAt.Equal(createdAt))
+		assert.Equal(t, id, cursor.ID)
+
+		_, err = DecodeCursor("not-a-cursor")
+		assert.Error(t, err)
+	})
+
+	t.Run("ParseOrderListFilters", func(t *testing.T) {
+		newContext := func(query string) *gin.Context {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest("GET", "/orders?"+query, nil)
+			return ctx
+		}
+
+		filters, err := ParseOrderListFilters(newContext("status=pending,settled&from=2024-05-01&to=2024-05-31&minAmount=10&maxAmount=500.5&institution=ABNGNGLA"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pending", "settled"}, filters.Statuses)
+		assert.Equal(t, "ABNGNGLA", filters.Institution)
+		assert.True(t, filters.From.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, filters.To.After(time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)))
+		assert.True(t, filters.MinAmount.Valid)
+		assert.True(t, filters.MaxAmount.Decimal.Equal(decimal.NewFromFloat(500.5)))
+		assert.Nil(t, filters.Cursor)
+
+		_, err = ParseOrderListFilters(newContext("from=2024-06-01&to=2024-05-01"))
+		assert.Error(t, err)
+
+		_, err = ParseOrderListFilters(newContext("minAmount=abc"))
+		assert.Error(t, err)
+
+		_, err = ParseOrderListFilters(newContext("cursor=abc"))
+		assert.Error(t, err)
+	})
+
+	t.Run("NextScheduledRun", func(t *testing.T) {
+		startAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+		// One-off orders run once at the start time
+		next, err := NextScheduledRun("", startAt, time.Time{}, time.Time{})
+		assert.NoError(t, err)
+		assert.True(t, next.Equal(startAt))
+
+		next, err = NextScheduledRun("", startAt, time.Time{}, startAt)
+		assert.NoError(t, err)
+		assert.True(t, next.IsZero())
+
+		// Recurring orders run on the schedule from the start time
+		next, err = NextScheduledRun("0 9 1 * *", startAt, time.Time{}, time.Time{})
+		assert.NoError(t, err)
+		assert.True(t, next.Equal(startAt))
+
+		next, err = NextScheduledRun("0 9 1 * *", startAt, time.Time{}, startAt.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, next.Equal(time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)))
+
+		// Recurring orders stop at the end time
+		next, err = NextScheduledRun("0 9 1 * *", startAt, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), startAt.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, next.IsZero())
+
+		_, err = NextScheduledRun("every month", startAt, time.Time{}, time.Time{})
+		assert.Error(t, err)
+	})
+
+	t.Run("IsProviderOpen", func(t *testing.T) {
+		provider := &ent.ProviderProfile{
+			Timezone: "Africa/Lagos",
+			OperatingHours: []types.ProviderOperatingHours{
+				{Day: 3, Open: "08:00", Close: "17:00"},
+				{Day: 5, Open: "22:00", Close: "06:00"},
+			},
+			Holidays: []string{"2024-05-08"},
+		}
+
+		// Hours are in the provider's timezone
+		assert.True(t, IsProviderOpen(provider, time.Date(2024, 5, 1, 7, 30, 0, 0, time.UTC)))
+		assert.False(t, IsProviderOpen(provider, time.Date(2024, 5, 1, 16, 30, 0, 0, time.UTC)))
+
+		// Providers are closed on holidays
+		assert.False(t, IsProviderOpen(provider, time.Date(2024, 5, 8, 9, 0, 0, 0, time.UTC)))
+
+		// Overnight hours run into the next day
+		assert.True(t, IsProviderOpen(provider, time.Date(2024, 5, 4, 4, 0, 0, 0, time.UTC)))
+		assert.False(t, IsProviderOpen(provider, time.Date(2024, 5, 4, 5, 30, 0, 0, time.UTC)))
+
+		// Providers without operating hours are always open
+		assert.True(t, IsProviderOpen(&ent.ProviderProfile{}, time.Date(2024, 5, 4, 5, 30, 0, 0, time.UTC)))
+	})
+
+	t.Run("SupportsInstitution", func(t *testing.T) {
+		// Providers without declared institutions support every institution
+		assert.True(t, SupportsInstitution(&ent.ProviderProfile{}, "MOMONGPC"))
+
+		// Unsupported institutions are skipped
+		provider := &ent.ProviderProfile{UnsupportedInstitutions: []string{"MOMONGPC"}}
+		assert.False(t, SupportsInstitution(provider, "MOMONGPC"))
+		assert.True(t, SupportsInstitution(provider, "ABNGNGLA"))
+
+		// Only supported institutions are used when declared
+		provider = &ent.ProviderProfile{SupportedInstitutions: []string{"ABNGNGLA"}}
+		assert.True(t, SupportsInstitution(provider, "ABNGNGLA"))
+		assert.False(t, SupportsInstitution(provider, "MOMONGPC"))
+	})
 }